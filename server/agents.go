@@ -0,0 +1,142 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// getAgentForRun returns the agent build a run was made by, or nil if it doesn't reference one
+func getAgentForRun(ctx context.Context, runId uuid.UUID, store Store) (*Agent, error) {
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting run: %w", err)
+	}
+	if run == nil || run.AgentId == nil {
+		return nil, nil
+	}
+
+	agent, err := store.GetAgent(ctx, *run.AgentId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting agent: %w", err)
+	}
+
+	return agent, nil
+}
+
+// agentAllowsTool reports whether an agent build declared a tool
+func agentAllowsTool(agent Agent, toolName string) bool {
+	return slices.Contains(agent.Tools, WildcardToolName) || slices.Contains(agent.Tools, toolName)
+}
+
+func apiRegisterAgentHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request RegisterAgentJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if request.Name == "" || request.Version == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "name and version are required", "")
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	existing, err := store.GetAgentFromNameAndVersion(ctx, projectId, request.Name, request.Version)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting agent", err.Error())
+		return
+	}
+
+	if existing != nil {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("agent %s version %s is already registered", request.Name, request.Version), existing.Id.String())
+		return
+	}
+
+	agent := Agent{
+		Id:           uuid.New(),
+		ProjectId:    projectId,
+		Name:         request.Name,
+		Version:      request.Version,
+		Capabilities: []string{},
+		Tools:        request.Tools,
+		ToolPolicies: []ToolPolicy{},
+		CreatedAt:    time.Now(),
+	}
+	if agent.Tools == nil {
+		agent.Tools = []string{}
+	}
+	if request.Capabilities != nil {
+		agent.Capabilities = *request.Capabilities
+	}
+	if request.ToolPolicies != nil {
+		agent.ToolPolicies = *request.ToolPolicies
+	}
+
+	if err := validateToolPolicies(ctx, agent.ToolPolicies, false, store); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid tool policy", err.Error())
+		return
+	}
+
+	if err := store.CreateAgent(ctx, agent); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating agent", err.Error())
+		return
+	}
+
+	respondJSON(w, agent, http.StatusCreated)
+}
+
+func apiGetProjectAgentsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	agents, err := store.GetProjectAgents(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting agents", err.Error())
+		return
+	}
+
+	respondJSON(w, agents, http.StatusOK)
+}
+
+func apiGetAgentHandler(w http.ResponseWriter, r *http.Request, agentId uuid.UUID, store AgentStore) {
+	agent, err := store.GetAgent(r.Context(), agentId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting agent", err.Error())
+		return
+	}
+
+	if agent == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Agent not found", "")
+		return
+	}
+
+	respondJSON(w, agent, http.StatusOK)
+}
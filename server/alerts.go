@@ -0,0 +1,356 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	alertEvaluationInterval = time.Minute
+	// newToolLookback is how far back first calls of tools are alerted on, so alerts aren't lost to
+	// the server being down for a while
+	newToolLookback = 24 * time.Hour
+
+	defaultAlertWindowSeconds = 3600
+	defaultAlertMinDecisions  = 10
+	defaultAlertsLimit        = 100
+	maxAlerts                 = 1000
+)
+
+// ToolFirstUse is when a tool was first called in a project
+type ToolFirstUse struct {
+	ToolName    string
+	FirstUsedAt time.Time
+}
+
+// validateAlertRules checks that rules have unique names and the settings their kind needs
+func validateAlertRules(rules []AlertRule) error {
+	names := make(map[string]bool)
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return fmt.Errorf("alert rules need a name")
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("duplicate alert rule %s", rule.Name)
+		}
+		names[rule.Name] = true
+
+		switch rule.Kind {
+		case NewTool:
+			if rule.Metric != nil || rule.Factor != nil || rule.WindowSeconds != nil || rule.MinDecisions != nil {
+				return fmt.Errorf("alert rule %s alerts on new tools, so it can't have a metric, factor, window or minimum", rule.Name)
+			}
+		case RateChange:
+			if rule.Metric == nil {
+				return fmt.Errorf("alert rule %s needs a metric", rule.Name)
+			}
+			switch *rule.Metric {
+			case RejectionRate, EscalationRate, DecisionVolume:
+			default:
+				return fmt.Errorf("unknown metric of alert rule %s: %s", rule.Name, *rule.Metric)
+			}
+			if rule.Factor == nil || *rule.Factor <= 0 || *rule.Factor == 1 {
+				return fmt.Errorf("alert rule %s needs a positive factor other than 1", rule.Name)
+			}
+			if rule.WindowSeconds != nil && *rule.WindowSeconds < 60 {
+				return fmt.Errorf("window_seconds of alert rule %s must be at least 60", rule.Name)
+			}
+			if rule.MinDecisions != nil && *rule.MinDecisions < 1 {
+				return fmt.Errorf("min_decisions of alert rule %s must be at least 1", rule.Name)
+			}
+			if rule.ToolNames != nil {
+				return fmt.Errorf("alert rule %s compares rates, so it can't have tool names", rule.Name)
+			}
+		default:
+			return fmt.Errorf("unknown kind of alert rule %s: %s", rule.Name, rule.Kind)
+		}
+	}
+	return nil
+}
+
+// AlertEngine evaluates the alert rules of every project, firing each alert once and delivering it
+// through the project's notification webhook
+type AlertEngine struct {
+	store Store
+}
+
+func NewAlertEngine(store Store) *AlertEngine {
+	return &AlertEngine{store: store}
+}
+
+func (e *AlertEngine) Start(ctx context.Context) {
+	ticker := time.NewTicker(alertEvaluationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.evaluate(ctx, time.Now()); err != nil {
+				log.Printf("Error evaluating alert rules: %v", err)
+			}
+		}
+	}
+}
+
+func (e *AlertEngine) evaluate(ctx context.Context, now time.Time) error {
+	projects, err := e.store.GetProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting projects: %w", err)
+	}
+
+	for _, project := range projects {
+		rules, err := e.store.GetAlertRules(ctx, project.Id)
+		if err != nil {
+			return fmt.Errorf("error getting alert rules of project %s: %w", project.Id, err)
+		}
+
+		for _, rule := range rules {
+			var alerts []Alert
+			switch rule.Kind {
+			case NewTool:
+				alerts, err = e.newToolAlerts(ctx, project.Id, rule, now)
+			case RateChange:
+				alerts, err = e.rateChangeAlerts(ctx, project.Id, rule, now)
+			}
+			if err != nil {
+				log.Printf("Error evaluating alert rule %s of project %s: %v", rule.Name, project.Id, err)
+				continue
+			}
+
+			for _, alert := range alerts {
+				e.fire(ctx, alert)
+			}
+		}
+	}
+	return nil
+}
+
+// newToolAlerts alerts on the tools first called in the project since the lookback
+func (e *AlertEngine) newToolAlerts(ctx context.Context, projectId uuid.UUID, rule AlertRule, now time.Time) ([]Alert, error) {
+	uses, err := e.store.GetFirstToolUses(ctx, projectId, now.Add(-newToolLookback))
+	if err != nil {
+		return nil, fmt.Errorf("error getting first tool uses: %w", err)
+	}
+
+	alerts := make([]Alert, 0)
+	for _, use := range uses {
+		if rule.ToolNames != nil && !slices.Contains(*rule.ToolNames, use.ToolName) {
+			continue
+		}
+		alerts = append(alerts, newAlert(projectId, rule, use.ToolName,
+			fmt.Sprintf("%s was called for the first time", use.ToolName),
+			map[string]interface{}{"tool_name": use.ToolName, "first_used_at": use.FirstUsedAt}))
+	}
+	return alerts, nil
+}
+
+// rateChangeAlerts compares the metric of the last completed window with the window before it
+func (e *AlertEngine) rateChangeAlerts(ctx context.Context, projectId uuid.UUID, rule AlertRule, now time.Time) ([]Alert, error) {
+	windowSeconds, minDecisions := defaultAlertWindowSeconds, defaultAlertMinDecisions
+	if rule.WindowSeconds != nil {
+		windowSeconds = *rule.WindowSeconds
+	}
+	if rule.MinDecisions != nil {
+		minDecisions = *rule.MinDecisions
+	}
+	window := time.Duration(windowSeconds) * time.Second
+
+	end := now.UTC().Truncate(window)
+	start := end.Add(-window)
+
+	current, err := e.store.GetDecisionCounts(ctx, projectId, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("error getting decision counts: %w", err)
+	}
+	previous, err := e.store.GetDecisionCounts(ctx, projectId, start.Add(-window), start)
+	if err != nil {
+		return nil, fmt.Errorf("error getting decision counts: %w", err)
+	}
+
+	currentTotal, previousTotal := totalDecisions(current), totalDecisions(previous)
+	if previousTotal < minDecisions || (*rule.Metric != DecisionVolume && currentTotal < minDecisions) {
+		return nil, nil
+	}
+
+	currentValue, previousValue := alertMetricValue(*rule.Metric, current), alertMetricValue(*rule.Metric, previous)
+	factor := *rule.Factor
+
+	// A metric rising from zero has changed by more than any factor
+	change := math.Inf(1)
+	switch {
+	case previousValue > 0:
+		change = currentValue / previousValue
+	case currentValue == 0:
+		change = 1
+	}
+	if (factor > 1 && change < factor) || (factor < 1 && change > factor) {
+		return nil, nil
+	}
+
+	message := fmt.Sprintf("%s went from %s to %s", *rule.Metric, formatAlertMetric(*rule.Metric, previousValue), formatAlertMetric(*rule.Metric, currentValue))
+	details := map[string]interface{}{
+		"metric":             *rule.Metric,
+		"window_start":       start,
+		"window_end":         end,
+		"value":              currentValue,
+		"previous_value":     previousValue,
+		"decisions":          currentTotal,
+		"previous_decisions": previousTotal,
+	}
+	return []Alert{newAlert(projectId, rule, start.Format(time.RFC3339), message, details)}, nil
+}
+
+func totalDecisions(counts map[Decision]int) int {
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+	return total
+}
+
+func alertMetricValue(metric AlertMetric, counts map[Decision]int) float64 {
+	total := float64(totalDecisions(counts))
+	switch metric {
+	case RejectionRate:
+		return float64(counts[Reject]) / total
+	case EscalationRate:
+		return float64(counts[Escalate]) / total
+	default:
+		return total
+	}
+}
+
+func formatAlertMetric(metric AlertMetric, value float64) string {
+	if metric == DecisionVolume {
+		return fmt.Sprintf("%.0f", value)
+	}
+	return fmt.Sprintf("%.1f%%", value*100)
+}
+
+func newAlert(projectId uuid.UUID, rule AlertRule, key string, message string, details map[string]interface{}) Alert {
+	return Alert{
+		Id:        uuid.New(),
+		ProjectId: projectId,
+		RuleName:  rule.Name,
+		Kind:      rule.Kind,
+		Key:       key,
+		Message:   message,
+		Details:   details,
+		FiredAt:   time.Now(),
+	}
+}
+
+// fire stores an alert and notifies its project, unless the rule already fired for the alert's key
+func (e *AlertEngine) fire(ctx context.Context, alert Alert) {
+	created, err := e.store.CreateAlert(ctx, alert)
+	if err != nil {
+		log.Printf("Error creating alert of rule %s: %v", alert.RuleName, err)
+		return
+	}
+	if !created {
+		return
+	}
+
+	notification := Notification{Event: AlertFired, ProjectId: alert.ProjectId, Alert: &alert}
+	if _, err := dispatchNotification(ctx, notification, e.store); err != nil {
+		log.Printf("Error sending alert %s of rule %s: %v", alert.Id, alert.RuleName, err)
+	}
+}
+
+func apiGetProjectAlertRulesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	rules, err := store.GetAlertRules(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting alert rules", err.Error())
+		return
+	}
+
+	respondJSON(w, rules, http.StatusOK)
+}
+
+func apiSetProjectAlertRulesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var rules []AlertRule
+	if err := json.NewDecoder(r.Body).Decode(&rules); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateAlertRules(rules); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid alert rule", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := store.SetAlertRules(ctx, projectId, rules); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting alert rules", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiGetProjectAlertsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, params GetProjectAlertsParams, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	limit := defaultAlertsLimit
+	if params.Limit != nil {
+		if *params.Limit <= 0 {
+			sendErrorResponse(w, http.StatusBadRequest, "limit must be positive", "")
+			return
+		}
+		limit = min(*params.Limit, maxAlerts)
+	}
+
+	alerts, err := store.GetAlerts(ctx, projectId, limit)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting alerts", err.Error())
+		return
+	}
+
+	respondJSON(w, alerts, http.StatusOK)
+}
@@ -0,0 +1,332 @@
+package asteroid
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// anthropicContentBlock is a block of the content of a message of Anthropic's Messages API. Only the
+// fields of the block types Asteroid reads are decoded: text, image, tool_use and tool_result.
+type anthropicContentBlock struct {
+	Type      string                `json:"type"`
+	Text      string                `json:"text,omitempty"`
+	Source    *anthropicImageSource `json:"source,omitempty"`
+	Id        string                `json:"id,omitempty"`
+	Name      string                `json:"name,omitempty"`
+	Input     json.RawMessage       `json:"input,omitempty"`
+	ToolUseId string                `json:"tool_use_id,omitempty"`
+	Content   anthropicContent      `json:"content,omitempty"`
+}
+
+type anthropicImageSource struct {
+	Type      string `json:"type"`
+	MediaType string `json:"media_type,omitempty"`
+	Data      string `json:"data,omitempty"`
+	Url       string `json:"url,omitempty"`
+}
+
+// anthropicContent is the content of a message, which the API takes as a string or a list of blocks
+type anthropicContent []anthropicContentBlock
+
+func (c *anthropicContent) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*c = anthropicContent{{Type: "text", Text: text}}
+		return nil
+	}
+
+	var blocks []anthropicContentBlock
+	if err := json.Unmarshal(data, &blocks); err != nil {
+		return err
+	}
+	*c = blocks
+	return nil
+}
+
+type anthropicMessage struct {
+	Role    string           `json:"role"`
+	Content anthropicContent `json:"content"`
+}
+
+// anthropicRequest keeps its system prompt and messages raw so they're stored as they were sent
+type anthropicRequest struct {
+	Model    string            `json:"model"`
+	System   json.RawMessage   `json:"system,omitempty"`
+	Messages []json.RawMessage `json:"messages"`
+}
+
+type anthropicResponse struct {
+	Id         string                  `json:"id"`
+	Type       string                  `json:"type"`
+	Role       string                  `json:"role"`
+	Content    []anthropicContentBlock `json:"content"`
+	StopReason string                  `json:"stop_reason"`
+}
+
+// AnthropicConverter converts chats made with Anthropic's Messages API
+type AnthropicConverter struct {
+	store ToolStore
+}
+
+func (c *AnthropicConverter) ToAsteroidMessages(
+	ctx context.Context,
+	requestData, responseData []byte,
+	runId uuid.UUID,
+) ([]AsteroidMessage, error) {
+	var chatRequest anthropicRequest
+	if err := json.Unmarshal(requestData, &chatRequest); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal chat request: %w", err)
+	}
+
+	asteroidMsgs := make([]AsteroidMessage, 0, len(chatRequest.Messages)+2)
+
+	// The system prompt is a field of the request rather than a message
+	if len(chatRequest.System) > 0 {
+		var system anthropicContent
+		if err := json.Unmarshal(chatRequest.System, &system); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal system prompt: %w", err)
+		}
+		converted, err := c.ConvertMessage(ctx, AsteroidMessageRoleSystem, system, chatRequest.System, runId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert system prompt: %w", err)
+		}
+		asteroidMsgs = append(asteroidMsgs, converted)
+	}
+
+	for _, raw := range chatRequest.Messages {
+		var msg anthropicMessage
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal message: %w", err)
+		}
+		converted, err := c.ConvertMessage(ctx, AsteroidMessageRole(msg.Role), msg.Content, raw, runId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert message: %w", err)
+		}
+		asteroidMsgs = append(asteroidMsgs, converted)
+	}
+
+	choices, err := c.ToAsteroidChoices(ctx, responseData, runId)
+	if err != nil {
+		return nil, err
+	}
+	for _, choice := range choices {
+		asteroidMsgs = append(asteroidMsgs, choice.Message)
+	}
+
+	return asteroidMsgs, nil
+}
+
+// ToAsteroidChoices converts the response, which is a single message, to a single choice
+func (c *AnthropicConverter) ToAsteroidChoices(
+	ctx context.Context,
+	responseData []byte,
+	runId uuid.UUID,
+) ([]AsteroidChoice, error) {
+	var chatResponse anthropicResponse
+	if err := json.Unmarshal(responseData, &chatResponse); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal chat response: %w", err)
+	}
+
+	// The response is stored whole, so its message is the response without the envelope
+	content, err := json.Marshal(anthropicMessage{Role: chatResponse.Role, Content: chatResponse.Content})
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling response message: %w", err)
+	}
+
+	message, err := c.ConvertMessage(ctx, AsteroidMessageRole(chatResponse.Role), chatResponse.Content, content, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error converting message: %w", err)
+	}
+
+	return []AsteroidChoice{{
+		AsteroidId:   uuid.New().String(),
+		Index:        0,
+		Message:      message,
+		FinishReason: anthropicFinishReason(chatResponse.StopReason),
+	}}, nil
+}
+
+// anthropicFinishReason maps the stop reason of a response to the finish reason of a choice
+func anthropicFinishReason(stopReason string) AsteroidChoiceFinishReason {
+	switch stopReason {
+	case "tool_use":
+		return ToolCalls
+	case "max_tokens":
+		return Length
+	case "refusal":
+		return ContentFilter
+	default:
+		return Stop
+	}
+}
+
+// ConvertMessage converts a message from its role and content blocks. Text blocks, and the text of
+// tool results, are joined into the message's content and tool_use blocks become its tool calls. A
+// user message of only tool results is a tool message.
+func (c *AnthropicConverter) ConvertMessage(
+	ctx context.Context,
+	role AsteroidMessageRole,
+	content anthropicContent,
+	raw []byte,
+	runId uuid.UUID,
+) (AsteroidMessage, error) {
+	texts := make([]string, 0, len(content))
+	toolCalls := make([]AsteroidToolCall, 0)
+	msgType := Text
+	var imageContent string
+	toolResults := 0
+
+	for _, block := range content {
+		switch block.Type {
+		case "text":
+			texts = append(texts, block.Text)
+		case "image":
+			msgType = ImageUrl
+			imageContent = anthropicImageUrl(block.Source)
+		case "tool_use":
+			toolCall, err := c.ConvertToolCall(ctx, block, runId)
+			if err != nil {
+				return AsteroidMessage{}, fmt.Errorf("error converting tool calls: %w", err)
+			}
+			toolCalls = append(toolCalls, *toolCall)
+		case "tool_result":
+			toolResults++
+			for _, resultBlock := range block.Content {
+				if resultBlock.Type == "text" {
+					texts = append(texts, resultBlock.Text)
+				}
+			}
+		}
+	}
+
+	if role == AsteroidMessageRoleUser && toolResults > 0 && toolResults == len(content) {
+		role = AsteroidMessageRoleTool
+	}
+
+	msgContent := imageContent
+	var language *string
+	if msgType == Text {
+		msgContent = NormalizeContent(strings.Join(texts, "\n"))
+		detected := DetectLanguage(msgContent)
+		language = &detected
+	}
+
+	b64 := base64.StdEncoding.EncodeToString(raw)
+	id := uuid.New()
+
+	return AsteroidMessage{
+		Id:        &id,
+		Role:      role,
+		ToolCalls: &toolCalls,
+		Type:      &msgType,
+		Content:   msgContent,
+		Data:      &b64,
+		Language:  language,
+	}, nil
+}
+
+// anthropicImageUrl returns the URL of an image block, as a data URL if the image was sent inline
+func anthropicImageUrl(source *anthropicImageSource) string {
+	if source == nil {
+		return ""
+	}
+	if source.Type == "base64" {
+		return fmt.Sprintf("data:%s;base64,%s", source.MediaType, source.Data)
+	}
+	return source.Url
+}
+
+func (c *AnthropicConverter) ConvertToolCall(
+	ctx context.Context,
+	block anthropicContentBlock,
+	runId uuid.UUID,
+) (*AsteroidToolCall, error) {
+	tool, err := c.store.GetToolFromNameAndRunId(ctx, block.Name, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool: %w", err)
+	}
+	if tool == nil {
+		return nil, fmt.Errorf("tool not found: %s", block.Name)
+	}
+
+	// Anthropic sends the input as an object where OpenAI sends a JSON string
+	arguments := "{}"
+	if len(block.Input) > 0 {
+		arguments = string(block.Input)
+	}
+	name := block.Name
+	callId := block.Id
+
+	return &AsteroidToolCall{
+		CallId:    &callId,
+		Id:        uuid.New(),
+		ToolId:    *tool.Id,
+		Name:      &name,
+		Arguments: &arguments,
+	}, nil
+}
+
+// decodeB64JSON decodes base64 encoded JSON, compacted so it's stored the same way however it was indented
+func decodeB64JSON(encodedData string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(encodedData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 format: %w", err)
+	}
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, decoded); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	return compacted.Bytes(), nil
+}
+
+// ValidateB64EncodedRequest checks the request is a Messages API request. Unlike OpenAI chats the
+// request is stored as it was sent, since only the fields Asteroid reads are decoded.
+func (c *AnthropicConverter) ValidateB64EncodedRequest(encodedData string) ([]byte, error) {
+	decodedRequest, err := decodeB64JSON(encodedData)
+	if err != nil {
+		return nil, err
+	}
+
+	var v anthropicRequest
+	if err := json.Unmarshal(decodedRequest, &v); err != nil {
+		return nil, fmt.Errorf("invalid request format: %w", err)
+	}
+	if len(v.System) > 0 {
+		var system anthropicContent
+		if err := json.Unmarshal(v.System, &system); err != nil {
+			return nil, fmt.Errorf("invalid request format: system must be a string or content blocks: %w", err)
+		}
+	}
+	for i, raw := range v.Messages {
+		var msg anthropicMessage
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			return nil, fmt.Errorf("invalid request format: message %d: %w", i, err)
+		}
+	}
+
+	return decodedRequest, nil
+}
+
+func (c *AnthropicConverter) ValidateB64EncodedResponse(encodedData string) ([]byte, error) {
+	decodedResponse, err := decodeB64JSON(encodedData)
+	if err != nil {
+		return nil, err
+	}
+
+	var v anthropicResponse
+	if err := json.Unmarshal(decodedResponse, &v); err != nil {
+		return nil, fmt.Errorf("invalid response format: %w", err)
+	}
+	if v.Type != "message" {
+		return nil, fmt.Errorf("invalid response format: type must be message, got %q", v.Type)
+	}
+
+	return decodedResponse, nil
+}
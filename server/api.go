@@ -3,6 +3,7 @@ package asteroid
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -12,8 +13,21 @@ import (
 )
 
 type Server struct {
-	Hub   *Hub
-	Store Store
+	Hub        *Hub
+	Store      Store
+	Translator Translator
+	Proxy      *ChatProxy
+	Blobs      BlobStore
+	Streams    *ChatStreams
+	Anchorer   *AuditAnchorer
+	Archiver   *ArchiveExporter
+	Breakers   *CircuitBreakers
+	Lanes      *PriorityLanes
+	Exports    *RunExports
+	Workers    *Workers
+	Config     *ConfigReloader
+	Pricing    PricingTable
+	Repairer   *StuckExecutionRepairer
 }
 
 func sendErrorResponse(w http.ResponseWriter, status int, message string, details string) {
@@ -29,30 +43,200 @@ func sendErrorResponse(w http.ResponseWriter, status int, message string, detail
 	}
 }
 
+// apiError is the error response of logic the HTTP and gRPC APIs share, which each sends its own
+// way. body, if set, is sent in place of an ErrorResponse.
+type apiError struct {
+	status  int
+	message string
+	details string
+	body    interface{}
+}
+
+func (e *apiError) Error() string {
+	if e.details != "" {
+		return e.message + ": " + e.details
+	}
+	return e.message
+}
+
+// sendAPIError sends the response of an error of shared logic, which is an internal error unless
+// it's an apiError
+func sendAPIError(w http.ResponseWriter, err error) {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		sendErrorResponse(w, http.StatusInternalServerError, "internal error", err.Error())
+		return
+	}
+
+	if apiErr.body != nil {
+		respondJSON(w, apiErr.body, apiErr.status)
+		return
+	}
+	sendErrorResponse(w, apiErr.status, apiErr.message, apiErr.details)
+}
+
 func InitAPI(store Store) {
 	log.Println("Initializing API v1")
 
+	config, err := NewConfigReloaderFromEnv()
+	if err != nil {
+		log.Fatal("Error loading configuration: ", err)
+	}
+
+	configureDemoMode()
+
 	humanReviewChan := make(chan SupervisionRequest, 100)
 
+	// Decisions are stored through the hub's store, so it hears of them whoever makes them
 	hub := NewHub(store, humanReviewChan)
+	store = hub.Store
 	go hub.Run()
 
-	processor := NewProcessor(store, humanReviewChan)
-	go processor.Start(context.Background())
+	proxy := NewChatProxyFromEnv()
+
+	if err := recoverSupervisionRequests(context.Background(), store); err != nil {
+		log.Printf("Error recovering supervision requests: %v", err)
+	}
+
+	lanes, err := NewPriorityLanesFromEnv()
+	if err != nil {
+		log.Fatal("Error configuring priority lanes: ", err)
+	}
+
+	serviceNow, err := NewServiceNowClientFromEnv()
+	if err != nil {
+		log.Fatal("Error configuring ServiceNow: ", err)
+	}
+
+	workers, err := NewWorkersFromEnv(store)
+	if err != nil {
+		log.Fatal("Error configuring background workers: ", err)
+	}
+
+	backplane, err := NewBackplaneFromEnv()
+	if err != nil {
+		log.Fatal("Error configuring hub backplane: ", err)
+	}
+	if backplane != nil {
+		hub.UseBackplane(context.Background(), backplane, workers.instance)
+	}
+
+	breakers := NewCircuitBreakers()
+	processor := NewProcessor(store, humanReviewChan, judgeFor(proxy), breakers, lanes, serviceNow)
+	// Human reviews go to the reviewers connected to the replica running the processor, or to those
+	// of the other replicas through the backplane if none of its reviewers has capacity
+	workers.Add("processor", processor.Start)
+
+	if serviceNow != nil {
+		changeRequests := NewChangeRequestPoller(serviceNow, store)
+		workers.Add("change_requests", changeRequests.Start)
+	}
+
+	timers := NewTimerRunner(store, hub)
+	workers.Add("timers", timers.Start)
+
+	alerts := NewAlertEngine(store)
+	workers.Add("alerts", alerts.Start)
+
+	backfills := NewBackfillRunner(store, judgeFor(proxy), lanes)
+	workers.Add("backfills", backfills.Start)
+
+	webhooks := NewWebhookDispatcher(store)
+	workers.Add("webhooks", webhooks.Start)
+
+	repairer, err := NewStuckExecutionRepairerFromEnv(store)
+	if err != nil {
+		log.Fatal("Error configuring stuck execution repair: ", err)
+	}
+	workers.Add("stuck_executions", repairer.Start)
+
+	// Every replica sends watch notifications to the reviewers connected to it
+	watchers := NewWatchNotifier(hub, store)
+	go watchers.Start(context.Background())
+
+	anchorer, err := NewAuditAnchorerFromEnv(store)
+	if err != nil {
+		log.Fatal("Error configuring audit anchoring: ", err)
+	}
+	if anchorer != nil {
+		workers.Add("audit_anchors", anchorer.Start)
+	}
+
+	archiver, err := NewArchiveExporterFromEnv(store)
+	if err != nil {
+		log.Fatal("Error configuring archive export: ", err)
+	}
+	if archiver != nil {
+		workers.Add("archives", archiver.Start)
+	}
+
+	workers.Start(context.Background())
+
+	translator, err := NewTranslatorFromEnv()
+	if err != nil {
+		log.Fatal("Error configuring translation backend: ", err)
+	}
+
+	blobs, err := NewBlobStoreFromEnv()
+	if err != nil {
+		log.Fatal("Error configuring blob store: ", err)
+	}
+
+	exports, err := NewRunExportsFromEnv()
+	if err != nil {
+		log.Fatal("Error configuring run exports: ", err)
+	}
+
+	pricing, err := NewPricingTableFromEnv()
+	if err != nil {
+		log.Fatal("Error configuring model pricing: ", err)
+	}
+
+	// The default run limits are read as requests come in, so they can be reloaded
+	if _, err := defaultRunLimits(os.Getenv); err != nil {
+		log.Fatal("Error configuring run limits: ", err)
+	}
 
+	config.Attach(lanes, exports)
+	go config.ReloadOnSignal()
+
+	streams := NewChatStreams()
 	server := Server{
-		Hub:   hub,
-		Store: store,
+		Hub:        hub,
+		Store:      store,
+		Translator: translator,
+		Proxy:      proxy,
+		Blobs:      blobs,
+		Streams:    streams,
+		Anchorer:   anchorer,
+		Archiver:   archiver,
+		Breakers:   breakers,
+		Lanes:      lanes,
+		Exports:    exports,
+		Workers:    workers,
+		Config:     config,
+		Pricing:    pricing,
+		Repairer:   repairer,
 	}
 
-	apiHandler := Handler(server)
+	apiHandler := HandlerWithOptions(server, StdHTTPServerOptions{
+		Middlewares: []MiddlewareFunc{apiKeyMiddleware(store, streams)},
+	})
 	corsHandler := enableCorsMiddleware(apiHandler)
 
+	if grpcPort := os.Getenv("GRPC_PORT"); grpcPort != "" {
+		go serveGRPC(grpcPort, server)
+	}
+
 	mux := http.NewServeMux()
 	mux.Handle("/api/v1/", http.StripPrefix("/api/v1", corsHandler))
 	mux.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
 		serveWs(hub, w, r)
 	})
+	events := NewEventStreams(hub)
+	mux.Handle("/api/events", enableCorsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serveEvents(events, w, r)
+	})))
 
 	port := os.Getenv("APPROVAL_WEBSERVER_PORT")
 	if port == "" {
@@ -60,7 +244,7 @@ func InitAPI(store Store) {
 	}
 
 	log.Printf("Server v1 started on port %s", port)
-	err := http.ListenAndServe(fmt.Sprintf(":%s", port), mux)
+	err = http.ListenAndServe(fmt.Sprintf(":%s", port), mux)
 	if err != nil {
 		log.Fatal("Error listening and serving: ", err)
 	}
@@ -151,7 +335,7 @@ func (s Server) GetTool(w http.ResponseWriter, r *http.Request, id uuid.UUID) {
 }
 
 func (s Server) CreateSupervisionRequest(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID, chainId uuid.UUID, supervisorId uuid.UUID) {
-	apiCreateSupervisionRequestHandler(w, r, toolCallId, chainId, supervisorId, s.Store)
+	apiCreateSupervisionRequestHandler(w, r, toolCallId, chainId, supervisorId, s.Hub, s.Store)
 }
 
 func (s Server) GetSupervisionRequestStatus(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
@@ -159,7 +343,35 @@ func (s Server) GetSupervisionRequestStatus(w http.ResponseWriter, r *http.Reque
 }
 
 func (s Server) CreateSupervisionResult(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
-	apiCreateSupervisionResultHandler(w, r, supervisionRequestId, s.Store)
+	apiCreateSupervisionResultHandler(w, r, supervisionRequestId, s.Store, s.Hub)
+}
+
+func (s Server) BatchDecideToolCalls(w http.ResponseWriter, r *http.Request) {
+	apiBatchDecideToolCallsHandler(w, r, s.Store, s.Hub)
+}
+
+func (s Server) GetSupervisorCircuitBreaker(w http.ResponseWriter, r *http.Request, supervisorId uuid.UUID) {
+	apiGetSupervisorCircuitBreakerHandler(w, r, supervisorId, s.Breakers, s.Store)
+}
+
+func (s Server) GetQueueStats(w http.ResponseWriter, r *http.Request) {
+	apiGetQueueStatsHandler(w, r, s.Lanes, s.Store)
+}
+
+func (s Server) GetCircuitBreakers(w http.ResponseWriter, r *http.Request) {
+	apiGetCircuitBreakersHandler(w, r, s.Breakers)
+}
+
+func (s Server) GetRunPause(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiGetRunPauseHandler(w, r, runId, s.Store)
+}
+
+func (s Server) PauseRun(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiPauseRunHandler(w, r, runId, s.Store)
+}
+
+func (s Server) ResumeRun(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiResumeRunHandler(w, r, runId, s.Store)
 }
 
 func (s Server) GetSupervisionResult(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
@@ -170,8 +382,8 @@ func (s Server) GetHubStats(w http.ResponseWriter, r *http.Request) {
 	apiGetHubStatsHandler(w, r, s.Hub)
 }
 
-func (s Server) GetSupervisionReviewPayload(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
-	apiGetSupervisionReviewPayloadHandler(w, r, supervisionRequestId, s.Store)
+func (s Server) GetSupervisionReviewPayload(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, params GetSupervisionReviewPayloadParams) {
+	apiGetSupervisionReviewPayloadHandler(w, r, supervisionRequestId, params, s.Store, s.Blobs)
 }
 
 func (s Server) GetToolCallStatus(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID) {
@@ -198,6 +410,30 @@ func (s Server) CreateNewChat(w http.ResponseWriter, r *http.Request, runId uuid
 	apiCreateNewChatHandler(w, r, runId, s.Store)
 }
 
+func (s Server) CreateChatStream(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiCreateChatStreamHandler(w, r, runId, s.Streams, s.Store)
+}
+
+func (s Server) GetChatStream(w http.ResponseWriter, r *http.Request, streamId uuid.UUID) {
+	apiGetChatStreamHandler(w, r, streamId, s.Streams)
+}
+
+func (s Server) AppendChatStreamChunks(w http.ResponseWriter, r *http.Request, streamId uuid.UUID) {
+	apiAppendChatStreamChunksHandler(w, r, streamId, s.Streams, s.Store)
+}
+
+func (s Server) CompleteChatStream(w http.ResponseWriter, r *http.Request, streamId uuid.UUID) {
+	apiCompleteChatStreamHandler(w, r, streamId, s.Streams, s.Store)
+}
+
+func (s Server) GetProjectChatSupervisors(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectChatSupervisorsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectChatSupervisors(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectChatSupervisorsHandler(w, r, projectId, s.Store)
+}
+
 func (s Server) GetRunMessages(w http.ResponseWriter, r *http.Request, runId uuid.UUID, index int) {
 	apiGetRunMessagesHandler(w, r, runId, index, s.Store)
 }
@@ -206,11 +442,499 @@ func (s Server) GetRunChatCount(w http.ResponseWriter, r *http.Request, runId uu
 	apiGetRunChatCountHandler(w, r, runId, s.Store)
 }
 
+func (s Server) GetMessageTranslation(w http.ResponseWriter, r *http.Request, messageId uuid.UUID, params GetMessageTranslationParams) {
+	apiGetMessageTranslationHandler(w, r, messageId, params, s.Store, s.Translator)
+}
+
+func (s Server) UpdateMessageContent(w http.ResponseWriter, r *http.Request, messageId uuid.UUID) {
+	apiUpdateMessageContentHandler(w, r, messageId, s.Store)
+}
+
+func (s Server) GetMessageDiffs(w http.ResponseWriter, r *http.Request, messageId uuid.UUID) {
+	apiGetMessageDiffsHandler(w, r, messageId, s.Store)
+}
+
+func (s Server) GetContextWindowPolicies(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetContextWindowPoliciesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetContextWindowPolicies(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetContextWindowPoliciesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) CreateProxyChatCompletion(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiCreateProxyChatCompletionHandler(w, r, runId, s.Store, s.Proxy)
+}
+
+func (s Server) GetRunTruncations(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiGetRunTruncationsHandler(w, r, runId, s.Store)
+}
+
+func (s Server) GetToolCallDependencies(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID) {
+	apiGetToolCallDependenciesHandler(w, r, toolCallId, s.Store)
+}
+
+func (s Server) SetToolCallDependencies(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID) {
+	apiSetToolCallDependenciesHandler(w, r, toolCallId, s.Store)
+}
+
+func (s Server) GetProjectTemplates(w http.ResponseWriter, r *http.Request) {
+	apiGetProjectTemplatesHandler(w, r)
+}
+
+func (s Server) BootstrapProject(w http.ResponseWriter, r *http.Request) {
+	apiBootstrapProjectHandler(w, r, s.Store)
+}
+
+func (s Server) GetProjectToolPolicies(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectToolPoliciesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectToolPolicies(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectToolPoliciesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetProjectArgumentRules(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectArgumentRulesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectArgumentRules(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectArgumentRulesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetProjectNotificationSettings(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectNotificationSettingsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectNotificationSettings(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectNotificationSettingsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetOrganizations(w http.ResponseWriter, r *http.Request) {
+	apiGetOrganizationsHandler(w, r, s.Store)
+}
+
+func (s Server) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	apiCreateOrganizationHandler(w, r, s.Store)
+}
+
+func (s Server) GetOrganization(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID) {
+	apiGetOrganizationHandler(w, r, organizationId, s.Store)
+}
+
+func (s Server) GetOrganizationToolPolicies(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID) {
+	apiGetOrganizationToolPoliciesHandler(w, r, organizationId, s.Store)
+}
+
+func (s Server) SetOrganizationToolPolicies(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID) {
+	apiSetOrganizationToolPoliciesHandler(w, r, organizationId, s.Store)
+}
+
+func (s Server) SetProjectOrganization(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectOrganizationHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetProjectEffectiveToolPolicies(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectEffectiveToolPoliciesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetApiKeys(w http.ResponseWriter, r *http.Request) {
+	apiGetApiKeysHandler(w, r, s.Store)
+}
+
+func (s Server) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+	apiCreateApiKeyHandler(w, r, s.Store)
+}
+
+func (s Server) RevokeApiKey(w http.ResponseWriter, r *http.Request, apiKeyId uuid.UUID) {
+	apiRevokeApiKeyHandler(w, r, apiKeyId, s.Store)
+}
+
+func (s Server) RotateApiKey(w http.ResponseWriter, r *http.Request, apiKeyId uuid.UUID) {
+	apiRotateApiKeyHandler(w, r, apiKeyId, s.Store)
+}
+
+func (s Server) GetSupervisionRequestAuditLog(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
+	apiGetSupervisionRequestAuditLogHandler(w, r, supervisionRequestId, s.Store)
+}
+
+func (s Server) GetHandoffBundles(w http.ResponseWriter, r *http.Request) {
+	apiGetHandoffBundlesHandler(w, r, s.Store)
+}
+
+func (s Server) CreateHandoffBundle(w http.ResponseWriter, r *http.Request) {
+	apiCreateHandoffBundleHandler(w, r, s.Store, s.Hub)
+}
+
+func (s Server) GetHandoffBundle(w http.ResponseWriter, r *http.Request, handoffBundleId uuid.UUID) {
+	apiGetHandoffBundleHandler(w, r, handoffBundleId, s.Store)
+}
+
+func (s Server) RestoreHandoffBundle(w http.ResponseWriter, r *http.Request, handoffBundleId uuid.UUID) {
+	apiRestoreHandoffBundleHandler(w, r, handoffBundleId, s.Store, s.Hub)
+}
+
+func (s Server) GetToolCallHistory(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID) {
+	apiGetToolCallHistoryHandler(w, r, toolCallId, s.Store)
+}
+
+func (s Server) GetKillSwitch(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID) {
+	apiGetKillSwitchHandler(w, r, organizationId, s.Store)
+}
+
+func (s Server) RequestKillSwitch(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID) {
+	apiRequestKillSwitchHandler(w, r, organizationId, s.Store)
+}
+
+func (s Server) ConfirmKillSwitch(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID, killSwitchRequestId uuid.UUID) {
+	apiConfirmKillSwitchHandler(w, r, organizationId, killSwitchRequestId, s.Store)
+}
+
+func (s Server) GetProjectIncidents(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectIncidentsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) StartIncidentMode(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiStartIncidentModeHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) EndIncidentMode(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiEndIncidentModeHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) DryRunSupervisor(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiDryRunSupervisorHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) CreateBackfill(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiCreateBackfillHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetProjectBackfills(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectBackfillsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetBackfill(w http.ResponseWriter, r *http.Request, backfillId uuid.UUID) {
+	apiGetBackfillHandler(w, r, backfillId, s.Store)
+}
+
+func (s Server) PauseBackfill(w http.ResponseWriter, r *http.Request, backfillId uuid.UUID) {
+	apiSetBackfillStatusHandler(w, r, backfillId, BackfillRunning, BackfillPaused, s.Store)
+}
+
+func (s Server) ResumeBackfill(w http.ResponseWriter, r *http.Request, backfillId uuid.UUID) {
+	apiSetBackfillStatusHandler(w, r, backfillId, BackfillPaused, BackfillRunning, s.Store)
+}
+
+func (s Server) GetBackfillReport(w http.ResponseWriter, r *http.Request, backfillId uuid.UUID) {
+	apiGetBackfillReportHandler(w, r, backfillId, s.Store)
+}
+
+func (s Server) GetProjectAgents(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectAgentsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) RegisterAgent(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiRegisterAgentHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetAgent(w http.ResponseWriter, r *http.Request, agentId uuid.UUID) {
+	apiGetAgentHandler(w, r, agentId, s.Store)
+}
+
+func (s Server) GetTaskSummary(w http.ResponseWriter, r *http.Request, taskId uuid.UUID) {
+	apiGetTaskSummaryHandler(w, r, taskId, s.Store)
+}
+
+func (s Server) GetTaskTimeline(w http.ResponseWriter, r *http.Request, taskId uuid.UUID) {
+	apiGetTaskTimelineHandler(w, r, taskId, s.Store)
+}
+
+func (s Server) GetSupervisionRequestConsent(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
+	apiGetSupervisionRequestConsentHandler(w, r, supervisionRequestId, s.Store)
+}
+
+func (s Server) GetConsentPrompt(w http.ResponseWriter, r *http.Request, token string) {
+	apiGetConsentPromptHandler(w, r, token, s.Store)
+}
+
+func (s Server) RespondToConsent(w http.ResponseWriter, r *http.Request, token string) {
+	apiRespondToConsentHandler(w, r, token, s.Store)
+}
+
+func (s Server) ExportProjectConfig(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiExportProjectConfigHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) ExportProjectRuns(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, params ExportProjectRunsParams) {
+	apiExportProjectRunsHandler(w, r, projectId, params, s.Exports, s.Store)
+}
+
+func (s Server) ImportProjectConfig(w http.ResponseWriter, r *http.Request) {
+	apiImportProjectConfigHandler(w, r, s.Store)
+}
+
+func (s Server) GetOrganizationQuotas(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID) {
+	apiGetOrganizationQuotasHandler(w, r, organizationId, s.Store)
+}
+
+func (s Server) SetOrganizationQuotas(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID) {
+	apiSetOrganizationQuotasHandler(w, r, organizationId, s.Store)
+}
+
+func (s Server) GetProjectQuotas(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectQuotasHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectQuotas(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectQuotasHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetProjectQuotaUsage(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectQuotaUsageHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetMeteringEvents(w http.ResponseWriter, r *http.Request, params GetMeteringEventsParams) {
+	apiGetMeteringEventsHandler(w, r, params, s.Store)
+}
+
+func (s Server) GetProjectIngestionHooks(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectIngestionHooksHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectIngestionHooks(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectIngestionHooksHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetProjectWebhooks(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectWebhooksHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) CreateProjectWebhook(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiCreateProjectWebhookHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) UpdateWebhook(w http.ResponseWriter, r *http.Request, webhookId uuid.UUID) {
+	apiUpdateWebhookHandler(w, r, webhookId, s.Store)
+}
+
+func (s Server) DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookId uuid.UUID) {
+	apiDeleteWebhookHandler(w, r, webhookId, s.Store)
+}
+
+func (s Server) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookId uuid.UUID) {
+	apiGetWebhookDeliveriesHandler(w, r, webhookId, s.Store)
+}
+
+func (s Server) AttachRunDocument(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiAttachRunDocumentHandler(w, r, runId, s.Store, s.Blobs)
+}
+
+func (s Server) GetRunDocuments(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiGetRunDocumentsHandler(w, r, runId, s.Store)
+}
+
+func (s Server) GetRunDocument(w http.ResponseWriter, r *http.Request, documentId uuid.UUID) {
+	apiGetRunDocumentHandler(w, r, documentId, s.Store, s.Blobs)
+}
+
+func (s Server) UploadRunArtifact(w http.ResponseWriter, r *http.Request, runId uuid.UUID, params UploadRunArtifactParams) {
+	apiUploadRunArtifactHandler(w, r, runId, params, s.Store, s.Blobs)
+}
+
+func (s Server) GetRunArtifacts(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiGetRunArtifactsHandler(w, r, runId, s.Store)
+}
+
+func (s Server) GetToolCallArtifacts(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID) {
+	apiGetToolCallArtifactsHandler(w, r, toolCallId, s.Store)
+}
+
+func (s Server) UploadToolCallScreenshot(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID) {
+	apiUploadToolCallScreenshotHandler(w, r, toolCallId, s.Store, s.Blobs)
+}
+
+func (s Server) GetRunArtifact(w http.ResponseWriter, r *http.Request, artifactId uuid.UUID) {
+	apiGetRunArtifactHandler(w, r, artifactId, s.Store)
+}
+
+func (s Server) DownloadRunArtifact(w http.ResponseWriter, r *http.Request, artifactId uuid.UUID) {
+	apiDownloadRunArtifactHandler(w, r, artifactId, s.Store, s.Blobs)
+}
+
+func (s Server) GetToolCallResources(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID) {
+	apiGetToolCallResourcesHandler(w, r, toolCallId, s.Store)
+}
+
+func (s Server) GetProjectResourceReferences(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, params GetProjectResourceReferencesParams) {
+	apiGetProjectResourceReferencesHandler(w, r, projectId, params, s.Store)
+}
+
+func (s Server) GetProjectVerdicts(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectVerdictsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectVerdicts(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectVerdictsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetSupervisionRequestClarifications(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
+	apiGetSupervisionRequestClarificationsHandler(w, r, supervisionRequestId, s.Store)
+}
+
+func (s Server) GetSupervisorTestCases(w http.ResponseWriter, r *http.Request, supervisorId uuid.UUID) {
+	apiGetSupervisorTestCasesHandler(w, r, supervisorId, s.Store)
+}
+
+func (s Server) SetSupervisorTestCases(w http.ResponseWriter, r *http.Request, supervisorId uuid.UUID) {
+	apiSetSupervisorTestCasesHandler(w, r, supervisorId, s.Store)
+}
+
+func (s Server) RunSupervisorTestCases(w http.ResponseWriter, r *http.Request, supervisorId uuid.UUID) {
+	apiRunSupervisorTestCasesHandler(w, r, supervisorId, s.Store, judgeFor(s.Proxy))
+}
+
+func (s Server) GetLocalizedMessages(w http.ResponseWriter, r *http.Request, locale string) {
+	apiGetLocalizedMessagesHandler(w, r, locale)
+}
+
+func (s Server) CreateRunEvent(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiCreateRunEventHandler(w, r, runId, s.Store)
+}
+
+func (s Server) GetRunEvents(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiGetRunEventsHandler(w, r, runId, s.Store)
+}
+
+func (s Server) GetWaitingSupervisionRequests(w http.ResponseWriter, r *http.Request) {
+	apiGetWaitingSupervisionRequestsHandler(w, r, s.Store, s.Hub)
+}
+
+func (s Server) CreateSupervisionRequestReminder(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
+	apiCreateSupervisionRequestReminderHandler(w, r, supervisionRequestId, s.Store)
+}
+
+func (s Server) GetSupervisionRequestReminders(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
+	apiGetSupervisionRequestRemindersHandler(w, r, supervisionRequestId, s.Store)
+}
+
+func (s Server) VerifyRunIntegrity(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiVerifyRunIntegrityHandler(w, r, runId, s.Store)
+}
+
+func (s Server) CreateRunPlan(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiCreateRunPlanHandler(w, r, runId, s.Store)
+}
+
+func (s Server) GetRunPlans(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiGetRunPlansHandler(w, r, runId, s.Store)
+}
+
+func (s Server) GetPlan(w http.ResponseWriter, r *http.Request, planId uuid.UUID) {
+	apiGetPlanHandler(w, r, planId, s.Store)
+}
+
+func (s Server) GetPlanDeviations(w http.ResponseWriter, r *http.Request, planId uuid.UUID) {
+	apiGetPlanDeviationsHandler(w, r, planId, s.Store)
+}
+
+func (s Server) DecidePlan(w http.ResponseWriter, r *http.Request, planId uuid.UUID) {
+	apiDecidePlanHandler(w, r, planId, s.Store)
+}
+
+func (s Server) VerifyAuditLog(w http.ResponseWriter, r *http.Request) {
+	apiVerifyAuditLogHandler(w, r, s.Store)
+}
+
+func (s Server) GetAuditAnchors(w http.ResponseWriter, r *http.Request) {
+	apiGetAuditAnchorsHandler(w, r, s.Store)
+}
+
+func (s Server) AnchorAuditLog(w http.ResponseWriter, r *http.Request) {
+	apiAnchorAuditLogHandler(w, r, s.Anchorer, s.Store)
+}
+
+func (s Server) GetArchives(w http.ResponseWriter, r *http.Request) {
+	apiGetArchivesHandler(w, r, s.Store)
+}
+
+func (s Server) ExportArchive(w http.ResponseWriter, r *http.Request, day string) {
+	apiExportArchiveHandler(w, r, day, s.Archiver, s.Store)
+}
+
+func (s Server) VerifyArchive(w http.ResponseWriter, r *http.Request, day string) {
+	apiVerifyArchiveHandler(w, r, day, s.Archiver, s.Store)
+}
+
+func (s Server) GetProjectAlertRules(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectAlertRulesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectAlertRules(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectAlertRulesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetProjectAlerts(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, params GetProjectAlertsParams) {
+	apiGetProjectAlertsHandler(w, r, projectId, params, s.Store)
+}
+
+func (s Server) PreapproveToolCall(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiPreapproveToolCallHandler(w, r, runId, s.Store, judgeFor(s.Proxy))
+}
+
+func (s Server) GetSupervisorPromptVariantReport(w http.ResponseWriter, r *http.Request, supervisorId uuid.UUID) {
+	apiGetSupervisorPromptVariantReportHandler(w, r, supervisorId, s.Store)
+}
+
+func (s Server) GetSupervisionRequestEnsembleVerdicts(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
+	apiGetSupervisionRequestEnsembleVerdictsHandler(w, r, supervisionRequestId, s.Store)
+}
+
+func (s Server) AnswerClarification(w http.ResponseWriter, r *http.Request, clarificationId uuid.UUID) {
+	apiAnswerClarificationHandler(w, r, clarificationId, s.Store, s.Hub)
+}
+
+func (s Server) GetProjectRoutingRules(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectRoutingRulesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectRoutingRules(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectRoutingRulesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetReviewers(w http.ResponseWriter, r *http.Request) {
+	apiGetReviewersHandler(w, r, s.Store)
+}
+
+func (s Server) SetReviewer(w http.ResponseWriter, r *http.Request, session string) {
+	apiSetReviewerHandler(w, r, session, s.Store)
+}
+
+func (s Server) UpdateRunAutonomy(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiUpdateRunAutonomyHandler(w, r, runId, s.Store)
+}
+
+func (s Server) GetAgentTrust(w http.ResponseWriter, r *http.Request, agentId uuid.UUID) {
+	apiGetAgentTrustHandler(w, r, agentId, s.Store)
+}
+
+func (s Server) GetProjectTrustPolicy(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectTrustPolicyHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectTrustPolicy(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectTrustPolicyHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetSupervisorCalibration(w http.ResponseWriter, r *http.Request, supervisorId uuid.UUID) {
+	apiGetSupervisorCalibrationHandler(w, r, supervisorId, s.Store)
+}
+
 func enableCorsMiddleware(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, Last-Event-ID, "+ApiKeyHeader)
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -220,3 +944,219 @@ func enableCorsMiddleware(handler http.Handler) http.Handler {
 		handler.ServeHTTP(w, r)
 	})
 }
+
+func (s Server) GetToolCallArgumentDiff(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID) {
+	apiGetToolCallArgumentDiffHandler(w, r, toolCallId, s.Store)
+}
+
+func (s Server) GetRunTranscript(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiGetRunTranscriptHandler(w, r, runId, s.Store)
+}
+
+func (s Server) CreateTranscriptSegment(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiCreateTranscriptSegmentHandler(w, r, runId, s.Store)
+}
+
+func (s Server) GetProjectPayloadTemplates(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectPayloadTemplatesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectPayloadTemplates(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectPayloadTemplatesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) PreviewPayloadTemplate(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiPreviewPayloadTemplateHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) ExportRun(w http.ResponseWriter, r *http.Request, runId uuid.UUID, params ExportRunParams) {
+	apiExportRunHandler(w, r, runId, params, s.Store)
+}
+
+func (s Server) ImportRun(w http.ResponseWriter, r *http.Request, taskId uuid.UUID) {
+	apiImportRunHandler(w, r, taskId, s.Store)
+}
+
+func (s Server) GetWatchSubscriptions(w http.ResponseWriter, r *http.Request, session string) {
+	apiGetWatchSubscriptionsHandler(w, r, session, s.Store)
+}
+
+func (s Server) CreateWatchSubscription(w http.ResponseWriter, r *http.Request, session string) {
+	apiCreateWatchSubscriptionHandler(w, r, session, s.Store)
+}
+
+func (s Server) DeleteWatchSubscription(w http.ResponseWriter, r *http.Request, watchSubscriptionId uuid.UUID) {
+	apiDeleteWatchSubscriptionHandler(w, r, watchSubscriptionId, s.Store)
+}
+
+func (s Server) GetWatchNotifications(w http.ResponseWriter, r *http.Request, session string, params GetWatchNotificationsParams) {
+	apiGetWatchNotificationsHandler(w, r, session, params, s.Store)
+}
+
+func (s Server) InsertChainSupervisor(w http.ResponseWriter, r *http.Request, toolId uuid.UUID, chainId uuid.UUID) {
+	apiInsertChainSupervisorHandler(w, r, toolId, chainId, s.Store)
+}
+
+func (s Server) RemoveChainSupervisor(w http.ResponseWriter, r *http.Request, toolId uuid.UUID, chainId uuid.UUID, supervisorId uuid.UUID) {
+	apiRemoveChainSupervisorHandler(w, r, toolId, chainId, supervisorId, s.Store)
+}
+
+func (s Server) ReorderChainSupervisors(w http.ResponseWriter, r *http.Request, toolId uuid.UUID, chainId uuid.UUID) {
+	apiReorderChainSupervisorsHandler(w, r, toolId, chainId, s.Store)
+}
+
+func (s Server) GetSupervisorChainVersion(w http.ResponseWriter, r *http.Request, toolId uuid.UUID, chainId uuid.UUID, version int) {
+	apiGetSupervisorChainVersionHandler(w, r, toolId, chainId, version, s.Store)
+}
+
+func (s Server) GetSupervisionRequestChangeRequest(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
+	apiGetSupervisionRequestChangeRequestHandler(w, r, supervisionRequestId, s.Store)
+}
+
+func (s Server) GetProjectArgumentBaselines(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectArgumentBaselinesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectArgumentBaselines(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectArgumentBaselinesHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) Search(w http.ResponseWriter, r *http.Request, params SearchParams) {
+	apiSearchHandler(w, r, params, s.Store)
+}
+
+func (s Server) GetToolCallArgumentForm(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID) {
+	apiGetToolCallArgumentFormHandler(w, r, toolCallId, s.Store)
+}
+
+func (s Server) ReportToolCallResult(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID) {
+	apiReportToolCallResultHandler(w, r, toolCallId, s.Store)
+}
+
+func (s Server) GetToolCallResult(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID) {
+	apiGetToolCallResultHandler(w, r, toolCallId, s.Store)
+}
+
+func (s Server) DecideToolCallResult(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID) {
+	apiDecideToolCallResultHandler(w, r, toolCallId, s.Store)
+}
+
+func (s Server) GetProjectToolCallResults(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, params GetProjectToolCallResultsParams) {
+	apiGetProjectToolCallResultsHandler(w, r, projectId, params, s.Store)
+}
+
+func (s Server) GetProjectResultSupervisors(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectResultSupervisorsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectResultSupervisors(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectResultSupervisorsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) ImportRunOpenApiTools(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiImportRunOpenApiToolsHandler(w, r, runId, s.Store)
+}
+
+func (s Server) CompareRuns(w http.ResponseWriter, r *http.Request, params CompareRunsParams) {
+	apiCompareRunsHandler(w, r, params, s.Store)
+}
+
+func (s Server) GetProjectRedactors(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectRedactorsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectRedactors(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectRedactorsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetRunRedactions(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiGetRunRedactionsHandler(w, r, runId, s.Store)
+}
+
+func (s Server) GetWorkers(w http.ResponseWriter, r *http.Request) {
+	apiGetWorkersHandler(w, r, s.Workers)
+}
+
+func (s Server) ReloadConfig(w http.ResponseWriter, r *http.Request) {
+	apiReloadConfigHandler(w, r, s.Config)
+}
+
+func (s Server) GetFeatureFlags(w http.ResponseWriter, r *http.Request) {
+	apiGetFeatureFlagsHandler(w, r, s.Store)
+}
+
+func (s Server) SetFeatureFlagRollout(w http.ResponseWriter, r *http.Request, flag string) {
+	apiSetFeatureFlagRolloutHandler(w, r, flag, s.Store)
+}
+
+func (s Server) DeleteFeatureFlagRollout(w http.ResponseWriter, r *http.Request, flag string) {
+	apiDeleteFeatureFlagRolloutHandler(w, r, flag, s.Store)
+}
+
+func (s Server) GetProjectFeatureFlags(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectFeatureFlagsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetCapabilities(w http.ResponseWriter, r *http.Request) {
+	apiGetCapabilitiesHandler(w, r)
+}
+
+func (s Server) NegotiateCapabilities(w http.ResponseWriter, r *http.Request) {
+	apiNegotiateCapabilitiesHandler(w, r)
+}
+
+func (s Server) GetRunUsage(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiGetRunUsageHandler(w, r, runId, s.Pricing, s.Store)
+}
+
+func (s Server) GetRunReplay(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiGetRunReplayHandler(w, r, runId, s.Store)
+}
+
+func (s Server) GetProjectUsage(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, params GetProjectUsageParams) {
+	apiGetProjectUsageHandler(w, r, projectId, params, s.Pricing, s.Store)
+}
+
+func (s Server) GetDiagnostics(w http.ResponseWriter, r *http.Request) {
+	apiGetDiagnosticsHandler(w, r, s.Hub, s.Lanes, s.Workers, s.Repairer, s.Store)
+}
+
+func (s Server) DraftReviewFeedback(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
+	apiDraftReviewFeedbackHandler(w, r, supervisionRequestId, judgeFor(s.Proxy), s.Store)
+}
+
+func (s Server) ClaimSupervisionRequest(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
+	apiClaimSupervisionRequestHandler(w, r, supervisionRequestId, s.Store)
+}
+
+func (s Server) ReleaseSupervisionRequest(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID) {
+	apiReleaseSupervisionRequestHandler(w, r, supervisionRequestId, s.Store)
+}
+
+func (s Server) GetUsers(w http.ResponseWriter, r *http.Request) {
+	apiGetUsersHandler(w, r, s.Store)
+}
+
+func (s Server) CreateUser(w http.ResponseWriter, r *http.Request) {
+	apiCreateUserHandler(w, r, s.Store)
+}
+
+func (s Server) GetUserQueue(w http.ResponseWriter, r *http.Request, userId uuid.UUID) {
+	apiGetUserQueueHandler(w, r, userId, s.Store, s.Hub)
+}
+
+func (s Server) GetProjectPrecedentChains(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectPrecedentChainsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetProjectRunLimits(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiGetProjectRunLimitsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) SetProjectRunLimits(w http.ResponseWriter, r *http.Request, projectId uuid.UUID) {
+	apiSetProjectRunLimitsHandler(w, r, projectId, s.Store)
+}
+
+func (s Server) GetRunLimitUsage(w http.ResponseWriter, r *http.Request, runId uuid.UUID) {
+	apiGetRunLimitUsageHandler(w, r, runId, s.Store)
+}
@@ -0,0 +1,200 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// projectKeyRoutes can be called with a project's key even though their path names no resource of a
+// project. Their handlers limit what they return or change to the key's project.
+var projectKeyRoutes = []string{
+	"GET /project",
+	"GET /api_key",
+	"POST /api_key",
+	"DELETE /api_key/{apiKeyId}",
+	"POST /api_key/{apiKeyId}/rotate",
+	"GET /messages/{locale}",
+	"GET /search",
+	"GET /run_comparison",
+	"GET /capabilities",
+	"POST /capabilities",
+}
+
+// projectResolver returns the project of the resource with an ID, or nil if there's no such resource
+type projectResolver func(ctx context.Context, id uuid.UUID, store Store, streams *ChatStreams) (*uuid.UUID, error)
+
+// pathProjectResolvers find the project of the resources named by path parameters. Every parameter
+// of a route that's listed here has to belong to a project key's project.
+var pathProjectResolvers = map[string]projectResolver{
+	"projectId": func(_ context.Context, id uuid.UUID, _ Store, _ *ChatStreams) (*uuid.UUID, error) {
+		return &id, nil
+	},
+	"taskId": func(ctx context.Context, id uuid.UUID, store Store, _ *ChatStreams) (*uuid.UUID, error) {
+		return projectIdOf(getProjectForTask(ctx, id, store))
+	},
+	"runId":  resolveRunProject,
+	"run_id": resolveRunProject,
+	"toolId": func(ctx context.Context, id uuid.UUID, store Store, streams *ChatStreams) (*uuid.UUID, error) {
+		tool, err := store.GetTool(ctx, id)
+		if err != nil || tool == nil {
+			return nil, err
+		}
+		return resolveRunProject(ctx, tool.RunId, store, streams)
+	},
+	"toolCallId": func(ctx context.Context, id uuid.UUID, store Store, _ *ChatStreams) (*uuid.UUID, error) {
+		return projectIdOf(getProjectForToolCall(ctx, id, store))
+	},
+	"supervisionRequestId": func(ctx context.Context, id uuid.UUID, store Store, streams *ChatStreams) (*uuid.UUID, error) {
+		runId, err := getRunIdForSupervisionRequest(ctx, id, store)
+		if err != nil || runId == nil {
+			return nil, err
+		}
+		return resolveRunProject(ctx, *runId, store, streams)
+	},
+	"clarificationId": func(ctx context.Context, id uuid.UUID, store Store, streams *ChatStreams) (*uuid.UUID, error) {
+		clarification, err := store.GetClarification(ctx, id)
+		if err != nil || clarification == nil {
+			return nil, err
+		}
+		runId, err := getRunIdForSupervisionRequest(ctx, clarification.SupervisionRequestId, store)
+		if err != nil || runId == nil {
+			return nil, err
+		}
+		return resolveRunProject(ctx, *runId, store, streams)
+	},
+	"planId": func(ctx context.Context, id uuid.UUID, store Store, streams *ChatStreams) (*uuid.UUID, error) {
+		plan, err := store.GetPlan(ctx, id)
+		if err != nil || plan == nil {
+			return nil, err
+		}
+		return resolveRunProject(ctx, plan.RunId, store, streams)
+	},
+	"documentId": func(ctx context.Context, id uuid.UUID, store Store, streams *ChatStreams) (*uuid.UUID, error) {
+		document, err := store.GetRunDocument(ctx, id)
+		if err != nil || document == nil {
+			return nil, err
+		}
+		return resolveRunProject(ctx, document.RunId, store, streams)
+	},
+	"artifactId": func(ctx context.Context, id uuid.UUID, store Store, streams *ChatStreams) (*uuid.UUID, error) {
+		artifact, err := store.GetRunArtifact(ctx, id)
+		if err != nil || artifact == nil {
+			return nil, err
+		}
+		return resolveRunProject(ctx, artifact.RunId, store, streams)
+	},
+	"messageId": func(ctx context.Context, id uuid.UUID, store Store, streams *ChatStreams) (*uuid.UUID, error) {
+		runId, err := store.GetMessageRunId(ctx, id)
+		if err != nil || runId == nil {
+			return nil, err
+		}
+		return resolveRunProject(ctx, *runId, store, streams)
+	},
+	"streamId": func(ctx context.Context, id uuid.UUID, store Store, streams *ChatStreams) (*uuid.UUID, error) {
+		stream := streams.get(id)
+		if stream == nil {
+			return nil, nil
+		}
+		return resolveRunProject(ctx, stream.state.RunId, store, streams)
+	},
+	"agentId": func(ctx context.Context, id uuid.UUID, store Store, _ *ChatStreams) (*uuid.UUID, error) {
+		agent, err := store.GetAgent(ctx, id)
+		if err != nil || agent == nil {
+			return nil, err
+		}
+		return &agent.ProjectId, nil
+	},
+	"webhookId": func(ctx context.Context, id uuid.UUID, store Store, _ *ChatStreams) (*uuid.UUID, error) {
+		webhook, err := store.GetWebhook(ctx, id)
+		if err != nil || webhook == nil {
+			return nil, err
+		}
+		return &webhook.ProjectId, nil
+	},
+	"backfillId": func(ctx context.Context, id uuid.UUID, store Store, _ *ChatStreams) (*uuid.UUID, error) {
+		backfill, err := store.GetBackfill(ctx, id)
+		if err != nil || backfill == nil {
+			return nil, err
+		}
+		return &backfill.ProjectId, nil
+	},
+}
+
+func resolveRunProject(ctx context.Context, runId uuid.UUID, store Store, _ *ChatStreams) (*uuid.UUID, error) {
+	return projectIdOf(getProjectForRun(ctx, runId, store))
+}
+
+func projectIdOf(project *Project, err error) (*uuid.UUID, error) {
+	if err != nil || project == nil {
+		return nil, err
+	}
+	return &project.Id, nil
+}
+
+// pathReachesProject reports whether every resource a call's path parameters name belongs to a
+// project, and whether they name any at all. Resources that don't exist don't belong to the project.
+func pathReachesProject(ctx context.Context, pathValue func(string) string, projectId uuid.UUID, store Store, streams *ChatStreams) (reaches bool, named bool, err error) {
+	for param, resolve := range pathProjectResolvers {
+		value := pathValue(param)
+		if value == "" {
+			continue
+		}
+		named = true
+
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return false, true, nil
+		}
+
+		resourceProject, err := resolve(ctx, id, store, streams)
+		if err != nil {
+			return false, true, fmt.Errorf("error getting project of %s %s: %w", param, id, err)
+		}
+		if resourceProject == nil || *resourceProject != projectId {
+			return false, true, nil
+		}
+	}
+
+	return true, named, nil
+}
+
+// checkProjectKey refuses a call of a route a project's key may not make. Resources of other projects
+// are answered as if they didn't exist, so keys can't probe for them.
+func checkProjectKey(ctx context.Context, pattern string, pathValue func(string) string, key *ApiKey, store Store, streams *ChatStreams) error {
+	reaches, named, err := pathReachesProject(ctx, pathValue, *key.ProjectId, store, streams)
+	if err != nil {
+		return &apiError{status: http.StatusInternalServerError, message: "error checking project of request", details: err.Error()}
+	}
+
+	if !named {
+		if slices.Contains(projectKeyRoutes, pattern) {
+			return nil
+		}
+		return &apiError{status: http.StatusForbidden, message: "route can't be called with a project's API key"}
+	}
+
+	if !reaches {
+		return &apiError{status: http.StatusNotFound, message: "Resource not found"}
+	}
+
+	return nil
+}
+
+// keyReachesProject reports whether a key may reach the resources of a project. Keys without a
+// project reach every project.
+func keyReachesProject(key *ApiKey, projectId uuid.UUID) bool {
+	return key == nil || key.ProjectId == nil || *key.ProjectId == projectId
+}
+
+// keyReachesApiKey reports whether a key may see and change another key, which project keys only
+// may for keys of their own project
+func keyReachesApiKey(key *ApiKey, other ApiKey) bool {
+	if key == nil || key.ProjectId == nil {
+		return true
+	}
+	return other.ProjectId != nil && *other.ProjectId == *key.ProjectId
+}
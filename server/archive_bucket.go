@@ -0,0 +1,308 @@
+package asteroid
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrArchiveObjectExists is returned when an archive object is written to a key that already has one
+var ErrArchiveObjectExists = errors.New("archive object already exists")
+
+const (
+	archiveBucketTimeout = 60 * time.Second
+	// maxArchiveObjectBytes bounds what's read back of an archive object
+	maxArchiveObjectBytes = 1 << 30
+)
+
+// ArchiveBucket is write-once storage for compliance archives. Objects are never replaced, and are kept
+// from being changed or deleted until their retention date.
+type ArchiveBucket interface {
+	PutObject(ctx context.Context, key string, data []byte, retainUntil time.Time) error
+	// GetObject returns nil if the bucket has no object at the key
+	GetObject(ctx context.Context, key string) ([]byte, error)
+	Location(key string) string
+}
+
+// NewArchiveBucketFromEnv returns the S3 bucket ARCHIVE_S3_BUCKET, or a directory ARCHIVE_DIR for
+// development, or nil if neither is set
+func NewArchiveBucketFromEnv() (ArchiveBucket, error) {
+	if bucket := os.Getenv("ARCHIVE_S3_BUCKET"); bucket != "" {
+		return newS3ArchiveBucket(bucket)
+	}
+
+	if dir := os.Getenv("ARCHIVE_DIR"); dir != "" {
+		if err := os.MkdirAll(dir, 0o750); err != nil {
+			return nil, fmt.Errorf("error creating archive directory: %w", err)
+		}
+		return &FilesystemArchiveBucket{dir: dir}, nil
+	}
+
+	return nil, nil
+}
+
+// S3ArchiveBucket writes archives to an S3 bucket with object lock enabled. Every object is written in
+// compliance mode, which not even the bucket's owner can lift before the retention date.
+type S3ArchiveBucket struct {
+	bucket          string
+	region          string
+	endpoint        *url.URL
+	pathStyle       bool
+	accessKeyId     string
+	secretAccessKey string
+	sessionToken    string
+	client          *http.Client
+}
+
+// newS3ArchiveBucket configures the bucket's region with ARCHIVE_S3_REGION and its credentials with
+// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN. ARCHIVE_S3_ENDPOINT points it at
+// S3-compatible storage, addressed in path style.
+func newS3ArchiveBucket(bucket string) (*S3ArchiveBucket, error) {
+	region := os.Getenv("ARCHIVE_S3_REGION")
+	if region == "" {
+		return nil, fmt.Errorf("ARCHIVE_S3_REGION must be set with ARCHIVE_S3_BUCKET")
+	}
+
+	accessKeyId := os.Getenv("AWS_ACCESS_KEY_ID")
+	secretAccessKey := os.Getenv("AWS_SECRET_ACCESS_KEY")
+	if accessKeyId == "" || secretAccessKey == "" {
+		return nil, fmt.Errorf("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set with ARCHIVE_S3_BUCKET")
+	}
+
+	s := &S3ArchiveBucket{
+		bucket:          bucket,
+		region:          region,
+		accessKeyId:     accessKeyId,
+		secretAccessKey: secretAccessKey,
+		sessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		client: &http.Client{
+			Timeout: archiveBucketTimeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+
+	if endpoint := os.Getenv("ARCHIVE_S3_ENDPOINT"); endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("ARCHIVE_S3_ENDPOINT must be an absolute http or https URL")
+		}
+		s.endpoint = u
+		s.pathStyle = true
+	} else {
+		s.endpoint = &url.URL{Scheme: "https", Host: fmt.Sprintf("%s.s3.%s.amazonaws.com", bucket, region)}
+	}
+
+	return s, nil
+}
+
+func (s *S3ArchiveBucket) objectUrl(key string) *url.URL {
+	u := *s.endpoint
+	path := "/" + key
+	if s.pathStyle {
+		path = "/" + s.bucket + path
+	}
+	u.Path = strings.TrimSuffix(s.endpoint.Path, "/") + path
+	return &u
+}
+
+func (s *S3ArchiveBucket) Location(key string) string {
+	return fmt.Sprintf("s3://%s/%s", s.bucket, key)
+}
+
+func (s *S3ArchiveBucket) PutObject(ctx context.Context, key string, data []byte, retainUntil time.Time) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectUrl(key).String(), bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	// Object lock needs the content's MD5, and If-None-Match keeps an existing object from being replaced
+	sum := md5.Sum(data)
+	request.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(sum[:]))
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("If-None-Match", "*")
+	request.Header.Set("X-Amz-Object-Lock-Mode", "COMPLIANCE")
+	request.Header.Set("X-Amz-Object-Lock-Retain-Until-Date", retainUntil.UTC().Format(time.RFC3339))
+	s.sign(request, data, time.Now())
+
+	resp, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("error calling S3: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusPreconditionFailed || resp.StatusCode == http.StatusConflict:
+		return ErrArchiveObjectExists
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return fmt.Errorf("S3 responded to writing %s with status %d: %s", key, resp.StatusCode, s3ErrorMessage(resp.Body))
+	}
+	return nil
+}
+
+func (s *S3ArchiveBucket) GetObject(ctx context.Context, key string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectUrl(key).String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	s.sign(request, nil, time.Now())
+
+	resp, err := s.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("error calling S3: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, nil
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return nil, fmt.Errorf("S3 responded to reading %s with status %d: %s", key, resp.StatusCode, s3ErrorMessage(resp.Body))
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxArchiveObjectBytes))
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", key, err)
+	}
+	return data, nil
+}
+
+func s3ErrorMessage(body io.Reader) string {
+	message, _ := io.ReadAll(io.LimitReader(body, 1024))
+	return strings.TrimSpace(string(message))
+}
+
+// sign adds an AWS Signature Version 4 to a request, covering its payload and every header it has
+func (s *S3ArchiveBucket) sign(request *http.Request, payload []byte, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	payloadSum := sha256.Sum256(payload)
+	payloadHash := hex.EncodeToString(payloadSum[:])
+
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.sessionToken != "" {
+		request.Header.Set("X-Amz-Security-Token", s.sessionToken)
+	}
+
+	headers := map[string]string{"host": request.URL.Host}
+	for name, values := range request.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		s3EscapePath(request.URL.Path),
+		request.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	canonicalSum := sha256.Sum256([]byte(canonicalRequest))
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalSum[:])
+
+	key := []byte("AWS4" + s.secretAccessKey)
+	for _, part := range []string{date, s.region, "s3", "aws4_request"} {
+		key = hmacSha256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSha256(key, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKeyId, scope, signedHeaders, signature))
+}
+
+func hmacSha256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// s3EscapePath escapes every byte of a path but unreserved characters and slashes, as S3 signs it
+func s3EscapePath(path string) string {
+	var escaped strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || strings.IndexByte("-_.~/", c) >= 0 {
+			escaped.WriteByte(c)
+		} else {
+			fmt.Fprintf(&escaped, "%%%02X", c)
+		}
+	}
+	return escaped.String()
+}
+
+// FilesystemArchiveBucket keeps archives in read-only files under a directory. Nothing stops the files
+// being changed by someone with access to the directory, so it's only meant for development.
+type FilesystemArchiveBucket struct {
+	dir string
+}
+
+func (s *FilesystemArchiveBucket) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
+
+func (s *FilesystemArchiveBucket) Location(key string) string {
+	return s.path(key)
+}
+
+func (s *FilesystemArchiveBucket) PutObject(_ context.Context, key string, data []byte, _ time.Time) error {
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("error creating archive directory: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o440)
+	if errors.Is(err, os.ErrExist) {
+		return ErrArchiveObjectExists
+	}
+	if err != nil {
+		return fmt.Errorf("error creating archive file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		return fmt.Errorf("error writing archive file: %w", err)
+	}
+	return file.Sync()
+}
+
+func (s *FilesystemArchiveBucket) GetObject(_ context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading archive file: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,448 @@
+package asteroid
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// archiveFormatVersion is the format_version of the manifests archives are written with
+	archiveFormatVersion = 1
+	archiveDayLayout     = "2006-01-02"
+	archiveManifestName  = "manifest.json"
+
+	archiveCheckInterval = time.Hour
+	// defaultArchiveRetention keeps archives for seven years, what most audit regimes ask for
+	defaultArchiveRetention = 7 * 365 * 24 * time.Hour
+)
+
+// archiveFile is one of the JSON lines files of an archive, with the ids of the records it holds
+type archiveFile struct {
+	name    string
+	ids     []uuid.UUID
+	records []interface{}
+}
+
+// archiveDayRecords are the records created on a UTC day
+type archiveDayRecords struct {
+	runs    []Run
+	results []SupervisionResult
+	events  []AuditEvent
+}
+
+func getArchiveDayRecords(ctx context.Context, day time.Time, store Store) (*archiveDayRecords, error) {
+	from, to := day, day.AddDate(0, 0, 1)
+
+	runs, err := store.GetRunsCreatedBetween(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("error getting runs: %w", err)
+	}
+
+	results, err := store.GetSupervisionResultsCreatedBetween(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("error getting supervision results: %w", err)
+	}
+
+	events, err := store.GetAuditEventsCreatedBetween(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("error getting audit events: %w", err)
+	}
+
+	return &archiveDayRecords{runs: runs, results: results, events: events}, nil
+}
+
+// files lays the records out in the files of an archive, in the order they're written
+func (d *archiveDayRecords) files() []archiveFile {
+	runs := archiveFile{name: "runs.jsonl"}
+	for _, run := range d.runs {
+		runs.ids = append(runs.ids, run.Id)
+		runs.records = append(runs.records, run)
+	}
+
+	decisions := archiveFile{name: "decisions.jsonl"}
+	for _, result := range d.results {
+		if result.Id != nil {
+			decisions.ids = append(decisions.ids, *result.Id)
+		}
+		decisions.records = append(decisions.records, result)
+	}
+
+	events := archiveFile{name: "audit_events.jsonl"}
+	for _, event := range d.events {
+		events.ids = append(events.ids, event.Id)
+		events.records = append(events.records, event)
+	}
+
+	return []archiveFile{runs, decisions, events}
+}
+
+func encodeJSONLines(records []interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// archivedIds reads the ids of the records in an archive file
+func archivedIds(data []byte) map[uuid.UUID]bool {
+	ids := make(map[uuid.UUID]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64<<10), maxArchiveObjectBytes)
+	for scanner.Scan() {
+		var record struct {
+			Id uuid.UUID `json:"id"`
+		}
+		if json.Unmarshal(scanner.Bytes(), &record) == nil {
+			ids[record.Id] = true
+		}
+	}
+	return ids
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func archiveKey(day, name string) string {
+	return path.Join("archives", day, name)
+}
+
+// parseArchiveDay parses a day in YYYY-MM-DD form as the start of that day in UTC
+func parseArchiveDay(day string) (time.Time, error) {
+	parsed, err := time.ParseInLocation(archiveDayLayout, day, time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("day must be in YYYY-MM-DD form")
+	}
+	return parsed, nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// ArchiveExporter writes the runs, decisions and audit events of every completed UTC day to a
+// write-once bucket
+type ArchiveExporter struct {
+	bucket    ArchiveBucket
+	retention time.Duration
+	store     Store
+}
+
+// NewArchiveExporterFromEnv archives to the bucket NewArchiveBucketFromEnv configures, keeping archives
+// for ARCHIVE_RETENTION_DAYS. It returns nil when no bucket is configured.
+func NewArchiveExporterFromEnv(store Store) (*ArchiveExporter, error) {
+	bucket, err := NewArchiveBucketFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if bucket == nil {
+		return nil, nil
+	}
+
+	retention := defaultArchiveRetention
+	if value := os.Getenv("ARCHIVE_RETENTION_DAYS"); value != "" {
+		days, err := strconv.Atoi(value)
+		if err != nil || days <= 0 {
+			return nil, fmt.Errorf("ARCHIVE_RETENTION_DAYS must be a positive number of days")
+		}
+		retention = time.Duration(days) * 24 * time.Hour
+	}
+
+	return &ArchiveExporter{bucket: bucket, retention: retention, store: store}, nil
+}
+
+func (e *ArchiveExporter) Start(ctx context.Context) {
+	ticker := time.NewTicker(archiveCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.exportCompletedDays(ctx); err != nil {
+			log.Printf("Error exporting archives: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// exportCompletedDays archives every completed day after the latest archive, starting with yesterday
+// when nothing was archived yet
+func (e *ArchiveExporter) exportCompletedDays(ctx context.Context) error {
+	archives, err := e.store.GetArchives(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting archives: %w", err)
+	}
+
+	today := startOfDay(time.Now())
+	day := today.AddDate(0, 0, -1)
+	if len(archives) > 0 {
+		latest, err := parseArchiveDay(archives[len(archives)-1].Day)
+		if err != nil {
+			return err
+		}
+		day = latest.AddDate(0, 0, 1)
+	}
+
+	for ; day.Before(today); day = day.AddDate(0, 0, 1) {
+		archive, err := e.export(ctx, day)
+		if err != nil {
+			return fmt.Errorf("error archiving %s: %w", day.Format(archiveDayLayout), err)
+		}
+		log.Printf("Archived %d records of %s to %s", archive.Records, archive.Day, archive.Location)
+	}
+	return nil
+}
+
+// export writes the archive of a day, with its manifest last so an archive without one is known to be
+// partial. Files left by an export that failed part way are kept if they're what would be written.
+func (e *ArchiveExporter) export(ctx context.Context, day time.Time) (*Archive, error) {
+	records, err := getArchiveDayRecords(ctx, day, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	name := day.Format(archiveDayLayout)
+	retainUntil := time.Now().Add(e.retention)
+	manifest := ArchiveManifest{
+		FormatVersion: archiveFormatVersion,
+		Day:           name,
+		GeneratedAt:   time.Now(),
+		Files:         make([]ArchiveManifestFile, 0),
+	}
+	if len(records.events) > 0 {
+		first, last := records.events[0], records.events[len(records.events)-1]
+		manifest.AuditFirstSequence = &first.Sequence
+		manifest.AuditPreviousHash = &first.PreviousHash
+		manifest.AuditLastSequence = &last.Sequence
+		manifest.AuditLastHash = &last.Hash
+	}
+
+	total := 0
+	for _, file := range records.files() {
+		data, err := encodeJSONLines(file.records)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %s: %w", file.name, err)
+		}
+		if err := e.putOnce(ctx, archiveKey(name, file.name), data, retainUntil); err != nil {
+			return nil, err
+		}
+
+		manifest.Files = append(manifest.Files, ArchiveManifestFile{
+			Name:    file.name,
+			Records: len(file.records),
+			Bytes:   int64(len(data)),
+			Sha256:  sha256Hex(data),
+		})
+		total += len(file.records)
+	}
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error encoding manifest: %w", err)
+	}
+	manifestKey := archiveKey(name, archiveManifestName)
+	if err := e.bucket.PutObject(ctx, manifestKey, manifestData, retainUntil); err != nil {
+		return nil, fmt.Errorf("error writing manifest: %w", err)
+	}
+
+	archive := Archive{
+		Day:            name,
+		Location:       e.bucket.Location(manifestKey),
+		ManifestSha256: sha256Hex(manifestData),
+		Records:        total,
+		RetainUntil:    retainUntil,
+		ExportedAt:     time.Now(),
+	}
+	if err := e.store.CreateArchive(ctx, archive); err != nil {
+		return nil, fmt.Errorf("error creating archive: %w", err)
+	}
+	return &archive, nil
+}
+
+// putOnce writes an archive file, accepting one that's already there with the same content
+func (e *ArchiveExporter) putOnce(ctx context.Context, key string, data []byte, retainUntil time.Time) error {
+	err := e.bucket.PutObject(ctx, key, data, retainUntil)
+	if !errors.Is(err, ErrArchiveObjectExists) {
+		if err != nil {
+			return fmt.Errorf("error writing %s: %w", key, err)
+		}
+		return nil
+	}
+
+	existing, err := e.bucket.GetObject(ctx, key)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", key, err)
+	}
+	if !bytes.Equal(existing, data) {
+		return fmt.Errorf("%s was already written with other content", key)
+	}
+	return nil
+}
+
+// verify reads an archive back and checks it against its manifest, and the manifest against the hash
+// recorded at export. Records of the day in the database that a file doesn't have are counted missing.
+func (e *ArchiveExporter) verify(ctx context.Context, archive Archive) (*ArchiveVerification, error) {
+	verification := ArchiveVerification{
+		Day:   archive.Day,
+		Files: make([]ArchiveFileVerification, 0),
+	}
+
+	manifestData, err := e.bucket.GetObject(ctx, archiveKey(archive.Day, archiveManifestName))
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest: %w", err)
+	}
+	verification.ManifestIntact = manifestData != nil && sha256Hex(manifestData) == archive.ManifestSha256
+
+	day, err := parseArchiveDay(archive.Day)
+	if err != nil {
+		return nil, err
+	}
+	records, err := getArchiveDayRecords(ctx, day, e.store)
+	if err != nil {
+		return nil, err
+	}
+	inDatabase := make(map[string][]uuid.UUID)
+	for _, file := range records.files() {
+		inDatabase[file.name] = file.ids
+	}
+
+	// A manifest that was altered is still checked against, so the files it describes are reported
+	var manifest ArchiveManifest
+	if manifestData != nil && json.Unmarshal(manifestData, &manifest) == nil {
+		for _, file := range manifest.Files {
+			data, err := e.bucket.GetObject(ctx, archiveKey(archive.Day, file.Name))
+			if err != nil {
+				return nil, fmt.Errorf("error reading %s: %w", file.Name, err)
+			}
+
+			checked := ArchiveFileVerification{Name: file.Name, Status: Intact}
+			switch {
+			case data == nil:
+				checked.Status = Missing
+			case sha256Hex(data) != file.Sha256:
+				checked.Status = Altered
+			}
+
+			archived := archivedIds(data)
+			for _, id := range inDatabase[file.Name] {
+				if !archived[id] {
+					checked.MissingRecords++
+				}
+			}
+			delete(inDatabase, file.Name)
+
+			verification.Files = append(verification.Files, checked)
+		}
+	}
+
+	// Files the manifest doesn't list are missing with every record the database has for them
+	for _, file := range records.files() {
+		if ids, ok := inDatabase[file.name]; ok {
+			verification.Files = append(verification.Files, ArchiveFileVerification{Name: file.name, Status: Missing, MissingRecords: len(ids)})
+		}
+	}
+
+	verification.Complete = verification.ManifestIntact
+	for _, file := range verification.Files {
+		verification.Complete = verification.Complete && file.Status == Intact && file.MissingRecords == 0
+	}
+	verification.VerifiedAt = time.Now()
+	return &verification, nil
+}
+
+func apiGetArchivesHandler(w http.ResponseWriter, r *http.Request, store Store) {
+	archives, err := store.GetArchives(r.Context())
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting archives", err.Error())
+		return
+	}
+
+	respondJSON(w, archives, http.StatusOK)
+}
+
+func apiExportArchiveHandler(w http.ResponseWriter, r *http.Request, day string, exporter *ArchiveExporter, store Store) {
+	ctx := r.Context()
+
+	if exporter == nil {
+		sendErrorResponse(w, http.StatusBadRequest, "archiving isn't configured", "set ARCHIVE_S3_BUCKET to export archives")
+		return
+	}
+
+	parsed, err := parseArchiveDay(day)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid day", err.Error())
+		return
+	}
+	if !parsed.Before(startOfDay(time.Now())) {
+		sendErrorResponse(w, http.StatusBadRequest, "day isn't over yet", "only completed UTC days can be archived")
+		return
+	}
+
+	existing, err := store.GetArchive(ctx, day)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting archive", err.Error())
+		return
+	}
+	if existing != nil {
+		sendErrorResponse(w, http.StatusConflict, "day was already archived", existing.Location)
+		return
+	}
+
+	archive, err := exporter.export(ctx, parsed)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadGateway, "error exporting archive", err.Error())
+		return
+	}
+
+	respondJSON(w, archive, http.StatusCreated)
+}
+
+func apiVerifyArchiveHandler(w http.ResponseWriter, r *http.Request, day string, exporter *ArchiveExporter, store Store) {
+	ctx := r.Context()
+
+	if exporter == nil {
+		sendErrorResponse(w, http.StatusBadRequest, "archiving isn't configured", "set ARCHIVE_S3_BUCKET to verify archives")
+		return
+	}
+
+	archive, err := store.GetArchive(ctx, day)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting archive", err.Error())
+		return
+	}
+	if archive == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Archive not found", "")
+		return
+	}
+
+	verification, err := exporter.verify(ctx, *archive)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadGateway, "error verifying archive", err.Error())
+		return
+	}
+
+	respondJSON(w, verification, http.StatusOK)
+}
@@ -0,0 +1,322 @@
+package asteroid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// maxDiffCandidates bounds how many earlier calls of a tool are checked for an approval, as each
+// takes a few queries to decide
+const maxDiffCandidates = 50
+
+// getPreviousApproval returns the latest call of a tool call's tool in its run that was made before
+// it and approved, or nil if there's none
+func getPreviousApproval(ctx context.Context, toolCall AsteroidToolCall, store Store) (*AsteroidToolCall, error) {
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool: %w", err)
+	}
+	if tool == nil {
+		return nil, nil
+	}
+
+	toolCalls, err := store.GetRunToolCalls(ctx, tool.RunId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting run tool calls: %w", err)
+	}
+
+	position := slices.IndexFunc(toolCalls, func(call AsteroidToolCall) bool { return call.Id == toolCall.Id })
+	if position < 0 {
+		return nil, nil
+	}
+
+	checked := 0
+	for i := position - 1; i >= 0 && checked < maxDiffCandidates; i-- {
+		candidate := toolCalls[i]
+		if candidate.ToolId != toolCall.ToolId {
+			continue
+		}
+		checked++
+
+		decision, err := getToolCallDecision(ctx, candidate.Id, store)
+		if err != nil {
+			return nil, err
+		}
+		if decision != nil && *decision == Approve {
+			return &candidate, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// diffArguments returns the fields that differ between the arguments of two tool calls. Arguments
+// that can't be parsed are compared as a whole.
+func diffArguments(previous, current *string) []ArgumentChange {
+	changes := make([]ArgumentChange, 0)
+
+	if previous == nil || current == nil {
+		switch {
+		case previous != nil:
+			changes = append(changes, ArgumentChange{Path: "", Op: Removed, Previous: previous})
+		case current != nil:
+			changes = append(changes, ArgumentChange{Path: "", Op: Added, Current: current})
+		}
+		return changes
+	}
+
+	previousValue, currentValue := decodeArguments(*previous), decodeArguments(*current)
+	if previousValue == nil || currentValue == nil {
+		if *previous != *current {
+			changes = append(changes, ArgumentChange{Path: "", Op: Changed, Previous: previous, Current: current, Segments: wordSegments(*previous, *current)})
+		}
+		return changes
+	}
+
+	return diffValues("", previousValue, currentValue, changes)
+}
+
+// diffValues appends the changes between two decoded JSON values at a path. Objects are compared by
+// field and arrays by position, anything else as a whole.
+func diffValues(path string, previous, current interface{}, changes []ArgumentChange) []ArgumentChange {
+	switch previous := previous.(type) {
+	case map[string]interface{}:
+		if current, ok := current.(map[string]interface{}); ok {
+			keys := make(map[string]bool, len(previous)+len(current))
+			for key := range previous {
+				keys[key] = true
+			}
+			for key := range current {
+				keys[key] = true
+			}
+
+			for _, key := range slices.Sorted(maps.Keys(keys)) {
+				fieldPath := path + "/" + escapePointer(key)
+				previousField, inPrevious := previous[key]
+				currentField, inCurrent := current[key]
+				switch {
+				case !inPrevious:
+					changes = append(changes, ArgumentChange{Path: fieldPath, Op: Added, Current: encodeValue(currentField)})
+				case !inCurrent:
+					changes = append(changes, ArgumentChange{Path: fieldPath, Op: Removed, Previous: encodeValue(previousField)})
+				default:
+					changes = diffValues(fieldPath, previousField, currentField, changes)
+				}
+			}
+			return changes
+		}
+	case []interface{}:
+		if current, ok := current.([]interface{}); ok {
+			for i := 0; i < max(len(previous), len(current)); i++ {
+				itemPath := path + "/" + strconv.Itoa(i)
+				switch {
+				case i >= len(previous):
+					changes = append(changes, ArgumentChange{Path: itemPath, Op: Added, Current: encodeValue(current[i])})
+				case i >= len(current):
+					changes = append(changes, ArgumentChange{Path: itemPath, Op: Removed, Previous: encodeValue(previous[i])})
+				default:
+					changes = diffValues(itemPath, previous[i], current[i], changes)
+				}
+			}
+			return changes
+		}
+	}
+
+	if reflect.DeepEqual(previous, current) {
+		return changes
+	}
+
+	change := ArgumentChange{Path: path, Op: Changed, Previous: encodeValue(previous), Current: encodeValue(current)}
+	if previousText, ok := previous.(string); ok {
+		if currentText, ok := current.(string); ok {
+			change.Segments = wordSegments(previousText, currentText)
+		}
+	}
+	return append(changes, change)
+}
+
+func wordSegments(previous, current string) *[]DiffSegment {
+	segments := DiffWords(previous, current)
+	return &segments
+}
+
+// escapePointer escapes a field name for a JSON pointer, see RFC 6901
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+func encodeValue(value interface{}) *string {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return nil
+	}
+	encoded := strings.TrimSuffix(buffer.String(), "\n")
+	return &encoded
+}
+
+// getToolBaseline returns the argument baseline of a tool call's tool in its project, or nil if it
+// has none
+func getToolBaseline(ctx context.Context, toolCall AsteroidToolCall, store Store) (*ArgumentBaseline, error) {
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool: %w", err)
+	}
+	if tool == nil {
+		return nil, nil
+	}
+
+	project, err := getProjectForRun(ctx, tool.RunId, store)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, nil
+	}
+
+	baseline, err := store.GetArgumentBaseline(ctx, project.Id, tool.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting argument baseline: %w", err)
+	}
+	return baseline, nil
+}
+
+// getArgumentDiff compares a tool call's arguments with those of the previous approved call of its
+// tool, or else with the tool's argument baseline
+func getArgumentDiff(ctx context.Context, toolCall AsteroidToolCall, store Store) (*ArgumentDiff, error) {
+	diff := ArgumentDiff{ToolCallId: toolCall.Id, Changes: make([]ArgumentChange, 0)}
+
+	previous, err := getPreviousApproval(ctx, toolCall, store)
+	if err != nil {
+		return nil, fmt.Errorf("error finding previous approval: %w", err)
+	}
+	if previous != nil {
+		basis := PreviousApproval
+		diff.Basis = &basis
+		diff.PreviousToolCallId = &previous.Id
+		diff.Changes = diffArguments(storedToolCallArguments(*previous), storedToolCallArguments(toolCall))
+		return &diff, nil
+	}
+
+	baseline, err := getToolBaseline(ctx, toolCall, store)
+	if err != nil {
+		return nil, err
+	}
+	if baseline != nil {
+		basis := SafeBaseline
+		diff.Basis = &basis
+		diff.Changes = diffArguments(&baseline.Arguments, storedToolCallArguments(toolCall))
+	}
+
+	return &diff, nil
+}
+
+// validateArgumentBaselines checks there's at most one baseline per tool and that each baseline's
+// arguments are valid JSON
+func validateArgumentBaselines(baselines []ArgumentBaseline) error {
+	tools := make(map[string]bool, len(baselines))
+	for _, baseline := range baselines {
+		if strings.TrimSpace(baseline.ToolName) == "" {
+			return fmt.Errorf("tool_name is required")
+		}
+		if tools[baseline.ToolName] {
+			return fmt.Errorf("duplicate argument baseline for tool %s", baseline.ToolName)
+		}
+		tools[baseline.ToolName] = true
+
+		if !json.Valid([]byte(baseline.Arguments)) {
+			return fmt.Errorf("arguments of the baseline for tool %s are not valid JSON", baseline.ToolName)
+		}
+	}
+	return nil
+}
+
+func apiGetToolCallArgumentDiffHandler(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
+		return
+	}
+
+	if toolCall == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call not found", "")
+		return
+	}
+
+	diff, err := getArgumentDiff(ctx, *toolCall, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error diffing arguments", err.Error())
+		return
+	}
+
+	respondJSON(w, diff, http.StatusOK)
+}
+
+func apiGetProjectArgumentBaselinesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	baselines, err := store.GetProjectArgumentBaselines(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting argument baselines", err.Error())
+		return
+	}
+
+	respondJSON(w, baselines, http.StatusOK)
+}
+
+func apiSetProjectArgumentBaselinesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var baselines []ArgumentBaseline
+	if err := json.NewDecoder(r.Body).Decode(&baselines); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := validateArgumentBaselines(baselines); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid argument baseline", err.Error())
+		return
+	}
+
+	if err := store.SetProjectArgumentBaselines(ctx, projectId, baselines); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting argument baselines", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
@@ -0,0 +1,499 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxArgumentFormDepth bounds how deeply nested fields are described, which also ends schemas
+	// that refer to themselves
+	maxArgumentFormDepth = 8
+	// maxArgumentFormFields bounds how many fields a form has, as arrays can be long
+	maxArgumentFormFields = 200
+	// maxSchemaRefs bounds how many $refs are followed to resolve a schema
+	maxSchemaRefs = 16
+)
+
+// validateToolParameters checks a tool's parameters look like a JSON Schema of an object, so its
+// calls' arguments can be described by it
+func validateToolParameters(parameters map[string]interface{}) error {
+	switch schemaType := parameters["type"].(type) {
+	case nil:
+	case string:
+		if schemaType != "object" {
+			return fmt.Errorf("type must be object, the arguments of a tool call are an object")
+		}
+	default:
+		return fmt.Errorf("type must be a string")
+	}
+
+	if properties, ok := parameters["properties"]; ok {
+		if _, ok := properties.(map[string]interface{}); !ok {
+			return fmt.Errorf("properties must be an object")
+		}
+	}
+	if required, ok := parameters["required"]; ok {
+		names, ok := required.([]interface{})
+		if !ok {
+			return fmt.Errorf("required must be an array of field names")
+		}
+		for _, name := range names {
+			if _, ok := name.(string); !ok {
+				return fmt.Errorf("required must be an array of field names")
+			}
+		}
+	}
+
+	return nil
+}
+
+// argumentForm builds the fields of a tool call's arguments from its tool's JSON Schema
+type argumentForm struct {
+	root map[string]interface{}
+	form ArgumentForm
+}
+
+// buildArgumentForm describes a tool call's arguments by its tool's JSON Schema. Tools without one
+// get a form without fields.
+func buildArgumentForm(toolCall AsteroidToolCall, tool *Tool) ArgumentForm {
+	builder := argumentForm{form: ArgumentForm{ToolCallId: toolCall.Id, Fields: make([]ArgumentField, 0), UnknownPaths: make([]string, 0)}}
+	if tool == nil || tool.Parameters == nil {
+		return builder.form
+	}
+	builder.root = *tool.Parameters
+
+	var arguments interface{}
+	if toolCall.Arguments != nil && strings.TrimSpace(*toolCall.Arguments) != "" {
+		if !json.Valid([]byte(*toolCall.Arguments)) {
+			builder.fail("the arguments aren't valid JSON")
+		} else {
+			arguments = decodeArguments(*toolCall.Arguments)
+		}
+	}
+
+	object, ok := arguments.(map[string]interface{})
+	if arguments != nil && !ok {
+		builder.fail("the arguments aren't a JSON object")
+	}
+	builder.addObject(builder.resolve(builder.root), object, "", 0)
+
+	return builder.form
+}
+
+func (b *argumentForm) fail(reason string) {
+	b.form.Error = &reason
+}
+
+// resolve follows a schema's $refs within the tool's schema, and picks the first branch of an anyOf
+// or oneOf that isn't null, as that's how optional fields are usually written
+func (b *argumentForm) resolve(schema interface{}) map[string]interface{} {
+	resolved, _ := schema.(map[string]interface{})
+	for range maxSchemaRefs {
+		if ref, ok := resolved["$ref"].(string); ok {
+			resolved, _ = schemaAtPointer(b.root, ref).(map[string]interface{})
+			continue
+		}
+
+		if _, typed := resolved["type"]; !typed {
+			for _, keyword := range []string{"anyOf", "oneOf"} {
+				branches := asList(resolved[keyword])
+				nullable := slices.ContainsFunc(branches, func(branch interface{}) bool {
+					schema, ok := branch.(map[string]interface{})
+					return ok && schema["type"] == "null"
+				})
+				for _, branch := range branches {
+					if branch, ok := branch.(map[string]interface{}); ok && branch["type"] != "null" {
+						merged := make(map[string]interface{}, len(resolved)+len(branch))
+						for key, value := range resolved {
+							if key != "anyOf" && key != "oneOf" {
+								merged[key] = value
+							}
+						}
+						for key, value := range branch {
+							merged[key] = value
+						}
+						if branchType, ok := merged["type"].(string); ok && nullable {
+							merged["type"] = []interface{}{branchType, "null"}
+						}
+						resolved = merged
+						break
+					}
+				}
+			}
+			if _, ref := resolved["$ref"]; ref {
+				continue
+			}
+		}
+		break
+	}
+	return resolved
+}
+
+// schemaAtPointer returns the part of a schema a local $ref like #/$defs/Address points to
+func schemaAtPointer(root map[string]interface{}, ref string) interface{} {
+	pointer, ok := strings.CutPrefix(ref, "#")
+	if !ok {
+		return nil
+	}
+
+	var current interface{} = root
+	for _, segment := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = object[segment]
+	}
+	return current
+}
+
+func asList(value interface{}) []interface{} {
+	list, _ := value.([]interface{})
+	return list
+}
+
+// addObject adds the fields of an object schema, and the fields nested in them
+func (b *argumentForm) addObject(schema map[string]interface{}, object map[string]interface{}, path string, depth int) {
+	if depth >= maxArgumentFormDepth {
+		return
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	required := make(map[string]bool)
+	for _, name := range asList(schema["required"]) {
+		if name, ok := name.(string); ok {
+			required[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		value, present := object[name]
+		b.addField(b.resolve(properties[name]), humanizeFieldName(name), path+"/"+escapePointer(name), required[name], present, value, depth)
+	}
+
+	// Fields the schema doesn't name are described by additionalProperties, if it's a schema
+	additional, describesOthers := schema["additionalProperties"].(map[string]interface{})
+	others := make([]string, 0)
+	for name := range object {
+		if _, named := properties[name]; !named {
+			others = append(others, name)
+		}
+	}
+	slices.Sort(others)
+
+	for _, name := range others {
+		fieldPath := path + "/" + escapePointer(name)
+		if describesOthers {
+			b.addField(b.resolve(additional), humanizeFieldName(name), fieldPath, false, true, object[name], depth)
+		} else {
+			b.form.UnknownPaths = append(b.form.UnknownPaths, fieldPath)
+		}
+	}
+}
+
+// addField adds a field and, for objects and arrays, the fields nested in it
+func (b *argumentForm) addField(schema map[string]interface{}, name string, path string, required bool, present bool, value interface{}, depth int) {
+	if len(b.form.Fields) >= maxArgumentFormFields {
+		return
+	}
+
+	schemaType, nullable := schemaTypeOf(schema)
+	field := ArgumentField{
+		Path:     path,
+		Label:    name,
+		Type:     schemaType,
+		Required: required,
+		Depth:    depth,
+	}
+	if title, ok := schema["title"].(string); ok && title != "" {
+		field.Label = title
+	}
+	if format, ok := schema["format"].(string); ok && format != "" {
+		field.Format = &format
+	}
+	if description, ok := schema["description"].(string); ok && description != "" {
+		field.Description = &description
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		field.Enum = &enum
+	}
+
+	if present {
+		field.Value = encodeValue(value)
+		if problem := valueProblem(schemaType, nullable, field.Enum, value); problem != "" {
+			field.Problem = &problem
+		}
+	} else if required {
+		problem := "required but left out"
+		field.Problem = &problem
+	}
+
+	b.form.Fields = append(b.form.Fields, field)
+
+	switch schemaType {
+	case "object":
+		object, _ := value.(map[string]interface{})
+		b.addObject(schema, object, path, depth+1)
+	case "array":
+		items := b.resolve(schema["items"])
+		list, _ := value.([]interface{})
+		if items == nil || depth+1 >= maxArgumentFormDepth {
+			return
+		}
+		for i, item := range list {
+			b.addField(items, fmt.Sprintf("%s %d", name, i+1), path+"/"+strconv.Itoa(i), false, true, item, depth+1)
+		}
+	}
+}
+
+// schemaTypeOf returns a schema's type, and whether it allows null too. Schemas without a type are
+// objects if they have properties, arrays if they have items, and otherwise of their enum's type.
+func schemaTypeOf(schema map[string]interface{}) (string, bool) {
+	switch schemaType := schema["type"].(type) {
+	case string:
+		return schemaType, schemaType == "null"
+	case []interface{}:
+		nullable, first := false, ""
+		for _, option := range schemaType {
+			if option == "null" {
+				nullable = true
+			} else if option, ok := option.(string); ok && first == "" {
+				first = option
+			}
+		}
+		return first, nullable
+	}
+
+	if _, ok := schema["properties"]; ok {
+		return "object", false
+	}
+	if _, ok := schema["items"]; ok {
+		return "array", false
+	}
+	if enum := asList(schema["enum"]); len(enum) > 0 {
+		return jsonTypeOf(enum[0]), false
+	}
+	return "", false
+}
+
+// valueProblem says how a value doesn't fit a field's type and enum, or returns an empty string if
+// it fits
+func valueProblem(schemaType string, nullable bool, enum *[]interface{}, value interface{}) string {
+	actual := jsonTypeOf(value)
+	switch {
+	case value == nil && nullable:
+	case schemaType == "" || schemaType == actual:
+	case schemaType == "number" && actual == "integer":
+	default:
+		return fmt.Sprintf("expected %s but got %s", schemaType, actual)
+	}
+
+	if enum != nil {
+		encoded := encodeValue(value)
+		for _, allowed := range *enum {
+			if allowedEncoded := encodeValue(allowed); encoded != nil && allowedEncoded != nil && *encoded == *allowedEncoded {
+				return ""
+			}
+		}
+		return "not one of the allowed values"
+	}
+
+	return ""
+}
+
+// jsonTypeOf returns the JSON Schema type of a decoded value, telling whole numbers apart as integers
+func jsonTypeOf(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if number, err := value.Float64(); err == nil && number == math.Trunc(number) {
+			return "integer"
+		}
+		return "number"
+	case float64:
+		if value == math.Trunc(value) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return "unknown"
+	}
+}
+
+// humanizeFieldName makes a field name like to_email or toEmail readable as To email
+func humanizeFieldName(name string) string {
+	var words []string
+	var word []rune
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == ' ' || r == '.':
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = nil
+			}
+			continue
+		case unicode.IsUpper(r) && len(word) > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))):
+			words = append(words, string(word))
+			word = nil
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	if len(words) == 0 {
+		return name
+	}
+
+	for i, word := range words {
+		// Acronyms like ID stay as they are
+		if strings.ToUpper(word) != word {
+			words[i] = strings.ToLower(word)
+		}
+	}
+	first := []rune(words[0])
+	first[0] = unicode.ToUpper(first[0])
+	words[0] = string(first)
+
+	return strings.Join(words, " ")
+}
+
+func apiGetToolCallArgumentFormHandler(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
+		return
+	}
+
+	if toolCall == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call not found", "")
+		return
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool", err.Error())
+		return
+	}
+
+	// The form describes the call's arguments rather than the record the store keeps of it
+	call := *toolCall
+	call.Arguments = storedToolCallArguments(*toolCall)
+	respondJSON(w, buildArgumentForm(call, tool), http.StatusOK)
+}
+
+// validateModifiedArguments checks the arguments a supervisor wants a tool call executed with
+// instead of its own. They must be a JSON object and, if the tool has a JSON Schema, fit it without
+// adding fields the schema doesn't describe that the original arguments didn't have already.
+func validateModifiedArguments(arguments string, toolCall AsteroidToolCall, tool *Tool) []string {
+	if object, ok := decodeArguments(arguments).(map[string]interface{}); !ok || object == nil {
+		return []string{"the modified arguments aren't a JSON object"}
+	}
+
+	original := buildArgumentForm(toolCall, tool)
+	toolCall.Arguments = &arguments
+	form := buildArgumentForm(toolCall, tool)
+
+	problems := make([]string, 0)
+	if form.Error != nil {
+		problems = append(problems, *form.Error)
+	}
+	for _, field := range form.Fields {
+		if field.Problem != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", field.Path, *field.Problem))
+		}
+	}
+	for _, path := range form.UnknownPaths {
+		if !slices.Contains(original.UnknownPaths, path) {
+			problems = append(problems, fmt.Sprintf("%s: the schema doesn't describe this field", path))
+		}
+	}
+	return problems
+}
+
+// ErrInvalidModification is returned for modify decisions whose arguments the tool call can't take
+var ErrInvalidModification = errors.New("invalid modification")
+
+// checkModification checks the arguments of a modify decision against its tool call, the one of the
+// supervision request unless the decision picks another, and records the arguments the tool call had
+// in place of any the decider sent. Other decisions keep neither.
+func checkModification(ctx context.Context, supervisionRequestId uuid.UUID, result *SupervisionResult, store Store) error {
+	if result.Decision != Modify {
+		result.ModifiedArguments = nil
+		result.OriginalArguments = nil
+		return nil
+	}
+
+	if result.ModifiedArguments == nil {
+		return fmt.Errorf("%w: modified arguments are required to modify a tool call", ErrInvalidModification)
+	}
+
+	toolCallId := result.ToolcallId
+	if toolCallId == nil {
+		id, err := getToolCallForSupervisionRequest(ctx, supervisionRequestId, store)
+		if err != nil {
+			return err
+		}
+		if id == nil {
+			return fmt.Errorf("%w: supervision request %s has no tool call", ErrInvalidModification, supervisionRequestId)
+		}
+		toolCallId = id
+	}
+
+	toolCall, err := store.GetToolCall(ctx, *toolCallId)
+	if err != nil {
+		return fmt.Errorf("error getting tool call: %w", err)
+	}
+	if toolCall == nil {
+		return fmt.Errorf("%w: tool call %s not found", ErrInvalidModification, *toolCallId)
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return fmt.Errorf("error getting tool: %w", err)
+	}
+
+	// The store keeps a record of the call, the modification is checked against the arguments in it
+	original := *toolCall
+	original.Arguments = storedToolCallArguments(*toolCall)
+	if problems := validateModifiedArguments(*result.ModifiedArguments, original, tool); len(problems) > 0 {
+		return fmt.Errorf("%w: the modified arguments don't fit the tool's schema: %s", ErrInvalidModification, strings.Join(problems, "; "))
+	}
+
+	// Both versions are kept, so reviewers can see what the supervisor changed
+	result.OriginalArguments = original.Arguments
+	return nil
+}
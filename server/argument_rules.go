@@ -0,0 +1,385 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// validateArgumentRules checks rule names are unique, every condition can be evaluated and the chains
+// rules are scoped to exist. Rules only approve or reject, anything else needs a supervisor.
+func validateArgumentRules(ctx context.Context, rules []ArgumentRule, store Store) error {
+	names := make(map[string]bool, len(rules))
+	for i, rule := range rules {
+		if rule.Name == "" {
+			return fmt.Errorf("argument rule %d needs a name", i)
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("duplicate argument rule %s", rule.Name)
+		}
+		names[rule.Name] = true
+
+		if rule.ToolName == "" {
+			return fmt.Errorf("argument rule %s needs a tool_name", rule.Name)
+		}
+		if rule.Decision != Approve && rule.Decision != Reject {
+			return fmt.Errorf("argument rule %s can only approve or reject, not %s", rule.Name, rule.Decision)
+		}
+		if len(rule.Conditions) == 0 {
+			return fmt.Errorf("argument rule %s needs a condition", rule.Name)
+		}
+		for j, condition := range rule.Conditions {
+			if err := validateArgumentCondition(condition); err != nil {
+				return fmt.Errorf("condition %d of argument rule %s: %w", j, rule.Name, err)
+			}
+		}
+
+		if rule.ChainId != nil {
+			chain, err := store.GetSupervisorChain(ctx, *rule.ChainId)
+			if err != nil {
+				return fmt.Errorf("error getting chain %s: %w", *rule.ChainId, err)
+			}
+			if chain == nil {
+				return fmt.Errorf("argument rule %s is scoped to chain %s, which doesn't exist", rule.Name, *rule.ChainId)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateArgumentCondition(condition ArgumentCondition) error {
+	if condition.Path != "" && !strings.HasPrefix(condition.Path, "/") {
+		return fmt.Errorf("path %s must be empty or start with /", condition.Path)
+	}
+
+	switch condition.Operator {
+	case Exists:
+		return nil
+	case OneOf:
+		if condition.Values == nil || len(*condition.Values) == 0 {
+			return fmt.Errorf("%s needs values", condition.Operator)
+		}
+		return nil
+	case Equals, NotEquals, StartsWith, EndsWith, Contains, Matches, LessThan, LessOrEqual, GreaterThan, GreaterOrEqual:
+	default:
+		return fmt.Errorf("unknown operator %s", condition.Operator)
+	}
+
+	if condition.Value == nil {
+		return fmt.Errorf("%s needs a value", condition.Operator)
+	}
+
+	switch condition.Operator {
+	case Matches:
+		if _, err := regexp.Compile(*condition.Value); err != nil {
+			return err
+		}
+	case LessThan, LessOrEqual, GreaterThan, GreaterOrEqual:
+		if _, err := strconv.ParseFloat(*condition.Value, 64); err != nil {
+			return fmt.Errorf("%s needs a number, got %s", condition.Operator, *condition.Value)
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON takes a condition's values as numbers as well as strings, keeping a number as its
+// text, so rules comparing numbers can give them as they'd be written in arguments
+func (c *ArgumentCondition) UnmarshalJSON(data []byte) error {
+	type argumentCondition ArgumentCondition
+	var raw struct {
+		argumentCondition
+		Value  *json.RawMessage   `json:"value,omitempty"`
+		Values *[]json.RawMessage `json:"values,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	condition := ArgumentCondition(raw.argumentCondition)
+	if raw.Value != nil {
+		value, err := conditionValue(*raw.Value)
+		if err != nil {
+			return fmt.Errorf("value: %w", err)
+		}
+		condition.Value = &value
+	}
+	if raw.Values != nil {
+		values := make([]string, 0, len(*raw.Values))
+		for i, item := range *raw.Values {
+			value, err := conditionValue(item)
+			if err != nil {
+				return fmt.Errorf("values %d: %w", i, err)
+			}
+			values = append(values, value)
+		}
+		condition.Values = &values
+	}
+
+	*c = condition
+	return nil
+}
+
+func conditionValue(raw json.RawMessage) (string, error) {
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text, nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(raw, &number); err != nil {
+		return "", fmt.Errorf("must be a string or a number, got %s", raw)
+	}
+	return number.String(), nil
+}
+
+// resolvePointer returns the value a JSON pointer refers to in decoded arguments, see RFC 6901
+func resolvePointer(value interface{}, pointer string) (interface{}, bool) {
+	if pointer == "" {
+		return value, true
+	}
+
+	for _, token := range strings.Split(pointer, "/")[1:] {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch v := value.(type) {
+		case map[string]interface{}:
+			field, ok := v[token]
+			if !ok {
+				return nil, false
+			}
+			value = field
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, false
+			}
+			value = v[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// equalsConditionValue compares a field with the value of a condition. Strings are compared as they
+// are, numbers by their value and anything else by its JSON.
+func equalsConditionValue(field interface{}, value string) bool {
+	switch field := field.(type) {
+	case string:
+		return field == value
+	case json.Number:
+		a, errA := field.Float64()
+		b, errB := strconv.ParseFloat(value, 64)
+		return errA == nil && errB == nil && a == b
+	default:
+		encoded, err := json.Marshal(field)
+		return err == nil && string(encoded) == value
+	}
+}
+
+// matchesCondition reports whether decoded arguments meet a condition
+func matchesCondition(condition ArgumentCondition, arguments interface{}) bool {
+	field, ok := resolvePointer(arguments, condition.Path)
+	if !ok {
+		return false
+	}
+
+	switch condition.Operator {
+	case Exists:
+		return true
+	case OneOf:
+		return slices.ContainsFunc(*condition.Values, func(value string) bool { return equalsConditionValue(field, value) })
+	}
+
+	value := *condition.Value
+	text, isText := field.(string)
+
+	switch condition.Operator {
+	case Equals:
+		return equalsConditionValue(field, value)
+	case NotEquals:
+		return !equalsConditionValue(field, value)
+	case StartsWith:
+		return isText && strings.HasPrefix(text, value)
+	case EndsWith:
+		return isText && strings.HasSuffix(text, value)
+	case Contains:
+		if items, ok := field.([]interface{}); ok {
+			return slices.ContainsFunc(items, func(item interface{}) bool { return equalsConditionValue(item, value) })
+		}
+		return isText && strings.Contains(text, value)
+	case Matches:
+		re, err := regexp.Compile(value)
+		return err == nil && isText && re.MatchString(text)
+	case LessThan, LessOrEqual, GreaterThan, GreaterOrEqual:
+		number, ok := field.(json.Number)
+		if !ok {
+			return false
+		}
+		a, errA := number.Float64()
+		b, errB := strconv.ParseFloat(value, 64)
+		if errA != nil || errB != nil {
+			return false
+		}
+		switch condition.Operator {
+		case LessThan:
+			return a < b
+		case LessOrEqual:
+			return a <= b
+		case GreaterThan:
+			return a > b
+		default:
+			return a >= b
+		}
+	default:
+		return false
+	}
+}
+
+// matchArgumentRule returns the first rule that decides a call of a tool for a chain, or nil if none
+// does. Arguments that aren't valid JSON don't match any rule.
+func matchArgumentRule(rules []ArgumentRule, toolName string, chainId uuid.UUID, arguments *string) *ArgumentRule {
+	if arguments == nil {
+		return nil
+	}
+	decoded := decodeArguments(*arguments)
+	if decoded == nil {
+		return nil
+	}
+
+	for i, rule := range rules {
+		if rule.ToolName != toolName && rule.ToolName != WildcardToolName {
+			continue
+		}
+		if rule.ChainId != nil && *rule.ChainId != chainId {
+			continue
+		}
+
+		matched := true
+		for _, condition := range rule.Conditions {
+			if !matchesCondition(condition, decoded) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return &rules[i]
+		}
+	}
+
+	return nil
+}
+
+// argumentRuleExplanation records the rule that decided a tool call, so reviewers of the chain's
+// history can tell no supervisor did
+func argumentRuleExplanation(rule ArgumentRule) ResultExplanation {
+	rationale := fmt.Sprintf("%s by argument rule %s, the arguments met its %d conditions", rule.Decision, rule.Name, len(rule.Conditions))
+	return ResultExplanation{ArgumentRule: &rule.Name, MatchedRuleIds: &[]string{rule.Name}, Rationale: &rationale}
+}
+
+// decideByArgumentRule resolves a new supervision request with the first of its project's argument
+// rules matching the tool call, and reports whether one did
+func decideByArgumentRule(ctx context.Context, supervisionRequestId uuid.UUID, toolCall AsteroidToolCall, tool *Tool, chainId uuid.UUID, project *Project, store Store) (bool, error) {
+	if tool == nil || project == nil {
+		return false, nil
+	}
+
+	rules, err := store.GetProjectArgumentRules(ctx, project.Id)
+	if err != nil {
+		return false, fmt.Errorf("error getting argument rules: %w", err)
+	}
+
+	rule := matchArgumentRule(rules, tool.Name, chainId, storedToolCallArguments(toolCall))
+	if rule == nil {
+		return false, nil
+	}
+
+	explanation := argumentRuleExplanation(*rule)
+	result := SupervisionResult{
+		CreatedAt:            time.Now(),
+		Decision:             rule.Decision,
+		Reasoning:            *explanation.Rationale,
+		Explanation:          &explanation,
+		SupervisionRequestId: supervisionRequestId,
+	}
+	if rule.Decision == Approve {
+		result.ToolcallId = &toolCall.Id
+	}
+
+	_, winner, err := resolveSupervisionRequest(ctx, supervisionRequestId, result, SystemActor, store)
+	if err != nil {
+		return false, err
+	}
+	if winner != nil {
+		log.Printf("Supervision request %s was resolved before argument rule %s decided it", supervisionRequestId, rule.Name)
+	}
+
+	return true, nil
+}
+
+func apiGetProjectArgumentRulesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	rules, err := store.GetProjectArgumentRules(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting argument rules", err.Error())
+		return
+	}
+
+	respondJSON(w, rules, http.StatusOK)
+}
+
+func apiSetProjectArgumentRulesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var rules []ArgumentRule
+	if err := json.NewDecoder(r.Body).Decode(&rules); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := validateArgumentRules(ctx, rules, store); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid argument rule", err.Error())
+		return
+	}
+
+	if err := store.SetProjectArgumentRules(ctx, projectId, rules); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting argument rules", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
@@ -0,0 +1,89 @@
+package asteroid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// storedToolCall returns a tool call the way the store reads it back, with the record it stored as
+// its arguments
+func storedToolCall(t *testing.T, name string, arguments string) AsteroidToolCall {
+	t.Helper()
+	callId := "call_1"
+	toolCall := AsteroidToolCall{Id: uuid.New(), CallId: &callId, ToolId: uuid.New(), Name: &name, Arguments: &arguments}
+	record, err := json.Marshal(toolCall)
+	if err != nil {
+		t.Fatalf("error marshalling tool call: %v", err)
+	}
+	stored := string(record)
+	toolCall.Arguments = &stored
+	return toolCall
+}
+
+func TestMatchArgumentRuleOnStoredToolCall(t *testing.T) {
+	limit := "100"
+	rules := []ArgumentRule{{
+		Name:       "small transfers",
+		ToolName:   "transfer",
+		Decision:   Approve,
+		Conditions: []ArgumentCondition{{Path: "/amount", Operator: LessThan, Value: &limit}},
+	}}
+
+	tests := []struct {
+		name      string
+		arguments string
+		matches   bool
+	}{
+		{name: "below the limit", arguments: `{"amount":5,"currency":"EUR"}`, matches: true},
+		{name: "above the limit", arguments: `{"amount":500,"currency":"EUR"}`, matches: false},
+		{name: "without the field", arguments: `{"currency":"EUR"}`, matches: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			toolCall := storedToolCall(t, "transfer", test.arguments)
+			rule := matchArgumentRule(rules, "transfer", uuid.New(), storedToolCallArguments(toolCall))
+			if matched := rule != nil; matched != test.matches {
+				t.Errorf("rule matched %v, want %v", matched, test.matches)
+			}
+		})
+	}
+}
+
+func TestStoredToolCallArguments(t *testing.T) {
+	toolCall := storedToolCall(t, "transfer", `{"amount":5}`)
+	arguments := storedToolCallArguments(toolCall)
+	if arguments == nil || *arguments != `{"amount":5}` {
+		t.Errorf("got arguments %q, want {\"amount\":5}", stringOrEmpty(arguments))
+	}
+
+	if arguments := storedToolCallArguments(AsteroidToolCall{}); arguments != nil {
+		t.Errorf("got arguments %q for a tool call without any", *arguments)
+	}
+}
+
+func TestArgumentConditionNumberValues(t *testing.T) {
+	var condition ArgumentCondition
+	data := `{"path":"/amount","operator":"less_than","value":100,"values":[1.5,"two"]}`
+	if err := json.Unmarshal([]byte(data), &condition); err != nil {
+		t.Fatalf("error unmarshalling condition: %v", err)
+	}
+
+	if condition.Path != "/amount" || condition.Operator != LessThan {
+		t.Errorf("got path %q and operator %q, want /amount and less_than", condition.Path, condition.Operator)
+	}
+	if condition.Value == nil || *condition.Value != "100" {
+		t.Errorf("got value %q, want 100", stringOrEmpty(condition.Value))
+	}
+	if condition.Values == nil || len(*condition.Values) != 2 || (*condition.Values)[0] != "1.5" || (*condition.Values)[1] != "two" {
+		t.Errorf("got values %v, want [1.5 two]", condition.Values)
+	}
+	if err := validateArgumentCondition(condition); err != nil {
+		t.Errorf("condition with a number value is invalid: %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"path":"/amount","operator":"equals","value":true}`), &condition); err == nil {
+		t.Error("condition with a boolean value unmarshalled")
+	}
+}
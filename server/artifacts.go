@@ -0,0 +1,247 @@
+package asteroid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxArtifactBytes is the largest artifact that can be uploaded to a run
+const maxArtifactBytes = 32 << 20
+
+// artifactBlobKey is where an artifact's content is kept in the blob store
+func artifactBlobKey(artifact RunArtifact) string {
+	return fmt.Sprintf("artifacts/%s/%s", artifact.RunId, artifact.Id)
+}
+
+// inlineArtifact reports whether an artifact can be shown in the browser, which only images can since
+// the content is whatever the agent uploaded. SVGs can run scripts, so they're downloaded too.
+func inlineArtifact(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && strings.HasPrefix(mediaType, "image/") && mediaType != "image/svg+xml"
+}
+
+// getToolCallRun returns the run a tool call was made in, or nil if there's no such tool call
+func getToolCallRun(ctx context.Context, toolCallId uuid.UUID, store Store) (*uuid.UUID, error) {
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil || toolCall == nil {
+		return nil, err
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil || tool == nil {
+		return nil, err
+	}
+	return &tool.RunId, nil
+}
+
+// readArtifactContent reads the content of an artifact from a request's body, responding instead if
+// it's empty or too large
+func readArtifactContent(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	content, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxArtifactBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			sendErrorResponse(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("artifacts can't be larger than %d bytes", maxArtifactBytes), "")
+			return nil, false
+		}
+		sendErrorResponse(w, http.StatusBadRequest, "error reading artifact", err.Error())
+		return nil, false
+	}
+
+	if len(content) == 0 {
+		sendErrorResponse(w, http.StatusBadRequest, "artifact is empty", "")
+		return nil, false
+	}
+
+	return content, true
+}
+
+// createRunArtifact stores an artifact's content and creates the artifact. The content is stored
+// first, so there's never an artifact without content.
+func createRunArtifact(ctx context.Context, artifact *RunArtifact, content []byte, store Store, blobs BlobStore) error {
+	artifact.Id = uuid.New()
+	artifact.SizeBytes = int64(len(content))
+	artifact.Sha256 = sha256Hex(content)
+	artifact.UploadedBy = actorFromContext(ctx)
+	artifact.CreatedAt = time.Now()
+
+	if err := blobs.PutBlob(ctx, artifactBlobKey(*artifact), content); err != nil {
+		return fmt.Errorf("error storing artifact content: %w", err)
+	}
+	return store.CreateRunArtifact(ctx, *artifact)
+}
+
+func apiUploadRunArtifactHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, params UploadRunArtifactParams, store Store, blobs BlobStore) {
+	ctx := r.Context()
+
+	if blobs == nil {
+		sendErrorResponse(w, http.StatusServiceUnavailable, ErrNoBlobStore.Error(), "set BLOB_STORE_DIR to enable artifacts")
+		return
+	}
+
+	// Only the file name is kept, agents often send the path they wrote the file to
+	name := path.Base(strings.ReplaceAll(strings.TrimSpace(params.Name), "\\", "/"))
+	if name == "" || name == "." || name == "/" {
+		sendErrorResponse(w, http.StatusBadRequest, "name is required", "")
+		return
+	}
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	if params.ToolCallId != nil {
+		toolCallRunId, err := getToolCallRun(ctx, *params.ToolCallId, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
+			return
+		}
+
+		if toolCallRunId == nil || *toolCallRunId != runId {
+			sendErrorResponse(w, http.StatusNotFound, "Tool call not found", fmt.Sprintf("run %s has no tool call %s", runId, *params.ToolCallId))
+			return
+		}
+	}
+
+	content, ok := readArtifactContent(w, r)
+	if !ok {
+		return
+	}
+
+	// Agents that don't know what they're uploading send octet-stream, which is sniffed so reviewers
+	// are still shown screenshots
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" || contentType == "application/octet-stream" {
+		contentType = http.DetectContentType(content)
+	}
+
+	artifact := RunArtifact{
+		RunId:       runId,
+		ToolCallId:  params.ToolCallId,
+		Name:        name,
+		ContentType: contentType,
+	}
+	if err := createRunArtifact(ctx, &artifact, content, store, blobs); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating artifact", err.Error())
+		return
+	}
+
+	respondJSON(w, artifact, http.StatusCreated)
+}
+
+func apiGetRunArtifactsHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	artifacts, err := store.GetRunArtifacts(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting artifacts", err.Error())
+		return
+	}
+
+	respondJSON(w, artifacts, http.StatusOK)
+}
+
+func apiGetToolCallArtifactsHandler(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
+		return
+	}
+
+	if toolCall == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call not found", "")
+		return
+	}
+
+	artifacts, err := store.GetToolCallArtifacts(ctx, toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting artifacts", err.Error())
+		return
+	}
+
+	respondJSON(w, artifacts, http.StatusOK)
+}
+
+func apiGetRunArtifactHandler(w http.ResponseWriter, r *http.Request, artifactId uuid.UUID, store Store) {
+	artifact, err := store.GetRunArtifact(r.Context(), artifactId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting artifact", err.Error())
+		return
+	}
+
+	if artifact == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Artifact not found", "")
+		return
+	}
+
+	respondJSON(w, artifact, http.StatusOK)
+}
+
+func apiDownloadRunArtifactHandler(w http.ResponseWriter, r *http.Request, artifactId uuid.UUID, store Store, blobs BlobStore) {
+	ctx := r.Context()
+
+	artifact, err := store.GetRunArtifact(ctx, artifactId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting artifact", err.Error())
+		return
+	}
+
+	if artifact == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Artifact not found", "")
+		return
+	}
+
+	if blobs == nil {
+		sendErrorResponse(w, http.StatusServiceUnavailable, ErrNoBlobStore.Error(), "set BLOB_STORE_DIR to enable artifacts")
+		return
+	}
+
+	content, err := blobs.GetBlob(ctx, artifactBlobKey(*artifact))
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting artifact content", err.Error())
+		return
+	}
+
+	disposition := "attachment"
+	if inlineArtifact(artifact.ContentType) {
+		disposition = "inline"
+	}
+
+	w.Header().Set("Content-Type", artifact.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": artifact.Name}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "sandbox")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(content)
+}
@@ -0,0 +1,230 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// defaultAssignmentStrategy is how reviews of human supervisors without an assignment_strategy are assigned
+const defaultAssignmentStrategy = LeastLoaded
+
+// reviewAssignment is how a review is matched to a reviewer session
+type reviewAssignment struct {
+	strategy AssignmentStrategy
+	// categories are the tool's categories, matched against reviewer skills
+	categories []string
+	// requireSkillMatch keeps skill matched reviews queued until a reviewer with a matching skill has capacity
+	requireSkillMatch bool
+	// requiredSkills are the skills the project's routing rules require of whoever reviews it
+	requiredSkills []string
+	// reviewerSkills are the skills admins tagged sessions with, which replace the skills they declare
+	reviewerSkills map[string][]string
+	// scope is matched against the subscriptions of sessions
+	scope reviewScope
+	// escalation is what sent the review to its supervisor, if another supervisor of the chain escalated
+	escalation *Escalation
+}
+
+// validateAssignmentAttributes checks the assignment attributes of a human supervisor
+func validateAssignmentAttributes(attributes map[string]interface{}) error {
+	if value, ok := attributes["assignment_strategy"]; ok {
+		strategy, _ := value.(string)
+		switch AssignmentStrategy(strategy) {
+		case RoundRobin, LeastLoaded, SkillMatch:
+		default:
+			return fmt.Errorf("unknown assignment_strategy: %v", value)
+		}
+	}
+
+	if value, ok := attributes["require_skill_match"]; ok {
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("require_skill_match must be a boolean")
+		}
+	}
+
+	steps, err := parseReminderSchedule(attributes)
+	if err != nil {
+		return err
+	}
+
+	return validateReminderSchedule(steps)
+}
+
+// parseSkills splits a comma separated list of skills, lowercased
+func parseSkills(skills string) []string {
+	parsed := make([]string, 0)
+	for _, skill := range strings.Split(skills, ",") {
+		if skill = strings.ToLower(strings.TrimSpace(skill)); skill != "" && !slices.Contains(parsed, skill) {
+			parsed = append(parsed, skill)
+		}
+	}
+	return parsed
+}
+
+// toolCategories reads the categories a tool was registered with, from its category or categories attribute
+func toolCategories(tool Tool) []string {
+	categories := make([]string, 0)
+	if category, ok := tool.Attributes["category"].(string); ok {
+		categories = append(categories, parseSkills(category)...)
+	}
+	if list, ok := tool.Attributes["categories"].([]interface{}); ok {
+		for _, value := range list {
+			if category, ok := value.(string); ok {
+				categories = append(categories, parseSkills(category)...)
+			}
+		}
+	}
+	return categories
+}
+
+// getReviewAssignment reads how a review should be assigned from its supervisor's attributes, the
+// tool it's for and the project's routing rules. A supervisor that can't be read falls back to the
+// default strategy, anything else fails, so reviews never go to reviewers without the skills they need.
+func getReviewAssignment(ctx context.Context, supervisionRequest SupervisionRequest, store Store) (reviewAssignment, error) {
+	assignment := reviewAssignment{
+		strategy:       defaultAssignmentStrategy,
+		reviewerSkills: make(map[string][]string),
+		scope:          reviewScope{supervisorId: supervisionRequest.SupervisorId},
+	}
+
+	reviewers, err := store.GetReviewers(ctx)
+	if err != nil {
+		return assignment, fmt.Errorf("error getting reviewers: %w", err)
+	}
+	for _, reviewer := range reviewers {
+		assignment.reviewerSkills[reviewer.Session] = reviewer.Skills
+	}
+
+	supervisor, err := store.GetSupervisor(ctx, supervisionRequest.SupervisorId)
+	if err != nil || supervisor == nil {
+		log.Printf("Error getting supervisor of request %s, assigning it %s: %v", *supervisionRequest.Id, assignment.strategy, err)
+	} else {
+		if strategy, ok := supervisor.Attributes["assignment_strategy"].(string); ok {
+			assignment.strategy = AssignmentStrategy(strategy)
+		}
+		assignment.requireSkillMatch, _ = supervisor.Attributes["require_skill_match"].(bool)
+	}
+
+	if supervisionRequest.ChainexecutionId != nil {
+		state, err := store.GetChainExecutionState(ctx, *supervisionRequest.ChainexecutionId)
+		if err != nil {
+			return assignment, fmt.Errorf("error getting chain state: %w", err)
+		}
+		if state != nil {
+			assignment.escalation = escalationTo(*state, supervisionRequest.PositionInChain)
+		}
+	}
+
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, *supervisionRequest.Id, store)
+	if err != nil || toolCallId == nil {
+		return assignment, err
+	}
+	toolCall, err := store.GetToolCall(ctx, *toolCallId)
+	if err != nil || toolCall == nil {
+		return assignment, err
+	}
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil || tool == nil {
+		return assignment, err
+	}
+	assignment.categories = toolCategories(*tool)
+
+	assignment.scope.runId = &tool.RunId
+	project, err := getProjectForRun(ctx, tool.RunId, store)
+	if err != nil {
+		return assignment, err
+	}
+	if project != nil {
+		assignment.scope.projectId = &project.Id
+	}
+
+	assignment.requiredSkills, err = getRequiredSkills(ctx, *toolCall, *tool, store)
+	if err != nil {
+		return assignment, err
+	}
+
+	return assignment, nil
+}
+
+// hasSkills reports whether a session has every one of a list of skills
+func hasSkills(sessionSkills []string, skills []string) bool {
+	for _, skill := range skills {
+		if !slices.Contains(sessionSkills, skill) {
+			return false
+		}
+	}
+	return true
+}
+
+// sessionSkills returns the skills of a session: those an admin tagged it with, otherwise those
+// all its connections declared. Must be called with the hub's ClientsMutex held.
+func (h *Hub) sessionSkills(session string, assignment reviewAssignment) []string {
+	if skills, ok := assignment.reviewerSkills[session]; ok {
+		return skills
+	}
+
+	skills := make([]string, 0)
+	for client := range h.Sessions[session] {
+		for _, skill := range client.Skills {
+			if !slices.Contains(skills, skill) {
+				skills = append(skills, skill)
+			}
+		}
+	}
+	return skills
+}
+
+// chooseSession picks the session a review is assigned to, or returns false if no session with
+// capacity subscribes to it and fits. Must be called with the hub's ClientsMutex and AssignedReviewsMutex held.
+func (h *Hub) chooseSession(supervisionRequest SupervisionRequest, assignment reviewAssignment) (string, bool) {
+	candidates := make([]string, 0, len(h.Sessions))
+	for session := range h.Sessions {
+		if len(h.AssignedReviews[session]) < MAX_SUPERVISORS_PER_CLIENT && h.sessionFollows(session, assignment.scope) &&
+			hasSkills(h.sessionSkills(session, assignment), assignment.requiredSkills) {
+			candidates = append(candidates, session)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+	sort.Strings(candidates)
+
+	switch assignment.strategy {
+	case RoundRobin:
+		// The session after the one this supervisor's last review went to
+		last := h.LastAssignedSessions[supervisionRequest.SupervisorId]
+		for _, session := range candidates {
+			if session > last {
+				return session, true
+			}
+		}
+		return candidates[0], true
+
+	case SkillMatch:
+		matching := make([]string, 0, len(candidates))
+		for _, session := range candidates {
+			skills := h.sessionSkills(session, assignment)
+			if slices.ContainsFunc(assignment.categories, func(category string) bool { return slices.Contains(skills, category) }) {
+				matching = append(matching, session)
+			}
+		}
+		if len(matching) > 0 {
+			candidates = matching
+		} else if assignment.requireSkillMatch {
+			return "", false
+		}
+	}
+
+	// Least loaded, which is also how skill matched reviews pick between matching sessions
+	chosen := candidates[0]
+	for _, session := range candidates[1:] {
+		if len(h.AssignedReviews[session]) < len(h.AssignedReviews[chosen]) {
+			chosen = session
+		}
+	}
+	return chosen, true
+}
@@ -0,0 +1,170 @@
+package asteroid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrSupervisionRequestResolved is returned by the store when a supervision request already has a result
+var ErrSupervisionRequestResolved = errors.New("supervision request already has a result")
+
+// SystemActor is the audit actor for decisions the server makes itself
+const SystemActor = "system"
+
+const supervisionRequestResource = "supervision_request"
+
+// actorFromContext names whoever made an API request, for the audit log
+func actorFromContext(ctx context.Context) string {
+	if key := apiKeyFromContext(ctx); key != nil {
+		if key.UserId != nil {
+			return userActor(*key.UserId)
+		}
+		return "api_key:" + key.Name
+	}
+	return "anonymous"
+}
+
+// sessionActor names a reviewer connection, for the audit log
+func sessionActor(session string) string {
+	return "session:" + session
+}
+
+// sessionFromActor returns the session of an audit actor that's a reviewer connection
+func sessionFromActor(actor string) (string, bool) {
+	return strings.CutPrefix(actor, "session:")
+}
+
+// recordAuditEvent stores an audit event. Failing to audit doesn't fail the action, so errors are only logged.
+func recordAuditEvent(ctx context.Context, actor string, action AuditAction, resourceType string, resourceId uuid.UUID, details map[string]interface{}, store AuditStore) {
+	event := AuditEvent{
+		Id:           uuid.New(),
+		CreatedAt:    time.Now(),
+		Actor:        actor,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceId:   resourceId,
+		Details:      details,
+	}
+
+	if err := store.CreateAuditEvent(ctx, event); err != nil {
+		log.Printf("Error recording %s audit event for %s %s: %v", action, resourceType, resourceId, err)
+	}
+}
+
+// resolveSupervisionRequest stores a decision for a supervision request unless it was already
+// resolved, in which case the existing result is returned. The first decision wins, and both it
+// and every losing attempt are recorded in the audit log.
+func resolveSupervisionRequest(ctx context.Context, requestId uuid.UUID, result SupervisionResult, actor string, store Store) (*uuid.UUID, *SupervisionResult, error) {
+	winner, err := store.GetSupervisionResultFromRequestID(ctx, requestId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting supervision result: %w", err)
+	}
+
+	var id *uuid.UUID
+	if winner == nil {
+		id, err = store.CreateSupervisionResult(ctx, result, requestId)
+		if errors.Is(err, ErrSupervisionRequestResolved) {
+			// Lost a race with a decision that was stored after we checked
+			winner, err = store.GetSupervisionResultFromRequestID(ctx, requestId)
+			if err == nil && winner == nil {
+				err = fmt.Errorf("supervision request %s was resolved but has no result", requestId)
+			}
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("error creating supervision result: %w", err)
+		}
+	}
+
+	details := decisionAuditDetails(result)
+	if winner != nil {
+		details["winning_result_id"] = winner.Id
+		details["winning_decision"] = winner.Decision
+		recordAuditEvent(ctx, actor, AuditActionDecisionConflict, supervisionRequestResource, requestId, details, store)
+		return nil, winner, nil
+	}
+
+	details["result_id"] = id
+	recordAuditEvent(ctx, actor, AuditActionDecisionRecorded, supervisionRequestResource, requestId, details, store)
+	recordTrustDecision(ctx, requestId, result, actor, store)
+	result.Id = id
+	emitDecisionWebhooks(ctx, result, store)
+	notifyRejectionWatchers(ctx, result, store)
+	return id, nil, nil
+}
+
+// resolveSupervisionRequests stores the results of several supervision requests together, recording
+// a decision for each. If any of the requests was already resolved, none of the results is stored and
+// ErrSupervisionRequestResolved is returned.
+func resolveSupervisionRequests(ctx context.Context, results []SupervisionResult, actor string, store Store) ([]uuid.UUID, error) {
+	ids, err := store.CreateSupervisionResults(ctx, results)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, result := range results {
+		details := decisionAuditDetails(result)
+		details["result_id"] = ids[i]
+		recordAuditEvent(ctx, actor, AuditActionDecisionRecorded, supervisionRequestResource, result.SupervisionRequestId, details, store)
+		recordTrustDecision(ctx, result.SupervisionRequestId, result, actor, store)
+		result.Id = &ids[i]
+		emitDecisionWebhooks(ctx, result, store)
+		notifyRejectionWatchers(ctx, result, store)
+	}
+	return ids, nil
+}
+
+func decisionAuditDetails(result SupervisionResult) map[string]interface{} {
+	details := map[string]interface{}{
+		"decision":  result.Decision,
+		"reasoning": result.Reasoning,
+	}
+	if result.Verdict != nil {
+		details["verdict"] = *result.Verdict
+	}
+	if result.OverriddenDecision != nil {
+		details["overridden_decision"] = *result.OverriddenDecision
+	}
+	if result.UserId != nil {
+		details["user_id"] = *result.UserId
+	}
+	if result.Precedents != nil {
+		details["precedents"] = *result.Precedents
+	}
+	if result.TimeoutFallback != nil {
+		details["timeout_fallback"] = *result.TimeoutFallback
+	}
+	if result.ModifiedArguments != nil {
+		details["modified_arguments"] = *result.ModifiedArguments
+	}
+	return details
+}
+
+func apiGetSupervisionRequestAuditLogHandler(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	request, err := store.GetSupervisionRequest(ctx, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision request", err.Error())
+		return
+	}
+
+	if request == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervision request not found", "")
+		return
+	}
+
+	events, err := store.GetAuditEvents(ctx, supervisionRequestResource, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting audit log", err.Error())
+		return
+	}
+
+	respondJSON(w, events, http.StatusOK)
+}
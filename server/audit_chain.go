@@ -0,0 +1,333 @@
+package asteroid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditGenesisHash is the previous hash of the first event of the audit log
+var AuditGenesisHash = strings.Repeat("0", 64)
+
+const (
+	// auditChainPageSize is how many events are verified at a time
+	auditChainPageSize = 1000
+
+	defaultAuditAnchorInterval = time.Hour
+	auditAnchorTimeout         = 10 * time.Second
+	// maxAuditAnchorReceiptBytes bounds the receipt kept of an anchoring service's response
+	maxAuditAnchorReceiptBytes = 64 << 10
+)
+
+// auditEventContent is what the hash of an audit event covers
+type auditEventContent struct {
+	Sequence     int64       `json:"sequence"`
+	PreviousHash string      `json:"previous_hash"`
+	Id           uuid.UUID   `json:"id"`
+	CreatedAt    string      `json:"created_at"`
+	Actor        string      `json:"actor"`
+	Action       AuditAction `json:"action"`
+	ResourceType string      `json:"resource_type"`
+	ResourceId   uuid.UUID   `json:"resource_id"`
+	Details      interface{} `json:"details"`
+}
+
+// AuditEventHash returns the hex SHA-256 of an audit event chained to the hash of the one before it.
+// The time is hashed at the database's microsecond precision and the details in canonical form, so
+// an event hashes the same once it's read back.
+func AuditEventHash(event AuditEvent) (string, error) {
+	details, err := json.Marshal(event.Details)
+	if err != nil {
+		return "", fmt.Errorf("error encoding details: %w", err)
+	}
+	var canonicalDetails interface{}
+	if err := json.Unmarshal(details, &canonicalDetails); err != nil {
+		return "", fmt.Errorf("error decoding details: %w", err)
+	}
+
+	content, err := json.Marshal(auditEventContent{
+		Sequence:     event.Sequence,
+		PreviousHash: event.PreviousHash,
+		Id:           event.Id,
+		CreatedAt:    event.CreatedAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+		Actor:        event.Actor,
+		Action:       event.Action,
+		ResourceType: event.ResourceType,
+		ResourceId:   event.ResourceId,
+		Details:      canonicalDetails,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error encoding audit event: %w", err)
+	}
+
+	return ContentHash(content)
+}
+
+// verifyAuditChain walks the audit log from its first event, checking every event hashes to its
+// stored hash and chains to the one before it, and that every anchor matches the chain
+func verifyAuditChain(ctx context.Context, store AuditStore) (*AuditChainVerification, error) {
+	anchors, err := store.GetAuditAnchors(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting audit anchors: %w", err)
+	}
+	anchorsAt := make(map[int64][]AuditAnchor)
+	for _, anchor := range anchors {
+		anchorsAt[anchor.Sequence] = append(anchorsAt[anchor.Sequence], anchor)
+	}
+
+	head, err := store.GetAuditChainHead(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting audit chain head: %w", err)
+	}
+
+	verification := AuditChainVerification{
+		Head:           *head,
+		AnchorsChecked: len(anchors),
+		Breaks:         make([]AuditChainBreak, 0),
+	}
+
+	previous := AuditGenesisHash
+	var last int64
+	for {
+		events, err := store.GetAuditChain(ctx, last, auditChainPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("error getting audit chain: %w", err)
+		}
+
+		for _, event := range events {
+			eventId := event.Id
+			if event.Sequence != last+1 {
+				verification.Breaks = append(verification.Breaks, AuditChainBreak{Kind: SequenceGap, Sequence: last + 1})
+			}
+			if event.PreviousHash != previous {
+				verification.Breaks = append(verification.Breaks, AuditChainBreak{Kind: PreviousHashMismatch, Sequence: event.Sequence, EventId: &eventId})
+			}
+			if computed, err := AuditEventHash(event); err != nil || computed != event.Hash {
+				verification.Breaks = append(verification.Breaks, AuditChainBreak{Kind: HashMismatch, Sequence: event.Sequence, EventId: &eventId})
+			}
+
+			for _, anchor := range anchorsAt[event.Sequence] {
+				if anchor.Hash != event.Hash {
+					anchorId := anchor.Id
+					verification.Breaks = append(verification.Breaks, AuditChainBreak{Kind: AnchorMismatch, Sequence: event.Sequence, EventId: &eventId, AnchorId: &anchorId})
+				}
+			}
+			delete(anchorsAt, event.Sequence)
+
+			previous = event.Hash
+			last = event.Sequence
+			verification.Checked++
+		}
+
+		if len(events) < auditChainPageSize {
+			break
+		}
+	}
+
+	// Events removed from the end of the log leave the head ahead of the last event
+	if head.Sequence > last {
+		verification.Breaks = append(verification.Breaks, AuditChainBreak{Kind: SequenceGap, Sequence: last + 1})
+	}
+
+	// Anchors left over were taken at events the log doesn't have anymore
+	for sequence, leftover := range anchorsAt {
+		for _, anchor := range leftover {
+			anchorId := anchor.Id
+			verification.Breaks = append(verification.Breaks, AuditChainBreak{Kind: AnchorMismatch, Sequence: sequence, AnchorId: &anchorId})
+		}
+	}
+
+	verification.Intact = len(verification.Breaks) == 0
+	verification.VerifiedAt = time.Now()
+	return &verification, nil
+}
+
+// AuditAnchorer publishes the head of the audit log to an external service, so the log can't be
+// rewritten without the anchored hashes giving it away
+type AuditAnchorer struct {
+	url      string
+	token    string
+	interval time.Duration
+	client   *http.Client
+	store    Store
+}
+
+// NewAuditAnchorerFromEnv configures anchoring with AUDIT_ANCHOR_URL, the optional bearer token
+// AUDIT_ANCHOR_TOKEN and AUDIT_ANCHOR_INTERVAL. It returns nil when no URL is set.
+func NewAuditAnchorerFromEnv(store Store) (*AuditAnchorer, error) {
+	anchorUrl := os.Getenv("AUDIT_ANCHOR_URL")
+	if anchorUrl == "" {
+		return nil, nil
+	}
+
+	u, err := url.Parse(anchorUrl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("AUDIT_ANCHOR_URL must be an absolute http or https URL")
+	}
+
+	interval := defaultAuditAnchorInterval
+	if value := os.Getenv("AUDIT_ANCHOR_INTERVAL"); value != "" {
+		interval, err = time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("AUDIT_ANCHOR_INTERVAL must be a positive duration like 1h")
+		}
+	}
+
+	return &AuditAnchorer{
+		url:      anchorUrl,
+		token:    os.Getenv("AUDIT_ANCHOR_TOKEN"),
+		interval: interval,
+		client: &http.Client{
+			Timeout: auditAnchorTimeout,
+			// Hashes are only anchored where they were configured to be
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		store: store,
+	}, nil
+}
+
+func (a *AuditAnchorer) Start(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := a.anchorIfChanged(ctx); err != nil {
+				log.Printf("Error anchoring audit log: %v", err)
+			}
+		}
+	}
+}
+
+// anchorIfChanged anchors the head of the audit log unless it was already anchored
+func (a *AuditAnchorer) anchorIfChanged(ctx context.Context) (*AuditAnchor, error) {
+	head, err := a.store.GetAuditChainHead(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting audit chain head: %w", err)
+	}
+	if head.Sequence == 0 {
+		return nil, nil
+	}
+
+	latest, err := a.store.GetLatestAuditAnchor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest audit anchor: %w", err)
+	}
+	if latest != nil && latest.Sequence == head.Sequence {
+		return nil, nil
+	}
+
+	return a.anchor(ctx, *head)
+}
+
+// anchor posts a head of the audit log to the anchoring service and stores its receipt
+func (a *AuditAnchorer) anchor(ctx context.Context, head AuditChainHead) (*AuditAnchor, error) {
+	body, err := json.Marshal(head)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling audit chain head: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating anchor request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if a.token != "" {
+		request.Header.Set("Authorization", "Bearer "+a.token)
+	}
+
+	resp, err := a.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("error calling anchoring service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("anchoring service responded with status %d", resp.StatusCode)
+	}
+
+	receipt, err := io.ReadAll(io.LimitReader(resp.Body, maxAuditAnchorReceiptBytes))
+	if err != nil {
+		return nil, fmt.Errorf("error reading anchoring service response: %w", err)
+	}
+
+	anchor := AuditAnchor{
+		Id:         uuid.New(),
+		Sequence:   head.Sequence,
+		Hash:       head.Hash,
+		Url:        a.url,
+		AnchoredAt: time.Now(),
+	}
+	if len(receipt) > 0 {
+		text := string(receipt)
+		anchor.Receipt = &text
+	}
+
+	if err := a.store.CreateAuditAnchor(ctx, anchor); err != nil {
+		return nil, fmt.Errorf("error creating audit anchor: %w", err)
+	}
+	return &anchor, nil
+}
+
+func apiVerifyAuditLogHandler(w http.ResponseWriter, r *http.Request, store Store) {
+	verification, err := verifyAuditChain(r.Context(), store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error verifying audit log", err.Error())
+		return
+	}
+
+	respondJSON(w, verification, http.StatusOK)
+}
+
+func apiGetAuditAnchorsHandler(w http.ResponseWriter, r *http.Request, store Store) {
+	anchors, err := store.GetAuditAnchors(r.Context())
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting audit anchors", err.Error())
+		return
+	}
+
+	respondJSON(w, anchors, http.StatusOK)
+}
+
+func apiAnchorAuditLogHandler(w http.ResponseWriter, r *http.Request, anchorer *AuditAnchorer, store Store) {
+	ctx := r.Context()
+
+	if anchorer == nil {
+		sendErrorResponse(w, http.StatusBadRequest, "audit anchoring isn't configured", "set AUDIT_ANCHOR_URL to anchor the audit log")
+		return
+	}
+
+	head, err := store.GetAuditChainHead(ctx)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting audit chain head", err.Error())
+		return
+	}
+
+	if head.Sequence == 0 {
+		sendErrorResponse(w, http.StatusBadRequest, "audit log has no events to anchor", "")
+		return
+	}
+
+	anchor, err := anchorer.anchor(ctx, *head)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadGateway, "error anchoring audit log", err.Error())
+		return
+	}
+
+	respondJSON(w, anchor, http.StatusCreated)
+}
@@ -0,0 +1,516 @@
+package asteroid
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApiKeyHeader is an alternative to sending the key as a bearer token
+const ApiKeyHeader = "X-Asteroid-Api-Key"
+
+// ApiKeyQueryParam is the WebSocket query parameter a key can be sent in, as browsers can't set
+// headers on WebSocket connections
+const ApiKeyQueryParam = "api_key"
+
+// apiKeyPrefix starts every key we issue, so leaked keys are easy to recognise
+const apiKeyPrefix = "ast_"
+
+type contextKey string
+
+const apiKeyContextKey contextKey = "apiKey"
+
+// routeScopes are the scopes needed for each mutating route and restricted read, keyed by mux pattern.
+// Other reads need read:runs, and writes that aren't listed need admin:projects so new routes are
+// locked down by default.
+var routeScopes = map[string]ApiKeyScope{
+	"PUT /message/{messageId}/content":         WriteRuns,
+	"POST /project/{projectId}/tasks":          WriteRuns,
+	"POST /task/{taskId}/run":                  WriteRuns,
+	"POST /run/{runId}/tool":                   WriteRuns,
+	"POST /run/{runId}/openapi_tools":          WriteRuns,
+	"POST /run/{run_id}/chat":                  WriteRuns,
+	"PUT /run/{runId}/status":                  WriteRuns,
+	"PUT /run/{runId}/result":                  WriteRuns,
+	"POST /run/{runId}/proxy/chat/completions": WriteRuns,
+	"POST /run/{runId}/preapproval":            WriteRuns,
+	"PUT /tool_call/{toolCallId}/dependencies": WriteRuns,
+	"POST /run/{runId}/documents":              WriteRuns,
+	"POST /run/{runId}/artifacts":              WriteRuns,
+	"POST /tool_call/{toolCallId}/screenshot":  WriteRuns,
+	"POST /tool_call/{toolCallId}/result":      WriteRuns,
+	"POST /run/{runId}/events":                 WriteRuns,
+	"POST /run/{runId}/plans":                  WriteRuns,
+	"POST /run/{runId}/chat_streams":           WriteRuns,
+	"POST /chat_stream/{streamId}/chunks":      WriteRuns,
+	"POST /chat_stream/{streamId}/complete":    WriteRuns,
+	"POST /run/{runId}/transcript":             WriteRuns,
+
+	// Agents answer the questions reviewers ask them
+	"POST /clarification/{clarificationId}/answer": WriteRuns,
+
+	"POST /tool_call/{toolCallId}/chain/{chainId}/supervisor/{supervisorId}/supervision_request": WriteDecisions,
+	"POST /supervision_request/{supervisionRequestId}/result":                                    WriteDecisions,
+	"POST /supervision_request/{supervisionRequestId}/feedback_draft":                            WriteDecisions,
+	"POST /tool_call/decisions:batch":                                                            WriteDecisions,
+	"POST /supervision_request/{supervisionRequestId}/reminders":                                 WriteDecisions,
+	"POST /plan/{planId}/decision":                                                               WriteDecisions,
+	"POST /tool_call/{toolCallId}/result/verdict":                                                WriteDecisions,
+	"POST /supervision_request/{supervisionRequestId}/claim":                                     WriteDecisions,
+	"DELETE /supervision_request/{supervisionRequestId}/claim":                                   WriteDecisions,
+
+	"POST /review_queue/handoff":                           WriteDecisions,
+	"POST /review_queue/handoff/{handoffBundleId}/restore": WriteDecisions,
+
+	// Reviewers choose what they're notified of
+	"POST /reviewer/{session}/watch_subscriptions":     WriteDecisions,
+	"DELETE /watch_subscription/{watchSubscriptionId}": WriteDecisions,
+
+	"POST /tool/{toolId}/chain/{chainId}/supervisors":                 AdminSupervisors,
+	"PUT /tool/{toolId}/chain/{chainId}/order":                        AdminSupervisors,
+	"DELETE /tool/{toolId}/chain/{chainId}/supervisor/{supervisorId}": AdminSupervisors,
+
+	"POST /project/{projectId}/supervisor":             AdminSupervisors,
+	"POST /tool/{toolId}/supervisors":                  AdminSupervisors,
+	"PUT /project/{projectId}/tool_policies":           AdminSupervisors,
+	"PUT /project/{projectId}/argument_rules":          AdminSupervisors,
+	"PUT /project/{projectId}/argument_baselines":      AdminSupervisors,
+	"PUT /project/{projectId}/context_window_policies": AdminSupervisors,
+	"PUT /project/{projectId}/notification_settings":   AdminSupervisors,
+	"PUT /project/{projectId}/verdicts":                AdminSupervisors,
+	"PUT /project/{projectId}/routing_rules":           AdminSupervisors,
+	"PUT /project/{projectId}/chat_supervisors":        AdminSupervisors,
+	"PUT /project/{projectId}/result_supervisors":      AdminSupervisors,
+	"PUT /project/{projectId}/alert_rules":             AdminSupervisors,
+	"PUT /reviewer/{session}":                          AdminSupervisors,
+	"POST /users":                                      AdminSupervisors,
+	"PUT /run/{runId}/autonomy":                        AdminSupervisors,
+	"POST /run/{runId}/pause":                          AdminSupervisors,
+	"POST /run/{runId}/resume":                         AdminSupervisors,
+	"PUT /project/{projectId}/trust_policy":            AdminSupervisors,
+	"PUT /supervisor/{supervisorId}/test_cases":        AdminSupervisors,
+	"POST /supervisor/{supervisorId}/test_cases/run":   AdminSupervisors,
+	"PUT /organization/{organizationId}/tool_policies": AdminSupervisors,
+	"POST /project/{projectId}/supervisor_dry_run":     AdminSupervisors,
+	"POST /project/{projectId}/backfills":              AdminSupervisors,
+	"POST /backfill/{backfillId}/pause":                AdminSupervisors,
+	"POST /backfill/{backfillId}/resume":               AdminSupervisors,
+	"POST /project/{projectId}/agents":                 AdminSupervisors,
+
+	// The originals of redacted text are kept from everyone who can read runs
+	"GET /run/{runId}/redactions": AdminProjects,
+
+	// SDKs negotiate capabilities with whatever key they run with
+	"POST /capabilities": ReadRuns,
+
+	"GET /workers":           AdminProjects,
+	"GET /feature_flags":     AdminProjects,
+	"GET /admin/diagnostics": AdminProjects,
+}
+
+// publicRoutes can be called without an API key even when keys are required
+var publicRoutes = []string{
+	"GET /openapi.yaml",
+	"GET /swagger-ui",
+	"GET /consent/{token}",
+	"POST /consent/{token}",
+}
+
+// allScopes is granted to the admin key from the environment
+var allScopes = []ApiKeyScope{ReadRuns, WriteRuns, WriteDecisions, AdminSupervisors, AdminProjects}
+
+// requiredScope returns the scope a call of a route needs, by its method and pattern
+func requiredScope(method string, pattern string) ApiKeyScope {
+	if scope, ok := routeScopes[pattern]; ok {
+		return scope
+	}
+	if method == http.MethodGet || method == http.MethodHead {
+		return ReadRuns
+	}
+	return AdminProjects
+}
+
+// HashApiKey returns the hex encoded SHA-256 of a key, which is all we store
+func HashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateApiKey returns a new random key
+func generateApiKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating API key: %w", err)
+	}
+	return apiKeyPrefix + hex.EncodeToString(b), nil
+}
+
+// apiKeyFromRequest reads the key from the Authorization header or the X-Asteroid-Api-Key header
+func apiKeyFromRequest(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		if token, ok := strings.CutPrefix(auth, "Bearer "); ok {
+			return strings.TrimSpace(token)
+		}
+	}
+	return strings.TrimSpace(r.Header.Get(ApiKeyHeader))
+}
+
+// apiKeyFromContext returns the API key a request was authenticated with, or nil if it had none
+func apiKeyFromContext(ctx context.Context) *ApiKey {
+	key, _ := ctx.Value(apiKeyContextKey).(*ApiKey)
+	return key
+}
+
+// hasScope reports whether a key was granted a scope
+func hasScope(key *ApiKey, scope ApiKeyScope) bool {
+	return slices.Contains(key.Scopes, scope)
+}
+
+// authenticateApiKey returns the key a raw key stands for, or nil if it's unknown, revoked or expired.
+// The admin key stands for a key with every scope.
+func authenticateApiKey(ctx context.Context, rawKey string, adminKey string, store ApiKeyStore) (*ApiKey, error) {
+	if adminKey != "" && subtle.ConstantTimeCompare([]byte(rawKey), []byte(adminKey)) == 1 {
+		return &ApiKey{Name: "admin", Scopes: allScopes}, nil
+	}
+
+	key, err := store.GetApiKeyFromHash(ctx, HashApiKey(rawKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if key == nil || key.RevokedAt != nil || (key.ExpiresAt != nil && key.ExpiresAt.Before(time.Now())) {
+		return nil, nil
+	}
+
+	if err := store.UpdateApiKeyLastUsed(ctx, key.Id, time.Now()); err != nil {
+		log.Printf("Error updating last use of API key %s: %v", key.Id, err)
+	}
+
+	return key, nil
+}
+
+// apiKeyMiddleware authenticates requests with an API key and checks the key has the scope the
+// route needs. Requests without a key are let through unless REQUIRE_API_KEY is true, so existing
+// deployments keep working until they opt in. ASTEROID_ADMIN_KEY, if set, is a key with every scope.
+// Keys of a project can only reach the runs, tools, tool calls and other resources of that project.
+// Both settings are read on every request, so a config reload applies them.
+func apiKeyMiddleware(store Store, streams *ChatStreams) MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(publicRoutes, r.Pattern) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			key, err := authorizeCall(r.Context(), apiKeyFromRequest(r), r.Method, r.Pattern, r.PathValue, store, streams)
+			if err != nil {
+				sendAPIError(w, err)
+				return
+			}
+
+			if key == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), apiKeyContextKey, key)))
+		})
+	}
+}
+
+// authorizeCall authenticates a call of a route with a raw key, the way apiKeyMiddleware describes,
+// and returns the key. The key is nil if the call has none and keys aren't required. pathValue
+// returns the call's path parameters.
+func authorizeCall(ctx context.Context, rawKey string, method string, pattern string, pathValue func(string) string, store Store, streams *ChatStreams) (*ApiKey, error) {
+	if rawKey == "" {
+		if os.Getenv("REQUIRE_API_KEY") == "true" {
+			return nil, &apiError{status: http.StatusUnauthorized, message: "API key required", details: "send the key as a bearer token"}
+		}
+		return nil, nil
+	}
+
+	key, err := authenticateApiKey(ctx, rawKey, os.Getenv("ASTEROID_ADMIN_KEY"), store)
+	if err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting API key", details: err.Error()}
+	}
+
+	if key == nil {
+		return nil, &apiError{status: http.StatusUnauthorized, message: "invalid API key"}
+	}
+
+	scope := requiredScope(method, pattern)
+	if !hasScope(key, scope) {
+		return nil, &apiError{status: http.StatusForbidden, message: fmt.Sprintf("API key is missing scope %s", scope)}
+	}
+
+	if key.ProjectId != nil {
+		if err := checkProjectKey(ctx, pattern, pathValue, key, store, streams); err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+// authenticateWs authenticates a reviewer connection like apiKeyMiddleware does requests, also taking
+// the key from the api_key query parameter. Connections decide reviews, so their key needs
+// write:decisions. Responds and reports false if the connection is refused.
+func authenticateWs(w http.ResponseWriter, r *http.Request, store ApiKeyStore) (*ApiKey, bool) {
+	rawKey := apiKeyFromRequest(r)
+	if rawKey == "" {
+		rawKey = strings.TrimSpace(r.URL.Query().Get(ApiKeyQueryParam))
+	}
+
+	if rawKey == "" {
+		if os.Getenv("REQUIRE_API_KEY") == "true" {
+			sendErrorResponse(w, http.StatusUnauthorized, "API key required", "send the key as a bearer token or in the api_key query parameter")
+			return nil, false
+		}
+		return nil, true
+	}
+
+	key, err := authenticateApiKey(r.Context(), rawKey, os.Getenv("ASTEROID_ADMIN_KEY"), store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting API key", err.Error())
+		return nil, false
+	}
+
+	if key == nil {
+		sendErrorResponse(w, http.StatusUnauthorized, "invalid API key", "")
+		return nil, false
+	}
+
+	if !hasScope(key, WriteDecisions) {
+		sendErrorResponse(w, http.StatusForbidden, fmt.Sprintf("API key is missing scope %s", WriteDecisions), "")
+		return nil, false
+	}
+
+	return key, true
+}
+
+func apiCreateApiKeyHandler(w http.ResponseWriter, r *http.Request, store Store) {
+	ctx := r.Context()
+
+	var request CreateApiKeyJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if request.Name == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "name is required", "")
+		return
+	}
+
+	if len(request.Scopes) == 0 {
+		sendErrorResponse(w, http.StatusBadRequest, "at least one scope is required", "")
+		return
+	}
+
+	// A key can't hand out scopes it doesn't have itself
+	creator := apiKeyFromContext(ctx)
+	for _, scope := range request.Scopes {
+		if !slices.Contains(allScopes, scope) {
+			sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown scope: %s", scope), "")
+			return
+		}
+		if creator != nil && !hasScope(creator, scope) {
+			sendErrorResponse(w, http.StatusForbidden, fmt.Sprintf("can't grant scope %s", scope), "")
+			return
+		}
+	}
+
+	if request.ExpiresAt != nil && request.ExpiresAt.Before(time.Now()) {
+		sendErrorResponse(w, http.StatusBadRequest, "expires_at must be in the future", "")
+		return
+	}
+
+	// Keys of a project only create keys of the same project, which is the default for them
+	if creator != nil && creator.ProjectId != nil {
+		if request.ProjectId == nil {
+			request.ProjectId = creator.ProjectId
+		}
+		if *request.ProjectId != *creator.ProjectId {
+			sendErrorResponse(w, http.StatusForbidden, "can't create keys of another project", "")
+			return
+		}
+	}
+
+	// Keys of a user can't speak for anyone else
+	if creator != nil && creator.UserId != nil && request.UserId != nil && *request.UserId != *creator.UserId {
+		sendErrorResponse(w, http.StatusForbidden, "can't create keys of another user", "")
+		return
+	}
+
+	if request.UserId != nil {
+		user, err := store.GetUser(ctx, *request.UserId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting user", err.Error())
+			return
+		}
+
+		if user == nil {
+			sendErrorResponse(w, http.StatusBadRequest, "user not found", "")
+			return
+		}
+	}
+
+	if request.ProjectId != nil {
+		project, err := store.GetProject(ctx, *request.ProjectId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+			return
+		}
+
+		if project == nil {
+			sendErrorResponse(w, http.StatusBadRequest, "project not found", "")
+			return
+		}
+	}
+
+	key, rawKey, err := newApiKey(request.Name, request.Scopes, request.ProjectId, request.UserId, request.ExpiresAt)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error generating API key", err.Error())
+		return
+	}
+
+	if err := store.CreateApiKey(ctx, key, HashApiKey(rawKey)); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating API key", err.Error())
+		return
+	}
+
+	respondJSON(w, CreatedApiKey{ApiKey: key, Key: rawKey}, http.StatusCreated)
+}
+
+// newApiKey returns a new key and its secret
+func newApiKey(name string, scopes []ApiKeyScope, projectId *uuid.UUID, userId *uuid.UUID, expiresAt *time.Time) (ApiKey, string, error) {
+	rawKey, err := generateApiKey()
+	if err != nil {
+		return ApiKey{}, "", err
+	}
+
+	key := ApiKey{
+		Id:        uuid.New(),
+		Name:      name,
+		Prefix:    rawKey[:len(apiKeyPrefix)+8],
+		Scopes:    slices.Compact(slices.Sorted(slices.Values(scopes))),
+		ProjectId: projectId,
+		CreatedAt: time.Now(),
+		ExpiresAt: expiresAt,
+		UserId:    userId,
+	}
+	return key, rawKey, nil
+}
+
+func apiGetApiKeysHandler(w http.ResponseWriter, r *http.Request, store ApiKeyStore) {
+	ctx := r.Context()
+
+	keys, err := store.GetApiKeys(ctx)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting API keys", err.Error())
+		return
+	}
+
+	caller := apiKeyFromContext(ctx)
+	keys = slices.DeleteFunc(keys, func(key ApiKey) bool { return !keyReachesApiKey(caller, key) })
+
+	respondJSON(w, keys, http.StatusOK)
+}
+
+func apiRevokeApiKeyHandler(w http.ResponseWriter, r *http.Request, apiKeyId uuid.UUID, store ApiKeyStore) {
+	ctx := r.Context()
+
+	key, err := store.GetApiKey(ctx, apiKeyId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting API key", err.Error())
+		return
+	}
+
+	if key == nil || !keyReachesApiKey(apiKeyFromContext(ctx), *key) {
+		sendErrorResponse(w, http.StatusNotFound, "API key not found", "")
+		return
+	}
+
+	if key.RevokedAt == nil {
+		if err := store.RevokeApiKey(ctx, apiKeyId, time.Now()); err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error revoking API key", err.Error())
+			return
+		}
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+// maxRotationGraceSeconds bounds how long a rotated key keeps working
+const maxRotationGraceSeconds = 7 * 24 * 60 * 60
+
+func apiRotateApiKeyHandler(w http.ResponseWriter, r *http.Request, apiKeyId uuid.UUID, store ApiKeyStore) {
+	ctx := r.Context()
+
+	// The body is optional, without a grace period the old key stops working right away
+	var request RotateApiKeyJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil && !errors.Is(err, io.EOF) {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	grace := 0
+	if request.GraceSeconds != nil {
+		grace = *request.GraceSeconds
+	}
+	if grace < 0 || grace > maxRotationGraceSeconds {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("grace_seconds must be between 0 and %d", maxRotationGraceSeconds), "")
+		return
+	}
+
+	key, err := store.GetApiKey(ctx, apiKeyId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting API key", err.Error())
+		return
+	}
+
+	if key == nil || !keyReachesApiKey(apiKeyFromContext(ctx), *key) {
+		sendErrorResponse(w, http.StatusNotFound, "API key not found", "")
+		return
+	}
+
+	// The new key lives as long as the old one was meant to
+	var expiresAt *time.Time
+	if key.ExpiresAt != nil {
+		next := time.Now().Add(key.ExpiresAt.Sub(key.CreatedAt))
+		expiresAt = &next
+	}
+
+	next, rawKey, err := newApiKey(key.Name, key.Scopes, key.ProjectId, key.UserId, expiresAt)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error generating API key", err.Error())
+		return
+	}
+
+	rotated, err := store.RotateApiKey(ctx, apiKeyId, next, HashApiKey(rawKey), next.CreatedAt.Add(time.Duration(grace)*time.Second))
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error rotating API key", err.Error())
+		return
+	}
+
+	if !rotated {
+		sendErrorResponse(w, http.StatusConflict, "API key was revoked, expired or already rotated", "")
+		return
+	}
+
+	respondJSON(w, CreatedApiKey{ApiKey: next, Key: rawKey}, http.StatusCreated)
+}
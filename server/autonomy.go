@@ -0,0 +1,188 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+const (
+	runResource = "run"
+
+	// ManualAutonomy consults only chains with a human supervisor, when a tool has any
+	ManualAutonomy AutonomyLevel = 0
+	// AutomaticAutonomy skips every chain with a human supervisor
+	AutomaticAutonomy AutonomyLevel = 3
+)
+
+// autonomyHumanReviewFloor is the lowest risk tier humans still review at the levels between manual and automatic
+var autonomyHumanReviewFloor = map[AutonomyLevel]RiskTier{
+	1: Medium,
+	2: High,
+}
+
+// validateAutonomyLevel checks that an autonomy level is one of the known levels
+func validateAutonomyLevel(level AutonomyLevel) error {
+	if level < ManualAutonomy || level > AutomaticAutonomy {
+		return fmt.Errorf("autonomy level must be between %d and %d, got %d", ManualAutonomy, AutomaticAutonomy, level)
+	}
+	return nil
+}
+
+// humanReviewRequired reports whether humans review a tool of a risk tier at an autonomy level.
+// Tools without a risk tier are reviewed by humans unless the run is fully automatic.
+func humanReviewRequired(level AutonomyLevel, riskTier *RiskTier) bool {
+	switch level {
+	case ManualAutonomy:
+		return true
+	case AutomaticAutonomy:
+		return false
+	}
+	if riskTier == nil {
+		return true
+	}
+	return riskTierRank[*riskTier] >= riskTierRank[autonomyHumanReviewFloor[level]]
+}
+
+// chainHasHuman reports whether any supervisor of a chain is a human, counting the approvers of
+// ServiceNow change requests
+func chainHasHuman(chain SupervisorChain) bool {
+	for _, supervisor := range chain.Supervisors {
+		if supervisor.Type == HumanSupervisor || supervisor.Type == ServicenowSupervisor {
+			return true
+		}
+	}
+	return false
+}
+
+// selectChainsForAutonomy picks which of a tool's chains supervise its calls given the autonomy level
+// of its run, or the one its agent earned with the tool if that's higher. Runs without either are
+// supervised by every chain.
+func selectChainsForAutonomy(ctx context.Context, tool Tool, chains []SupervisorChain, store Store) ([]SupervisorChain, error) {
+	run, err := store.GetRun(ctx, tool.RunId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting run: %w", err)
+	}
+	if run == nil {
+		return chains, nil
+	}
+
+	level := run.AutonomyLevel
+	earned, err := getEarnedAutonomy(ctx, tool, store)
+	if err != nil {
+		return nil, err
+	}
+	if earned != nil && (level == nil || *earned > *level) {
+		level = earned
+	}
+	if level == nil {
+		return chains, nil
+	}
+
+	riskTier, err := getToolRiskTier(ctx, tool, store)
+	if err != nil {
+		return nil, err
+	}
+
+	human := make([]SupervisorChain, 0, len(chains))
+	automated := make([]SupervisorChain, 0, len(chains))
+	for _, chain := range chains {
+		if chainHasHuman(chain) {
+			human = append(human, chain)
+		} else {
+			automated = append(automated, chain)
+		}
+	}
+
+	switch {
+	case !humanReviewRequired(*level, riskTier):
+		return automated, nil
+	case *level == ManualAutonomy && len(human) > 0:
+		return human, nil
+	default:
+		return chains, nil
+	}
+}
+
+// selectChains picks the chains that supervise a tool's calls: those its run's autonomy level
+// selects, plus the chain of an active incident whatever the level
+func selectChains(ctx context.Context, tool Tool, chains []SupervisorChain, store Store) ([]SupervisorChain, error) {
+	selected, err := selectChainsForAutonomy(ctx, tool, chains, store)
+	if err != nil {
+		return nil, err
+	}
+
+	return withIncidentChain(ctx, tool, selected, store)
+}
+
+// selectedChainIds returns which of the chains a tool call's executions belong to are selected for it,
+// or nil if its tool can't be found
+func selectedChainIds(ctx context.Context, toolCallId uuid.UUID, chains []SupervisorChain, store Store) (map[uuid.UUID]bool, error) {
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil || toolCall == nil {
+		return nil, err
+	}
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil || tool == nil {
+		return nil, err
+	}
+
+	// Tool calls an approved plan step covers were reviewed with the plan
+	_, step, err := getPlanStepForToolCall(ctx, toolCallId, store)
+	if err != nil {
+		return nil, err
+	}
+	if step != nil {
+		return map[uuid.UUID]bool{}, nil
+	}
+
+	selected, err := selectChains(ctx, *tool, chains, store)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[uuid.UUID]bool, len(selected))
+	for _, chain := range selected {
+		ids[chain.ChainId] = true
+	}
+	return ids, nil
+}
+
+func apiUpdateRunAutonomyHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var level AutonomyLevel
+	if err := json.NewDecoder(r.Body).Decode(&level); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "error decoding autonomy level", err.Error())
+		return
+	}
+
+	if err := validateAutonomyLevel(level); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid autonomy level", err.Error())
+		return
+	}
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	if err := store.UpdateRunAutonomy(ctx, runId, level); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error updating run autonomy", err.Error())
+		return
+	}
+
+	recordAuditEvent(ctx, actorFromContext(ctx), AuditActionAutonomyChanged, runResource, runId,
+		map[string]interface{}{"from": run.AutonomyLevel, "to": level}, store)
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
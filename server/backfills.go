@@ -0,0 +1,468 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// backfillTickInterval is how often running backfills evaluate the tool calls they're due
+	backfillTickInterval = time.Second
+
+	defaultBackfillRatePerMinute = 60
+	maxBackfillRatePerMinute     = 600
+	// maxBackfillToolCalls caps how many tool calls one backfill evaluates
+	maxBackfillToolCalls = 100_000
+)
+
+// BackfillRunner evaluates the tool calls of running backfills at their rate. Automated supervisors
+// are only asked when a batch priority slot is free, so backfills wait behind live traffic instead
+// of competing with it.
+type BackfillRunner struct {
+	store Store
+	judge Judge
+	lanes *PriorityLanes
+	// last is when each running backfill last evaluated a tool call
+	last map[uuid.UUID]time.Time
+}
+
+func NewBackfillRunner(store Store, judge Judge, lanes *PriorityLanes) *BackfillRunner {
+	return &BackfillRunner{store: store, judge: judge, lanes: lanes, last: make(map[uuid.UUID]time.Time)}
+}
+
+func (r *BackfillRunner) Start(ctx context.Context) {
+	ticker := time.NewTicker(backfillTickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.runBackfills(ctx, time.Now()); err != nil {
+				log.Printf("Error running backfills: %v", err)
+			}
+		}
+	}
+}
+
+func (r *BackfillRunner) runBackfills(ctx context.Context, now time.Time) error {
+	backfills, err := r.store.GetBackfillsForStatus(ctx, BackfillRunning)
+	if err != nil {
+		return fmt.Errorf("error getting running backfills: %w", err)
+	}
+
+	running := make(map[uuid.UUID]bool)
+	for _, backfill := range backfills {
+		running[backfill.Id] = true
+		if err := r.advance(ctx, backfill, now); err != nil {
+			log.Printf("Error running backfill %s: %v", backfill.Id, err)
+		}
+	}
+
+	// Paused and completed backfills start over with their rate once they run again
+	for id := range r.last {
+		if !running[id] {
+			delete(r.last, id)
+		}
+	}
+	return nil
+}
+
+// backfillInterval is how long a backfill waits between tool calls
+func backfillInterval(backfill Backfill) time.Duration {
+	return time.Minute / time.Duration(backfill.RatePerMinute)
+}
+
+// due returns how many tool calls a backfill can evaluate now. A backfill that fell behind, e.g.
+// because no batch slot was free, doesn't catch up faster than its rate.
+func (r *BackfillRunner) due(backfill Backfill, now time.Time) int {
+	last, ok := r.last[backfill.Id]
+	if !ok {
+		return 1
+	}
+
+	burst := max(1, backfill.RatePerMinute*int(backfillTickInterval/time.Second)/60)
+	return min(int(now.Sub(last)/backfillInterval(backfill)), burst)
+}
+
+// advance evaluates the tool calls a backfill is due, completing it once it evaluated all of them
+func (r *BackfillRunner) advance(ctx context.Context, backfill Backfill, now time.Time) error {
+	if backfill.Evaluated >= backfill.TotalCalls {
+		return r.complete(ctx, backfill)
+	}
+
+	due := min(r.due(backfill, now), backfill.TotalCalls-backfill.Evaluated)
+	if due == 0 {
+		return nil
+	}
+
+	toolCalls, err := r.store.GetUnevaluatedBackfillToolCalls(ctx, backfill, due)
+	if err != nil {
+		return err
+	}
+
+	// The calls left to evaluate were deleted
+	if len(toolCalls) == 0 {
+		return r.complete(ctx, backfill)
+	}
+
+	var chain *SupervisorChain
+	if backfill.ChainId != nil {
+		chain, err = r.store.GetSupervisorChain(ctx, *backfill.ChainId)
+		if err != nil {
+			return fmt.Errorf("error getting supervisor chain: %w", err)
+		}
+	}
+
+	for _, toolCall := range toolCalls {
+		if !r.lanes.acquire(Batch) {
+			return nil
+		}
+		result, err := evaluateBackfillToolCall(ctx, backfill, chain, toolCall, r.judge, r.store)
+		r.lanes.release(Batch)
+		if err != nil {
+			return err
+		}
+
+		if err := r.store.CreateBackfillResult(ctx, backfill.Id, result); err != nil {
+			return err
+		}
+
+		last, ok := r.last[backfill.Id]
+		next := now
+		if ok && now.Sub(last) < 2*backfillInterval(backfill) {
+			next = last.Add(backfillInterval(backfill))
+		}
+		r.last[backfill.Id] = next
+	}
+	return nil
+}
+
+func (r *BackfillRunner) complete(ctx context.Context, backfill Backfill) error {
+	completed, err := r.store.SetBackfillStatus(ctx, backfill.Id, BackfillRunning, BackfillCompleted)
+	if err != nil {
+		return err
+	}
+	if completed {
+		log.Printf("Backfill %s of project %s completed after evaluating %d tool calls", backfill.Id, backfill.ProjectId, backfill.Evaluated)
+	}
+	return nil
+}
+
+// evaluateBackfillToolCall walks a tool call through the backfill's shadow chain, or else the chains
+// its tool selects, and records what actually happened to it. Calls that can't be evaluated are
+// recorded without a prediction, so one of them doesn't hold up the rest.
+func evaluateBackfillToolCall(ctx context.Context, backfill Backfill, chain *SupervisorChain, toolCall AsteroidToolCall, judge Judge, store Store) (BackfillResult, error) {
+	result := BackfillResult{ToolCallId: toolCall.Id}
+	if toolCall.Name != nil {
+		result.ToolName = *toolCall.Name
+	}
+	if toolCall.CreatedAt != nil {
+		result.CalledAt = *toolCall.CreatedAt
+	}
+
+	actual, err := getToolCallDecision(ctx, toolCall.Id, store)
+	if err != nil {
+		return result, err
+	}
+	result.Actual = actual
+
+	if actual != nil {
+		result.ActuallyEscalated, err = wasEscalated(ctx, toolCall.Id, store)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return result, fmt.Errorf("error getting tool: %w", err)
+	}
+
+	result.EvaluatedAt = time.Now()
+	switch {
+	case tool == nil:
+		result.Reasoning = fmt.Sprintf("Couldn't be evaluated, tool %s not found", toolCall.ToolId)
+	case backfill.ChainId != nil && chain == nil:
+		result.Reasoning = fmt.Sprintf("Couldn't be evaluated, supervisor chain %s not found", *backfill.ChainId)
+	case chain != nil:
+		// A shadow chain is evaluated on its supervisors alone, the project's argument rules aren't tried
+		advice, err := adviseChain(ctx, *chain, *tool, storedToolCallArguments(toolCall), nil, judge, store)
+		if err != nil {
+			result.Reasoning = fmt.Sprintf("Couldn't be evaluated: %v", err)
+			break
+		}
+		result.Predicted = advice.LikelyDecision
+		result.Confidence = advice.Confidence
+		result.Reasoning = advice.Reasoning
+	default:
+		preapproval, err := preapprove(ctx, *tool, storedToolCallArguments(toolCall), judge, store)
+		if err != nil {
+			result.Reasoning = fmt.Sprintf("Couldn't be evaluated: %v", err)
+			break
+		}
+		result.Predicted = &preapproval.LikelyDecision
+		result.Reasoning = fmt.Sprintf("No chain supervises %s", tool.Name)
+		if len(preapproval.Chains) > 0 {
+			reasons := make([]string, 0, len(preapproval.Chains))
+			for _, advice := range preapproval.Chains {
+				reasons = append(reasons, advice.Reasoning)
+			}
+			result.Reasoning = strings.Join(reasons, "; ")
+		}
+	}
+
+	return result, nil
+}
+
+// backfillReport compares what a backfill's shadow chains would have decided to what actually happened
+func backfillReport(backfill Backfill, results []BackfillResult) BackfillReport {
+	report := BackfillReport{
+		BackfillId:  backfill.Id,
+		Status:      backfill.Status,
+		TotalCalls:  backfill.TotalCalls,
+		Evaluated:   backfill.Evaluated,
+		Differences: []BackfillResult{},
+	}
+
+	for _, result := range results {
+		var predictedOutcome Decision
+		if result.Predicted == nil {
+			report.Unpredicted++
+		} else {
+			predictedOutcome = dryRunOutcome(*result.Predicted, false)
+			switch predictedOutcome {
+			case Reject:
+				report.WouldReject++
+			case Escalate:
+				report.WouldEscalate++
+			default:
+				report.WouldApprove++
+			}
+		}
+
+		if result.Actual == nil {
+			report.Undecided++
+			continue
+		}
+
+		actualOutcome := dryRunOutcome(*result.Actual, result.ActuallyEscalated)
+		switch actualOutcome {
+		case Reject:
+			report.ActuallyRejected++
+		case Escalate:
+			report.ActuallyEscalated++
+		default:
+			report.ActuallyApproved++
+		}
+
+		if result.Predicted == nil {
+			continue
+		}
+
+		if actualOutcome == predictedOutcome {
+			report.Agreed++
+			continue
+		}
+
+		switch {
+		case predictedOutcome == Reject:
+			report.NewlyRejected++
+		case predictedOutcome == Escalate && actualOutcome == Approve:
+			report.NewlyEscalated++
+		case actualOutcome == Reject && predictedOutcome == Approve:
+			report.MissedRejections++
+		}
+		report.Differences = append(report.Differences, result)
+	}
+
+	return report
+}
+
+func apiCreateBackfillHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request BackfillRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if !request.From.Before(request.To) {
+		sendErrorResponse(w, http.StatusBadRequest, "from must be before to", "")
+		return
+	}
+
+	rate := defaultBackfillRatePerMinute
+	if request.RatePerMinute != nil {
+		rate = *request.RatePerMinute
+	}
+	if rate < 1 || rate > maxBackfillRatePerMinute {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("rate_per_minute must be between 1 and %d", maxBackfillRatePerMinute), "")
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if request.ChainId != nil {
+		chain, err := store.GetSupervisorChain(ctx, *request.ChainId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor chain", err.Error())
+			return
+		}
+
+		if chain == nil {
+			sendErrorResponse(w, http.StatusNotFound, "Supervisor chain not found", "")
+			return
+		}
+	}
+
+	total, err := store.CountProjectToolCalls(ctx, projectId, request.From, request.To)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error counting tool calls", err.Error())
+		return
+	}
+
+	now := time.Now()
+	backfill := Backfill{
+		Id:            uuid.New(),
+		ProjectId:     projectId,
+		ChainId:       request.ChainId,
+		From:          request.From,
+		To:            request.To,
+		RatePerMinute: rate,
+		Status:        BackfillRunning,
+		TotalCalls:    min(total, maxBackfillToolCalls),
+		Truncated:     total > maxBackfillToolCalls,
+		CreatedBy:     actorFromContext(ctx),
+		CreatedAt:     now,
+	}
+
+	// There's nothing to evaluate in an empty range
+	if backfill.TotalCalls == 0 {
+		backfill.Status = BackfillCompleted
+		backfill.CompletedAt = &now
+	}
+
+	if err := store.CreateBackfill(ctx, backfill); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating backfill", err.Error())
+		return
+	}
+
+	respondJSON(w, backfill, http.StatusCreated)
+}
+
+func apiGetProjectBackfillsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	backfills, err := store.GetProjectBackfills(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting backfills", err.Error())
+		return
+	}
+
+	respondJSON(w, backfills, http.StatusOK)
+}
+
+func apiGetBackfillHandler(w http.ResponseWriter, r *http.Request, backfillId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	backfill, err := store.GetBackfill(ctx, backfillId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting backfill", err.Error())
+		return
+	}
+
+	if backfill == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Backfill not found", "")
+		return
+	}
+
+	respondJSON(w, backfill, http.StatusOK)
+}
+
+// apiSetBackfillStatusHandler moves a backfill between running and paused
+func apiSetBackfillStatusHandler(w http.ResponseWriter, r *http.Request, backfillId uuid.UUID, from BackfillStatus, to BackfillStatus, store Store) {
+	ctx := r.Context()
+
+	backfill, err := store.GetBackfill(ctx, backfillId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting backfill", err.Error())
+		return
+	}
+
+	if backfill == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Backfill not found", "")
+		return
+	}
+
+	updated, err := store.SetBackfillStatus(ctx, backfillId, from, to)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting backfill status", err.Error())
+		return
+	}
+
+	if !updated {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("backfill is %s, not %s", backfill.Status, from), "")
+		return
+	}
+
+	backfill, err = store.GetBackfill(ctx, backfillId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting backfill", err.Error())
+		return
+	}
+
+	respondJSON(w, backfill, http.StatusOK)
+}
+
+func apiGetBackfillReportHandler(w http.ResponseWriter, r *http.Request, backfillId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	backfill, err := store.GetBackfill(ctx, backfillId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting backfill", err.Error())
+		return
+	}
+
+	if backfill == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Backfill not found", "")
+		return
+	}
+
+	results, err := store.GetBackfillResults(ctx, backfillId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting backfill results", err.Error())
+		return
+	}
+
+	respondJSON(w, backfillReport(*backfill, results), http.StatusOK)
+}
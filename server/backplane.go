@@ -0,0 +1,214 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// reviewClaimTTL is how long a review stays claimed by the replica assigning it. Offers of the review
+// repeated meanwhile, while its status isn't assigned yet, can't be taken by another replica.
+const reviewClaimTTL = 10 * time.Second
+
+// Backplane carries the hub's messages between replicas, so reviewers can connect to any of them
+type Backplane interface {
+	Publish(ctx context.Context, message []byte) error
+	// Subscribe calls handle with every message published, by any replica, until the context is done
+	Subscribe(ctx context.Context, handle func(message []byte))
+	// Claim reports whether this replica was the first to claim a key, which stays claimed for a while
+	Claim(ctx context.Context, key string, ttl time.Duration) (bool, error)
+}
+
+// NewBackplaneFromEnv connects to the Redis server at REDIS_URL and shares hub messages on the
+// REDIS_HUB_CHANNEL channel, sentinel:hub unless set. Returns nil if REDIS_URL isn't set, which
+// leaves each replica's hub on its own.
+func NewBackplaneFromEnv() (Backplane, error) {
+	redisURL := os.Getenv("REDIS_URL")
+	if redisURL == "" {
+		return nil, nil
+	}
+
+	client, err := newRedisClient(redisURL)
+	if err != nil {
+		return nil, err
+	}
+
+	channel := os.Getenv("REDIS_HUB_CHANNEL")
+	if channel == "" {
+		channel = "sentinel:hub"
+	}
+
+	return &redisBackplane{client: client, channel: channel}, nil
+}
+
+type redisBackplane struct {
+	client  *redisClient
+	channel string
+}
+
+func (b *redisBackplane) Publish(ctx context.Context, message []byte) error {
+	return b.client.publish(ctx, b.channel, message)
+}
+
+// Subscribe resubscribes whenever the connection is lost, waiting longer each time it fails in a row.
+// Messages published while it's disconnected are lost.
+func (b *redisBackplane) Subscribe(ctx context.Context, handle func(message []byte)) {
+	backoff := time.Second
+	for {
+		start := time.Now()
+		err := b.client.subscribe(ctx, b.channel, handle)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Lost subscription to hub backplane channel %s, resubscribing in %s: %v", b.channel, backoff, err)
+
+		if time.Since(start) > time.Minute {
+			backoff = time.Second
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, 30*time.Second)
+	}
+}
+
+func (b *redisBackplane) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return b.client.setNX(ctx, b.channel+":claim:"+key, "1", ttl)
+}
+
+type hubMessageKind string
+
+const (
+	// offerMessage offers a review no session of its replica had capacity for to the other replicas
+	offerMessage hubMessageKind = "offer"
+	// releaseMessage takes a review from the sessions it's assigned to, sending them the event
+	releaseMessage hubMessageKind = "release"
+	// resolvedMessage tells the other connections of a session that one of them decided a review
+	resolvedMessage hubMessageKind = "resolved"
+	// batchMessage tells the sessions following any of the event's reviews they were decided together
+	batchMessage hubMessageKind = "batch"
+	// remindMessage reminds the session a review is assigned to of it
+	remindMessage hubMessageKind = "remind"
+	// remindQueueMessage reminds every session following a review of it
+	remindQueueMessage hubMessageKind = "remind_queue"
+	// reassignMessage moves a review to a session
+	reassignMessage hubMessageKind = "reassign"
+	// decidedMessage tells the watchers of a tool call one of its supervision requests was resolved
+	decidedMessage hubMessageKind = "decided"
+)
+
+// hubMessage is something a replica's hub did that the hubs of the other replicas do too, for the
+// reviewers connected to them
+type hubMessage struct {
+	// Origin is the instance ID of the replica that sent the message
+	Origin             string              `json:"origin"`
+	Kind               hubMessageKind      `json:"kind"`
+	Event              *ReviewEvent        `json:"event,omitempty"`
+	SupervisionRequest *SupervisionRequest `json:"supervision_request,omitempty"`
+	Session            string              `json:"session,omitempty"`
+	ToolCallId         *uuid.UUID          `json:"tool_call_id,omitempty"`
+}
+
+// UseBackplane makes the hub share what it does with the hubs of other replicas, and do what they
+// share. Must be called before any client connects.
+func (h *Hub) UseBackplane(ctx context.Context, backplane Backplane, instance string) {
+	h.backplane = backplane
+	h.instance = instance
+	go backplane.Subscribe(ctx, h.receive)
+	log.Printf("Sharing hub messages with other replicas as instance %s", instance)
+}
+
+// publish shares a message with the other replicas. A message that can't be published is lost to
+// them, and only logged.
+func (h *Hub) publish(message hubMessage) {
+	if h.backplane == nil {
+		return
+	}
+
+	message.Origin = h.instance
+	data, err := json.Marshal(message)
+	if err != nil {
+		log.Printf("Error marshalling %s hub message: %v", message.Kind, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+	if err := h.backplane.Publish(ctx, data); err != nil {
+		log.Printf("Error publishing %s hub message: %v", message.Kind, err)
+	}
+}
+
+// receive does what another replica's hub shared. Messages of this replica were already done when
+// they were published.
+func (h *Hub) receive(data []byte) {
+	var message hubMessage
+	if err := json.Unmarshal(data, &message); err != nil {
+		log.Printf("Error unmarshalling hub message: %v", err)
+		return
+	}
+	if message.Origin == h.instance {
+		return
+	}
+
+	switch message.Kind {
+	case offerMessage:
+		if message.SupervisionRequest != nil && message.SupervisionRequest.Id != nil {
+			h.assignReview(*message.SupervisionRequest)
+		}
+	case releaseMessage:
+		if message.Event != nil {
+			h.releaseAssignedReviewLocally(*message.Event)
+		}
+	case resolvedMessage:
+		if message.Event != nil {
+			h.notifySessionLocally(message.Session, *message.Event, nil)
+		}
+	case batchMessage:
+		if message.Event != nil {
+			h.resolveBatchLocally(message.Event.RequestIds, message.Event.Decision)
+		}
+	case remindMessage:
+		if message.Event != nil {
+			h.remindAssignedReviewLocally(message.Event.RequestId)
+		}
+	case remindQueueMessage:
+		if message.Event != nil {
+			h.remindQueueLocally(message.Event.RequestId)
+		}
+	case reassignMessage:
+		if message.SupervisionRequest != nil && message.SupervisionRequest.Id != nil {
+			h.reassignReviewLocally(*message.SupervisionRequest, message.Session, false)
+		}
+	case decidedMessage:
+		if message.ToolCallId != nil {
+			h.toolCallResolvedLocally(*message.ToolCallId)
+		}
+	default:
+		log.Printf("Ignoring hub message of unknown kind %s from instance %s", message.Kind, message.Origin)
+	}
+}
+
+// claimReview reports whether this replica may assign a review. Without a backplane it always may,
+// otherwise the first replica to claim it does.
+func (h *Hub) claimReview(requestId uuid.UUID) bool {
+	if h.backplane == nil {
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+	claimed, err := h.backplane.Claim(ctx, fmt.Sprintf("review:%s", requestId), reviewClaimTTL)
+	if err != nil {
+		log.Printf("Error claiming review %s, leaving it pending: %v", requestId, err)
+		return false
+	}
+	return claimed
+}
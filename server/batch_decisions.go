@@ -0,0 +1,254 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxBatchDecisions is how many tool calls can be decided in one batch
+const maxBatchDecisions = 100
+
+// batchReviewStatuses are the statuses of reviews a batch decides. Reviews waiting for the agent to
+// answer a question are left to the reviewer who asked it.
+var batchReviewStatuses = []Status{Pending, Assigned}
+
+// getWaitingHumanReviews returns the supervision requests of a tool call that wait for a human
+// supervisor's decision
+func getWaitingHumanReviews(ctx context.Context, toolCallId uuid.UUID, store Store) ([]SupervisionRequest, error) {
+	chainExecutions, err := store.GetChainExecutionsFromToolCall(ctx, toolCallId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chain executions: %w", err)
+	}
+
+	reviews := make([]SupervisionRequest, 0)
+	for _, execution := range chainExecutions {
+		state, err := store.GetChainExecutionState(ctx, execution)
+		if err != nil {
+			return nil, fmt.Errorf("error getting chain state: %w", err)
+		}
+
+		humans := make(map[uuid.UUID]bool)
+		for _, supervisor := range state.Chain.Supervisors {
+			if supervisor.Type == HumanSupervisor && supervisor.Id != nil {
+				humans[*supervisor.Id] = true
+			}
+		}
+
+		for _, request := range state.SupervisionRequests {
+			if request.Result != nil || !humans[request.SupervisionRequest.SupervisorId] {
+				continue
+			}
+			for _, status := range batchReviewStatuses {
+				if request.Status.Status == status {
+					reviews = append(reviews, request.SupervisionRequest)
+					break
+				}
+			}
+		}
+	}
+	return reviews, nil
+}
+
+func apiBatchDecideToolCallsHandler(w http.ResponseWriter, r *http.Request, store Store, hub *Hub) {
+	ctx := r.Context()
+
+	var request BatchDecisionRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if len(request.ToolCallIds) == 0 {
+		sendErrorResponse(w, http.StatusBadRequest, "tool_call_ids must not be empty", "")
+		return
+	}
+
+	if len(request.ToolCallIds) > maxBatchDecisions {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("at most %d tool calls can be decided at once", maxBatchDecisions), "")
+		return
+	}
+
+	switch request.Decision {
+	case Approve, Reject, Terminate, Escalate:
+	case Modify:
+		sendErrorResponse(w, http.StatusBadRequest, "modify needs modified arguments for each tool call, so it can't be batched", "")
+		return
+	default:
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown decision: %s", request.Decision), "")
+		return
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	for _, toolCallId := range request.ToolCallIds {
+		if seen[toolCallId] {
+			sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Tool call %s is listed more than once", toolCallId), "")
+			return
+		}
+		seen[toolCallId] = true
+	}
+
+	// Check every tool call before deciding any, so a batch is decided whole or not at all
+	now := time.Now()
+	userId := userFromContext(ctx)
+	results := make([]SupervisionResult, 0, len(request.ToolCallIds))
+	decisions := make([]BatchDecision, 0, len(request.ToolCallIds))
+	for _, toolCallId := range request.ToolCallIds {
+		toolCall, err := store.GetToolCall(ctx, toolCallId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
+			return
+		}
+
+		if toolCall == nil {
+			sendErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Tool call %s not found", toolCallId), "")
+			return
+		}
+
+		tool, err := store.GetTool(ctx, toolCall.ToolId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting tool", err.Error())
+			return
+		}
+
+		if tool == nil {
+			sendErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Tool of tool call %s not found", toolCallId), "")
+			return
+		}
+
+		reviews, err := getWaitingHumanReviews(ctx, toolCallId, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting reviews", err.Error())
+			return
+		}
+
+		if len(reviews) == 0 {
+			sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("Tool call %s has no review waiting for a human supervisor", toolCallId), "")
+			return
+		}
+
+		rejected, undecided, err := checkToolCallDependencies(ctx, toolCallId, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error checking tool call dependencies", err.Error())
+			return
+		}
+
+		if rejected != nil {
+			sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("Tool call %s was rejected because it depends on rejected tool call %s", toolCallId, *rejected), "")
+			return
+		}
+
+		if len(undecided) > 0 {
+			sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("Tool call %s depends on tool call %s, which has not been decided yet", toolCallId, undecided[0]), "")
+			return
+		}
+
+		// Approvals are held while the organization's kill switch is active
+		if holdsDecision(request.Decision) {
+			project, err := getProjectForToolCall(ctx, toolCallId, store)
+			if err != nil {
+				sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+				return
+			}
+
+			killSwitch, err := getActiveKillSwitch(ctx, project, store)
+			if err != nil {
+				sendErrorResponse(w, http.StatusInternalServerError, "error getting kill switch", err.Error())
+				return
+			}
+
+			if killSwitch != nil {
+				sendHaltedResponse(w, killSwitch)
+				return
+			}
+		}
+
+		pause, err := store.GetRunPause(ctx, tool.RunId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting run pause", err.Error())
+			return
+		}
+
+		if pause != nil {
+			sendRunPausedResponse(w, pause)
+			return
+		}
+
+		for _, review := range reviews {
+			claim, err := claimedByOther(ctx, *review.Id, userId, store)
+			if err != nil {
+				sendErrorResponse(w, http.StatusInternalServerError, "error getting review claim", err.Error())
+				return
+			}
+
+			if claim != nil {
+				sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("Tool call %s has a review claimed by user %s", toolCallId, claim.UserId), "")
+				return
+			}
+
+			result := SupervisionResult{
+				CreatedAt:            now,
+				Decision:             request.Decision,
+				Reasoning:            request.Reasoning,
+				SupervisionRequestId: *review.Id,
+				UserId:               userId,
+				Precedents:           request.Precedents,
+			}
+			if err := checkPrecedents(ctx, *review.Id, &result, store); err != nil {
+				if errors.Is(err, ErrInvalidPrecedent) {
+					sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid precedents for tool call %s", toolCallId), err.Error())
+					return
+				}
+				sendErrorResponse(w, http.StatusInternalServerError, "error checking precedents", err.Error())
+				return
+			}
+			if request.Decision == Approve {
+				result.ToolcallId = &toolCallId
+			}
+			results = append(results, result)
+			decisions = append(decisions, BatchDecision{ToolCallId: toolCallId, SupervisionRequestId: *review.Id})
+		}
+	}
+
+	ids, err := resolveSupervisionRequests(ctx, results, actorFromContext(ctx), store)
+	if errors.Is(err, ErrSupervisionRequestResolved) {
+		sendErrorResponse(w, http.StatusConflict, "a review was decided while the batch was applied, so none of the tool calls were decided", "")
+		return
+	}
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating supervision results", err.Error())
+		return
+	}
+
+	requestIds := make([]uuid.UUID, 0, len(decisions))
+	for i := range decisions {
+		decisions[i].ResultId = ids[i]
+		requestIds = append(requestIds, decisions[i].SupervisionRequestId)
+	}
+
+	if hub != nil {
+		hub.resolveBatch(requestIds, request.Decision)
+	}
+
+	// Rejecting the tool calls rejects everything depending on them too
+	if request.Decision == Reject || request.Decision == Terminate {
+		for _, toolCallId := range request.ToolCallIds {
+			decision, err := getToolCallDecision(ctx, toolCallId, store)
+			if err != nil {
+				log.Printf("Error getting decision for tool call %s: %v", toolCallId, err)
+			} else if decision != nil && (*decision == Reject || *decision == Terminate) {
+				if err := propagateRejection(ctx, toolCallId, store); err != nil {
+					log.Printf("Error propagating rejection of tool call %s: %v", toolCallId, err)
+				}
+			}
+		}
+	}
+
+	respondJSON(w, decisions, http.StatusCreated)
+}
@@ -0,0 +1,145 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	// destructiveSQLPattern finds statements that can't be undone once committed
+	destructiveSQLPattern = regexp.MustCompile(`(?i)\b(drop|truncate|delete\s+from)\b`)
+
+	irreversibleVerbs = map[string]bool{
+		"delete": true, "remove": true, "rm": true, "drop": true, "truncate": true, "destroy": true,
+		"terminate": true, "purge": true, "wipe": true, "send": true, "email": true, "transfer": true,
+		"pay": true, "charge": true, "refund": true, "publish": true, "post": true,
+	}
+	reversibleVerbs = map[string]bool{
+		"get": true, "list": true, "read": true, "search": true, "fetch": true, "describe": true,
+		"view": true, "show": true, "find": true, "lookup": true, "query": true,
+	}
+)
+
+// toolNameWords splits a tool name like deleteFile, delete_file or delete-file into lowercase words
+func toolNameWords(name string) []string {
+	var words []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			words = append(words, strings.ToLower(word.String()))
+			word.Reset()
+		}
+	}
+	for _, r := range name {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r):
+			flush()
+			word.WriteRune(r)
+		default:
+			word.WriteRune(r)
+		}
+	}
+	flush()
+	return words
+}
+
+// assessReversibility guesses whether a tool call can be undone. A tool's reversible attribute
+// decides, otherwise destructive SQL in the arguments or the verbs in the tool's name.
+func assessReversibility(tool Tool, arguments string) (Reversibility, string) {
+	if reversible, ok := tool.Attributes["reversible"].(bool); ok {
+		if reversible {
+			return Reversible, fmt.Sprintf("tool %s is declared reversible", tool.Name)
+		}
+		return Irreversible, fmt.Sprintf("tool %s is declared irreversible", tool.Name)
+	}
+
+	if m := destructiveSQLPattern.FindString(arguments); m != "" {
+		return Irreversible, fmt.Sprintf("arguments contain destructive SQL (%s)", strings.ToUpper(m))
+	}
+
+	words := toolNameWords(tool.Name)
+	for _, word := range words {
+		if irreversibleVerbs[word] {
+			return Irreversible, fmt.Sprintf("tool %s looks like it can %s", tool.Name, word)
+		}
+	}
+	for _, word := range words {
+		if reversibleVerbs[word] {
+			return Reversible, fmt.Sprintf("tool %s looks read only", tool.Name)
+		}
+	}
+
+	return Unknown, fmt.Sprintf("nothing is known about whether tool %s can be undone", tool.Name)
+}
+
+// summarizeBlastRadius describes a blast radius in a sentence for reviewers
+func summarizeBlastRadius(blastRadius BlastRadius) string {
+	if len(blastRadius.Resources) == 0 {
+		return fmt.Sprintf("No resources found in the arguments, %s", blastRadius.ReversibilityReason)
+	}
+
+	var rejections, incidents int
+	for _, resource := range blastRadius.Resources {
+		rejections += resource.PriorRejections
+		incidents += resource.PriorIncidents
+	}
+
+	summary := fmt.Sprintf("Touches %d resource(s), %s", len(blastRadius.Resources), blastRadius.ReversibilityReason)
+	if rejections > 0 {
+		summary += fmt.Sprintf(", %d earlier tool call(s) on them were rejected", rejections)
+	}
+	if incidents > 0 {
+		summary += fmt.Sprintf(", they were touched during %d incident(s)", incidents)
+	}
+	return summary
+}
+
+// getBlastRadius estimates what a tool call could affect from the resources in its arguments and
+// the project's history with them
+func getBlastRadius(ctx context.Context, toolCall AsteroidToolCall, tool Tool, store Store) (*BlastRadius, error) {
+	project, err := getProjectForRun(ctx, tool.RunId, store)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, nil
+	}
+
+	var arguments string
+	if toolCall.Arguments != nil {
+		arguments = *toolCall.Arguments
+	}
+
+	blastRadius := BlastRadius{Resources: make([]BlastRadiusResource, 0)}
+	blastRadius.Reversibility, blastRadius.ReversibilityReason = assessReversibility(tool, arguments)
+
+	blastRadius.RiskTier, err = getToolRiskTier(ctx, tool, store)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[resourceMatch]bool)
+	for _, reference := range getResourceReferences(tool.RunId, toolCall, time.Time{}) {
+		match := resourceMatch{kind: reference.Kind, identifier: reference.Identifier}
+		if seen[match] {
+			continue
+		}
+		seen[match] = true
+
+		resource, err := store.GetResourceHistory(ctx, project.Id, reference.Kind, reference.Identifier, toolCall.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting history of %s: %w", reference.Identifier, err)
+		}
+		blastRadius.Resources = append(blastRadius.Resources, *resource)
+	}
+
+	blastRadius.Summary = summarizeBlastRadius(blastRadius)
+
+	return &blastRadius, nil
+}
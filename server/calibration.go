@@ -0,0 +1,133 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// calibrationBuckets is how many equal ranges of confidence calibration is reported in
+const calibrationBuckets = 10
+
+// ConfidenceOutcome is a decision a supervisor made with some confidence, and what the humans
+// after it in the chain finally decided
+type ConfidenceOutcome struct {
+	Confidence    float64
+	Decision      Decision
+	HumanDecision Decision
+}
+
+// validateMinConfidence checks that a chain's confidence threshold is a probability
+func validateMinConfidence(chain ChainRequest) error {
+	if chain.MinConfidence != nil && (*chain.MinConfidence < 0 || *chain.MinConfidence > 1) {
+		return fmt.Errorf("min_confidence must be between 0 and 1, got %v", *chain.MinConfidence)
+	}
+	return nil
+}
+
+// getSupervisionRequestChain returns a supervision request with the chain it's part of, at the version
+// its execution started with, or a nil chain if either can't be found
+func getSupervisionRequestChain(ctx context.Context, supervisionRequestId uuid.UUID, store Store) (*SupervisionRequest, *SupervisorChain, error) {
+	supervisionRequest, err := store.GetSupervisionRequest(ctx, supervisionRequestId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting supervision request: %w", err)
+	}
+	if supervisionRequest == nil || supervisionRequest.ChainexecutionId == nil {
+		return nil, nil, nil
+	}
+
+	chain, err := store.GetChainExecutionChain(ctx, *supervisionRequest.ChainexecutionId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting supervisor chain: %w", err)
+	}
+
+	return supervisionRequest, chain, nil
+}
+
+// applyConfidenceThreshold escalates the result of an automated supervisor whose confidence is below
+// its chain's min_confidence, or that gave none, keeping the decision it gave. The last supervisor
+// of a chain has no one to escalate to, so its results stand.
+func applyConfidenceThreshold(ctx context.Context, supervisionRequestId uuid.UUID, supervisor Supervisor, result *SupervisionResult, store Store) error {
+	result.OverriddenDecision = nil
+	if !isAutomated(supervisor.Type) || result.Decision == Escalate {
+		return nil
+	}
+
+	supervisionRequest, chain, err := getSupervisionRequestChain(ctx, supervisionRequestId, store)
+	if err != nil {
+		return err
+	}
+	if chain == nil || chain.MinConfidence == nil || supervisionRequest.PositionInChain >= len(chain.Supervisors)-1 {
+		return nil
+	}
+
+	if result.Explanation != nil && result.Explanation.Confidence != nil && *result.Explanation.Confidence >= *chain.MinConfidence {
+		return nil
+	}
+
+	decision := result.Decision
+	result.OverriddenDecision = &decision
+	result.Decision = Escalate
+	return nil
+}
+
+// calibrate buckets the outcomes of a supervisor's decisions by confidence
+func calibrate(supervisorId uuid.UUID, outcomes []ConfidenceOutcome) ConfidenceCalibration {
+	calibration := ConfidenceCalibration{
+		SupervisorId: supervisorId,
+		Results:      len(outcomes),
+		Buckets:      make([]ConfidenceBucket, calibrationBuckets),
+	}
+
+	width := 1.0 / calibrationBuckets
+	for i := range calibration.Buckets {
+		calibration.Buckets[i].MinConfidence = float64(i) * width
+		calibration.Buckets[i].MaxConfidence = float64(i+1) * width
+	}
+
+	for _, outcome := range outcomes {
+		// A confidence of 1 belongs to the last bucket
+		i := min(max(int(outcome.Confidence/width), 0), calibrationBuckets-1)
+		bucket := &calibration.Buckets[i]
+		bucket.Results++
+		bucket.MeanConfidence += outcome.Confidence
+		if outcome.Decision == outcome.HumanDecision {
+			bucket.Agreed++
+		}
+	}
+
+	for i := range calibration.Buckets {
+		bucket := &calibration.Buckets[i]
+		if bucket.Results > 0 {
+			bucket.MeanConfidence /= float64(bucket.Results)
+			bucket.AgreementRate = float64(bucket.Agreed) / float64(bucket.Results)
+		}
+	}
+
+	return calibration
+}
+
+func apiGetSupervisorCalibrationHandler(w http.ResponseWriter, r *http.Request, supervisorId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	supervisor, err := store.GetSupervisor(ctx, supervisorId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor", err.Error())
+		return
+	}
+
+	if supervisor == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervisor not found", "")
+		return
+	}
+
+	outcomes, err := store.GetConfidenceOutcomes(ctx, supervisorId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting confidence outcomes", err.Error())
+		return
+	}
+
+	respondJSON(w, calibrate(supervisorId, outcomes), http.StatusOK)
+}
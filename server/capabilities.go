@@ -0,0 +1,161 @@
+package asteroid
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+)
+
+// specEnum returns the values of an enum of the OpenAPI spec, which is what the server was built
+// with, so values added to the spec are announced without listing them again here
+func specEnum[T ~string](name string) ([]T, error) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("error loading OpenAPI spec: %w", err)
+	}
+
+	schema, ok := swagger.Components.Schemas[name]
+	if !ok || schema.Value == nil {
+		return nil, fmt.Errorf("no %s schema in the OpenAPI spec", name)
+	}
+
+	values := make([]T, 0, len(schema.Value.Enum))
+	for _, value := range schema.Value.Enum {
+		if s, ok := value.(string); ok {
+			values = append(values, T(s))
+		}
+	}
+	return values, nil
+}
+
+// getServerCapabilities returns what this server supports
+func getServerCapabilities() (*ServerCapabilities, error) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("error loading OpenAPI spec: %w", err)
+	}
+
+	capabilities := ServerCapabilities{
+		ApiVersion: swagger.Info.Version,
+		Transports: []Transport{Http, Websocket, Sse},
+	}
+	if os.Getenv("GRPC_PORT") != "" {
+		capabilities.Transports = append(capabilities.Transports, Grpc)
+	}
+
+	if capabilities.SupervisorTypes, err = specEnum[SupervisorType]("SupervisorType"); err != nil {
+		return nil, err
+	}
+	if capabilities.Decisions, err = specEnum[Decision]("Decision"); err != nil {
+		return nil, err
+	}
+	if capabilities.VerdictBehaviors, err = specEnum[VerdictBehavior]("VerdictBehavior"); err != nil {
+		return nil, err
+	}
+	if capabilities.ChatFormats, err = specEnum[ChatFormat]("ChatFormat"); err != nil {
+		return nil, err
+	}
+
+	return &capabilities, nil
+}
+
+// majorVersion returns the major version of a version like v1.2.3
+func majorVersion(version string) string {
+	major, _, _ := strings.Cut(strings.TrimPrefix(version, "v"), ".")
+	return major
+}
+
+// unsupported returns the values an SDK uses that the server doesn't support
+func unsupported[T ~string](kind CapabilityKind, used *[]string, supported []T, name string) []CapabilityIncompatibility {
+	incompatibilities := make([]CapabilityIncompatibility, 0)
+	if used == nil {
+		return incompatibilities
+	}
+	for _, value := range *used {
+		if !slices.Contains(supported, T(value)) {
+			incompatibilities = append(incompatibilities, CapabilityIncompatibility{
+				Capability: kind,
+				Value:      value,
+				Reason:     fmt.Sprintf("the SDK uses %s %s, which the server doesn't support", name, value),
+			})
+		}
+	}
+	return incompatibilities
+}
+
+// unhandled returns the values the server can answer with that an SDK can't act on
+func unhandled[T ~string](kind CapabilityKind, handled *[]string, answered []T, name string) []CapabilityIncompatibility {
+	incompatibilities := make([]CapabilityIncompatibility, 0)
+	if handled == nil {
+		return incompatibilities
+	}
+	for _, value := range answered {
+		if !slices.Contains(*handled, string(value)) {
+			incompatibilities = append(incompatibilities, CapabilityIncompatibility{
+				Capability: kind,
+				Value:      string(value),
+				Reason:     fmt.Sprintf("the server can answer with %s %s, which the SDK can't act on", name, value),
+			})
+		}
+	}
+	return incompatibilities
+}
+
+// negotiateCapabilities checks an SDK's capabilities against the server's
+func negotiateCapabilities(client ClientCapabilities, server ServerCapabilities) CapabilityNegotiation {
+	incompatibilities := make([]CapabilityIncompatibility, 0)
+
+	if client.ApiVersion != nil && majorVersion(*client.ApiVersion) != majorVersion(server.ApiVersion) {
+		incompatibilities = append(incompatibilities, CapabilityIncompatibility{
+			Capability: ApiVersionCapability,
+			Value:      *client.ApiVersion,
+			Reason:     fmt.Sprintf("the SDK was built against API %s, the server serves %s", *client.ApiVersion, server.ApiVersion),
+		})
+	}
+
+	incompatibilities = append(incompatibilities, unsupported(SupervisorTypeCapability, client.SupervisorTypes, server.SupervisorTypes, "supervisor type")...)
+	incompatibilities = append(incompatibilities, unsupported(ChatFormatCapability, client.ChatFormats, server.ChatFormats, "chat format")...)
+	incompatibilities = append(incompatibilities, unhandled(DecisionCapability, client.Decisions, server.Decisions, "decision")...)
+	incompatibilities = append(incompatibilities, unhandled(VerdictBehaviorCapability, client.VerdictBehaviors, server.VerdictBehaviors, "verdict behavior")...)
+
+	return CapabilityNegotiation{
+		Server:            server,
+		Compatible:        len(incompatibilities) == 0,
+		Incompatibilities: incompatibilities,
+	}
+}
+
+func apiGetCapabilitiesHandler(w http.ResponseWriter, r *http.Request) {
+	capabilities, err := getServerCapabilities()
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting server capabilities", err.Error())
+		return
+	}
+
+	respondJSON(w, capabilities, http.StatusOK)
+}
+
+func apiNegotiateCapabilitiesHandler(w http.ResponseWriter, r *http.Request) {
+	var client ClientCapabilities
+	if err := json.NewDecoder(r.Body).Decode(&client); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	capabilities, err := getServerCapabilities()
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting server capabilities", err.Error())
+		return
+	}
+
+	negotiation := negotiateCapabilities(client, *capabilities)
+	if !negotiation.Compatible {
+		log.Printf("SDK %s %s is incompatible with the server: %d incompatibilities", client.Sdk, client.SdkVersion, len(negotiation.Incompatibilities))
+	}
+
+	respondJSON(w, negotiation, http.StatusOK)
+}
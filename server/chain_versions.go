@@ -0,0 +1,256 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+const chainResource = "chain"
+
+// ErrChainVersionConflict is returned when a version of a chain is made from one that's no longer
+// its current version
+var ErrChainVersionConflict = errors.New("chain was edited since it was read")
+
+// getToolChain returns a chain of a tool at its current version, sending a 404 and returning nil if
+// the tool doesn't exist or doesn't have the chain
+func getToolChain(ctx context.Context, w http.ResponseWriter, toolId uuid.UUID, chainId uuid.UUID, store Store) *SupervisorChain {
+	tool, err := store.GetTool(ctx, toolId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool", err.Error())
+		return nil
+	}
+
+	if tool == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool not found", "")
+		return nil
+	}
+
+	chains, err := store.GetSupervisorChains(ctx, toolId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor chains", err.Error())
+		return nil
+	}
+
+	for _, chain := range chains {
+		if chain.ChainId == chainId {
+			return &chain
+		}
+	}
+
+	sendErrorResponse(w, http.StatusNotFound, "Supervisor chain not found", "")
+	return nil
+}
+
+// chainSupervisorIds returns the IDs of a chain's supervisors, in order
+func chainSupervisorIds(chain SupervisorChain) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(chain.Supervisors))
+	for _, supervisor := range chain.Supervisors {
+		if supervisor.Id != nil {
+			ids = append(ids, *supervisor.Id)
+		}
+	}
+	return ids
+}
+
+// editChain makes the next version of a chain with supervisors in order, keeping the timeouts and
+// tiers of the supervisors that stay, and responds with the chain at the new version
+func editChain(w http.ResponseWriter, r *http.Request, chain SupervisorChain, supervisorIds []uuid.UUID, timeouts []SupervisorTimeout, tiers []SupervisorTier, store Store) {
+	ctx := r.Context()
+
+	kept := make([]SupervisorTimeout, 0, len(timeouts))
+	for _, timeout := range timeouts {
+		if slices.Contains(supervisorIds, timeout.SupervisorId) {
+			kept = append(kept, timeout)
+		}
+	}
+	keptTiers := make([]SupervisorTier, 0, len(tiers))
+	for _, tier := range tiers {
+		if slices.Contains(supervisorIds, tier.SupervisorId) {
+			keptTiers = append(keptTiers, tier)
+		}
+	}
+
+	// Reordering or removing can leave a supervisor that escalates on timeout at the end of the chain,
+	// and reordering or inserting can split a tier
+	request := ChainRequest{SupervisorIds: &supervisorIds, Timeout: chain.Timeout, SupervisorTimeouts: &kept, SupervisorTiers: &keptTiers}
+	if err := validateChainTimeouts(request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid supervisor chain", err.Error())
+		return
+	}
+	if err := validateChainTiers(request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid supervisor chain", err.Error())
+		return
+	}
+
+	version := chain.Version + 1
+	err := store.CreateSupervisorChainVersion(ctx, chain.ChainId, version, supervisorIds, kept, keptTiers)
+	if errors.Is(err, ErrChainVersionConflict) {
+		sendErrorResponse(w, http.StatusConflict, "Supervisor chain was edited meanwhile", err.Error())
+		return
+	}
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error editing supervisor chain", err.Error())
+		return
+	}
+
+	recordAuditEvent(ctx, actorFromContext(ctx), AuditActionChainEdited, chainResource, chain.ChainId, map[string]interface{}{
+		"from_version":   chain.Version,
+		"version":        version,
+		"supervisor_ids": supervisorIds,
+	}, store)
+
+	edited, err := store.GetSupervisorChainVersion(ctx, chain.ChainId, version)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor chain", err.Error())
+		return
+	}
+
+	respondJSON(w, edited, http.StatusOK)
+}
+
+func chainTimeouts(chain SupervisorChain) []SupervisorTimeout {
+	if chain.SupervisorTimeouts == nil {
+		return nil
+	}
+	return *chain.SupervisorTimeouts
+}
+
+func chainTierList(chain SupervisorChain) []SupervisorTier {
+	if chain.SupervisorTiers == nil {
+		return nil
+	}
+	return *chain.SupervisorTiers
+}
+
+func apiInsertChainSupervisorHandler(w http.ResponseWriter, r *http.Request, toolId uuid.UUID, chainId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var insertion ChainSupervisorInsertion
+	if err := json.NewDecoder(r.Body).Decode(&insertion); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	chain := getToolChain(ctx, w, toolId, chainId, store)
+	if chain == nil {
+		return
+	}
+
+	supervisor, err := store.GetSupervisor(ctx, insertion.SupervisorId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor", err.Error())
+		return
+	}
+
+	if supervisor == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervisor not found", "")
+		return
+	}
+
+	supervisorIds := chainSupervisorIds(*chain)
+	if slices.Contains(supervisorIds, insertion.SupervisorId) {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Supervisor %s is already in chain %s", insertion.SupervisorId, chainId), "")
+		return
+	}
+
+	position := len(supervisorIds)
+	if insertion.Position != nil {
+		if *insertion.Position < 0 || *insertion.Position > len(supervisorIds) {
+			sendErrorResponse(w, http.StatusBadRequest, "invalid position", fmt.Sprintf("position must be between 0 and %d", len(supervisorIds)))
+			return
+		}
+		position = *insertion.Position
+	}
+
+	timeouts := chainTimeouts(*chain)
+	if insertion.Timeout != nil {
+		timeouts = append(slices.Clone(timeouts), SupervisorTimeout{
+			SupervisorId:   insertion.SupervisorId,
+			TimeoutSeconds: insertion.Timeout.TimeoutSeconds,
+			Fallback:       insertion.Timeout.Fallback,
+		})
+	}
+
+	tiers := chainTierList(*chain)
+	if insertion.Tier != nil {
+		tiers = append(slices.Clone(tiers), SupervisorTier{SupervisorId: insertion.SupervisorId, Tier: *insertion.Tier})
+	}
+
+	editChain(w, r, *chain, slices.Insert(supervisorIds, position, insertion.SupervisorId), timeouts, tiers, store)
+}
+
+func apiRemoveChainSupervisorHandler(w http.ResponseWriter, r *http.Request, toolId uuid.UUID, chainId uuid.UUID, supervisorId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	chain := getToolChain(ctx, w, toolId, chainId, store)
+	if chain == nil {
+		return
+	}
+
+	supervisorIds := chainSupervisorIds(*chain)
+	position := slices.Index(supervisorIds, supervisorId)
+	if position < 0 {
+		sendErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Supervisor %s not associated with chain %s", supervisorId, chainId), "")
+		return
+	}
+
+	if len(supervisorIds) == 1 {
+		sendErrorResponse(w, http.StatusBadRequest, "A chain needs at least one supervisor", "")
+		return
+	}
+
+	editChain(w, r, *chain, slices.Delete(supervisorIds, position, position+1), chainTimeouts(*chain), chainTierList(*chain), store)
+}
+
+func apiReorderChainSupervisorsHandler(w http.ResponseWriter, r *http.Request, toolId uuid.UUID, chainId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var order ChainOrder
+	if err := json.NewDecoder(r.Body).Decode(&order); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	chain := getToolChain(ctx, w, toolId, chainId, store)
+	if chain == nil {
+		return
+	}
+
+	current := chainSupervisorIds(*chain)
+	sorted := func(ids []uuid.UUID) []uuid.UUID {
+		return slices.SortedFunc(slices.Values(ids), func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	}
+	if !slices.Equal(sorted(current), sorted(order.SupervisorIds)) {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid order", "supervisor_ids must list each of the chain's supervisors once")
+		return
+	}
+
+	editChain(w, r, *chain, order.SupervisorIds, chainTimeouts(*chain), chainTierList(*chain), store)
+}
+
+func apiGetSupervisorChainVersionHandler(w http.ResponseWriter, r *http.Request, toolId uuid.UUID, chainId uuid.UUID, version int, store Store) {
+	ctx := r.Context()
+
+	if getToolChain(ctx, w, toolId, chainId, store) == nil {
+		return
+	}
+
+	chain, err := store.GetSupervisorChainVersion(ctx, chainId, version)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor chain", err.Error())
+		return
+	}
+
+	if chain == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervisor chain version not found", "")
+		return
+	}
+
+	respondJSON(w, chain, http.StatusOK)
+}
@@ -0,0 +1,563 @@
+package asteroid
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sashabaranov/go-openai"
+)
+
+const (
+	// chatStreamIdleTimeout is how long a stream is kept without chunks before it's dropped
+	chatStreamIdleTimeout = 10 * time.Minute
+	// maxChatStreamEventBytes bounds a single server-sent event of a stream
+	maxChatStreamEventBytes = 4 << 20
+)
+
+// ChatStreams holds the streamed chat completions being assembled. They only live in memory, since
+// a stream is of no use once the agent posting it is gone.
+type ChatStreams struct {
+	mu      sync.Mutex
+	streams map[uuid.UUID]*chatStream
+}
+
+func NewChatStreams() *ChatStreams {
+	return &ChatStreams{streams: make(map[uuid.UUID]*chatStream)}
+}
+
+// add holds a new stream, dropping the streams that went idle
+func (c *ChatStreams) add(stream *chatStream) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, s := range c.streams {
+		if s.idle() {
+			delete(c.streams, id)
+		}
+	}
+	c.streams[stream.state.Id] = stream
+}
+
+// get returns a stream, or nil if there's none or it went idle
+func (c *ChatStreams) get(id uuid.UUID) *chatStream {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stream, ok := c.streams[id]
+	if !ok || stream.idle() {
+		delete(c.streams, id)
+		return nil
+	}
+	return stream
+}
+
+// compiledChatSupervisor is a chat supervisor with its pattern compiled
+type compiledChatSupervisor struct {
+	ChatSupervisor
+	pattern *regexp.Regexp
+}
+
+// chatStream assembles the chunks of a streamed chat completion into its response
+type chatStream struct {
+	mu sync.Mutex
+
+	state       ChatStream
+	request     []byte
+	response    openai.ChatCompletionResponse
+	supervisors []compiledChatSupervisor
+
+	// lastActive is when the stream was last used, in Unix nanoseconds. It's read without the lock
+	// so a stream being stored doesn't hold up looking up others.
+	lastActive atomic.Int64
+}
+
+func (s *chatStream) touch() {
+	s.lastActive.Store(time.Now().UnixNano())
+}
+
+func (s *chatStream) idle() bool {
+	return time.Since(time.Unix(0, s.lastActive.Load())) > chatStreamIdleTimeout
+}
+
+// choice returns the assembled choice with an index, adding it if it's the first chunk of the choice
+func (s *chatStream) choice(index int) *openai.ChatCompletionChoice {
+	for i := range s.response.Choices {
+		if s.response.Choices[i].Index == index {
+			return &s.response.Choices[i]
+		}
+	}
+	s.response.Choices = append(s.response.Choices, openai.ChatCompletionChoice{
+		Index:   index,
+		Message: openai.ChatCompletionMessage{Role: openai.ChatMessageRoleAssistant},
+	})
+	return &s.response.Choices[len(s.response.Choices)-1]
+}
+
+// apply adds the deltas of a chunk to the choices they belong to. The arguments of a tool call come
+// in fragments, keyed by the tool call's index within its choice.
+func (s *chatStream) apply(chunk openai.ChatCompletionStreamResponse) {
+	if chunk.ID != "" {
+		s.response.ID = chunk.ID
+	}
+	if chunk.Model != "" {
+		s.response.Model = chunk.Model
+	}
+	if chunk.Created != 0 {
+		s.response.Created = chunk.Created
+	}
+	if chunk.SystemFingerprint != "" {
+		s.response.SystemFingerprint = chunk.SystemFingerprint
+	}
+	if chunk.Usage != nil {
+		s.response.Usage = *chunk.Usage
+	}
+
+	for _, delta := range chunk.Choices {
+		choice := s.choice(delta.Index)
+		if delta.Delta.Role != "" {
+			choice.Message.Role = delta.Delta.Role
+		}
+		choice.Message.Content += delta.Delta.Content
+		choice.Message.Refusal += delta.Delta.Refusal
+
+		for i, toolCall := range delta.Delta.ToolCalls {
+			index := i
+			if toolCall.Index != nil {
+				index = *toolCall.Index
+			}
+			for len(choice.Message.ToolCalls) <= index {
+				choice.Message.ToolCalls = append(choice.Message.ToolCalls, openai.ToolCall{Type: openai.ToolTypeFunction})
+			}
+
+			assembled := &choice.Message.ToolCalls[index]
+			if toolCall.ID != "" {
+				assembled.ID = toolCall.ID
+			}
+			if toolCall.Type != "" {
+				assembled.Type = toolCall.Type
+			}
+			if toolCall.Function.Name != "" {
+				assembled.Function.Name = toolCall.Function.Name
+			}
+			assembled.Function.Arguments += toolCall.Function.Arguments
+		}
+
+		if delta.FinishReason != "" {
+			choice.FinishReason = delta.FinishReason
+		}
+	}
+
+	s.state.Events++
+}
+
+// choiceText is what chat supervisors check of a choice: its content, then its tool calls' arguments
+func choiceText(choice openai.ChatCompletionChoice) string {
+	lines := []string{choice.Message.Content}
+	for _, toolCall := range choice.Message.ToolCalls {
+		lines = append(lines, toolCall.Function.Arguments)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// check runs the chat supervisors over every choice assembled so far, and returns the first match.
+// The whole text is checked each time so patterns match across chunks.
+func (s *chatStream) check() *ChatStreamCutOff {
+	for _, choice := range s.response.Choices {
+		text := choiceText(choice)
+		for _, supervisor := range s.supervisors {
+			match := supervisor.pattern.FindString(text)
+			if match == "" {
+				continue
+			}
+			return &ChatStreamCutOff{
+				Supervisor:  supervisor.Name,
+				ChoiceIndex: choice.Index,
+				Match:       match,
+				Reason:      supervisor.Reason,
+				CutOffAt:    time.Now(),
+			}
+		}
+	}
+	return nil
+}
+
+// snapshot returns the state of the stream with the choices assembled so far
+func (s *chatStream) snapshot() ChatStream {
+	state := s.state
+	state.Choices = make([]ChatStreamChoice, 0, len(s.response.Choices))
+	for _, choice := range s.response.Choices {
+		assembled := ChatStreamChoice{
+			Index:     choice.Index,
+			Content:   choice.Message.Content,
+			ToolCalls: make([]ChatStreamToolCall, 0, len(choice.Message.ToolCalls)),
+		}
+		if choice.FinishReason != "" {
+			finishReason := string(choice.FinishReason)
+			assembled.FinishReason = &finishReason
+		}
+		for _, toolCall := range choice.Message.ToolCalls {
+			streamed := ChatStreamToolCall{Name: toolCall.Function.Name, Arguments: toolCall.Function.Arguments}
+			if toolCall.ID != "" {
+				callId := toolCall.ID
+				streamed.CallId = &callId
+			}
+			assembled.ToolCalls = append(assembled.ToolCalls, streamed)
+		}
+		state.Choices = append(state.Choices, assembled)
+	}
+	return state
+}
+
+// completedResponse returns the response assembled by the stream. A stream that was cut off keeps what
+// was generated before the cut, but not its tool calls, which the agent won't make.
+func (s *chatStream) completedResponse() openai.ChatCompletionResponse {
+	response := s.response
+	response.Object = "chat.completion"
+	response.Choices = make([]openai.ChatCompletionChoice, len(s.response.Choices))
+	copy(response.Choices, s.response.Choices)
+
+	if s.state.Status == CutOff {
+		for i := range response.Choices {
+			response.Choices[i].Message.ToolCalls = nil
+			response.Choices[i].FinishReason = openai.FinishReasonContentFilter
+		}
+	}
+	return response
+}
+
+// readServerSentEvents calls apply with the data of each event of a body as it's read, until apply
+// returns false. Comments and fields other than data are ignored.
+func readServerSentEvents(body io.Reader, apply func(data string) (bool, error)) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxChatStreamEventBytes)
+
+	data := make([]string, 0, 1)
+	dispatch := func() (bool, error) {
+		if len(data) == 0 {
+			return true, nil
+		}
+		event := strings.Join(data, "\n")
+		data = data[:0]
+		return apply(event)
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if more, err := dispatch(); err != nil || !more {
+				return err
+			}
+			continue
+		}
+		if value, ok := strings.CutPrefix(line, "data:"); ok {
+			data = append(data, strings.TrimPrefix(value, " "))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading events: %w", err)
+	}
+
+	// The last event may not be followed by a blank line
+	_, err := dispatch()
+	return err
+}
+
+// validateChatSupervisors checks that chat supervisors have unique names and patterns that compile
+func validateChatSupervisors(supervisors []ChatSupervisor) error {
+	names := make(map[string]bool)
+	for _, supervisor := range supervisors {
+		if supervisor.Name == "" {
+			return fmt.Errorf("chat supervisors need a name")
+		}
+		if names[supervisor.Name] {
+			return fmt.Errorf("duplicate chat supervisor %s", supervisor.Name)
+		}
+		names[supervisor.Name] = true
+
+		if supervisor.Pattern == "" {
+			return fmt.Errorf("chat supervisor %s needs a pattern", supervisor.Name)
+		}
+		if _, err := regexp.Compile(supervisor.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern of chat supervisor %s: %w", supervisor.Name, err)
+		}
+	}
+	return nil
+}
+
+// getChatSupervisors returns the compiled chat supervisors of a project, in the order they're checked
+func getChatSupervisors(ctx context.Context, project *Project, store Store) ([]compiledChatSupervisor, error) {
+	if project == nil {
+		return nil, nil
+	}
+
+	supervisors, err := store.GetChatSupervisors(ctx, project.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chat supervisors: %w", err)
+	}
+
+	compiled := make([]compiledChatSupervisor, 0, len(supervisors))
+	for _, supervisor := range supervisors {
+		pattern, err := regexp.Compile(supervisor.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern of chat supervisor %s: %w", supervisor.Name, err)
+		}
+		compiled = append(compiled, compiledChatSupervisor{ChatSupervisor: supervisor, pattern: pattern})
+	}
+	return compiled, nil
+}
+
+func apiCreateChatStreamHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, streams *ChatStreams, store Store) {
+	ctx := r.Context()
+
+	var payload ChatStreamRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	project, ok := admitChat(ctx, w, runId, store)
+	if !ok {
+		return
+	}
+
+	converter := &OpenAIConverter{store: store}
+	jsonRequest, err := converter.ValidateB64EncodedRequest(payload.RequestData)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Request: %s", err.Error()), "")
+		return
+	}
+
+	// A stream is checked by the chat supervisors the project had when it started
+	supervisors, err := getChatSupervisors(ctx, project, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting chat supervisors", err.Error())
+		return
+	}
+
+	now := time.Now()
+	stream := &chatStream{
+		state: ChatStream{
+			Id:        uuid.New(),
+			RunId:     runId,
+			Status:    Streaming,
+			CreatedAt: now,
+		},
+		request:     jsonRequest,
+		supervisors: supervisors,
+	}
+	stream.touch()
+	streams.add(stream)
+
+	respondJSON(w, stream.snapshot(), http.StatusCreated)
+}
+
+func apiGetChatStreamHandler(w http.ResponseWriter, r *http.Request, streamId uuid.UUID, streams *ChatStreams) {
+	stream := streams.get(streamId)
+	if stream == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Chat stream not found", "")
+		return
+	}
+
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	respondJSON(w, stream.snapshot(), http.StatusOK)
+}
+
+func apiAppendChatStreamChunksHandler(w http.ResponseWriter, r *http.Request, streamId uuid.UUID, streams *ChatStreams, store Store) {
+	ctx := r.Context()
+
+	stream := streams.get(streamId)
+	if stream == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Chat stream not found", "")
+		return
+	}
+
+	// The lock is only held while an event is applied, not while the next one is read
+	stream.mu.Lock()
+	status := stream.state.Status
+	stream.mu.Unlock()
+
+	if status == Stored {
+		sendErrorResponse(w, http.StatusConflict, "chat stream was already stored", "")
+		return
+	}
+
+	var cutOff *ChatStreamCutOff
+	err := readServerSentEvents(r.Body, func(data string) (bool, error) {
+		stream.mu.Lock()
+		defer stream.mu.Unlock()
+
+		stream.touch()
+		if stream.state.Status != Streaming {
+			return false, nil
+		}
+		if data == "[DONE]" {
+			stream.state.Done = true
+			return true, nil
+		}
+
+		var chunk openai.ChatCompletionStreamResponse
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return false, fmt.Errorf("invalid event %d: %w", stream.state.Events, err)
+		}
+		stream.apply(chunk)
+
+		if cutOff = stream.check(); cutOff != nil {
+			stream.state.Status = CutOff
+			stream.state.CutOff = cutOff
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "error applying events", err.Error())
+		return
+	}
+
+	if cutOff != nil {
+		recordAuditEvent(ctx, SystemActor, AuditActionChatStreamCutOff, runResource, stream.state.RunId, map[string]interface{}{
+			"stream_id":    stream.state.Id,
+			"supervisor":   cutOff.Supervisor,
+			"choice_index": cutOff.ChoiceIndex,
+			"match":        cutOff.Match,
+		}, store)
+	}
+
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	respondJSON(w, stream.snapshot(), http.StatusOK)
+}
+
+func apiCompleteChatStreamHandler(w http.ResponseWriter, r *http.Request, streamId uuid.UUID, streams *ChatStreams, store Store) {
+	ctx := r.Context()
+
+	stream := streams.get(streamId)
+	if stream == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Chat stream not found", "")
+		return
+	}
+
+	// Held until the chat is stored, so a stream can't be stored twice
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	if stream.state.Status == Stored {
+		sendErrorResponse(w, http.StatusConflict, "chat stream was already stored", "")
+		return
+	}
+
+	if len(stream.response.Choices) == 0 {
+		sendErrorResponse(w, http.StatusBadRequest, "chat stream has no choices", "")
+		return
+	}
+
+	project, ok := admitChat(ctx, w, stream.state.RunId, store)
+	if !ok {
+		return
+	}
+
+	assembled, err := json.Marshal(stream.completedResponse())
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error marshalling response", err.Error())
+		return
+	}
+
+	// Assembled responses are validated like posted ones, which stores them the same way
+	converter := &OpenAIConverter{store: store}
+	jsonResponse, err := converter.ValidateB64EncodedResponse(base64.StdEncoding.EncodeToString(assembled))
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Response: %s", err.Error()), "")
+		return
+	}
+
+	chatIds, err := storeChat(ctx, project, stream.state.RunId, Openai, converter, stream.request, jsonResponse, store)
+	if err != nil {
+		sendAPIError(w, err)
+		return
+	}
+
+	stream.state.Status = Stored
+	stream.touch()
+
+	respondJSON(w, chatIds, http.StatusOK)
+}
+
+func apiGetProjectChatSupervisorsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	supervisors, err := store.GetChatSupervisors(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting chat supervisors", err.Error())
+		return
+	}
+
+	respondJSON(w, supervisors, http.StatusOK)
+}
+
+func apiSetProjectChatSupervisorsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var supervisors []ChatSupervisor
+	if err := json.NewDecoder(r.Body).Decode(&supervisors); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateChatSupervisors(supervisors); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid chat supervisor", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := store.SetChatSupervisors(ctx, projectId, supervisors); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting chat supervisors", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
@@ -0,0 +1,228 @@
+package asteroid
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultBreakerFailureThreshold = 5
+	defaultBreakerCooldownSeconds  = 60
+)
+
+// failureHandling is how an automated supervisor handles failing to decide
+type failureHandling struct {
+	policy    FailurePolicy
+	threshold int
+	cooldown  time.Duration
+}
+
+// parseFailureHandling reads the failure policy and circuit breaker settings of an automated supervisor
+func parseFailureHandling(attributes map[string]interface{}) (failureHandling, error) {
+	handling := failureHandling{
+		policy:    EscalateOnFailure,
+		threshold: defaultBreakerFailureThreshold,
+		cooldown:  defaultBreakerCooldownSeconds * time.Second,
+	}
+
+	if value, ok := attributes["failure_policy"]; ok {
+		policy, _ := value.(string)
+		switch FailurePolicy(policy) {
+		case FailOpen, FailClosed, EscalateOnFailure:
+			handling.policy = FailurePolicy(policy)
+		default:
+			return handling, fmt.Errorf("unknown failure_policy: %v", value)
+		}
+	}
+
+	if value, ok := attributes["circuit_breaker"]; ok {
+		jsonSettings, err := json.Marshal(value)
+		if err != nil {
+			return handling, fmt.Errorf("error marshalling circuit_breaker: %w", err)
+		}
+
+		var settings CircuitBreakerSettings
+		if err := json.Unmarshal(jsonSettings, &settings); err != nil {
+			return handling, fmt.Errorf("circuit_breaker must have an optional failure_threshold and cooldown_seconds: %w", err)
+		}
+		if settings.FailureThreshold != nil {
+			if *settings.FailureThreshold < 1 {
+				return handling, fmt.Errorf("failure_threshold of circuit_breaker must be at least 1")
+			}
+			handling.threshold = *settings.FailureThreshold
+		}
+		if settings.CooldownSeconds != nil {
+			if *settings.CooldownSeconds < 1 {
+				return handling, fmt.Errorf("cooldown_seconds of circuit_breaker must be at least 1")
+			}
+			handling.cooldown = time.Duration(*settings.CooldownSeconds) * time.Second
+		}
+	}
+
+	return handling, nil
+}
+
+// applyFailurePolicy decides a request an automated supervisor failed to decide with its failure policy
+func applyFailurePolicy(result *SupervisionResult, policy FailurePolicy, reason string) {
+	switch policy {
+	case FailOpen:
+		result.Decision = Approve
+		result.Reasoning = fmt.Sprintf("Approved by the fail_open failure policy because %s", reason)
+	case FailClosed:
+		result.Decision = Reject
+		result.Reasoning = fmt.Sprintf("Rejected by the fail_closed failure policy because %s", reason)
+	default:
+		result.Decision = Escalate
+		result.Reasoning = fmt.Sprintf("Escalated because %s", reason)
+	}
+}
+
+// CircuitBreakers keep automated supervisors that keep failing from being asked, so their failure
+// policy decides right away instead of every request waiting for the supervisor to fail again. The
+// breakers live in memory, so each server starts with them all closed.
+type CircuitBreakers struct {
+	mutex    sync.Mutex
+	breakers map[uuid.UUID]*CircuitBreaker
+}
+
+func NewCircuitBreakers() *CircuitBreakers {
+	return &CircuitBreakers{breakers: make(map[uuid.UUID]*CircuitBreaker)}
+}
+
+// breaker returns the breaker of a supervisor, creating a closed one the first time. The caller
+// holds the mutex.
+func (b *CircuitBreakers) breaker(supervisor Supervisor, policy FailurePolicy) *CircuitBreaker {
+	breaker, ok := b.breakers[*supervisor.Id]
+	if !ok {
+		breaker = &CircuitBreaker{SupervisorId: *supervisor.Id, State: Closed}
+		b.breakers[*supervisor.Id] = breaker
+	}
+	breaker.SupervisorName = supervisor.Name
+	breaker.FailurePolicy = policy
+	return breaker
+}
+
+// allow reports whether a supervisor can be asked to decide a request. An open breaker lets one
+// request through once its cooldown is over, and refuses the others until that one's outcome is recorded.
+func (b *CircuitBreakers) allow(supervisor Supervisor, handling failureHandling, now time.Time) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	breaker := b.breaker(supervisor, handling.policy)
+	switch breaker.State {
+	case Open:
+		if now.Before(*breaker.RetryAt) {
+			breaker.ShortCircuited++
+			return false
+		}
+		breaker.State = HalfOpen
+		return true
+	case HalfOpen:
+		breaker.ShortCircuited++
+		return false
+	default:
+		return true
+	}
+}
+
+// record counts whether a supervisor that was allowed to decide a request failed to, opening its
+// breaker once it failed too often in a row
+func (b *CircuitBreakers) record(supervisor Supervisor, handling failureHandling, failed bool, now time.Time) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	breaker := b.breaker(supervisor, handling.policy)
+	if !failed {
+		breaker.Successes++
+		breaker.ConsecutiveFailures = 0
+		breaker.State = Closed
+		breaker.RetryAt = nil
+		return
+	}
+
+	breaker.Failures++
+	breaker.ConsecutiveFailures++
+	if breaker.State == HalfOpen || breaker.ConsecutiveFailures >= handling.threshold {
+		retryAt := now.Add(handling.cooldown)
+		breaker.State = Open
+		breaker.OpenedAt = &now
+		breaker.RetryAt = &retryAt
+		breaker.Trips++
+		log.Printf("Circuit breaker of supervisor %s opened after %d failures in a row, retrying at %s",
+			*supervisor.Id, breaker.ConsecutiveFailures, retryAt.Format(time.RFC3339))
+	}
+}
+
+// get returns a copy of a supervisor's breaker, a closed one if it wasn't asked yet
+func (b *CircuitBreakers) get(supervisor Supervisor, policy FailurePolicy) CircuitBreaker {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	breaker, ok := b.breakers[*supervisor.Id]
+	if !ok {
+		return CircuitBreaker{SupervisorId: *supervisor.Id, SupervisorName: supervisor.Name, State: Closed, FailurePolicy: policy}
+	}
+	copied := *breaker
+	copied.SupervisorName = supervisor.Name
+	copied.FailurePolicy = policy
+	return copied
+}
+
+// list returns copies of every breaker, open ones first
+func (b *CircuitBreakers) list() []CircuitBreaker {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	breakers := make([]CircuitBreaker, 0, len(b.breakers))
+	for _, breaker := range b.breakers {
+		breakers = append(breakers, *breaker)
+	}
+
+	rank := map[CircuitBreakerState]int{Open: 0, HalfOpen: 1, Closed: 2}
+	sort.Slice(breakers, func(i, j int) bool {
+		if rank[breakers[i].State] != rank[breakers[j].State] {
+			return rank[breakers[i].State] < rank[breakers[j].State]
+		}
+		return breakers[i].SupervisorName < breakers[j].SupervisorName
+	})
+	return breakers
+}
+
+func apiGetSupervisorCircuitBreakerHandler(w http.ResponseWriter, r *http.Request, supervisorId uuid.UUID, breakers *CircuitBreakers, store Store) {
+	ctx := r.Context()
+
+	supervisor, err := store.GetSupervisor(ctx, supervisorId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor", err.Error())
+		return
+	}
+
+	if supervisor == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervisor not found", "")
+		return
+	}
+
+	if supervisor.Type != EnsembleSupervisor && supervisor.Type != LlmSupervisor {
+		sendErrorResponse(w, http.StatusBadRequest, "only ensemble and LLM supervisors have a circuit breaker", "")
+		return
+	}
+
+	handling, err := parseFailureHandling(supervisor.Attributes)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error reading failure policy", err.Error())
+		return
+	}
+
+	respondJSON(w, breakers.get(*supervisor, handling.policy), http.StatusOK)
+}
+
+func apiGetCircuitBreakersHandler(w http.ResponseWriter, _ *http.Request, breakers *CircuitBreakers) {
+	respondJSON(w, breakers.list(), http.StatusOK)
+}
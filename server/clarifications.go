@@ -0,0 +1,191 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrClarificationPending is returned when a reviewer asks the agent a question while another is still unanswered
+var ErrClarificationPending = errors.New("supervision request already awaits an answer from the agent")
+
+// validateClarificationQuestion checks that a clarify verdict comes with something to ask
+func validateClarificationQuestion(question *ClarificationQuestion) error {
+	if question == nil || strings.TrimSpace(question.Question) == "" {
+		return fmt.Errorf("a question for the agent is required with a verdict whose behavior is clarify")
+	}
+	if question.Choices != nil && len(*question.Choices) == 0 {
+		return fmt.Errorf("choices can't be empty, leave them out to allow any answer")
+	}
+	return nil
+}
+
+// requestClarification asks the agent a reviewer's question instead of deciding a supervision request.
+// The request leaves the reviewer's queue until the agent answers.
+func requestClarification(ctx context.Context, requestId uuid.UUID, result SupervisionResult, actor string, store Store, hub *Hub) (*Clarification, error) {
+	winner, err := store.GetSupervisionResultFromRequestID(ctx, requestId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting supervision result: %w", err)
+	}
+	if winner != nil {
+		return nil, ErrSupervisionRequestResolved
+	}
+
+	clarification := Clarification{
+		Id:                   uuid.New(),
+		SupervisionRequestId: requestId,
+		Question:             *result.Question,
+		AskedBy:              actor,
+		AskedAt:              time.Now(),
+	}
+
+	created, err := store.CreateClarification(ctx, clarification)
+	if err != nil {
+		return nil, fmt.Errorf("error creating clarification: %w", err)
+	}
+	if !created {
+		return nil, ErrClarificationPending
+	}
+
+	details := map[string]interface{}{
+		"clarification_id": clarification.Id,
+		"question":         clarification.Question.Question,
+	}
+	if result.Verdict != nil {
+		details["verdict"] = *result.Verdict
+	}
+	recordAuditEvent(ctx, actor, AuditActionClarificationRequested, supervisionRequestResource, requestId, details, store)
+
+	if hub != nil {
+		hub.holdForClarification(requestId)
+	}
+
+	return &clarification, nil
+}
+
+// requeueClarifiedReview sends a supervision request back to the session of the reviewer who asked
+// the question, or to the next available reviewer if they're gone. Returns the session it went to.
+func requeueClarifiedReview(ctx context.Context, clarification Clarification, store Store, hub *Hub) (*string, error) {
+	if session, ok := sessionFromActor(clarification.AskedBy); ok && hub != nil && hub.sessionConnected(session) {
+		supervisionRequest, err := store.GetSupervisionRequest(ctx, clarification.SupervisionRequestId)
+		if err != nil {
+			return nil, fmt.Errorf("error getting supervision request: %w", err)
+		}
+		if supervisionRequest != nil {
+			if err := hub.reassignReview(*supervisionRequest, session); err != nil {
+				return nil, err
+			}
+			return &session, nil
+		}
+	}
+
+	status := SupervisionStatus{
+		Status:               Pending,
+		CreatedAt:            time.Now(),
+		SupervisionRequestId: &clarification.SupervisionRequestId,
+	}
+	if err := store.CreateSupervisionStatus(ctx, clarification.SupervisionRequestId, status); err != nil {
+		return nil, fmt.Errorf("error creating supervision status: %w", err)
+	}
+
+	return nil, nil
+}
+
+func apiGetSupervisionRequestClarificationsHandler(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	supervisionRequest, err := store.GetSupervisionRequest(ctx, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision request", err.Error())
+		return
+	}
+
+	if supervisionRequest == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervision request not found", "")
+		return
+	}
+
+	clarifications, err := store.GetClarifications(ctx, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting clarifications", err.Error())
+		return
+	}
+
+	respondJSON(w, clarifications, http.StatusOK)
+}
+
+func apiAnswerClarificationHandler(w http.ResponseWriter, r *http.Request, clarificationId uuid.UUID, store Store, hub *Hub) {
+	ctx := r.Context()
+
+	var request ClarificationAnswer
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if strings.TrimSpace(request.Answer) == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "answer is required", "")
+		return
+	}
+
+	clarification, err := store.GetClarification(ctx, clarificationId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting clarification", err.Error())
+		return
+	}
+
+	if clarification == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Clarification not found", "")
+		return
+	}
+
+	if clarification.Question.Choices != nil && !slices.Contains(*clarification.Question.Choices, request.Answer) {
+		sendErrorResponse(w, http.StatusBadRequest, "answer must be one of the question's choices", strings.Join(*clarification.Question.Choices, ", "))
+		return
+	}
+
+	// The request may have been decided meanwhile, e.g. rejected with a tool call it depends on
+	result, err := store.GetSupervisionResultFromRequestID(ctx, clarification.SupervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision result", err.Error())
+		return
+	}
+
+	if result != nil {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("supervision request %s was already resolved with decision %s", clarification.SupervisionRequestId, result.Decision), "")
+		return
+	}
+
+	answeredAt := time.Now()
+	answered, err := store.AnswerClarification(ctx, clarificationId, request.Answer, answeredAt)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error answering clarification", err.Error())
+		return
+	}
+
+	if !answered {
+		sendErrorResponse(w, http.StatusConflict, "Clarification was already answered", "")
+		return
+	}
+
+	clarification.Answer = &request.Answer
+	clarification.AnsweredAt = &answeredAt
+
+	session, err := requeueClarifiedReview(ctx, *clarification, store, hub)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error requeueing supervision request", err.Error())
+		return
+	}
+
+	recordAuditEvent(ctx, actorFromContext(ctx), AuditActionClarificationAnswered, supervisionRequestResource, clarification.SupervisionRequestId,
+		map[string]interface{}{"clarification_id": clarification.Id, "requeued_to_session": session}, store)
+
+	respondJSON(w, clarification, http.StatusOK)
+}
@@ -8,7 +8,7 @@ import (
 )
 
 func main() {
-	db, err := database.NewPostgresqlStore()
+	db, err := database.NewStoreFromEnv()
 	if err != nil {
 		log.Fatalf("Failed to connect to the database: %v", err)
 	}
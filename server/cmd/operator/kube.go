@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	group   = "sentinel.asteroid.ai"
+	version = "v1alpha1"
+
+	serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+)
+
+// kubeClient reads the custom resources and writes their statuses through the Kubernetes API, with
+// the operator's service account or through kubectl proxy
+type kubeClient struct {
+	client *http.Client
+	url    string
+	token  string
+	// namespace limits the operator to one namespace, every namespace if empty
+	namespace string
+}
+
+// newKubeClient connects to the Kubernetes API at a URL, or to the cluster the operator runs in if
+// the URL is empty
+func newKubeClient(url string, namespace string) (*kubeClient, error) {
+	if url != "" {
+		return &kubeClient{client: &http.Client{Timeout: 30 * time.Second}, url: strings.TrimSuffix(url, "/"), namespace: namespace}, nil
+	}
+
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, fmt.Errorf("not running in a cluster, pass -kube-url")
+	}
+
+	token, err := os.ReadFile(serviceAccountDir + "/token")
+	if err != nil {
+		return nil, fmt.Errorf("error reading service account token: %w", err)
+	}
+	ca, err := os.ReadFile(serviceAccountDir + "/ca.crt")
+	if err != nil {
+		return nil, fmt.Errorf("error reading cluster CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("cluster CA has no certificates")
+	}
+
+	return &kubeClient{
+		client: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}},
+		},
+		url:       "https://" + net.JoinHostPort(host, port),
+		token:     strings.TrimSpace(string(token)),
+		namespace: namespace,
+	}, nil
+}
+
+func (k *kubeClient) do(ctx context.Context, method string, path string, contentType string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshalling body: %w", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, k.url+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if k.token != "" {
+		req.Header.Set("Authorization", "Bearer "+k.token)
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("kubernetes responded %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+	return nil
+}
+
+// list gets the resources of a kind, as plural, and decodes them into a list like resourceList[T]
+func (k *kubeClient) list(ctx context.Context, plural string, out interface{}) error {
+	path := fmt.Sprintf("/apis/%s/%s/%s", group, version, plural)
+	if k.namespace != "" {
+		path = fmt.Sprintf("/apis/%s/%s/namespaces/%s/%s", group, version, k.namespace, plural)
+	}
+	return k.do(ctx, http.MethodGet, path, "", nil, out)
+}
+
+// updateStatus merges a status into a resource's status subresource
+func (k *kubeClient) updateStatus(ctx context.Context, plural string, metadata objectMeta, status interface{}) error {
+	path := fmt.Sprintf("/apis/%s/%s/namespaces/%s/%s/%s/status", group, version, metadata.Namespace, plural, metadata.Name)
+	return k.do(ctx, http.MethodPatch, path, "application/merge-patch+json", map[string]interface{}{"status": status}, nil)
+}
@@ -0,0 +1,75 @@
+// Command operator reconciles SentinelProject, SupervisorChain and ToolPolicy resources against the
+// API, so supervision policy can be managed with GitOps like workloads are.
+//
+//	operator -url http://sentinel.sentinel.svc:8080/api/v1 [-namespace team-a]
+//
+// Apply crds.yaml and rbac.yaml from this directory, and run it in the cluster with the sentinel-operator
+// service account, or outside it through kubectl proxy with -kube-url http://localhost:8001. The URL
+// defaults to ASTEROID_API_URL, and the API key is read from -key or ASTEROID_API_KEY and needs the
+// read:runs, admin:projects and admin:supervisors scopes.
+//
+// Projects are created by name and never deleted. Supervisors can't be changed once created, so a
+// changed supervisor of a chain is created anew. A project's tool policies are replaced with the
+// ToolPolicy resources that refer to it, every interval, which undoes changes made any other way.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+)
+
+func main() {
+	defaultURL := os.Getenv("ASTEROID_API_URL")
+	if defaultURL == "" {
+		defaultURL = "http://localhost:8080/api/v1"
+	}
+
+	url := flag.String("url", defaultURL, "base URL of the API")
+	key := flag.String("key", os.Getenv("ASTEROID_API_KEY"), "API key")
+	kubeURL := flag.String("kube-url", "", "URL of the Kubernetes API, the cluster the operator runs in if empty")
+	namespace := flag.String("namespace", "", "namespace to reconcile the resources of, every namespace if empty")
+	interval := flag.Duration("interval", 30*time.Second, "how often to reconcile")
+	once := flag.Bool("once", false, "reconcile once and exit, non-zero if that failed")
+	flag.Parse()
+
+	kube, err := newKubeClient(*kubeURL, *namespace)
+	if err != nil {
+		log.Fatalf("Error connecting to Kubernetes: %v", err)
+	}
+
+	o := &operator{
+		kube:     kube,
+		sentinel: &sentinelClient{client: &http.Client{Timeout: 30 * time.Second}, url: strings.TrimSuffix(*url, "/"), key: *key},
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *once {
+		if err := o.reconcile(ctx); err != nil {
+			log.Fatalf("Error reconciling: %v", err)
+		}
+		return
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for {
+		if err := o.reconcile(ctx); err != nil {
+			log.Printf("Error reconciling: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,333 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	asteroid "github.com/asteroidai/asteroid/server"
+	"github.com/google/uuid"
+)
+
+// sentinelClient calls the Sentinel API with a key that isn't limited to a project and has the
+// read:runs, admin:projects and admin:supervisors scopes, to read tool policies, create projects and
+// create supervisors and tool policies
+type sentinelClient struct {
+	client *http.Client
+	url    string
+	key    string
+}
+
+func (s *sentinelClient) do(ctx context.Context, method string, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshalling body: %w", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.url+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if s.key != "" {
+		req.Header.Set(asteroid.ApiKeyHeader, s.key)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("sentinel responded %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+	return nil
+}
+
+type operator struct {
+	kube     *kubeClient
+	sentinel *sentinelClient
+}
+
+// resourceKey names a resource within the operator, as references between resources are to
+// resources of the same namespace
+func resourceKey(namespace string, name string) string {
+	return namespace + "/" + name
+}
+
+// reconcile brings Sentinel in line with every resource, projects first as chains and policies
+// need their IDs, then chains as policies need their supervisors
+func (o *operator) reconcile(ctx context.Context) error {
+	var projects resourceList[SentinelProject]
+	if err := o.kube.list(ctx, "sentinelprojects", &projects); err != nil {
+		return fmt.Errorf("error listing sentinel projects: %w", err)
+	}
+	var chains resourceList[SupervisorChain]
+	if err := o.kube.list(ctx, "supervisorchains", &chains); err != nil {
+		return fmt.Errorf("error listing supervisor chains: %w", err)
+	}
+	var policies resourceList[ToolPolicy]
+	if err := o.kube.list(ctx, "toolpolicies", &policies); err != nil {
+		return fmt.Errorf("error listing tool policies: %w", err)
+	}
+
+	projectIds := make(map[string]uuid.UUID)
+	for _, project := range projects.Items {
+		status := o.reconcileProject(ctx, project)
+		if status.Synced {
+			projectIds[resourceKey(project.Metadata.Namespace, project.Metadata.Name)] = uuid.MustParse(status.ProjectId)
+		}
+		o.updateStatus(ctx, "sentinelprojects", project.Metadata, project.Status, status)
+	}
+
+	chainRequests := make(map[string]asteroid.ChainRequest)
+	for _, chain := range chains.Items {
+		status, request := o.reconcileChain(ctx, chain, projectIds)
+		if request != nil {
+			chainRequests[resourceKey(chain.Metadata.Namespace, chain.Metadata.Name)] = *request
+		}
+		o.updateStatus(ctx, "supervisorchains", chain.Metadata, chain.Status, status)
+	}
+
+	for _, project := range projects.Items {
+		key := resourceKey(project.Metadata.Namespace, project.Metadata.Name)
+		projectId, ok := projectIds[key]
+		if !ok {
+			continue
+		}
+
+		projectPolicies := slices.DeleteFunc(slices.Clone(policies.Items), func(policy ToolPolicy) bool {
+			return resourceKey(policy.Metadata.Namespace, policy.Spec.Project) != key
+		})
+		statuses := o.reconcileToolPolicies(ctx, projectId, projectPolicies, chainRequests)
+		for i, policy := range projectPolicies {
+			o.updateStatus(ctx, "toolpolicies", policy.Metadata, policy.Status, statuses[i])
+		}
+	}
+
+	// Policies of projects that don't exist or aren't synced are left for when they are
+	for _, policy := range policies.Items {
+		if _, ok := projectIds[resourceKey(policy.Metadata.Namespace, policy.Spec.Project)]; !ok {
+			o.updateStatus(ctx, "toolpolicies", policy.Metadata, policy.Status, resourceStatus{
+				ObservedGeneration: policy.Metadata.Generation,
+				Message:            fmt.Sprintf("waiting for sentinel project %s", policy.Spec.Project),
+			})
+		}
+	}
+
+	return nil
+}
+
+// updateStatus writes a resource's status if it changed, logging rather than failing as the next
+// reconciliation tries again
+func (o *operator) updateStatus(ctx context.Context, plural string, metadata objectMeta, current interface{}, status interface{}) {
+	if reflect.DeepEqual(current, status) {
+		return
+	}
+	if err := o.kube.updateStatus(ctx, plural, metadata, status); err != nil {
+		log.Printf("Error updating status of %s %s/%s: %v", plural, metadata.Namespace, metadata.Name, err)
+	}
+}
+
+func (o *operator) reconcileProject(ctx context.Context, project SentinelProject) SentinelProjectStatus {
+	status := SentinelProjectStatus{resourceStatus: resourceStatus{ObservedGeneration: project.Metadata.Generation}}
+
+	request := map[string]interface{}{
+		"name":            project.Spec.Name,
+		"run_result_tags": project.Spec.RunResultTags,
+	}
+	if project.Spec.Name == "" {
+		request["name"] = project.Metadata.Name
+	}
+	if project.Spec.RunResultTags == nil {
+		request["run_result_tags"] = []string{}
+	}
+	if project.Spec.OrganizationId != "" {
+		request["organization_id"] = project.Spec.OrganizationId
+	}
+
+	// Projects are created by name, and an existing project of the name is returned as it is
+	var projectId uuid.UUID
+	if err := o.sentinel.do(ctx, http.MethodPost, "/project", request, &projectId); err != nil {
+		status.Message = fmt.Sprintf("error creating project: %v", err)
+		return status
+	}
+
+	status.ProjectId = projectId.String()
+	status.Synced = true
+	return status
+}
+
+// supervisorHash identifies the config of a chain's supervisor, leaving out its timeout which is
+// set on the chain
+func supervisorHash(supervisor ChainSupervisor) string {
+	encoded, _ := json.Marshal(map[string]interface{}{
+		"name":        supervisor.Name,
+		"description": supervisor.Description,
+		"type":        supervisor.Type,
+		"code":        supervisor.Code,
+		"attributes":  supervisor.Attributes,
+	})
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+// reconcileChain creates the supervisors of a chain that don't exist yet, and returns the chain a
+// tool policy creates for it once all of them do
+func (o *operator) reconcileChain(ctx context.Context, chain SupervisorChain, projectIds map[string]uuid.UUID) (SupervisorChainStatus, *asteroid.ChainRequest) {
+	status := SupervisorChainStatus{resourceStatus: resourceStatus{ObservedGeneration: chain.Metadata.Generation}}
+
+	projectId, ok := projectIds[resourceKey(chain.Metadata.Namespace, chain.Spec.Project)]
+	if !ok {
+		status.Supervisors = chain.Status.Supervisors
+		status.Message = fmt.Sprintf("waiting for sentinel project %s", chain.Spec.Project)
+		return status, nil
+	}
+
+	request := asteroid.ChainRequest{MinConfidence: chain.Spec.MinConfidence}
+	if chain.Spec.Timeout != nil {
+		request.Timeout = &asteroid.ChainTimeout{TimeoutSeconds: chain.Spec.Timeout.TimeoutSeconds, Fallback: chain.Spec.Timeout.Fallback}
+	}
+	supervisorIds := make([]uuid.UUID, 0, len(chain.Spec.Supervisors))
+	timeouts := make([]asteroid.SupervisorTimeout, 0)
+	tiers := make([]asteroid.SupervisorTier, 0)
+	for _, supervisor := range chain.Spec.Supervisors {
+		hash := supervisorHash(supervisor)
+		synced := slices.IndexFunc(chain.Status.Supervisors, func(synced SyncedSupervisor) bool {
+			return synced.Name == supervisor.Name && synced.Hash == hash
+		})
+
+		var supervisorId uuid.UUID
+		if synced >= 0 {
+			supervisorId = uuid.MustParse(chain.Status.Supervisors[synced].Id)
+		} else {
+			attributes := supervisor.Attributes
+			if attributes == nil {
+				attributes = map[string]interface{}{}
+			}
+			created := asteroid.Supervisor{
+				Name:        supervisor.Name,
+				Description: supervisor.Description,
+				Type:        supervisor.Type,
+				Code:        supervisor.Code,
+				Attributes:  attributes,
+				CreatedAt:   time.Now(),
+			}
+			if err := o.sentinel.do(ctx, http.MethodPost, fmt.Sprintf("/project/%s/supervisor", projectId), created, &supervisorId); err != nil {
+				// The supervisors created so far are kept, so they aren't created again
+				for _, previous := range chain.Status.Supervisors {
+					if !slices.Contains(status.Supervisors, previous) {
+						status.Supervisors = append(status.Supervisors, previous)
+					}
+				}
+				status.Message = fmt.Sprintf("error creating supervisor %s: %v", supervisor.Name, err)
+				return status, nil
+			}
+		}
+
+		status.Supervisors = append(status.Supervisors, SyncedSupervisor{Name: supervisor.Name, Id: supervisorId.String(), Hash: hash})
+		supervisorIds = append(supervisorIds, supervisorId)
+		if supervisor.TimeoutSeconds > 0 {
+			fallback := supervisor.Fallback
+			if fallback == "" {
+				fallback = asteroid.EscalateToNext
+			}
+			timeouts = append(timeouts, asteroid.SupervisorTimeout{SupervisorId: supervisorId, TimeoutSeconds: supervisor.TimeoutSeconds, Fallback: fallback})
+		}
+		if supervisor.Tier != nil {
+			tiers = append(tiers, asteroid.SupervisorTier{SupervisorId: supervisorId, Tier: *supervisor.Tier})
+		}
+	}
+
+	request.SupervisorIds = &supervisorIds
+	if len(timeouts) > 0 {
+		request.SupervisorTimeouts = &timeouts
+	}
+	if len(tiers) > 0 {
+		request.SupervisorTiers = &tiers
+	}
+	status.Synced = true
+	return status, &request
+}
+
+// reconcileToolPolicies replaces a project's tool policies with its ToolPolicy resources, once the
+// chains of all of them are synced, and returns the status of each
+func (o *operator) reconcileToolPolicies(ctx context.Context, projectId uuid.UUID, policies []ToolPolicy, chainRequests map[string]asteroid.ChainRequest) []resourceStatus {
+	statuses := make([]resourceStatus, len(policies))
+	desired := make([]asteroid.ToolPolicy, 0, len(policies))
+	waiting := false
+	for i, policy := range policies {
+		statuses[i] = resourceStatus{ObservedGeneration: policy.Metadata.Generation}
+
+		toolPolicy := asteroid.ToolPolicy{ToolName: policy.Spec.ToolName, RiskTier: policy.Spec.RiskTier, Chains: make([]asteroid.ChainRequest, 0, len(policy.Spec.Chains))}
+		for _, chain := range policy.Spec.Chains {
+			request, ok := chainRequests[resourceKey(policy.Metadata.Namespace, chain)]
+			if !ok {
+				statuses[i].Message = fmt.Sprintf("waiting for supervisor chain %s", chain)
+				waiting = true
+				break
+			}
+			toolPolicy.Chains = append(toolPolicy.Chains, request)
+		}
+		desired = append(desired, toolPolicy)
+	}
+
+	// Setting the policies without one that's waiting would remove it
+	if waiting {
+		for i := range statuses {
+			if statuses[i].Message == "" {
+				statuses[i].Message = "waiting for the other tool policies of the project"
+			}
+		}
+		return statuses
+	}
+
+	var current []asteroid.ToolPolicy
+	path := fmt.Sprintf("/project/%s/tool_policies", projectId)
+	err := o.sentinel.do(ctx, http.MethodGet, path, nil, &current)
+	if err == nil && !sameToolPolicies(current, desired) {
+		err = o.sentinel.do(ctx, http.MethodPut, path, desired, nil)
+	}
+
+	for i := range statuses {
+		if err != nil {
+			statuses[i].Message = fmt.Sprintf("error setting tool policies: %v", err)
+		} else {
+			statuses[i].Synced = true
+		}
+	}
+	return statuses
+}
+
+// sameToolPolicies compares policies by their JSON, so policies that differ only in how they were
+// decoded are the same
+func sameToolPolicies(a, b []asteroid.ToolPolicy) bool {
+	encodedA, errA := json.Marshal(a)
+	encodedB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(encodedA, encodedB)
+}
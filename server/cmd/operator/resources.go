@@ -0,0 +1,106 @@
+package main
+
+import (
+	asteroid "github.com/asteroidai/asteroid/server"
+)
+
+type objectMeta struct {
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+	Generation int64  `json:"generation,omitempty"`
+}
+
+type resourceList[T any] struct {
+	Items []T `json:"items"`
+}
+
+// resourceStatus is what every resource reports of its last reconciliation
+type resourceStatus struct {
+	ObservedGeneration int64  `json:"observedGeneration"`
+	Synced             bool   `json:"synced"`
+	Message            string `json:"message"`
+}
+
+// SentinelProject is a project, created by name if it doesn't exist yet
+type SentinelProject struct {
+	Metadata objectMeta            `json:"metadata"`
+	Spec     SentinelProjectSpec   `json:"spec"`
+	Status   SentinelProjectStatus `json:"status"`
+}
+
+type SentinelProjectSpec struct {
+	// Name is the project's name in Sentinel, the resource's name if empty
+	Name           string   `json:"name,omitempty"`
+	RunResultTags  []string `json:"runResultTags,omitempty"`
+	OrganizationId string   `json:"organizationId,omitempty"`
+}
+
+type SentinelProjectStatus struct {
+	resourceStatus
+	ProjectId string `json:"projectId,omitempty"`
+}
+
+// SupervisorChain is a chain of supervisors that tool policies of its project refer to by name
+type SupervisorChain struct {
+	Metadata objectMeta            `json:"metadata"`
+	Spec     SupervisorChainSpec   `json:"spec"`
+	Status   SupervisorChainStatus `json:"status"`
+}
+
+type SupervisorChainSpec struct {
+	// Project is the name of the SentinelProject in the same namespace
+	Project       string            `json:"project"`
+	Supervisors   []ChainSupervisor `json:"supervisors"`
+	MinConfidence *float64          `json:"minConfidence,omitempty"`
+	Timeout       *ChainTimeout     `json:"timeout,omitempty"`
+}
+
+// ChainTimeout is how long each supervisor of a chain has to decide, unless it has its own timeout
+type ChainTimeout struct {
+	TimeoutSeconds int                      `json:"timeoutSeconds"`
+	Fallback       asteroid.TimeoutFallback `json:"fallback"`
+}
+
+// ChainSupervisor is a supervisor of a chain. Supervisors can't be changed once created, so a
+// changed supervisor is created anew and the chain moves to it.
+type ChainSupervisor struct {
+	Name           string                   `json:"name"`
+	Description    string                   `json:"description,omitempty"`
+	Type           asteroid.SupervisorType  `json:"type"`
+	Code           string                   `json:"code,omitempty"`
+	Attributes     map[string]interface{}   `json:"attributes,omitempty"`
+	TimeoutSeconds int                      `json:"timeoutSeconds,omitempty"`
+	Fallback       asteroid.TimeoutFallback `json:"fallback,omitempty"`
+	// Tier is the supervisor's escalation tier, a tier of its own if unset
+	Tier *int `json:"tier,omitempty"`
+}
+
+type SupervisorChainStatus struct {
+	resourceStatus
+	Supervisors []SyncedSupervisor `json:"supervisors,omitempty"`
+}
+
+// SyncedSupervisor is the supervisor created for a supervisor of a chain, which is reused while the
+// hash of its config stays the same
+type SyncedSupervisor struct {
+	Name string `json:"name"`
+	Id   string `json:"id"`
+	Hash string `json:"hash"`
+}
+
+// ToolPolicy is the policy of a tool of a project. A project's tool policies are replaced with its
+// ToolPolicy resources, so policies set any other way are removed.
+type ToolPolicy struct {
+	Metadata objectMeta     `json:"metadata"`
+	Spec     ToolPolicySpec `json:"spec"`
+	Status   resourceStatus `json:"status"`
+}
+
+type ToolPolicySpec struct {
+	// Project is the name of the SentinelProject in the same namespace
+	Project  string            `json:"project"`
+	ToolName string            `json:"toolName"`
+	RiskTier asteroid.RiskTier `json:"riskTier"`
+	// Chains are the names of SupervisorChains in the same namespace
+	Chains []string `json:"chains"`
+}
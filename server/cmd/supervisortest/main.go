@@ -0,0 +1,100 @@
+// Command supervisortest runs the test cases of supervisors against a server and exits non-zero if
+// any of them fail, so policy changes can be checked in CI like code.
+//
+//	supervisortest -url http://localhost:8080/api/v1 <supervisor id>...
+//
+// The URL defaults to ASTEROID_API_URL, and the API key is read from -key or ASTEROID_API_KEY and
+// needs the admin:supervisors scope.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	asteroid "github.com/asteroidai/asteroid/server"
+)
+
+func main() {
+	defaultURL := os.Getenv("ASTEROID_API_URL")
+	if defaultURL == "" {
+		defaultURL = "http://localhost:8080/api/v1"
+	}
+
+	url := flag.String("url", defaultURL, "base URL of the API")
+	key := flag.String("key", os.Getenv("ASTEROID_API_KEY"), "API key")
+	timeout := flag.Duration("timeout", 10*time.Minute, "how long to wait for each supervisor's cases")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		log.Fatal("Usage: supervisortest [-url url] [-key key] <supervisor id>...")
+	}
+
+	client := &http.Client{Timeout: *timeout}
+	failed := false
+	for _, supervisorId := range flag.Args() {
+		report, err := runTestCases(client, strings.TrimSuffix(*url, "/"), *key, supervisorId)
+		if err != nil {
+			log.Fatalf("Error running test cases of supervisor %s: %v", supervisorId, err)
+		}
+
+		for _, result := range report.Results {
+			outcome := "PASS"
+			switch {
+			case result.Skipped:
+				outcome = "SKIP"
+			case !result.Passed:
+				outcome = "FAIL"
+			}
+
+			decision := "none"
+			if result.Decision != nil {
+				decision = string(*result.Decision)
+			}
+			fmt.Printf("%s %s/%s: expected %s, got %s (%s)\n", outcome, supervisorId, result.Name, result.ExpectedDecision, decision, result.Reasoning)
+		}
+		fmt.Printf("supervisor %s: %d passed, %d failed, %d skipped\n", supervisorId, report.Passed, report.Failed, report.Skipped)
+
+		if report.Failed > 0 {
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func runTestCases(client *http.Client, url string, key string, supervisorId string) (*asteroid.SupervisorTestReport, error) {
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/supervisor/%s/test_cases/run", url, supervisorId), nil)
+	if err != nil {
+		return nil, err
+	}
+	if key != "" {
+		req.Header.Set(asteroid.ApiKeyHeader, key)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("server responded %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var report asteroid.SupervisorTestReport
+	if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		return nil, fmt.Errorf("error decoding report: %w", err)
+	}
+
+	return &report, nil
+}
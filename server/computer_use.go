@@ -0,0 +1,490 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// passwordElementPattern matches the names and selectors of elements that take passwords when agents
+// don't report the input type
+var passwordElementPattern = regexp.MustCompile(`(?i)passw(or)?d|passcode|passphrase`)
+
+// errNotComputerAction is returned for arguments of tool calls that aren't computer use actions
+var errNotComputerAction = errors.New("not a computer use action")
+
+// openAIComputerActions maps the types of OpenAI's computer use actions to ours. Clicks are mapped by
+// their button.
+var openAIComputerActions = map[string]ComputerActionType{
+	"double_click": DoubleClick,
+	"type":         Type,
+	"keypress":     Key,
+	"scroll":       Scroll,
+	"move":         MouseMove,
+	"drag":         LeftClickDrag,
+	"screenshot":   Screenshot,
+	"wait":         Wait,
+}
+
+var clickButtons = map[string]ComputerActionType{
+	"":       LeftClick,
+	"left":   LeftClick,
+	"right":  RightClick,
+	"middle": MiddleClick,
+	"wheel":  MiddleClick,
+}
+
+// rawComputerAction holds the arguments of both Anthropic's and OpenAI's computer use tools
+type rawComputerAction struct {
+	Action          *string           `json:"action"`
+	Type            *string           `json:"type"`
+	Coordinate      []float64         `json:"coordinate"`
+	StartCoordinate []float64         `json:"start_coordinate"`
+	X               *float64          `json:"x"`
+	Y               *float64          `json:"y"`
+	Button          string            `json:"button"`
+	Text            *string           `json:"text"`
+	Keys            []string          `json:"keys"`
+	ScrollDirection *string           `json:"scroll_direction"`
+	ScrollAmount    *int              `json:"scroll_amount"`
+	ScrollX         *float64          `json:"scroll_x"`
+	ScrollY         *float64          `json:"scroll_y"`
+	Path            []ScreenshotPoint `json:"path"`
+	Element         *ComputerElement  `json:"element"`
+}
+
+func isComputerActionType(action ComputerActionType) bool {
+	switch action {
+	case LeftClick, RightClick, MiddleClick, DoubleClick, Type, Key, Scroll, MouseMove, LeftClickDrag, Screenshot, Wait:
+		return true
+	default:
+		return false
+	}
+}
+
+func pointFromCoordinate(coordinate []float64) (*ScreenshotPoint, error) {
+	if coordinate == nil {
+		return nil, nil
+	}
+	if len(coordinate) != 2 {
+		return nil, fmt.Errorf("coordinate must be [x, y], got %d values", len(coordinate))
+	}
+	return &ScreenshotPoint{X: int(coordinate[0]), Y: int(coordinate[1])}, nil
+}
+
+// parseComputerAction reads the action a computer use tool call takes from its arguments. It returns
+// errNotComputerAction for tool calls of other tools, and an error describing what's wrong for
+// computer use actions that are malformed.
+func parseComputerAction(arguments *string) (*ComputerAction, error) {
+	if arguments == nil {
+		return nil, errNotComputerAction
+	}
+
+	var raw rawComputerAction
+	if err := json.Unmarshal([]byte(*arguments), &raw); err != nil {
+		return nil, errNotComputerAction
+	}
+
+	action := ComputerAction{Text: raw.Text, Element: raw.Element, ScrollAmount: raw.ScrollAmount}
+
+	switch {
+	case raw.Action != nil:
+		action.Action = ComputerActionType(*raw.Action)
+		if !isComputerActionType(action.Action) {
+			return nil, errNotComputerAction
+		}
+
+		coordinate, err := pointFromCoordinate(raw.Coordinate)
+		if err != nil {
+			return nil, err
+		}
+		startCoordinate, err := pointFromCoordinate(raw.StartCoordinate)
+		if err != nil {
+			return nil, fmt.Errorf("start_%w", err)
+		}
+		action.Coordinate, action.StartCoordinate = coordinate, startCoordinate
+
+		if raw.ScrollDirection != nil {
+			direction := ComputerActionScrollDirection(*raw.ScrollDirection)
+			action.ScrollDirection = &direction
+		}
+	case raw.Type != nil:
+		var ok bool
+		if *raw.Type == "click" {
+			action.Action, ok = clickButtons[raw.Button]
+			if !ok {
+				return nil, fmt.Errorf("unknown click button %s", raw.Button)
+			}
+		} else if action.Action, ok = openAIComputerActions[*raw.Type]; !ok {
+			return nil, errNotComputerAction
+		}
+
+		if raw.X != nil && raw.Y != nil {
+			action.Coordinate = &ScreenshotPoint{X: int(*raw.X), Y: int(*raw.Y)}
+		}
+		if len(raw.Keys) > 0 {
+			keys := strings.Join(raw.Keys, "+")
+			action.Text = &keys
+		}
+		if len(raw.Path) > 0 {
+			action.StartCoordinate, action.Coordinate = &raw.Path[0], &raw.Path[len(raw.Path)-1]
+		}
+		if action.Action == Scroll {
+			scrollOpenAI(&action, raw)
+		}
+	default:
+		return nil, errNotComputerAction
+	}
+
+	if err := validateComputerAction(action); err != nil {
+		return nil, err
+	}
+	return &action, nil
+}
+
+// scrollOpenAI sets the direction and amount of an OpenAI scroll from its scroll_x and scroll_y,
+// taking the axis scrolled further
+func scrollOpenAI(action *ComputerAction, raw rawComputerAction) {
+	var x, y float64
+	if raw.ScrollX != nil {
+		x = *raw.ScrollX
+	}
+	if raw.ScrollY != nil {
+		y = *raw.ScrollY
+	}
+
+	var direction ComputerActionScrollDirection
+	amount := 0.0
+	switch {
+	case x == 0 && y == 0:
+		return
+	case math.Abs(y) >= math.Abs(x):
+		direction, amount = Down, y
+		if y < 0 {
+			direction = Up
+		}
+	default:
+		direction, amount = Right, x
+		if x < 0 {
+			direction = Left
+		}
+	}
+
+	scrolled := int(math.Abs(amount))
+	action.ScrollDirection, action.ScrollAmount = &direction, &scrolled
+}
+
+// validateComputerAction checks an action has what it needs to be carried out
+func validateComputerAction(action ComputerAction) error {
+	for _, point := range []*ScreenshotPoint{action.Coordinate, action.StartCoordinate} {
+		if point != nil && (point.X < 0 || point.Y < 0) {
+			return fmt.Errorf("coordinates can't be negative, got [%d, %d]", point.X, point.Y)
+		}
+	}
+
+	switch action.Action {
+	case LeftClick, RightClick, MiddleClick, DoubleClick, MouseMove:
+		if action.Coordinate == nil && action.Element == nil {
+			return fmt.Errorf("%s needs a coordinate or an element", action.Action)
+		}
+	case LeftClickDrag:
+		if action.StartCoordinate == nil || action.Coordinate == nil {
+			return fmt.Errorf("%s needs a start_coordinate and a coordinate", action.Action)
+		}
+	case Type, Key:
+		if action.Text == nil || *action.Text == "" {
+			return fmt.Errorf("%s needs text", action.Action)
+		}
+	case Scroll:
+		if action.ScrollDirection != nil {
+			switch *action.ScrollDirection {
+			case Up, Down, Left, Right:
+			default:
+				return fmt.Errorf("unknown scroll_direction %s", *action.ScrollDirection)
+			}
+		}
+		if action.ScrollAmount != nil && *action.ScrollAmount < 0 {
+			return fmt.Errorf("scroll_amount can't be negative")
+		}
+	}
+
+	return nil
+}
+
+// typesIntoPassword reports whether an action types into an element taking a password
+func typesIntoPassword(action ComputerAction) bool {
+	if action.Action != Type || action.Element == nil {
+		return false
+	}
+
+	element := action.Element
+	if element.InputType != nil && strings.EqualFold(*element.InputType, "password") {
+		return true
+	}
+	for _, value := range []*string{element.Name, element.Selector} {
+		if value != nil && passwordElementPattern.MatchString(*value) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseComputerUseRules reads the rules of a computer use supervisor from its attributes
+func parseComputerUseRules(attributes map[string]interface{}) ([]ComputerUseRule, error) {
+	value, ok := attributes["rules"]
+	if !ok {
+		return nil, nil
+	}
+
+	jsonRules, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling computer use rules: %w", err)
+	}
+
+	var rules []ComputerUseRule
+	if err := json.Unmarshal(jsonRules, &rules); err != nil {
+		return nil, fmt.Errorf("rules must be a list of name, decision and what they match: %w", err)
+	}
+
+	return rules, nil
+}
+
+// computerUseInvalidDecision returns what a computer use supervisor decides for tool calls that
+// aren't valid computer use actions
+func computerUseInvalidDecision(attributes map[string]interface{}) Decision {
+	if decision, ok := attributes["invalid_decision"].(string); ok && decision != "" {
+		return Decision(decision)
+	}
+	return Escalate
+}
+
+// blocksPasswordTyping reports whether a computer use supervisor rejects typing into password fields,
+// which it does unless turned off
+func blocksPasswordTyping(attributes map[string]interface{}) bool {
+	block, ok := attributes["block_password_typing"].(bool)
+	return !ok || block
+}
+
+// validateComputerUseAttributes checks a computer use supervisor's rules and decisions
+func validateComputerUseAttributes(attributes map[string]interface{}) error {
+	rules, err := parseComputerUseRules(attributes)
+	if err != nil {
+		return err
+	}
+
+	if value, ok := attributes["block_password_typing"]; ok {
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("block_password_typing must be a boolean")
+		}
+	}
+
+	names := make(map[string]bool, len(rules))
+	for i, rule := range rules {
+		if rule.Name == "" {
+			return fmt.Errorf("computer use rule %d needs a name", i)
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("duplicate computer use rule %s", rule.Name)
+		}
+		names[rule.Name] = true
+
+		if rule.Actions != nil {
+			for _, action := range *rule.Actions {
+				if !isComputerActionType(action) {
+					return fmt.Errorf("computer use rule %s matches unknown action %s", rule.Name, action)
+				}
+			}
+		}
+		for _, pattern := range []*string{rule.ElementPattern, rule.TextPattern, rule.UrlPattern} {
+			if pattern == nil {
+				continue
+			}
+			if _, err := regexp.Compile(*pattern); err != nil {
+				return fmt.Errorf("computer use rule %s: %w", rule.Name, err)
+			}
+		}
+		if rule.Region != nil && (rule.Region.Width < 1 || rule.Region.Height < 1) {
+			return fmt.Errorf("region of computer use rule %s must be at least 1x1", rule.Name)
+		}
+		if err := validatePolicyDecision(rule.Decision); err != nil {
+			return fmt.Errorf("computer use rule %s: %w", rule.Name, err)
+		}
+	}
+
+	if err := validatePolicyDecision(policyDefaultDecision(attributes)); err != nil {
+		return err
+	}
+	return validatePolicyDecision(computerUseInvalidDecision(attributes))
+}
+
+// matchesPattern reports whether a pattern matches any of the values that are set. Values that
+// aren't set never match.
+func matchesPattern(pattern string, values ...*string) (bool, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false, err
+	}
+	for _, value := range values {
+		if value != nil && re.MatchString(*value) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchesComputerAction reports whether every condition of a computer use rule holds for an action
+func matchesComputerAction(rule ComputerUseRule, action ComputerAction) (bool, error) {
+	element := action.Element
+	if element == nil {
+		element = &ComputerElement{}
+	}
+
+	if rule.Actions != nil && !slices.Contains(*rule.Actions, action.Action) {
+		return false, nil
+	}
+	if rule.InputTypes != nil {
+		if element.InputType == nil || !containsFold(*rule.InputTypes, *element.InputType) {
+			return false, nil
+		}
+	}
+	if rule.Region != nil {
+		point := action.Coordinate
+		region := rule.Region
+		if point == nil || point.X < region.X || point.Y < region.Y || point.X >= region.X+region.Width || point.Y >= region.Y+region.Height {
+			return false, nil
+		}
+	}
+
+	patterns := []struct {
+		pattern *string
+		values  []*string
+	}{
+		{rule.ElementPattern, []*string{element.Selector, element.Role, element.Name}},
+		{rule.TextPattern, []*string{action.Text}},
+		{rule.UrlPattern, []*string{element.Url}},
+	}
+	for _, p := range patterns {
+		if p.pattern == nil {
+			continue
+		}
+		matched, err := matchesPattern(*p.pattern, p.values...)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluateComputerUse decides on the computer use action in a tool call's arguments. Typing into
+// password fields is rejected first unless turned off, then the first of the supervisor's rules the
+// action matches decides, or its default decision if none does.
+func evaluateComputerUse(supervisor Supervisor, arguments *string) (Decision, ResultExplanation, error) {
+	rules, err := parseComputerUseRules(supervisor.Attributes)
+	if err != nil {
+		return "", ResultExplanation{}, err
+	}
+
+	action, err := parseComputerAction(arguments)
+	if err != nil {
+		decision := computerUseInvalidDecision(supervisor.Attributes)
+		rationale := fmt.Sprintf("%s, the arguments aren't a valid computer use action: %v", decision, err)
+		return decision, ResultExplanation{Rationale: &rationale}, nil
+	}
+
+	if blocksPasswordTyping(supervisor.Attributes) && typesIntoPassword(*action) {
+		rationale := fmt.Sprintf("%s, the action types into a password field", Reject)
+		return Reject, ResultExplanation{MatchedRuleIds: &[]string{"block_password_typing"}, Rationale: &rationale}, nil
+	}
+
+	for _, rule := range rules {
+		matched, err := matchesComputerAction(rule, *action)
+		if err != nil {
+			return "", ResultExplanation{}, fmt.Errorf("computer use rule %s: %w", rule.Name, err)
+		}
+		if !matched {
+			continue
+		}
+
+		rationale := fmt.Sprintf("%s by rule %s, which matched the %s action", rule.Decision, rule.Name, action.Action)
+		return rule.Decision, ResultExplanation{MatchedRuleIds: &[]string{rule.Name}, Rationale: &rationale}, nil
+	}
+
+	decision := policyDefaultDecision(supervisor.Attributes)
+	rationale := fmt.Sprintf("%s by default, no rule matched the %s action", decision, action.Action)
+	return decision, ResultExplanation{Rationale: &rationale}, nil
+}
+
+// decideComputerUse resolves a supervision request with what a computer use supervisor decides on
+// the action of the request's tool call
+func decideComputerUse(ctx context.Context, supervisionRequest SupervisionRequest, supervisor Supervisor, store Store) error {
+	requestId := *supervisionRequest.Id
+
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, requestId, store)
+	if err != nil {
+		return err
+	}
+	if toolCallId == nil {
+		return fmt.Errorf("tool call of supervision request %s not found", requestId)
+	}
+
+	toolCall, err := store.GetToolCall(ctx, *toolCallId)
+	if err != nil {
+		return fmt.Errorf("error getting tool call: %w", err)
+	}
+	if toolCall == nil {
+		return fmt.Errorf("tool call %s not found", *toolCallId)
+	}
+
+	decision, explanation, err := evaluateComputerUse(supervisor, storedToolCallArguments(*toolCall))
+	if err != nil {
+		return err
+	}
+
+	result := SupervisionResult{
+		Decision:             decision,
+		Reasoning:            *explanation.Rationale,
+		Explanation:          &explanation,
+		SupervisionRequestId: requestId,
+		CreatedAt:            time.Now(),
+	}
+	_, winner, err := resolveSupervisionRequest(ctx, requestId, result, SystemActor, store)
+	if err != nil {
+		return err
+	}
+	if winner != nil {
+		log.Printf("Supervision request %s was resolved before its computer use supervisor decided", requestId)
+	}
+
+	return nil
+}
+
+// getComputerAction returns the computer use action of a tool call for reviewers, or nil if it isn't one
+func getComputerAction(toolCallId uuid.UUID, arguments *string) *ComputerAction {
+	action, err := parseComputerAction(arguments)
+	if err != nil {
+		if !errors.Is(err, errNotComputerAction) {
+			log.Printf("Tool call %s has an invalid computer use action: %v", toolCallId, err)
+		}
+		return nil
+	}
+	return action
+}
@@ -0,0 +1,433 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// projectConfigFormatVersion is bumped when bundles change in a way older servers can't import
+const projectConfigFormatVersion = 1
+
+// configSupervisors collects the supervisors a project's configuration refers to, keyed for a bundle
+type configSupervisors struct {
+	keys        map[uuid.UUID]string
+	supervisors []ConfigSupervisor
+}
+
+// key returns the bundle key of a supervisor, adding it to the bundle the first time
+func (c *configSupervisors) key(ctx context.Context, id uuid.UUID, store Store) (string, error) {
+	if key, ok := c.keys[id]; ok {
+		return key, nil
+	}
+
+	supervisor, err := store.GetSupervisor(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("error getting supervisor: %w", err)
+	}
+	if supervisor == nil {
+		return "", fmt.Errorf("supervisor %s not found", id)
+	}
+
+	key := id.String()
+	attributes := supervisor.Attributes
+	if attributes == nil {
+		attributes = map[string]interface{}{}
+	}
+	c.keys[id] = key
+	c.supervisors = append(c.supervisors, ConfigSupervisor{
+		Key:         key,
+		Name:        supervisor.Name,
+		Description: supervisor.Description,
+		Type:        supervisor.Type,
+		Code:        supervisor.Code,
+		Attributes:  attributes,
+	})
+
+	return key, nil
+}
+
+// configToolPolicies replaces the supervisor IDs in tool policies with bundle keys
+func (c *configSupervisors) configToolPolicies(ctx context.Context, policies []ToolPolicy, store Store) ([]ConfigToolPolicy, error) {
+	configPolicies := make([]ConfigToolPolicy, 0, len(policies))
+	for _, policy := range policies {
+		configPolicy := ConfigToolPolicy{
+			ToolName: policy.ToolName,
+			RiskTier: policy.RiskTier,
+			Chains:   make([][]string, 0, len(policy.Chains)),
+		}
+
+		for _, chain := range policy.Chains {
+			keys := make([]string, 0)
+			if chain.SupervisorIds != nil {
+				for _, id := range *chain.SupervisorIds {
+					key, err := c.key(ctx, id, store)
+					if err != nil {
+						return nil, err
+					}
+					keys = append(keys, key)
+				}
+			}
+			configPolicy.Chains = append(configPolicy.Chains, keys)
+		}
+
+		configPolicies = append(configPolicies, configPolicy)
+	}
+
+	return configPolicies, nil
+}
+
+// exportProjectConfig builds the configuration bundle of a project
+func exportProjectConfig(ctx context.Context, project Project, store Store) (*ProjectConfigBundle, error) {
+	supervisors := &configSupervisors{keys: map[uuid.UUID]string{}, supervisors: []ConfigSupervisor{}}
+
+	policies, err := store.GetProjectToolPolicies(ctx, project.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool policies: %w", err)
+	}
+
+	toolPolicies, err := supervisors.configToolPolicies(ctx, policies, store)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := store.GetNotificationSettings(ctx, project.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting notification settings: %w", err)
+	}
+
+	contextWindowPolicies, err := store.GetContextWindowPolicies(ctx, project.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting context window policies: %w", err)
+	}
+
+	agents, err := store.GetProjectAgents(ctx, project.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting agents: %w", err)
+	}
+
+	configAgents := make([]ConfigAgent, 0, len(agents))
+	for _, agent := range agents {
+		agentPolicies, err := supervisors.configToolPolicies(ctx, agent.ToolPolicies, store)
+		if err != nil {
+			return nil, err
+		}
+
+		configAgents = append(configAgents, ConfigAgent{
+			Name:         agent.Name,
+			Version:      agent.Version,
+			Capabilities: agent.Capabilities,
+			Tools:        agent.Tools,
+			ToolPolicies: agentPolicies,
+		})
+	}
+
+	runResultTags := project.RunResultTags
+	if runResultTags == nil {
+		runResultTags = []string{}
+	}
+
+	return &ProjectConfigBundle{
+		FormatVersion:         projectConfigFormatVersion,
+		ExportedAt:            time.Now(),
+		ProjectName:           project.Name,
+		RunResultTags:         runResultTags,
+		Supervisors:           supervisors.supervisors,
+		ToolPolicies:          toolPolicies,
+		NotificationSettings:  settings,
+		ContextWindowPolicies: contextWindowPolicies,
+		Agents:                configAgents,
+	}, nil
+}
+
+// validateConfigToolPolicies checks tool policies of a bundle the way validateToolPolicies checks
+// policies, except that chains must refer to supervisors in the bundle
+func validateConfigToolPolicies(policies []ConfigToolPolicy, keys map[string]bool) error {
+	seen := make(map[string]bool)
+	for _, policy := range policies {
+		if policy.ToolName == "" {
+			return fmt.Errorf("tool_name is required")
+		}
+		if seen[policy.ToolName] {
+			return fmt.Errorf("duplicate policy for tool %s", policy.ToolName)
+		}
+		seen[policy.ToolName] = true
+
+		switch policy.RiskTier {
+		case Low, Medium, High, Critical:
+		default:
+			return fmt.Errorf("unknown risk tier: %s", policy.RiskTier)
+		}
+
+		for _, chain := range policy.Chains {
+			if len(chain) == 0 {
+				return fmt.Errorf("chains for tool %s must have at least one supervisor", policy.ToolName)
+			}
+
+			inChain := make(map[string]bool)
+			for _, key := range chain {
+				if !keys[key] {
+					return fmt.Errorf("chain for tool %s uses supervisor %s, which isn't in the bundle", policy.ToolName, key)
+				}
+				if inChain[key] {
+					return fmt.Errorf("supervisor %s appears twice in a chain for tool %s", key, policy.ToolName)
+				}
+				inChain[key] = true
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateProjectConfig checks that a bundle can be imported before anything is created
+func validateProjectConfig(bundle ProjectConfigBundle) error {
+	if bundle.FormatVersion > projectConfigFormatVersion {
+		return fmt.Errorf("bundle format version %d is newer than the %d this server supports", bundle.FormatVersion, projectConfigFormatVersion)
+	}
+
+	keys := make(map[string]bool)
+	for _, supervisor := range bundle.Supervisors {
+		if supervisor.Key == "" {
+			return fmt.Errorf("supervisor key is required")
+		}
+		if keys[supervisor.Key] {
+			return fmt.Errorf("duplicate supervisor key %s", supervisor.Key)
+		}
+		keys[supervisor.Key] = true
+
+		switch supervisor.Type {
+		case ClientSupervisor, HumanSupervisor, NoSupervisor, ConsentSupervisor:
+		case EnsembleSupervisor:
+			if err := validateEnsembleAttributes(supervisor.Attributes); err != nil {
+				return fmt.Errorf("supervisor %s has invalid attributes: %w", supervisor.Key, err)
+			}
+		case PolicySupervisor:
+			if err := validatePolicyAttributes(supervisor.Attributes); err != nil {
+				return fmt.Errorf("supervisor %s has invalid attributes: %w", supervisor.Key, err)
+			}
+		case ServicenowSupervisor:
+			if err := validateServiceNowAttributes(supervisor.Attributes); err != nil {
+				return fmt.Errorf("supervisor %s has invalid attributes: %w", supervisor.Key, err)
+			}
+		case ComputerUseSupervisor:
+			if err := validateComputerUseAttributes(supervisor.Attributes); err != nil {
+				return fmt.Errorf("supervisor %s has invalid attributes: %w", supervisor.Key, err)
+			}
+		case LlmSupervisor:
+			if err := validateLlmAttributes(supervisor.Attributes); err != nil {
+				return fmt.Errorf("supervisor %s has invalid attributes: %w", supervisor.Key, err)
+			}
+		default:
+			return fmt.Errorf("supervisor %s has unknown type %s", supervisor.Key, supervisor.Type)
+		}
+	}
+
+	if err := validateConfigToolPolicies(bundle.ToolPolicies, keys); err != nil {
+		return err
+	}
+
+	if bundle.NotificationSettings != nil {
+		if err := validateNotificationSettings(*bundle.NotificationSettings); err != nil {
+			return err
+		}
+	}
+
+	if err := validateContextWindowPolicies(bundle.ContextWindowPolicies); err != nil {
+		return err
+	}
+
+	versions := make(map[string]bool)
+	for _, agent := range bundle.Agents {
+		if agent.Name == "" || agent.Version == "" {
+			return fmt.Errorf("agent name and version are required")
+		}
+		if versions[agent.Name+"@"+agent.Version] {
+			return fmt.Errorf("duplicate agent %s version %s", agent.Name, agent.Version)
+		}
+		versions[agent.Name+"@"+agent.Version] = true
+
+		if err := validateConfigToolPolicies(agent.ToolPolicies, keys); err != nil {
+			return fmt.Errorf("agent %s version %s: %w", agent.Name, agent.Version, err)
+		}
+	}
+
+	return nil
+}
+
+// toolPoliciesFromConfig replaces the bundle keys in tool policies with the IDs of the imported supervisors
+func toolPoliciesFromConfig(policies []ConfigToolPolicy, supervisorIds map[string]uuid.UUID) []ToolPolicy {
+	toolPolicies := make([]ToolPolicy, 0, len(policies))
+	for _, policy := range policies {
+		chains := make([]ChainRequest, 0, len(policy.Chains))
+		for _, keys := range policy.Chains {
+			ids := make([]uuid.UUID, 0, len(keys))
+			for _, key := range keys {
+				ids = append(ids, supervisorIds[key])
+			}
+			chains = append(chains, ChainRequest{SupervisorIds: &ids})
+		}
+
+		toolPolicies = append(toolPolicies, ToolPolicy{ToolName: policy.ToolName, RiskTier: policy.RiskTier, Chains: chains})
+	}
+
+	return toolPolicies
+}
+
+func apiExportProjectConfigHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	bundle, err := exportProjectConfig(ctx, *project, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error exporting project configuration", err.Error())
+		return
+	}
+
+	respondJSON(w, bundle, http.StatusOK)
+}
+
+func apiImportProjectConfigHandler(w http.ResponseWriter, r *http.Request, store Store) {
+	ctx := r.Context()
+
+	var request ImportProjectConfigJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	bundle := request.Bundle
+	name := bundle.ProjectName
+	if request.Name != nil {
+		name = *request.Name
+	}
+
+	if name == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "name is required", "")
+		return
+	}
+
+	if err := validateProjectConfig(bundle); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid bundle", err.Error())
+		return
+	}
+
+	existingProject, err := store.GetProjectFromName(ctx, name)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "Error getting project", err.Error())
+		return
+	}
+
+	if existingProject != nil {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("project %s already exists", name), existingProject.Id.String())
+		return
+	}
+
+	if request.OrganizationId != nil {
+		organization, err := store.GetOrganization(ctx, *request.OrganizationId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting organization", err.Error())
+			return
+		}
+
+		if organization == nil {
+			sendErrorResponse(w, http.StatusNotFound, "Organization not found", "")
+			return
+		}
+	}
+
+	project := Project{
+		Id:             uuid.New(),
+		Name:           name,
+		RunResultTags:  bundle.RunResultTags,
+		OrganizationId: request.OrganizationId,
+		CreatedAt:      time.Now(),
+	}
+	if project.RunResultTags == nil {
+		project.RunResultTags = []string{}
+	}
+
+	if err := store.CreateProject(ctx, project); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "Failed to register project", err.Error())
+		return
+	}
+
+	supervisorIds := make(map[string]uuid.UUID, len(bundle.Supervisors))
+	for _, configSupervisor := range bundle.Supervisors {
+		supervisorId, err := store.CreateSupervisor(ctx, Supervisor{
+			Name:        configSupervisor.Name,
+			Description: configSupervisor.Description,
+			Type:        configSupervisor.Type,
+			Code:        configSupervisor.Code,
+			Attributes:  configSupervisor.Attributes,
+			CreatedAt:   time.Now(),
+		})
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error creating supervisor", err.Error())
+			return
+		}
+		supervisorIds[configSupervisor.Key] = supervisorId
+	}
+
+	if err := store.SetProjectToolPolicies(ctx, project.Id, toolPoliciesFromConfig(bundle.ToolPolicies, supervisorIds)); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting tool policies", err.Error())
+		return
+	}
+
+	if bundle.NotificationSettings != nil {
+		if err := store.SetNotificationSettings(ctx, project.Id, *bundle.NotificationSettings); err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error setting notification settings", err.Error())
+			return
+		}
+	}
+
+	if err := store.SetContextWindowPolicies(ctx, project.Id, bundle.ContextWindowPolicies); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting context window policies", err.Error())
+		return
+	}
+
+	for _, configAgent := range bundle.Agents {
+		agent := Agent{
+			Id:           uuid.New(),
+			ProjectId:    project.Id,
+			Name:         configAgent.Name,
+			Version:      configAgent.Version,
+			Capabilities: configAgent.Capabilities,
+			Tools:        configAgent.Tools,
+			ToolPolicies: toolPoliciesFromConfig(configAgent.ToolPolicies, supervisorIds),
+			CreatedAt:    time.Now(),
+		}
+		if agent.Capabilities == nil {
+			agent.Capabilities = []string{}
+		}
+		if agent.Tools == nil {
+			agent.Tools = []string{}
+		}
+
+		if err := store.CreateAgent(ctx, agent); err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error creating agent", err.Error())
+			return
+		}
+	}
+
+	result := ProjectImportResult{
+		Project:     project,
+		Supervisors: supervisorIds,
+	}
+
+	respondJSON(w, result, http.StatusCreated)
+}
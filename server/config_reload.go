@@ -0,0 +1,250 @@
+package asteroid
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+var ErrConfigInvalid = errors.New("invalid configuration")
+
+// reloadableSettings are the settings that can change while the server runs. The others are read
+// once, when the server starts.
+var reloadableSettings = map[string]bool{
+	"DEMO_MODE":                    true,
+	"REQUIRE_API_KEY":              true,
+	"ASTEROID_ADMIN_KEY":           true,
+	"CONSENT_BASE_URL":             true,
+	"SUPERVISOR_CONCURRENCY":       true,
+	"BATCH_SUPERVISOR_CONCURRENCY": true,
+	"EXPORT_RUNS_PER_SECOND":       true,
+	"RUN_TOOL_CALLS_PER_MINUTE":    true,
+	"RUN_MAX_PENDING_SUPERVISIONS": true,
+	"RUN_MAX_CHAT_REQUESTS":        true,
+}
+
+// ConfigReloader loads the settings in CONFIG_FILE into the environment, over the ones the process
+// was started with, and applies the settings changed in the file when asked to reload
+type ConfigReloader struct {
+	file    string
+	lanes   *PriorityLanes
+	exports *RunExports
+
+	mutex sync.Mutex
+	// original is what the process was started with for each setting ever loaded from the file, nil
+	// if it was unset, so a setting removed from the file goes back to it
+	original map[string]*string
+}
+
+// NewConfigReloaderFromEnv loads the file named by CONFIG_FILE, which has a KEY=VALUE setting per
+// line, like .env.example. Reloading is disabled without it.
+func NewConfigReloaderFromEnv() (*ConfigReloader, error) {
+	c := &ConfigReloader{file: os.Getenv("CONFIG_FILE"), original: make(map[string]*string)}
+	if c.file == "" {
+		return c, nil
+	}
+
+	changes, err := c.changes()
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range changes {
+		setEnv(name, value)
+	}
+
+	log.Printf("Loaded %d settings from %s", len(changes), c.file)
+	return c, nil
+}
+
+// Attach sets the priority lanes and run exports whose limits a reload changes
+func (c *ConfigReloader) Attach(lanes *PriorityLanes, exports *RunExports) {
+	c.lanes = lanes
+	c.exports = exports
+}
+
+// ReloadOnSignal reloads the configuration whenever the process is sent SIGHUP
+func (c *ConfigReloader) ReloadOnSignal() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	for range signals {
+		reload, err := c.Reload()
+		if err != nil {
+			log.Printf("Error reloading configuration: %v", err)
+			continue
+		}
+		log.Printf("Reloaded configuration from %s, applied %v, restart required for %v", reload.File, reload.Applied, reload.RestartRequired)
+	}
+}
+
+// Reload reads the file again and applies the settings that changed. Nothing is applied if the file
+// can't be read or a reloadable setting in it is invalid.
+func (c *ConfigReloader) Reload() (*ConfigReload, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.file == "" {
+		return nil, fmt.Errorf("configuration isn't loaded from a file, set CONFIG_FILE")
+	}
+
+	changes, err := c.changes()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validated with the changes as they'd be applied, before anything is
+	getenv := func(name string) string {
+		if value, ok := changes[name]; ok {
+			return stringOrEmpty(value)
+		}
+		return os.Getenv(name)
+	}
+	capacity, batchCapacity, err := priorityLaneCapacities(getenv)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrConfigInvalid, err)
+	}
+	runsPerSecond, err := exportRunsPerSecond(getenv)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrConfigInvalid, err)
+	}
+	if _, err := defaultRunLimits(getenv); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrConfigInvalid, err)
+	}
+
+	reload := ConfigReload{
+		File:            c.file,
+		ReloadedAt:      time.Now(),
+		Applied:         []string{},
+		RestartRequired: []string{},
+	}
+	for name, value := range changes {
+		setEnv(name, value)
+		if reloadableSettings[name] {
+			reload.Applied = append(reload.Applied, name)
+		} else {
+			reload.RestartRequired = append(reload.RestartRequired, name)
+		}
+	}
+	slices.Sort(reload.Applied)
+	slices.Sort(reload.RestartRequired)
+
+	configureDemoMode()
+	if c.lanes != nil {
+		c.lanes.resize(capacity, batchCapacity)
+	}
+	if c.exports != nil {
+		c.exports.runsPerSecond.Store(int64(runsPerSecond))
+	}
+
+	return &reload, nil
+}
+
+// changes reads the file and returns the settings that differ from the environment, nil for those
+// to unset, recording what the process was started with for settings the file has for the first time
+func (c *ConfigReloader) changes() (map[string]*string, error) {
+	settings, err := readConfigFile(c.file)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]*string)
+	for name, value := range settings {
+		current, ok := os.LookupEnv(name)
+		if _, seen := c.original[name]; !seen {
+			if ok {
+				c.original[name] = &current
+			} else {
+				c.original[name] = nil
+			}
+		}
+		if !ok || current != value {
+			changes[name] = &value
+		}
+	}
+
+	for name, original := range c.original {
+		if _, ok := settings[name]; ok {
+			continue
+		}
+		current, ok := os.LookupEnv(name)
+		if original == nil && ok || original != nil && (!ok || current != *original) {
+			changes[name] = original
+		}
+	}
+
+	return changes, nil
+}
+
+// readConfigFile parses KEY=VALUE lines, skipping blank lines and # comments. Values can be quoted,
+// and aren't expanded.
+func readConfigFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening config file: %w", err)
+	}
+	defer file.Close()
+
+	settings := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		name, value, ok := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%w: line %d of %s isn't a KEY=VALUE setting", ErrConfigInvalid, number, path)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		settings[name] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading config file: %w", err)
+	}
+
+	return settings, nil
+}
+
+// setEnv sets a setting in the environment, or unsets it if value is nil
+func setEnv(name string, value *string) {
+	if value == nil {
+		os.Unsetenv(name)
+		return
+	}
+	os.Setenv(name, *value)
+}
+
+func apiReloadConfigHandler(w http.ResponseWriter, r *http.Request, config *ConfigReloader) {
+	if config.file == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "configuration isn't loaded from a file", "set CONFIG_FILE to reload the configuration")
+		return
+	}
+
+	reload, err := config.Reload()
+	if err != nil {
+		if errors.Is(err, ErrConfigInvalid) {
+			sendErrorResponse(w, http.StatusBadRequest, "invalid configuration", err.Error())
+			return
+		}
+		sendErrorResponse(w, http.StatusInternalServerError, "error reloading configuration", err.Error())
+		return
+	}
+
+	log.Printf("Reloaded configuration from %s, applied %v, restart required for %v", reload.File, reload.Applied, reload.RestartRequired)
+	respondJSON(w, reload, http.StatusOK)
+}
@@ -0,0 +1,281 @@
+package asteroid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// defaultConsentTTL is how long an end user has to respond, unless the supervisor's
+// consent_ttl_minutes attribute says otherwise
+const defaultConsentTTL = 24 * time.Hour
+
+// ConsentLink returns where the end user responds to a consent request. CONSENT_BASE_URL points
+// at the page that shows the prompt, without it the link is the API route.
+func ConsentLink(token string) string {
+	if base := os.Getenv("CONSENT_BASE_URL"); base != "" {
+		return strings.TrimRight(base, "/") + "/" + token
+	}
+	return "/api/v1/consent/" + token
+}
+
+// consentTTL reads how long a consent supervisor gives the end user to respond
+func consentTTL(supervisor Supervisor) time.Duration {
+	if minutes, ok := supervisor.Attributes["consent_ttl_minutes"].(float64); ok && minutes > 0 {
+		return time.Duration(minutes * float64(time.Minute))
+	}
+	return defaultConsentTTL
+}
+
+// generateConsentToken returns a new random token for a consent link
+func generateConsentToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating consent token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// issueConsentRequest creates the consent request for a supervision request, unless it already has one
+func issueConsentRequest(ctx context.Context, supervisionRequest SupervisionRequest, supervisor Supervisor, store Store) (*ConsentRequest, error) {
+	existing, err := store.GetConsentRequest(ctx, *supervisionRequest.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting consent request: %w", err)
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	token, err := generateConsentToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	consent := ConsentRequest{
+		Id:                   uuid.New(),
+		SupervisionRequestId: *supervisionRequest.Id,
+		Token:                token,
+		Link:                 ConsentLink(token),
+		Status:               AwaitingConsent,
+		CreatedAt:            now,
+		ExpiresAt:            now.Add(consentTTL(supervisor)),
+	}
+
+	if err := store.CreateConsentRequest(ctx, consent); err != nil {
+		return nil, fmt.Errorf("error creating consent request: %w", err)
+	}
+
+	return &consent, nil
+}
+
+// resolveConsentRequest turns the end user's response into the supervision request's result. A
+// response only counts if the consent request was still awaiting one, so it can't be changed later.
+func resolveConsentRequest(ctx context.Context, consent ConsentRequest, status ConsentStatus, comment *string, actor string, store Store) (bool, *SupervisionResult, error) {
+	now := time.Now()
+	updated, err := store.SetConsentResponse(ctx, consent.Id, status, comment, now)
+	if err != nil {
+		return false, nil, fmt.Errorf("error recording consent response: %w", err)
+	}
+	if !updated {
+		return false, nil, nil
+	}
+
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, consent.SupervisionRequestId, store)
+	if err != nil {
+		return false, nil, err
+	}
+
+	result := SupervisionResult{
+		CreatedAt:            now,
+		Decision:             Reject,
+		SupervisionRequestId: consent.SupervisionRequestId,
+		ToolcallId:           toolCallId,
+	}
+	switch status {
+	case ConsentGranted:
+		result.Decision = Approve
+		result.Reasoning = "The end user consented"
+	case ConsentExpired:
+		result.Reasoning = "The end user didn't respond before the consent request expired"
+	default:
+		result.Reasoning = "The end user refused consent"
+	}
+	if comment != nil && *comment != "" {
+		result.Reasoning += ": " + *comment
+	}
+
+	_, winner, err := resolveSupervisionRequest(ctx, consent.SupervisionRequestId, result, actor, store)
+	if err != nil {
+		return false, nil, err
+	}
+
+	// A refusal rejects everything depending on the tool call too
+	if winner == nil && toolCallId != nil && result.Decision == Reject {
+		decision, err := getToolCallDecision(ctx, *toolCallId, store)
+		if err != nil {
+			log.Printf("Error getting decision for tool call %s: %v", *toolCallId, err)
+		} else if decision != nil && (*decision == Reject || *decision == Terminate) {
+			if err := propagateRejection(ctx, *toolCallId, store); err != nil {
+				log.Printf("Error propagating rejection of tool call %s: %v", *toolCallId, err)
+			}
+		}
+	}
+
+	return true, winner, nil
+}
+
+// getConsentPrompt describes the tool call a consent request is about, for the end user
+func getConsentPrompt(ctx context.Context, consent ConsentRequest, store Store) (*ConsentPrompt, error) {
+	prompt := ConsentPrompt{
+		Status:    consent.Status,
+		ExpiresAt: consent.ExpiresAt,
+	}
+
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, consent.SupervisionRequestId, store)
+	if err != nil || toolCallId == nil {
+		return &prompt, err
+	}
+
+	toolCall, err := store.GetToolCall(ctx, *toolCallId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool call: %w", err)
+	}
+	if toolCall == nil {
+		return &prompt, nil
+	}
+	if arguments := storedToolCallArguments(*toolCall); arguments != nil {
+		prompt.Arguments = *arguments
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool: %w", err)
+	}
+	if tool != nil {
+		prompt.ToolName = tool.Name
+		prompt.ToolDescription = tool.Description
+	}
+
+	return &prompt, nil
+}
+
+func apiGetSupervisionRequestConsentHandler(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, store ConsentStore) {
+	consent, err := store.GetConsentRequest(r.Context(), supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting consent request", err.Error())
+		return
+	}
+
+	if consent == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Consent request not found", "")
+		return
+	}
+
+	respondJSON(w, consent, http.StatusOK)
+}
+
+func apiGetConsentPromptHandler(w http.ResponseWriter, r *http.Request, token string, store Store) {
+	ctx := r.Context()
+
+	consent, err := store.GetConsentRequestFromToken(ctx, token)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting consent request", err.Error())
+		return
+	}
+
+	if consent == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Consent request not found", "")
+		return
+	}
+
+	prompt, err := getConsentPrompt(ctx, *consent, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting consent prompt", err.Error())
+		return
+	}
+
+	respondJSON(w, prompt, http.StatusOK)
+}
+
+func apiRespondToConsentHandler(w http.ResponseWriter, r *http.Request, token string, store Store) {
+	ctx := r.Context()
+
+	var request RespondToConsentJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	consent, err := store.GetConsentRequestFromToken(ctx, token)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting consent request", err.Error())
+		return
+	}
+
+	if consent == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Consent request not found", "")
+		return
+	}
+
+	if consent.Status != AwaitingConsent {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("consent request is already %s", consent.Status), "")
+		return
+	}
+
+	if time.Now().After(consent.ExpiresAt) {
+		sendErrorResponse(w, http.StatusGone, "consent request has expired", "")
+		return
+	}
+
+	status := ConsentDeclined
+	if request.Granted {
+		status = ConsentGranted
+
+		// Consent is an approval, so it's held like one while the kill switch is active
+		killSwitch, err := getActiveKillSwitchForSupervisionRequest(ctx, consent.SupervisionRequestId, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting kill switch", err.Error())
+			return
+		}
+
+		if killSwitch != nil {
+			sendHaltedResponse(w, killSwitch)
+			return
+		}
+	}
+
+	updated, winner, err := resolveConsentRequest(ctx, *consent, status, request.Comment, "end_user:"+consent.Id.String(), store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error recording consent response", err.Error())
+		return
+	}
+
+	if !updated {
+		sendErrorResponse(w, http.StatusConflict, "consent request was already responded to", "")
+		return
+	}
+
+	if winner != nil {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("tool call was already decided with decision %s", winner.Decision), "")
+		return
+	}
+
+	consent.Status = status
+	prompt, err := getConsentPrompt(ctx, *consent, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting consent prompt", err.Error())
+		return
+	}
+
+	respondJSON(w, prompt, http.StatusOK)
+}
@@ -0,0 +1,304 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sashabaranov/go-openai"
+)
+
+// WildcardModel is the model name of a policy that applies to every model without a specific policy
+const WildcardModel = "*"
+
+// ErrContextWindowExceeded is returned when a request can't be brought within its context window
+var ErrContextWindowExceeded = errors.New("context window exceeded")
+
+// knownContextWindows are the context windows of common models, used when a policy doesn't set a limit.
+// Keys are matched as prefixes of the requested model name, longest first.
+var knownContextWindows = map[string]int{
+	"gpt-4o":        128000,
+	"gpt-4-turbo":   128000,
+	"gpt-4-32k":     32768,
+	"gpt-4":         8192,
+	"gpt-3.5-turbo": 16385,
+	"o1":            128000,
+	"o3":            200000,
+	"claude":        200000,
+	"gemini-1.5":    1000000,
+}
+
+// EstimateTokens approximates the prompt tokens used by a message. We don't ship a tokenizer, so
+// this uses the usual ~4 characters per token heuristic plus a fixed per-message overhead.
+func EstimateTokens(message openai.ChatCompletionMessage) int {
+	const perMessageOverhead = 4
+	const perImage = 85
+
+	chars := len(message.Content) + len(message.Name)
+	tokens := perMessageOverhead
+	for _, part := range message.MultiContent {
+		if part.Type == openai.ChatMessagePartTypeImageURL {
+			tokens += perImage
+			continue
+		}
+		chars += len(part.Text)
+	}
+	for _, toolCall := range message.ToolCalls {
+		chars += len(toolCall.Function.Name) + len(toolCall.Function.Arguments)
+	}
+
+	return tokens + (chars+3)/4
+}
+
+// EstimateRequestTokens approximates the prompt tokens of a whole request
+func EstimateRequestTokens(messages []openai.ChatCompletionMessage) int {
+	total := 0
+	for _, message := range messages {
+		total += EstimateTokens(message)
+	}
+	return total
+}
+
+// ResolveContextWindowPolicy picks the policy for a model: an exact match wins over the wildcard.
+// Returns nil if the project has no applicable policy.
+func ResolveContextWindowPolicy(policies []ContextWindowPolicy, model string) *ContextWindowPolicy {
+	var wildcard *ContextWindowPolicy
+	for i := range policies {
+		if policies[i].Model == model {
+			return &policies[i]
+		}
+		if policies[i].Model == WildcardModel {
+			wildcard = &policies[i]
+		}
+	}
+	return wildcard
+}
+
+// contextWindowLimit returns the token limit of a policy, falling back to the model's known window
+func contextWindowLimit(policy ContextWindowPolicy, model string) int {
+	if policy.MaxContextTokens > 0 {
+		return policy.MaxContextTokens
+	}
+
+	best, bestLen := 0, 0
+	for prefix, window := range knownContextWindows {
+		if strings.HasPrefix(model, prefix) && len(prefix) > bestLen {
+			best, bestLen = window, len(prefix)
+		}
+	}
+	return best
+}
+
+// Summarizer condenses messages dropped from a request so their gist survives truncation
+type Summarizer interface {
+	Summarize(ctx context.Context, model string, messages []openai.ChatCompletionMessage) (string, error)
+}
+
+// ApplyContextWindowPolicy brings a request's messages within the policy's token limit. It returns the
+// messages to send, and a description of what was dropped or nil if the request already fit.
+func ApplyContextWindowPolicy(
+	ctx context.Context,
+	request openai.ChatCompletionRequest,
+	policy ContextWindowPolicy,
+	summarizer Summarizer,
+) ([]openai.ChatCompletionMessage, *ContextTruncation, error) {
+	messages := request.Messages
+	limit := contextWindowLimit(policy, request.Model)
+	original := EstimateRequestTokens(messages)
+
+	if limit <= 0 || original <= limit {
+		return messages, nil, nil
+	}
+
+	if policy.Strategy == Fail {
+		return nil, nil, fmt.Errorf("%w: request uses ~%d tokens, limit is %d", ErrContextWindowExceeded, original, limit)
+	}
+
+	// Leading system messages and the final message are never dropped. middle_out also protects the
+	// first non-system message, which is usually the user's original task.
+	start := 0
+	for start < len(messages) && messages[start].Role == openai.ChatMessageRoleSystem {
+		start++
+	}
+	if policy.Strategy == MiddleOut && start < len(messages)-1 {
+		start++
+	}
+	end := len(messages) - 1
+
+	// When summarizing, reserve room for the summary message we're going to add
+	budget := limit
+	if policy.Strategy == Summarize {
+		budget -= limit / 10
+	}
+
+	total := original
+	dropEnd := start
+	for dropEnd < end && total > budget {
+		total -= EstimateTokens(messages[dropEnd])
+		dropEnd++
+		// Tool results can't be sent without the assistant message that requested them
+		for dropEnd < end && messages[dropEnd].Role == openai.ChatMessageRoleTool {
+			total -= EstimateTokens(messages[dropEnd])
+			dropEnd++
+		}
+	}
+
+	if total > budget {
+		return nil, nil, fmt.Errorf("%w: request uses ~%d tokens after dropping every droppable message, limit is %d", ErrContextWindowExceeded, total, limit)
+	}
+
+	dropped := messages[start:dropEnd]
+	kept := make([]openai.ChatCompletionMessage, 0, len(messages)-len(dropped)+1)
+	kept = append(kept, messages[:start]...)
+
+	truncation := ContextTruncation{
+		Id:               uuid.New(),
+		Model:            request.Model,
+		Strategy:         policy.Strategy,
+		MaxContextTokens: limit,
+		OriginalTokens:   original,
+		DroppedMessages:  make([]map[string]interface{}, 0, len(dropped)),
+	}
+
+	for _, message := range dropped {
+		raw, err := messageToMap(message)
+		if err != nil {
+			return nil, nil, err
+		}
+		truncation.DroppedMessages = append(truncation.DroppedMessages, raw)
+	}
+
+	if policy.Strategy == Summarize && len(dropped) > 0 {
+		if summarizer == nil {
+			return nil, nil, fmt.Errorf("summarize strategy requires an upstream model to summarize with")
+		}
+
+		summary, err := summarizer.Summarize(ctx, request.Model, dropped)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error summarizing dropped messages: %w", err)
+		}
+
+		truncation.Summary = &summary
+		kept = append(kept, openai.ChatCompletionMessage{
+			Role:    openai.ChatMessageRoleSystem,
+			Content: "Summary of earlier conversation that was removed to fit the context window:\n" + summary,
+		})
+	}
+
+	kept = append(kept, messages[dropEnd:]...)
+	truncation.FinalTokens = EstimateRequestTokens(kept)
+
+	return kept, &truncation, nil
+}
+
+func messageToMap(message openai.ChatCompletionMessage) (map[string]interface{}, error) {
+	b, err := json.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling message: %w", err)
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("error unmarshalling message: %w", err)
+	}
+
+	return m, nil
+}
+
+func apiGetContextWindowPoliciesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	policies, err := store.GetContextWindowPolicies(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting context window policies", err.Error())
+		return
+	}
+
+	respondJSON(w, policies, http.StatusOK)
+}
+
+// validateContextWindowPolicies checks that models are unique and limits and strategies are valid
+func validateContextWindowPolicies(policies []ContextWindowPolicy) error {
+	seen := make(map[string]bool)
+	for _, policy := range policies {
+		if policy.Model == "" {
+			return fmt.Errorf("model is required")
+		}
+		if seen[policy.Model] {
+			return fmt.Errorf("duplicate policy for model %s", policy.Model)
+		}
+		seen[policy.Model] = true
+
+		if policy.MaxContextTokens < 0 {
+			return fmt.Errorf("max_context_tokens must not be negative")
+		}
+
+		switch policy.Strategy {
+		case Fail, DropOldest, MiddleOut, Summarize:
+		default:
+			return fmt.Errorf("unknown truncation strategy: %s", policy.Strategy)
+		}
+	}
+
+	return nil
+}
+
+func apiSetContextWindowPoliciesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var policies []ContextWindowPolicy
+	if err := json.NewDecoder(r.Body).Decode(&policies); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateContextWindowPolicies(policies); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, err.Error(), "")
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := store.SetContextWindowPolicies(ctx, projectId, policies); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting context window policies", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiGetRunTruncationsHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	truncations, err := store.GetRunTruncations(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run truncations", err.Error())
+		return
+	}
+
+	respondJSON(w, truncations, http.StatusOK)
+}
@@ -10,13 +10,33 @@ import (
 	"github.com/sashabaranov/go-openai"
 )
 
-type AsteroidConverter interface {
-	ToAsteroidMessages(requestData, responseData []byte) ([]AsteroidMessage, error)
-	ToAsteroidChoices(responseData []byte) ([]AsteroidChoice, error)
+// ChatFormatConverter validates the chats of one LLM API's format and converts them to Asteroid's
+// messages and choices, so chats are stored as they were sent and read back in any format
+type ChatFormatConverter interface {
+	ToAsteroidMessages(ctx context.Context, requestData, responseData []byte, runId uuid.UUID) ([]AsteroidMessage, error)
+	ToAsteroidChoices(ctx context.Context, responseData []byte, runId uuid.UUID) ([]AsteroidChoice, error)
 	ValidateB64EncodedRequest(encodedData string) ([]byte, error)
 	ValidateB64EncodedResponse(encodedData string) ([]byte, error)
 }
 
+// converterForFormat returns the converter of a chat format, OpenAI's if none is given
+func converterForFormat(format *ChatFormat, store ToolStore) (ChatFormatConverter, error) {
+	if format == nil {
+		return &OpenAIConverter{store}, nil
+	}
+
+	switch *format {
+	case Openai:
+		return &OpenAIConverter{store}, nil
+	case Anthropic:
+		return &AnthropicConverter{store}, nil
+	case Gemini:
+		return &GeminiConverter{store}, nil
+	default:
+		return nil, fmt.Errorf("unknown chat format: %s", *format)
+	}
+}
+
 type OpenAIConverter struct {
 	store ToolStore
 }
@@ -127,7 +147,13 @@ func (c *OpenAIConverter) ConvertMessage(
 		}
 	} else {
 		msgType = Text
-		msgContent = message.Content
+		msgContent = NormalizeContent(message.Content)
+	}
+
+	var language *string
+	if msgType == Text {
+		detected := DetectLanguage(msgContent)
+		language = &detected
 	}
 
 	originalMessageJSON, err := json.Marshal(message)
@@ -145,6 +171,7 @@ func (c *OpenAIConverter) ConvertMessage(
 		Type:      &msgType,
 		Content:   msgContent,
 		Data:      &b64,
+		Language:  language,
 	}
 
 	return sMsg, nil
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	asteroid "github.com/asteroidai/asteroid/server"
+)
+
+// connections are the store's connection pools. Queries of a realtime context run on the reserved
+// pool when there is one, so they don't wait for a connection that other traffic holds.
+type connections struct {
+	main     *sql.DB
+	realtime *sql.DB
+}
+
+func (c *connections) pool(ctx context.Context) *sql.DB {
+	if c.realtime != nil && asteroid.IsRealtime(ctx) {
+		return c.realtime
+	}
+	return c.main
+}
+
+func (c *connections) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return c.pool(ctx).ExecContext(ctx, query, args...)
+}
+
+func (c *connections) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return c.pool(ctx).QueryContext(ctx, query, args...)
+}
+
+func (c *connections) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return c.pool(ctx).QueryRowContext(ctx, query, args...)
+}
+
+func (c *connections) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return c.pool(ctx).BeginTx(ctx, opts)
+}
+
+func (c *connections) Close() error {
+	err := c.main.Close()
+	if c.realtime != nil {
+		err = errors.Join(err, c.realtime.Close())
+	}
+	return err
+}
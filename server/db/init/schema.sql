@@ -1,20 +1,84 @@
 -- First drop tables in reverse dependency order
+DROP TABLE IF EXISTS feature_flag_target CASCADE;
+DROP TABLE IF EXISTS feature_flag_rollout CASCADE;
+DROP TABLE IF EXISTS worker_lease CASCADE;
+DROP TABLE IF EXISTS redaction CASCADE;
+DROP TABLE IF EXISTS project_redactor CASCADE;
+DROP TABLE IF EXISTS toolcall_result CASCADE;
+DROP TABLE IF EXISTS project_result_supervisor CASCADE;
+DROP TABLE IF EXISTS project_argument_baseline CASCADE;
+DROP TABLE IF EXISTS change_request CASCADE;
+DROP TABLE IF EXISTS watch_notification CASCADE;
+DROP TABLE IF EXISTS watch_subscription CASCADE;
+DROP TABLE IF EXISTS project_payload_template CASCADE;
+DROP TABLE IF EXISTS run_transcript_segment CASCADE;
+DROP TABLE IF EXISTS project_argument_rule CASCADE;
+DROP TABLE IF EXISTS webhook_delivery CASCADE;
+DROP TABLE IF EXISTS webhook CASCADE;
+DROP TABLE IF EXISTS run_artifact CASCADE;
+DROP TABLE IF EXISTS backfill_result CASCADE;
+DROP TABLE IF EXISTS backfill CASCADE;
+DROP TABLE IF EXISTS run_pause CASCADE;
+DROP TABLE IF EXISTS alert CASCADE;
+DROP TABLE IF EXISTS project_alert_rule CASCADE;
+DROP TABLE IF EXISTS archive CASCADE;
+DROP TABLE IF EXISTS project_chat_supervisor CASCADE;
+DROP TABLE IF EXISTS plan_deviation CASCADE;
+DROP TABLE IF EXISTS plan_step CASCADE;
+DROP TABLE IF EXISTS plan CASCADE;
+DROP TABLE IF EXISTS durable_timer CASCADE;
+DROP TABLE IF EXISTS run_event CASCADE;
+DROP TABLE IF EXISTS supervisor_test_case CASCADE;
+DROP TABLE IF EXISTS ensemble_verdict CASCADE;
+DROP TABLE IF EXISTS agent_tool_trust CASCADE;
+DROP TABLE IF EXISTS project_trust_policy CASCADE;
+DROP TABLE IF EXISTS review_claim CASCADE;
+DROP TABLE IF EXISTS reviewer CASCADE;
+DROP TABLE IF EXISTS project_routing_rule CASCADE;
+DROP TABLE IF EXISTS clarification CASCADE;
+DROP TABLE IF EXISTS project_verdict CASCADE;
+DROP TABLE IF EXISTS toolcall_resource CASCADE;
+DROP TABLE IF EXISTS run_document CASCADE;
+DROP TABLE IF EXISTS project_ingestion_hook CASCADE;
+DROP TABLE IF EXISTS metering_event CASCADE;
+DROP TABLE IF EXISTS project_run_limits CASCADE;
+DROP TABLE IF EXISTS quota CASCADE;
+DROP TABLE IF EXISTS handoff_bundle_item CASCADE;
+DROP TABLE IF EXISTS handoff_bundle CASCADE;
+DROP TABLE IF EXISTS audit_anchor CASCADE;
+DROP TABLE IF EXISTS audit_chain_head CASCADE;
+DROP TABLE IF EXISTS audit_event CASCADE;
+DROP TABLE IF EXISTS api_key CASCADE;
+DROP TABLE IF EXISTS chat_truncation CASCADE;
+DROP TABLE IF EXISTS context_window_policy CASCADE;
+DROP TABLE IF EXISTS msg_diff CASCADE;
+DROP TABLE IF EXISTS msg_translation CASCADE;
 DROP TABLE IF EXISTS msg CASCADE;
 DROP TABLE IF EXISTS choice CASCADE;
 DROP TABLE IF EXISTS chat CASCADE;
+DROP TABLE IF EXISTS consent_request CASCADE;
 DROP TABLE IF EXISTS supervisionresult CASCADE;
 DROP TABLE IF EXISTS supervisionrequest_status CASCADE;
 DROP TABLE IF EXISTS supervisionrequest CASCADE;
 DROP TABLE IF EXISTS chainexecution CASCADE;
+DROP TABLE IF EXISTS toolcall_dependency CASCADE;
 DROP TABLE IF EXISTS toolcall CASCADE;
 DROP TABLE IF EXISTS chain_tool CASCADE;
 DROP TABLE IF EXISTS chain_supervisor CASCADE;
+DROP TABLE IF EXISTS project_incident CASCADE;
+DROP TABLE IF EXISTS project_notification_settings CASCADE;
+DROP TABLE IF EXISTS project_tool_policy CASCADE;
 DROP TABLE IF EXISTS user_project CASCADE;
 DROP TABLE IF EXISTS tool CASCADE;
 DROP TABLE IF EXISTS run CASCADE;
+DROP TABLE IF EXISTS agent CASCADE;
 DROP TABLE IF EXISTS chain CASCADE;
 DROP TABLE IF EXISTS supervisor CASCADE;
 DROP TABLE IF EXISTS project CASCADE;
+DROP TABLE IF EXISTS kill_switch_request CASCADE;
+DROP TABLE IF EXISTS organization_kill_switch CASCADE;
+DROP TABLE IF EXISTS organization_tool_policy CASCADE;
+DROP TABLE IF EXISTS organization CASCADE;
 DROP TABLE IF EXISTS asteroid_user CASCADE;
 DROP TABLE IF EXISTS task CASCADE;
 
@@ -26,11 +90,67 @@ CREATE TABLE asteroid_user (
     email TEXT NOT NULL UNIQUE
 );
 
+CREATE TABLE organization (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE organization_tool_policy (
+    organization_id UUID REFERENCES organization(id) NOT NULL,
+    tool_name TEXT NOT NULL,
+    risk_tier TEXT NOT NULL CHECK (risk_tier IN ('low', 'medium', 'high', 'critical')),
+    chains JSONB DEFAULT '[]' NOT NULL,
+    locked BOOLEAN DEFAULT FALSE NOT NULL,
+    PRIMARY KEY (organization_id, tool_name)
+);
+
+CREATE TABLE organization_kill_switch (
+    organization_id UUID PRIMARY KEY REFERENCES organization(id),
+    active BOOLEAN DEFAULT FALSE NOT NULL,
+    reason TEXT,
+    updated_at TIMESTAMP WITH TIME ZONE,
+    requested_by TEXT,
+    confirmed_by TEXT
+);
+
+CREATE TABLE kill_switch_request (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID REFERENCES organization(id) NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('activate', 'deactivate')),
+    reason TEXT,
+    requested_by TEXT NOT NULL,
+    requested_by_key_id UUID NOT NULL,
+    requested_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    confirmed_by TEXT,
+    confirmed_at TIMESTAMP WITH TIME ZONE
+);
+
 CREATE TABLE project (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     name TEXT DEFAULT '' UNIQUE,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    run_result_tags TEXT[] DEFAULT '{"success", "failure"}' NOT NULL
+    run_result_tags TEXT[] DEFAULT '{"success", "failure"}' NOT NULL,
+    organization_id UUID REFERENCES organization(id)
+);
+
+CREATE TABLE api_key (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    prefix TEXT NOT NULL,
+    key_hash TEXT NOT NULL UNIQUE,
+    scopes TEXT[] DEFAULT '{}' NOT NULL,
+    -- Keys of a project can only reach that project's resources, keys without one reach every project
+    project_id UUID REFERENCES project(id),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    -- The key this one was rotated into
+    rotated_to UUID REFERENCES api_key(id),
+    -- The user whose decisions the key records as theirs
+    user_id UUID REFERENCES asteroid_user(id)
 );
 
 CREATE TABLE supervisor (
@@ -38,14 +158,19 @@ CREATE TABLE supervisor (
     name TEXT DEFAULT '',
     description TEXT DEFAULT '',
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    type TEXT DEFAULT 'no_supervisor' CHECK (type in ('human_supervisor', 'client_supervisor', 'no_supervisor')),
+    type TEXT DEFAULT 'no_supervisor' CHECK (type in ('human_supervisor', 'client_supervisor', 'no_supervisor', 'consent_supervisor', 'ensemble_supervisor', 'policy_supervisor', 'llm_supervisor', 'computer_use_supervisor', 'servicenow_supervisor')),
     code TEXT DEFAULT '',
     attributes JSONB DEFAULT '{}' NOT NULL
 );
 
 CREATE TABLE chain (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    min_confidence DOUBLE PRECISION NULL CHECK (min_confidence BETWEEN 0 AND 1),
+    timeout_seconds INTEGER NULL CHECK (timeout_seconds > 0),
+    timeout_fallback TEXT NULL CHECK (timeout_fallback IN ('auto_approve', 'auto_reject', 'escalate_to_next')),
+    -- The version of chain_supervisor new tool calls are supervised by
+    version INTEGER NOT NULL DEFAULT 1
 );
 
 CREATE TABLE task (
@@ -56,12 +181,27 @@ CREATE TABLE task (
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
 );
 
+CREATE TABLE agent (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID REFERENCES project(id) NOT NULL,
+    name TEXT NOT NULL,
+    version TEXT NOT NULL,
+    capabilities TEXT[] DEFAULT '{}' NOT NULL,
+    tools TEXT[] DEFAULT '{}' NOT NULL,
+    tool_policies JSONB DEFAULT '[]' NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (project_id, name, version)
+);
+
 CREATE TABLE run (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     task_id UUID REFERENCES task(id),
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    status TEXT DEFAULT 'pending' CHECK (status IN ('pending', 'completed', 'failed')) NOT NULL,
-    result TEXT DEFAULT ''
+    status TEXT DEFAULT 'pending' CHECK (status IN ('pending', 'completed', 'failed', 'paused')) NOT NULL,
+    result TEXT DEFAULT '',
+    agent_id UUID REFERENCES agent(id),
+    autonomy_level INTEGER CHECK (autonomy_level BETWEEN 0 AND 3),
+    priority TEXT NOT NULL DEFAULT 'interactive' CHECK (priority IN ('realtime', 'interactive', 'batch'))
 );
 
 CREATE TABLE tool (
@@ -71,7 +211,9 @@ CREATE TABLE tool (
     description TEXT DEFAULT '',
     attributes JSONB DEFAULT '{}' NOT NULL,
     ignored_attributes TEXT[] DEFAULT '{}' NOT NULL,
-    code TEXT DEFAULT ''
+    code TEXT DEFAULT '',
+    -- The JSON Schema of the tool's arguments
+    parameters JSONB
 );
 
 CREATE TABLE user_project (
@@ -80,11 +222,32 @@ CREATE TABLE user_project (
     PRIMARY KEY (user_id, project_id)
 );
 
+CREATE TABLE project_tool_policy (
+    project_id UUID REFERENCES project(id) NOT NULL,
+    tool_name TEXT NOT NULL,
+    risk_tier TEXT NOT NULL CHECK (risk_tier IN ('low', 'medium', 'high', 'critical')),
+    chains JSONB DEFAULT '[]' NOT NULL,
+    PRIMARY KEY (project_id, tool_name)
+);
+
+CREATE TABLE project_notification_settings (
+    project_id UUID PRIMARY KEY REFERENCES project(id),
+    webhook_url TEXT,
+    events JSONB DEFAULT '[]' NOT NULL
+);
+
+-- The supervisors of each version of a chain. Editing a chain adds a version rather than changing
+-- one, as chain executions keep the version they started with.
 CREATE TABLE chain_supervisor (
     supervisor_id UUID REFERENCES supervisor(id),
     chain_id UUID REFERENCES chain(id),
+    version INTEGER NOT NULL DEFAULT 1,
     position_in_chain INTEGER,
-    PRIMARY KEY (supervisor_id, chain_id)
+    timeout_seconds INTEGER NULL CHECK (timeout_seconds > 0),
+    timeout_fallback TEXT NULL CHECK (timeout_fallback IN ('auto_approve', 'auto_reject', 'escalate_to_next')),
+    -- The supervisor's escalation tier, NULL for a tier of its own
+    tier INTEGER NULL CHECK (tier >= 0),
+    PRIMARY KEY (supervisor_id, chain_id, version)
 );
 
 CREATE TABLE chain_tool (
@@ -99,9 +262,18 @@ CREATE TABLE chat (
     request_data JSONB DEFAULT '{}' NOT NULL,
     response_data JSONB DEFAULT '{}' NOT NULL,
     run_id UUID REFERENCES run(id) NOT NULL,
-    format TEXT DEFAULT 'openai' CHECK (format IN ('openai', 'anthropic')) NOT NULL
+    format TEXT DEFAULT 'openai' CHECK (format IN ('openai', 'anthropic', 'gemini')) NOT NULL,
+    -- Hashes of the request and response when they were stored, to detect changes made outside the API
+    request_hash TEXT,
+    response_hash TEXT,
+    -- The model and token usage the provider reported in the response, for all of its choices
+    model TEXT DEFAULT '' NOT NULL,
+    prompt_tokens INTEGER DEFAULT 0 NOT NULL,
+    completion_tokens INTEGER DEFAULT 0 NOT NULL
 );
 
+CREATE INDEX chat_run_idx ON chat (run_id, created_at);
+
 CREATE TABLE choice (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     chat_id UUID REFERENCES chat(id),
@@ -114,7 +286,54 @@ CREATE TABLE msg (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     choice_id UUID REFERENCES choice(id),
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    msg_data JSONB DEFAULT '{}' NOT NULL
+    msg_data JSONB DEFAULT '{}' NOT NULL,
+    content_hash TEXT,
+    -- Searched without stemming, so identifiers like tool names match as they're written
+    search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', coalesce(msg_data->>'content', ''))) STORED
+);
+
+CREATE INDEX msg_search ON msg USING GIN (search_vector);
+
+CREATE TABLE msg_translation (
+    msg_id UUID REFERENCES msg(id) NOT NULL,
+    target_language TEXT NOT NULL,
+    source_language TEXT NOT NULL,
+    content TEXT DEFAULT '' NOT NULL,
+    translated_content TEXT DEFAULT '' NOT NULL,
+    backend TEXT DEFAULT '' NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (msg_id, target_language)
+);
+
+CREATE TABLE msg_diff (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    msg_id UUID REFERENCES msg(id) NOT NULL,
+    original_content TEXT DEFAULT '' NOT NULL,
+    modified_content TEXT DEFAULT '' NOT NULL,
+    segments JSONB DEFAULT '[]' NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE context_window_policy (
+    project_id UUID REFERENCES project(id) NOT NULL,
+    model TEXT NOT NULL,
+    max_context_tokens INTEGER DEFAULT 0 NOT NULL,
+    strategy TEXT NOT NULL,
+    PRIMARY KEY (project_id, model)
+);
+
+CREATE TABLE chat_truncation (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    run_id UUID REFERENCES run(id) NOT NULL,
+    chat_id UUID REFERENCES chat(id),
+    model TEXT NOT NULL,
+    strategy TEXT NOT NULL,
+    max_context_tokens INTEGER NOT NULL,
+    original_tokens INTEGER NOT NULL,
+    final_tokens INTEGER NOT NULL,
+    dropped_messages JSONB DEFAULT '[]' NOT NULL,
+    summary TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
 );
 
 CREATE TABLE toolcall (
@@ -123,13 +342,26 @@ CREATE TABLE toolcall (
     tool_id UUID REFERENCES tool(id),
     msg_id UUID REFERENCES msg(id),
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    tool_call_data JSONB DEFAULT '{}' NOT NULL
+    tool_call_data JSONB DEFAULT '{}' NOT NULL,
+    search_vector TSVECTOR GENERATED ALWAYS AS (
+        to_tsvector('simple', coalesce(tool_call_data->>'name', '') || ' ' || coalesce(tool_call_data->>'arguments', ''))
+    ) STORED
+);
+
+CREATE INDEX toolcall_search ON toolcall USING GIN (search_vector);
+
+CREATE TABLE toolcall_dependency (
+    toolcall_id UUID REFERENCES toolcall(id) NOT NULL,
+    depends_on_toolcall_id UUID REFERENCES toolcall(id) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (toolcall_id, depends_on_toolcall_id)
 );
 
 CREATE TABLE chainexecution (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     toolcall_id UUID REFERENCES toolcall(id),
     chain_id UUID REFERENCES chain(id),
+    chain_version INTEGER NOT NULL DEFAULT 1,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
 );
 
@@ -144,15 +376,664 @@ CREATE TABLE supervisionrequest_status (
     id SERIAL PRIMARY KEY,
     supervisionrequest_id UUID REFERENCES supervisionrequest(id),
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
-    status TEXT DEFAULT 'pending' CHECK (status IN ('timeout', 'pending', 'completed', 'failed', 'assigned'))
+    status TEXT DEFAULT 'pending' CHECK (status IN ('timeout', 'pending', 'completed', 'failed', 'assigned', 'awaiting_clarification'))
 );
 
 CREATE TABLE supervisionresult (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-    supervisionrequest_id UUID REFERENCES supervisionrequest(id),
+    supervisionrequest_id UUID REFERENCES supervisionrequest(id) UNIQUE,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
     decision TEXT DEFAULT 'reject' CHECK (decision IN ('approve', 'reject', 'terminate', 'modify', 'escalate')),
     reasoning TEXT DEFAULT '',
-    toolcall_id UUID REFERENCES toolcall(id) NULL
+    toolcall_id UUID REFERENCES toolcall(id) NULL,
+    verdict TEXT NULL,
+    verdict_behavior TEXT NULL CHECK (verdict_behavior IN ('block', 'continue', 'clarify')),
+    explanation JSONB NULL,
+    overridden_decision TEXT NULL CHECK (overridden_decision IN ('approve', 'reject', 'terminate', 'modify')),
+    timeout_fallback TEXT NULL CHECK (timeout_fallback IN ('auto_approve', 'auto_reject', 'escalate_to_next')),
+    -- The arguments a modify decision executes the tool call with, and the tool call's own at the time
+    modified_arguments TEXT NULL,
+    original_arguments TEXT NULL,
+    -- The user who decided, when it was made with their key or connection
+    user_id UUID REFERENCES asteroid_user(id) NULL,
+    -- The results of earlier decisions this one cites as its precedents
+    precedents UUID[] DEFAULT '{}' NOT NULL,
+    search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', coalesce(reasoning, ''))) STORED
+);
+
+CREATE INDEX supervisionresult_search ON supervisionresult USING GIN (search_vector);
+
+CREATE TABLE consent_request (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    supervisionrequest_id UUID REFERENCES supervisionrequest(id) UNIQUE NOT NULL,
+    token TEXT UNIQUE NOT NULL,
+    status TEXT DEFAULT 'awaiting_consent' CHECK (status IN ('awaiting_consent', 'consent_granted', 'consent_declined', 'consent_expired')) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    responded_at TIMESTAMP WITH TIME ZONE,
+    comment TEXT
+);
+
+CREATE TABLE audit_event (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    actor TEXT NOT NULL,
+    action TEXT NOT NULL,
+    resource_type TEXT NOT NULL,
+    resource_id UUID NOT NULL,
+    details JSONB DEFAULT '{}' NOT NULL,
+    sequence BIGINT UNIQUE NOT NULL,
+    previous_hash TEXT NOT NULL,
+    hash TEXT NOT NULL
+);
+
+CREATE INDEX audit_event_resource_idx ON audit_event (resource_type, resource_id, created_at);
+
+-- The newest event of the audit log's hash chain, locked while an event is appended
+CREATE TABLE audit_chain_head (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    sequence BIGINT NOT NULL,
+    hash TEXT NOT NULL
+);
+
+INSERT INTO audit_chain_head (sequence, hash) VALUES (0, repeat('0', 64));
+
+CREATE TABLE audit_anchor (
+    id UUID PRIMARY KEY,
+    sequence BIGINT NOT NULL,
+    hash TEXT NOT NULL,
+    url TEXT NOT NULL,
+    receipt TEXT,
+    anchored_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX audit_anchor_sequence_idx ON audit_anchor (sequence);
+
+CREATE TABLE handoff_bundle (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    created_by TEXT NOT NULL,
+    restored_at TIMESTAMP WITH TIME ZONE,
+    restored_to TEXT
+);
+
+CREATE TABLE handoff_bundle_item (
+    handoff_bundle_id UUID REFERENCES handoff_bundle(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    supervisionrequest_id UUID REFERENCES supervisionrequest(id) NOT NULL,
+    toolcall_id UUID REFERENCES toolcall(id),
+    status TEXT NOT NULL,
+    assigned_session TEXT,
+    priority TEXT,
+    PRIMARY KEY (handoff_bundle_id, position)
+);
+
+-- At most one incident per project can be open at a time
+CREATE TABLE project_incident (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID REFERENCES project(id) NOT NULL,
+    reason TEXT,
+    supervisor_id UUID REFERENCES supervisor(id) NOT NULL,
+    chain_id UUID REFERENCES chain(id) NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    started_by TEXT NOT NULL,
+    ended_at TIMESTAMP WITH TIME ZONE,
+    ended_by TEXT
+);
+
+CREATE UNIQUE INDEX project_incident_open_idx ON project_incident (project_id) WHERE ended_at IS NULL;
+
+CREATE TABLE quota (
+    scope TEXT NOT NULL CHECK (scope IN ('project', 'organization')),
+    scope_id UUID NOT NULL,
+    metric TEXT NOT NULL CHECK (metric IN ('runs_per_day', 'stored_bytes', 'pending_reviews')),
+    soft_limit BIGINT,
+    hard_limit BIGINT,
+    PRIMARY KEY (scope, scope_id, metric)
+);
+
+-- Limits left NULL fall back to the server's defaults
+CREATE TABLE project_run_limits (
+    project_id UUID PRIMARY KEY REFERENCES project(id) ON DELETE CASCADE,
+    tool_calls_per_minute INTEGER CHECK (tool_calls_per_minute > 0),
+    max_pending_supervisions INTEGER CHECK (max_pending_supervisions > 0),
+    max_chat_requests INTEGER CHECK (max_chat_requests > 0)
+);
+
+-- Append only, billing integrations page through it by sequence
+CREATE TABLE metering_event (
+    sequence BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE,
+    idempotency_key TEXT NOT NULL UNIQUE,
+    metric TEXT NOT NULL CHECK (metric IN ('tool_calls_supervised', 'supervisor_tokens', 'bytes_stored')),
+    quantity BIGINT NOT NULL,
+    project_id UUID REFERENCES project(id) NOT NULL,
+    organization_id UUID REFERENCES organization(id),
+    resource_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Hooks run in order of position
+CREATE TABLE project_ingestion_hook (
+    project_id UUID REFERENCES project(id) NOT NULL,
+    position INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    url TEXT NOT NULL,
+    payload_types JSONB DEFAULT '[]' NOT NULL,
+    timeout_ms INTEGER,
+    fail_open BOOLEAN DEFAULT FALSE NOT NULL,
+    PRIMARY KEY (project_id, position),
+    UNIQUE (project_id, name)
+);
+
+-- Content is kept in the blob store, under documents/<run_id>/<id>
+CREATE TABLE run_document (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    run_id UUID REFERENCES run(id) NOT NULL,
+    name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    include_in_supervisor_context BOOLEAN DEFAULT FALSE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- External resources found in tool call arguments, identifiers are normalized
+CREATE TABLE toolcall_resource (
+    toolcall_id UUID REFERENCES toolcall(id) NOT NULL,
+    run_id UUID REFERENCES run(id) NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('url', 'arn', 'file_path', 'db_table')),
+    identifier TEXT NOT NULL,
+    argument TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (toolcall_id, kind, identifier, argument)
+);
+
+CREATE INDEX toolcall_resource_identifier ON toolcall_resource (identifier text_pattern_ops);
+
+-- Verdicts supervisors can give besides the built in decisions, decision follows from behavior
+CREATE TABLE project_verdict (
+    project_id UUID REFERENCES project(id) NOT NULL,
+    name TEXT NOT NULL,
+    behavior TEXT NOT NULL CHECK (behavior IN ('block', 'continue', 'clarify')),
+    description TEXT,
+    PRIMARY KEY (project_id, name)
+);
+
+-- Questions reviewers ask agents before deciding, a request has at most one open question
+CREATE TABLE clarification (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    supervisionrequest_id UUID REFERENCES supervisionrequest(id) NOT NULL,
+    question JSONB NOT NULL,
+    asked_by TEXT NOT NULL,
+    asked_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    answer TEXT,
+    answered_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX clarification_open ON clarification (supervisionrequest_id) WHERE answered_at IS NULL;
+
+-- Rules apply in order of position, a review needs reviewers with the skills of every rule it matches
+CREATE TABLE project_routing_rule (
+    project_id UUID REFERENCES project(id) NOT NULL,
+    position INTEGER NOT NULL,
+    tool_name TEXT,
+    category TEXT,
+    min_risk_tier TEXT CHECK (min_risk_tier IN ('low', 'medium', 'high', 'critical')),
+    irreversible BOOLEAN,
+    required_skills JSONB DEFAULT '[]' NOT NULL,
+    PRIMARY KEY (project_id, position)
+);
+
+-- Skills and locales admins tagged reviewer sessions with
+CREATE TABLE reviewer (
+    session TEXT PRIMARY KEY,
+    name TEXT,
+    skills JSONB DEFAULT '[]' NOT NULL,
+    locale TEXT,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A request is claimed by one user at a time, who is the only one who can decide it
+CREATE TABLE review_claim (
+    supervisionrequest_id UUID PRIMARY KEY REFERENCES supervisionrequest(id),
+    user_id UUID NOT NULL REFERENCES asteroid_user(id),
+    claimed_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX review_claim_user_idx ON review_claim (user_id);
+
+CREATE TABLE project_trust_policy (
+    project_id UUID PRIMARY KEY REFERENCES project(id),
+    enabled BOOLEAN NOT NULL,
+    approvals_to_relax INTEGER NOT NULL CHECK (approvals_to_relax >= 1),
+    max_autonomy_level INTEGER NOT NULL CHECK (max_autonomy_level BETWEEN 0 AND 3)
+);
+
+CREATE TABLE agent_tool_trust (
+    agent_id UUID NOT NULL REFERENCES agent(id),
+    tool_name TEXT NOT NULL,
+    approvals INTEGER NOT NULL DEFAULT 0,
+    rejections INTEGER NOT NULL DEFAULT 0,
+    consecutive_approvals INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (agent_id, tool_name)
+);
+
+-- The verdict each member of an ensemble supervisor gave, kept to calibrate members individually
+CREATE TABLE ensemble_verdict (
+    id UUID PRIMARY KEY,
+    supervisionrequest_id UUID REFERENCES supervisionrequest(id) NOT NULL,
+    member TEXT NOT NULL,
+    model TEXT NOT NULL,
+    decision TEXT NOT NULL CHECK (decision IN ('approve', 'reject', 'terminate', 'escalate')),
+    confidence DOUBLE PRECISION,
+    rationale TEXT,
+    error TEXT,
+    variant TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX ensemble_verdict_supervisionrequest ON ensemble_verdict (supervisionrequest_id);
+
+-- Example tool calls supervisors are regression tested with, in order of position
+CREATE TABLE supervisor_test_case (
+    supervisor_id UUID REFERENCES supervisor(id) NOT NULL,
+    position INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    tool_name TEXT NOT NULL,
+    tool_description TEXT,
+    arguments TEXT,
+    expected_decision TEXT NOT NULL CHECK (expected_decision IN ('approve', 'reject', 'terminate', 'modify', 'escalate')),
+    PRIMARY KEY (supervisor_id, position)
+);
+
+-- Events external systems posted to runs, decided on by policy supervisors
+CREATE TABLE run_event (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    run_id UUID REFERENCES run(id) NOT NULL,
+    source TEXT NOT NULL,
+    type TEXT NOT NULL,
+    message TEXT,
+    attributes JSONB,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX run_event_run_id_idx ON run_event (run_id, occurred_at);
+
+-- Timers stored so they fire even if the server restarts before they're due
+CREATE TABLE durable_timer (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    kind TEXT NOT NULL CHECK (kind IN ('review_reminder', 'decision_timeout')),
+    supervisionrequest_id UUID REFERENCES supervisionrequest(id) NOT NULL,
+    fire_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    fired_at TIMESTAMP WITH TIME ZONE,
+    attributes JSONB,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX durable_timer_due_idx ON durable_timer (fire_at) WHERE fired_at IS NULL;
+
+-- Tool calls runs submitted for review before making them, approved steps aren't supervised again
+CREATE TABLE plan (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    run_id UUID REFERENCES run(id) NOT NULL,
+    argument_tolerance DOUBLE PRECISION NOT NULL DEFAULT 0,
+    decided_by TEXT,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    reasoning TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX plan_run_id_idx ON plan (run_id, created_at);
+
+CREATE TABLE plan_step (
+    plan_id UUID REFERENCES plan(id) ON DELETE CASCADE NOT NULL,
+    position INTEGER NOT NULL,
+    tool_name TEXT NOT NULL,
+    arguments TEXT NOT NULL,
+    description TEXT,
+    decision TEXT CHECK (decision IN ('approve', 'reject')),
+    toolcall_id UUID REFERENCES toolcall(id),
+    PRIMARY KEY (plan_id, position)
+);
+
+-- Tool calls that strayed from their run's approved plan
+CREATE TABLE plan_deviation (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    plan_id UUID REFERENCES plan(id) ON DELETE CASCADE NOT NULL,
+    toolcall_id UUID REFERENCES toolcall(id) NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('out_of_order', 'argument_mismatch', 'unplanned_tool')),
+    expected_position INTEGER,
+    step_position INTEGER,
+    similarity DOUBLE PRECISION,
+    diff JSONB,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX plan_deviation_plan_id_idx ON plan_deviation (plan_id, created_at);
+CREATE INDEX plan_deviation_toolcall_id_idx ON plan_deviation (toolcall_id);
+
+-- Patterns checked against streamed chat completions as they're generated, in order of position
+CREATE TABLE project_chat_supervisor (
+    project_id UUID REFERENCES project(id) NOT NULL,
+    position INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    pattern TEXT NOT NULL,
+    reason TEXT,
+    PRIMARY KEY (project_id, position),
+    UNIQUE (project_id, name)
+);
+
+-- Daily compliance archives exported to the archive bucket
+CREATE TABLE archive (
+    day DATE PRIMARY KEY,
+    location TEXT NOT NULL,
+    manifest_sha256 TEXT NOT NULL,
+    records INTEGER NOT NULL,
+    retain_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    exported_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX run_created_at_idx ON run (created_at);
+CREATE INDEX supervisionresult_created_at_idx ON supervisionresult (created_at);
+CREATE INDEX audit_event_created_at_idx ON audit_event (created_at);
+
+-- Rules that alert on changes in a project's behavior, in order of position
+CREATE TABLE project_alert_rule (
+    project_id UUID REFERENCES project(id) NOT NULL,
+    position INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('new_tool', 'rate_change')),
+    tool_names TEXT[],
+    metric TEXT CHECK (metric IN ('rejection_rate', 'escalation_rate', 'decision_volume')),
+    window_seconds INTEGER,
+    factor DOUBLE PRECISION,
+    min_decisions INTEGER,
+    PRIMARY KEY (project_id, position),
+    UNIQUE (project_id, name)
+);
+
+-- Alerts fired by project alert rules, at most one per rule and key
+CREATE TABLE alert (
+    id UUID PRIMARY KEY,
+    project_id UUID REFERENCES project(id) NOT NULL,
+    rule_name TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    key TEXT NOT NULL,
+    message TEXT NOT NULL,
+    details JSONB NOT NULL DEFAULT '{}',
+    fired_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (project_id, rule_name, key)
+);
+
+CREATE INDEX alert_project_id_idx ON alert (project_id, fired_at);
+
+-- Runs paused by an operator, with the status they get back when resumed
+CREATE TABLE run_pause (
+    run_id UUID PRIMARY KEY REFERENCES run(id),
+    paused_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    paused_by TEXT NOT NULL,
+    reason TEXT,
+    previous_status TEXT NOT NULL
+);
+
+-- Backfills supervise a project's historical tool calls with shadow chains, recording what they
+-- would have decided without creating supervision requests
+CREATE TABLE backfill (
+    id UUID PRIMARY KEY,
+    project_id UUID REFERENCES project(id) NOT NULL,
+    chain_id UUID REFERENCES chain(id),
+    from_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    to_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    rate_per_minute INTEGER NOT NULL CHECK (rate_per_minute > 0),
+    status TEXT NOT NULL CHECK (status IN ('backfill_running', 'backfill_paused', 'backfill_completed')),
+    total_calls INTEGER NOT NULL,
+    truncated BOOLEAN NOT NULL DEFAULT FALSE,
+    evaluated INTEGER NOT NULL DEFAULT 0,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX backfill_project ON backfill (project_id, created_at);
+
+CREATE TABLE backfill_result (
+    backfill_id UUID REFERENCES backfill(id) NOT NULL,
+    toolcall_id UUID REFERENCES toolcall(id) NOT NULL,
+    tool_name TEXT NOT NULL,
+    called_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    predicted TEXT,
+    confidence DOUBLE PRECISION,
+    reasoning TEXT NOT NULL,
+    actual TEXT,
+    actually_escalated BOOLEAN NOT NULL DEFAULT FALSE,
+    evaluated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (backfill_id, toolcall_id)
+);
+
+-- Files agents upload during runs, their content is in the blob store
+CREATE TABLE run_artifact (
+    id UUID PRIMARY KEY,
+    run_id UUID REFERENCES run(id) NOT NULL,
+    toolcall_id UUID REFERENCES toolcall(id),
+    name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    sha256 TEXT NOT NULL,
+    uploaded_by TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX run_artifact_run ON run_artifact (run_id, created_at);
+CREATE INDEX run_artifact_toolcall ON run_artifact (toolcall_id, created_at);
+
+-- Callback URLs projects register for their events. Each event a webhook is sent is queued as a
+-- delivery, which is retried until the webhook accepts it or every attempt failed.
+CREATE TABLE webhook (
+    id UUID PRIMARY KEY,
+    project_id UUID REFERENCES project(id) NOT NULL,
+    url TEXT NOT NULL,
+    events JSONB NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    secret TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX webhook_project ON webhook (project_id, created_at);
+
+CREATE TABLE webhook_delivery (
+    id UUID PRIMARY KEY,
+    webhook_id UUID REFERENCES webhook(id) ON DELETE CASCADE NOT NULL,
+    event TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('queued', 'delivered', 'abandoned')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_status_code INTEGER,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    delivered_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX webhook_delivery_due ON webhook_delivery (next_attempt_at) WHERE status = 'queued';
+CREATE INDEX webhook_delivery_webhook ON webhook_delivery (webhook_id, created_at);
+
+-- Rules that decide a project's tool calls by their arguments before any supervisor does, tried in
+-- order of position
+CREATE TABLE project_argument_rule (
+    project_id UUID REFERENCES project(id) NOT NULL,
+    name TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    tool_name TEXT NOT NULL,
+    chain_id UUID REFERENCES chain(id) ON DELETE CASCADE,
+    conditions JSONB NOT NULL,
+    decision TEXT NOT NULL CHECK (decision IN ('approve', 'reject')),
+    PRIMARY KEY (project_id, name)
+);
+
+-- What voice agents and their callers say, as it's transcribed. An utterance is the text of its
+-- segments in the order they were created.
+CREATE TABLE run_transcript_segment (
+    id UUID PRIMARY KEY,
+    run_id UUID REFERENCES run(id) NOT NULL,
+    utterance_id TEXT NOT NULL,
+    speaker TEXT NOT NULL CHECK (speaker IN ('agent', 'caller')),
+    text TEXT NOT NULL,
+    offset_ms INTEGER,
+    barge_in JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX run_transcript_segment_run ON run_transcript_segment (run_id, created_at);
+CREATE INDEX run_transcript_segment_utterance ON run_transcript_segment (run_id, utterance_id, created_at);
+
+-- Templates a project's webhook deliveries and notifications are rendered with, one per target
+CREATE TABLE project_payload_template (
+    project_id UUID REFERENCES project(id) NOT NULL,
+    target TEXT NOT NULL CHECK (target IN ('webhooks', 'notifications')),
+    template TEXT NOT NULL,
+    content_type TEXT,
+    PRIMARY KEY (project_id, target)
+);
+
+-- The runs, agents and tools reviewer sessions watch. Exactly one of run_id, agent_id and tool_name is
+-- set, and tools are watched within project_id.
+CREATE TABLE watch_subscription (
+    id UUID PRIMARY KEY,
+    session TEXT NOT NULL,
+    run_id UUID REFERENCES run(id),
+    agent_id UUID REFERENCES agent(id),
+    tool_name TEXT,
+    project_id UUID REFERENCES project(id),
+    events JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX watch_subscription_session ON watch_subscription (session, created_at);
+CREATE INDEX watch_subscription_run ON watch_subscription (run_id) WHERE run_id IS NOT NULL;
+CREATE INDEX watch_subscription_agent ON watch_subscription (agent_id) WHERE agent_id IS NOT NULL;
+CREATE INDEX watch_subscription_tool ON watch_subscription (project_id, tool_name) WHERE tool_name IS NOT NULL;
+
+-- What watch subscriptions matched, in the order sequence gives them
+CREATE TABLE watch_notification (
+    sequence BIGSERIAL PRIMARY KEY,
+    id UUID UNIQUE NOT NULL,
+    subscription_id UUID REFERENCES watch_subscription(id) ON DELETE CASCADE NOT NULL,
+    session TEXT NOT NULL,
+    event TEXT NOT NULL,
+    run_id UUID NOT NULL,
+    tool_call_id UUID,
+    tool_name TEXT,
+    decision TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX watch_notification_session ON watch_notification (session, sequence);
+
+-- The ServiceNow change requests servicenow supervisors open, followed until they decide their
+-- supervision request or it's decided otherwise
+CREATE TABLE change_request (
+    id UUID PRIMARY KEY,
+    supervisionrequest_id UUID REFERENCES supervisionrequest(id) UNIQUE NOT NULL,
+    sys_id TEXT NOT NULL,
+    number TEXT NOT NULL,
+    link TEXT NOT NULL,
+    approval TEXT NOT NULL,
+    state TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    checked_at TIMESTAMP WITH TIME ZONE,
+    closed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX change_request_open ON change_request (checked_at NULLS FIRST, created_at) WHERE closed_at IS NULL;
+
+-- Arguments known to be safe for a project's tools, which calls are compared with for reviewers when
+-- no earlier call of their tool in the run was approved
+CREATE TABLE project_argument_baseline (
+    project_id UUID REFERENCES project(id) NOT NULL,
+    tool_name TEXT NOT NULL,
+    arguments TEXT NOT NULL,
+    description TEXT,
+    PRIMARY KEY (project_id, tool_name)
+);
+
+-- Patterns checked against the results of executed tool calls, in order of position
+CREATE TABLE project_result_supervisor (
+    project_id UUID REFERENCES project(id) NOT NULL,
+    position INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    pattern TEXT NOT NULL,
+    tool_name TEXT,
+    action TEXT NOT NULL CHECK (action IN ('flag_result', 'quarantine_result', 'hold_result')),
+    reason TEXT,
+    PRIMARY KEY (project_id, position),
+    UNIQUE (project_id, name)
+);
+
+-- The results agents reported for executed tool calls, with the verdicts of their supervision
+CREATE TABLE toolcall_result (
+    toolcall_id UUID PRIMARY KEY REFERENCES toolcall(id),
+    result TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('result_accepted', 'result_flagged', 'result_quarantined', 'result_held')),
+    supervisor TEXT,
+    reason TEXT,
+    decided_by TEXT,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX toolcall_result_status_idx ON toolcall_result (status, created_at);
+
+-- Redactors run over a project's chats before they're stored, in order of position
+CREATE TABLE project_redactor (
+    project_id UUID REFERENCES project(id) NOT NULL,
+    position INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    detector TEXT CHECK (detector IN ('credit_card_number', 'api_credential', 'email_address')),
+    pattern TEXT,
+    field_path TEXT,
+    PRIMARY KEY (project_id, position),
+    UNIQUE (project_id, name)
+);
+
+-- The originals of what was redacted from stored chats, readable only by admins
+CREATE TABLE redaction (
+    id UUID PRIMARY KEY,
+    run_id UUID REFERENCES run(id) NOT NULL,
+    chat_id UUID REFERENCES chat(id) NOT NULL,
+    redactor TEXT NOT NULL,
+    placeholder TEXT NOT NULL,
+    original TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX redaction_run_idx ON redaction (run_id, created_at);
+
+-- Which replica runs each background worker, until the lease expires
+CREATE TABLE worker_lease (
+    worker TEXT PRIMARY KEY,
+    holder TEXT NOT NULL,
+    acquired_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    renewed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- How far each feature flag is rolled out. Flags without a rollout are at their default.
+CREATE TABLE feature_flag_rollout (
+    flag TEXT PRIMARY KEY,
+    percentage INTEGER NOT NULL CHECK (percentage BETWEEN 0 AND 100),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_by TEXT NOT NULL
+);
+
+CREATE TABLE feature_flag_target (
+    flag TEXT REFERENCES feature_flag_rollout(flag) ON DELETE CASCADE NOT NULL,
+    project_id UUID REFERENCES project(id) ON DELETE CASCADE,
+    organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL,
+    CHECK ((project_id IS NULL) <> (organization_id IS NULL))
 );
 
+CREATE INDEX feature_flag_target_flag_idx ON feature_flag_target (flag);
@@ -9,6 +9,9 @@ import (
 	"log"
 	"net"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/cloudsqlconn"
@@ -21,14 +24,73 @@ import (
 )
 
 type PostgresqlStore struct {
-	db *sql.DB
+	db *connections
 }
 
 // Check if PostgresqlStore implements asteroid.Store
 var _ asteroid.Store = &PostgresqlStore{}
 
-// NewPostgresqlStore creates a new PostgreSQL store
+// NewPostgresqlStore creates a new PostgreSQL store, with REALTIME_DB_CONNECTIONS connections of its
+// own for the queries of realtime reviews
 func NewPostgresqlStore() (*PostgresqlStore, error) {
+	reserved := 0
+	if value := os.Getenv("REALTIME_DB_CONNECTIONS"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("REALTIME_DB_CONNECTIONS must be a number of connections")
+		}
+		reserved = parsed
+	}
+
+	db, err := openDatabase()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("error connecting to the database: %w", err)
+	}
+
+	var realtime *sql.DB
+	if reserved > 0 {
+		if realtime, err = openReservedConnections(reserved); err != nil {
+			return nil, err
+		}
+	}
+
+	return &PostgresqlStore{db: &connections{main: db, realtime: realtime}}, nil
+}
+
+// openReservedConnections opens a pool of a number of connections and keeps them all open, so
+// queries on it don't wait to connect
+func openReservedConnections(count int) (*sql.DB, error) {
+	db, err := openDatabase()
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(count)
+	db.SetMaxIdleConns(count)
+
+	conns := make([]*sql.Conn, 0, count)
+	defer func() {
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+	}()
+	for range count {
+		conn, err := db.Conn(context.Background())
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("error opening reserved connection: %w", err)
+		}
+		conns = append(conns, conn)
+	}
+
+	return db, nil
+}
+
+// openDatabase opens a connection pool to the database of the environment
+func openDatabase() (*sql.DB, error) {
 	var db *sql.DB
 	var err error
 
@@ -51,11 +113,7 @@ func NewPostgresqlStore() (*PostgresqlStore, error) {
 		}
 	}
 
-	if err := db.Ping(); err != nil {
-		return nil, fmt.Errorf("error connecting to the database: %w", err)
-	}
-
-	return &PostgresqlStore{db: db}, nil
+	return db, nil
 }
 
 // connectWithCloudSQL handles Cloud SQL connection in production
@@ -113,10 +171,10 @@ func (s *PostgresqlStore) Close() error {
 // ProjectStore implementation
 func (s *PostgresqlStore) CreateProject(ctx context.Context, project asteroid.Project) error {
 	query := `
-		INSERT INTO project (id, name, created_at, run_result_tags)
-		VALUES ($1, $2, $3, $4)`
+		INSERT INTO project (id, name, created_at, run_result_tags, organization_id)
+		VALUES ($1, $2, $3, $4, $5)`
 
-	_, err := s.db.ExecContext(ctx, query, project.Id, project.Name, project.CreatedAt, pq.Array(project.RunResultTags))
+	_, err := s.db.ExecContext(ctx, query, project.Id, project.Name, project.CreatedAt, pq.Array(project.RunResultTags), project.OrganizationId)
 	if err != nil {
 		return fmt.Errorf("error creating project: %w", err)
 	}
@@ -126,7 +184,7 @@ func (s *PostgresqlStore) CreateProject(ctx context.Context, project asteroid.Pr
 
 func (s *PostgresqlStore) GetProject(ctx context.Context, id uuid.UUID) (*asteroid.Project, error) {
 	query := `
-		SELECT id, name, created_at, run_result_tags
+		SELECT id, name, created_at, run_result_tags, organization_id
 		FROM project
 		WHERE id = $1`
 
@@ -136,6 +194,7 @@ func (s *PostgresqlStore) GetProject(ctx context.Context, id uuid.UUID) (*astero
 		&project.Name,
 		&project.CreatedAt,
 		pq.Array(&project.RunResultTags),
+		&project.OrganizationId,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
@@ -149,7 +208,7 @@ func (s *PostgresqlStore) GetProject(ctx context.Context, id uuid.UUID) (*astero
 
 func (s *PostgresqlStore) GetProjectFromName(ctx context.Context, name string) (*asteroid.Project, error) {
 	query := `
-		SELECT id, name, created_at, run_result_tags
+		SELECT id, name, created_at, run_result_tags, organization_id
 		FROM project
 		WHERE name = $1`
 
@@ -159,6 +218,7 @@ func (s *PostgresqlStore) GetProjectFromName(ctx context.Context, name string) (
 		&project.Name,
 		&project.CreatedAt,
 		pq.Array(&project.RunResultTags),
+		&project.OrganizationId,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
@@ -297,7 +357,7 @@ func (s *PostgresqlStore) GetSupervisorFromValues(
 
 func (s *PostgresqlStore) GetProjects(ctx context.Context) ([]asteroid.Project, error) {
 	query := `
-		SELECT id, name, created_at, run_result_tags
+		SELECT id, name, created_at, run_result_tags, organization_id
 		FROM project
 		ORDER BY created_at DESC`
 
@@ -315,6 +375,7 @@ func (s *PostgresqlStore) GetProjects(ctx context.Context) ([]asteroid.Project,
 			&project.Name,
 			&project.CreatedAt,
 			pq.Array(&project.RunResultTags),
+			&project.OrganizationId,
 		); err != nil {
 			return nil, fmt.Errorf("error scanning project: %w", err)
 		}
@@ -326,7 +387,7 @@ func (s *PostgresqlStore) GetProjects(ctx context.Context) ([]asteroid.Project,
 
 func (s *PostgresqlStore) GetRuns(ctx context.Context, taskId uuid.UUID) ([]asteroid.Run, error) {
 	query := `
-		SELECT id, task_id, created_at, status, result
+		SELECT id, task_id, created_at, status, result, agent_id, autonomy_level, priority
 		FROM run
 		WHERE task_id = $1`
 
@@ -339,7 +400,7 @@ func (s *PostgresqlStore) GetRuns(ctx context.Context, taskId uuid.UUID) ([]aste
 	var runs []asteroid.Run
 	for rows.Next() {
 		var run asteroid.Run
-		if err := rows.Scan(&run.Id, &run.TaskId, &run.CreatedAt, &run.Status, &run.Result); err != nil {
+		if err := rows.Scan(&run.Id, &run.TaskId, &run.CreatedAt, &run.Status, &run.Result, &run.AgentId, &run.AutonomyLevel, &run.Priority); err != nil {
 			return nil, fmt.Errorf("error scanning run: %w", err)
 		}
 		runs = append(runs, run)
@@ -351,7 +412,7 @@ func (s *PostgresqlStore) GetRuns(ctx context.Context, taskId uuid.UUID) ([]aste
 
 func (s *PostgresqlStore) GetTaskRuns(ctx context.Context, taskId uuid.UUID) ([]asteroid.Run, error) {
 	query := `
-		SELECT id, task_id, created_at, status, result
+		SELECT id, task_id, created_at, status, result, agent_id, autonomy_level, priority
 		FROM run
 		WHERE task_id = $1`
 
@@ -364,7 +425,7 @@ func (s *PostgresqlStore) GetTaskRuns(ctx context.Context, taskId uuid.UUID) ([]
 	runs := make([]asteroid.Run, 0)
 	for rows.Next() {
 		var run asteroid.Run
-		if err := rows.Scan(&run.Id, &run.TaskId, &run.CreatedAt, &run.Status, &run.Result); err != nil {
+		if err := rows.Scan(&run.Id, &run.TaskId, &run.CreatedAt, &run.Status, &run.Result, &run.AgentId, &run.AutonomyLevel, &run.Priority); err != nil {
 			return nil, fmt.Errorf("error scanning run: %w", err)
 		}
 		runs = append(runs, run)
@@ -411,19 +472,13 @@ func (s *PostgresqlStore) CreateSupervisorChain(ctx context.Context, toolId uuid
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	// Create new chain
-	chainId := uuid.New()
-	query := `
-		INSERT INTO chain (id)
-		VALUES ($1)`
-
-	_, err = tx.ExecContext(ctx, query, chainId)
+	chainId, err := createChain(ctx, tx, chain)
 	if err != nil {
-		return nil, fmt.Errorf("error creating chain: %w", err)
+		return nil, err
 	}
 
 	// Link chain to tool
-	query = `
+	query := `
 		INSERT INTO chain_tool (tool_id, chain_id)
 		VALUES ($1, $2)`
 
@@ -432,19 +487,30 @@ func (s *PostgresqlStore) CreateSupervisorChain(ctx context.Context, toolId uuid
 		return nil, fmt.Errorf("error linking tool to chain: %w", err)
 	}
 
-	// Add chain_supervisor entries for each supervisor
-	query = `
-		INSERT INTO chain_supervisor (chain_id, supervisor_id, position_in_chain)
-		VALUES ($1, $2, $3)`
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("error committing transaction: %w", err)
+	}
 
-	for i, supervisorId := range ids {
-		_, err = tx.ExecContext(ctx, query, chainId, supervisorId, i)
-		if err != nil {
-			return nil, fmt.Errorf("error adding supervisor to chain: %w", err)
-		}
+	return &chainId, nil
+}
+
+func (s *PostgresqlStore) CreateChain(ctx context.Context, chain asteroid.ChainRequest) (*uuid.UUID, error) {
+	if chain.SupervisorIds == nil {
+		return nil, fmt.Errorf("supervisor IDs are required to make a chain of supervisors")
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	chainId, err := createChain(ctx, tx, chain)
+	if err != nil {
+		return nil, err
 	}
 
-	// Commit transaction
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("error committing transaction: %w", err)
 	}
@@ -452,26 +518,116 @@ func (s *PostgresqlStore) CreateSupervisorChain(ctx context.Context, toolId uuid
 	return &chainId, nil
 }
 
+// createChain inserts a chain and its supervisors, in order, with their timeouts and tiers
+func createChain(ctx context.Context, tx *sql.Tx, chain asteroid.ChainRequest) (uuid.UUID, error) {
+	chainId := uuid.New()
+	query := `
+		INSERT INTO chain (id, min_confidence, timeout_seconds, timeout_fallback)
+		VALUES ($1, $2, $3, $4)`
+
+	var timeoutSeconds *int
+	var timeoutFallback *asteroid.TimeoutFallback
+	if chain.Timeout != nil {
+		timeoutSeconds, timeoutFallback = &chain.Timeout.TimeoutSeconds, &chain.Timeout.Fallback
+	}
+
+	_, err := tx.ExecContext(ctx, query, chainId, chain.MinConfidence, timeoutSeconds, timeoutFallback)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error creating chain: %w", err)
+	}
+
+	supervisorTimeouts := make(map[uuid.UUID]asteroid.SupervisorTimeout)
+	if chain.SupervisorTimeouts != nil {
+		for _, timeout := range *chain.SupervisorTimeouts {
+			supervisorTimeouts[timeout.SupervisorId] = timeout
+		}
+	}
+
+	supervisorTiers := make(map[uuid.UUID]int)
+	if chain.SupervisorTiers != nil {
+		for _, tier := range *chain.SupervisorTiers {
+			supervisorTiers[tier.SupervisorId] = tier.Tier
+		}
+	}
+
+	// Add chain_supervisor entries for each supervisor
+	query = `
+		INSERT INTO chain_supervisor (chain_id, supervisor_id, position_in_chain, timeout_seconds, timeout_fallback, tier)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	for i, supervisorId := range *chain.SupervisorIds {
+		var timeoutSeconds *int
+		var timeoutFallback *asteroid.TimeoutFallback
+		if timeout, ok := supervisorTimeouts[supervisorId]; ok {
+			timeoutSeconds, timeoutFallback = &timeout.TimeoutSeconds, &timeout.Fallback
+		}
+		var tier *int
+		if value, ok := supervisorTiers[supervisorId]; ok {
+			tier = &value
+		}
+
+		_, err = tx.ExecContext(ctx, query, chainId, supervisorId, i, timeoutSeconds, timeoutFallback, tier)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("error adding supervisor to chain: %w", err)
+		}
+	}
+
+	return chainId, nil
+}
+
 func (s *PostgresqlStore) GetSupervisorChain(ctx context.Context, chainId uuid.UUID) (*asteroid.SupervisorChain, error) {
+	return s.getSupervisorChain(ctx, chainId, nil)
+}
+
+func (s *PostgresqlStore) GetSupervisorChainVersion(ctx context.Context, chainId uuid.UUID, version int) (*asteroid.SupervisorChain, error) {
+	return s.getSupervisorChain(ctx, chainId, &version)
+}
+
+// getSupervisorChain returns a chain at a version, or at its current one if version is nil. Returns
+// nil if the chain doesn't exist or hasn't reached the version.
+func (s *PostgresqlStore) getSupervisorChain(ctx context.Context, chainId uuid.UUID, version *int) (*asteroid.SupervisorChain, error) {
+	var minConfidence *float64
+	var timeoutSeconds *int
+	var timeoutFallback *asteroid.TimeoutFallback
+	var currentVersion int
+	err := s.db.QueryRowContext(ctx, `SELECT min_confidence, timeout_seconds, timeout_fallback, version FROM chain WHERE id = $1`, chainId).Scan(&minConfidence, &timeoutSeconds, &timeoutFallback, &currentVersion)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting chain: %w", err)
+	}
+
+	if version == nil {
+		version = &currentVersion
+	} else if *version < 1 || *version > currentVersion {
+		return nil, nil
+	}
+
 	// Order by the position column in chain_supervisor table
 	query := `
-		SELECT s.id, s.name, s.description, s.type, s.attributes, s.created_at, s.code
+		SELECT s.id, s.name, s.description, s.type, s.attributes, s.created_at, s.code, cs.timeout_seconds, cs.timeout_fallback, cs.tier
 		FROM chain_supervisor cs
 		INNER JOIN supervisor s ON cs.supervisor_id = s.id
-		WHERE cs.chain_id = $1
+		WHERE cs.chain_id = $1 AND cs.version = $2
 		ORDER BY cs.position_in_chain ASC`
 
-	rows, err := s.db.QueryContext(ctx, query, chainId)
+	rows, err := s.db.QueryContext(ctx, query, chainId, *version)
 	if err != nil {
 		return nil, fmt.Errorf("error getting tool supervisor chain: %w", err)
 	}
 	defer rows.Close()
 
 	supervisors := make([]asteroid.Supervisor, 0)
+	supervisorTimeouts := make([]asteroid.SupervisorTimeout, 0)
+	supervisorTiers := make([]asteroid.SupervisorTier, 0)
 	for rows.Next() {
 		// Parse out the attributes bytes into json
 		var attributesJSON []byte
 		var supervisor asteroid.Supervisor
+		var supervisorTimeoutSeconds *int
+		var supervisorTimeoutFallback *asteroid.TimeoutFallback
+		var tier *int
 		if err := rows.Scan(
 			&supervisor.Id,
 			&supervisor.Name,
@@ -480,10 +636,25 @@ func (s *PostgresqlStore) GetSupervisorChain(ctx context.Context, chainId uuid.U
 			&attributesJSON,
 			&supervisor.CreatedAt,
 			&supervisor.Code,
+			&supervisorTimeoutSeconds,
+			&supervisorTimeoutFallback,
+			&tier,
 		); err != nil {
 			return nil, fmt.Errorf("error scanning supervisor: %w", err)
 		}
 
+		if supervisor.Id != nil && tier != nil {
+			supervisorTiers = append(supervisorTiers, asteroid.SupervisorTier{SupervisorId: *supervisor.Id, Tier: *tier})
+		}
+
+		if supervisor.Id != nil && supervisorTimeoutSeconds != nil && supervisorTimeoutFallback != nil {
+			supervisorTimeouts = append(supervisorTimeouts, asteroid.SupervisorTimeout{
+				SupervisorId:   *supervisor.Id,
+				TimeoutSeconds: *supervisorTimeoutSeconds,
+				Fallback:       *supervisorTimeoutFallback,
+			})
+		}
+
 		// Parse the JSON attributes if they exist
 		var attributes map[string]interface{}
 		if len(attributesJSON) > 0 {
@@ -496,10 +667,23 @@ func (s *PostgresqlStore) GetSupervisorChain(ctx context.Context, chainId uuid.U
 		supervisors = append(supervisors, supervisor)
 	}
 
-	return &asteroid.SupervisorChain{
-		ChainId:     chainId,
-		Supervisors: supervisors,
-	}, nil
+	chain := &asteroid.SupervisorChain{
+		ChainId:       chainId,
+		Version:       *version,
+		Supervisors:   supervisors,
+		MinConfidence: minConfidence,
+	}
+	if timeoutSeconds != nil && timeoutFallback != nil {
+		chain.Timeout = &asteroid.ChainTimeout{TimeoutSeconds: *timeoutSeconds, Fallback: *timeoutFallback}
+	}
+	if len(supervisorTimeouts) > 0 {
+		chain.SupervisorTimeouts = &supervisorTimeouts
+	}
+	if len(supervisorTiers) > 0 {
+		chain.SupervisorTiers = &supervisorTiers
+	}
+
+	return chain, nil
 }
 
 func (s *PostgresqlStore) GetSupervisorChains(ctx context.Context, toolId uuid.UUID) ([]asteroid.SupervisorChain, error) {
@@ -520,6 +704,64 @@ func (s *PostgresqlStore) GetSupervisorChains(ctx context.Context, toolId uuid.U
 	return chains, nil
 }
 
+func (s *PostgresqlStore) CreateSupervisorChainVersion(ctx context.Context, chainId uuid.UUID, version int, supervisorIds []uuid.UUID, supervisorTimeouts []asteroid.SupervisorTimeout, supervisorTiers []asteroid.SupervisorTier) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Bumping the version first locks the chain, so of two edits of the same version only one is made
+	res, err := tx.ExecContext(ctx, `UPDATE chain SET version = $2 WHERE id = $1 AND version = $2 - 1`, chainId, version)
+	if err != nil {
+		return fmt.Errorf("error updating chain version: %w", err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting updated chains: %w", err)
+	}
+	if updated == 0 {
+		return asteroid.ErrChainVersionConflict
+	}
+
+	timeouts := make(map[uuid.UUID]asteroid.SupervisorTimeout, len(supervisorTimeouts))
+	for _, timeout := range supervisorTimeouts {
+		timeouts[timeout.SupervisorId] = timeout
+	}
+
+	tiers := make(map[uuid.UUID]int, len(supervisorTiers))
+	for _, tier := range supervisorTiers {
+		tiers[tier.SupervisorId] = tier.Tier
+	}
+
+	query := `
+		INSERT INTO chain_supervisor (chain_id, supervisor_id, version, position_in_chain, timeout_seconds, timeout_fallback, tier)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	for i, supervisorId := range supervisorIds {
+		var timeoutSeconds *int
+		var timeoutFallback *asteroid.TimeoutFallback
+		if timeout, ok := timeouts[supervisorId]; ok {
+			timeoutSeconds, timeoutFallback = &timeout.TimeoutSeconds, &timeout.Fallback
+		}
+		var tier *int
+		if value, ok := tiers[supervisorId]; ok {
+			tier = &value
+		}
+
+		_, err = tx.ExecContext(ctx, query, chainId, supervisorId, version, i, timeoutSeconds, timeoutFallback, tier)
+		if err != nil {
+			return fmt.Errorf("error adding supervisor to chain: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
 func (s *PostgresqlStore) GetToolCallFromCallId(ctx context.Context, id string) (*asteroid.AsteroidToolCall, error) {
 	query := `
 		SELECT id, call_id, created_at, tool_id, tool_call_data
@@ -577,6 +819,154 @@ func (s *PostgresqlStore) GetToolCall(ctx context.Context, id uuid.UUID) (*aster
 	return &toolCall, nil
 }
 
+func (s *PostgresqlStore) GetProjectToolCalls(ctx context.Context, projectId uuid.UUID, from time.Time, to time.Time, limit int) ([]asteroid.AsteroidToolCall, error) {
+	query := `
+		SELECT tc.id, tc.call_id, tc.created_at, tc.tool_id, t.name, tc.tool_call_data
+		FROM toolcall tc
+		JOIN tool t ON tc.tool_id = t.id
+		JOIN run r ON t.run_id = r.id
+		JOIN task ta ON r.task_id = ta.id
+		WHERE ta.project_id = $1 AND tc.created_at >= $2 AND tc.created_at < $3
+		ORDER BY tc.created_at, tc.id
+		LIMIT $4`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId, from, to, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project tool calls: %w", err)
+	}
+	defer rows.Close()
+
+	return scanNamedToolCalls(rows)
+}
+
+func (s *PostgresqlStore) CountProjectToolCalls(ctx context.Context, projectId uuid.UUID, from time.Time, to time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM toolcall tc
+		JOIN tool t ON tc.tool_id = t.id
+		JOIN run r ON t.run_id = r.id
+		JOIN task ta ON r.task_id = ta.id
+		WHERE ta.project_id = $1 AND tc.created_at >= $2 AND tc.created_at < $3`
+
+	var count int
+	if err := s.db.QueryRowContext(ctx, query, projectId, from, to).Scan(&count); err != nil {
+		return 0, fmt.Errorf("error counting project tool calls: %w", err)
+	}
+
+	return count, nil
+}
+
+func (s *PostgresqlStore) GetRunToolCalls(ctx context.Context, runId uuid.UUID) ([]asteroid.AsteroidToolCall, error) {
+	query := `
+		SELECT tc.id, tc.call_id, tc.created_at, tc.tool_id, t.name, tc.tool_call_data
+		FROM toolcall tc
+		JOIN tool t ON tc.tool_id = t.id
+		WHERE t.run_id = $1
+		ORDER BY tc.created_at, tc.id`
+
+	rows, err := s.db.QueryContext(ctx, query, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting run tool calls: %w", err)
+	}
+	defer rows.Close()
+
+	return scanNamedToolCalls(rows)
+}
+
+// scanNamedToolCalls scans tool calls selected with the name of their tool
+func scanNamedToolCalls(rows *sql.Rows) ([]asteroid.AsteroidToolCall, error) {
+	toolCalls := make([]asteroid.AsteroidToolCall, 0)
+	for rows.Next() {
+		var toolCall asteroid.AsteroidToolCall
+		var name string
+		var toolCallDataJSON []byte
+		if err := rows.Scan(
+			&toolCall.Id,
+			&toolCall.CallId,
+			&toolCall.CreatedAt,
+			&toolCall.ToolId,
+			&name,
+			&toolCallDataJSON,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning tool call: %w", err)
+		}
+
+		args := string(toolCallDataJSON)
+		toolCall.Name = &name
+		toolCall.Arguments = &args
+		toolCalls = append(toolCalls, toolCall)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tool calls: %w", err)
+	}
+
+	return toolCalls, nil
+}
+
+func (s *PostgresqlStore) SetToolCallDependencies(ctx context.Context, toolCallId uuid.UUID, dependsOn []uuid.UUID) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM toolcall_dependency WHERE toolcall_id = $1`, toolCallId)
+	if err != nil {
+		return fmt.Errorf("error deleting tool call dependencies: %w", err)
+	}
+
+	query := `
+		INSERT INTO toolcall_dependency (toolcall_id, depends_on_toolcall_id)
+		VALUES ($1, $2)`
+
+	for _, dependencyId := range dependsOn {
+		_, err = tx.ExecContext(ctx, query, toolCallId, dependencyId)
+		if err != nil {
+			return fmt.Errorf("error creating tool call dependency: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetToolCallDependencies(ctx context.Context, toolCallId uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+		SELECT depends_on_toolcall_id FROM toolcall_dependency WHERE toolcall_id = $1 ORDER BY created_at ASC`
+
+	return s.queryToolCallIds(ctx, query, toolCallId)
+}
+
+func (s *PostgresqlStore) GetToolCallDependents(ctx context.Context, toolCallId uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+		SELECT toolcall_id FROM toolcall_dependency WHERE depends_on_toolcall_id = $1 ORDER BY created_at ASC`
+
+	return s.queryToolCallIds(ctx, query, toolCallId)
+}
+
+func (s *PostgresqlStore) queryToolCallIds(ctx context.Context, query string, toolCallId uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := s.db.QueryContext(ctx, query, toolCallId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool call dependencies: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("error scanning tool call ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
 func (s *PostgresqlStore) GetChainExecutionsFromToolCall(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
 	query := `
 			SELECT id FROM chainexecution WHERE toolcall_id = $1`
@@ -636,9 +1026,10 @@ func (s *PostgresqlStore) createChainExecution(
 	toolCallId uuid.UUID,
 	tx *sql.Tx,
 ) (*uuid.UUID, error) {
+	// Executions keep the version of the chain they started with
 	query := `
-		INSERT INTO chainexecution (id, chain_id, toolcall_id)
-		VALUES ($1, $2, $3)`
+		INSERT INTO chainexecution (id, chain_id, toolcall_id, chain_version)
+		SELECT $1, $2, $3, version FROM chain WHERE id = $2`
 
 	id := uuid.New()
 	_, err := tx.ExecContext(ctx, query, id, chainId, toolCallId)
@@ -709,39 +1100,90 @@ func (s *PostgresqlStore) CreateSupervisionRequest(
 	return &requestID, nil
 }
 
-func (s *PostgresqlStore) getChainExecutionForToolCall(ctx context.Context, chainId uuid.UUID, toolCallId uuid.UUID, tx *sql.Tx) (*uuid.UUID, error) {
-	query := `
-		SELECT id 
-		FROM chainexecution 
-		WHERE chain_id = $1 AND toolcall_id = $2`
-
-	var id uuid.UUID
-	err := tx.QueryRowContext(ctx, query, chainId, toolCallId).Scan(&id)
-	if err != nil {
-		return nil, fmt.Errorf("error getting chain execution for tool call: %w", err)
-	}
-
-	return &id, nil
-}
-
-func (s *PostgresqlStore) CreateSupervisionStatus(ctx context.Context, requestID uuid.UUID, status asteroid.SupervisionStatus) error {
+func (s *PostgresqlStore) ImportSupervisionRequest(
+	ctx context.Context,
+	request asteroid.SupervisionRequest,
+	chainId uuid.UUID,
+	toolCallId uuid.UUID,
+	statuses []asteroid.SupervisionStatus,
+	result *asteroid.SupervisionResult,
+) (uuid.UUID, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("error starting transaction: %w", err)
+		return uuid.Nil, fmt.Errorf("error starting transaction: %w", err)
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	err = s.createSupervisionStatus(ctx, requestID, status, tx)
+	ceId, err := s.getChainExecutionForToolCall(ctx, chainId, toolCallId, tx)
 	if err != nil {
-		return fmt.Errorf("error creating supervisor status: %w", err)
+		return uuid.Nil, fmt.Errorf("error getting chain execution: %w", err)
 	}
-
-	err = tx.Commit()
-	if err != nil {
-		return fmt.Errorf("error committing transaction: %w", err)
+	if ceId == nil {
+		return uuid.Nil, fmt.Errorf("chain execution not found for tool call %s and chain %s", toolCallId, chainId)
 	}
 
-	return nil
+	query := `
+		INSERT INTO supervisionrequest (id, supervisor_id, position_in_chain, chainexecution_id)
+		VALUES ($1, $2, $3, $4)`
+
+	requestID := uuid.New()
+	_, err = tx.ExecContext(ctx, query, requestID, request.SupervisorId, request.PositionInChain, ceId)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error creating supervision request: %w", err)
+	}
+
+	for _, status := range statuses {
+		if err := s.createSupervisionStatus(ctx, requestID, status, tx); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	if result != nil {
+		if _, err := s.createSupervisionResult(ctx, tx, *result, requestID); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.Nil, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return requestID, nil
+}
+
+func (s *PostgresqlStore) getChainExecutionForToolCall(ctx context.Context, chainId uuid.UUID, toolCallId uuid.UUID, tx *sql.Tx) (*uuid.UUID, error) {
+	query := `
+		SELECT id 
+		FROM chainexecution 
+		WHERE chain_id = $1 AND toolcall_id = $2`
+
+	var id uuid.UUID
+	err := tx.QueryRowContext(ctx, query, chainId, toolCallId).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chain execution for tool call: %w", err)
+	}
+
+	return &id, nil
+}
+
+func (s *PostgresqlStore) CreateSupervisionStatus(ctx context.Context, requestID uuid.UUID, status asteroid.SupervisionStatus) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.createSupervisionStatus(ctx, requestID, status, tx)
+	if err != nil {
+		return fmt.Errorf("error creating supervisor status: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
 }
 
 func (s *PostgresqlStore) createSupervisionStatus(ctx context.Context, requestID uuid.UUID, status asteroid.SupervisionStatus, tx *sql.Tx) error {
@@ -759,12 +1201,12 @@ func (s *PostgresqlStore) createSupervisionStatus(ctx context.Context, requestID
 
 func (s *PostgresqlStore) GetTool(ctx context.Context, id uuid.UUID) (*asteroid.Tool, error) {
 	query := `
-		SELECT id, run_id, name, description, attributes, ignored_attributes, code
+		SELECT id, run_id, name, description, attributes, ignored_attributes, code, parameters
 		FROM tool
 		WHERE id = $1`
 
 	var tool asteroid.Tool
-	var attributesJSON []byte
+	var attributesJSON, parametersJSON []byte
 	var ignoredAttributes []string
 	err := s.db.QueryRowContext(ctx, query, id).Scan(
 		&tool.Id,
@@ -774,6 +1216,7 @@ func (s *PostgresqlStore) GetTool(ctx context.Context, id uuid.UUID) (*asteroid.
 		&attributesJSON,
 		pq.Array(&ignoredAttributes),
 		&tool.Code,
+		&parametersJSON,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
@@ -791,10 +1234,27 @@ func (s *PostgresqlStore) GetTool(ctx context.Context, id uuid.UUID) (*asteroid.
 		tool.Attributes = attributes
 	}
 
+	if tool.Parameters, err = parseToolParameters(parametersJSON); err != nil {
+		return nil, err
+	}
+
 	tool.IgnoredAttributes = &ignoredAttributes
 	return &tool, nil
 }
 
+// parseToolParameters parses the JSON Schema a tool was registered with, if it was
+func parseToolParameters(parametersJSON []byte) (*map[string]interface{}, error) {
+	if len(parametersJSON) == 0 {
+		return nil, nil
+	}
+
+	var parameters map[string]interface{}
+	if err := json.Unmarshal(parametersJSON, &parameters); err != nil {
+		return nil, fmt.Errorf("error parsing tool parameters: %w", err)
+	}
+	return &parameters, nil
+}
+
 func (s *PostgresqlStore) GetProjectTools(ctx context.Context, projectId uuid.UUID) ([]asteroid.Tool, error) {
 	query := `
 		SELECT DISTINCT r.id
@@ -878,6 +1338,47 @@ func (s *PostgresqlStore) CountSupervisionRequests(ctx context.Context, status a
 	return count, nil
 }
 
+// marshalExplanation encodes a result's explanation for its JSONB column, which is NULL without one.
+// A nil []byte would be sent as an empty string, so results without one get a nil interface.
+func marshalExplanation(explanation *asteroid.ResultExplanation) (any, error) {
+	if explanation == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(explanation)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling result explanation: %w", err)
+	}
+	return data, nil
+}
+
+// parseExplanation decodes a result's explanation, returning nil for results without one
+// optionalUUIDs scans a UUID array into an optional list, left unset when the array is empty
+type optionalUUIDs struct {
+	dest **[]uuid.UUID
+}
+
+func (o optionalUUIDs) Scan(src any) error {
+	var ids []uuid.UUID
+	if err := pq.Array(&ids).Scan(src); err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		*o.dest = &ids
+	}
+	return nil
+}
+
+func parseExplanation(data []byte) (*asteroid.ResultExplanation, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var explanation asteroid.ResultExplanation
+	if err := json.Unmarshal(data, &explanation); err != nil {
+		return nil, fmt.Errorf("error parsing result explanation: %w", err)
+	}
+	return &explanation, nil
+}
+
 func (s *PostgresqlStore) CreateSupervisionResult(ctx context.Context, result asteroid.SupervisionResult, requestId uuid.UUID) (*uuid.UUID, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -885,12 +1386,64 @@ func (s *PostgresqlStore) CreateSupervisionResult(ctx context.Context, result as
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	id, err := s.createSupervisionResult(ctx, tx, result, requestId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return &id, nil
+}
+
+// CreateSupervisionResults stores the results of several supervision requests in one transaction, so
+// none is stored if any of the requests already has a result
+func (s *PostgresqlStore) CreateSupervisionResults(ctx context.Context, results []asteroid.SupervisionResult) ([]uuid.UUID, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	ids := make([]uuid.UUID, 0, len(results))
+	for _, result := range results {
+		id, err := s.createSupervisionResult(ctx, tx, result, result.SupervisionRequestId)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (s *PostgresqlStore) createSupervisionResult(ctx context.Context, tx *sql.Tx, result asteroid.SupervisionResult, requestId uuid.UUID) (uuid.UUID, error) {
+	// A request only ever gets one result, so a second one is a conflict rather than an error
 	query := `
-		INSERT INTO supervisionresult (id, supervisionrequest_id, created_at, decision, reasoning, toolcall_id)
-		VALUES ($1, $2, $3, $4, $5, $6)`
+		INSERT INTO supervisionresult (id, supervisionrequest_id, created_at, decision, reasoning, toolcall_id, verdict, verdict_behavior, explanation, overridden_decision, timeout_fallback, modified_arguments, original_arguments, user_id, precedents)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		ON CONFLICT (supervisionrequest_id) DO NOTHING`
+
+	explanation, err := marshalExplanation(result.Explanation)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	precedents := make([]uuid.UUID, 0)
+	if result.Precedents != nil {
+		precedents = *result.Precedents
+	}
 
 	id := uuid.New()
-	_, err = tx.ExecContext(
+	res, err := tx.ExecContext(
 		ctx,
 		query,
 		id,
@@ -899,9 +1452,26 @@ func (s *PostgresqlStore) CreateSupervisionResult(ctx context.Context, result as
 		result.Decision,
 		result.Reasoning,
 		result.ToolcallId,
+		result.Verdict,
+		result.VerdictBehavior,
+		explanation,
+		result.OverriddenDecision,
+		result.TimeoutFallback,
+		result.ModifiedArguments,
+		result.OriginalArguments,
+		result.UserId,
+		pq.Array(precedents),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error creating supervision result: %w", err)
+		return uuid.Nil, fmt.Errorf("error creating supervision result: %w", err)
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error creating supervision result: %w", err)
+	}
+	if inserted == 0 {
+		return uuid.Nil, asteroid.ErrSupervisionRequestResolved
 	}
 
 	// Create a supervisionrequest_status
@@ -910,15 +1480,10 @@ func (s *PostgresqlStore) CreateSupervisionResult(ctx context.Context, result as
 		CreatedAt: result.CreatedAt,
 	}, tx)
 	if err != nil {
-		return nil, fmt.Errorf("error creating supervision status for result: %w", err)
-	}
-
-	err = tx.Commit()
-	if err != nil {
-		return nil, fmt.Errorf("error committing transaction: %w", err)
+		return uuid.Nil, fmt.Errorf("error creating supervision status for result: %w", err)
 	}
 
-	return &id, nil
+	return id, nil
 }
 
 func (s *PostgresqlStore) GetSupervisionRequestsForStatus(ctx context.Context, status asteroid.Status) ([]asteroid.SupervisionRequest, error) {
@@ -933,7 +1498,13 @@ func (s *PostgresqlStore) GetSupervisionRequestsForStatus(ctx context.Context, s
 				GROUP BY supervisionrequest_id
 		) latest ON sr.id = latest.supervisionrequest_id
 		JOIN supervisionrequest_status srs ON srs.id = latest.latest_status_id
+		LEFT JOIN chainexecution ce ON ce.id = sr.chainexecution_id
+		LEFT JOIN toolcall tc ON tc.id = ce.toolcall_id
+		LEFT JOIN tool t ON t.id = tc.tool_id
+		LEFT JOIN run r ON r.id = t.run_id
 		WHERE s.type != $1 AND srs.status = $2
+		ORDER BY CASE COALESCE(r.priority, 'interactive') WHEN 'realtime' THEN 0 WHEN 'interactive' THEN 1 ELSE 2 END,
+			srs.created_at ASC
 	`
 	rows, err := s.db.QueryContext(ctx, query, asteroid.ClientSupervisor, status)
 	if err != nil {
@@ -976,11 +1547,12 @@ func (s *PostgresqlStore) GetSupervisionRequestsForStatus(ctx context.Context, s
 
 func (s *PostgresqlStore) GetSupervisionResultFromRequestID(ctx context.Context, requestId uuid.UUID) (*asteroid.SupervisionResult, error) {
 	query := `
-		SELECT id, supervisionrequest_id, created_at, decision, reasoning, toolcall_id
+		SELECT id, supervisionrequest_id, created_at, decision, reasoning, toolcall_id, verdict, verdict_behavior, explanation, overridden_decision, timeout_fallback, modified_arguments, original_arguments, user_id, precedents
 		FROM supervisionresult
 		WHERE supervisionrequest_id = $1`
 
 	var result asteroid.SupervisionResult
+	var explanation []byte
 	err := s.db.QueryRowContext(ctx, query, requestId).Scan(
 		&result.Id,
 		&result.SupervisionRequestId,
@@ -988,6 +1560,15 @@ func (s *PostgresqlStore) GetSupervisionResultFromRequestID(ctx context.Context,
 		&result.Decision,
 		&result.Reasoning,
 		&result.ToolcallId,
+		&result.Verdict,
+		&result.VerdictBehavior,
+		&explanation,
+		&result.OverriddenDecision,
+		&result.TimeoutFallback,
+		&result.ModifiedArguments,
+		&result.OriginalArguments,
+		&result.UserId,
+		optionalUUIDs{&result.Precedents},
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
@@ -996,14 +1577,27 @@ func (s *PostgresqlStore) GetSupervisionResultFromRequestID(ctx context.Context,
 		return nil, fmt.Errorf("error getting supervision result: %w", err)
 	}
 
+	if result.Explanation, err = parseExplanation(explanation); err != nil {
+		return nil, err
+	}
+
 	return &result, nil
 }
 
+// supervisionRequestPriority selects the priority of the run of the supervision request sr
+const supervisionRequestPriority = `(
+	SELECT r.priority
+	FROM chainexecution ce
+	JOIN toolcall tc ON tc.id = ce.toolcall_id
+	JOIN tool t ON t.id = tc.tool_id
+	JOIN run r ON r.id = t.run_id
+	WHERE ce.id = sr.chainexecution_id)`
+
 func (s *PostgresqlStore) GetSupervisionRequest(ctx context.Context, id uuid.UUID) (*asteroid.SupervisionRequest, error) {
 	query := `
-		SELECT id, supervisor_id, position_in_chain, chainexecution_id
-		FROM supervisionrequest
-		WHERE id = $1`
+		SELECT sr.id, sr.supervisor_id, sr.position_in_chain, sr.chainexecution_id, ` + supervisionRequestPriority + `
+		FROM supervisionrequest sr
+		WHERE sr.id = $1`
 
 	var request asteroid.SupervisionRequest
 	err := s.db.QueryRowContext(ctx, query, id).Scan(
@@ -1011,6 +1605,7 @@ func (s *PostgresqlStore) GetSupervisionRequest(ctx context.Context, id uuid.UUI
 		&request.SupervisorId,
 		&request.PositionInChain,
 		&request.ChainexecutionId,
+		&request.Priority,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
@@ -1121,10 +1716,10 @@ func (s *PostgresqlStore) CreateRun(ctx context.Context, run asteroid.Run) (uuid
 	id := uuid.New()
 
 	query := `
-		INSERT INTO run (id, task_id, created_at, status)
-		VALUES ($1, $2, $3, $4)`
+		INSERT INTO run (id, task_id, created_at, status, agent_id, autonomy_level, priority)
+		VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, 'interactive'))`
 
-	_, err = s.db.ExecContext(ctx, query, id, run.TaskId, run.CreatedAt, asteroid.Pending)
+	_, err = s.db.ExecContext(ctx, query, id, run.TaskId, run.CreatedAt, asteroid.Pending, run.AgentId, run.AutonomyLevel, run.Priority)
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("error creating run: %w", err)
 	}
@@ -1139,6 +1734,7 @@ func (s *PostgresqlStore) CreateTool(
 	description string,
 	ignoredAttributes []string,
 	code string,
+	parameters *map[string]interface{},
 ) (*asteroid.Tool, error) {
 	// Convert attributes to JSON if it's not already
 	attributesJSON, err := json.Marshal(attributes)
@@ -1146,14 +1742,22 @@ func (s *PostgresqlStore) CreateTool(
 		return nil, fmt.Errorf("error marshaling tool attributes: %w", err)
 	}
 
+	var parametersJSON []byte
+	if parameters != nil {
+		parametersJSON, err = json.Marshal(*parameters)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling tool parameters: %w", err)
+		}
+	}
+
 	if ignoredAttributes == nil {
 		ignoredAttributes = []string{}
 	}
 
 	id := uuid.New()
 	query := `
-		INSERT INTO tool (id, run_id, name, description, attributes, ignored_attributes, code)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+		INSERT INTO tool (id, run_id, name, description, attributes, ignored_attributes, code, parameters)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
 
 	_, err = s.db.ExecContext(ctx, query,
 		id,
@@ -1163,6 +1767,7 @@ func (s *PostgresqlStore) CreateTool(
 		attributesJSON, // Use the JSON-encoded attributes
 		pq.Array(ignoredAttributes),
 		code,
+		parametersJSON,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error creating tool: %w", err)
@@ -1176,6 +1781,7 @@ func (s *PostgresqlStore) CreateTool(
 		Attributes:        attributes,
 		IgnoredAttributes: &ignoredAttributes,
 		Code:              code,
+		Parameters:        parameters,
 	}
 
 	return &tool, nil
@@ -1183,7 +1789,7 @@ func (s *PostgresqlStore) CreateTool(
 
 func (s *PostgresqlStore) GetRun(ctx context.Context, id uuid.UUID) (*asteroid.Run, error) {
 	query := `
-		SELECT id, task_id, created_at, status, result
+		SELECT id, task_id, created_at, status, result, agent_id, autonomy_level, priority
 		FROM run
 		WHERE id = $1`
 
@@ -1194,6 +1800,9 @@ func (s *PostgresqlStore) GetRun(ctx context.Context, id uuid.UUID) (*asteroid.R
 		&run.CreatedAt,
 		&run.Status,
 		&run.Result,
+		&run.AgentId,
+		&run.AutonomyLevel,
+		&run.Priority,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
@@ -1207,7 +1816,7 @@ func (s *PostgresqlStore) GetRun(ctx context.Context, id uuid.UUID) (*asteroid.R
 
 func (s *PostgresqlStore) GetRunTools(ctx context.Context, runId uuid.UUID) ([]asteroid.Tool, error) {
 	query := `
-		SELECT tool.id, tool.run_id, tool.name, tool.description, tool.attributes, COALESCE(tool.ignored_attributes, '{}') as ignored_attributes, tool.code
+		SELECT tool.id, tool.run_id, tool.name, tool.description, tool.attributes, COALESCE(tool.ignored_attributes, '{}') as ignored_attributes, tool.code, tool.parameters
 		FROM tool 
 		WHERE run_id = $1`
 
@@ -1220,7 +1829,7 @@ func (s *PostgresqlStore) GetRunTools(ctx context.Context, runId uuid.UUID) ([]a
 	tools := make([]asteroid.Tool, 0)
 	for rows.Next() {
 		var tool asteroid.Tool
-		var attributesJSON []byte
+		var attributesJSON, parametersJSON []byte
 		var ignoredAttributes []string
 
 		if err := rows.Scan(
@@ -1231,6 +1840,7 @@ func (s *PostgresqlStore) GetRunTools(ctx context.Context, runId uuid.UUID) ([]a
 			&attributesJSON,
 			pq.Array(&ignoredAttributes),
 			&tool.Code,
+			&parametersJSON,
 		); err != nil {
 			return nil, fmt.Errorf("error scanning tool: %w", err)
 		}
@@ -1247,6 +1857,12 @@ func (s *PostgresqlStore) GetRunTools(ctx context.Context, runId uuid.UUID) ([]a
 		tool.Attributes = t
 		tool.IgnoredAttributes = &ignoredAttributes
 
+		parameters, err := parseToolParameters(parametersJSON)
+		if err != nil {
+			return nil, err
+		}
+		tool.Parameters = parameters
+
 		tools = append(tools, tool)
 	}
 
@@ -1284,7 +1900,7 @@ func (s *PostgresqlStore) GetSupervisors(ctx context.Context, projectId uuid.UUI
 		SELECT s.id, s.description, s.name, s.code, s.created_at, s.type, s.attributes
 		FROM supervisor s 
 		INNER JOIN chain_supervisor cs ON s.id = cs.supervisor_id
-		INNER JOIN chain c ON cs.chain_id = c.id
+		INNER JOIN chain c ON cs.chain_id = c.id AND cs.version = c.version
 		INNER JOIN chain_tool ct ON c.id = ct.chain_id
 		INNER JOIN tool t ON ct.tool_id = t.id
 		INNER JOIN run r ON t.run_id = r.id
@@ -1333,7 +1949,8 @@ func (s *PostgresqlStore) GetSupervisionStatusesForRequest(ctx context.Context,
 	query := `
         SELECT ss.id, ss.supervisionrequest_id, ss.status, ss.created_at
         FROM supervisionrequest_status ss
-        WHERE ss.supervisionrequest_id = $1`
+        WHERE ss.supervisionrequest_id = $1
+        ORDER BY ss.created_at ASC, ss.id ASC`
 
 	rows, err := s.db.QueryContext(ctx, query, requestId)
 	if err != nil {
@@ -1355,7 +1972,7 @@ func (s *PostgresqlStore) GetSupervisionStatusesForRequest(ctx context.Context,
 
 func (s *PostgresqlStore) GetSupervisionResultsForChainExecution(ctx context.Context, executionId uuid.UUID) ([]asteroid.SupervisionResult, error) {
 	query := `
-        SELECT sr.id, sr.supervisionrequest_id, sr.created_at, sr.decision, sr.reasoning, sr.toolcall_id
+        SELECT sr.id, sr.supervisionrequest_id, sr.created_at, sr.decision, sr.reasoning, sr.toolcall_id, sr.verdict, sr.verdict_behavior, sr.explanation, sr.overridden_decision, sr.timeout_fallback, sr.modified_arguments, sr.original_arguments, sr.user_id, sr.precedents
         FROM supervisionresult sr
         INNER JOIN supervisionrequest sreq ON sr.supervisionrequest_id = sreq.id
         WHERE sreq.chainexecution_id = $1`
@@ -1369,6 +1986,7 @@ func (s *PostgresqlStore) GetSupervisionResultsForChainExecution(ctx context.Con
 	var results []asteroid.SupervisionResult
 	for rows.Next() {
 		var result asteroid.SupervisionResult
+		var explanation []byte
 		err := rows.Scan(
 			&result.Id,
 			&result.SupervisionRequestId,
@@ -1376,11 +1994,24 @@ func (s *PostgresqlStore) GetSupervisionResultsForChainExecution(ctx context.Con
 			&result.Decision,
 			&result.Reasoning,
 			&result.ToolcallId,
+			&result.Verdict,
+			&result.VerdictBehavior,
+			&explanation,
+			&result.OverriddenDecision,
+			&result.TimeoutFallback,
+			&result.ModifiedArguments,
+			&result.OriginalArguments,
+			&result.UserId,
+			optionalUUIDs{&result.Precedents},
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error scanning supervision result: %w", err)
 		}
 
+		if result.Explanation, err = parseExplanation(explanation); err != nil {
+			return nil, err
+		}
+
 		results = append(results, result)
 	}
 
@@ -1422,7 +2053,7 @@ func (s *PostgresqlStore) GetSupervisionStatusesForChainExecution(ctx context.Co
 // GetChainExecutionSupervisionRequests gets all supervision requests for a specific chain execution
 func (s *PostgresqlStore) GetChainExecutionSupervisionRequests(ctx context.Context, chainExecutionId uuid.UUID) ([]asteroid.SupervisionRequest, error) {
 	query := `
-        SELECT sr.id, sr.supervisor_id, sr.chainexecution_id, position_in_chain
+        SELECT sr.id, sr.supervisor_id, sr.chainexecution_id, position_in_chain, ` + supervisionRequestPriority + `
         FROM supervisionrequest sr
         JOIN chainexecution ce ON sr.chainexecution_id = ce.id
         WHERE ce.id = $1
@@ -1442,6 +2073,7 @@ func (s *PostgresqlStore) GetChainExecutionSupervisionRequests(ctx context.Conte
 			&request.SupervisorId,
 			&request.ChainexecutionId,
 			&request.PositionInChain,
+			&request.Priority,
 		); err != nil {
 			return nil, fmt.Errorf("error scanning supervision request: %w", err)
 		}
@@ -1487,6 +2119,20 @@ func (s *PostgresqlStore) GetSupervisionRequestStatus(ctx context.Context, reque
 	return &status, nil
 }
 
+func (s *PostgresqlStore) GetChainExecutionChain(ctx context.Context, executionId uuid.UUID) (*asteroid.SupervisorChain, error) {
+	var chainId uuid.UUID
+	var version int
+	err := s.db.QueryRowContext(ctx, `SELECT chain_id, chain_version FROM chainexecution WHERE id = $1`, executionId).Scan(&chainId, &version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting chain execution: %w", err)
+	}
+
+	return s.GetSupervisorChainVersion(ctx, chainId, version)
+}
+
 func (s *PostgresqlStore) GetChainExecution(ctx context.Context, executionId uuid.UUID) (*uuid.UUID, *uuid.UUID, error) {
 	query := `SELECT chain_id, toolcall_id FROM chainexecution WHERE id = $1`
 
@@ -1507,7 +2153,7 @@ func (s *PostgresqlStore) GetChainExecutionState(ctx context.Context, executionI
 	// First, get the chain execution record
 	var chainExecution asteroid.ChainExecution
 	err := s.db.QueryRowContext(ctx, `
-        SELECT id, toolcall_id, chain_id, created_at
+        SELECT id, toolcall_id, chain_id, chain_version, created_at
         FROM chainexecution
         WHERE id = $1
 				ORDER BY id ASC
@@ -1515,6 +2161,7 @@ func (s *PostgresqlStore) GetChainExecutionState(ctx context.Context, executionI
 		&chainExecution.Id,
 		&chainExecution.ToolcallId,
 		&chainExecution.ChainId,
+		&chainExecution.ChainVersion,
 		&chainExecution.CreatedAt,
 	)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -1524,11 +2171,14 @@ func (s *PostgresqlStore) GetChainExecutionState(ctx context.Context, executionI
 		return nil, fmt.Errorf("failed to get chain execution: %w", err)
 	}
 
-	// Get the supervisor chain
-	supervisorChain, err := s.GetSupervisorChain(ctx, chainExecution.ChainId)
+	// Get the supervisor chain, as it was when the execution started
+	supervisorChain, err := s.GetSupervisorChainVersion(ctx, chainExecution.ChainId, chainExecution.ChainVersion)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get supervisor chain: %w", err)
 	}
+	if supervisorChain == nil {
+		return nil, fmt.Errorf("supervisor chain %s not found", chainExecution.ChainId)
+	}
 
 	// Get all supervision requests for this chain execution
 	supervisionRequests, err := s.GetChainExecutionSupervisionRequests(ctx, chainExecution.Id)
@@ -1555,8 +2205,9 @@ func (s *PostgresqlStore) GetChainExecutionState(ctx context.Context, executionI
 
 		// Get the result, if any
 		result := &asteroid.SupervisionResult{}
+		var explanation []byte
 		err = s.db.QueryRowContext(ctx, `
-            SELECT id, supervisionrequest_id, created_at, decision, reasoning, toolcall_id
+            SELECT id, supervisionrequest_id, created_at, decision, reasoning, toolcall_id, verdict, verdict_behavior, explanation, overridden_decision, timeout_fallback, modified_arguments, original_arguments, user_id, precedents
             FROM supervisionresult
             WHERE supervisionrequest_id = $1
         `, request.Id).Scan(
@@ -1566,6 +2217,15 @@ func (s *PostgresqlStore) GetChainExecutionState(ctx context.Context, executionI
 			&result.Decision,
 			&result.Reasoning,
 			&result.ToolcallId,
+			&result.Verdict,
+			&result.VerdictBehavior,
+			&explanation,
+			&result.OverriddenDecision,
+			&result.TimeoutFallback,
+			&result.ModifiedArguments,
+			&result.OriginalArguments,
+			&result.UserId,
+			optionalUUIDs{&result.Precedents},
 		)
 		if err != nil {
 			if err == sql.ErrNoRows {
@@ -1573,6 +2233,8 @@ func (s *PostgresqlStore) GetChainExecutionState(ctx context.Context, executionI
 			} else {
 				return nil, fmt.Errorf("failed to get supervision result: %w", err)
 			}
+		} else if result.Explanation, err = parseExplanation(explanation); err != nil {
+			return nil, err
 		}
 
 		supervisionRequestState := asteroid.SupervisionRequestState{
@@ -1584,10 +2246,12 @@ func (s *PostgresqlStore) GetChainExecutionState(ctx context.Context, executionI
 	}
 
 	// Build and return the ChainExecutionState
+	escalationPath := asteroid.EscalationPath(*supervisorChain, supervisionRequestStates)
 	state := asteroid.ChainExecutionState{
 		Chain:               *supervisorChain,
 		ChainExecution:      chainExecution,
 		SupervisionRequests: supervisionRequestStates,
+		EscalationPath:      &escalationPath,
 	}
 
 	return &state, nil
@@ -1623,6 +2287,16 @@ func (s *PostgresqlStore) UpdateRunStatus(ctx context.Context, runId uuid.UUID,
 	return nil
 }
 
+func (s *PostgresqlStore) UpdateRunAutonomy(ctx context.Context, runId uuid.UUID, level asteroid.AutonomyLevel) error {
+	query := `UPDATE run SET autonomy_level = $1 WHERE id = $2`
+	_, err := s.db.ExecContext(ctx, query, level, runId)
+	if err != nil {
+		return fmt.Errorf("error updating run autonomy: %w", err)
+	}
+
+	return nil
+}
+
 func (s *PostgresqlStore) UpdateRunResult(ctx context.Context, runId uuid.UUID, result string) error {
 	query := `
 		UPDATE run SET result = $1 WHERE id = $2
@@ -1643,6 +2317,7 @@ func (s *PostgresqlStore) CreateChatRequest(
 	choices []asteroid.AsteroidChoice,
 	format string,
 	requestMessages []asteroid.AsteroidMessage,
+	usage asteroid.ChatUsage,
 ) (*uuid.UUID, error) {
 	if len(request) == 0 {
 		return nil, fmt.Errorf("request is empty")
@@ -1654,12 +2329,23 @@ func (s *PostgresqlStore) CreateChatRequest(
 	}
 	defer func() { _ = tx.Rollback() }()
 
+	requestHash, err := asteroid.ContentHash(request)
+	if err != nil {
+		return nil, fmt.Errorf("error hashing request: %w", err)
+	}
+	responseHash, err := asteroid.ContentHash(response)
+	if err != nil {
+		return nil, fmt.Errorf("error hashing response: %w", err)
+	}
+
 	query := `
-		INSERT INTO chat (request_data, response_data, run_id, format)
-		VALUES ($1, $2, $3, $4) RETURNING id
+		INSERT INTO chat (request_data, response_data, run_id, format, request_hash, response_hash, model, prompt_tokens, completion_tokens)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id
 	`
 	var id uuid.UUID
-	err = tx.QueryRowContext(ctx, query, request, response, runId, format).Scan(&id)
+	err = tx.QueryRowContext(
+		ctx, query, request, response, runId, format, requestHash, responseHash, usage.Model, usage.PromptTokens, usage.CompletionTokens,
+	).Scan(&id)
 	if err != nil {
 		return nil, fmt.Errorf("error creating chat entry: %w", err)
 	}
@@ -1686,14 +2372,18 @@ func (s *PostgresqlStore) createChatRequestMessages(
 	// For each message, store it in the DB
 	for _, message := range requestMessages {
 		query := `
-			INSERT INTO msg (id, choice_id, msg_data)
-			VALUES ($1, $2, $3)
+			INSERT INTO msg (id, choice_id, msg_data, content_hash)
+			VALUES ($1, $2, $3, $4)
 		`
 		msgData, err := json.Marshal(message)
 		if err != nil {
 			return fmt.Errorf("error marshalling message data: %w", err)
 		}
-		_, err = tx.ExecContext(ctx, query, message.Id, choiceId, msgData)
+		hash, err := asteroid.ContentHash(msgData)
+		if err != nil {
+			return fmt.Errorf("error hashing message data: %w", err)
+		}
+		_, err = tx.ExecContext(ctx, query, message.Id, choiceId, msgData, hash)
 		if err != nil {
 			return fmt.Errorf("error creating chat message: %w", err)
 		}
@@ -1708,6 +2398,9 @@ func (s *PostgresqlStore) GetMessage(ctx context.Context, id uuid.UUID) (*astero
 	`
 	var msgData []byte
 	err := s.db.QueryRowContext(ctx, query, id).Scan(&msgData)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error getting message: %w", err)
 	}
@@ -1721,15 +2414,63 @@ func (s *PostgresqlStore) GetMessage(ctx context.Context, id uuid.UUID) (*astero
 	return &message, nil
 }
 
+func (s *PostgresqlStore) GetMessageRunId(ctx context.Context, id uuid.UUID) (*uuid.UUID, error) {
+	query := `
+		SELECT c.run_id
+		FROM msg m
+		JOIN choice ch ON ch.id = m.choice_id
+		JOIN chat c ON c.id = ch.chat_id
+		WHERE m.id = $1
+	`
+	var runId uuid.UUID
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&runId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting run of message: %w", err)
+	}
+
+	return &runId, nil
+}
+
+func (s *PostgresqlStore) GetToolCallMessage(ctx context.Context, toolCallId uuid.UUID) (*asteroid.AsteroidMessage, error) {
+	query := `
+		SELECT m.msg_data
+		FROM toolcall tc
+		JOIN msg m ON m.id = tc.msg_id
+		WHERE tc.id = $1
+	`
+	var msgData []byte
+	err := s.db.QueryRowContext(ctx, query, toolCallId).Scan(&msgData)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool call message: %w", err)
+	}
+
+	var message asteroid.AsteroidMessage
+	if err := json.Unmarshal(msgData, &message); err != nil {
+		return nil, fmt.Errorf("error unmarshalling message: %w", err)
+	}
+
+	return &message, nil
+}
+
 func (s *PostgresqlStore) UpdateMessage(ctx context.Context, id uuid.UUID, message asteroid.AsteroidMessage) error {
 	query := `
-		UPDATE msg SET msg_data = $1 WHERE id = $2	
+		UPDATE msg SET msg_data = $1, content_hash = $3 WHERE id = $2	
 	`
 	msgData, err := json.Marshal(message)
 	if err != nil {
 		return fmt.Errorf("error marshalling message data: %w", err)
 	}
-	_, err = s.db.ExecContext(ctx, query, msgData, id)
+	hash, err := asteroid.ContentHash(msgData)
+	if err != nil {
+		return fmt.Errorf("error hashing message data: %w", err)
+	}
+	_, err = s.db.ExecContext(ctx, query, msgData, id, hash)
 	if err != nil {
 		return fmt.Errorf("error updating message: %w", err)
 	}
@@ -1740,9 +2481,9 @@ func (s *PostgresqlStore) GetChat(
 	ctx context.Context,
 	runId uuid.UUID,
 	index int,
-) ([]byte, []byte, error) {
+) ([]byte, []byte, asteroid.ChatFormat, error) {
 	query := `
-		SELECT request_data, response_data
+		SELECT request_data, response_data, format
 		FROM chat
 		WHERE run_id = $1
 		ORDER BY created_at DESC
@@ -1750,12 +2491,13 @@ func (s *PostgresqlStore) GetChat(
 	`
 
 	var requestData, responseData []byte
-	err := s.db.QueryRowContext(ctx, query, runId, index).Scan(&requestData, &responseData)
+	var format asteroid.ChatFormat
+	err := s.db.QueryRowContext(ctx, query, runId, index).Scan(&requestData, &responseData, &format)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error getting message: %w", err)
+		return nil, nil, "", fmt.Errorf("error getting message: %w", err)
 	}
 
-	return requestData, responseData, nil
+	return requestData, responseData, format, nil
 }
 
 func (s *PostgresqlStore) GetRunChatCount(ctx context.Context, runId uuid.UUID) (int, error) {
@@ -1813,20 +2555,24 @@ func (s *PostgresqlStore) createChatChoices(
 		fmt.Printf("Choice message: %+v\n", choice.Message)
 		// Store the message
 		query = `
-			INSERT INTO msg (id, choice_id, msg_data)
-			VALUES ($1, $2, $3)
+			INSERT INTO msg (id, choice_id, msg_data, content_hash)
+			VALUES ($1, $2, $3, $4)
 		`
 		messageData, err := json.Marshal(choice.Message)
 		if err != nil {
 			return fmt.Errorf("error marshalling message data: %w", err)
 		}
+		hash, err := asteroid.ContentHash(messageData)
+		if err != nil {
+			return fmt.Errorf("error hashing message data: %w", err)
+		}
 
 		msgId := choice.Message.Id
 		if msgId == nil {
 			return fmt.Errorf("message ID is nil")
 		}
 
-		_, err = tx.ExecContext(ctx, query, *msgId, choice.AsteroidId, messageData)
+		_, err = tx.ExecContext(ctx, query, *msgId, choice.AsteroidId, messageData, hash)
 		if err != nil {
 			return fmt.Errorf("error creating chat message: %w", err)
 		}
@@ -1879,3 +2625,6006 @@ func (s *PostgresqlStore) createToolCalls(
 
 	return nil
 }
+
+func (s *PostgresqlStore) GetMessageTranslation(ctx context.Context, messageId uuid.UUID, targetLanguage string) (*asteroid.MessageTranslation, error) {
+	query := `
+		SELECT msg_id, source_language, target_language, content, translated_content, backend, created_at
+		FROM msg_translation
+		WHERE msg_id = $1 AND target_language = $2`
+
+	var translation asteroid.MessageTranslation
+	err := s.db.QueryRowContext(ctx, query, messageId, targetLanguage).Scan(
+		&translation.MessageId,
+		&translation.SourceLanguage,
+		&translation.TargetLanguage,
+		&translation.Content,
+		&translation.TranslatedContent,
+		&translation.Backend,
+		&translation.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting message translation: %w", err)
+	}
+
+	return &translation, nil
+}
+
+func (s *PostgresqlStore) CreateMessageTranslation(ctx context.Context, translation asteroid.MessageTranslation) error {
+	query := `
+		INSERT INTO msg_translation (msg_id, source_language, target_language, content, translated_content, backend, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (msg_id, target_language) DO UPDATE
+		SET translated_content = EXCLUDED.translated_content, backend = EXCLUDED.backend, created_at = EXCLUDED.created_at`
+
+	_, err := s.db.ExecContext(
+		ctx,
+		query,
+		translation.MessageId,
+		translation.SourceLanguage,
+		translation.TargetLanguage,
+		translation.Content,
+		translation.TranslatedContent,
+		translation.Backend,
+		translation.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating message translation: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) UpdateMessageContent(ctx context.Context, message asteroid.AsteroidMessage, diff asteroid.MessageDiff) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	msgData, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("error marshalling message data: %w", err)
+	}
+
+	hash, err := asteroid.ContentHash(msgData)
+	if err != nil {
+		return fmt.Errorf("error hashing message data: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE msg SET msg_data = $1, content_hash = $3 WHERE id = $2`, msgData, diff.MessageId, hash)
+	if err != nil {
+		return fmt.Errorf("error updating message: %w", err)
+	}
+
+	// Translations of the content it had are out of date, so the next request translates it again
+	_, err = tx.ExecContext(ctx, `DELETE FROM msg_translation WHERE msg_id = $1`, diff.MessageId)
+	if err != nil {
+		return fmt.Errorf("error deleting message translations: %w", err)
+	}
+
+	segments, err := json.Marshal(diff.Segments)
+	if err != nil {
+		return fmt.Errorf("error marshalling diff segments: %w", err)
+	}
+
+	query := `
+		INSERT INTO msg_diff (id, msg_id, original_content, modified_content, segments, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err = tx.ExecContext(ctx, query, diff.Id, diff.MessageId, diff.OriginalContent, diff.ModifiedContent, segments, diff.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error creating message diff: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetMessageDiffs(ctx context.Context, messageId uuid.UUID) ([]asteroid.MessageDiff, error) {
+	query := `
+		SELECT id, msg_id, original_content, modified_content, segments, created_at
+		FROM msg_diff
+		WHERE msg_id = $1
+		ORDER BY created_at ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, messageId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting message diffs: %w", err)
+	}
+	defer rows.Close()
+
+	diffs := make([]asteroid.MessageDiff, 0)
+	for rows.Next() {
+		var diff asteroid.MessageDiff
+		var segmentsJSON []byte
+		if err := rows.Scan(
+			&diff.Id,
+			&diff.MessageId,
+			&diff.OriginalContent,
+			&diff.ModifiedContent,
+			&segmentsJSON,
+			&diff.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning message diff: %w", err)
+		}
+
+		if err := json.Unmarshal(segmentsJSON, &diff.Segments); err != nil {
+			return nil, fmt.Errorf("error parsing message diff segments: %w", err)
+		}
+
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+func (s *PostgresqlStore) GetContextWindowPolicies(ctx context.Context, projectId uuid.UUID) ([]asteroid.ContextWindowPolicy, error) {
+	query := `
+		SELECT model, max_context_tokens, strategy
+		FROM context_window_policy
+		WHERE project_id = $1
+		ORDER BY model ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting context window policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := make([]asteroid.ContextWindowPolicy, 0)
+	for rows.Next() {
+		var policy asteroid.ContextWindowPolicy
+		if err := rows.Scan(&policy.Model, &policy.MaxContextTokens, &policy.Strategy); err != nil {
+			return nil, fmt.Errorf("error scanning context window policy: %w", err)
+		}
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func (s *PostgresqlStore) SetContextWindowPolicies(ctx context.Context, projectId uuid.UUID, policies []asteroid.ContextWindowPolicy) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM context_window_policy WHERE project_id = $1`, projectId)
+	if err != nil {
+		return fmt.Errorf("error deleting context window policies: %w", err)
+	}
+
+	query := `
+		INSERT INTO context_window_policy (project_id, model, max_context_tokens, strategy)
+		VALUES ($1, $2, $3, $4)`
+
+	for _, policy := range policies {
+		_, err = tx.ExecContext(ctx, query, projectId, policy.Model, policy.MaxContextTokens, policy.Strategy)
+		if err != nil {
+			return fmt.Errorf("error creating context window policy: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) CreateContextTruncation(ctx context.Context, truncation asteroid.ContextTruncation) error {
+	droppedMessages, err := json.Marshal(truncation.DroppedMessages)
+	if err != nil {
+		return fmt.Errorf("error marshalling dropped messages: %w", err)
+	}
+
+	query := `
+		INSERT INTO chat_truncation (
+			id, run_id, chat_id, model, strategy, max_context_tokens,
+			original_tokens, final_tokens, dropped_messages, summary, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	_, err = s.db.ExecContext(ctx, query,
+		truncation.Id,
+		truncation.RunId,
+		truncation.ChatId,
+		truncation.Model,
+		truncation.Strategy,
+		truncation.MaxContextTokens,
+		truncation.OriginalTokens,
+		truncation.FinalTokens,
+		droppedMessages,
+		truncation.Summary,
+		truncation.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating context truncation: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetRunTruncations(ctx context.Context, runId uuid.UUID) ([]asteroid.ContextTruncation, error) {
+	query := `
+		SELECT id, run_id, chat_id, model, strategy, max_context_tokens,
+			original_tokens, final_tokens, dropped_messages, summary, created_at
+		FROM chat_truncation
+		WHERE run_id = $1
+		ORDER BY created_at ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting run truncations: %w", err)
+	}
+	defer rows.Close()
+
+	truncations := make([]asteroid.ContextTruncation, 0)
+	for rows.Next() {
+		var truncation asteroid.ContextTruncation
+		var droppedJSON []byte
+		if err := rows.Scan(
+			&truncation.Id,
+			&truncation.RunId,
+			&truncation.ChatId,
+			&truncation.Model,
+			&truncation.Strategy,
+			&truncation.MaxContextTokens,
+			&truncation.OriginalTokens,
+			&truncation.FinalTokens,
+			&droppedJSON,
+			&truncation.Summary,
+			&truncation.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning context truncation: %w", err)
+		}
+
+		if err := json.Unmarshal(droppedJSON, &truncation.DroppedMessages); err != nil {
+			return nil, fmt.Errorf("error parsing dropped messages: %w", err)
+		}
+
+		truncations = append(truncations, truncation)
+	}
+
+	return truncations, nil
+}
+
+func (s *PostgresqlStore) GetProjectToolPolicies(ctx context.Context, projectId uuid.UUID) ([]asteroid.ToolPolicy, error) {
+	query := `
+		SELECT tool_name, risk_tier, chains
+		FROM project_tool_policy
+		WHERE project_id = $1
+		ORDER BY tool_name ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := make([]asteroid.ToolPolicy, 0)
+	for rows.Next() {
+		var policy asteroid.ToolPolicy
+		var chainsJSON []byte
+		if err := rows.Scan(&policy.ToolName, &policy.RiskTier, &chainsJSON); err != nil {
+			return nil, fmt.Errorf("error scanning tool policy: %w", err)
+		}
+
+		if err := json.Unmarshal(chainsJSON, &policy.Chains); err != nil {
+			return nil, fmt.Errorf("error parsing tool policy chains: %w", err)
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func (s *PostgresqlStore) SetProjectToolPolicies(ctx context.Context, projectId uuid.UUID, policies []asteroid.ToolPolicy) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM project_tool_policy WHERE project_id = $1`, projectId)
+	if err != nil {
+		return fmt.Errorf("error deleting tool policies: %w", err)
+	}
+
+	query := `
+		INSERT INTO project_tool_policy (project_id, tool_name, risk_tier, chains)
+		VALUES ($1, $2, $3, $4)`
+
+	for _, policy := range policies {
+		chains, err := json.Marshal(policy.Chains)
+		if err != nil {
+			return fmt.Errorf("error marshalling tool policy chains: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, query, projectId, policy.ToolName, policy.RiskTier, chains)
+		if err != nil {
+			return fmt.Errorf("error creating tool policy: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetProjectArgumentRules(ctx context.Context, projectId uuid.UUID) ([]asteroid.ArgumentRule, error) {
+	query := `
+		SELECT name, tool_name, chain_id, conditions, decision
+		FROM project_argument_rule
+		WHERE project_id = $1
+		ORDER BY position ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting argument rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := make([]asteroid.ArgumentRule, 0)
+	for rows.Next() {
+		var rule asteroid.ArgumentRule
+		var conditionsJSON []byte
+		if err := rows.Scan(&rule.Name, &rule.ToolName, &rule.ChainId, &conditionsJSON, &rule.Decision); err != nil {
+			return nil, fmt.Errorf("error scanning argument rule: %w", err)
+		}
+
+		if err := json.Unmarshal(conditionsJSON, &rule.Conditions); err != nil {
+			return nil, fmt.Errorf("error parsing argument rule conditions: %w", err)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+func (s *PostgresqlStore) SetProjectArgumentRules(ctx context.Context, projectId uuid.UUID, rules []asteroid.ArgumentRule) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM project_argument_rule WHERE project_id = $1`, projectId)
+	if err != nil {
+		return fmt.Errorf("error deleting argument rules: %w", err)
+	}
+
+	query := `
+		INSERT INTO project_argument_rule (project_id, name, position, tool_name, chain_id, conditions, decision)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	for position, rule := range rules {
+		conditions, err := json.Marshal(rule.Conditions)
+		if err != nil {
+			return fmt.Errorf("error marshalling argument rule conditions: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, query, projectId, rule.Name, position, rule.ToolName, rule.ChainId, conditions, rule.Decision)
+		if err != nil {
+			return fmt.Errorf("error creating argument rule: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetProjectPayloadTemplates(ctx context.Context, projectId uuid.UUID) ([]asteroid.PayloadTemplate, error) {
+	query := `
+		SELECT target, template, content_type
+		FROM project_payload_template
+		WHERE project_id = $1
+		ORDER BY target ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting payload templates: %w", err)
+	}
+	defer rows.Close()
+
+	templates := make([]asteroid.PayloadTemplate, 0)
+	for rows.Next() {
+		var template asteroid.PayloadTemplate
+		if err := rows.Scan(&template.Target, &template.Template, &template.ContentType); err != nil {
+			return nil, fmt.Errorf("error scanning payload template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, rows.Err()
+}
+
+func (s *PostgresqlStore) SetProjectPayloadTemplates(ctx context.Context, projectId uuid.UUID, templates []asteroid.PayloadTemplate) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM project_payload_template WHERE project_id = $1`, projectId)
+	if err != nil {
+		return fmt.Errorf("error deleting payload templates: %w", err)
+	}
+
+	query := `
+		INSERT INTO project_payload_template (project_id, target, template, content_type)
+		VALUES ($1, $2, $3, $4)`
+
+	for _, template := range templates {
+		_, err = tx.ExecContext(ctx, query, projectId, template.Target, template.Template, template.ContentType)
+		if err != nil {
+			return fmt.Errorf("error creating payload template: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetNotificationSettings(ctx context.Context, projectId uuid.UUID) (*asteroid.NotificationSettings, error) {
+	query := `
+		SELECT webhook_url, events
+		FROM project_notification_settings
+		WHERE project_id = $1`
+
+	var settings asteroid.NotificationSettings
+	var eventsJSON []byte
+	err := s.db.QueryRowContext(ctx, query, projectId).Scan(&settings.WebhookUrl, &eventsJSON)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting notification settings: %w", err)
+	}
+
+	if err := json.Unmarshal(eventsJSON, &settings.Events); err != nil {
+		return nil, fmt.Errorf("error parsing notification events: %w", err)
+	}
+
+	return &settings, nil
+}
+
+func (s *PostgresqlStore) SetNotificationSettings(ctx context.Context, projectId uuid.UUID, settings asteroid.NotificationSettings) error {
+	events, err := json.Marshal(settings.Events)
+	if err != nil {
+		return fmt.Errorf("error marshalling notification events: %w", err)
+	}
+
+	query := `
+		INSERT INTO project_notification_settings (project_id, webhook_url, events)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (project_id) DO UPDATE SET webhook_url = EXCLUDED.webhook_url, events = EXCLUDED.events`
+
+	_, err = s.db.ExecContext(ctx, query, projectId, settings.WebhookUrl, events)
+	if err != nil {
+		return fmt.Errorf("error setting notification settings: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) SetProjectOrganization(ctx context.Context, projectId uuid.UUID, organizationId *uuid.UUID) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE project SET organization_id = $1 WHERE id = $2`, organizationId, projectId)
+	if err != nil {
+		return fmt.Errorf("error setting project organization: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) CreateOrganization(ctx context.Context, organization asteroid.Organization) error {
+	query := `
+		INSERT INTO organization (id, name, created_at)
+		VALUES ($1, $2, $3)`
+
+	_, err := s.db.ExecContext(ctx, query, organization.Id, organization.Name, organization.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error creating organization: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetOrganization(ctx context.Context, id uuid.UUID) (*asteroid.Organization, error) {
+	query := `
+		SELECT id, name, created_at
+		FROM organization
+		WHERE id = $1`
+
+	var organization asteroid.Organization
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&organization.Id, &organization.Name, &organization.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting organization: %w", err)
+	}
+
+	return &organization, nil
+}
+
+func (s *PostgresqlStore) GetOrganizationFromName(ctx context.Context, name string) (*asteroid.Organization, error) {
+	query := `
+		SELECT id, name, created_at
+		FROM organization
+		WHERE name = $1`
+
+	var organization asteroid.Organization
+	err := s.db.QueryRowContext(ctx, query, name).Scan(&organization.Id, &organization.Name, &organization.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting organization: %w", err)
+	}
+
+	return &organization, nil
+}
+
+func (s *PostgresqlStore) GetOrganizations(ctx context.Context) ([]asteroid.Organization, error) {
+	query := `
+		SELECT id, name, created_at
+		FROM organization
+		ORDER BY created_at DESC`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error listing organizations: %w", err)
+	}
+	defer rows.Close()
+
+	organizations := make([]asteroid.Organization, 0)
+	for rows.Next() {
+		var organization asteroid.Organization
+		if err := rows.Scan(&organization.Id, &organization.Name, &organization.CreatedAt); err != nil {
+			return nil, fmt.Errorf("error scanning organization: %w", err)
+		}
+		organizations = append(organizations, organization)
+	}
+
+	return organizations, nil
+}
+
+func (s *PostgresqlStore) GetOrganizationToolPolicies(ctx context.Context, organizationId uuid.UUID) ([]asteroid.ToolPolicy, error) {
+	query := `
+		SELECT tool_name, risk_tier, chains, locked
+		FROM organization_tool_policy
+		WHERE organization_id = $1
+		ORDER BY tool_name ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, organizationId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting organization tool policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := make([]asteroid.ToolPolicy, 0)
+	for rows.Next() {
+		var policy asteroid.ToolPolicy
+		var chainsJSON []byte
+		var locked bool
+		if err := rows.Scan(&policy.ToolName, &policy.RiskTier, &chainsJSON, &locked); err != nil {
+			return nil, fmt.Errorf("error scanning organization tool policy: %w", err)
+		}
+		policy.Locked = &locked
+
+		if err := json.Unmarshal(chainsJSON, &policy.Chains); err != nil {
+			return nil, fmt.Errorf("error parsing tool policy chains: %w", err)
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func (s *PostgresqlStore) SetOrganizationToolPolicies(ctx context.Context, organizationId uuid.UUID, policies []asteroid.ToolPolicy) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM organization_tool_policy WHERE organization_id = $1`, organizationId)
+	if err != nil {
+		return fmt.Errorf("error deleting organization tool policies: %w", err)
+	}
+
+	query := `
+		INSERT INTO organization_tool_policy (organization_id, tool_name, risk_tier, chains, locked)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	for _, policy := range policies {
+		chains, err := json.Marshal(policy.Chains)
+		if err != nil {
+			return fmt.Errorf("error marshalling tool policy chains: %w", err)
+		}
+
+		locked := policy.Locked != nil && *policy.Locked
+		_, err = tx.ExecContext(ctx, query, organizationId, policy.ToolName, policy.RiskTier, chains, locked)
+		if err != nil {
+			return fmt.Errorf("error creating organization tool policy: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) CreateApiKey(ctx context.Context, key asteroid.ApiKey, hash string) error {
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	query := `
+		INSERT INTO api_key (id, name, prefix, key_hash, scopes, project_id, created_at, expires_at, user_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := s.db.ExecContext(ctx, query, key.Id, key.Name, key.Prefix, hash, pq.Array(scopes), key.ProjectId, key.CreatedAt, key.ExpiresAt, key.UserId)
+	if err != nil {
+		return fmt.Errorf("error creating API key: %w", err)
+	}
+
+	return nil
+}
+
+const apiKeyColumns = `id, name, prefix, scopes, project_id, created_at, expires_at, last_used_at, revoked_at, rotated_to, user_id`
+
+func scanApiKey(row interface{ Scan(dest ...any) error }) (*asteroid.ApiKey, error) {
+	var key asteroid.ApiKey
+	var scopes []string
+	if err := row.Scan(
+		&key.Id,
+		&key.Name,
+		&key.Prefix,
+		pq.Array(&scopes),
+		&key.ProjectId,
+		&key.CreatedAt,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+		&key.RotatedTo,
+		&key.UserId,
+	); err != nil {
+		return nil, err
+	}
+
+	key.Scopes = make([]asteroid.ApiKeyScope, len(scopes))
+	for i, scope := range scopes {
+		key.Scopes[i] = asteroid.ApiKeyScope(scope)
+	}
+
+	return &key, nil
+}
+
+func (s *PostgresqlStore) GetApiKey(ctx context.Context, id uuid.UUID) (*asteroid.ApiKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_key WHERE id = $1`
+
+	key, err := scanApiKey(s.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting API key: %w", err)
+	}
+
+	return key, nil
+}
+
+func (s *PostgresqlStore) GetApiKeyFromHash(ctx context.Context, hash string) (*asteroid.ApiKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_key WHERE key_hash = $1`
+
+	key, err := scanApiKey(s.db.QueryRowContext(ctx, query, hash))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting API key: %w", err)
+	}
+
+	return key, nil
+}
+
+func (s *PostgresqlStore) GetApiKeys(ctx context.Context) ([]asteroid.ApiKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_key ORDER BY created_at DESC`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error listing API keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make([]asteroid.ApiKey, 0)
+	for rows.Next() {
+		key, err := scanApiKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning API key: %w", err)
+		}
+		keys = append(keys, *key)
+	}
+
+	return keys, nil
+}
+
+func (s *PostgresqlStore) RevokeApiKey(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE api_key SET revoked_at = $1 WHERE id = $2`, revokedAt, id)
+	if err != nil {
+		return fmt.Errorf("error revoking API key: %w", err)
+	}
+
+	return nil
+}
+
+// RotateApiKey points the old key at the new one in the same transaction as creating it, so a key
+// rotated twice at once only gets one replacement
+func (s *PostgresqlStore) RotateApiKey(ctx context.Context, id uuid.UUID, next asteroid.ApiKey, hash string, expiresAt time.Time) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	scopes := make([]string, len(next.Scopes))
+	for i, scope := range next.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO api_key (id, name, prefix, key_hash, scopes, project_id, created_at, expires_at, user_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		next.Id, next.Name, next.Prefix, hash, pq.Array(scopes), next.ProjectId, next.CreatedAt, next.ExpiresAt, next.UserId)
+	if err != nil {
+		return false, fmt.Errorf("error creating API key: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE api_key
+		SET rotated_to = $1, expires_at = LEAST(COALESCE(expires_at, $2), $2)
+		WHERE id = $3 AND revoked_at IS NULL AND rotated_to IS NULL AND (expires_at IS NULL OR expires_at > $4)`,
+		next.Id, expiresAt, id, next.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("error rotating API key: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error getting rows affected: %w", err)
+	}
+	if rows == 0 {
+		return false, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *PostgresqlStore) UpdateApiKeyLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE api_key SET last_used_at = $1 WHERE id = $2`, usedAt, id)
+	if err != nil {
+		return fmt.Errorf("error updating API key last use: %w", err)
+	}
+
+	return nil
+}
+
+// CreateAuditEvent appends an event to the audit log's hash chain. The chain's head is locked until
+// the event is stored, so events are chained one at a time.
+func (s *PostgresqlStore) CreateAuditEvent(ctx context.Context, event asteroid.AuditEvent) error {
+	details, err := json.Marshal(event.Details)
+	if err != nil {
+		return fmt.Errorf("error marshaling audit event details: %w", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = tx.QueryRowContext(ctx, `SELECT sequence, hash FROM audit_chain_head FOR UPDATE`).Scan(&event.Sequence, &event.PreviousHash)
+	if err != nil {
+		return fmt.Errorf("error getting audit chain head: %w", err)
+	}
+	event.Sequence++
+
+	event.Hash, err = asteroid.AuditEventHash(event)
+	if err != nil {
+		return fmt.Errorf("error hashing audit event: %w", err)
+	}
+
+	query := `
+		INSERT INTO audit_event (id, created_at, actor, action, resource_type, resource_id, details, sequence, previous_hash, hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	_, err = tx.ExecContext(ctx, query, event.Id, event.CreatedAt, event.Actor, event.Action, event.ResourceType, event.ResourceId, details,
+		event.Sequence, event.PreviousHash, event.Hash)
+	if err != nil {
+		return fmt.Errorf("error creating audit event: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE audit_chain_head SET sequence = $1, hash = $2`, event.Sequence, event.Hash)
+	if err != nil {
+		return fmt.Errorf("error updating audit chain head: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+const auditEventColumns = `id, created_at, actor, action, resource_type, resource_id, details, sequence, previous_hash, hash`
+
+func scanAuditEvent(row interface{ Scan(dest ...any) error }) (*asteroid.AuditEvent, error) {
+	var event asteroid.AuditEvent
+	var details []byte
+	if err := row.Scan(&event.Id, &event.CreatedAt, &event.Actor, &event.Action, &event.ResourceType, &event.ResourceId, &details,
+		&event.Sequence, &event.PreviousHash, &event.Hash); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(details, &event.Details); err != nil {
+		return nil, fmt.Errorf("error unmarshaling audit event details: %w", err)
+	}
+	return &event, nil
+}
+
+func (s *PostgresqlStore) queryAuditEvents(ctx context.Context, query string, args ...any) ([]asteroid.AuditEvent, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting audit events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]asteroid.AuditEvent, 0)
+	for rows.Next() {
+		event, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning audit event: %w", err)
+		}
+		events = append(events, *event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating audit events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (s *PostgresqlStore) GetAuditEvents(ctx context.Context, resourceType string, resourceId uuid.UUID) ([]asteroid.AuditEvent, error) {
+	query := `
+		SELECT ` + auditEventColumns + `
+		FROM audit_event
+		WHERE resource_type = $1 AND resource_id = $2
+		ORDER BY created_at ASC`
+
+	return s.queryAuditEvents(ctx, query, resourceType, resourceId)
+}
+
+func (s *PostgresqlStore) GetAuditChain(ctx context.Context, afterSequence int64, limit int) ([]asteroid.AuditEvent, error) {
+	query := `
+		SELECT ` + auditEventColumns + `
+		FROM audit_event
+		WHERE sequence > $1
+		ORDER BY sequence ASC
+		LIMIT $2`
+
+	return s.queryAuditEvents(ctx, query, afterSequence, limit)
+}
+
+func (s *PostgresqlStore) GetAuditChainHead(ctx context.Context) (*asteroid.AuditChainHead, error) {
+	var head asteroid.AuditChainHead
+	err := s.db.QueryRowContext(ctx, `SELECT sequence, hash FROM audit_chain_head`).Scan(&head.Sequence, &head.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("error getting audit chain head: %w", err)
+	}
+
+	return &head, nil
+}
+
+func (s *PostgresqlStore) CreateHandoffBundle(ctx context.Context, bundle asteroid.HandoffBundle) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO handoff_bundle (id, name, created_at, created_by)
+		VALUES ($1, $2, $3, $4)`,
+		bundle.Id, bundle.Name, bundle.CreatedAt, bundle.CreatedBy)
+	if err != nil {
+		return fmt.Errorf("error creating handoff bundle: %w", err)
+	}
+
+	for i, item := range bundle.Items {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO handoff_bundle_item (handoff_bundle_id, position, supervisionrequest_id, toolcall_id, status, assigned_session, priority)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			bundle.Id, i, item.SupervisionRequestId, item.ToolCallId, item.Status, item.AssignedSession, item.Priority)
+		if err != nil {
+			return fmt.Errorf("error creating handoff bundle item: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+const handoffBundleColumns = `id, name, created_at, created_by, restored_at, restored_to`
+
+func scanHandoffBundle(row interface{ Scan(dest ...any) error }) (*asteroid.HandoffBundle, error) {
+	var bundle asteroid.HandoffBundle
+	if err := row.Scan(&bundle.Id, &bundle.Name, &bundle.CreatedAt, &bundle.CreatedBy, &bundle.RestoredAt, &bundle.RestoredTo); err != nil {
+		return nil, err
+	}
+
+	return &bundle, nil
+}
+
+// getHandoffBundleItems loads a bundle's items in the order they were snapshotted
+func (s *PostgresqlStore) getHandoffBundleItems(ctx context.Context, bundle *asteroid.HandoffBundle) error {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT supervisionrequest_id, toolcall_id, status, assigned_session, priority
+		FROM handoff_bundle_item
+		WHERE handoff_bundle_id = $1
+		ORDER BY position`, bundle.Id)
+	if err != nil {
+		return fmt.Errorf("error getting handoff bundle items: %w", err)
+	}
+	defer rows.Close()
+
+	bundle.Items = make([]asteroid.HandoffItem, 0)
+	for rows.Next() {
+		var item asteroid.HandoffItem
+		if err := rows.Scan(&item.SupervisionRequestId, &item.ToolCallId, &item.Status, &item.AssignedSession, &item.Priority); err != nil {
+			return fmt.Errorf("error scanning handoff bundle item: %w", err)
+		}
+		bundle.Items = append(bundle.Items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating handoff bundle items: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetHandoffBundle(ctx context.Context, id uuid.UUID) (*asteroid.HandoffBundle, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+handoffBundleColumns+` FROM handoff_bundle WHERE id = $1`, id)
+	bundle, err := scanHandoffBundle(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting handoff bundle: %w", err)
+	}
+
+	if err := s.getHandoffBundleItems(ctx, bundle); err != nil {
+		return nil, err
+	}
+
+	return bundle, nil
+}
+
+func (s *PostgresqlStore) GetHandoffBundleFromName(ctx context.Context, name string) (*asteroid.HandoffBundle, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+handoffBundleColumns+` FROM handoff_bundle WHERE name = $1`, name)
+	bundle, err := scanHandoffBundle(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting handoff bundle: %w", err)
+	}
+
+	if err := s.getHandoffBundleItems(ctx, bundle); err != nil {
+		return nil, err
+	}
+
+	return bundle, nil
+}
+
+func (s *PostgresqlStore) GetHandoffBundles(ctx context.Context) ([]asteroid.HandoffBundle, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+handoffBundleColumns+` FROM handoff_bundle ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("error listing handoff bundles: %w", err)
+	}
+	defer rows.Close()
+
+	bundles := make([]asteroid.HandoffBundle, 0)
+	for rows.Next() {
+		bundle, err := scanHandoffBundle(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning handoff bundle: %w", err)
+		}
+		bundles = append(bundles, *bundle)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating handoff bundles: %w", err)
+	}
+	rows.Close()
+
+	// Items are loaded once the bundle rows are closed, so this only holds one connection
+	for i := range bundles {
+		if err := s.getHandoffBundleItems(ctx, &bundles[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return bundles, nil
+}
+
+func (s *PostgresqlStore) SetHandoffBundleRestored(ctx context.Context, id uuid.UUID, session string, restoredAt time.Time) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE handoff_bundle SET restored_at = $1, restored_to = $2 WHERE id = $3`, restoredAt, session, id)
+	if err != nil {
+		return fmt.Errorf("error updating handoff bundle: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetKillSwitch(ctx context.Context, organizationId uuid.UUID) (*asteroid.KillSwitch, error) {
+	query := `
+		SELECT organization_id, active, reason, updated_at, requested_by, confirmed_by
+		FROM organization_kill_switch
+		WHERE organization_id = $1`
+
+	var killSwitch asteroid.KillSwitch
+	err := s.db.QueryRowContext(ctx, query, organizationId).Scan(
+		&killSwitch.OrganizationId,
+		&killSwitch.Active,
+		&killSwitch.Reason,
+		&killSwitch.UpdatedAt,
+		&killSwitch.RequestedBy,
+		&killSwitch.ConfirmedBy,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting kill switch: %w", err)
+	}
+
+	return &killSwitch, nil
+}
+
+func (s *PostgresqlStore) SetKillSwitch(ctx context.Context, killSwitch asteroid.KillSwitch) error {
+	query := `
+		INSERT INTO organization_kill_switch (organization_id, active, reason, updated_at, requested_by, confirmed_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (organization_id) DO UPDATE SET
+			active = EXCLUDED.active,
+			reason = EXCLUDED.reason,
+			updated_at = EXCLUDED.updated_at,
+			requested_by = EXCLUDED.requested_by,
+			confirmed_by = EXCLUDED.confirmed_by`
+
+	_, err := s.db.ExecContext(ctx, query,
+		killSwitch.OrganizationId,
+		killSwitch.Active,
+		killSwitch.Reason,
+		killSwitch.UpdatedAt,
+		killSwitch.RequestedBy,
+		killSwitch.ConfirmedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("error setting kill switch: %w", err)
+	}
+
+	return nil
+}
+
+const killSwitchRequestColumns = `id, organization_id, action, reason, requested_by, requested_by_key_id, requested_at, expires_at, confirmed_by, confirmed_at`
+
+func scanKillSwitchRequest(row interface{ Scan(dest ...any) error }) (*asteroid.KillSwitchRequest, error) {
+	var request asteroid.KillSwitchRequest
+	if err := row.Scan(
+		&request.Id,
+		&request.OrganizationId,
+		&request.Action,
+		&request.Reason,
+		&request.RequestedBy,
+		&request.RequestedByKeyId,
+		&request.RequestedAt,
+		&request.ExpiresAt,
+		&request.ConfirmedBy,
+		&request.ConfirmedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+func (s *PostgresqlStore) CreateKillSwitchRequest(ctx context.Context, request asteroid.KillSwitchRequest) error {
+	query := `
+		INSERT INTO kill_switch_request (id, organization_id, action, reason, requested_by, requested_by_key_id, requested_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := s.db.ExecContext(ctx, query,
+		request.Id,
+		request.OrganizationId,
+		request.Action,
+		request.Reason,
+		request.RequestedBy,
+		request.RequestedByKeyId,
+		request.RequestedAt,
+		request.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating kill switch request: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetKillSwitchRequest(ctx context.Context, id uuid.UUID) (*asteroid.KillSwitchRequest, error) {
+	query := `SELECT ` + killSwitchRequestColumns + ` FROM kill_switch_request WHERE id = $1`
+
+	request, err := scanKillSwitchRequest(s.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting kill switch request: %w", err)
+	}
+
+	return request, nil
+}
+
+func (s *PostgresqlStore) GetPendingKillSwitchRequest(ctx context.Context, organizationId uuid.UUID, now time.Time) (*asteroid.KillSwitchRequest, error) {
+	query := `
+		SELECT ` + killSwitchRequestColumns + `
+		FROM kill_switch_request
+		WHERE organization_id = $1 AND confirmed_at IS NULL AND expires_at > $2
+		ORDER BY requested_at DESC
+		LIMIT 1`
+
+	request, err := scanKillSwitchRequest(s.db.QueryRowContext(ctx, query, organizationId, now))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting pending kill switch request: %w", err)
+	}
+
+	return request, nil
+}
+
+func (s *PostgresqlStore) ConfirmKillSwitchRequest(ctx context.Context, id uuid.UUID, confirmedBy string, confirmedAt time.Time) (bool, error) {
+	query := `
+		UPDATE kill_switch_request
+		SET confirmed_by = $1, confirmed_at = $2
+		WHERE id = $3 AND confirmed_at IS NULL`
+
+	res, err := s.db.ExecContext(ctx, query, confirmedBy, confirmedAt, id)
+	if err != nil {
+		return false, fmt.Errorf("error confirming kill switch request: %w", err)
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error confirming kill switch request: %w", err)
+	}
+
+	return updated == 1, nil
+}
+
+func (s *PostgresqlStore) SetOrganizationRunsStatus(ctx context.Context, organizationId uuid.UUID, from asteroid.Status, to asteroid.Status) ([]uuid.UUID, error) {
+	query := `
+		UPDATE run SET status = $1
+		WHERE status = $2 AND task_id IN (
+			SELECT t.id
+			FROM task t
+			JOIN project p ON p.id = t.project_id
+			WHERE p.organization_id = $3
+		) AND id NOT IN (SELECT run_id FROM run_pause)
+		RETURNING id`
+
+	rows, err := s.db.QueryContext(ctx, query, to, from, organizationId)
+	if err != nil {
+		return nil, fmt.Errorf("error updating organization runs: %w", err)
+	}
+	defer rows.Close()
+
+	runIds := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var runId uuid.UUID
+		if err := rows.Scan(&runId); err != nil {
+			return nil, fmt.Errorf("error scanning run ID: %w", err)
+		}
+		runIds = append(runIds, runId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating updated runs: %w", err)
+	}
+
+	return runIds, nil
+}
+
+const incidentColumns = `id, project_id, reason, supervisor_id, chain_id, started_at, started_by, ended_at, ended_by`
+
+func scanIncident(row interface{ Scan(dest ...any) error }) (*asteroid.IncidentMode, error) {
+	var incident asteroid.IncidentMode
+	if err := row.Scan(
+		&incident.Id,
+		&incident.ProjectId,
+		&incident.Reason,
+		&incident.SupervisorId,
+		&incident.ChainId,
+		&incident.StartedAt,
+		&incident.StartedBy,
+		&incident.EndedAt,
+		&incident.EndedBy,
+	); err != nil {
+		return nil, err
+	}
+
+	return &incident, nil
+}
+
+func (s *PostgresqlStore) CreateIncident(ctx context.Context, incident asteroid.IncidentMode) error {
+	query := `
+		INSERT INTO project_incident (id, project_id, reason, supervisor_id, chain_id, started_at, started_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := s.db.ExecContext(ctx, query,
+		incident.Id,
+		incident.ProjectId,
+		incident.Reason,
+		incident.SupervisorId,
+		incident.ChainId,
+		incident.StartedAt,
+		incident.StartedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating incident: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetActiveIncident(ctx context.Context, projectId uuid.UUID) (*asteroid.IncidentMode, error) {
+	query := `SELECT ` + incidentColumns + ` FROM project_incident WHERE project_id = $1 AND ended_at IS NULL`
+
+	incident, err := scanIncident(s.db.QueryRowContext(ctx, query, projectId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting active incident: %w", err)
+	}
+
+	return incident, nil
+}
+
+func (s *PostgresqlStore) GetProjectIncidents(ctx context.Context, projectId uuid.UUID) ([]asteroid.IncidentMode, error) {
+	query := `SELECT ` + incidentColumns + ` FROM project_incident WHERE project_id = $1 ORDER BY started_at DESC`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting incidents: %w", err)
+	}
+	defer rows.Close()
+
+	incidents := make([]asteroid.IncidentMode, 0)
+	for rows.Next() {
+		incident, err := scanIncident(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning incident: %w", err)
+		}
+		incidents = append(incidents, *incident)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating incidents: %w", err)
+	}
+
+	return incidents, nil
+}
+
+func (s *PostgresqlStore) EndIncident(ctx context.Context, id uuid.UUID, endedBy string, endedAt time.Time) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE project_incident SET ended_at = $1, ended_by = $2 WHERE id = $3`, endedAt, endedBy, id)
+	if err != nil {
+		return fmt.Errorf("error ending incident: %w", err)
+	}
+
+	return nil
+}
+
+const agentColumns = `id, project_id, name, version, capabilities, tools, tool_policies, created_at`
+
+func scanAgent(row interface{ Scan(dest ...any) error }) (*asteroid.Agent, error) {
+	var agent asteroid.Agent
+	var toolPoliciesJSON []byte
+	if err := row.Scan(
+		&agent.Id,
+		&agent.ProjectId,
+		&agent.Name,
+		&agent.Version,
+		pq.Array(&agent.Capabilities),
+		pq.Array(&agent.Tools),
+		&toolPoliciesJSON,
+		&agent.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(toolPoliciesJSON, &agent.ToolPolicies); err != nil {
+		return nil, fmt.Errorf("error parsing agent tool policies: %w", err)
+	}
+	if agent.Capabilities == nil {
+		agent.Capabilities = []string{}
+	}
+	if agent.Tools == nil {
+		agent.Tools = []string{}
+	}
+
+	return &agent, nil
+}
+
+func (s *PostgresqlStore) CreateAgent(ctx context.Context, agent asteroid.Agent) error {
+	toolPolicies, err := json.Marshal(agent.ToolPolicies)
+	if err != nil {
+		return fmt.Errorf("error marshalling agent tool policies: %w", err)
+	}
+
+	query := `
+		INSERT INTO agent (id, project_id, name, version, capabilities, tools, tool_policies, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err = s.db.ExecContext(ctx, query,
+		agent.Id,
+		agent.ProjectId,
+		agent.Name,
+		agent.Version,
+		pq.Array(agent.Capabilities),
+		pq.Array(agent.Tools),
+		toolPolicies,
+		agent.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating agent: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetAgent(ctx context.Context, id uuid.UUID) (*asteroid.Agent, error) {
+	query := `SELECT ` + agentColumns + ` FROM agent WHERE id = $1`
+
+	agent, err := scanAgent(s.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting agent: %w", err)
+	}
+
+	return agent, nil
+}
+
+func (s *PostgresqlStore) GetAgentFromNameAndVersion(ctx context.Context, projectId uuid.UUID, name string, version string) (*asteroid.Agent, error) {
+	query := `SELECT ` + agentColumns + ` FROM agent WHERE project_id = $1 AND name = $2 AND version = $3`
+
+	agent, err := scanAgent(s.db.QueryRowContext(ctx, query, projectId, name, version))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting agent: %w", err)
+	}
+
+	return agent, nil
+}
+
+func (s *PostgresqlStore) GetProjectAgents(ctx context.Context, projectId uuid.UUID) ([]asteroid.Agent, error) {
+	query := `SELECT ` + agentColumns + ` FROM agent WHERE project_id = $1 ORDER BY name, created_at DESC`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting agents: %w", err)
+	}
+	defer rows.Close()
+
+	agents := make([]asteroid.Agent, 0)
+	for rows.Next() {
+		agent, err := scanAgent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning agent: %w", err)
+		}
+		agents = append(agents, *agent)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating agents: %w", err)
+	}
+
+	return agents, nil
+}
+
+// chatUsageColumns are a chat's ID, run, creation time, model and token usage
+const chatUsageColumns = `c.id, c.run_id, c.created_at, c.model, c.prompt_tokens, c.completion_tokens`
+
+func (s *PostgresqlStore) GetTaskChatUsage(ctx context.Context, taskId uuid.UUID) ([]asteroid.ChatUsage, error) {
+	query := `
+		SELECT ` + chatUsageColumns + `
+		FROM chat c
+		JOIN run r ON c.run_id = r.id
+		WHERE r.task_id = $1
+		ORDER BY c.created_at, c.id`
+
+	rows, err := s.db.QueryContext(ctx, query, taskId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chat usage: %w", err)
+	}
+	defer rows.Close()
+
+	return scanChatUsage(rows)
+}
+
+func (s *PostgresqlStore) GetRunChatUsage(ctx context.Context, runId uuid.UUID) ([]asteroid.ChatUsage, error) {
+	query := `
+		SELECT ` + chatUsageColumns + `
+		FROM chat c
+		WHERE c.run_id = $1
+		ORDER BY c.created_at, c.id`
+
+	rows, err := s.db.QueryContext(ctx, query, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chat usage: %w", err)
+	}
+	defer rows.Close()
+
+	return scanChatUsage(rows)
+}
+
+func scanChatUsage(rows *sql.Rows) ([]asteroid.ChatUsage, error) {
+	usage := make([]asteroid.ChatUsage, 0)
+	for rows.Next() {
+		var chat asteroid.ChatUsage
+		if err := rows.Scan(&chat.Id, &chat.RunId, &chat.CreatedAt, &chat.Model, &chat.PromptTokens, &chat.CompletionTokens); err != nil {
+			return nil, fmt.Errorf("error scanning chat usage: %w", err)
+		}
+		usage = append(usage, chat)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating chat usage: %w", err)
+	}
+
+	return usage, nil
+}
+
+func (s *PostgresqlStore) GetProjectModelUsage(ctx context.Context, projectId uuid.UUID, since time.Time) ([]asteroid.ModelUsage, error) {
+	query := `
+		SELECT c.model, COUNT(*), COALESCE(SUM(c.prompt_tokens), 0), COALESCE(SUM(c.completion_tokens), 0)
+		FROM chat c
+		JOIN run r ON c.run_id = r.id
+		JOIN task t ON r.task_id = t.id
+		WHERE t.project_id = $1 AND c.created_at >= $2
+		GROUP BY c.model
+		ORDER BY c.model`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId, since)
+	if err != nil {
+		return nil, fmt.Errorf("error getting model usage: %w", err)
+	}
+	defer rows.Close()
+
+	usage := make([]asteroid.ModelUsage, 0)
+	for rows.Next() {
+		var model asteroid.ModelUsage
+		if err := rows.Scan(&model.Model, &model.Chats, &model.PromptTokens, &model.CompletionTokens); err != nil {
+			return nil, fmt.Errorf("error scanning model usage: %w", err)
+		}
+		usage = append(usage, model)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating model usage: %w", err)
+	}
+
+	return usage, nil
+}
+
+const consentColumns = `id, supervisionrequest_id, token, status, created_at, expires_at, responded_at, comment`
+
+func scanConsentRequest(row interface{ Scan(dest ...any) error }) (*asteroid.ConsentRequest, error) {
+	var consent asteroid.ConsentRequest
+	if err := row.Scan(
+		&consent.Id,
+		&consent.SupervisionRequestId,
+		&consent.Token,
+		&consent.Status,
+		&consent.CreatedAt,
+		&consent.ExpiresAt,
+		&consent.RespondedAt,
+		&consent.Comment,
+	); err != nil {
+		return nil, err
+	}
+
+	consent.Link = asteroid.ConsentLink(consent.Token)
+	return &consent, nil
+}
+
+func (s *PostgresqlStore) CreateConsentRequest(ctx context.Context, consent asteroid.ConsentRequest) error {
+	query := `
+		INSERT INTO consent_request (id, supervisionrequest_id, token, status, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err := s.db.ExecContext(ctx, query,
+		consent.Id,
+		consent.SupervisionRequestId,
+		consent.Token,
+		consent.Status,
+		consent.CreatedAt,
+		consent.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating consent request: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetConsentRequest(ctx context.Context, supervisionRequestId uuid.UUID) (*asteroid.ConsentRequest, error) {
+	query := `SELECT ` + consentColumns + ` FROM consent_request WHERE supervisionrequest_id = $1`
+
+	consent, err := scanConsentRequest(s.db.QueryRowContext(ctx, query, supervisionRequestId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting consent request: %w", err)
+	}
+
+	return consent, nil
+}
+
+func (s *PostgresqlStore) GetConsentRequestFromToken(ctx context.Context, token string) (*asteroid.ConsentRequest, error) {
+	query := `SELECT ` + consentColumns + ` FROM consent_request WHERE token = $1`
+
+	consent, err := scanConsentRequest(s.db.QueryRowContext(ctx, query, token))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting consent request: %w", err)
+	}
+
+	return consent, nil
+}
+
+func (s *PostgresqlStore) GetExpiredConsentRequests(ctx context.Context, now time.Time) ([]asteroid.ConsentRequest, error) {
+	query := `SELECT ` + consentColumns + ` FROM consent_request WHERE status = $1 AND expires_at <= $2 ORDER BY expires_at`
+
+	rows, err := s.db.QueryContext(ctx, query, asteroid.AwaitingConsent, now)
+	if err != nil {
+		return nil, fmt.Errorf("error getting expired consent requests: %w", err)
+	}
+	defer rows.Close()
+
+	consents := make([]asteroid.ConsentRequest, 0)
+	for rows.Next() {
+		consent, err := scanConsentRequest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning consent request: %w", err)
+		}
+		consents = append(consents, *consent)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating consent requests: %w", err)
+	}
+
+	return consents, nil
+}
+
+func (s *PostgresqlStore) SetConsentResponse(ctx context.Context, id uuid.UUID, status asteroid.ConsentStatus, comment *string, respondedAt time.Time) (bool, error) {
+	query := `
+		UPDATE consent_request
+		SET status = $1, comment = $2, responded_at = $3
+		WHERE id = $4 AND status = $5`
+
+	res, err := s.db.ExecContext(ctx, query, status, comment, respondedAt, id, asteroid.AwaitingConsent)
+	if err != nil {
+		return false, fmt.Errorf("error recording consent response: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error recording consent response: %w", err)
+	}
+
+	return n > 0, nil
+}
+
+func (s *PostgresqlStore) GetQuotas(ctx context.Context, scope string, scopeId uuid.UUID) ([]asteroid.Quota, error) {
+	query := `
+		SELECT metric, soft_limit, hard_limit
+		FROM quota
+		WHERE scope = $1 AND scope_id = $2
+		ORDER BY metric`
+
+	rows, err := s.db.QueryContext(ctx, query, scope, scopeId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting quotas: %w", err)
+	}
+	defer rows.Close()
+
+	quotas := make([]asteroid.Quota, 0)
+	for rows.Next() {
+		var quota asteroid.Quota
+		var softLimit, hardLimit sql.NullInt64
+		if err := rows.Scan(&quota.Metric, &softLimit, &hardLimit); err != nil {
+			return nil, fmt.Errorf("error scanning quota: %w", err)
+		}
+		if softLimit.Valid {
+			quota.SoftLimit = &softLimit.Int64
+		}
+		if hardLimit.Valid {
+			quota.HardLimit = &hardLimit.Int64
+		}
+		quotas = append(quotas, quota)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating quotas: %w", err)
+	}
+
+	return quotas, nil
+}
+
+func (s *PostgresqlStore) SetQuotas(ctx context.Context, scope string, scopeId uuid.UUID, quotas []asteroid.Quota) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM quota WHERE scope = $1 AND scope_id = $2`, scope, scopeId)
+	if err != nil {
+		return fmt.Errorf("error deleting quotas: %w", err)
+	}
+
+	query := `
+		INSERT INTO quota (scope, scope_id, metric, soft_limit, hard_limit)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	for _, quota := range quotas {
+		_, err = tx.ExecContext(ctx, query, scope, scopeId, quota.Metric, quota.SoftLimit, quota.HardLimit)
+		if err != nil {
+			return fmt.Errorf("error creating quota: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetQuotaUsage(ctx context.Context, scope string, scopeId uuid.UUID, metric asteroid.QuotaMetric, since time.Time) (int64, error) {
+	projectFilter := `p.id = $1`
+	if scope == "organization" {
+		projectFilter = `p.organization_id = $1`
+	}
+
+	var query string
+	args := []any{scopeId}
+	switch metric {
+	case asteroid.RunsPerDay:
+		query = `
+			SELECT COUNT(*)
+			FROM run r
+			JOIN task t ON t.id = r.task_id
+			JOIN project p ON p.id = t.project_id
+			WHERE ` + projectFilter + ` AND r.created_at >= $2`
+		args = append(args, since)
+	case asteroid.StoredBytes:
+		query = `
+			SELECT COALESCE(SUM(pg_column_size(c.request_data) + pg_column_size(c.response_data)), 0)
+			FROM chat c
+			JOIN run r ON r.id = c.run_id
+			JOIN task t ON t.id = r.task_id
+			JOIN project p ON p.id = t.project_id
+			WHERE ` + projectFilter
+	case asteroid.PendingReviews:
+		query = `
+			SELECT COUNT(*)
+			FROM supervisionrequest sr
+			JOIN supervisor s ON s.id = sr.supervisor_id
+			JOIN (
+					SELECT supervisionrequest_id, MAX(id) as latest_status_id
+					FROM supervisionrequest_status
+					GROUP BY supervisionrequest_id
+			) latest ON sr.id = latest.supervisionrequest_id
+			JOIN supervisionrequest_status srs ON srs.id = latest.latest_status_id
+			JOIN chainexecution ce ON ce.id = sr.chainexecution_id
+			JOIN toolcall tc ON tc.id = ce.toolcall_id
+			JOIN tool tl ON tl.id = tc.tool_id
+			JOIN run r ON r.id = tl.run_id
+			JOIN task t ON t.id = r.task_id
+			JOIN project p ON p.id = t.project_id
+			WHERE ` + projectFilter + ` AND s.type != $2 AND srs.status IN ($3, $4)`
+		args = append(args, asteroid.ClientSupervisor, asteroid.Pending, asteroid.Assigned)
+	default:
+		return 0, fmt.Errorf("unknown quota metric: %s", metric)
+	}
+
+	var used int64
+	if err := s.db.QueryRowContext(ctx, query, args...).Scan(&used); err != nil {
+		return 0, fmt.Errorf("error getting %s usage: %w", metric, err)
+	}
+
+	return used, nil
+}
+
+func (s *PostgresqlStore) GetRunLimits(ctx context.Context, projectId uuid.UUID) (*asteroid.RunLimits, error) {
+	query := `
+		SELECT tool_calls_per_minute, max_pending_supervisions, max_chat_requests
+		FROM project_run_limits
+		WHERE project_id = $1`
+
+	var limits asteroid.RunLimits
+	err := s.db.QueryRowContext(ctx, query, projectId).Scan(&limits.ToolCallsPerMinute, &limits.MaxPendingSupervisions, &limits.MaxChatRequests)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting run limits: %w", err)
+	}
+
+	return &limits, nil
+}
+
+func (s *PostgresqlStore) SetRunLimits(ctx context.Context, projectId uuid.UUID, limits asteroid.RunLimits) error {
+	query := `
+		INSERT INTO project_run_limits (project_id, tool_calls_per_minute, max_pending_supervisions, max_chat_requests)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id) DO UPDATE SET
+			tool_calls_per_minute = EXCLUDED.tool_calls_per_minute,
+			max_pending_supervisions = EXCLUDED.max_pending_supervisions,
+			max_chat_requests = EXCLUDED.max_chat_requests`
+
+	_, err := s.db.ExecContext(ctx, query, projectId, limits.ToolCallsPerMinute, limits.MaxPendingSupervisions, limits.MaxChatRequests)
+	if err != nil {
+		return fmt.Errorf("error setting run limits: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetRunLimitUsage(ctx context.Context, runId uuid.UUID, limit asteroid.RunLimitKind, since time.Time) (int64, error) {
+	var query string
+	args := []any{runId}
+	switch limit {
+	case asteroid.ToolCallsPerMinute:
+		query = `
+			SELECT COUNT(*)
+			FROM toolcall tc
+			JOIN tool tl ON tl.id = tc.tool_id
+			WHERE tl.run_id = $1 AND tc.created_at >= $2`
+		args = append(args, since)
+	case asteroid.MaxPendingSupervisions:
+		query = `
+			SELECT COUNT(*)
+			FROM supervisionrequest sr
+			JOIN supervisor s ON s.id = sr.supervisor_id
+			JOIN (
+					SELECT supervisionrequest_id, MAX(id) as latest_status_id
+					FROM supervisionrequest_status
+					GROUP BY supervisionrequest_id
+			) latest ON sr.id = latest.supervisionrequest_id
+			JOIN supervisionrequest_status srs ON srs.id = latest.latest_status_id
+			JOIN chainexecution ce ON ce.id = sr.chainexecution_id
+			JOIN toolcall tc ON tc.id = ce.toolcall_id
+			JOIN tool tl ON tl.id = tc.tool_id
+			WHERE tl.run_id = $1 AND s.type != $2 AND srs.status IN ($3, $4)`
+		args = append(args, asteroid.ClientSupervisor, asteroid.Pending, asteroid.Assigned)
+	case asteroid.MaxChatRequests:
+		query = `SELECT COUNT(*) FROM chat WHERE run_id = $1`
+	default:
+		return 0, fmt.Errorf("unknown run limit: %s", limit)
+	}
+
+	var used int64
+	if err := s.db.QueryRowContext(ctx, query, args...).Scan(&used); err != nil {
+		return 0, fmt.Errorf("error getting %s usage: %w", limit, err)
+	}
+
+	return used, nil
+}
+
+func (s *PostgresqlStore) CreateMeteringEvent(ctx context.Context, event asteroid.MeteringEvent) error {
+	// Events that were already recorded are skipped, so metering is idempotent
+	query := `
+		INSERT INTO metering_event (id, idempotency_key, metric, quantity, project_id, organization_id, resource_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (idempotency_key) DO NOTHING`
+
+	_, err := s.db.ExecContext(ctx, query,
+		event.Id,
+		event.IdempotencyKey,
+		event.Metric,
+		event.Quantity,
+		event.ProjectId,
+		event.OrganizationId,
+		event.ResourceId,
+		event.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating metering event: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetMeteringEvents(ctx context.Context, after int64, limit int, organizationId *uuid.UUID, projectId *uuid.UUID) ([]asteroid.MeteringEvent, error) {
+	query := `
+		SELECT sequence, id, idempotency_key, metric, quantity, project_id, organization_id, resource_id, created_at
+		FROM metering_event
+		WHERE sequence > $1
+			AND ($2::uuid IS NULL OR organization_id = $2)
+			AND ($3::uuid IS NULL OR project_id = $3)
+		ORDER BY sequence
+		LIMIT $4`
+
+	rows, err := s.db.QueryContext(ctx, query, after, organizationId, projectId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting metering events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]asteroid.MeteringEvent, 0)
+	for rows.Next() {
+		var event asteroid.MeteringEvent
+		if err := rows.Scan(
+			&event.Sequence,
+			&event.Id,
+			&event.IdempotencyKey,
+			&event.Metric,
+			&event.Quantity,
+			&event.ProjectId,
+			&event.OrganizationId,
+			&event.ResourceId,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning metering event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating metering events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (s *PostgresqlStore) GetIngestionHooks(ctx context.Context, projectId uuid.UUID) ([]asteroid.IngestionHook, error) {
+	query := `
+		SELECT name, url, payload_types, timeout_ms, fail_open
+		FROM project_ingestion_hook
+		WHERE project_id = $1
+		ORDER BY position`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ingestion hooks: %w", err)
+	}
+	defer rows.Close()
+
+	hooks := make([]asteroid.IngestionHook, 0)
+	for rows.Next() {
+		var hook asteroid.IngestionHook
+		var payloadTypesJSON []byte
+		var timeoutMs sql.NullInt32
+		var failOpen bool
+		if err := rows.Scan(&hook.Name, &hook.Url, &payloadTypesJSON, &timeoutMs, &failOpen); err != nil {
+			return nil, fmt.Errorf("error scanning ingestion hook: %w", err)
+		}
+		if err := json.Unmarshal(payloadTypesJSON, &hook.PayloadTypes); err != nil {
+			return nil, fmt.Errorf("error unmarshalling ingestion hook payload types: %w", err)
+		}
+		if timeoutMs.Valid {
+			timeout := int(timeoutMs.Int32)
+			hook.TimeoutMs = &timeout
+		}
+		hook.FailOpen = &failOpen
+		hooks = append(hooks, hook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ingestion hooks: %w", err)
+	}
+
+	return hooks, nil
+}
+
+func (s *PostgresqlStore) SetIngestionHooks(ctx context.Context, projectId uuid.UUID, hooks []asteroid.IngestionHook) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM project_ingestion_hook WHERE project_id = $1`, projectId)
+	if err != nil {
+		return fmt.Errorf("error deleting ingestion hooks: %w", err)
+	}
+
+	query := `
+		INSERT INTO project_ingestion_hook (project_id, position, name, url, payload_types, timeout_ms, fail_open)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	for i, hook := range hooks {
+		payloadTypes, err := json.Marshal(hook.PayloadTypes)
+		if err != nil {
+			return fmt.Errorf("error marshalling ingestion hook payload types: %w", err)
+		}
+
+		failOpen := hook.FailOpen != nil && *hook.FailOpen
+		_, err = tx.ExecContext(ctx, query, projectId, i, hook.Name, hook.Url, payloadTypes, hook.TimeoutMs, failOpen)
+		if err != nil {
+			return fmt.Errorf("error creating ingestion hook: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+const runDocumentColumns = `id, run_id, name, content_type, size_bytes, include_in_supervisor_context, created_at`
+
+func scanRunDocument(row interface{ Scan(dest ...any) error }) (*asteroid.RunDocument, error) {
+	var document asteroid.RunDocument
+	if err := row.Scan(
+		&document.Id,
+		&document.RunId,
+		&document.Name,
+		&document.ContentType,
+		&document.SizeBytes,
+		&document.IncludeInSupervisorContext,
+		&document.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+func (s *PostgresqlStore) CreateRunDocument(ctx context.Context, document asteroid.RunDocument) error {
+	query := `INSERT INTO run_document (` + runDocumentColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := s.db.ExecContext(ctx, query,
+		document.Id,
+		document.RunId,
+		document.Name,
+		document.ContentType,
+		document.SizeBytes,
+		document.IncludeInSupervisorContext,
+		document.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating run document: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetRunDocument(ctx context.Context, id uuid.UUID) (*asteroid.RunDocument, error) {
+	query := `SELECT ` + runDocumentColumns + ` FROM run_document WHERE id = $1`
+
+	document, err := scanRunDocument(s.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting run document: %w", err)
+	}
+
+	return document, nil
+}
+
+func (s *PostgresqlStore) GetRunDocuments(ctx context.Context, runId uuid.UUID) ([]asteroid.RunDocument, error) {
+	query := `SELECT ` + runDocumentColumns + ` FROM run_document WHERE run_id = $1 ORDER BY created_at`
+
+	rows, err := s.db.QueryContext(ctx, query, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting run documents: %w", err)
+	}
+	defer rows.Close()
+
+	documents := make([]asteroid.RunDocument, 0)
+	for rows.Next() {
+		document, err := scanRunDocument(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning run document: %w", err)
+		}
+		documents = append(documents, *document)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating run documents: %w", err)
+	}
+
+	return documents, nil
+}
+
+func (s *PostgresqlStore) CreateResourceReferences(ctx context.Context, references []asteroid.ResourceReference) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		INSERT INTO toolcall_resource (toolcall_id, run_id, kind, identifier, argument, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT DO NOTHING`
+
+	for _, reference := range references {
+		_, err := tx.ExecContext(ctx, query,
+			reference.ToolCallId,
+			reference.RunId,
+			reference.Kind,
+			reference.Identifier,
+			reference.Argument,
+			reference.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("error creating resource reference: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func scanResourceReferences(rows *sql.Rows) ([]asteroid.ResourceReference, error) {
+	defer rows.Close()
+
+	references := make([]asteroid.ResourceReference, 0)
+	for rows.Next() {
+		var reference asteroid.ResourceReference
+		if err := rows.Scan(
+			&reference.ToolCallId,
+			&reference.RunId,
+			&reference.Kind,
+			&reference.Identifier,
+			&reference.Argument,
+			&reference.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning resource reference: %w", err)
+		}
+		references = append(references, reference)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating resource references: %w", err)
+	}
+
+	return references, nil
+}
+
+func (s *PostgresqlStore) GetToolCallResourceReferences(ctx context.Context, toolCallId uuid.UUID) ([]asteroid.ResourceReference, error) {
+	query := `
+		SELECT toolcall_id, run_id, kind, identifier, argument, created_at
+		FROM toolcall_resource
+		WHERE toolcall_id = $1
+		ORDER BY identifier, argument`
+
+	rows, err := s.db.QueryContext(ctx, query, toolCallId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource references: %w", err)
+	}
+
+	return scanResourceReferences(rows)
+}
+
+func (s *PostgresqlStore) GetProjectResourceReferences(ctx context.Context, projectId uuid.UUID, identifier string, prefix bool, kind *asteroid.ResourceKind, limit int) ([]asteroid.ResourceReference, error) {
+	// A prefix only matches whole path, schema or ARN segments, so s3://bucket doesn't match s3://bucket-2
+	query := `
+		SELECT tr.toolcall_id, tr.run_id, tr.kind, tr.identifier, tr.argument, tr.created_at
+		FROM toolcall_resource tr
+		JOIN run r ON r.id = tr.run_id
+		JOIN task t ON t.id = r.task_id
+		WHERE t.project_id = $1
+			AND (tr.identifier = $2 OR ($3 AND tr.identifier LIKE $4 ESCAPE '\' AND substr(tr.identifier, length($2) + 1, 1) IN ('/', '.', ':')))
+			AND ($5::text IS NULL OR tr.kind = $5)
+		ORDER BY tr.created_at DESC
+		LIMIT $6`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId, identifier, prefix, escapeLike(identifier)+"%", kind, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource references: %w", err)
+	}
+
+	return scanResourceReferences(rows)
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern, with a backslash as its ESCAPE
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (s *PostgresqlStore) GetResourceHistory(ctx context.Context, projectId uuid.UUID, kind asteroid.ResourceKind, identifier string, excludeToolCallId uuid.UUID) (*asteroid.BlastRadiusResource, error) {
+	query := `
+		WITH touches AS (
+			SELECT DISTINCT tr.toolcall_id, tc.created_at
+			FROM toolcall_resource tr
+			JOIN toolcall tc ON tc.id = tr.toolcall_id
+			JOIN run r ON r.id = tr.run_id
+			JOIN task t ON t.id = r.task_id
+			WHERE t.project_id = $1 AND tr.kind = $2 AND tr.identifier = $3 AND tr.toolcall_id != $4
+		)
+		SELECT
+			(SELECT COUNT(*) FROM touches),
+			(SELECT COUNT(*) FROM touches tt WHERE EXISTS (
+				SELECT 1
+				FROM chainexecution ce
+				JOIN supervisionrequest sr ON sr.chainexecution_id = ce.id
+				JOIN supervisionresult res ON res.supervisionrequest_id = sr.id
+				WHERE ce.toolcall_id = tt.toolcall_id AND res.decision IN ('reject', 'terminate')
+			)),
+			(SELECT COUNT(DISTINCT i.id)
+				FROM project_incident i
+				JOIN touches tt ON tt.created_at >= i.started_at AND (i.ended_at IS NULL OR tt.created_at <= i.ended_at)
+				WHERE i.project_id = $1),
+			(SELECT MAX(created_at) FROM touches)`
+
+	resource := asteroid.BlastRadiusResource{Kind: kind, Identifier: identifier}
+	var lastTouchedAt sql.NullTime
+	err := s.db.QueryRowContext(ctx, query, projectId, kind, identifier, excludeToolCallId).Scan(
+		&resource.PriorToolCalls,
+		&resource.PriorRejections,
+		&resource.PriorIncidents,
+		&lastTouchedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource history: %w", err)
+	}
+	if lastTouchedAt.Valid {
+		resource.LastTouchedAt = &lastTouchedAt.Time
+	}
+
+	return &resource, nil
+}
+
+func (s *PostgresqlStore) GetProjectVerdicts(ctx context.Context, projectId uuid.UUID) ([]asteroid.CustomVerdict, error) {
+	query := `
+		SELECT name, behavior, description
+		FROM project_verdict
+		WHERE project_id = $1
+		ORDER BY name`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting verdicts: %w", err)
+	}
+	defer rows.Close()
+
+	verdicts := make([]asteroid.CustomVerdict, 0)
+	for rows.Next() {
+		var verdict asteroid.CustomVerdict
+		if err := rows.Scan(&verdict.Name, &verdict.Behavior, &verdict.Description); err != nil {
+			return nil, fmt.Errorf("error scanning verdict: %w", err)
+		}
+		verdicts = append(verdicts, verdict)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating verdicts: %w", err)
+	}
+
+	return verdicts, nil
+}
+
+func (s *PostgresqlStore) SetProjectVerdicts(ctx context.Context, projectId uuid.UUID, verdicts []asteroid.CustomVerdict) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM project_verdict WHERE project_id = $1`, projectId)
+	if err != nil {
+		return fmt.Errorf("error deleting verdicts: %w", err)
+	}
+
+	query := `
+		INSERT INTO project_verdict (project_id, name, behavior, description)
+		VALUES ($1, $2, $3, $4)`
+
+	for _, verdict := range verdicts {
+		_, err = tx.ExecContext(ctx, query, projectId, verdict.Name, verdict.Behavior, verdict.Description)
+		if err != nil {
+			return fmt.Errorf("error creating verdict: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+const clarificationColumns = `id, supervisionrequest_id, question, asked_by, asked_at, answer, answered_at`
+
+func scanClarification(row interface{ Scan(dest ...any) error }) (*asteroid.Clarification, error) {
+	var clarification asteroid.Clarification
+	var questionJSON []byte
+	var answer sql.NullString
+	var answeredAt sql.NullTime
+	if err := row.Scan(
+		&clarification.Id,
+		&clarification.SupervisionRequestId,
+		&questionJSON,
+		&clarification.AskedBy,
+		&clarification.AskedAt,
+		&answer,
+		&answeredAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(questionJSON, &clarification.Question); err != nil {
+		return nil, fmt.Errorf("error unmarshalling clarification question: %w", err)
+	}
+	if answer.Valid {
+		clarification.Answer = &answer.String
+	}
+	if answeredAt.Valid {
+		clarification.AnsweredAt = &answeredAt.Time
+	}
+	return &clarification, nil
+}
+
+func (s *PostgresqlStore) CreateClarification(ctx context.Context, clarification asteroid.Clarification) (bool, error) {
+	question, err := json.Marshal(clarification.Question)
+	if err != nil {
+		return false, fmt.Errorf("error marshalling clarification question: %w", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// A request only has one open question, so asking another is a conflict rather than an error
+	query := `
+		INSERT INTO clarification (id, supervisionrequest_id, question, asked_by, asked_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT DO NOTHING`
+
+	res, err := tx.ExecContext(ctx, query, clarification.Id, clarification.SupervisionRequestId, question, clarification.AskedBy, clarification.AskedAt)
+	if err != nil {
+		return false, fmt.Errorf("error creating clarification: %w", err)
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error creating clarification: %w", err)
+	}
+	if inserted == 0 {
+		return false, nil
+	}
+
+	err = s.createSupervisionStatus(ctx, clarification.SupervisionRequestId, asteroid.SupervisionStatus{
+		Status:    asteroid.AwaitingClarification,
+		CreatedAt: clarification.AskedAt,
+	}, tx)
+	if err != nil {
+		return false, fmt.Errorf("error creating supervision status for clarification: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *PostgresqlStore) GetClarification(ctx context.Context, id uuid.UUID) (*asteroid.Clarification, error) {
+	query := `SELECT ` + clarificationColumns + ` FROM clarification WHERE id = $1`
+
+	clarification, err := scanClarification(s.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting clarification: %w", err)
+	}
+
+	return clarification, nil
+}
+
+func (s *PostgresqlStore) GetClarifications(ctx context.Context, supervisionRequestId uuid.UUID) ([]asteroid.Clarification, error) {
+	query := `SELECT ` + clarificationColumns + ` FROM clarification WHERE supervisionrequest_id = $1 ORDER BY asked_at`
+
+	rows, err := s.db.QueryContext(ctx, query, supervisionRequestId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting clarifications: %w", err)
+	}
+	defer rows.Close()
+
+	clarifications := make([]asteroid.Clarification, 0)
+	for rows.Next() {
+		clarification, err := scanClarification(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning clarification: %w", err)
+		}
+		clarifications = append(clarifications, *clarification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating clarifications: %w", err)
+	}
+
+	return clarifications, nil
+}
+
+func (s *PostgresqlStore) AnswerClarification(ctx context.Context, id uuid.UUID, answer string, answeredAt time.Time) (bool, error) {
+	query := `
+		UPDATE clarification
+		SET answer = $2, answered_at = $3
+		WHERE id = $1 AND answered_at IS NULL`
+
+	res, err := s.db.ExecContext(ctx, query, id, answer, answeredAt)
+	if err != nil {
+		return false, fmt.Errorf("error answering clarification: %w", err)
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error answering clarification: %w", err)
+	}
+
+	return updated > 0, nil
+}
+
+func (s *PostgresqlStore) GetRoutingRules(ctx context.Context, projectId uuid.UUID) ([]asteroid.RoutingRule, error) {
+	query := `
+		SELECT tool_name, category, min_risk_tier, irreversible, required_skills
+		FROM project_routing_rule
+		WHERE project_id = $1
+		ORDER BY position`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting routing rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := make([]asteroid.RoutingRule, 0)
+	for rows.Next() {
+		var rule asteroid.RoutingRule
+		var skillsJSON []byte
+		if err := rows.Scan(&rule.ToolName, &rule.Category, &rule.MinRiskTier, &rule.Irreversible, &skillsJSON); err != nil {
+			return nil, fmt.Errorf("error scanning routing rule: %w", err)
+		}
+		if err := json.Unmarshal(skillsJSON, &rule.RequiredSkills); err != nil {
+			return nil, fmt.Errorf("error unmarshalling routing rule skills: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating routing rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (s *PostgresqlStore) SetRoutingRules(ctx context.Context, projectId uuid.UUID, rules []asteroid.RoutingRule) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM project_routing_rule WHERE project_id = $1`, projectId)
+	if err != nil {
+		return fmt.Errorf("error deleting routing rules: %w", err)
+	}
+
+	query := `
+		INSERT INTO project_routing_rule (project_id, position, tool_name, category, min_risk_tier, irreversible, required_skills)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	for i, rule := range rules {
+		skills, err := json.Marshal(rule.RequiredSkills)
+		if err != nil {
+			return fmt.Errorf("error marshalling routing rule skills: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, query, projectId, i, rule.ToolName, rule.Category, rule.MinRiskTier, rule.Irreversible, skills)
+		if err != nil {
+			return fmt.Errorf("error creating routing rule: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetReviewers(ctx context.Context) ([]asteroid.Reviewer, error) {
+	query := `
+		SELECT session, name, skills, locale, updated_at
+		FROM reviewer
+		ORDER BY session`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error getting reviewers: %w", err)
+	}
+	defer rows.Close()
+
+	reviewers := make([]asteroid.Reviewer, 0)
+	for rows.Next() {
+		var reviewer asteroid.Reviewer
+		var skillsJSON []byte
+		if err := rows.Scan(&reviewer.Session, &reviewer.Name, &skillsJSON, &reviewer.Locale, &reviewer.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("error scanning reviewer: %w", err)
+		}
+		if err := json.Unmarshal(skillsJSON, &reviewer.Skills); err != nil {
+			return nil, fmt.Errorf("error unmarshalling reviewer skills: %w", err)
+		}
+		reviewers = append(reviewers, reviewer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reviewers: %w", err)
+	}
+
+	return reviewers, nil
+}
+
+func (s *PostgresqlStore) SetReviewer(ctx context.Context, reviewer asteroid.Reviewer) error {
+	skills, err := json.Marshal(reviewer.Skills)
+	if err != nil {
+		return fmt.Errorf("error marshalling reviewer skills: %w", err)
+	}
+
+	query := `
+		INSERT INTO reviewer (session, name, skills, locale, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (session) DO UPDATE SET name = EXCLUDED.name, skills = EXCLUDED.skills, locale = EXCLUDED.locale, updated_at = EXCLUDED.updated_at`
+
+	_, err = s.db.ExecContext(ctx, query, reviewer.Session, reviewer.Name, skills, reviewer.Locale, reviewer.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("error setting reviewer: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) CreateUser(ctx context.Context, user asteroid.User) (bool, error) {
+	query := `
+		INSERT INTO asteroid_user (id, email, name, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (email) DO NOTHING`
+
+	result, err := s.db.ExecContext(ctx, query, user.Id, user.Email, user.Name, user.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("error creating user: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+func (s *PostgresqlStore) GetUser(ctx context.Context, id uuid.UUID) (*asteroid.User, error) {
+	query := `SELECT id, email, name, created_at FROM asteroid_user WHERE id = $1`
+
+	var user asteroid.User
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&user.Id, &user.Email, &user.Name, &user.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting user: %w", err)
+	}
+
+	return &user, nil
+}
+
+func (s *PostgresqlStore) GetUsers(ctx context.Context) ([]asteroid.User, error) {
+	query := `SELECT id, email, name, created_at FROM asteroid_user ORDER BY name, email`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error getting users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]asteroid.User, 0)
+	for rows.Next() {
+		var user asteroid.User
+		if err := rows.Scan(&user.Id, &user.Email, &user.Name, &user.CreatedAt); err != nil {
+			return nil, fmt.Errorf("error scanning user: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating users: %w", err)
+	}
+
+	return users, nil
+}
+
+// ClaimReview inserts the claim unless the request has one, then reads whichever claim holds it, so
+// of two users claiming a request at once only one gets it
+func (s *PostgresqlStore) ClaimReview(ctx context.Context, claim asteroid.ReviewClaim) (*asteroid.ReviewClaim, error) {
+	query := `
+		INSERT INTO review_claim (supervisionrequest_id, user_id, claimed_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (supervisionrequest_id) DO NOTHING`
+
+	if _, err := s.db.ExecContext(ctx, query, claim.SupervisionRequestId, claim.UserId, claim.ClaimedAt); err != nil {
+		return nil, fmt.Errorf("error claiming review: %w", err)
+	}
+
+	held, err := s.GetReviewClaim(ctx, claim.SupervisionRequestId)
+	if err == nil && held == nil {
+		err = fmt.Errorf("review %s was claimed but has no claim", claim.SupervisionRequestId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return held, nil
+}
+
+func (s *PostgresqlStore) GetReviewClaim(ctx context.Context, supervisionRequestId uuid.UUID) (*asteroid.ReviewClaim, error) {
+	query := `SELECT supervisionrequest_id, user_id, claimed_at FROM review_claim WHERE supervisionrequest_id = $1`
+
+	var claim asteroid.ReviewClaim
+	err := s.db.QueryRowContext(ctx, query, supervisionRequestId).Scan(&claim.SupervisionRequestId, &claim.UserId, &claim.ClaimedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting review claim: %w", err)
+	}
+
+	return &claim, nil
+}
+
+func (s *PostgresqlStore) GetUserReviewClaims(ctx context.Context, userId uuid.UUID) ([]asteroid.ReviewClaim, error) {
+	query := `
+		SELECT supervisionrequest_id, user_id, claimed_at
+		FROM review_claim
+		WHERE user_id = $1
+		ORDER BY claimed_at`
+
+	rows, err := s.db.QueryContext(ctx, query, userId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting review claims: %w", err)
+	}
+	defer rows.Close()
+
+	claims := make([]asteroid.ReviewClaim, 0)
+	for rows.Next() {
+		var claim asteroid.ReviewClaim
+		if err := rows.Scan(&claim.SupervisionRequestId, &claim.UserId, &claim.ClaimedAt); err != nil {
+			return nil, fmt.Errorf("error scanning review claim: %w", err)
+		}
+		claims = append(claims, claim)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating review claims: %w", err)
+	}
+
+	return claims, nil
+}
+
+func (s *PostgresqlStore) ReleaseReview(ctx context.Context, supervisionRequestId uuid.UUID, userId uuid.UUID) (bool, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM review_claim WHERE supervisionrequest_id = $1 AND user_id = $2`, supervisionRequestId, userId)
+	if err != nil {
+		return false, fmt.Errorf("error releasing review: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+func (s *PostgresqlStore) GetTrustPolicy(ctx context.Context, projectId uuid.UUID) (*asteroid.TrustPolicy, error) {
+	query := `
+		SELECT enabled, approvals_to_relax, max_autonomy_level
+		FROM project_trust_policy
+		WHERE project_id = $1`
+
+	var policy asteroid.TrustPolicy
+	err := s.db.QueryRowContext(ctx, query, projectId).Scan(&policy.Enabled, &policy.ApprovalsToRelax, &policy.MaxAutonomyLevel)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting trust policy: %w", err)
+	}
+
+	return &policy, nil
+}
+
+func (s *PostgresqlStore) SetTrustPolicy(ctx context.Context, projectId uuid.UUID, policy asteroid.TrustPolicy) error {
+	query := `
+		INSERT INTO project_trust_policy (project_id, enabled, approvals_to_relax, max_autonomy_level)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id) DO UPDATE SET
+			enabled = EXCLUDED.enabled,
+			approvals_to_relax = EXCLUDED.approvals_to_relax,
+			max_autonomy_level = EXCLUDED.max_autonomy_level`
+
+	_, err := s.db.ExecContext(ctx, query, projectId, policy.Enabled, policy.ApprovalsToRelax, policy.MaxAutonomyLevel)
+	if err != nil {
+		return fmt.Errorf("error setting trust policy: %w", err)
+	}
+
+	return nil
+}
+
+func scanAgentToolTrust(row interface{ Scan(dest ...any) error }) (*asteroid.AgentToolTrust, error) {
+	var trust asteroid.AgentToolTrust
+	if err := row.Scan(
+		&trust.AgentId,
+		&trust.ToolName,
+		&trust.Approvals,
+		&trust.Rejections,
+		&trust.ConsecutiveApprovals,
+		&trust.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &trust, nil
+}
+
+func (s *PostgresqlStore) GetAgentTrust(ctx context.Context, agentId uuid.UUID) ([]asteroid.AgentToolTrust, error) {
+	query := `
+		SELECT agent_id, tool_name, approvals, rejections, consecutive_approvals, updated_at
+		FROM agent_tool_trust
+		WHERE agent_id = $1
+		ORDER BY tool_name`
+
+	rows, err := s.db.QueryContext(ctx, query, agentId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting agent trust: %w", err)
+	}
+	defer rows.Close()
+
+	trust := make([]asteroid.AgentToolTrust, 0)
+	for rows.Next() {
+		toolTrust, err := scanAgentToolTrust(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning agent trust: %w", err)
+		}
+		trust = append(trust, *toolTrust)
+	}
+
+	return trust, rows.Err()
+}
+
+func (s *PostgresqlStore) GetAgentToolTrust(ctx context.Context, agentId uuid.UUID, toolName string) (*asteroid.AgentToolTrust, error) {
+	query := `
+		SELECT agent_id, tool_name, approvals, rejections, consecutive_approvals, updated_at
+		FROM agent_tool_trust
+		WHERE agent_id = $1 AND tool_name = $2`
+
+	trust, err := scanAgentToolTrust(s.db.QueryRowContext(ctx, query, agentId, toolName))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting agent tool trust: %w", err)
+	}
+
+	return trust, nil
+}
+
+func (s *PostgresqlStore) RecordAgentToolDecision(ctx context.Context, agentId uuid.UUID, toolName string, approved bool, decidedAt time.Time) (*asteroid.AgentToolTrust, int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Lock the row so concurrent decisions don't lose each other's counts
+	var previous int
+	err = tx.QueryRowContext(ctx, `
+		SELECT consecutive_approvals
+		FROM agent_tool_trust
+		WHERE agent_id = $1 AND tool_name = $2
+		FOR UPDATE`, agentId, toolName).Scan(&previous)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, 0, fmt.Errorf("error getting agent tool trust: %w", err)
+	}
+
+	approvals, rejections, consecutive := 0, 1, 0
+	if approved {
+		approvals, rejections, consecutive = 1, 0, previous+1
+	}
+
+	query := `
+		INSERT INTO agent_tool_trust (agent_id, tool_name, approvals, rejections, consecutive_approvals, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (agent_id, tool_name) DO UPDATE SET
+			approvals = agent_tool_trust.approvals + EXCLUDED.approvals,
+			rejections = agent_tool_trust.rejections + EXCLUDED.rejections,
+			consecutive_approvals = EXCLUDED.consecutive_approvals,
+			updated_at = EXCLUDED.updated_at
+		RETURNING agent_id, tool_name, approvals, rejections, consecutive_approvals, updated_at`
+
+	trust, err := scanAgentToolTrust(tx.QueryRowContext(ctx, query, agentId, toolName, approvals, rejections, consecutive, decidedAt))
+	if err != nil {
+		return nil, 0, fmt.Errorf("error recording agent tool decision: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return trust, previous, nil
+}
+
+func (s *PostgresqlStore) GetConfidenceOutcomes(ctx context.Context, supervisorId uuid.UUID) ([]asteroid.ConfidenceOutcome, error) {
+	// The last human result later in the chain that decided something is the humans' decision
+	query := `
+		SELECT DISTINCT ON (res.id)
+			(res.explanation->>'confidence')::double precision,
+			COALESCE(res.overridden_decision, res.decision),
+			human_res.decision
+		FROM supervisionresult res
+		JOIN supervisionrequest req ON req.id = res.supervisionrequest_id
+		JOIN supervisionrequest human_req ON human_req.chainexecution_id = req.chainexecution_id
+			AND human_req.position_in_chain > req.position_in_chain
+		JOIN supervisor human ON human.id = human_req.supervisor_id AND human.type = $2
+		JOIN supervisionresult human_res ON human_res.supervisionrequest_id = human_req.id
+			AND human_res.decision <> $3
+		WHERE req.supervisor_id = $1 AND res.explanation ? 'confidence'
+		ORDER BY res.id, human_req.position_in_chain DESC`
+	return s.queryConfidenceOutcomes(ctx, query, supervisorId)
+}
+
+// queryConfidenceOutcomes runs a query of a supervisor's confidence outcomes, which takes the type of
+// human supervisors and the decision that didn't decide anything after the supervisor
+func (s *PostgresqlStore) queryConfidenceOutcomes(ctx context.Context, query string, supervisorId uuid.UUID) ([]asteroid.ConfidenceOutcome, error) {
+	rows, err := s.db.QueryContext(ctx, query, supervisorId, asteroid.HumanSupervisor, asteroid.Escalate)
+	if err != nil {
+		return nil, fmt.Errorf("error getting confidence outcomes: %w", err)
+	}
+	defer rows.Close()
+
+	outcomes := make([]asteroid.ConfidenceOutcome, 0)
+	for rows.Next() {
+		var outcome asteroid.ConfidenceOutcome
+		if err := rows.Scan(&outcome.Confidence, &outcome.Decision, &outcome.HumanDecision); err != nil {
+			return nil, fmt.Errorf("error scanning confidence outcome: %w", err)
+		}
+		outcomes = append(outcomes, outcome)
+	}
+
+	return outcomes, rows.Err()
+}
+
+func (s *PostgresqlStore) CreateEnsembleVerdicts(ctx context.Context, verdicts []asteroid.EnsembleVerdict) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		INSERT INTO ensemble_verdict (id, supervisionrequest_id, member, model, decision, confidence, rationale, error, variant, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	for _, verdict := range verdicts {
+		if _, err := tx.ExecContext(ctx, query,
+			verdict.Id,
+			verdict.SupervisionRequestId,
+			verdict.Member,
+			verdict.Model,
+			verdict.Decision,
+			verdict.Confidence,
+			verdict.Rationale,
+			verdict.Error,
+			verdict.Variant,
+			verdict.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("error creating ensemble verdict: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing ensemble verdicts: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetEnsembleVerdicts(ctx context.Context, supervisionRequestId uuid.UUID) ([]asteroid.EnsembleVerdict, error) {
+	query := `
+		SELECT id, supervisionrequest_id, member, model, decision, confidence, rationale, error, variant, created_at
+		FROM ensemble_verdict
+		WHERE supervisionrequest_id = $1
+		ORDER BY created_at, member`
+
+	rows, err := s.db.QueryContext(ctx, query, supervisionRequestId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ensemble verdicts: %w", err)
+	}
+	defer rows.Close()
+
+	verdicts := make([]asteroid.EnsembleVerdict, 0)
+	for rows.Next() {
+		var verdict asteroid.EnsembleVerdict
+		var confidence sql.NullFloat64
+		var rationale, verdictError, variant sql.NullString
+		if err := rows.Scan(
+			&verdict.Id,
+			&verdict.SupervisionRequestId,
+			&verdict.Member,
+			&verdict.Model,
+			&verdict.Decision,
+			&confidence,
+			&rationale,
+			&verdictError,
+			&variant,
+			&verdict.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning ensemble verdict: %w", err)
+		}
+		if confidence.Valid {
+			verdict.Confidence = &confidence.Float64
+		}
+		if rationale.Valid {
+			verdict.Rationale = &rationale.String
+		}
+		if verdictError.Valid {
+			verdict.Error = &verdictError.String
+		}
+		if variant.Valid {
+			verdict.Variant = &variant.String
+		}
+		verdicts = append(verdicts, verdict)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ensemble verdicts: %w", err)
+	}
+
+	return verdicts, nil
+}
+
+func (s *PostgresqlStore) GetPromptVariantOutcomes(ctx context.Context, supervisorId uuid.UUID) ([]asteroid.PromptVariantOutcome, error) {
+	// Like confidence outcomes, the last human result later in the chain that decided something is
+	// the humans' decision, except results humans never decided are kept
+	query := `
+		SELECT DISTINCT ON (res.id)
+			variant.variant,
+			COALESCE(res.overridden_decision, res.decision),
+			human_res.decision
+		FROM supervisionresult res
+		JOIN supervisionrequest req ON req.id = res.supervisionrequest_id
+		JOIN (
+			SELECT DISTINCT supervisionrequest_id, variant
+			FROM ensemble_verdict
+			WHERE variant IS NOT NULL
+		) variant ON variant.supervisionrequest_id = req.id
+		LEFT JOIN (
+			supervisionrequest human_req
+			JOIN supervisor human ON human.id = human_req.supervisor_id AND human.type = $2
+			JOIN supervisionresult human_res ON human_res.supervisionrequest_id = human_req.id
+				AND human_res.decision <> $3
+		) ON human_req.chainexecution_id = req.chainexecution_id
+			AND human_req.position_in_chain > req.position_in_chain
+		WHERE req.supervisor_id = $1
+		ORDER BY res.id, human_req.position_in_chain DESC NULLS LAST`
+	return s.queryPromptVariantOutcomes(ctx, query, supervisorId)
+}
+
+// queryPromptVariantOutcomes runs a query of a supervisor's prompt variant outcomes, which takes the
+// same arguments as one of its confidence outcomes
+func (s *PostgresqlStore) queryPromptVariantOutcomes(ctx context.Context, query string, supervisorId uuid.UUID) ([]asteroid.PromptVariantOutcome, error) {
+	rows, err := s.db.QueryContext(ctx, query, supervisorId, asteroid.HumanSupervisor, asteroid.Escalate)
+	if err != nil {
+		return nil, fmt.Errorf("error getting prompt variant outcomes: %w", err)
+	}
+	defer rows.Close()
+
+	outcomes := make([]asteroid.PromptVariantOutcome, 0)
+	for rows.Next() {
+		var outcome asteroid.PromptVariantOutcome
+		var humanDecision sql.NullString
+		if err := rows.Scan(&outcome.Variant, &outcome.Decision, &humanDecision); err != nil {
+			return nil, fmt.Errorf("error scanning prompt variant outcome: %w", err)
+		}
+		if humanDecision.Valid {
+			decision := asteroid.Decision(humanDecision.String)
+			outcome.HumanDecision = &decision
+		}
+		outcomes = append(outcomes, outcome)
+	}
+
+	return outcomes, rows.Err()
+}
+
+func (s *PostgresqlStore) GetSupervisorToolDecisions(ctx context.Context, supervisorId uuid.UUID, toolName string) (map[asteroid.Decision]int, error) {
+	query := `
+		SELECT res.decision, COUNT(*)
+		FROM supervisionresult res
+		JOIN supervisionrequest req ON req.id = res.supervisionrequest_id
+		JOIN chainexecution ce ON ce.id = req.chainexecution_id
+		JOIN toolcall tc ON tc.id = ce.toolcall_id
+		JOIN tool t ON t.id = tc.tool_id
+		WHERE req.supervisor_id = $1 AND t.name = $2
+		GROUP BY res.decision`
+
+	rows, err := s.db.QueryContext(ctx, query, supervisorId, toolName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting supervisor tool decisions: %w", err)
+	}
+	defer rows.Close()
+
+	decisions := make(map[asteroid.Decision]int)
+	for rows.Next() {
+		var decision asteroid.Decision
+		var count int
+		if err := rows.Scan(&decision, &count); err != nil {
+			return nil, fmt.Errorf("error scanning supervisor tool decision: %w", err)
+		}
+		decisions[decision] = count
+	}
+
+	return decisions, rows.Err()
+}
+
+func (s *PostgresqlStore) GetToolDecisionPrecedents(ctx context.Context, projectId uuid.UUID, toolName string, limit int) ([]asteroid.FeedbackPrecedent, error) {
+	query := `
+		SELECT tc.id, tc.tool_call_data->>'arguments', res.decision, COALESCE(res.reasoning, ''), res.created_at
+		FROM supervisionresult res
+		JOIN supervisionrequest req ON req.id = res.supervisionrequest_id
+		JOIN supervisor s ON s.id = req.supervisor_id
+		JOIN chainexecution ce ON ce.id = req.chainexecution_id
+		JOIN toolcall tc ON tc.id = ce.toolcall_id
+		JOIN tool t ON t.id = tc.tool_id
+		JOIN run r ON r.id = t.run_id
+		JOIN task ta ON ta.id = r.task_id
+		WHERE ta.project_id = $1 AND t.name = $2 AND s.type = $3
+		ORDER BY res.created_at DESC
+		LIMIT $4`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId, toolName, asteroid.HumanSupervisor, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool decision precedents: %w", err)
+	}
+	defer rows.Close()
+
+	precedents := make([]asteroid.FeedbackPrecedent, 0)
+	for rows.Next() {
+		var precedent asteroid.FeedbackPrecedent
+		if err := rows.Scan(&precedent.ToolCallId, &precedent.Arguments, &precedent.Decision, &precedent.Reasoning, &precedent.DecidedAt); err != nil {
+			return nil, fmt.Errorf("error scanning tool decision precedent: %w", err)
+		}
+		precedents = append(precedents, precedent)
+	}
+
+	return precedents, rows.Err()
+}
+
+// precedentDecisionQuery selects the decisions of a project's tool calls, followed by a condition on
+// the result res
+const precedentDecisionQuery = `
+	SELECT res.id, tc.id, t.name, tc.tool_call_data->>'arguments', res.decision, COALESCE(res.reasoning, ''), res.created_at, res.user_id, res.precedents
+	FROM supervisionresult res
+	JOIN supervisionrequest req ON req.id = res.supervisionrequest_id
+	JOIN chainexecution ce ON ce.id = req.chainexecution_id
+	JOIN toolcall tc ON tc.id = ce.toolcall_id
+	JOIN tool t ON t.id = tc.tool_id
+	JOIN run r ON r.id = t.run_id
+	JOIN task ta ON ta.id = r.task_id
+	WHERE ta.project_id = $1 AND `
+
+func (s *PostgresqlStore) queryPrecedentDecisions(ctx context.Context, query string, args ...any) ([]asteroid.PrecedentDecision, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting precedent decisions: %w", err)
+	}
+	defer rows.Close()
+
+	decisions := make([]asteroid.PrecedentDecision, 0)
+	for rows.Next() {
+		var decision asteroid.PrecedentDecision
+		if err := rows.Scan(
+			&decision.ResultId,
+			&decision.ToolCallId,
+			&decision.ToolName,
+			&decision.Arguments,
+			&decision.Decision,
+			&decision.Reasoning,
+			&decision.DecidedAt,
+			&decision.UserId,
+			pq.Array(&decision.Precedents),
+		); err != nil {
+			return nil, fmt.Errorf("error scanning precedent decision: %w", err)
+		}
+		decisions = append(decisions, decision)
+	}
+
+	return decisions, rows.Err()
+}
+
+func (s *PostgresqlStore) GetPrecedentDecisions(ctx context.Context, projectId uuid.UUID, resultIds []uuid.UUID) ([]asteroid.PrecedentDecision, error) {
+	return s.queryPrecedentDecisions(ctx, precedentDecisionQuery+`res.id = ANY($2) ORDER BY res.created_at`, projectId, pq.Array(resultIds))
+}
+
+func (s *PostgresqlStore) GetCitingDecisions(ctx context.Context, projectId uuid.UUID) ([]asteroid.PrecedentDecision, error) {
+	return s.queryPrecedentDecisions(ctx, precedentDecisionQuery+`cardinality(res.precedents) > 0 ORDER BY res.created_at`, projectId)
+}
+
+func (s *PostgresqlStore) GetSupervisorTestCases(ctx context.Context, supervisorId uuid.UUID) ([]asteroid.SupervisorTestCase, error) {
+	query := `
+		SELECT name, tool_name, tool_description, arguments, expected_decision
+		FROM supervisor_test_case
+		WHERE supervisor_id = $1
+		ORDER BY position`
+
+	rows, err := s.db.QueryContext(ctx, query, supervisorId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting supervisor test cases: %w", err)
+	}
+	defer rows.Close()
+
+	cases := make([]asteroid.SupervisorTestCase, 0)
+	for rows.Next() {
+		var testCase asteroid.SupervisorTestCase
+		var toolDescription, arguments sql.NullString
+		if err := rows.Scan(&testCase.Name, &testCase.ToolName, &toolDescription, &arguments, &testCase.ExpectedDecision); err != nil {
+			return nil, fmt.Errorf("error scanning supervisor test case: %w", err)
+		}
+		if toolDescription.Valid {
+			testCase.ToolDescription = &toolDescription.String
+		}
+		if arguments.Valid {
+			testCase.Arguments = &arguments.String
+		}
+		cases = append(cases, testCase)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating supervisor test cases: %w", err)
+	}
+
+	return cases, nil
+}
+
+func (s *PostgresqlStore) SetSupervisorTestCases(ctx context.Context, supervisorId uuid.UUID, cases []asteroid.SupervisorTestCase) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM supervisor_test_case WHERE supervisor_id = $1`, supervisorId)
+	if err != nil {
+		return fmt.Errorf("error deleting supervisor test cases: %w", err)
+	}
+
+	query := `
+		INSERT INTO supervisor_test_case (supervisor_id, position, name, tool_name, tool_description, arguments, expected_decision)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	for i, testCase := range cases {
+		_, err = tx.ExecContext(ctx, query, supervisorId, i, testCase.Name, testCase.ToolName, testCase.ToolDescription, testCase.Arguments, testCase.ExpectedDecision)
+		if err != nil {
+			return fmt.Errorf("error creating supervisor test case: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+const runEventColumns = `id, run_id, source, type, message, attributes, occurred_at, created_at`
+
+func scanRunEvent(row interface{ Scan(dest ...any) error }) (*asteroid.RunEvent, error) {
+	var event asteroid.RunEvent
+	var attributesJSON []byte
+	if err := row.Scan(
+		&event.Id,
+		&event.RunId,
+		&event.Source,
+		&event.Type,
+		&event.Message,
+		&attributesJSON,
+		&event.OccurredAt,
+		&event.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if attributesJSON != nil {
+		if err := json.Unmarshal(attributesJSON, &event.Attributes); err != nil {
+			return nil, fmt.Errorf("error unmarshalling run event attributes: %w", err)
+		}
+	}
+	return &event, nil
+}
+
+func (s *PostgresqlStore) CreateRunEvent(ctx context.Context, event asteroid.RunEvent) error {
+	var attributes []byte
+	if event.Attributes != nil {
+		var err error
+		attributes, err = json.Marshal(event.Attributes)
+		if err != nil {
+			return fmt.Errorf("error marshalling run event attributes: %w", err)
+		}
+	}
+
+	query := `INSERT INTO run_event (` + runEventColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := s.db.ExecContext(ctx, query,
+		event.Id,
+		event.RunId,
+		event.Source,
+		event.Type,
+		event.Message,
+		attributes,
+		event.OccurredAt,
+		event.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating run event: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetRunEvents(ctx context.Context, runId uuid.UUID) ([]asteroid.RunEvent, error) {
+	query := `SELECT ` + runEventColumns + ` FROM run_event WHERE run_id = $1 ORDER BY occurred_at, created_at`
+
+	rows, err := s.db.QueryContext(ctx, query, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting run events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]asteroid.RunEvent, 0)
+	for rows.Next() {
+		event, err := scanRunEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning run event: %w", err)
+		}
+		events = append(events, *event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating run events: %w", err)
+	}
+
+	return events, nil
+}
+
+const durableTimerColumns = `id, kind, supervisionrequest_id, fire_at, fired_at, attributes, created_at`
+
+func scanDurableTimer(row interface{ Scan(dest ...any) error }) (*asteroid.DurableTimer, error) {
+	var timer asteroid.DurableTimer
+	var attributesJSON []byte
+	if err := row.Scan(
+		&timer.Id,
+		&timer.Kind,
+		&timer.SupervisionRequestId,
+		&timer.FireAt,
+		&timer.FiredAt,
+		&attributesJSON,
+		&timer.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if attributesJSON != nil {
+		if err := json.Unmarshal(attributesJSON, &timer.Attributes); err != nil {
+			return nil, fmt.Errorf("error unmarshalling timer attributes: %w", err)
+		}
+	}
+	return &timer, nil
+}
+
+func (s *PostgresqlStore) queryDurableTimers(ctx context.Context, query string, args ...any) ([]asteroid.DurableTimer, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting timers: %w", err)
+	}
+	defer rows.Close()
+
+	timers := make([]asteroid.DurableTimer, 0)
+	for rows.Next() {
+		timer, err := scanDurableTimer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning timer: %w", err)
+		}
+		timers = append(timers, *timer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating timers: %w", err)
+	}
+
+	return timers, nil
+}
+
+func (s *PostgresqlStore) CreateTimer(ctx context.Context, timer asteroid.DurableTimer) error {
+	var attributes []byte
+	if timer.Attributes != nil {
+		var err error
+		attributes, err = json.Marshal(timer.Attributes)
+		if err != nil {
+			return fmt.Errorf("error marshalling timer attributes: %w", err)
+		}
+	}
+
+	query := `INSERT INTO durable_timer (` + durableTimerColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := s.db.ExecContext(ctx, query,
+		timer.Id,
+		timer.Kind,
+		timer.SupervisionRequestId,
+		timer.FireAt,
+		timer.FiredAt,
+		attributes,
+		timer.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating timer: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetDueTimers(ctx context.Context, now time.Time) ([]asteroid.DurableTimer, error) {
+	// Timers of paused runs wait until the run is resumed
+	query := `
+		SELECT ` + durableTimerColumns + `
+		FROM durable_timer
+		WHERE fired_at IS NULL AND fire_at <= $1 AND supervisionrequest_id NOT IN (` + pausedSupervisionRequests + `)
+		ORDER BY fire_at`
+	return s.queryDurableTimers(ctx, query, now)
+}
+
+func (s *PostgresqlStore) MarkTimerFired(ctx context.Context, id uuid.UUID, firedAt time.Time) error {
+	query := `UPDATE durable_timer SET fired_at = $2 WHERE id = $1`
+
+	if _, err := s.db.ExecContext(ctx, query, id, firedAt); err != nil {
+		return fmt.Errorf("error marking timer fired: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetSupervisionRequestTimers(ctx context.Context, supervisionRequestId uuid.UUID) ([]asteroid.DurableTimer, error) {
+	query := `SELECT ` + durableTimerColumns + ` FROM durable_timer WHERE supervisionrequest_id = $1 ORDER BY fire_at`
+	return s.queryDurableTimers(ctx, query, supervisionRequestId)
+}
+
+const planColumns = `id, run_id, argument_tolerance, decided_by, decided_at, reasoning, created_at`
+
+const planStepColumns = `position, tool_name, arguments, description, decision, toolcall_id`
+
+func scanPlan(row interface{ Scan(dest ...any) error }) (*asteroid.Plan, error) {
+	var plan asteroid.Plan
+	if err := row.Scan(
+		&plan.Id,
+		&plan.RunId,
+		&plan.ArgumentTolerance,
+		&plan.DecidedBy,
+		&plan.DecidedAt,
+		&plan.Reasoning,
+		&plan.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+func (s *PostgresqlStore) getPlanSteps(ctx context.Context, planId uuid.UUID) ([]asteroid.PlanStep, error) {
+	query := `SELECT ` + planStepColumns + ` FROM plan_step WHERE plan_id = $1 ORDER BY position`
+
+	rows, err := s.db.QueryContext(ctx, query, planId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting plan steps: %w", err)
+	}
+	defer rows.Close()
+
+	steps := make([]asteroid.PlanStep, 0)
+	for rows.Next() {
+		var step asteroid.PlanStep
+		if err := rows.Scan(
+			&step.Position,
+			&step.ToolName,
+			&step.Arguments,
+			&step.Description,
+			&step.Decision,
+			&step.ToolCallId,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning plan step: %w", err)
+		}
+		steps = append(steps, step)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating plan steps: %w", err)
+	}
+
+	return steps, nil
+}
+
+func (s *PostgresqlStore) CreatePlan(ctx context.Context, plan asteroid.Plan) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `INSERT INTO plan (` + planColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err = tx.ExecContext(ctx, query,
+		plan.Id,
+		plan.RunId,
+		plan.ArgumentTolerance,
+		plan.DecidedBy,
+		plan.DecidedAt,
+		plan.Reasoning,
+		plan.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating plan: %w", err)
+	}
+
+	stepQuery := `INSERT INTO plan_step (plan_id, ` + planStepColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	for _, step := range plan.Steps {
+		_, err = tx.ExecContext(ctx, stepQuery, plan.Id, step.Position, step.ToolName, step.Arguments, step.Description, step.Decision, step.ToolCallId)
+		if err != nil {
+			return fmt.Errorf("error creating plan step: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetPlan(ctx context.Context, id uuid.UUID) (*asteroid.Plan, error) {
+	query := `SELECT ` + planColumns + ` FROM plan WHERE id = $1`
+
+	plan, err := scanPlan(s.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting plan: %w", err)
+	}
+
+	plan.Steps, err = s.getPlanSteps(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (s *PostgresqlStore) GetRunPlans(ctx context.Context, runId uuid.UUID) ([]asteroid.Plan, error) {
+	query := `SELECT ` + planColumns + ` FROM plan WHERE run_id = $1 ORDER BY created_at`
+
+	rows, err := s.db.QueryContext(ctx, query, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting plans: %w", err)
+	}
+	defer rows.Close()
+
+	plans := make([]asteroid.Plan, 0)
+	for rows.Next() {
+		plan, err := scanPlan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning plan: %w", err)
+		}
+		plans = append(plans, *plan)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating plans: %w", err)
+	}
+
+	for i := range plans {
+		plans[i].Steps, err = s.getPlanSteps(ctx, plans[i].Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return plans, nil
+}
+
+func (s *PostgresqlStore) DecidePlan(ctx context.Context, plan asteroid.Plan) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `UPDATE plan SET decided_by = $2, decided_at = $3, reasoning = $4 WHERE id = $1 AND decided_at IS NULL`
+	result, err := tx.ExecContext(ctx, query, plan.Id, plan.DecidedBy, plan.DecidedAt, plan.Reasoning)
+	if err != nil {
+		return false, fmt.Errorf("error deciding plan: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error getting affected rows: %w", err)
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	stepQuery := `UPDATE plan_step SET decision = $3 WHERE plan_id = $1 AND position = $2`
+	for _, step := range plan.Steps {
+		if _, err := tx.ExecContext(ctx, stepQuery, plan.Id, step.Position, step.Decision); err != nil {
+			return false, fmt.Errorf("error deciding plan step: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *PostgresqlStore) BindPlanStep(ctx context.Context, planId uuid.UUID, position int, toolCallId uuid.UUID) (bool, error) {
+	query := `UPDATE plan_step SET toolcall_id = $3 WHERE plan_id = $1 AND position = $2 AND toolcall_id IS NULL`
+
+	result, err := s.db.ExecContext(ctx, query, planId, position, toolCallId)
+	if err != nil {
+		return false, fmt.Errorf("error binding plan step: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error getting affected rows: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+const planDeviationColumns = `id, plan_id, toolcall_id, kind, expected_position, step_position, similarity, diff, created_at`
+
+func scanPlanDeviation(row interface{ Scan(dest ...any) error }) (*asteroid.PlanDeviation, error) {
+	var deviation asteroid.PlanDeviation
+	var diffJSON []byte
+	if err := row.Scan(
+		&deviation.Id,
+		&deviation.PlanId,
+		&deviation.ToolCallId,
+		&deviation.Kind,
+		&deviation.ExpectedPosition,
+		&deviation.StepPosition,
+		&deviation.Similarity,
+		&diffJSON,
+		&deviation.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if diffJSON != nil {
+		if err := json.Unmarshal(diffJSON, &deviation.Diff); err != nil {
+			return nil, fmt.Errorf("error unmarshalling plan deviation diff: %w", err)
+		}
+	}
+	return &deviation, nil
+}
+
+func (s *PostgresqlStore) CreatePlanDeviation(ctx context.Context, deviation asteroid.PlanDeviation) error {
+	var diff []byte
+	if deviation.Diff != nil {
+		var err error
+		diff, err = json.Marshal(deviation.Diff)
+		if err != nil {
+			return fmt.Errorf("error marshalling plan deviation diff: %w", err)
+		}
+	}
+
+	query := `INSERT INTO plan_deviation (` + planDeviationColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := s.db.ExecContext(ctx, query,
+		deviation.Id,
+		deviation.PlanId,
+		deviation.ToolCallId,
+		deviation.Kind,
+		deviation.ExpectedPosition,
+		deviation.StepPosition,
+		deviation.Similarity,
+		diff,
+		deviation.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating plan deviation: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetPlanDeviations(ctx context.Context, planId uuid.UUID) ([]asteroid.PlanDeviation, error) {
+	query := `SELECT ` + planDeviationColumns + ` FROM plan_deviation WHERE plan_id = $1 ORDER BY created_at`
+
+	rows, err := s.db.QueryContext(ctx, query, planId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting plan deviations: %w", err)
+	}
+	defer rows.Close()
+
+	deviations := make([]asteroid.PlanDeviation, 0)
+	for rows.Next() {
+		deviation, err := scanPlanDeviation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning plan deviation: %w", err)
+		}
+		deviations = append(deviations, *deviation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating plan deviations: %w", err)
+	}
+
+	return deviations, nil
+}
+
+func (s *PostgresqlStore) GetToolCallPlanDeviation(ctx context.Context, toolCallId uuid.UUID) (*asteroid.PlanDeviation, error) {
+	query := `SELECT ` + planDeviationColumns + ` FROM plan_deviation WHERE toolcall_id = $1 ORDER BY created_at DESC LIMIT 1`
+
+	deviation, err := scanPlanDeviation(s.db.QueryRowContext(ctx, query, toolCallId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting plan deviation: %w", err)
+	}
+
+	return deviation, nil
+}
+
+func (s *PostgresqlStore) GetRunStoredContent(ctx context.Context, runId uuid.UUID) ([]asteroid.StoredContent, error) {
+	query := `
+		SELECT 'chat_request', id, request_data, request_hash FROM chat WHERE run_id = $1
+		UNION ALL
+		SELECT 'chat_response', id, response_data, response_hash FROM chat WHERE run_id = $1
+		UNION ALL
+		SELECT 'chat_message', m.id, m.msg_data, m.content_hash
+		FROM msg m
+		JOIN choice c ON c.id = m.choice_id
+		JOIN chat ch ON ch.id = c.chat_id
+		WHERE ch.run_id = $1`
+
+	rows, err := s.db.QueryContext(ctx, query, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting stored content: %w", err)
+	}
+	defer rows.Close()
+
+	contents := make([]asteroid.StoredContent, 0)
+	for rows.Next() {
+		var content asteroid.StoredContent
+		if err := rows.Scan(&content.Kind, &content.Id, &content.Data, &content.Hash); err != nil {
+			return nil, fmt.Errorf("error scanning stored content: %w", err)
+		}
+		contents = append(contents, content)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stored content: %w", err)
+	}
+
+	return contents, nil
+}
+
+func (s *PostgresqlStore) GetChatSupervisors(ctx context.Context, projectId uuid.UUID) ([]asteroid.ChatSupervisor, error) {
+	query := `
+		SELECT name, pattern, reason
+		FROM project_chat_supervisor
+		WHERE project_id = $1
+		ORDER BY position`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chat supervisors: %w", err)
+	}
+	defer rows.Close()
+
+	supervisors := make([]asteroid.ChatSupervisor, 0)
+	for rows.Next() {
+		var supervisor asteroid.ChatSupervisor
+		if err := rows.Scan(&supervisor.Name, &supervisor.Pattern, &supervisor.Reason); err != nil {
+			return nil, fmt.Errorf("error scanning chat supervisor: %w", err)
+		}
+		supervisors = append(supervisors, supervisor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating chat supervisors: %w", err)
+	}
+
+	return supervisors, nil
+}
+
+func (s *PostgresqlStore) SetChatSupervisors(ctx context.Context, projectId uuid.UUID, supervisors []asteroid.ChatSupervisor) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM project_chat_supervisor WHERE project_id = $1`, projectId)
+	if err != nil {
+		return fmt.Errorf("error deleting chat supervisors: %w", err)
+	}
+
+	query := `
+		INSERT INTO project_chat_supervisor (project_id, position, name, pattern, reason)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	for i, supervisor := range supervisors {
+		_, err = tx.ExecContext(ctx, query, projectId, i, supervisor.Name, supervisor.Pattern, supervisor.Reason)
+		if err != nil {
+			return fmt.Errorf("error creating chat supervisor: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+const auditAnchorColumns = `id, sequence, hash, url, receipt, anchored_at`
+
+func scanAuditAnchor(row interface{ Scan(dest ...any) error }) (*asteroid.AuditAnchor, error) {
+	var anchor asteroid.AuditAnchor
+	if err := row.Scan(&anchor.Id, &anchor.Sequence, &anchor.Hash, &anchor.Url, &anchor.Receipt, &anchor.AnchoredAt); err != nil {
+		return nil, err
+	}
+	return &anchor, nil
+}
+
+func (s *PostgresqlStore) CreateAuditAnchor(ctx context.Context, anchor asteroid.AuditAnchor) error {
+	query := `
+		INSERT INTO audit_anchor (` + auditAnchorColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err := s.db.ExecContext(ctx, query, anchor.Id, anchor.Sequence, anchor.Hash, anchor.Url, anchor.Receipt, anchor.AnchoredAt)
+	if err != nil {
+		return fmt.Errorf("error creating audit anchor: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetAuditAnchors(ctx context.Context) ([]asteroid.AuditAnchor, error) {
+	query := `
+		SELECT ` + auditAnchorColumns + `
+		FROM audit_anchor
+		ORDER BY sequence ASC, anchored_at ASC`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error getting audit anchors: %w", err)
+	}
+	defer rows.Close()
+
+	anchors := make([]asteroid.AuditAnchor, 0)
+	for rows.Next() {
+		anchor, err := scanAuditAnchor(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning audit anchor: %w", err)
+		}
+		anchors = append(anchors, *anchor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating audit anchors: %w", err)
+	}
+
+	return anchors, nil
+}
+
+func (s *PostgresqlStore) GetLatestAuditAnchor(ctx context.Context) (*asteroid.AuditAnchor, error) {
+	query := `
+		SELECT ` + auditAnchorColumns + `
+		FROM audit_anchor
+		ORDER BY sequence DESC, anchored_at DESC
+		LIMIT 1`
+
+	anchor, err := scanAuditAnchor(s.db.QueryRowContext(ctx, query))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest audit anchor: %w", err)
+	}
+
+	return anchor, nil
+}
+
+func (s *PostgresqlStore) GetRunsCreatedBetween(ctx context.Context, from time.Time, to time.Time) ([]asteroid.Run, error) {
+	query := `
+		SELECT id, task_id, created_at, status, result, agent_id, autonomy_level, priority
+		FROM run
+		WHERE created_at >= $1 AND created_at < $2
+		ORDER BY created_at ASC, id ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("error getting runs: %w", err)
+	}
+	defer rows.Close()
+
+	runs := make([]asteroid.Run, 0)
+	for rows.Next() {
+		var run asteroid.Run
+		if err := rows.Scan(&run.Id, &run.TaskId, &run.CreatedAt, &run.Status, &run.Result, &run.AgentId, &run.AutonomyLevel, &run.Priority); err != nil {
+			return nil, fmt.Errorf("error scanning run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating runs: %w", err)
+	}
+
+	return runs, nil
+}
+
+func (s *PostgresqlStore) GetProjectRunsAfter(ctx context.Context, projectId uuid.UUID, afterCreatedAt time.Time, afterId uuid.UUID, until time.Time, limit int) ([]asteroid.Run, error) {
+	query := `
+		SELECT r.id, r.task_id, r.created_at, r.status, r.result, r.agent_id, r.autonomy_level, r.priority
+		FROM run r
+		JOIN task t ON t.id = r.task_id
+		WHERE t.project_id = $1 AND r.created_at < $2 AND (r.created_at, r.id) > ($3, $4)
+		ORDER BY r.created_at ASC, r.id ASC
+		LIMIT $5`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId, until, afterCreatedAt, afterId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project runs: %w", err)
+	}
+	defer rows.Close()
+
+	runs := make([]asteroid.Run, 0)
+	for rows.Next() {
+		var run asteroid.Run
+		if err := rows.Scan(&run.Id, &run.TaskId, &run.CreatedAt, &run.Status, &run.Result, &run.AgentId, &run.AutonomyLevel, &run.Priority); err != nil {
+			return nil, fmt.Errorf("error scanning run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating runs: %w", err)
+	}
+
+	return runs, nil
+}
+
+func (s *PostgresqlStore) GetSupervisionResultsCreatedBetween(ctx context.Context, from time.Time, to time.Time) ([]asteroid.SupervisionResult, error) {
+	query := `
+		SELECT id, supervisionrequest_id, created_at, decision, reasoning, toolcall_id, verdict, verdict_behavior, explanation, overridden_decision, timeout_fallback, modified_arguments, original_arguments, user_id, precedents
+		FROM supervisionresult
+		WHERE created_at >= $1 AND created_at < $2
+		ORDER BY created_at ASC, id ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("error getting supervision results: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]asteroid.SupervisionResult, 0)
+	for rows.Next() {
+		var result asteroid.SupervisionResult
+		var explanation []byte
+		if err := rows.Scan(
+			&result.Id,
+			&result.SupervisionRequestId,
+			&result.CreatedAt,
+			&result.Decision,
+			&result.Reasoning,
+			&result.ToolcallId,
+			&result.Verdict,
+			&result.VerdictBehavior,
+			&explanation,
+			&result.OverriddenDecision,
+			&result.TimeoutFallback,
+			&result.ModifiedArguments,
+			&result.OriginalArguments,
+			&result.UserId,
+			optionalUUIDs{&result.Precedents},
+		); err != nil {
+			return nil, fmt.Errorf("error scanning supervision result: %w", err)
+		}
+		if result.Explanation, err = parseExplanation(explanation); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating supervision results: %w", err)
+	}
+
+	return results, nil
+}
+
+func (s *PostgresqlStore) GetAuditEventsCreatedBetween(ctx context.Context, from time.Time, to time.Time) ([]asteroid.AuditEvent, error) {
+	query := `
+		SELECT ` + auditEventColumns + `
+		FROM audit_event
+		WHERE created_at >= $1 AND created_at < $2
+		ORDER BY sequence ASC`
+
+	return s.queryAuditEvents(ctx, query, from, to)
+}
+
+const archiveColumns = `to_char(day, 'YYYY-MM-DD'), location, manifest_sha256, records, retain_until, exported_at`
+
+func scanArchive(row interface{ Scan(dest ...any) error }) (*asteroid.Archive, error) {
+	var archive asteroid.Archive
+	if err := row.Scan(&archive.Day, &archive.Location, &archive.ManifestSha256, &archive.Records, &archive.RetainUntil, &archive.ExportedAt); err != nil {
+		return nil, err
+	}
+	return &archive, nil
+}
+
+func (s *PostgresqlStore) CreateArchive(ctx context.Context, archive asteroid.Archive) error {
+	query := `
+		INSERT INTO archive (day, location, manifest_sha256, records, retain_until, exported_at)
+		VALUES ($1::date, $2, $3, $4, $5, $6)`
+
+	_, err := s.db.ExecContext(ctx, query, archive.Day, archive.Location, archive.ManifestSha256, archive.Records, archive.RetainUntil, archive.ExportedAt)
+	if err != nil {
+		return fmt.Errorf("error creating archive: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetArchive(ctx context.Context, day string) (*asteroid.Archive, error) {
+	query := `
+		SELECT ` + archiveColumns + `
+		FROM archive
+		WHERE to_char(day, 'YYYY-MM-DD') = $1`
+
+	archive, err := scanArchive(s.db.QueryRowContext(ctx, query, day))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting archive: %w", err)
+	}
+
+	return archive, nil
+}
+
+func (s *PostgresqlStore) GetArchives(ctx context.Context) ([]asteroid.Archive, error) {
+	query := `
+		SELECT ` + archiveColumns + `
+		FROM archive
+		ORDER BY day ASC`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error getting archives: %w", err)
+	}
+	defer rows.Close()
+
+	archives := make([]asteroid.Archive, 0)
+	for rows.Next() {
+		archive, err := scanArchive(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning archive: %w", err)
+		}
+		archives = append(archives, *archive)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating archives: %w", err)
+	}
+
+	return archives, nil
+}
+
+func (s *PostgresqlStore) GetAlertRules(ctx context.Context, projectId uuid.UUID) ([]asteroid.AlertRule, error) {
+	query := `
+		SELECT name, kind, tool_names, metric, window_seconds, factor, min_decisions
+		FROM project_alert_rule
+		WHERE project_id = $1
+		ORDER BY position`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting alert rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := make([]asteroid.AlertRule, 0)
+	for rows.Next() {
+		var rule asteroid.AlertRule
+		var toolNames []string
+		if err := rows.Scan(&rule.Name, &rule.Kind, pq.Array(&toolNames), &rule.Metric, &rule.WindowSeconds, &rule.Factor, &rule.MinDecisions); err != nil {
+			return nil, fmt.Errorf("error scanning alert rule: %w", err)
+		}
+		if toolNames != nil {
+			rule.ToolNames = &toolNames
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating alert rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func (s *PostgresqlStore) SetAlertRules(ctx context.Context, projectId uuid.UUID, rules []asteroid.AlertRule) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM project_alert_rule WHERE project_id = $1`, projectId)
+	if err != nil {
+		return fmt.Errorf("error deleting alert rules: %w", err)
+	}
+
+	query := `
+		INSERT INTO project_alert_rule (project_id, position, name, kind, tool_names, metric, window_seconds, factor, min_decisions)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	for i, rule := range rules {
+		var toolNames []string
+		if rule.ToolNames != nil {
+			toolNames = *rule.ToolNames
+		}
+		_, err = tx.ExecContext(ctx, query, projectId, i, rule.Name, rule.Kind, pq.Array(toolNames), rule.Metric, rule.WindowSeconds, rule.Factor, rule.MinDecisions)
+		if err != nil {
+			return fmt.Errorf("error creating alert rule: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) CreateAlert(ctx context.Context, alert asteroid.Alert) (bool, error) {
+	details, err := json.Marshal(alert.Details)
+	if err != nil {
+		return false, fmt.Errorf("error marshalling alert details: %w", err)
+	}
+
+	query := `
+		INSERT INTO alert (id, project_id, rule_name, kind, key, message, details, fired_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (project_id, rule_name, key) DO NOTHING`
+
+	result, err := s.db.ExecContext(ctx, query, alert.Id, alert.ProjectId, alert.RuleName, alert.Kind, alert.Key, alert.Message, details, alert.FiredAt)
+	if err != nil {
+		return false, fmt.Errorf("error creating alert: %w", err)
+	}
+
+	created, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	return created > 0, nil
+}
+
+func (s *PostgresqlStore) GetAlerts(ctx context.Context, projectId uuid.UUID, limit int) ([]asteroid.Alert, error) {
+	query := `
+		SELECT id, project_id, rule_name, kind, key, message, details, fired_at
+		FROM alert
+		WHERE project_id = $1
+		ORDER BY fired_at DESC
+		LIMIT $2`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting alerts: %w", err)
+	}
+	defer rows.Close()
+
+	alerts := make([]asteroid.Alert, 0)
+	for rows.Next() {
+		var alert asteroid.Alert
+		var details []byte
+		if err := rows.Scan(&alert.Id, &alert.ProjectId, &alert.RuleName, &alert.Kind, &alert.Key, &alert.Message, &details, &alert.FiredAt); err != nil {
+			return nil, fmt.Errorf("error scanning alert: %w", err)
+		}
+		if err := json.Unmarshal(details, &alert.Details); err != nil {
+			return nil, fmt.Errorf("error unmarshalling alert details: %w", err)
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating alerts: %w", err)
+	}
+
+	return alerts, nil
+}
+
+func (s *PostgresqlStore) GetFirstToolUses(ctx context.Context, projectId uuid.UUID, since time.Time) ([]asteroid.ToolFirstUse, error) {
+	query := `
+		SELECT tool.name, MIN(toolcall.created_at)
+		FROM toolcall
+		JOIN tool ON tool.id = toolcall.tool_id
+		JOIN run ON run.id = tool.run_id
+		JOIN task ON task.id = run.task_id
+		WHERE task.project_id = $1
+		GROUP BY tool.name
+		HAVING MIN(toolcall.created_at) >= $2
+		ORDER BY MIN(toolcall.created_at)`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId, since)
+	if err != nil {
+		return nil, fmt.Errorf("error getting first tool uses: %w", err)
+	}
+	defer rows.Close()
+
+	uses := make([]asteroid.ToolFirstUse, 0)
+	for rows.Next() {
+		var use asteroid.ToolFirstUse
+		if err := rows.Scan(&use.ToolName, &use.FirstUsedAt); err != nil {
+			return nil, fmt.Errorf("error scanning first tool use: %w", err)
+		}
+		uses = append(uses, use)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating first tool uses: %w", err)
+	}
+
+	return uses, nil
+}
+
+func (s *PostgresqlStore) GetDecisionCounts(ctx context.Context, projectId uuid.UUID, from time.Time, to time.Time) (map[asteroid.Decision]int, error) {
+	query := `
+		SELECT sr.decision, COUNT(*)
+		FROM supervisionresult sr
+		JOIN supervisionrequest sreq ON sreq.id = sr.supervisionrequest_id
+		JOIN chainexecution ce ON ce.id = sreq.chainexecution_id
+		JOIN toolcall tc ON tc.id = ce.toolcall_id
+		JOIN tool ON tool.id = tc.tool_id
+		JOIN run ON run.id = tool.run_id
+		JOIN task ON task.id = run.task_id
+		WHERE task.project_id = $1 AND sr.created_at >= $2 AND sr.created_at < $3
+		GROUP BY sr.decision`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("error getting decision counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[asteroid.Decision]int)
+	for rows.Next() {
+		var decision asteroid.Decision
+		var count int
+		if err := rows.Scan(&decision, &count); err != nil {
+			return nil, fmt.Errorf("error scanning decision count: %w", err)
+		}
+		counts[decision] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating decision counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+func (s *PostgresqlStore) GetSupervisionQueueStats(ctx context.Context) ([]asteroid.PriorityQueueStats, error) {
+	query := `
+		SELECT COALESCE(r.priority, 'interactive') AS priority,
+			COUNT(*) FILTER (WHERE srs.status = $2),
+			COUNT(*) FILTER (WHERE srs.status = $3),
+			MIN(srs.created_at) FILTER (WHERE srs.status = $2)
+		FROM supervisionrequest sr
+		JOIN supervisor s ON s.id = sr.supervisor_id
+		JOIN (
+				SELECT supervisionrequest_id, MAX(id) as latest_status_id
+				FROM supervisionrequest_status
+				GROUP BY supervisionrequest_id
+		) latest ON sr.id = latest.supervisionrequest_id
+		JOIN supervisionrequest_status srs ON srs.id = latest.latest_status_id
+		LEFT JOIN chainexecution ce ON ce.id = sr.chainexecution_id
+		LEFT JOIN toolcall tc ON tc.id = ce.toolcall_id
+		LEFT JOIN tool t ON t.id = tc.tool_id
+		LEFT JOIN run r ON r.id = t.run_id
+		WHERE s.type != $1 AND srs.status IN ($2, $3)
+		GROUP BY 1`
+
+	rows, err := s.db.QueryContext(ctx, query, asteroid.ClientSupervisor, asteroid.Pending, asteroid.Assigned)
+	if err != nil {
+		return nil, fmt.Errorf("error getting supervision queue stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make([]asteroid.PriorityQueueStats, 0)
+	for rows.Next() {
+		var queue asteroid.PriorityQueueStats
+		if err := rows.Scan(&queue.Priority, &queue.Pending, &queue.Assigned, &queue.OldestPendingAt); err != nil {
+			return nil, fmt.Errorf("error scanning supervision queue stats: %w", err)
+		}
+		stats = append(stats, queue)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error getting supervision queue stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// pausedSupervisionRequests selects the supervision requests of paused runs
+const pausedSupervisionRequests = `
+	SELECT sr.id
+	FROM supervisionrequest sr
+	JOIN chainexecution ce ON ce.id = sr.chainexecution_id
+	JOIN toolcall tc ON tc.id = ce.toolcall_id
+	JOIN tool t ON t.id = tc.tool_id
+	JOIN run_pause rp ON rp.run_id = t.run_id`
+
+func (s *PostgresqlStore) PauseRun(ctx context.Context, pause asteroid.RunPause) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		INSERT INTO run_pause (run_id, paused_at, paused_by, reason, previous_status)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (run_id) DO NOTHING`
+
+	res, err := tx.ExecContext(ctx, query, pause.RunId, pause.PausedAt, pause.PausedBy, pause.Reason, pause.PreviousStatus)
+	if err != nil {
+		return false, fmt.Errorf("error creating run pause: %w", err)
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error creating run pause: %w", err)
+	}
+	if inserted == 0 {
+		return false, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE run SET status = $2 WHERE id = $1`, pause.RunId, asteroid.Paused); err != nil {
+		return false, fmt.Errorf("error pausing run: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *PostgresqlStore) GetRunPause(ctx context.Context, runId uuid.UUID) (*asteroid.RunPause, error) {
+	query := `
+		SELECT run_id, paused_at, paused_by, reason, previous_status
+		FROM run_pause
+		WHERE run_id = $1`
+
+	var pause asteroid.RunPause
+	err := s.db.QueryRowContext(ctx, query, runId).Scan(&pause.RunId, &pause.PausedAt, &pause.PausedBy, &pause.Reason, &pause.PreviousStatus)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting run pause: %w", err)
+	}
+
+	return &pause, nil
+}
+
+func (s *PostgresqlStore) ResumeRun(ctx context.Context, runId uuid.UUID, status asteroid.Status, resumedAt time.Time) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var pausedAt time.Time
+	err = tx.QueryRowContext(ctx, `DELETE FROM run_pause WHERE run_id = $1 RETURNING paused_at`, runId).Scan(&pausedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error deleting run pause: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE run SET status = $2 WHERE id = $1`, runId, status); err != nil {
+		return false, fmt.Errorf("error resuming run: %w", err)
+	}
+
+	// Reminders and timeouts didn't run while the run was paused, so they're due as much later
+	query := `
+		UPDATE durable_timer
+		SET fire_at = fire_at + $2::interval
+		WHERE fired_at IS NULL AND supervisionrequest_id IN (
+			SELECT sr.id
+			FROM supervisionrequest sr
+			JOIN chainexecution ce ON ce.id = sr.chainexecution_id
+			JOIN toolcall tc ON tc.id = ce.toolcall_id
+			JOIN tool t ON t.id = tc.tool_id
+			WHERE t.run_id = $1
+		)`
+	paused := fmt.Sprintf("%d microseconds", resumedAt.Sub(pausedAt).Microseconds())
+	if _, err := tx.ExecContext(ctx, query, runId, paused); err != nil {
+		return false, fmt.Errorf("error postponing timers of run: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+const backfillColumns = `id, project_id, chain_id, from_time, to_time, rate_per_minute, status, total_calls, truncated, evaluated, created_by, created_at, completed_at`
+
+func scanBackfill(row interface{ Scan(dest ...any) error }) (*asteroid.Backfill, error) {
+	var backfill asteroid.Backfill
+	if err := row.Scan(
+		&backfill.Id,
+		&backfill.ProjectId,
+		&backfill.ChainId,
+		&backfill.From,
+		&backfill.To,
+		&backfill.RatePerMinute,
+		&backfill.Status,
+		&backfill.TotalCalls,
+		&backfill.Truncated,
+		&backfill.Evaluated,
+		&backfill.CreatedBy,
+		&backfill.CreatedAt,
+		&backfill.CompletedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &backfill, nil
+}
+
+func (s *PostgresqlStore) queryBackfills(ctx context.Context, query string, args ...any) ([]asteroid.Backfill, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting backfills: %w", err)
+	}
+	defer rows.Close()
+
+	backfills := make([]asteroid.Backfill, 0)
+	for rows.Next() {
+		backfill, err := scanBackfill(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning backfill: %w", err)
+		}
+		backfills = append(backfills, *backfill)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating backfills: %w", err)
+	}
+
+	return backfills, nil
+}
+
+func (s *PostgresqlStore) CreateBackfill(ctx context.Context, backfill asteroid.Backfill) error {
+	query := `INSERT INTO backfill (` + backfillColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+
+	_, err := s.db.ExecContext(ctx, query,
+		backfill.Id,
+		backfill.ProjectId,
+		backfill.ChainId,
+		backfill.From,
+		backfill.To,
+		backfill.RatePerMinute,
+		backfill.Status,
+		backfill.TotalCalls,
+		backfill.Truncated,
+		backfill.Evaluated,
+		backfill.CreatedBy,
+		backfill.CreatedAt,
+		backfill.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating backfill: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetBackfill(ctx context.Context, id uuid.UUID) (*asteroid.Backfill, error) {
+	query := `SELECT ` + backfillColumns + ` FROM backfill WHERE id = $1`
+
+	backfill, err := scanBackfill(s.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting backfill: %w", err)
+	}
+
+	return backfill, nil
+}
+
+func (s *PostgresqlStore) GetProjectBackfills(ctx context.Context, projectId uuid.UUID) ([]asteroid.Backfill, error) {
+	query := `SELECT ` + backfillColumns + ` FROM backfill WHERE project_id = $1 ORDER BY created_at DESC`
+	return s.queryBackfills(ctx, query, projectId)
+}
+
+func (s *PostgresqlStore) GetBackfillsForStatus(ctx context.Context, status asteroid.BackfillStatus) ([]asteroid.Backfill, error) {
+	query := `SELECT ` + backfillColumns + ` FROM backfill WHERE status = $1 ORDER BY created_at`
+	return s.queryBackfills(ctx, query, status)
+}
+
+func (s *PostgresqlStore) SetBackfillStatus(ctx context.Context, id uuid.UUID, from asteroid.BackfillStatus, to asteroid.BackfillStatus) (bool, error) {
+	query := `
+		UPDATE backfill
+		SET status = $3, completed_at = CASE WHEN $3 = 'backfill_completed' THEN CURRENT_TIMESTAMP END
+		WHERE id = $1 AND status = $2`
+
+	res, err := s.db.ExecContext(ctx, query, id, from, to)
+	if err != nil {
+		return false, fmt.Errorf("error setting backfill status: %w", err)
+	}
+
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error setting backfill status: %w", err)
+	}
+
+	return updated > 0, nil
+}
+
+func (s *PostgresqlStore) GetUnevaluatedBackfillToolCalls(ctx context.Context, backfill asteroid.Backfill, limit int) ([]asteroid.AsteroidToolCall, error) {
+	query := `
+		SELECT tc.id, tc.call_id, tc.created_at, tc.tool_id, t.name, tc.tool_call_data
+		FROM toolcall tc
+		JOIN tool t ON tc.tool_id = t.id
+		JOIN run r ON t.run_id = r.id
+		JOIN task ta ON r.task_id = ta.id
+		WHERE ta.project_id = $1 AND tc.created_at >= $2 AND tc.created_at < $3
+			AND NOT EXISTS (SELECT 1 FROM backfill_result br WHERE br.backfill_id = $4 AND br.toolcall_id = tc.id)
+		ORDER BY tc.created_at, tc.id
+		LIMIT $5`
+
+	rows, err := s.db.QueryContext(ctx, query, backfill.ProjectId, backfill.From, backfill.To, backfill.Id, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting backfill tool calls: %w", err)
+	}
+	defer rows.Close()
+
+	return scanNamedToolCalls(rows)
+}
+
+func (s *PostgresqlStore) CreateBackfillResult(ctx context.Context, backfillId uuid.UUID, result asteroid.BackfillResult) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		INSERT INTO backfill_result (backfill_id, toolcall_id, tool_name, called_at, predicted, confidence, reasoning, actual, actually_escalated, evaluated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (backfill_id, toolcall_id) DO NOTHING`
+
+	res, err := tx.ExecContext(ctx, query,
+		backfillId,
+		result.ToolCallId,
+		result.ToolName,
+		result.CalledAt,
+		result.Predicted,
+		result.Confidence,
+		result.Reasoning,
+		result.Actual,
+		result.ActuallyEscalated,
+		result.EvaluatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating backfill result: %w", err)
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error creating backfill result: %w", err)
+	}
+
+	if inserted > 0 {
+		if _, err := tx.ExecContext(ctx, `UPDATE backfill SET evaluated = evaluated + 1 WHERE id = $1`, backfillId); err != nil {
+			return fmt.Errorf("error counting backfill result: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetBackfillResults(ctx context.Context, backfillId uuid.UUID) ([]asteroid.BackfillResult, error) {
+	query := `
+		SELECT toolcall_id, tool_name, called_at, predicted, confidence, reasoning, actual, actually_escalated, evaluated_at
+		FROM backfill_result
+		WHERE backfill_id = $1
+		ORDER BY called_at, toolcall_id`
+
+	rows, err := s.db.QueryContext(ctx, query, backfillId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting backfill results: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]asteroid.BackfillResult, 0)
+	for rows.Next() {
+		var result asteroid.BackfillResult
+		if err := rows.Scan(
+			&result.ToolCallId,
+			&result.ToolName,
+			&result.CalledAt,
+			&result.Predicted,
+			&result.Confidence,
+			&result.Reasoning,
+			&result.Actual,
+			&result.ActuallyEscalated,
+			&result.EvaluatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning backfill result: %w", err)
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating backfill results: %w", err)
+	}
+
+	return results, nil
+}
+
+const runArtifactColumns = `id, run_id, toolcall_id, name, content_type, size_bytes, sha256, uploaded_by, created_at`
+
+func scanRunArtifact(row interface{ Scan(dest ...any) error }) (*asteroid.RunArtifact, error) {
+	var artifact asteroid.RunArtifact
+	if err := row.Scan(
+		&artifact.Id,
+		&artifact.RunId,
+		&artifact.ToolCallId,
+		&artifact.Name,
+		&artifact.ContentType,
+		&artifact.SizeBytes,
+		&artifact.Sha256,
+		&artifact.UploadedBy,
+		&artifact.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &artifact, nil
+}
+
+func (s *PostgresqlStore) CreateRunArtifact(ctx context.Context, artifact asteroid.RunArtifact) error {
+	query := `INSERT INTO run_artifact (` + runArtifactColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := s.db.ExecContext(ctx, query,
+		artifact.Id,
+		artifact.RunId,
+		artifact.ToolCallId,
+		artifact.Name,
+		artifact.ContentType,
+		artifact.SizeBytes,
+		artifact.Sha256,
+		artifact.UploadedBy,
+		artifact.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating run artifact: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetRunArtifact(ctx context.Context, id uuid.UUID) (*asteroid.RunArtifact, error) {
+	query := `SELECT ` + runArtifactColumns + ` FROM run_artifact WHERE id = $1`
+
+	artifact, err := scanRunArtifact(s.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting run artifact: %w", err)
+	}
+
+	return artifact, nil
+}
+
+func (s *PostgresqlStore) GetRunArtifacts(ctx context.Context, runId uuid.UUID) ([]asteroid.RunArtifact, error) {
+	return s.queryRunArtifacts(ctx, `SELECT `+runArtifactColumns+` FROM run_artifact WHERE run_id = $1 ORDER BY created_at, id`, runId)
+}
+
+func (s *PostgresqlStore) GetToolCallArtifacts(ctx context.Context, toolCallId uuid.UUID) ([]asteroid.RunArtifact, error) {
+	return s.queryRunArtifacts(ctx, `SELECT `+runArtifactColumns+` FROM run_artifact WHERE toolcall_id = $1 ORDER BY created_at, id`, toolCallId)
+}
+
+func (s *PostgresqlStore) queryRunArtifacts(ctx context.Context, query string, args ...any) ([]asteroid.RunArtifact, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting run artifacts: %w", err)
+	}
+	defer rows.Close()
+
+	artifacts := make([]asteroid.RunArtifact, 0)
+	for rows.Next() {
+		artifact, err := scanRunArtifact(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning run artifact: %w", err)
+		}
+		artifacts = append(artifacts, *artifact)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating run artifacts: %w", err)
+	}
+
+	return artifacts, nil
+}
+
+const transcriptSegmentColumns = `id, run_id, utterance_id, speaker, text, offset_ms, barge_in, created_at`
+
+func scanTranscriptSegment(row interface{ Scan(dest ...any) error }) (*asteroid.TranscriptSegment, error) {
+	var segment asteroid.TranscriptSegment
+	var bargeIn []byte
+	if err := row.Scan(
+		&segment.Id,
+		&segment.RunId,
+		&segment.UtteranceId,
+		&segment.Speaker,
+		&segment.Text,
+		&segment.OffsetMs,
+		&bargeIn,
+		&segment.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if bargeIn != nil {
+		if err := json.Unmarshal(bargeIn, &segment.BargeIn); err != nil {
+			return nil, fmt.Errorf("error parsing barge-in: %w", err)
+		}
+	}
+
+	return &segment, nil
+}
+
+func (s *PostgresqlStore) CreateTranscriptSegment(ctx context.Context, segment asteroid.TranscriptSegment) error {
+	var bargeIn any
+	if segment.BargeIn != nil {
+		data, err := json.Marshal(segment.BargeIn)
+		if err != nil {
+			return fmt.Errorf("error marshalling barge-in: %w", err)
+		}
+		bargeIn = data
+	}
+
+	query := `INSERT INTO run_transcript_segment (` + transcriptSegmentColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := s.db.ExecContext(ctx, query,
+		segment.Id,
+		segment.RunId,
+		segment.UtteranceId,
+		segment.Speaker,
+		segment.Text,
+		segment.OffsetMs,
+		bargeIn,
+		segment.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating transcript segment: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetRunTranscript(ctx context.Context, runId uuid.UUID) ([]asteroid.TranscriptSegment, error) {
+	return s.queryTranscriptSegments(ctx, `SELECT `+transcriptSegmentColumns+` FROM run_transcript_segment WHERE run_id = $1 ORDER BY created_at, id`, runId)
+}
+
+func (s *PostgresqlStore) GetUtteranceSegments(ctx context.Context, runId uuid.UUID, utteranceId string) ([]asteroid.TranscriptSegment, error) {
+	return s.queryTranscriptSegments(ctx, `SELECT `+transcriptSegmentColumns+` FROM run_transcript_segment WHERE run_id = $1 AND utterance_id = $2 ORDER BY created_at, id`, runId, utteranceId)
+}
+
+func (s *PostgresqlStore) queryTranscriptSegments(ctx context.Context, query string, args ...any) ([]asteroid.TranscriptSegment, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting transcript segments: %w", err)
+	}
+	defer rows.Close()
+
+	segments := make([]asteroid.TranscriptSegment, 0)
+	for rows.Next() {
+		segment, err := scanTranscriptSegment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning transcript segment: %w", err)
+		}
+		segments = append(segments, *segment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating transcript segments: %w", err)
+	}
+
+	return segments, nil
+}
+
+const webhookColumns = `id, project_id, url, events, enabled, created_at`
+
+func scanWebhook(row interface{ Scan(dest ...any) error }) (*asteroid.Webhook, error) {
+	var webhook asteroid.Webhook
+	var events []byte
+	if err := row.Scan(
+		&webhook.Id,
+		&webhook.ProjectId,
+		&webhook.Url,
+		&events,
+		&webhook.Enabled,
+		&webhook.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(events, &webhook.Events); err != nil {
+		return nil, fmt.Errorf("error parsing webhook events: %w", err)
+	}
+	return &webhook, nil
+}
+
+func (s *PostgresqlStore) CreateWebhook(ctx context.Context, webhook asteroid.Webhook, secret string) error {
+	events, err := json.Marshal(webhook.Events)
+	if err != nil {
+		return fmt.Errorf("error marshalling webhook events: %w", err)
+	}
+
+	query := `INSERT INTO webhook (` + webhookColumns + `, secret) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err = s.db.ExecContext(ctx, query,
+		webhook.Id,
+		webhook.ProjectId,
+		webhook.Url,
+		events,
+		webhook.Enabled,
+		webhook.CreatedAt,
+		secret,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetWebhook(ctx context.Context, id uuid.UUID) (*asteroid.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook WHERE id = $1`
+
+	webhook, err := scanWebhook(s.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func (s *PostgresqlStore) GetWebhookSecret(ctx context.Context, id uuid.UUID) (string, error) {
+	var secret string
+	err := s.db.QueryRowContext(ctx, `SELECT secret FROM webhook WHERE id = $1`, id).Scan(&secret)
+	if err != nil {
+		return "", fmt.Errorf("error getting webhook secret: %w", err)
+	}
+
+	return secret, nil
+}
+
+func (s *PostgresqlStore) GetProjectWebhooks(ctx context.Context, projectId uuid.UUID) ([]asteroid.Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook WHERE project_id = $1 ORDER BY created_at, id`
+	return s.queryWebhooks(ctx, query, projectId)
+}
+
+func (s *PostgresqlStore) GetEventWebhooks(ctx context.Context, projectId uuid.UUID, event asteroid.WebhookEvent) ([]asteroid.Webhook, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhook
+		WHERE project_id = $1 AND enabled AND events @> jsonb_build_array($2::text)
+		ORDER BY created_at, id`
+	return s.queryWebhooks(ctx, query, projectId, event)
+}
+
+func (s *PostgresqlStore) queryWebhooks(ctx context.Context, query string, args ...any) ([]asteroid.Webhook, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	webhooks := make([]asteroid.Webhook, 0)
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning webhook: %w", err)
+		}
+		webhooks = append(webhooks, *webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (s *PostgresqlStore) UpdateWebhook(ctx context.Context, webhook asteroid.Webhook) error {
+	events, err := json.Marshal(webhook.Events)
+	if err != nil {
+		return fmt.Errorf("error marshalling webhook events: %w", err)
+	}
+
+	query := `UPDATE webhook SET url = $2, events = $3, enabled = $4 WHERE id = $1`
+
+	if _, err := s.db.ExecContext(ctx, query, webhook.Id, webhook.Url, events, webhook.Enabled); err != nil {
+		return fmt.Errorf("error updating webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) DeleteWebhook(ctx context.Context, id uuid.UUID) (bool, error) {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM webhook WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("error deleting webhook: %w", err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error getting deleted webhooks: %w", err)
+	}
+
+	return deleted > 0, nil
+}
+
+const webhookDeliveryColumns = `id, webhook_id, event, payload, status, attempts, next_attempt_at, last_status_code, last_error, created_at, delivered_at`
+
+func scanWebhookDelivery(row interface{ Scan(dest ...any) error }) (*asteroid.WebhookDelivery, error) {
+	var delivery asteroid.WebhookDelivery
+	var payload []byte
+	if err := row.Scan(
+		&delivery.Id,
+		&delivery.WebhookId,
+		&delivery.Event,
+		&payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&delivery.LastStatusCode,
+		&delivery.LastError,
+		&delivery.CreatedAt,
+		&delivery.DeliveredAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(payload, &delivery.Payload); err != nil {
+		return nil, fmt.Errorf("error parsing webhook payload: %w", err)
+	}
+	return &delivery, nil
+}
+
+func (s *PostgresqlStore) CreateWebhookDeliveries(ctx context.Context, deliveries []asteroid.WebhookDelivery) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `INSERT INTO webhook_delivery (` + webhookDeliveryColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	for _, delivery := range deliveries {
+		payload, err := json.Marshal(delivery.Payload)
+		if err != nil {
+			return fmt.Errorf("error marshalling webhook payload: %w", err)
+		}
+
+		_, err = tx.ExecContext(ctx, query,
+			delivery.Id,
+			delivery.WebhookId,
+			delivery.Event,
+			payload,
+			delivery.Status,
+			delivery.Attempts,
+			delivery.NextAttemptAt,
+			delivery.LastStatusCode,
+			delivery.LastError,
+			delivery.CreatedAt,
+			delivery.DeliveredAt,
+		)
+		if err != nil {
+			return fmt.Errorf("error creating webhook delivery: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]asteroid.WebhookDelivery, error) {
+	// Deliveries another server is claiming are skipped rather than waited for
+	query := `
+		UPDATE webhook_delivery SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM webhook_delivery
+			WHERE status = 'queued' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + webhookDeliveryColumns
+
+	deliveries, err := s.queryWebhookDeliveries(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// RETURNING doesn't keep the order deliveries were claimed in
+	slices.SortFunc(deliveries, func(a, b asteroid.WebhookDelivery) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return deliveries, nil
+}
+
+func (s *PostgresqlStore) UpdateWebhookDelivery(ctx context.Context, delivery asteroid.WebhookDelivery) error {
+	query := `
+		UPDATE webhook_delivery
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_status_code = $5, last_error = $6, delivered_at = $7
+		WHERE id = $1`
+
+	_, err := s.db.ExecContext(ctx, query,
+		delivery.Id,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.LastStatusCode,
+		delivery.LastError,
+		delivery.DeliveredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error updating webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetWebhookDeliveries(ctx context.Context, webhookId uuid.UUID, limit int) ([]asteroid.WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_delivery WHERE webhook_id = $1 ORDER BY created_at DESC, id LIMIT $2`
+	return s.queryWebhookDeliveries(ctx, query, webhookId, limit)
+}
+
+func (s *PostgresqlStore) queryWebhookDeliveries(ctx context.Context, query string, args ...any) ([]asteroid.WebhookDelivery, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]asteroid.WebhookDelivery, 0)
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+const watchSubscriptionColumns = `id, session, run_id, agent_id, tool_name, project_id, events, created_at`
+
+func scanWatchSubscription(row interface{ Scan(dest ...any) error }) (*asteroid.WatchSubscription, error) {
+	var subscription asteroid.WatchSubscription
+	var events []byte
+	if err := row.Scan(
+		&subscription.Id,
+		&subscription.Session,
+		&subscription.RunId,
+		&subscription.AgentId,
+		&subscription.ToolName,
+		&subscription.ProjectId,
+		&events,
+		&subscription.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(events, &subscription.Events); err != nil {
+		return nil, fmt.Errorf("error unmarshalling watch subscription events: %w", err)
+	}
+
+	return &subscription, nil
+}
+
+func (s *PostgresqlStore) CreateWatchSubscription(ctx context.Context, subscription asteroid.WatchSubscription) error {
+	events, err := json.Marshal(subscription.Events)
+	if err != nil {
+		return fmt.Errorf("error marshalling watch subscription events: %w", err)
+	}
+
+	query := `INSERT INTO watch_subscription (` + watchSubscriptionColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err = s.db.ExecContext(ctx, query,
+		subscription.Id,
+		subscription.Session,
+		subscription.RunId,
+		subscription.AgentId,
+		subscription.ToolName,
+		subscription.ProjectId,
+		events,
+		subscription.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating watch subscription: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetWatchSubscription(ctx context.Context, id uuid.UUID) (*asteroid.WatchSubscription, error) {
+	query := `SELECT ` + watchSubscriptionColumns + ` FROM watch_subscription WHERE id = $1`
+
+	subscription, err := scanWatchSubscription(s.db.QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting watch subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (s *PostgresqlStore) GetSessionWatchSubscriptions(ctx context.Context, session string) ([]asteroid.WatchSubscription, error) {
+	query := `
+		SELECT ` + watchSubscriptionColumns + `
+		FROM watch_subscription
+		WHERE session = $1
+		ORDER BY created_at, id`
+	return s.queryWatchSubscriptions(ctx, query, session)
+}
+
+func (s *PostgresqlStore) GetMatchingWatchSubscriptions(ctx context.Context, event asteroid.WatchEvent, runId uuid.UUID, agentId *uuid.UUID, projectId *uuid.UUID, toolName *string) ([]asteroid.WatchSubscription, error) {
+	query := `
+		SELECT ` + watchSubscriptionColumns + `
+		FROM watch_subscription
+		WHERE events @> jsonb_build_array($1::text)
+		AND (run_id = $2 OR agent_id = $3 OR (tool_name = $4 AND project_id = $5))
+		ORDER BY created_at, id`
+	return s.queryWatchSubscriptions(ctx, query, event, runId, agentId, toolName, projectId)
+}
+
+func (s *PostgresqlStore) queryWatchSubscriptions(ctx context.Context, query string, args ...any) ([]asteroid.WatchSubscription, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting watch subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	subscriptions := make([]asteroid.WatchSubscription, 0)
+	for rows.Next() {
+		subscription, err := scanWatchSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning watch subscription: %w", err)
+		}
+		subscriptions = append(subscriptions, *subscription)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating watch subscriptions: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+func (s *PostgresqlStore) DeleteWatchSubscription(ctx context.Context, id uuid.UUID) (bool, error) {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM watch_subscription WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("error deleting watch subscription: %w", err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error getting deleted watch subscriptions: %w", err)
+	}
+
+	return deleted > 0, nil
+}
+
+const watchNotificationColumns = `sequence, id, subscription_id, session, event, run_id, tool_call_id, tool_name, decision, created_at`
+
+func (s *PostgresqlStore) CreateWatchNotifications(ctx context.Context, notifications []asteroid.WatchNotification) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		INSERT INTO watch_notification (id, subscription_id, session, event, run_id, tool_call_id, tool_name, decision, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING sequence`
+
+	for i := range notifications {
+		notification := &notifications[i]
+		err := tx.QueryRowContext(ctx, query,
+			notification.Id,
+			notification.SubscriptionId,
+			notification.Session,
+			notification.Event,
+			notification.RunId,
+			notification.ToolCallId,
+			notification.ToolName,
+			notification.Decision,
+			notification.CreatedAt,
+		).Scan(&notification.Sequence)
+		if err != nil {
+			return fmt.Errorf("error creating watch notification: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetWatchNotificationsAfter(ctx context.Context, after int64, limit int) ([]asteroid.WatchNotification, error) {
+	query := `
+		SELECT ` + watchNotificationColumns + `
+		FROM watch_notification
+		WHERE sequence > $1
+		ORDER BY sequence
+		LIMIT $2`
+	return s.queryWatchNotifications(ctx, query, after, limit)
+}
+
+func (s *PostgresqlStore) GetSessionWatchNotifications(ctx context.Context, session string, after int64, limit int) ([]asteroid.WatchNotification, error) {
+	query := `
+		SELECT ` + watchNotificationColumns + `
+		FROM watch_notification
+		WHERE session = $1 AND sequence > $2
+		ORDER BY sequence
+		LIMIT $3`
+	return s.queryWatchNotifications(ctx, query, session, after, limit)
+}
+
+func (s *PostgresqlStore) queryWatchNotifications(ctx context.Context, query string, args ...any) ([]asteroid.WatchNotification, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting watch notifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := make([]asteroid.WatchNotification, 0)
+	for rows.Next() {
+		var notification asteroid.WatchNotification
+		if err := rows.Scan(
+			&notification.Sequence,
+			&notification.Id,
+			&notification.SubscriptionId,
+			&notification.Session,
+			&notification.Event,
+			&notification.RunId,
+			&notification.ToolCallId,
+			&notification.ToolName,
+			&notification.Decision,
+			&notification.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning watch notification: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating watch notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+func (s *PostgresqlStore) GetLatestWatchNotificationSequence(ctx context.Context) (int64, error) {
+	var sequence int64
+	err := s.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(sequence), 0) FROM watch_notification`).Scan(&sequence)
+	if err != nil {
+		return 0, fmt.Errorf("error getting latest watch notification sequence: %w", err)
+	}
+
+	return sequence, nil
+}
+
+const changeRequestColumns = `id, supervisionrequest_id, sys_id, number, link, approval, state, created_at, checked_at, closed_at`
+
+func scanChangeRequest(row interface{ Scan(dest ...any) error }) (*asteroid.ChangeRequest, error) {
+	var changeRequest asteroid.ChangeRequest
+	if err := row.Scan(
+		&changeRequest.Id,
+		&changeRequest.SupervisionRequestId,
+		&changeRequest.SysId,
+		&changeRequest.Number,
+		&changeRequest.Link,
+		&changeRequest.Approval,
+		&changeRequest.State,
+		&changeRequest.CreatedAt,
+		&changeRequest.CheckedAt,
+		&changeRequest.ClosedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &changeRequest, nil
+}
+
+func (s *PostgresqlStore) CreateChangeRequest(ctx context.Context, changeRequest asteroid.ChangeRequest) error {
+	query := `INSERT INTO change_request (` + changeRequestColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	_, err := s.db.ExecContext(ctx, query,
+		changeRequest.Id,
+		changeRequest.SupervisionRequestId,
+		changeRequest.SysId,
+		changeRequest.Number,
+		changeRequest.Link,
+		changeRequest.Approval,
+		changeRequest.State,
+		changeRequest.CreatedAt,
+		changeRequest.CheckedAt,
+		changeRequest.ClosedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating change request: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetChangeRequest(ctx context.Context, supervisionRequestId uuid.UUID) (*asteroid.ChangeRequest, error) {
+	query := `SELECT ` + changeRequestColumns + ` FROM change_request WHERE supervisionrequest_id = $1`
+
+	changeRequest, err := scanChangeRequest(s.db.QueryRowContext(ctx, query, supervisionRequestId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting change request: %w", err)
+	}
+
+	return changeRequest, nil
+}
+
+func (s *PostgresqlStore) GetOpenChangeRequests(ctx context.Context, limit int) ([]asteroid.ChangeRequest, error) {
+	query := `
+		SELECT ` + changeRequestColumns + `
+		FROM change_request
+		WHERE closed_at IS NULL
+		ORDER BY checked_at NULLS FIRST, created_at
+		LIMIT $1`
+
+	rows, err := s.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting open change requests: %w", err)
+	}
+	defer rows.Close()
+
+	changeRequests := make([]asteroid.ChangeRequest, 0)
+	for rows.Next() {
+		changeRequest, err := scanChangeRequest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning change request: %w", err)
+		}
+		changeRequests = append(changeRequests, *changeRequest)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating change requests: %w", err)
+	}
+
+	return changeRequests, nil
+}
+
+func (s *PostgresqlStore) UpdateChangeRequest(ctx context.Context, changeRequest asteroid.ChangeRequest) error {
+	query := `
+		UPDATE change_request
+		SET approval = $2, state = $3, checked_at = $4, closed_at = $5
+		WHERE id = $1`
+
+	_, err := s.db.ExecContext(ctx, query,
+		changeRequest.Id,
+		changeRequest.Approval,
+		changeRequest.State,
+		changeRequest.CheckedAt,
+		changeRequest.ClosedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error updating change request: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetProjectArgumentBaselines(ctx context.Context, projectId uuid.UUID) ([]asteroid.ArgumentBaseline, error) {
+	query := `
+		SELECT tool_name, arguments, description
+		FROM project_argument_baseline
+		WHERE project_id = $1
+		ORDER BY tool_name ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting argument baselines: %w", err)
+	}
+	defer rows.Close()
+
+	baselines := make([]asteroid.ArgumentBaseline, 0)
+	for rows.Next() {
+		var baseline asteroid.ArgumentBaseline
+		if err := rows.Scan(&baseline.ToolName, &baseline.Arguments, &baseline.Description); err != nil {
+			return nil, fmt.Errorf("error scanning argument baseline: %w", err)
+		}
+		baselines = append(baselines, baseline)
+	}
+
+	return baselines, rows.Err()
+}
+
+func (s *PostgresqlStore) SetProjectArgumentBaselines(ctx context.Context, projectId uuid.UUID, baselines []asteroid.ArgumentBaseline) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM project_argument_baseline WHERE project_id = $1`, projectId)
+	if err != nil {
+		return fmt.Errorf("error deleting argument baselines: %w", err)
+	}
+
+	query := `
+		INSERT INTO project_argument_baseline (project_id, tool_name, arguments, description)
+		VALUES ($1, $2, $3, $4)`
+
+	for _, baseline := range baselines {
+		_, err = tx.ExecContext(ctx, query, projectId, baseline.ToolName, baseline.Arguments, baseline.Description)
+		if err != nil {
+			return fmt.Errorf("error creating argument baseline: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetArgumentBaseline(ctx context.Context, projectId uuid.UUID, toolName string) (*asteroid.ArgumentBaseline, error) {
+	query := `
+		SELECT tool_name, arguments, description
+		FROM project_argument_baseline
+		WHERE project_id = $1 AND tool_name = $2`
+
+	var baseline asteroid.ArgumentBaseline
+	err := s.db.QueryRowContext(ctx, query, projectId, toolName).Scan(&baseline.ToolName, &baseline.Arguments, &baseline.Description)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting argument baseline: %w", err)
+	}
+
+	return &baseline, nil
+}
+
+// searchHeadlineOptions mark the matched words of a search hit's snippet
+const searchHeadlineOptions = "StartSel=[[, StopSel=]], MaxWords=30, MinWords=10, MaxFragments=2"
+
+func (s *PostgresqlStore) Search(ctx context.Context, query string, projectId *uuid.UUID, kind *asteroid.SearchHitKind, limit int) ([]asteroid.SearchHit, error) {
+	// Snippets are only made for the hits returned, as ts_headline reads the whole text
+	sqlQuery := `
+		WITH search AS (SELECT websearch_to_tsquery('simple', $1) AS query)
+		SELECT kind, run_id, project_id, message_id, tool_call_id, supervision_request_id, tool_name,
+			ts_headline('simple', text, search.query, $5), rank, created_at
+		FROM (
+			SELECT 'message_hit' AS kind, c.run_id, t.project_id, m.id AS message_id, NULL::uuid AS tool_call_id,
+				NULL::uuid AS supervision_request_id, NULL::text AS tool_name, m.msg_data->>'content' AS text,
+				ts_rank(m.search_vector, search.query) AS rank, m.created_at
+			FROM search, msg m
+			JOIN choice ch ON ch.id = m.choice_id
+			JOIN chat c ON c.id = ch.chat_id
+			JOIN run r ON r.id = c.run_id
+			JOIN task t ON t.id = r.task_id
+			WHERE m.search_vector @@ search.query AND t.project_id IS NOT NULL
+				AND ($2::uuid IS NULL OR t.project_id = $2) AND ($3::text IS NULL OR $3 = 'message_hit')
+
+			UNION ALL
+
+			SELECT 'tool_call_hit', tl.run_id, t.project_id, tc.msg_id, tc.id, NULL, tl.name,
+				coalesce(tc.tool_call_data->>'name', '') || ' ' || coalesce(tc.tool_call_data->>'arguments', ''),
+				ts_rank(tc.search_vector, search.query), tc.created_at
+			FROM search, toolcall tc
+			JOIN tool tl ON tl.id = tc.tool_id
+			JOIN run r ON r.id = tl.run_id
+			JOIN task t ON t.id = r.task_id
+			WHERE tc.search_vector @@ search.query AND t.project_id IS NOT NULL
+				AND ($2::uuid IS NULL OR t.project_id = $2) AND ($3::text IS NULL OR $3 = 'tool_call_hit')
+
+			UNION ALL
+
+			SELECT 'decision_hit', tl.run_id, t.project_id, NULL, tc.id, sr.supervisionrequest_id, tl.name, sr.reasoning,
+				ts_rank(sr.search_vector, search.query), sr.created_at
+			FROM search, supervisionresult sr
+			JOIN supervisionrequest sq ON sq.id = sr.supervisionrequest_id
+			JOIN chainexecution ce ON ce.id = sq.chainexecution_id
+			JOIN toolcall tc ON tc.id = ce.toolcall_id
+			JOIN tool tl ON tl.id = tc.tool_id
+			JOIN run r ON r.id = tl.run_id
+			JOIN task t ON t.id = r.task_id
+			WHERE sr.search_vector @@ search.query AND t.project_id IS NOT NULL
+				AND ($2::uuid IS NULL OR t.project_id = $2) AND ($3::text IS NULL OR $3 = 'decision_hit')
+
+			ORDER BY rank DESC, created_at DESC
+			LIMIT $4
+		) hits, search
+		ORDER BY rank DESC, created_at DESC`
+
+	rows, err := s.db.QueryContext(ctx, sqlQuery, query, projectId, kind, limit, searchHeadlineOptions)
+	if err != nil {
+		return nil, fmt.Errorf("error searching: %w", err)
+	}
+	defer rows.Close()
+
+	hits := make([]asteroid.SearchHit, 0)
+	for rows.Next() {
+		var hit asteroid.SearchHit
+		var snippet sql.NullString
+		err := rows.Scan(&hit.Kind, &hit.RunId, &hit.ProjectId, &hit.MessageId, &hit.ToolCallId,
+			&hit.SupervisionRequestId, &hit.ToolName, &snippet, &hit.Rank, &hit.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning search hit: %w", err)
+		}
+		hit.Snippet = snippet.String
+		hits = append(hits, hit)
+	}
+
+	return hits, rows.Err()
+}
+
+func (s *PostgresqlStore) GetResultSupervisors(ctx context.Context, projectId uuid.UUID) ([]asteroid.ResultSupervisor, error) {
+	query := `
+		SELECT name, pattern, tool_name, action, reason
+		FROM project_result_supervisor
+		WHERE project_id = $1
+		ORDER BY position`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting result supervisors: %w", err)
+	}
+	defer rows.Close()
+
+	supervisors := make([]asteroid.ResultSupervisor, 0)
+	for rows.Next() {
+		var supervisor asteroid.ResultSupervisor
+		if err := rows.Scan(&supervisor.Name, &supervisor.Pattern, &supervisor.ToolName, &supervisor.Action, &supervisor.Reason); err != nil {
+			return nil, fmt.Errorf("error scanning result supervisor: %w", err)
+		}
+		supervisors = append(supervisors, supervisor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating result supervisors: %w", err)
+	}
+
+	return supervisors, nil
+}
+
+func (s *PostgresqlStore) SetResultSupervisors(ctx context.Context, projectId uuid.UUID, supervisors []asteroid.ResultSupervisor) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM project_result_supervisor WHERE project_id = $1`, projectId)
+	if err != nil {
+		return fmt.Errorf("error deleting result supervisors: %w", err)
+	}
+
+	query := `
+		INSERT INTO project_result_supervisor (project_id, position, name, pattern, tool_name, action, reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	for i, supervisor := range supervisors {
+		_, err = tx.ExecContext(ctx, query, projectId, i, supervisor.Name, supervisor.Pattern, supervisor.ToolName, supervisor.Action, supervisor.Reason)
+		if err != nil {
+			return fmt.Errorf("error creating result supervisor: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+const toolCallResultColumns = `toolcall_id, result, status, supervisor, reason, decided_by, decided_at, created_at`
+
+func scanToolCallResult(row interface{ Scan(dest ...any) error }) (*asteroid.ToolCallResult, error) {
+	var result asteroid.ToolCallResult
+	if err := row.Scan(&result.ToolCallId, &result.Result, &result.Status, &result.Supervisor, &result.Reason, &result.DecidedBy, &result.DecidedAt, &result.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (s *PostgresqlStore) CreateToolCallResult(ctx context.Context, result asteroid.ToolCallResult) (bool, error) {
+	query := `
+		INSERT INTO toolcall_result (` + toolCallResultColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (toolcall_id) DO NOTHING`
+
+	res, err := s.db.ExecContext(ctx, query, result.ToolCallId, result.Result, result.Status, result.Supervisor, result.Reason, result.DecidedBy, result.DecidedAt, result.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("error creating tool call result: %w", err)
+	}
+
+	created, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	return created > 0, nil
+}
+
+func (s *PostgresqlStore) GetToolCallResult(ctx context.Context, toolCallId uuid.UUID) (*asteroid.ToolCallResult, error) {
+	query := `SELECT ` + toolCallResultColumns + ` FROM toolcall_result WHERE toolcall_id = $1`
+
+	result, err := scanToolCallResult(s.db.QueryRowContext(ctx, query, toolCallId))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool call result: %w", err)
+	}
+
+	return result, nil
+}
+
+func (s *PostgresqlStore) DecideToolCallResult(ctx context.Context, result asteroid.ToolCallResult) (bool, error) {
+	query := `
+		UPDATE toolcall_result
+		SET status = $2, reason = $3, decided_by = $4, decided_at = $5
+		WHERE toolcall_id = $1 AND status = $6`
+
+	res, err := s.db.ExecContext(ctx, query, result.ToolCallId, result.Status, result.Reason, result.DecidedBy, result.DecidedAt, asteroid.ResultHeld)
+	if err != nil {
+		return false, fmt.Errorf("error deciding tool call result: %w", err)
+	}
+
+	decided, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	return decided > 0, nil
+}
+
+func (s *PostgresqlStore) GetProjectToolCallResults(ctx context.Context, projectId uuid.UUID, status *asteroid.ToolCallResultStatus) ([]asteroid.ToolCallResult, error) {
+	query := `
+		SELECT tr.toolcall_id, tr.result, tr.status, tr.supervisor, tr.reason, tr.decided_by, tr.decided_at, tr.created_at
+		FROM toolcall_result tr
+		JOIN toolcall tc ON tr.toolcall_id = tc.id
+		JOIN tool t ON tc.tool_id = t.id
+		JOIN run r ON t.run_id = r.id
+		JOIN task ta ON r.task_id = ta.id
+		WHERE ta.project_id = $1 AND ($2::text IS NULL OR tr.status = $2)
+		ORDER BY tr.created_at DESC`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId, status)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project tool call results: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]asteroid.ToolCallResult, 0)
+	for rows.Next() {
+		result, err := scanToolCallResult(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning tool call result: %w", err)
+		}
+		results = append(results, *result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tool call results: %w", err)
+	}
+
+	return results, nil
+}
+
+func (s *PostgresqlStore) GetWithheldToolCallResults(ctx context.Context, runId uuid.UUID) (map[string]asteroid.ToolCallResult, error) {
+	query := `
+		SELECT tc.call_id, tr.toolcall_id, tr.result, tr.status, tr.supervisor, tr.reason, tr.decided_by, tr.decided_at, tr.created_at
+		FROM toolcall_result tr
+		JOIN toolcall tc ON tr.toolcall_id = tc.id
+		JOIN tool t ON tc.tool_id = t.id
+		WHERE t.run_id = $1 AND tr.status IN ($2, $3)`
+
+	rows, err := s.db.QueryContext(ctx, query, runId, asteroid.ResultQuarantined, asteroid.ResultHeld)
+	if err != nil {
+		return nil, fmt.Errorf("error getting withheld tool call results: %w", err)
+	}
+	defer rows.Close()
+
+	results := make(map[string]asteroid.ToolCallResult)
+	for rows.Next() {
+		var callId string
+		var result asteroid.ToolCallResult
+		if err := rows.Scan(&callId, &result.ToolCallId, &result.Result, &result.Status, &result.Supervisor, &result.Reason, &result.DecidedBy, &result.DecidedAt, &result.CreatedAt); err != nil {
+			return nil, fmt.Errorf("error scanning tool call result: %w", err)
+		}
+		results[callId] = result
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tool call results: %w", err)
+	}
+
+	return results, nil
+}
+
+func (s *PostgresqlStore) GetRedactors(ctx context.Context, projectId uuid.UUID) ([]asteroid.Redactor, error) {
+	query := `
+		SELECT name, detector, pattern, field_path
+		FROM project_redactor
+		WHERE project_id = $1
+		ORDER BY position`
+
+	rows, err := s.db.QueryContext(ctx, query, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting redactors: %w", err)
+	}
+	defer rows.Close()
+
+	redactors := make([]asteroid.Redactor, 0)
+	for rows.Next() {
+		var redactor asteroid.Redactor
+		if err := rows.Scan(&redactor.Name, &redactor.Detector, &redactor.Pattern, &redactor.FieldPath); err != nil {
+			return nil, fmt.Errorf("error scanning redactor: %w", err)
+		}
+		redactors = append(redactors, redactor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating redactors: %w", err)
+	}
+
+	return redactors, nil
+}
+
+func (s *PostgresqlStore) SetRedactors(ctx context.Context, projectId uuid.UUID, redactors []asteroid.Redactor) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM project_redactor WHERE project_id = $1`, projectId)
+	if err != nil {
+		return fmt.Errorf("error deleting redactors: %w", err)
+	}
+
+	query := `
+		INSERT INTO project_redactor (project_id, position, name, detector, pattern, field_path)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	for i, redactor := range redactors {
+		_, err = tx.ExecContext(ctx, query, projectId, i, redactor.Name, redactor.Detector, redactor.Pattern, redactor.FieldPath)
+		if err != nil {
+			return fmt.Errorf("error creating redactor: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) CreateRedactions(ctx context.Context, redactions []asteroid.Redaction) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		INSERT INTO redaction (id, run_id, chat_id, redactor, placeholder, original, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	for _, redaction := range redactions {
+		_, err = tx.ExecContext(ctx, query, redaction.Id, redaction.RunId, redaction.ChatId, redaction.Redactor, redaction.Placeholder, redaction.Original, redaction.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("error creating redaction: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetRunRedactions(ctx context.Context, runId uuid.UUID) ([]asteroid.Redaction, error) {
+	query := `
+		SELECT id, run_id, chat_id, redactor, placeholder, original, created_at
+		FROM redaction
+		WHERE run_id = $1
+		ORDER BY created_at, id`
+
+	rows, err := s.db.QueryContext(ctx, query, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting redactions: %w", err)
+	}
+	defer rows.Close()
+
+	redactions := make([]asteroid.Redaction, 0)
+	for rows.Next() {
+		var redaction asteroid.Redaction
+		if err := rows.Scan(&redaction.Id, &redaction.RunId, &redaction.ChatId, &redaction.Redactor, &redaction.Placeholder, &redaction.Original, &redaction.CreatedAt); err != nil {
+			return nil, fmt.Errorf("error scanning redaction: %w", err)
+		}
+		redactions = append(redactions, redaction)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating redactions: %w", err)
+	}
+
+	return redactions, nil
+}
+
+const workerLeaseColumns = `worker, holder, acquired_at, renewed_at, expires_at`
+
+func scanWorkerLease(row interface{ Scan(dest ...any) error }) (*asteroid.WorkerLease, error) {
+	var lease asteroid.WorkerLease
+	if err := row.Scan(&lease.Worker, &lease.Holder, &lease.AcquiredAt, &lease.RenewedAt, &lease.ExpiresAt); err != nil {
+		return nil, err
+	}
+	return &lease, nil
+}
+
+func (s *PostgresqlStore) AcquireWorkerLease(ctx context.Context, worker string, holder string, duration time.Duration) (*asteroid.WorkerLease, error) {
+	// The holder keeps when it first acquired the lease while it renews it
+	query := `
+		INSERT INTO worker_lease (worker, holder, acquired_at, renewed_at, expires_at)
+		VALUES ($1, $2, now(), now(), now() + make_interval(secs => $3))
+		ON CONFLICT (worker) DO UPDATE SET
+			holder = EXCLUDED.holder,
+			acquired_at = CASE WHEN worker_lease.holder = EXCLUDED.holder THEN worker_lease.acquired_at ELSE EXCLUDED.acquired_at END,
+			renewed_at = EXCLUDED.renewed_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE worker_lease.holder = EXCLUDED.holder OR worker_lease.expires_at < EXCLUDED.renewed_at
+		RETURNING ` + workerLeaseColumns
+
+	lease, err := scanWorkerLease(s.db.QueryRowContext(ctx, query, worker, holder, duration.Seconds()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error acquiring worker lease: %w", err)
+	}
+
+	return lease, nil
+}
+
+func (s *PostgresqlStore) GetWorkerLeases(ctx context.Context) ([]asteroid.WorkerLease, error) {
+	query := `SELECT ` + workerLeaseColumns + ` FROM worker_lease ORDER BY worker`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error getting worker leases: %w", err)
+	}
+	defer rows.Close()
+
+	leases := make([]asteroid.WorkerLease, 0)
+	for rows.Next() {
+		lease, err := scanWorkerLease(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning worker lease: %w", err)
+		}
+		leases = append(leases, *lease)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating worker leases: %w", err)
+	}
+
+	return leases, nil
+}
+
+// getFeatureFlagTargets returns the targets of each rolled out flag, or of one flag if it's given
+func (s *PostgresqlStore) getFeatureFlagTargets(ctx context.Context, flag *string) (map[string][]asteroid.FeatureFlagTarget, error) {
+	query := `
+		SELECT flag, project_id, organization_id, enabled
+		FROM feature_flag_target
+		WHERE $1::TEXT IS NULL OR flag = $1`
+
+	rows, err := s.db.QueryContext(ctx, query, flag)
+	if err != nil {
+		return nil, fmt.Errorf("error getting feature flag targets: %w", err)
+	}
+	defer rows.Close()
+
+	targets := make(map[string][]asteroid.FeatureFlagTarget)
+	for rows.Next() {
+		var name string
+		var target asteroid.FeatureFlagTarget
+		if err := rows.Scan(&name, &target.ProjectId, &target.OrganizationId, &target.Enabled); err != nil {
+			return nil, fmt.Errorf("error scanning feature flag target: %w", err)
+		}
+		targets[name] = append(targets[name], target)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating feature flag targets: %w", err)
+	}
+
+	return targets, nil
+}
+
+func (s *PostgresqlStore) GetFeatureFlagRollout(ctx context.Context, flag string) (*asteroid.FeatureFlagRollout, error) {
+	query := `SELECT flag, percentage, updated_at, updated_by FROM feature_flag_rollout WHERE flag = $1`
+
+	var rollout asteroid.FeatureFlagRollout
+	err := s.db.QueryRowContext(ctx, query, flag).Scan(&rollout.Flag, &rollout.Percentage, &rollout.UpdatedAt, &rollout.UpdatedBy)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting feature flag rollout: %w", err)
+	}
+
+	targets, err := s.getFeatureFlagTargets(ctx, &flag)
+	if err != nil {
+		return nil, err
+	}
+	rollout.Targets = targets[flag]
+	if rollout.Targets == nil {
+		rollout.Targets = make([]asteroid.FeatureFlagTarget, 0)
+	}
+
+	return &rollout, nil
+}
+
+func (s *PostgresqlStore) GetFeatureFlagRollouts(ctx context.Context) ([]asteroid.FeatureFlagRollout, error) {
+	query := `SELECT flag, percentage, updated_at, updated_by FROM feature_flag_rollout ORDER BY flag`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error getting feature flag rollouts: %w", err)
+	}
+	defer rows.Close()
+
+	rollouts := make([]asteroid.FeatureFlagRollout, 0)
+	for rows.Next() {
+		var rollout asteroid.FeatureFlagRollout
+		if err := rows.Scan(&rollout.Flag, &rollout.Percentage, &rollout.UpdatedAt, &rollout.UpdatedBy); err != nil {
+			return nil, fmt.Errorf("error scanning feature flag rollout: %w", err)
+		}
+		rollouts = append(rollouts, rollout)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating feature flag rollouts: %w", err)
+	}
+
+	targets, err := s.getFeatureFlagTargets(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rollouts {
+		rollouts[i].Targets = targets[rollouts[i].Flag]
+		if rollouts[i].Targets == nil {
+			rollouts[i].Targets = make([]asteroid.FeatureFlagTarget, 0)
+		}
+	}
+
+	return rollouts, nil
+}
+
+func (s *PostgresqlStore) SetFeatureFlagRollout(ctx context.Context, rollout asteroid.FeatureFlagRollout) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		INSERT INTO feature_flag_rollout (flag, percentage, updated_at, updated_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (flag) DO UPDATE SET
+			percentage = EXCLUDED.percentage,
+			updated_at = EXCLUDED.updated_at,
+			updated_by = EXCLUDED.updated_by`
+
+	_, err = tx.ExecContext(ctx, query, rollout.Flag, rollout.Percentage, rollout.UpdatedAt, rollout.UpdatedBy)
+	if err != nil {
+		return fmt.Errorf("error setting feature flag rollout: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM feature_flag_target WHERE flag = $1`, rollout.Flag)
+	if err != nil {
+		return fmt.Errorf("error deleting feature flag targets: %w", err)
+	}
+
+	query = `
+		INSERT INTO feature_flag_target (flag, project_id, organization_id, enabled)
+		VALUES ($1, $2, $3, $4)`
+
+	for _, target := range rollout.Targets {
+		_, err = tx.ExecContext(ctx, query, rollout.Flag, target.ProjectId, target.OrganizationId, target.Enabled)
+		if err != nil {
+			return fmt.Errorf("error creating feature flag target: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) DeleteFeatureFlagRollout(ctx context.Context, flag string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM feature_flag_rollout WHERE flag = $1`, flag)
+	if err != nil {
+		return fmt.Errorf("error deleting feature flag rollout: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresqlStore) GetWebhookDeliveryStats(ctx context.Context, since time.Time) ([]asteroid.WebhookDeliveryStats, error) {
+	query := `
+		SELECT w.id, w.project_id,
+			COUNT(*) FILTER (WHERE d.status = 'queued'),
+			COUNT(*) FILTER (WHERE d.status = 'queued' AND d.attempts > 0),
+			COUNT(*) FILTER (WHERE d.status = 'delivered'),
+			COUNT(*) FILTER (WHERE d.status = 'abandoned')
+		FROM webhook_delivery d
+		JOIN webhook w ON w.id = d.webhook_id
+		WHERE d.created_at >= $1
+		GROUP BY w.id, w.project_id`
+
+	rows, err := s.db.QueryContext(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("error getting webhook delivery stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make([]asteroid.WebhookDeliveryStats, 0)
+	for rows.Next() {
+		var webhook asteroid.WebhookDeliveryStats
+		if err := rows.Scan(&webhook.WebhookId, &webhook.ProjectId, &webhook.Queued, &webhook.Retrying, &webhook.Delivered, &webhook.Abandoned); err != nil {
+			return nil, fmt.Errorf("error scanning webhook delivery stats: %w", err)
+		}
+		stats = append(stats, webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhook delivery stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (s *PostgresqlStore) Ping(ctx context.Context) error {
+	var one int
+	if err := s.db.QueryRowContext(ctx, "SELECT 1").Scan(&one); err != nil {
+		return fmt.Errorf("error pinging database: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,395 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	asteroid "github.com/asteroidai/asteroid/server"
+	"github.com/google/uuid"
+)
+
+//go:embed schema_sqlite.sql
+var sqliteSchema string
+
+// SQLiteStore is the embedded store, kept in a database file so small deployments don't need a
+// database server. It runs the Postgres store's queries, except those SQLite has no equivalent of.
+type SQLiteStore struct {
+	*PostgresqlStore
+}
+
+// Check if SQLiteStore implements asteroid.Store
+var _ asteroid.Store = &SQLiteStore{}
+
+// NewSQLiteStore opens the SQLite store in the file at SQLITE_PATH, creating it and its tables when
+// they don't exist yet
+func NewSQLiteStore() (*SQLiteStore, error) {
+	path := os.Getenv("SQLITE_PATH")
+	if path == "" {
+		return nil, fmt.Errorf("SQLITE_PATH is not set")
+	}
+
+	// Transactions take the write lock when they begin, so they don't fail to upgrade a read lock when
+	// another one writes first, and wait for each other rather than failing while the database is busy
+	params := url.Values{
+		"_pragma": {"busy_timeout(10000)", "foreign_keys(1)", "journal_mode(WAL)", "case_sensitive_like(1)"},
+		"_txlock": {"immediate"},
+	}
+	db, err := sql.Open(sqliteDriverName, "file:"+path+"?"+params.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("error opening database: %w", err)
+	}
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("error creating the database's tables: %w", err)
+	}
+
+	return &SQLiteStore{PostgresqlStore: &PostgresqlStore{db: &connections{main: db}}}, nil
+}
+
+func (s *SQLiteStore) CountSupervisionRequests(ctx context.Context, status asteroid.Status) (int, error) {
+	query := `
+        SELECT COUNT(*)
+        FROM (
+            SELECT DISTINCT sr.id
+            FROM supervisionrequest sr
+            JOIN supervisionrequest_status ss ON sr.id = ss.supervisionrequest_id
+            WHERE NOT EXISTS (
+                SELECT 1
+                FROM supervisionrequest_status newer
+                WHERE newer.supervisionrequest_id = sr.id
+                AND newer.created_at > ss.created_at
+            )
+            AND ss.status = $1
+        ) as latest_requests`
+
+	var count int
+	err := s.db.QueryRowContext(ctx, query, status).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("error counting supervision requests: %w", err)
+	}
+
+	return count, nil
+}
+
+func (s *SQLiteStore) GetConfidenceOutcomes(ctx context.Context, supervisorId uuid.UUID) ([]asteroid.ConfidenceOutcome, error) {
+	query := `
+		SELECT confidence, decision, human_decision
+		FROM (
+			SELECT res.id,
+				CAST(res.explanation->>'confidence' AS REAL) AS confidence,
+				COALESCE(res.overridden_decision, res.decision) AS decision,
+				human_res.decision AS human_decision,
+				ROW_NUMBER() OVER (PARTITION BY res.id ORDER BY human_req.position_in_chain DESC) AS latest
+			FROM supervisionresult res
+			JOIN supervisionrequest req ON req.id = res.supervisionrequest_id
+			JOIN supervisionrequest human_req ON human_req.chainexecution_id = req.chainexecution_id
+				AND human_req.position_in_chain > req.position_in_chain
+			JOIN supervisor human ON human.id = human_req.supervisor_id AND human.type = $2
+			JOIN supervisionresult human_res ON human_res.supervisionrequest_id = human_req.id
+				AND human_res.decision <> $3
+			WHERE req.supervisor_id = $1 AND json_type(res.explanation, '$.confidence') IS NOT NULL
+		) outcomes
+		WHERE latest = 1
+		ORDER BY id`
+	return s.queryConfidenceOutcomes(ctx, query, supervisorId)
+}
+
+func (s *SQLiteStore) GetPromptVariantOutcomes(ctx context.Context, supervisorId uuid.UUID) ([]asteroid.PromptVariantOutcome, error) {
+	query := `
+		SELECT variant, decision, human_decision
+		FROM (
+			SELECT res.id,
+				variant.variant,
+				COALESCE(res.overridden_decision, res.decision) AS decision,
+				human_res.decision AS human_decision,
+				ROW_NUMBER() OVER (PARTITION BY res.id ORDER BY human_req.position_in_chain DESC NULLS LAST) AS latest
+			FROM supervisionresult res
+			JOIN supervisionrequest req ON req.id = res.supervisionrequest_id
+			JOIN (
+				SELECT DISTINCT supervisionrequest_id, variant
+				FROM ensemble_verdict
+				WHERE variant IS NOT NULL
+			) variant ON variant.supervisionrequest_id = req.id
+			LEFT JOIN (
+				supervisionrequest human_req
+				JOIN supervisor human ON human.id = human_req.supervisor_id AND human.type = $2
+				JOIN supervisionresult human_res ON human_res.supervisionrequest_id = human_req.id
+					AND human_res.decision <> $3
+			) ON human_req.chainexecution_id = req.chainexecution_id
+				AND human_req.position_in_chain > req.position_in_chain
+			WHERE req.supervisor_id = $1
+		) outcomes
+		WHERE latest = 1
+		ORDER BY id`
+	return s.queryPromptVariantOutcomes(ctx, query, supervisorId)
+}
+
+func (s *SQLiteStore) GetPrecedentDecisions(ctx context.Context, projectId uuid.UUID, resultIds []uuid.UUID) ([]asteroid.PrecedentDecision, error) {
+	ids, err := json.Marshal(resultIds)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding result ids: %w", err)
+	}
+	return s.queryPrecedentDecisions(ctx, precedentDecisionQuery+`res.id IN (SELECT value FROM json_each($2)) ORDER BY res.created_at`, projectId, string(ids))
+}
+
+func (s *SQLiteStore) GetCitingDecisions(ctx context.Context, projectId uuid.UUID) ([]asteroid.PrecedentDecision, error) {
+	return s.queryPrecedentDecisions(ctx, precedentDecisionQuery+`res.precedents <> '{}' ORDER BY res.created_at`, projectId)
+}
+
+func (s *SQLiteStore) ResumeRun(ctx context.Context, runId uuid.UUID, status asteroid.Status, resumedAt time.Time) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var pausedAt time.Time
+	err = tx.QueryRowContext(ctx, `DELETE FROM run_pause WHERE run_id = $1 RETURNING paused_at`, runId).Scan(&pausedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error deleting run pause: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE run SET status = $2 WHERE id = $1`, runId, status); err != nil {
+		return false, fmt.Errorf("error resuming run: %w", err)
+	}
+
+	// Reminders and timeouts didn't run while the run was paused, so they're due as much later. SQLite
+	// can't add to the times as stored, so each is postponed from here.
+	query := `
+		SELECT id, fire_at
+		FROM durable_timer
+		WHERE fired_at IS NULL AND supervisionrequest_id IN (
+			SELECT sr.id
+			FROM supervisionrequest sr
+			JOIN chainexecution ce ON ce.id = sr.chainexecution_id
+			JOIN toolcall tc ON tc.id = ce.toolcall_id
+			JOIN tool t ON t.id = tc.tool_id
+			WHERE t.run_id = $1
+		)`
+	rows, err := tx.QueryContext(ctx, query, runId)
+	if err != nil {
+		return false, fmt.Errorf("error getting timers of run: %w", err)
+	}
+	defer rows.Close()
+
+	fireAt := make(map[uuid.UUID]time.Time)
+	for rows.Next() {
+		var id uuid.UUID
+		var at time.Time
+		if err := rows.Scan(&id, &at); err != nil {
+			return false, fmt.Errorf("error scanning timer: %w", err)
+		}
+		fireAt[id] = at
+	}
+	if err := rows.Err(); err != nil {
+		return false, fmt.Errorf("error iterating timers: %w", err)
+	}
+
+	paused := resumedAt.Sub(pausedAt)
+	for id, at := range fireAt {
+		if _, err := tx.ExecContext(ctx, `UPDATE durable_timer SET fire_at = $2 WHERE id = $1`, id, at.Add(paused)); err != nil {
+			return false, fmt.Errorf("error postponing timers of run: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+func (s *SQLiteStore) GetEventWebhooks(ctx context.Context, projectId uuid.UUID, event asteroid.WebhookEvent) ([]asteroid.Webhook, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhook
+		WHERE project_id = $1 AND enabled AND EXISTS (SELECT 1 FROM json_each(events) WHERE value = $2)
+		ORDER BY created_at, id`
+	return s.queryWebhooks(ctx, query, projectId, event)
+}
+
+func (s *SQLiteStore) GetMatchingWatchSubscriptions(ctx context.Context, event asteroid.WatchEvent, runId uuid.UUID, agentId *uuid.UUID, projectId *uuid.UUID, toolName *string) ([]asteroid.WatchSubscription, error) {
+	query := `
+		SELECT ` + watchSubscriptionColumns + `
+		FROM watch_subscription
+		WHERE EXISTS (SELECT 1 FROM json_each(events) WHERE value = $1)
+		AND (run_id = $2 OR agent_id = $3 OR (tool_name = $4 AND project_id = $5))
+		ORDER BY created_at, id`
+	return s.queryWatchSubscriptions(ctx, query, event, runId, agentId, toolName, projectId)
+}
+
+func (s *SQLiteStore) AcquireWorkerLease(ctx context.Context, worker string, holder string, duration time.Duration) (*asteroid.WorkerLease, error) {
+	// The holder keeps when it first acquired the lease while it renews it
+	query := `
+		INSERT INTO worker_lease (worker, holder, acquired_at, renewed_at, expires_at)
+		VALUES ($1, $2, $3, $3, $4)
+		ON CONFLICT (worker) DO UPDATE SET
+			holder = EXCLUDED.holder,
+			acquired_at = CASE WHEN worker_lease.holder = EXCLUDED.holder THEN worker_lease.acquired_at ELSE EXCLUDED.acquired_at END,
+			renewed_at = EXCLUDED.renewed_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE worker_lease.holder = EXCLUDED.holder OR worker_lease.expires_at < EXCLUDED.renewed_at
+		RETURNING ` + workerLeaseColumns
+
+	now := time.Now()
+	lease, err := scanWorkerLease(s.db.QueryRowContext(ctx, query, worker, holder, now, now.Add(duration)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error acquiring worker lease: %w", err)
+	}
+
+	return lease, nil
+}
+
+// searchSnippetWords is how many words of a hit's text its snippet has, some before its first match
+const searchSnippetWords = 30
+
+// Search matches the words of a query anywhere in the text, without the full-text index of Postgres.
+// A hit has to have every word except those with a leading minus, which it mustn't have, and ranks
+// by how much of its text they make up.
+func (s *SQLiteStore) Search(ctx context.Context, query string, projectId *uuid.UUID, kind *asteroid.SearchHitKind, limit int) ([]asteroid.SearchHit, error) {
+	var words, excluded []string
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		word = strings.Trim(word, `"`)
+		if rest, ok := strings.CutPrefix(word, "-"); ok && rest != "" {
+			excluded = append(excluded, rest)
+		} else if word != "" && word != "or" {
+			words = append(words, word)
+		}
+	}
+	hits := make([]asteroid.SearchHit, 0)
+	if len(words) == 0 {
+		return hits, nil
+	}
+
+	args := []any{projectId, kind}
+	var conditions []string
+	for _, word := range append(words, excluded...) {
+		args = append(args, escapeLike(word))
+		condition := `lower(text) LIKE '%' || $` + strconv.Itoa(len(args)) + ` || '%' ESCAPE '\'`
+		if len(conditions) >= len(words) {
+			condition = "NOT " + condition
+		}
+		conditions = append(conditions, condition)
+	}
+
+	sqlQuery := `
+		SELECT kind, run_id, project_id, message_id, tool_call_id, supervision_request_id, tool_name, text, created_at
+		FROM (
+			SELECT 'message_hit' AS kind, c.run_id, t.project_id, m.id AS message_id, NULL AS tool_call_id,
+				NULL AS supervision_request_id, NULL AS tool_name, m.msg_data->>'content' AS text, m.created_at
+			FROM msg m
+			JOIN choice ch ON ch.id = m.choice_id
+			JOIN chat c ON c.id = ch.chat_id
+			JOIN run r ON r.id = c.run_id
+			JOIN task t ON t.id = r.task_id
+			WHERE t.project_id IS NOT NULL
+				AND ($1 IS NULL OR t.project_id = $1) AND ($2 IS NULL OR $2 = 'message_hit')
+
+			UNION ALL
+
+			SELECT 'tool_call_hit', tl.run_id, t.project_id, tc.msg_id, tc.id, NULL, tl.name,
+				coalesce(tc.tool_call_data->>'name', '') || ' ' || coalesce(tc.tool_call_data->>'arguments', ''),
+				tc.created_at
+			FROM toolcall tc
+			JOIN tool tl ON tl.id = tc.tool_id
+			JOIN run r ON r.id = tl.run_id
+			JOIN task t ON t.id = r.task_id
+			WHERE t.project_id IS NOT NULL
+				AND ($1 IS NULL OR t.project_id = $1) AND ($2 IS NULL OR $2 = 'tool_call_hit')
+
+			UNION ALL
+
+			SELECT 'decision_hit', tl.run_id, t.project_id, NULL, tc.id, sr.supervisionrequest_id, tl.name, sr.reasoning,
+				sr.created_at
+			FROM supervisionresult sr
+			JOIN supervisionrequest sq ON sq.id = sr.supervisionrequest_id
+			JOIN chainexecution ce ON ce.id = sq.chainexecution_id
+			JOIN toolcall tc ON tc.id = ce.toolcall_id
+			JOIN tool tl ON tl.id = tc.tool_id
+			JOIN run r ON r.id = tl.run_id
+			JOIN task t ON t.id = r.task_id
+			WHERE t.project_id IS NOT NULL
+				AND ($1 IS NULL OR t.project_id = $1) AND ($2 IS NULL OR $2 = 'decision_hit')
+		) hits
+		WHERE ` + strings.Join(conditions, " AND ")
+
+	rows, err := s.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error searching: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var hit asteroid.SearchHit
+		var text string
+		err := rows.Scan(&hit.Kind, &hit.RunId, &hit.ProjectId, &hit.MessageId, &hit.ToolCallId,
+			&hit.SupervisionRequestId, &hit.ToolName, &text, &hit.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning search hit: %w", err)
+		}
+		hit.Snippet, hit.Rank = searchSnippet(text, words)
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating search hits: %w", err)
+	}
+
+	slices.SortStableFunc(hits, func(a, b asteroid.SearchHit) int {
+		if a.Rank != b.Rank {
+			if a.Rank > b.Rank {
+				return -1
+			}
+			return 1
+		}
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	return hits, nil
+}
+
+// searchSnippet returns the words of a hit's text around its first match with the matched ones
+// between [[ and ]], like the snippets of Postgres, and the share of the text's words that match
+func searchSnippet(text string, words []string) (string, float32) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return "", 0
+	}
+
+	first, matches := -1, 0
+	marked := make([]string, len(fields))
+	for i, field := range fields {
+		marked[i] = field
+		lower := strings.ToLower(field)
+		if slices.ContainsFunc(words, func(word string) bool { return strings.Contains(lower, word) }) {
+			marked[i] = "[[" + field + "]]"
+			matches++
+			if first < 0 {
+				first = i
+			}
+		}
+	}
+
+	start := max(0, first-searchSnippetWords/3)
+	end := min(len(marked), start+searchSnippetWords)
+	return strings.Join(marked[start:end], " "), float32(matches) / float32(len(fields))
+}
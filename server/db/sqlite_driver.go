@@ -0,0 +1,277 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"modernc.org/sqlite"
+)
+
+// sqliteDriverName is the driver of the SQLite store. It wraps the SQLite driver so the queries of the
+// Postgres store run on SQLite, and values read back have the types the Postgres driver gives them.
+const sqliteDriverName = "asteroid-sqlite"
+
+// sqliteTimeFormat is how times are stored. They're always in UTC with every digit of the
+// fraction, so comparing and ordering them as text compares and orders the times.
+const sqliteTimeFormat = "2006-01-02 15:04:05.000000000-07:00"
+
+func init() {
+	// Functions are only registered on the driver the SQLite package registers, so that's the one wrapped
+	registered, err := sql.Open("sqlite", "")
+	if err != nil {
+		panic(err)
+	}
+	sql.Register(sqliteDriverName, &sqliteDriver{driver: registered.Driver()})
+	_ = registered.Close()
+
+	// The Postgres functions the store's queries and schema use
+	sqlite.MustRegisterScalarFunction("now", 0, func(*sqlite.FunctionContext, []driver.Value) (driver.Value, error) {
+		return formatSQLiteTime(time.Now()), nil
+	})
+	sqlite.MustRegisterScalarFunction("gen_random_uuid", 0, func(*sqlite.FunctionContext, []driver.Value) (driver.Value, error) {
+		return uuid.NewString(), nil
+	})
+	sqlite.MustRegisterDeterministicScalarFunction("pg_column_size", 1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		switch v := args[0].(type) {
+		case nil:
+			return nil, nil
+		case string:
+			return int64(len(v)), nil
+		case []byte:
+			return int64(len(v)), nil
+		default:
+			return int64(8), nil
+		}
+	})
+	// Dates are stored as their day already, which is the only format the store asks for
+	sqlite.MustRegisterDeterministicScalarFunction("to_char", 2, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		if format, _ := args[1].(string); format != "YYYY-MM-DD" {
+			return nil, fmt.Errorf("to_char format %q isn't supported", format)
+		}
+		day, ok := args[0].(string)
+		if !ok || len(day) < len("2006-01-02") {
+			return args[0], nil
+		}
+		return day[:len("2006-01-02")], nil
+	})
+}
+
+func formatSQLiteTime(t time.Time) string {
+	return t.UTC().Format(sqliteTimeFormat)
+}
+
+// sqliteTimePattern matches the times SQLite computes, which come back as text of no declared type
+var sqliteTimePattern = regexp.MustCompile(`^\d{4}-\d\d-\d\d \d\d:\d\d:\d\d(\.\d+)?(\+00:00)?$`)
+
+func parseSQLiteTime(s string) (time.Time, bool) {
+	if !sqliteTimePattern.MatchString(s) {
+		return time.Time{}, false
+	}
+	t, err := time.Parse("2006-01-02 15:04:05.999999999", strings.TrimSuffix(s, "+00:00"))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// sqliteRewrites turn the Postgres syntax of the store's queries into SQLite's. Queries that can't be
+// rewritten like this are overridden by the SQLite store.
+var sqliteRewrites = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// SQLite columns aren't typed, so casts only mattered to Postgres
+	{regexp.MustCompile(`(?i)::(uuid|text|date|double precision|interval)\b`), ""},
+	{regexp.MustCompile(`\bLEAST\(`), "MIN("},
+	// Transactions take the database's write lock when they begin, so rows needn't be locked
+	{regexp.MustCompile(`\s+FOR UPDATE(\s+SKIP LOCKED)?`), ""},
+	{regexp.MustCompile(`\bCURRENT_TIMESTAMP\b`), "now()"},
+}
+
+var sqliteQueries sync.Map
+
+func rewriteSQLiteQuery(query string) string {
+	if rewritten, ok := sqliteQueries.Load(query); ok {
+		return rewritten.(string)
+	}
+
+	rewritten := query
+	for _, rewrite := range sqliteRewrites {
+		rewritten = rewrite.pattern.ReplaceAllString(rewritten, rewrite.replacement)
+	}
+	sqliteQueries.Store(query, rewritten)
+	return rewritten
+}
+
+// sqliteArgs stores times in the format they're compared in, and text as text rather than as a blob,
+// which SQLite's JSON functions would read as binary JSON. Nil bytes are NULL, as they are to Postgres.
+func sqliteArgs(args []driver.NamedValue) []driver.NamedValue {
+	converted := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		switch v := arg.Value.(type) {
+		case time.Time:
+			arg.Value = formatSQLiteTime(v)
+		case []byte:
+			if v == nil {
+				arg.Value = nil
+			} else if utf8.Valid(v) {
+				arg.Value = string(v)
+			}
+		}
+		converted[i] = arg
+	}
+	return converted
+}
+
+type sqliteDriver struct {
+	driver driver.Driver
+}
+
+func (d *sqliteDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteConn{conn: conn}, nil
+}
+
+// sqliteConn is assumed to wrap a connection of the SQLite driver, which implements every interface
+// used here
+type sqliteConn struct {
+	conn driver.Conn
+}
+
+func (c *sqliteConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *sqliteConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.conn.(driver.ConnPrepareContext).PrepareContext(ctx, rewriteSQLiteQuery(query))
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteStmt{stmt: stmt}, nil
+}
+
+func (c *sqliteConn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *sqliteConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *sqliteConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	return c.conn.(driver.ConnBeginTx).BeginTx(ctx, opts)
+}
+
+func (c *sqliteConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return c.conn.(driver.ExecerContext).ExecContext(ctx, rewriteSQLiteQuery(query), sqliteArgs(args))
+}
+
+func (c *sqliteConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.conn.(driver.QueryerContext).QueryContext(ctx, rewriteSQLiteQuery(query), sqliteArgs(args))
+	if err != nil {
+		return nil, err
+	}
+	return newSQLiteRows(rows), nil
+}
+
+func (c *sqliteConn) Ping(ctx context.Context) error {
+	return c.conn.(driver.Pinger).Ping(ctx)
+}
+
+func (c *sqliteConn) ResetSession(ctx context.Context) error {
+	return c.conn.(driver.SessionResetter).ResetSession(ctx)
+}
+
+func (c *sqliteConn) IsValid() bool {
+	return c.conn.(driver.Validator).IsValid()
+}
+
+type sqliteStmt struct {
+	stmt driver.Stmt
+}
+
+func (s *sqliteStmt) Close() error {
+	return s.stmt.Close()
+}
+
+func (s *sqliteStmt) NumInput() int {
+	return s.stmt.NumInput()
+}
+
+func (s *sqliteStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+func (s *sqliteStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+func (s *sqliteStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.stmt.(driver.StmtExecContext).ExecContext(ctx, sqliteArgs(args))
+}
+
+func (s *sqliteStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := s.stmt.(driver.StmtQueryContext).QueryContext(ctx, sqliteArgs(args))
+	if err != nil {
+		return nil, err
+	}
+	return newSQLiteRows(rows), nil
+}
+
+func namedValues(args []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return named
+}
+
+// sqliteRows read text as bytes, like the Postgres driver does, so it scans into JSON and arrays.
+// Times of columns without a declared type, which SQLite computed, are read as times.
+type sqliteRows struct {
+	driver.Rows
+	computed []bool
+}
+
+func newSQLiteRows(rows driver.Rows) *sqliteRows {
+	columns := rows.Columns()
+	computed := make([]bool, len(columns))
+	if typed, ok := rows.(driver.RowsColumnTypeDatabaseTypeName); ok {
+		for i := range columns {
+			computed[i] = typed.ColumnTypeDatabaseTypeName(i) == ""
+		}
+	}
+	return &sqliteRows{Rows: rows, computed: computed}
+}
+
+func (r *sqliteRows) Next(dest []driver.Value) error {
+	if err := r.Rows.Next(dest); err != nil {
+		return err
+	}
+
+	for i, value := range dest {
+		text, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if r.computed[i] {
+			if t, ok := parseSQLiteTime(text); ok {
+				dest[i] = t
+				continue
+			}
+		}
+		dest[i] = []byte(text)
+	}
+	return nil
+}
@@ -0,0 +1,390 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	asteroid "github.com/asteroidai/asteroid/server"
+	"github.com/google/uuid"
+)
+
+// sprintfVerb matches the verbs of queries formatted with fmt.Sprintf, which are only complete once formatted
+var sprintfVerb = regexp.MustCompile(`%[sdv]`)
+
+// sqlitePlaceholder matches the placeholders of a query, the highest of which is how many arguments it takes
+var sqlitePlaceholder = regexp.MustCompile(`\$(\d+)`)
+
+// TestSQLiteQueries prepares every query of the Postgres store the SQLite store runs, so queries added
+// with Postgres only syntax fail here rather than when they're first run on SQLite
+func TestSQLiteQueries(t *testing.T) {
+	store := newTestSQLiteStore(t)
+
+	fset := token.NewFileSet()
+	postgres, err := parser.ParseFile(fset, "postgresql.go", nil, 0)
+	if err != nil {
+		t.Fatalf("error parsing postgresql.go: %v", err)
+	}
+	sqlite, err := parser.ParseFile(fset, "sqlite.go", nil, 0)
+	if err != nil {
+		t.Fatalf("error parsing sqlite.go: %v", err)
+	}
+
+	// Methods the SQLite store overrides don't run the Postgres store's queries
+	overridden := make(map[string]bool)
+	for _, decl := range sqlite.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && receiverName(fn) == "SQLiteStore" {
+			overridden[fn.Name.Name] = true
+		}
+	}
+
+	constants := make(map[string]string)
+	for _, decl := range postgres.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			for i, name := range value.Names {
+				if i < len(value.Values) {
+					if s, ok := stringValue(value.Values[i], constants); ok {
+						constants[name.Name] = s
+					}
+				}
+			}
+		}
+	}
+
+	for _, decl := range postgres.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || receiverName(fn) != "PostgresqlStore" || overridden[fn.Name.Name] {
+			continue
+		}
+
+		// Parts of queries are kept in variables they're first assigned, like the filters queries vary by
+		values := maps.Clone(constants)
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			assign, ok := node.(*ast.AssignStmt)
+			if !ok || assign.Tok != token.DEFINE || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+				return true
+			}
+			if s, ok := stringValue(assign.Rhs[0], values); ok && !isSQLiteQuery(s) {
+				values[assign.Lhs[0].(*ast.Ident).Name] = s
+			}
+			return true
+		})
+
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			expr, ok := node.(ast.Expr)
+			if !ok {
+				return true
+			}
+			query, ok := stringValue(expr, values)
+			if !ok {
+				// Queries concatenated with what's only known when they run can't be prepared
+				binary, ok := expr.(*ast.BinaryExpr)
+				return !ok || binary.Op != token.ADD
+			}
+			if !isSQLiteQuery(query) {
+				return false
+			}
+
+			args := make([]any, placeholderCount(query))
+			if _, err := store.db.ExecContext(context.Background(), "EXPLAIN "+query, args...); err != nil {
+				t.Errorf("%s: %s: %v", fset.Position(expr.Pos()), fn.Name.Name, err)
+			}
+			return false
+		})
+	}
+}
+
+func receiverName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 {
+		return ""
+	}
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return ""
+	}
+	ident, ok := star.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return ident.Name
+}
+
+// stringValue evaluates string literals, the constants among them and their concatenations
+func stringValue(expr ast.Expr, constants map[string]string) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return "", false
+		}
+		s, err := strconv.Unquote(e.Value)
+		return s, err == nil
+	case *ast.Ident:
+		s, ok := constants[e.Name]
+		return s, ok
+	case *ast.ParenExpr:
+		return stringValue(e.X, constants)
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return "", false
+		}
+		x, ok := stringValue(e.X, constants)
+		if !ok {
+			return "", false
+		}
+		y, ok := stringValue(e.Y, constants)
+		return x + y, ok
+	}
+	return "", false
+}
+
+func isSQLiteQuery(s string) bool {
+	fields := strings.Fields(s)
+	if len(fields) == 0 || sprintfVerb.MatchString(s) {
+		return false
+	}
+	switch strings.ToUpper(fields[0]) {
+	case "SELECT", "INSERT", "UPDATE", "DELETE", "WITH":
+		return true
+	}
+	return false
+}
+
+func placeholderCount(query string) int {
+	count := 0
+	for _, match := range sqlitePlaceholder.FindAllStringSubmatch(query, -1) {
+		if n, _ := strconv.Atoi(match[1]); n > count {
+			count = n
+		}
+	}
+	return count
+}
+
+func newTestSQLiteStore(t *testing.T) *SQLiteStore {
+	t.Helper()
+	t.Setenv("SQLITE_PATH", t.TempDir()+"/sentinel.db")
+	store, err := NewSQLiteStore()
+	if err != nil {
+		t.Fatalf("error opening SQLite store: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+// checkSQLite fails the test on the error of a step the next ones need
+func checkSQLite(t *testing.T, step string, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("error %s: %v", step, err)
+	}
+}
+
+// TestSQLiteStore runs a tool call through the SQLite store the way the server does, from its project
+// to its decision, with the methods the SQLite store overrides along the way
+func TestSQLiteStore(t *testing.T) {
+	ctx := context.Background()
+	store := newTestSQLiteStore(t)
+	now := time.Now()
+
+	checkSQLite(t, "pinging", store.Ping(ctx))
+
+	project := asteroid.Project{Id: uuid.New(), Name: "smoke", CreatedAt: now, RunResultTags: []string{}}
+	checkSQLite(t, "creating project", store.CreateProject(ctx, project))
+	byName, err := store.GetProjectFromName(ctx, project.Name)
+	checkSQLite(t, "getting project by name", err)
+	if byName == nil || byName.Id != project.Id {
+		t.Fatalf("got project %v by name, want %s", byName, project.Id)
+	}
+
+	taskId, err := store.CreateTask(ctx, asteroid.Task{Name: "task", ProjectId: project.Id, CreatedAt: now})
+	checkSQLite(t, "creating task", err)
+
+	runId, err := store.CreateRun(ctx, asteroid.Run{TaskId: *taskId, CreatedAt: now})
+	checkSQLite(t, "creating run", err)
+	run, err := store.GetRun(ctx, runId)
+	checkSQLite(t, "getting run", err)
+	if run == nil || run.TaskId != *taskId {
+		t.Fatalf("got run %v, want a run of task %s", run, *taskId)
+	}
+	runs, err := store.GetTaskRuns(ctx, *taskId)
+	checkSQLite(t, "getting task runs", err)
+	if len(runs) != 1 {
+		t.Errorf("got %d runs of the task, want 1", len(runs))
+	}
+
+	tool, err := store.CreateTool(ctx, runId, map[string]interface{}{"team": "payments"}, "transfer", "Moves money", nil, "", nil)
+	checkSQLite(t, "creating tool", err)
+	supervisorId, err := store.CreateSupervisor(ctx, asteroid.Supervisor{
+		Name:        "client",
+		Description: "Decides in the agent",
+		Type:        asteroid.ClientSupervisor,
+		Attributes:  map[string]interface{}{},
+		CreatedAt:   now,
+	})
+	checkSQLite(t, "creating supervisor", err)
+	chainId, err := store.CreateSupervisorChain(ctx, *tool.Id, asteroid.ChainRequest{SupervisorIds: &[]uuid.UUID{supervisorId}})
+	checkSQLite(t, "creating chain", err)
+	chains, err := store.GetSupervisorChains(ctx, *tool.Id)
+	checkSQLite(t, "getting chains", err)
+	if len(chains) != 1 || len(chains[0].Supervisors) != 1 || chains[0].Supervisors[0].Id == nil || *chains[0].Supervisors[0].Id != supervisorId {
+		t.Fatalf("got chains %v, want one with the supervisor", chains)
+	}
+
+	toolCallId, messageId, choiceId := uuid.New(), uuid.New(), uuid.New()
+	callId, name, arguments := "call_1", "transfer", `{"amount":5}`
+	choices := []asteroid.AsteroidChoice{{
+		AsteroidId:   choiceId.String(),
+		FinishReason: asteroid.ToolCalls,
+		Message: asteroid.AsteroidMessage{
+			Id:        &messageId,
+			Role:      asteroid.AsteroidMessageRoleAssistant,
+			Content:   "Paying the invoice",
+			ToolCalls: &[]asteroid.AsteroidToolCall{{Id: toolCallId, ToolId: *tool.Id, CallId: &callId, Name: &name, Arguments: &arguments}},
+		},
+	}}
+	usage := asteroid.ChatUsage{Model: "gpt-4o", PromptTokens: 10, CompletionTokens: 5}
+	_, err = store.CreateChatRequest(ctx, runId, []byte(`{"messages":[]}`), []byte(`{"choices":[]}`), choices, "openai", nil, usage)
+	checkSQLite(t, "creating chat", err)
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	checkSQLite(t, "getting tool call", err)
+	if toolCall == nil || toolCall.ToolId != *tool.Id {
+		t.Fatalf("got tool call %v, want one of tool %s", toolCall, *tool.Id)
+	}
+	count, err := store.GetRunChatCount(ctx, runId)
+	checkSQLite(t, "counting chats", err)
+	if count != 1 {
+		t.Errorf("got %d chats, want 1", count)
+	}
+
+	requestId, err := store.CreateSupervisionRequest(ctx, asteroid.SupervisionRequest{SupervisorId: supervisorId}, *chainId, toolCallId)
+	checkSQLite(t, "creating supervision request", err)
+	pending, err := store.CountSupervisionRequests(ctx, asteroid.Pending)
+	checkSQLite(t, "counting supervision requests", err)
+	if pending != 1 {
+		t.Errorf("got %d pending supervision requests, want 1", pending)
+	}
+
+	result := asteroid.SupervisionResult{
+		SupervisionRequestId: *requestId,
+		Decision:             asteroid.Approve,
+		Reasoning:            "The invoice is due",
+		ToolcallId:           &toolCallId,
+		CreatedAt:            now,
+	}
+	resultId, err := store.CreateSupervisionResult(ctx, result, *requestId)
+	checkSQLite(t, "creating supervision result", err)
+	if _, err := store.CreateSupervisionResult(ctx, result, *requestId); !errors.Is(err, asteroid.ErrSupervisionRequestResolved) {
+		t.Errorf("got error %v creating a second result, want %v", err, asteroid.ErrSupervisionRequestResolved)
+	}
+	stored, err := store.GetSupervisionResultFromRequestID(ctx, *requestId)
+	checkSQLite(t, "getting supervision result", err)
+	if stored == nil || stored.Decision != asteroid.Approve {
+		t.Fatalf("got result %v, want an approval", stored)
+	}
+
+	_, err = store.GetConfidenceOutcomes(ctx, supervisorId)
+	checkSQLite(t, "getting confidence outcomes", err)
+	_, err = store.GetPromptVariantOutcomes(ctx, supervisorId)
+	checkSQLite(t, "getting prompt variant outcomes", err)
+	precedents, err := store.GetPrecedentDecisions(ctx, project.Id, []uuid.UUID{*resultId})
+	checkSQLite(t, "getting precedent decisions", err)
+	if len(precedents) != 1 {
+		t.Errorf("got %d precedent decisions, want 1", len(precedents))
+	}
+	_, err = store.GetCitingDecisions(ctx, project.Id)
+	checkSQLite(t, "getting citing decisions", err)
+
+	hits, err := store.Search(ctx, "invoice", &project.Id, nil, 10)
+	checkSQLite(t, "searching", err)
+	if len(hits) == 0 {
+		t.Error("search for invoice found nothing")
+	}
+
+	paused, err := store.PauseRun(ctx, asteroid.RunPause{RunId: runId, PausedAt: now, PausedBy: "smoke", PreviousStatus: asteroid.Pending})
+	checkSQLite(t, "pausing run", err)
+	resumed, err := store.ResumeRun(ctx, runId, asteroid.Pending, now.Add(time.Minute))
+	checkSQLite(t, "resuming run", err)
+	if !paused || !resumed {
+		t.Errorf("run paused %v and resumed %v, want both", paused, resumed)
+	}
+
+	timer := asteroid.DurableTimer{Id: uuid.New(), Kind: asteroid.DecisionTimeout, SupervisionRequestId: *requestId, FireAt: now.Add(-time.Second), CreatedAt: now}
+	checkSQLite(t, "creating timer", store.CreateTimer(ctx, timer))
+	due, err := store.GetDueTimers(ctx, now)
+	checkSQLite(t, "getting due timers", err)
+	if len(due) != 1 || due[0].Id != timer.Id {
+		t.Errorf("got due timers %v, want the timer", due)
+	}
+	checkSQLite(t, "marking timer fired", store.MarkTimerFired(ctx, timer.Id, now))
+	if due, err := store.GetDueTimers(ctx, now); err != nil || len(due) != 0 {
+		t.Errorf("got due timers %v and error %v after the timer fired, want none", due, err)
+	}
+
+	webhook := asteroid.Webhook{Id: uuid.New(), ProjectId: project.Id, Url: "https://example.com/hook", Events: []asteroid.WebhookEvent{asteroid.DecisionMade}, Enabled: true, CreatedAt: now}
+	checkSQLite(t, "creating webhook", store.CreateWebhook(ctx, webhook, "secret"))
+	webhooks, err := store.GetEventWebhooks(ctx, project.Id, asteroid.DecisionMade)
+	checkSQLite(t, "getting event webhooks", err)
+	if len(webhooks) != 1 {
+		t.Errorf("got %d webhooks sent decisions, want 1", len(webhooks))
+	}
+	if webhooks, err := store.GetEventWebhooks(ctx, project.Id, asteroid.RunCompleted); err != nil || len(webhooks) != 0 {
+		t.Errorf("got webhooks %v and error %v sent completed runs, want none", webhooks, err)
+	}
+
+	subscription := asteroid.WatchSubscription{Id: uuid.New(), Session: "reviewer", Events: []asteroid.WatchEvent{asteroid.NewToolCall}, RunId: &runId, CreatedAt: now}
+	checkSQLite(t, "creating watch subscription", store.CreateWatchSubscription(ctx, subscription))
+	subscriptions, err := store.GetMatchingWatchSubscriptions(ctx, asteroid.NewToolCall, runId, nil, &project.Id, &name)
+	checkSQLite(t, "getting matching watch subscriptions", err)
+	if len(subscriptions) != 1 {
+		t.Errorf("got %d subscriptions watching the run, want 1", len(subscriptions))
+	}
+
+	lease, err := store.AcquireWorkerLease(ctx, "timers", "a", time.Minute)
+	checkSQLite(t, "acquiring worker lease", err)
+	taken, err := store.AcquireWorkerLease(ctx, "timers", "b", time.Minute)
+	checkSQLite(t, "acquiring a held worker lease", err)
+	if lease == nil || taken != nil {
+		t.Errorf("got leases %v and %v, want only the first", lease, taken)
+	}
+
+	event := asteroid.AuditEvent{
+		Id:           uuid.New(),
+		CreatedAt:    now,
+		Actor:        "smoke",
+		Action:       asteroid.AuditActionDecisionRecorded,
+		ResourceType: "supervision_request",
+		ResourceId:   *requestId,
+		Details:      map[string]interface{}{},
+	}
+	checkSQLite(t, "creating audit event", store.CreateAuditEvent(ctx, event))
+	events, err := store.GetAuditChain(ctx, 0, 10)
+	checkSQLite(t, "getting audit chain", err)
+	if len(events) != 1 || events[0].Sequence != 1 {
+		t.Errorf("got audit chain %v, want the event first", events)
+	}
+
+	runsToday, err := store.GetQuotaUsage(ctx, "project", project.Id, asteroid.RunsPerDay, now.Add(-time.Hour))
+	checkSQLite(t, "getting quota usage", err)
+	if runsToday != 1 {
+		t.Errorf("got %d runs in the last hour, want 1", runsToday)
+	}
+
+	checkSQLite(t, "completing run", store.UpdateRunStatus(ctx, runId, asteroid.Completed))
+	run, err = store.GetRun(ctx, runId)
+	checkSQLite(t, "getting completed run", err)
+	if run == nil || run.Status == nil || *run.Status != asteroid.Completed {
+		t.Errorf("got run %v, want a completed run", run)
+	}
+}
@@ -0,0 +1,27 @@
+package database
+
+import (
+	"fmt"
+	"os"
+
+	asteroid "github.com/asteroidai/asteroid/server"
+)
+
+// ClosableStore is a store that holds connections until it's closed
+type ClosableStore interface {
+	asteroid.Store
+	Close() error
+}
+
+// NewStoreFromEnv opens the store of the backend set in STORE_BACKEND, postgres unless set. sqlite is
+// an embedded store in a file, for deployments without a database server.
+func NewStoreFromEnv() (ClosableStore, error) {
+	switch backend := os.Getenv("STORE_BACKEND"); backend {
+	case "", "postgres":
+		return NewPostgresqlStore()
+	case "sqlite":
+		return NewSQLiteStore()
+	default:
+		return nil, fmt.Errorf("unknown STORE_BACKEND %q, supported backends: postgres, sqlite", backend)
+	}
+}
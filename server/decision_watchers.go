@@ -0,0 +1,118 @@
+package asteroid
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// decisionStore tells the hub of every supervision result stored. Results are stored by handlers,
+// supervisors and workers that only have the store, so the hub hears of them here rather than from
+// each of them.
+type decisionStore struct {
+	Store
+	hub *Hub
+}
+
+func (s decisionStore) CreateSupervisionResult(ctx context.Context, result SupervisionResult, requestId uuid.UUID) (*uuid.UUID, error) {
+	id, err := s.Store.CreateSupervisionResult(ctx, result, requestId)
+	if err == nil {
+		s.resolved(ctx, requestId)
+	}
+	return id, err
+}
+
+func (s decisionStore) CreateSupervisionResults(ctx context.Context, results []SupervisionResult) ([]uuid.UUID, error) {
+	ids, err := s.Store.CreateSupervisionResults(ctx, results)
+	if err == nil {
+		for _, result := range results {
+			s.resolved(ctx, result.SupervisionRequestId)
+		}
+	}
+	return ids, err
+}
+
+// resolved tells the hub the tool call of a supervision request may have been decided. Failing to
+// doesn't fail the result, which is already stored, so errors are only logged.
+func (s decisionStore) resolved(ctx context.Context, requestId uuid.UUID) {
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, requestId, s.Store)
+	if err != nil {
+		log.Printf("Error getting tool call of supervision request %s for decision watchers: %v", requestId, err)
+		return
+	}
+	if toolCallId != nil {
+		s.hub.toolCallResolved(*toolCallId)
+	}
+}
+
+// decisionWatcher is told which of the tool calls it watches had a supervision request resolved, on
+// any replica. notify is signalled when there are some it hasn't taken yet.
+type decisionWatcher struct {
+	notify   chan struct{}
+	mu       sync.Mutex
+	resolved map[uuid.UUID]bool
+}
+
+func newDecisionWatcher() *decisionWatcher {
+	return &decisionWatcher{notify: make(chan struct{}, 1), resolved: make(map[uuid.UUID]bool)}
+}
+
+// take returns the tool calls resolved since it was last called
+func (w *decisionWatcher) take() map[uuid.UUID]bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	resolved := w.resolved
+	w.resolved = make(map[uuid.UUID]bool)
+	return resolved
+}
+
+func (w *decisionWatcher) add(toolCallId uuid.UUID) {
+	w.mu.Lock()
+	w.resolved[toolCallId] = true
+	w.mu.Unlock()
+
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+// watchDecision tells a watcher whenever a supervision request of a tool call is resolved. Tool
+// calls are watched before they're first checked, so a decision made in between isn't missed.
+func (h *Hub) watchDecision(watcher *decisionWatcher, toolCallId uuid.UUID) {
+	h.decisionWatchersMutex.Lock()
+	defer h.decisionWatchersMutex.Unlock()
+
+	if _, exists := h.decisionWatchers[toolCallId]; !exists {
+		h.decisionWatchers[toolCallId] = make(map[*decisionWatcher]bool)
+	}
+	h.decisionWatchers[toolCallId][watcher] = true
+}
+
+func (h *Hub) unwatchDecision(watcher *decisionWatcher, toolCallId uuid.UUID) {
+	h.decisionWatchersMutex.Lock()
+	defer h.decisionWatchersMutex.Unlock()
+
+	delete(h.decisionWatchers[toolCallId], watcher)
+	if len(h.decisionWatchers[toolCallId]) == 0 {
+		delete(h.decisionWatchers, toolCallId)
+	}
+}
+
+// toolCallResolved tells the watchers of a tool call, on every replica, that one of its supervision
+// requests was resolved
+func (h *Hub) toolCallResolved(toolCallId uuid.UUID) {
+	h.toolCallResolvedLocally(toolCallId)
+	h.publish(hubMessage{Kind: decidedMessage, ToolCallId: &toolCallId})
+}
+
+func (h *Hub) toolCallResolvedLocally(toolCallId uuid.UUID) {
+	h.decisionWatchersMutex.Lock()
+	defer h.decisionWatchersMutex.Unlock()
+
+	for watcher := range h.decisionWatchers[toolCallId] {
+		watcher.add(toolCallId)
+	}
+}
@@ -0,0 +1,222 @@
+package asteroid
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"hash/fnv"
+	"log"
+	"math/rand"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// demoMode replaces the content of every response with fake data, so a real deployment can be
+// demoed or screenshotted without leaking anything. Set with DEMO_MODE=true.
+var demoMode atomic.Bool
+
+// demoKeptKeys are the fields whose values are structure rather than content, like statuses and
+// decisions, which demo mode leaves alone. IDs and timestamps are kept wherever they are.
+var demoKeptKeys = map[string]bool{
+	"status":              true,
+	"decision":            true,
+	"type":                true,
+	"role":                true,
+	"finish_reason":       true,
+	"action":              true,
+	"resource_type":       true,
+	"assignment_strategy": true,
+	"risk_tier":           true,
+	"min_risk_tier":       true,
+	"reversibility":       true,
+	"scopes":              true,
+	"metric":              true,
+	"aggregation":         true,
+	"expected_decision":   true,
+	"likely_decision":     true,
+	"overridden_decision": true,
+	"winning_decision":    true,
+	"attempted_decision":  true,
+	"language":            true,
+	"events":              true,
+	"behavior":            true,
+}
+
+// demoWords are the words fake text is made of, by length
+var demoWords = map[int][]string{
+	1: {"a"},
+	2: {"an", "in", "on", "to", "by", "of", "at", "up"},
+	3: {"the", "new", "run", "key", "map", "log", "box", "set", "sum", "row"},
+	4: {"data", "item", "task", "file", "list", "node", "plan", "user", "page", "mail"},
+	5: {"order", "value", "query", "agent", "event", "table", "batch", "field", "token", "stock"},
+	6: {"report", "record", "client", "amount", "search", "update", "review", "status", "ticket", "vendor"},
+	7: {"account", "invoice", "message", "product", "project", "request", "summary", "payment", "student", "weather"},
+	8: {"customer", "document", "schedule", "shipment", "analysis", "response", "database", "calendar", "contract", "transfer"},
+	9: {"inventory", "reference", "statement", "workspace", "operation", "marketing", "portfolio", "character", "complaint", "condition"},
+}
+
+// configureDemoMode reads whether demo mode is on from the environment
+func configureDemoMode() {
+	demoMode.Store(os.Getenv("DEMO_MODE") == "true")
+	if demoMode.Load() {
+		log.Println("Demo mode is on, responses contain fake data")
+	}
+}
+
+// redactForDemo returns fake data with the structure of a response in demo mode, and the response itself otherwise
+func redactForDemo(data interface{}) interface{} {
+	if !demoMode.Load() || data == nil {
+		return data
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return data
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return data
+	}
+
+	return fakeValue(value)
+}
+
+// redactedForDemo is redactForDemo for responses that aren't sent as JSON, returning the fake data as
+// the response's own type
+func redactedForDemo[T any](data T) T {
+	if !demoMode.Load() {
+		return data
+	}
+
+	encoded, err := json.Marshal(redactForDemo(data))
+	if err != nil {
+		return data
+	}
+
+	var redacted T
+	if err := json.Unmarshal(encoded, &redacted); err != nil {
+		return data
+	}
+	return redacted
+}
+
+// fakeValue replaces the content of a decoded JSON value, keeping its shape, numbers and booleans
+func fakeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if !demoKeptKeys[key] {
+				v[key] = fakeValue(field)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = fakeValue(item)
+		}
+		return v
+	case string:
+		return fakeString(v)
+	default:
+		return v
+	}
+}
+
+// fakeString replaces the content of a string. IDs and timestamps are kept, JSON keeps its keys and
+// base64 encoded JSON stays decodable.
+func fakeString(s string) string {
+	if _, err := uuid.Parse(s); err == nil {
+		return s
+	}
+	if _, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return s
+	}
+
+	trimmed := strings.TrimSpace(s)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		if faked, ok := fakeJSON([]byte(s)); ok {
+			return string(faked)
+		}
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(s); err == nil && json.Valid(decoded) {
+		if faked, ok := fakeJSON(decoded); ok {
+			return base64.StdEncoding.EncodeToString(faked)
+		}
+	}
+
+	return fakeText(s)
+}
+
+// fakeJSON replaces the content of a JSON document
+func fakeJSON(data []byte) ([]byte, bool) {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false
+	}
+
+	faked, err := json.Marshal(fakeValue(value))
+	if err != nil {
+		return nil, false
+	}
+	return faked, true
+}
+
+// fakeText replaces every word and number of a text with a fake one of the same length, keeping
+// punctuation, whitespace and capitalization. The same text always gets the same replacement, so
+// the same value reads the same wherever it's shown.
+func fakeText(s string) string {
+	hash := fnv.New64a()
+	hash.Write([]byte(s))
+	random := rand.New(rand.NewSource(int64(hash.Sum64())))
+
+	runes := []rune(s)
+	var faked strings.Builder
+	for i := 0; i < len(runes); {
+		switch {
+		case unicode.IsLetter(runes[i]):
+			j := i
+			for j < len(runes) && unicode.IsLetter(runes[j]) {
+				j++
+			}
+			faked.WriteString(fakeWord(runes[i:j], random))
+			i = j
+		case unicode.IsDigit(runes[i]):
+			faked.WriteRune(rune('0' + random.Intn(10)))
+			i++
+		default:
+			faked.WriteRune(runes[i])
+			i++
+		}
+	}
+	return faked.String()
+}
+
+// fakeWord returns a word of the same length and capitalization as another
+func fakeWord(word []rune, random *rand.Rand) string {
+	var fake []rune
+	if words, ok := demoWords[len(word)]; ok {
+		fake = []rune(words[random.Intn(len(words))])
+	} else {
+		fake = make([]rune, len(word))
+		for i := range fake {
+			fake[i] = rune('a' + random.Intn(26))
+		}
+	}
+
+	for i, r := range word {
+		if unicode.IsUpper(r) {
+			fake[i] = unicode.ToUpper(fake[i])
+		}
+	}
+	return string(fake)
+}
@@ -0,0 +1,463 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxDependencyGraphSize bounds how many tool calls we'll walk when building a dependency graph
+const maxDependencyGraphSize = 500
+
+// getToolCallDecision returns the overall outcome of a tool call's supervision, or nil if it hasn't
+// been decided yet. A rejection in any chain rejects the tool call.
+func getToolCallDecision(ctx context.Context, toolCallId uuid.UUID, store Store) (*Decision, error) {
+	decision, _, err := decideToolCall(ctx, toolCallId, store)
+	return decision, err
+}
+
+// decideToolCall returns the overall outcome of a tool call's supervision along with the result of
+// the chain that rejected it, if one did, or else of the chain that modified it, which holds the
+// arguments to execute it with. Chains the run's autonomy level skips don't count.
+func decideToolCall(ctx context.Context, toolCallId uuid.UUID, store Store) (*Decision, *SupervisionResult, error) {
+	chainExecutions, err := store.GetChainExecutionsFromToolCall(ctx, toolCallId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting chain executions: %w", err)
+	}
+
+	// A tool call without any supervisor chains is never reviewed, so nothing waits on it
+	decision := Approve
+	if len(chainExecutions) == 0 {
+		return &decision, nil, nil
+	}
+
+	states := make([]ChainExecutionState, 0, len(chainExecutions))
+	chains := make([]SupervisorChain, 0, len(chainExecutions))
+	for _, execution := range chainExecutions {
+		state, err := store.GetChainExecutionState(ctx, execution)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting chain state: %w", err)
+		}
+		states = append(states, *state)
+		chains = append(chains, state.Chain)
+	}
+
+	selected, err := selectedChainIds(ctx, toolCallId, chains, store)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error selecting chains: %w", err)
+	}
+
+	decided := true
+	var modified *SupervisionResult
+	for _, state := range states {
+		if selected != nil && !selected[state.Chain.ChainId] {
+			continue
+		}
+
+		if determineChainStatus(state.SupervisionRequests, state.Chain) != Completed {
+			decided = false
+			continue
+		}
+
+		// The chain's outcome is the result of its last completed supervisor
+		var last *SupervisionResult
+		highestPosition := -1
+		for _, request := range state.SupervisionRequests {
+			if request.Result != nil && request.SupervisionRequest.PositionInChain > highestPosition {
+				highestPosition = request.SupervisionRequest.PositionInChain
+				last = request.Result
+			}
+		}
+		if last == nil {
+			continue
+		}
+
+		switch last.Decision {
+		case Reject, Terminate:
+			return &last.Decision, last, nil
+		case Modify:
+			decision = Modify
+			modified = last
+		}
+	}
+
+	if !decided {
+		return nil, nil, nil
+	}
+
+	return &decision, modified, nil
+}
+
+// checkToolCallDependencies reports whether a tool call can be reviewed yet. It returns the first
+// dependency that was rejected, or the dependencies that are still waiting on a decision.
+func checkToolCallDependencies(ctx context.Context, toolCallId uuid.UUID, store Store) (*uuid.UUID, []uuid.UUID, error) {
+	dependencies, err := store.GetToolCallDependencies(ctx, toolCallId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting tool call dependencies: %w", err)
+	}
+
+	undecided := make([]uuid.UUID, 0)
+	for _, dependency := range dependencies {
+		decision, err := getToolCallDecision(ctx, dependency, store)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if decision == nil {
+			undecided = append(undecided, dependency)
+			continue
+		}
+
+		if *decision == Reject || *decision == Terminate {
+			return &dependency, nil, nil
+		}
+	}
+
+	return nil, undecided, nil
+}
+
+// rejectPendingSupervisionRequests rejects every supervision request of a tool call that doesn't have
+// a result yet, because a tool call it depends on was rejected
+func rejectPendingSupervisionRequests(ctx context.Context, toolCallId uuid.UUID, rejectedDependency uuid.UUID, store Store) error {
+	chainExecutions, err := store.GetChainExecutionsFromToolCall(ctx, toolCallId)
+	if err != nil {
+		return fmt.Errorf("error getting chain executions: %w", err)
+	}
+
+	for _, execution := range chainExecutions {
+		state, err := store.GetChainExecutionState(ctx, execution)
+		if err != nil {
+			return fmt.Errorf("error getting chain state: %w", err)
+		}
+
+		for _, request := range state.SupervisionRequests {
+			if request.Result != nil || request.Status.Status == Completed {
+				continue
+			}
+
+			result := SupervisionResult{
+				CreatedAt:            time.Now(),
+				Decision:             Reject,
+				Reasoning:            fmt.Sprintf("Rejected automatically because tool call %s, which this tool call depends on, was rejected", rejectedDependency),
+				SupervisionRequestId: *request.SupervisionRequest.Id,
+			}
+
+			// A decision that beat us to it stands, so a conflict isn't an error here
+			if _, _, err := resolveSupervisionRequest(ctx, *request.SupervisionRequest.Id, result, SystemActor, store); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// propagateRejection rejects the pending supervision requests of every tool call that directly or
+// transitively depends on a rejected tool call
+func propagateRejection(ctx context.Context, toolCallId uuid.UUID, store Store) error {
+	visited := map[uuid.UUID]bool{toolCallId: true}
+	queue := []uuid.UUID{toolCallId}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		dependents, err := store.GetToolCallDependents(ctx, current)
+		if err != nil {
+			return fmt.Errorf("error getting tool call dependents: %w", err)
+		}
+
+		for _, dependent := range dependents {
+			if visited[dependent] {
+				continue
+			}
+			visited[dependent] = true
+
+			if err := rejectPendingSupervisionRequests(ctx, dependent, current, store); err != nil {
+				return err
+			}
+			queue = append(queue, dependent)
+		}
+	}
+
+	return nil
+}
+
+// getToolCallForSupervisionRequest returns the ID of the tool call a supervision request is for
+func getToolCallForSupervisionRequest(ctx context.Context, supervisionRequestId uuid.UUID, store Store) (*uuid.UUID, error) {
+	supervisionRequest, err := store.GetSupervisionRequest(ctx, supervisionRequestId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting supervision request: %w", err)
+	}
+	if supervisionRequest == nil || supervisionRequest.ChainexecutionId == nil {
+		return nil, nil
+	}
+
+	_, toolCallId, err := store.GetChainExecution(ctx, *supervisionRequest.ChainexecutionId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chain execution: %w", err)
+	}
+
+	return toolCallId, nil
+}
+
+// buildDependencyGraph collects every tool call connected to the given one through dependencies in
+// either direction. Returns nil if the tool call has no dependencies or dependents.
+func buildDependencyGraph(ctx context.Context, toolCallId uuid.UUID, store Store) (*ToolCallDependencyGraph, error) {
+	graph := ToolCallDependencyGraph{
+		ToolCallId: toolCallId,
+		Nodes:      make([]ToolCallDependencyNode, 0),
+		Edges:      make([]ToolCallDependencyEdge, 0),
+	}
+
+	visited := map[uuid.UUID]bool{toolCallId: true}
+	queue := []uuid.UUID{toolCallId}
+
+	for len(queue) > 0 && len(graph.Nodes) < maxDependencyGraphSize {
+		current := queue[0]
+		queue = queue[1:]
+
+		node, err := buildDependencyNode(ctx, current, store)
+		if err != nil {
+			return nil, err
+		}
+		graph.Nodes = append(graph.Nodes, *node)
+
+		dependencies, err := store.GetToolCallDependencies(ctx, current)
+		if err != nil {
+			return nil, fmt.Errorf("error getting tool call dependencies: %w", err)
+		}
+
+		// Edges are recorded from the dependent side only, so each one appears once
+		for _, dependency := range dependencies {
+			graph.Edges = append(graph.Edges, ToolCallDependencyEdge{ToolCallId: current, DependsOnToolCallId: dependency})
+			if !visited[dependency] {
+				visited[dependency] = true
+				queue = append(queue, dependency)
+			}
+		}
+
+		dependents, err := store.GetToolCallDependents(ctx, current)
+		if err != nil {
+			return nil, fmt.Errorf("error getting tool call dependents: %w", err)
+		}
+
+		for _, dependent := range dependents {
+			if !visited[dependent] {
+				visited[dependent] = true
+				queue = append(queue, dependent)
+			}
+		}
+	}
+
+	if len(graph.Edges) == 0 {
+		return nil, nil
+	}
+
+	return &graph, nil
+}
+
+func buildDependencyNode(ctx context.Context, toolCallId uuid.UUID, store Store) (*ToolCallDependencyNode, error) {
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool call: %w", err)
+	}
+	if toolCall == nil {
+		return nil, fmt.Errorf("tool call %s not found", toolCallId)
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool: %w", err)
+	}
+
+	node := ToolCallDependencyNode{ToolCallId: toolCallId}
+	if tool != nil {
+		node.Name = tool.Name
+	}
+
+	node.Status, err = getToolCallStatus(ctx, toolCallId, store)
+	if err != nil {
+		return nil, err
+	}
+
+	node.Decision, err = getToolCallDecision(ctx, toolCallId, store)
+	if err != nil {
+		return nil, err
+	}
+
+	return &node, nil
+}
+
+// dependsOn reports whether target can be reached from start by following dependencies
+func dependsOn(ctx context.Context, start uuid.UUID, target uuid.UUID, store Store) (bool, error) {
+	visited := map[uuid.UUID]bool{start: true}
+	queue := []uuid.UUID{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current == target {
+			return true, nil
+		}
+
+		dependencies, err := store.GetToolCallDependencies(ctx, current)
+		if err != nil {
+			return false, fmt.Errorf("error getting tool call dependencies: %w", err)
+		}
+
+		for _, dependency := range dependencies {
+			if !visited[dependency] {
+				visited[dependency] = true
+				queue = append(queue, dependency)
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func apiGetToolCallDependenciesHandler(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
+		return
+	}
+
+	if toolCall == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call not found", "")
+		return
+	}
+
+	graph, err := buildDependencyGraph(ctx, toolCallId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error building dependency graph", err.Error())
+		return
+	}
+
+	if graph == nil {
+		// A tool call without dependencies is a graph of one
+		node, err := buildDependencyNode(ctx, toolCallId, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error building dependency graph", err.Error())
+			return
+		}
+
+		graph = &ToolCallDependencyGraph{
+			ToolCallId: toolCallId,
+			Nodes:      []ToolCallDependencyNode{*node},
+			Edges:      []ToolCallDependencyEdge{},
+		}
+	}
+
+	respondJSON(w, graph, http.StatusOK)
+}
+
+func apiSetToolCallDependenciesHandler(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request SetToolCallDependenciesJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
+		return
+	}
+
+	if toolCall == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call not found", "")
+		return
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool", err.Error())
+		return
+	}
+
+	if tool == nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "can't find run ID from tool", "")
+		return
+	}
+
+	dependsOnIds := make([]uuid.UUID, 0, len(request.DependsOn))
+	seen := make(map[uuid.UUID]bool)
+	for _, dependencyId := range request.DependsOn {
+		if seen[dependencyId] {
+			continue
+		}
+		seen[dependencyId] = true
+
+		if dependencyId == toolCallId {
+			sendErrorResponse(w, http.StatusBadRequest, "a tool call can't depend on itself", "")
+			return
+		}
+
+		dependency, err := store.GetToolCall(ctx, dependencyId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
+			return
+		}
+
+		if dependency == nil {
+			sendErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Tool call %s not found", dependencyId), "")
+			return
+		}
+
+		// Dependencies only make sense within the same batch of calls, which is always in a single run
+		dependencyTool, err := store.GetTool(ctx, dependency.ToolId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting tool", err.Error())
+			return
+		}
+
+		if dependencyTool == nil || dependencyTool.RunId != tool.RunId {
+			sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("tool call %s is not in run %s", dependencyId, tool.RunId), "")
+			return
+		}
+
+		cycle, err := dependsOn(ctx, dependencyId, toolCallId, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error checking for dependency cycles", err.Error())
+			return
+		}
+
+		if cycle {
+			sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("depending on tool call %s would create a cycle", dependencyId), "")
+			return
+		}
+
+		dependsOnIds = append(dependsOnIds, dependencyId)
+	}
+
+	if err := store.SetToolCallDependencies(ctx, toolCallId, dependsOnIds); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting tool call dependencies", err.Error())
+		return
+	}
+
+	// A dependency may already have been rejected, in which case this call never gets reviewed
+	rejected, _, err := checkToolCallDependencies(ctx, toolCallId, store)
+	if err != nil {
+		log.Printf("Error checking dependencies of tool call %s: %v", toolCallId, err)
+	} else if rejected != nil {
+		if err := rejectPendingSupervisionRequests(ctx, toolCallId, *rejected, store); err != nil {
+			log.Printf("Error rejecting tool call %s: %v", toolCallId, err)
+		} else if err := propagateRejection(ctx, toolCallId, store); err != nil {
+			log.Printf("Error propagating rejection of tool call %s: %v", toolCallId, err)
+		}
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
@@ -0,0 +1,148 @@
+package asteroid
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const (
+	// diagnosticsWebhookWindow is how far back webhook deliveries are counted
+	diagnosticsWebhookWindow = time.Hour
+	// diagnosticsPings is how many round trips to the database are timed
+	diagnosticsPings = 20
+)
+
+// failureRate returns the share of attempted deliveries that failed their last attempt. Deliveries
+// still queued without an attempt haven't failed yet.
+func failureRate(retrying, delivered, abandoned int) float64 {
+	attempted := retrying + delivered + abandoned
+	if attempted == 0 {
+		return 0
+	}
+	return float64(retrying+abandoned) / float64(attempted)
+}
+
+// getWebhookDiagnostics adds up the webhook deliveries of the past window
+func getWebhookDiagnostics(ctx context.Context, now time.Time, store Store) (*WebhookDiagnostics, error) {
+	webhooks, err := store.GetWebhookDeliveryStats(ctx, now.Add(-diagnosticsWebhookWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics := WebhookDiagnostics{WindowSeconds: int(diagnosticsWebhookWindow.Seconds()), Webhooks: webhooks}
+	retrying := 0
+	for i, webhook := range webhooks {
+		webhooks[i].FailureRate = failureRate(webhook.Retrying, webhook.Delivered, webhook.Abandoned)
+		diagnostics.Queued += webhook.Queued
+		diagnostics.Delivered += webhook.Delivered
+		diagnostics.Abandoned += webhook.Abandoned
+		retrying += webhook.Retrying
+	}
+	diagnostics.FailureRate = failureRate(retrying, diagnostics.Delivered, diagnostics.Abandoned)
+
+	slices.SortStableFunc(webhooks, func(a, b WebhookDeliveryStats) int {
+		switch {
+		case a.FailureRate > b.FailureRate:
+			return -1
+		case a.FailureRate < b.FailureRate:
+			return 1
+		}
+		return 0
+	})
+	return &diagnostics, nil
+}
+
+// percentile returns the nearest-rank percentile of sorted durations in milliseconds
+func percentile(sorted []time.Duration, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return float64(sorted[max(rank, 1)-1].Microseconds()) / 1000
+}
+
+// measureDatabaseLatency times round trips to the database one after another
+func measureDatabaseLatency(ctx context.Context, store Store) DatabaseLatency {
+	latency := DatabaseLatency{Samples: diagnosticsPings}
+	durations := make([]time.Duration, 0, diagnosticsPings)
+	for range diagnosticsPings {
+		start := time.Now()
+		if err := store.Ping(ctx); err != nil {
+			latency.Failed++
+			continue
+		}
+		durations = append(durations, time.Since(start))
+	}
+
+	slices.Sort(durations)
+	latency.P50Ms = percentile(durations, 50)
+	latency.P95Ms = percentile(durations, 95)
+	latency.P99Ms = percentile(durations, 99)
+	latency.MaxMs = percentile(durations, 100)
+	return latency
+}
+
+// diagnostics counts the hub's connections and the reviews assigned to its sessions
+func (h *Hub) diagnostics() HubDiagnostics {
+	diagnostics := HubDiagnostics{
+		ReviewChannelDepth:    len(h.ReviewChan),
+		ReviewChannelCapacity: cap(h.ReviewChan),
+		RealtimeChannelDepth:  len(h.RealtimeReviewChan),
+		Backplane:             h.backplane != nil,
+	}
+
+	h.ClientsMutex.RLock()
+	diagnostics.Clients = len(h.Clients)
+	diagnostics.Sessions = len(h.Sessions)
+	h.ClientsMutex.RUnlock()
+
+	h.AssignedReviewsMutex.RLock()
+	for _, reviews := range h.AssignedReviews {
+		diagnostics.AssignedReviews += len(reviews)
+	}
+	h.AssignedReviewsMutex.RUnlock()
+
+	return diagnostics
+}
+
+func apiGetDiagnosticsHandler(w http.ResponseWriter, r *http.Request, hub *Hub, lanes *PriorityLanes, workers *Workers, repairer *StuckExecutionRepairer, store Store) {
+	ctx := r.Context()
+	now := time.Now()
+
+	queues, err := getQueueStats(ctx, lanes, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting queue stats", err.Error())
+		return
+	}
+
+	webhooks, err := getWebhookDiagnostics(ctx, now, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting webhook delivery stats", err.Error())
+		return
+	}
+
+	diagnostics := Diagnostics{
+		InstanceId:      workers.instance,
+		GeneratedAt:     now,
+		Queues:          queues,
+		Webhooks:        *webhooks,
+		Database:        measureDatabaseLatency(ctx, store),
+		Hub:             hub.diagnostics(),
+		StuckExecutions: repairer.report(),
+	}
+
+	for _, queue := range queues {
+		if queue.OldestPendingAt == nil {
+			continue
+		}
+		waited := now.Sub(*queue.OldestPendingAt).Seconds()
+		if diagnostics.OldestPendingReviewSeconds == nil || waited > *diagnostics.OldestPendingReviewSeconds {
+			diagnostics.OldestPendingReviewSeconds = &waited
+		}
+	}
+
+	respondJSON(w, diagnostics, http.StatusOK)
+}
@@ -0,0 +1,198 @@
+package asteroid
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// maxDiffCells bounds the size of the LCS table so a huge message can't exhaust memory.
+// Beyond it we fall back to a single delete + insert.
+const maxDiffCells = 4_000_000
+
+// TokenizeWords splits text into alternating runs of word characters, whitespace and
+// punctuation, so that joining the tokens gives back the original text exactly
+func TokenizeWords(text string) []string {
+	tokens := make([]string, 0)
+	runes := []rune(text)
+
+	class := func(r rune) int {
+		switch {
+		case unicode.IsSpace(r):
+			return 0
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	start := 0
+	for i := 1; i <= len(runes); i++ {
+		// Punctuation is always its own token, other classes are grouped into runs
+		if i == len(runes) || class(runes[i]) != class(runes[start]) || class(runes[start]) == 2 {
+			tokens = append(tokens, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	return tokens
+}
+
+// DiffWords computes a word level diff between two strings
+func DiffWords(original, modified string) []DiffSegment {
+	return DiffTokens(TokenizeWords(original), TokenizeWords(modified))
+}
+
+// DiffTokens computes the shortest edit between two token lists using their longest common
+// subsequence, merging adjacent tokens with the same operation into one segment
+func DiffTokens(a, b []string) []DiffSegment {
+	segments := make([]DiffSegment, 0)
+	appendSegment := func(op DiffOp, text string) {
+		if len(segments) > 0 && segments[len(segments)-1].Op == op {
+			segments[len(segments)-1].Text += text
+			return
+		}
+		segments = append(segments, DiffSegment{Op: op, Text: text})
+	}
+
+	// Strip the common prefix and suffix, which is most of the text for typical edits
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	for _, token := range a[:prefix] {
+		appendSegment(Equal, token)
+	}
+
+	midA := a[prefix : len(a)-suffix]
+	midB := b[prefix : len(b)-suffix]
+
+	if len(midA)*len(midB) > maxDiffCells {
+		for _, token := range midA {
+			appendSegment(Delete, token)
+		}
+		for _, token := range midB {
+			appendSegment(Insert, token)
+		}
+	} else {
+		// lcs[i][j] is the length of the LCS of midA[i:] and midB[j:]
+		lcs := make([][]int, len(midA)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(midB)+1)
+		}
+		for i := len(midA) - 1; i >= 0; i-- {
+			for j := len(midB) - 1; j >= 0; j-- {
+				if midA[i] == midB[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < len(midA) && j < len(midB) {
+			switch {
+			case midA[i] == midB[j]:
+				appendSegment(Equal, midA[i])
+				i++
+				j++
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				appendSegment(Delete, midA[i])
+				i++
+			default:
+				appendSegment(Insert, midB[j])
+				j++
+			}
+		}
+		for ; i < len(midA); i++ {
+			appendSegment(Delete, midA[i])
+		}
+		for ; j < len(midB); j++ {
+			appendSegment(Insert, midB[j])
+		}
+	}
+
+	for _, token := range a[len(a)-suffix:] {
+		appendSegment(Equal, token)
+	}
+
+	return segments
+}
+
+func apiUpdateMessageContentHandler(w http.ResponseWriter, r *http.Request, messageId uuid.UUID, store ChatStore) {
+	ctx := r.Context()
+
+	var request UpdateMessageContentJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	message, err := store.GetMessage(ctx, messageId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting message", err.Error())
+		return
+	}
+
+	if message == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Message not found", "")
+		return
+	}
+
+	modifiedContent := NormalizeContent(request.Content)
+
+	diff := MessageDiff{
+		Id:              uuid.New(),
+		MessageId:       messageId,
+		OriginalContent: message.Content,
+		ModifiedContent: modifiedContent,
+		Segments:        DiffWords(message.Content, modifiedContent),
+		CreatedAt:       time.Now(),
+	}
+
+	message.Content = modifiedContent
+	if message.Type == nil || *message.Type == Text {
+		language := DetectLanguage(modifiedContent)
+		message.Language = &language
+	}
+
+	if err := store.UpdateMessageContent(ctx, *message, diff); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error updating message", err.Error())
+		return
+	}
+
+	respondJSON(w, diff, http.StatusOK)
+}
+
+func apiGetMessageDiffsHandler(w http.ResponseWriter, r *http.Request, messageId uuid.UUID, store ChatStore) {
+	ctx := r.Context()
+
+	message, err := store.GetMessage(ctx, messageId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting message", err.Error())
+		return
+	}
+
+	if message == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Message not found", "")
+		return
+	}
+
+	diffs, err := store.GetMessageDiffs(ctx, messageId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting message diffs", err.Error())
+		return
+	}
+
+	respondJSON(w, diffs, http.StatusOK)
+}
@@ -0,0 +1,227 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxDocumentBytes is the largest document that can be attached to a run
+const maxDocumentBytes = 1 << 20
+
+// ErrNoBlobStore is returned when documents are attached without a blob store configured
+var ErrNoBlobStore = errors.New("no blob store is configured")
+
+// BlobStore holds content too large to keep in the database, like the documents attached to runs
+type BlobStore interface {
+	PutBlob(ctx context.Context, key string, data []byte) error
+	GetBlob(ctx context.Context, key string) ([]byte, error)
+}
+
+// NewBlobStoreFromEnv returns a blob store in the directory BLOB_STORE_DIR, or nil if it isn't set
+func NewBlobStoreFromEnv() (BlobStore, error) {
+	dir := os.Getenv("BLOB_STORE_DIR")
+	if dir == "" {
+		return nil, nil
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("error creating blob store directory: %w", err)
+	}
+
+	return &FilesystemBlobStore{dir: dir}, nil
+}
+
+// FilesystemBlobStore keeps each blob in a file under a directory
+type FilesystemBlobStore struct {
+	dir string
+}
+
+func (s *FilesystemBlobStore) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
+
+func (s *FilesystemBlobStore) PutBlob(_ context.Context, key string, data []byte) error {
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("error creating blob directory: %w", err)
+	}
+
+	// Write to a temporary file first so a blob is never read half written
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return fmt.Errorf("error writing blob: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("error writing blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FilesystemBlobStore) GetBlob(_ context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(s.path(key))
+	if err != nil {
+		return nil, fmt.Errorf("error reading blob: %w", err)
+	}
+	return data, nil
+}
+
+// documentBlobKey is where a document's content is kept in the blob store
+func documentBlobKey(document RunDocument) string {
+	return fmt.Sprintf("documents/%s/%s", document.RunId, document.Id)
+}
+
+// getRunDocumentsWithContent returns the documents attached to a run with their content, optionally
+// only the ones meant for LLM supervisors
+func getRunDocumentsWithContent(ctx context.Context, runId uuid.UUID, forSupervisor bool, store Store, blobs BlobStore) ([]RunDocument, error) {
+	documents, err := store.GetRunDocuments(ctx, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting run documents: %w", err)
+	}
+
+	withContent := make([]RunDocument, 0, len(documents))
+	for _, document := range documents {
+		if forSupervisor && !document.IncludeInSupervisorContext {
+			continue
+		}
+
+		if blobs == nil {
+			return nil, ErrNoBlobStore
+		}
+
+		content, err := blobs.GetBlob(ctx, documentBlobKey(document))
+		if err != nil {
+			return nil, fmt.Errorf("error getting content of document %s: %w", document.Id, err)
+		}
+		text := string(content)
+		document.Content = &text
+		withContent = append(withContent, document)
+	}
+
+	return withContent, nil
+}
+
+func apiAttachRunDocumentHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store, blobs BlobStore) {
+	ctx := r.Context()
+
+	if blobs == nil {
+		sendErrorResponse(w, http.StatusServiceUnavailable, ErrNoBlobStore.Error(), "set BLOB_STORE_DIR to enable documents")
+		return
+	}
+
+	var request AttachRunDocumentJSONBody
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 2*maxDocumentBytes)).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if request.Name == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "name is required", "")
+		return
+	}
+
+	if len(request.Content) > maxDocumentBytes {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("documents can't be larger than %d bytes", maxDocumentBytes), "")
+		return
+	}
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	document := RunDocument{
+		Id:          uuid.New(),
+		RunId:       runId,
+		Name:        request.Name,
+		ContentType: "text/plain",
+		SizeBytes:   int64(len(request.Content)),
+		CreatedAt:   time.Now(),
+	}
+	if request.ContentType != nil && *request.ContentType != "" {
+		document.ContentType = *request.ContentType
+	}
+	if request.IncludeInSupervisorContext != nil {
+		document.IncludeInSupervisorContext = *request.IncludeInSupervisorContext
+	}
+
+	// The content is stored before the document, so there's never a document without content
+	if err := blobs.PutBlob(ctx, documentBlobKey(document), []byte(request.Content)); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error storing document content", err.Error())
+		return
+	}
+
+	if err := store.CreateRunDocument(ctx, document); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating document", err.Error())
+		return
+	}
+
+	respondJSON(w, document, http.StatusCreated)
+}
+
+func apiGetRunDocumentsHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	documents, err := store.GetRunDocuments(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting documents", err.Error())
+		return
+	}
+
+	respondJSON(w, documents, http.StatusOK)
+}
+
+func apiGetRunDocumentHandler(w http.ResponseWriter, r *http.Request, documentId uuid.UUID, store Store, blobs BlobStore) {
+	ctx := r.Context()
+
+	document, err := store.GetRunDocument(ctx, documentId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting document", err.Error())
+		return
+	}
+
+	if document == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Document not found", "")
+		return
+	}
+
+	if blobs == nil {
+		sendErrorResponse(w, http.StatusServiceUnavailable, ErrNoBlobStore.Error(), "set BLOB_STORE_DIR to enable documents")
+		return
+	}
+
+	content, err := blobs.GetBlob(ctx, documentBlobKey(*document))
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting document content", err.Error())
+		return
+	}
+	text := string(content)
+	document.Content = &text
+
+	respondJSON(w, document, http.StatusOK)
+}
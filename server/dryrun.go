@@ -0,0 +1,233 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+// maxDryRunToolCalls caps how many tool calls one dry run evaluates
+const maxDryRunToolCalls = 10_000
+
+// dryRunRule is a DryRunRule with its pattern compiled
+type dryRunRule struct {
+	DryRunRule
+	pattern *regexp.Regexp
+}
+
+// compileDryRunRules validates a candidate's rules and compiles their patterns
+func compileDryRunRules(rules []DryRunRule) ([]dryRunRule, error) {
+	compiled := make([]dryRunRule, 0, len(rules))
+	for i, rule := range rules {
+		if rule.ToolName == "" {
+			return nil, fmt.Errorf("rule %d has no tool name", i)
+		}
+
+		switch rule.Decision {
+		case Approve, Reject, Terminate, Escalate:
+		default:
+			return nil, fmt.Errorf("rule %d has decision %s, but dry runs can only approve, reject, terminate or escalate", i, rule.Decision)
+		}
+
+		c := dryRunRule{DryRunRule: rule}
+		if rule.ArgumentsPattern != nil {
+			pattern, err := regexp.Compile(*rule.ArgumentsPattern)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d has an invalid arguments pattern: %w", i, err)
+			}
+			c.pattern = pattern
+		}
+		compiled = append(compiled, c)
+	}
+
+	return compiled, nil
+}
+
+// evaluateDryRunRules returns what the candidate decides for a tool call, and the index of the
+// rule that decided it, or nil if no rule matched and the call is approved
+func evaluateDryRunRules(rules []dryRunRule, toolName string, arguments string) (Decision, *int) {
+	for i, rule := range rules {
+		if rule.ToolName != WildcardToolName && rule.ToolName != toolName {
+			continue
+		}
+		if rule.pattern != nil && !rule.pattern.MatchString(arguments) {
+			continue
+		}
+		return rule.Decision, &i
+	}
+
+	return Approve, nil
+}
+
+// wasEscalated reports whether any supervisor escalated a tool call
+func wasEscalated(ctx context.Context, toolCallId uuid.UUID, store Store) (bool, error) {
+	chainExecutions, err := store.GetChainExecutionsFromToolCall(ctx, toolCallId)
+	if err != nil {
+		return false, fmt.Errorf("error getting chain executions: %w", err)
+	}
+
+	for _, execution := range chainExecutions {
+		state, err := store.GetChainExecutionState(ctx, execution)
+		if err != nil {
+			return false, fmt.Errorf("error getting chain state: %w", err)
+		}
+
+		for _, request := range state.SupervisionRequests {
+			if request.Result != nil && request.Result.Decision == Escalate {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// dryRunOutcome groups decisions into what a dry run compares: approved, rejected or escalated
+func dryRunOutcome(decision Decision, escalated bool) Decision {
+	switch {
+	case decision == Reject || decision == Terminate:
+		return Reject
+	case decision == Escalate || escalated:
+		return Escalate
+	default:
+		return Approve
+	}
+}
+
+// dryRunSupervisor evaluates a candidate against a project's stored tool calls
+func dryRunSupervisor(ctx context.Context, projectId uuid.UUID, request DryRunRequest, rules []dryRunRule, store Store) (*DryRunReport, error) {
+	toolCalls, err := store.GetProjectToolCalls(ctx, projectId, request.From, request.To, maxDryRunToolCalls+1)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool calls: %w", err)
+	}
+
+	report := DryRunReport{
+		ProjectId:   projectId,
+		From:        request.From,
+		To:          request.To,
+		Differences: []DryRunCall{},
+	}
+	if len(toolCalls) > maxDryRunToolCalls {
+		toolCalls = toolCalls[:maxDryRunToolCalls]
+		report.Truncated = true
+	}
+	report.TotalCalls = len(toolCalls)
+
+	for _, toolCall := range toolCalls {
+		name, arguments := "", ""
+		if toolCall.Name != nil {
+			name = *toolCall.Name
+		}
+		if stored := storedToolCallArguments(toolCall); stored != nil {
+			arguments = *stored
+		}
+
+		predicted, ruleIndex := evaluateDryRunRules(rules, name, arguments)
+		predictedOutcome := dryRunOutcome(predicted, false)
+		switch predictedOutcome {
+		case Reject:
+			report.WouldReject++
+		case Escalate:
+			report.WouldEscalate++
+		default:
+			report.WouldApprove++
+		}
+
+		actual, err := getToolCallDecision(ctx, toolCall.Id, store)
+		if err != nil {
+			return nil, err
+		}
+		if actual == nil {
+			report.Undecided++
+			continue
+		}
+
+		escalated, err := wasEscalated(ctx, toolCall.Id, store)
+		if err != nil {
+			return nil, err
+		}
+
+		actualOutcome := dryRunOutcome(*actual, escalated)
+		switch actualOutcome {
+		case Reject:
+			report.ActuallyRejected++
+		case Escalate:
+			report.ActuallyEscalated++
+		default:
+			report.ActuallyApproved++
+		}
+
+		if actualOutcome == predictedOutcome {
+			report.Agreed++
+			continue
+		}
+
+		switch {
+		case predictedOutcome == Reject:
+			report.NewlyRejected++
+		case predictedOutcome == Escalate && actualOutcome == Approve:
+			report.NewlyEscalated++
+		case actualOutcome == Reject && predictedOutcome == Approve:
+			report.MissedRejections++
+		}
+
+		call := DryRunCall{
+			ToolCallId:        toolCall.Id,
+			ToolName:          name,
+			Predicted:         predicted,
+			RuleIndex:         ruleIndex,
+			Actual:            actual,
+			ActuallyEscalated: escalated,
+		}
+		if toolCall.CreatedAt != nil {
+			call.CreatedAt = *toolCall.CreatedAt
+		}
+		report.Differences = append(report.Differences, call)
+	}
+
+	return &report, nil
+}
+
+func apiDryRunSupervisorHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request DryRunRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if !request.From.Before(request.To) {
+		sendErrorResponse(w, http.StatusBadRequest, "from must be before to", "")
+		return
+	}
+
+	rules, err := compileDryRunRules(request.Rules)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid rule", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	report, err := dryRunSupervisor(ctx, projectId, request, rules, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error running dry run", err.Error())
+		return
+	}
+
+	respondJSON(w, report, http.StatusOK)
+}
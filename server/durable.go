@@ -0,0 +1,330 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// timerInterval is how often due timers are looked for
+const timerInterval = 5 * time.Second
+
+// waitingStatuses are the statuses of supervision requests that still wait for a decision
+var waitingStatuses = []Status{Pending, Assigned, AwaitingClarification}
+
+// timerHandler does what a timer of some kind is for once it's due
+type timerHandler func(ctx context.Context, timer DurableTimer, hub *Hub, store Store) error
+
+// timerHandlers are the handlers of each kind of timer
+var timerHandlers = map[TimerKind]timerHandler{
+	ReviewReminder:  fireReviewReminder,
+	DecisionTimeout: fireDecisionTimeout,
+}
+
+// TimerRunner fires stored timers once they're due. Timers are marked fired after their handler
+// ran, so one that was due when the server stopped fires once it's back.
+type TimerRunner struct {
+	store    Store
+	hub      *Hub
+	interval time.Duration
+}
+
+func NewTimerRunner(store Store, hub *Hub) *TimerRunner {
+	return &TimerRunner{store: store, hub: hub, interval: timerInterval}
+}
+
+func (t *TimerRunner) Start(ctx context.Context) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.fireDueTimers(ctx); err != nil {
+				log.Printf("Error firing timers: %v", err)
+			}
+		}
+	}
+}
+
+func (t *TimerRunner) fireDueTimers(ctx context.Context) error {
+	now := time.Now()
+	timers, err := t.store.GetDueTimers(ctx, now)
+	if err != nil {
+		return fmt.Errorf("error getting due timers: %w", err)
+	}
+
+	for _, timer := range timers {
+		handler, ok := timerHandlers[timer.Kind]
+		if !ok {
+			log.Printf("Timer %s has unknown kind %s, dropping it", timer.Id, timer.Kind)
+		} else if err := handler(ctx, timer, t.hub, t.store); err != nil {
+			// Left unfired so it's tried again on the next tick
+			log.Printf("Error firing %s timer %s: %v", timer.Kind, timer.Id, err)
+			continue
+		}
+
+		if err := t.store.MarkTimerFired(ctx, timer.Id, now); err != nil {
+			log.Printf("Error marking timer %s fired: %v", timer.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// scheduleTimer stores a timer of some kind for a supervision request
+func scheduleTimer(ctx context.Context, kind TimerKind, supervisionRequestId uuid.UUID, fireAt time.Time, attributes map[string]interface{}, store TimerStore) (*DurableTimer, error) {
+	timer := DurableTimer{
+		Id:                   uuid.New(),
+		Kind:                 kind,
+		SupervisionRequestId: supervisionRequestId,
+		FireAt:               fireAt,
+		CreatedAt:            time.Now(),
+	}
+	if len(attributes) > 0 {
+		timer.Attributes = &attributes
+	}
+
+	if err := store.CreateTimer(ctx, timer); err != nil {
+		return nil, fmt.Errorf("error creating timer: %w", err)
+	}
+	return &timer, nil
+}
+
+// isWaiting reports whether a supervision request is still waiting for a decision
+func isWaiting(ctx context.Context, supervisionRequestId uuid.UUID, store Store) (bool, *Status, error) {
+	result, err := store.GetSupervisionResultFromRequestID(ctx, supervisionRequestId)
+	if err != nil {
+		return false, nil, fmt.Errorf("error getting supervision result: %w", err)
+	}
+	if result != nil {
+		return false, nil, nil
+	}
+
+	status, err := store.GetSupervisionRequestStatus(ctx, supervisionRequestId)
+	if err != nil {
+		return false, nil, fmt.Errorf("error getting supervision status: %w", err)
+	}
+	if status == nil {
+		return false, nil, nil
+	}
+
+	for _, waiting := range waitingStatuses {
+		if status.Status == waiting {
+			return true, &status.Status, nil
+		}
+	}
+	return false, &status.Status, nil
+}
+
+// recoverSupervisionRequests puts the requests a previous run of the server was working on back in
+// the queue. Review assignments only live in the hub and ensembles are asked in the background, so
+// neither survives a restart, while the requests themselves are stored and would otherwise wait forever.
+func recoverSupervisionRequests(ctx context.Context, store Store) error {
+	requests, err := store.GetSupervisionRequestsForStatus(ctx, Assigned)
+	if err != nil {
+		return fmt.Errorf("error getting assigned supervision requests: %w", err)
+	}
+
+	recovered := 0
+	for _, request := range requests {
+		supervisor, err := store.GetSupervisor(ctx, request.SupervisorId)
+		if err != nil {
+			return fmt.Errorf("error getting supervisor: %w", err)
+		}
+		// Consent requests are stored with their expiry and change requests are polled until they're
+		// closed, so they carry on by themselves
+		if supervisor == nil || (supervisor.Type != HumanSupervisor && supervisor.Type != EnsembleSupervisor && supervisor.Type != LlmSupervisor) {
+			continue
+		}
+
+		status := SupervisionStatus{Status: Pending, CreatedAt: time.Now(), SupervisionRequestId: request.Id}
+		if err := store.CreateSupervisionStatus(ctx, *request.Id, status); err != nil {
+			return fmt.Errorf("error creating supervision status: %w", err)
+		}
+		recordAuditEvent(ctx, SystemActor, AuditActionReviewRecovered, supervisionRequestResource, *request.Id,
+			map[string]interface{}{"supervisor_type": supervisor.Type}, store)
+		recovered++
+	}
+
+	if recovered > 0 {
+		log.Printf("Recovered %d supervision requests interrupted by a restart", recovered)
+	}
+	return nil
+}
+
+// getWaitingSupervisionRequests lists every supervision request still waiting for a decision with
+// how long it has waited, oldest first
+func getWaitingSupervisionRequests(ctx context.Context, store Store, hub *Hub) ([]WaitingSupervisionRequest, error) {
+	now := time.Now()
+	waiting := make([]WaitingSupervisionRequest, 0)
+	for _, status := range waitingStatuses {
+		requests, err := store.GetSupervisionRequestsForStatus(ctx, status)
+		if err != nil {
+			return nil, fmt.Errorf("error getting %s supervision requests: %w", status, err)
+		}
+
+		for _, request := range requests {
+			item, err := getWaitingSupervisionRequest(ctx, request, status, now, store, hub)
+			if err != nil {
+				return nil, err
+			}
+			waiting = append(waiting, *item)
+		}
+	}
+
+	sortWaitingSupervisionRequests(waiting)
+	return waiting, nil
+}
+
+// getWaitingSupervisionRequest describes a supervision request waiting in a status
+func getWaitingSupervisionRequest(ctx context.Context, request SupervisionRequest, status Status, now time.Time, store Store, hub *Hub) (*WaitingSupervisionRequest, error) {
+	statuses, err := store.GetSupervisionStatusesForRequest(ctx, *request.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting supervision statuses: %w", err)
+	}
+	since := now
+	for _, s := range statuses {
+		if s.CreatedAt.Before(since) {
+			since = s.CreatedAt
+		}
+	}
+
+	item := WaitingSupervisionRequest{
+		SupervisionRequestId: *request.Id,
+		SupervisorId:         request.SupervisorId,
+		Status:               status,
+		WaitingSince:         since,
+		AgeSeconds:           int64(now.Sub(since).Seconds()),
+		AssignedSession:      hub.assignedSession(*request.Id),
+	}
+
+	claim, err := store.GetReviewClaim(ctx, *request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if claim != nil {
+		item.ClaimedBy = &claim.UserId
+	}
+
+	timers, err := store.GetSupervisionRequestTimers(ctx, *request.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting timers: %w", err)
+	}
+	for i := range timers {
+		if timers[i].Kind != ReviewReminder || timers[i].FiredAt != nil {
+			continue
+		}
+		if item.NextReminderAt == nil || timers[i].FireAt.Before(*item.NextReminderAt) {
+			item.NextReminderAt = &timers[i].FireAt
+		}
+	}
+
+	return &item, nil
+}
+
+// sortWaitingSupervisionRequests puts the requests that have waited longest first
+func sortWaitingSupervisionRequests(waiting []WaitingSupervisionRequest) {
+	sort.SliceStable(waiting, func(i, j int) bool {
+		return waiting[i].WaitingSince.Before(waiting[j].WaitingSince)
+	})
+}
+
+func apiGetWaitingSupervisionRequestsHandler(w http.ResponseWriter, r *http.Request, store Store, hub *Hub) {
+	waiting, err := getWaitingSupervisionRequests(r.Context(), store, hub)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting waiting supervision requests", err.Error())
+		return
+	}
+
+	respondJSON(w, waiting, http.StatusOK)
+}
+
+func apiCreateSupervisionRequestReminderHandler(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request ReminderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if request.AfterSeconds < 1 {
+		sendErrorResponse(w, http.StatusBadRequest, "after_seconds must be at least 1", "")
+		return
+	}
+
+	supervisionRequest, err := store.GetSupervisionRequest(ctx, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision request", err.Error())
+		return
+	}
+
+	if supervisionRequest == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervision request not found", "")
+		return
+	}
+
+	waiting, _, err := isWaiting(ctx, supervisionRequestId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision status", err.Error())
+		return
+	}
+
+	if !waiting {
+		sendErrorResponse(w, http.StatusBadRequest, "supervision request isn't waiting for a decision", "")
+		return
+	}
+
+	attributes := map[string]interface{}{}
+	if request.Note != nil && *request.Note != "" {
+		attributes["note"] = *request.Note
+	}
+
+	fireAt := time.Now().Add(time.Duration(request.AfterSeconds) * time.Second)
+	timer, err := scheduleTimer(ctx, ReviewReminder, supervisionRequestId, fireAt, attributes, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error scheduling reminder", err.Error())
+		return
+	}
+
+	respondJSON(w, timer, http.StatusCreated)
+}
+
+func apiGetSupervisionRequestRemindersHandler(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	supervisionRequest, err := store.GetSupervisionRequest(ctx, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision request", err.Error())
+		return
+	}
+
+	if supervisionRequest == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervision request not found", "")
+		return
+	}
+
+	timers, err := store.GetSupervisionRequestTimers(ctx, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting reminders", err.Error())
+		return
+	}
+
+	reminders := make([]DurableTimer, 0, len(timers))
+	for _, timer := range timers {
+		if timer.Kind == ReviewReminder {
+			reminders = append(reminders, timer)
+		}
+	}
+
+	respondJSON(w, reminders, http.StatusOK)
+}
@@ -0,0 +1,465 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sashabaranov/go-openai"
+)
+
+// ensembleJudgeTimeout is how long each member of an ensemble has to give its verdict
+const ensembleJudgeTimeout = 60 * time.Second
+
+// ensembleVerdictFormat is appended to every member's prompt so their answers can be parsed
+const ensembleVerdictFormat = `Reply with a JSON object with the fields "decision", one of approve, reject, terminate or escalate, ` +
+	`"confidence", a number between 0 and 1, and "rationale", a short explanation of the decision.`
+
+// decisionSeverity orders the decisions an ensemble aggregates from least to most severe
+var decisionSeverity = map[Decision]int{
+	Approve:   0,
+	Escalate:  1,
+	Reject:    2,
+	Terminate: 3,
+}
+
+// Judge asks a model for its verdict on a tool call
+type Judge interface {
+	Judge(ctx context.Context, model string, instructions string, subject string) (string, error)
+	// Decide asks for an answer that follows a JSON schema
+	Decide(ctx context.Context, model string, instructions string, prompt string, schema json.RawMessage) (string, error)
+}
+
+// judgeFor returns the judge of ensemble supervisors, which is the upstream provider of proxy mode if it's configured
+func judgeFor(proxy *ChatProxy) Judge {
+	if proxy == nil {
+		return nil
+	}
+	return proxy
+}
+
+// Judge implements Judge against the upstream provider of proxy mode
+func (p *ChatProxy) Judge(ctx context.Context, model string, instructions string, subject string) (string, error) {
+	resp, err := p.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model: model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: instructions + "\n\n" + ensembleVerdictFormat},
+			{Role: openai.ChatMessageRoleUser, Content: subject},
+		},
+		ResponseFormat: &openai.ChatCompletionResponseFormat{Type: openai.ChatCompletionResponseFormatTypeJSONObject},
+		Temperature:    0,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error calling judge model: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("judge model returned no choices")
+	}
+
+	return resp.Choices[0].Message.Content, nil
+}
+
+// Decide implements Judge against the upstream provider of proxy mode, with the answer constrained to the schema
+func (p *ChatProxy) Decide(ctx context.Context, model string, instructions string, prompt string, schema json.RawMessage) (string, error) {
+	resp, err := p.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model: model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: instructions},
+			{Role: openai.ChatMessageRoleUser, Content: prompt},
+		},
+		ResponseFormat: &openai.ChatCompletionResponseFormat{
+			Type: openai.ChatCompletionResponseFormatTypeJSONSchema,
+			JSONSchema: &openai.ChatCompletionResponseFormatJSONSchema{
+				Name:   "decision",
+				Schema: schema,
+				Strict: true,
+			},
+		},
+		Temperature: 0,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error calling model: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("model returned no choices")
+	}
+
+	return resp.Choices[0].Message.Content, nil
+}
+
+// parseEnsembleMembers reads the members of an ensemble supervisor from its attributes
+func parseEnsembleMembers(attributes map[string]interface{}) ([]EnsembleMember, error) {
+	value, ok := attributes["members"]
+	if !ok {
+		return nil, fmt.Errorf("ensemble supervisors need members")
+	}
+
+	jsonMembers, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling members: %w", err)
+	}
+
+	var members []EnsembleMember
+	if err := json.Unmarshal(jsonMembers, &members); err != nil {
+		return nil, fmt.Errorf("members must be a list of name, model and prompt: %w", err)
+	}
+
+	return members, nil
+}
+
+// ensembleAggregation reads how an ensemble supervisor aggregates its members' verdicts from its attributes
+func ensembleAggregation(attributes map[string]interface{}) EnsembleAggregation {
+	if aggregation, ok := attributes["aggregation"].(string); ok {
+		return EnsembleAggregation(aggregation)
+	}
+	return Majority
+}
+
+// validateEnsembleAttributes checks the members and aggregation of an ensemble supervisor
+func validateEnsembleAttributes(attributes map[string]interface{}) error {
+	members, err := parseEnsembleMembers(attributes)
+	if err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		return fmt.Errorf("ensemble supervisors need at least one member")
+	}
+
+	names := make(map[string]bool, len(members))
+	for i, member := range members {
+		if member.Name == "" || member.Model == "" || strings.TrimSpace(member.Prompt) == "" {
+			return fmt.Errorf("member %d needs a name, model and prompt", i)
+		}
+		if names[member.Name] {
+			return fmt.Errorf("duplicate member %s", member.Name)
+		}
+		names[member.Name] = true
+	}
+
+	if value, ok := attributes["aggregation"]; ok {
+		aggregation, _ := value.(string)
+		switch EnsembleAggregation(aggregation) {
+		case Majority, UnanimousApprove, MaxRisk:
+		default:
+			return fmt.Errorf("unknown aggregation: %v", value)
+		}
+	}
+
+	if _, err := parseFailureHandling(attributes); err != nil {
+		return err
+	}
+
+	variants, err := parsePromptVariants(attributes)
+	if err != nil {
+		return err
+	}
+	return validatePromptVariants(variants)
+}
+
+// ensembleSubject describes the tool call a supervision request is for to the members of an ensemble
+func ensembleSubject(ctx context.Context, supervisionRequestId uuid.UUID, store Store) (string, error) {
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, supervisionRequestId, store)
+	if err != nil {
+		return "", err
+	}
+	if toolCallId == nil {
+		return "", fmt.Errorf("supervision request %s has no tool call", supervisionRequestId)
+	}
+
+	toolCall, err := store.GetToolCall(ctx, *toolCallId)
+	if err != nil {
+		return "", fmt.Errorf("error getting tool call: %w", err)
+	}
+	if toolCall == nil {
+		return "", fmt.Errorf("tool call %s not found", *toolCallId)
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return "", fmt.Errorf("error getting tool: %w", err)
+	}
+	if tool == nil {
+		return "", fmt.Errorf("tool %s not found", toolCall.ToolId)
+	}
+
+	return toolCallSubject(*tool, storedToolCallArguments(*toolCall)), nil
+}
+
+// toolCallSubject describes a call of a tool to the members of an ensemble
+func toolCallSubject(tool Tool, arguments *string) string {
+	args := "{}"
+	if arguments != nil {
+		args = *arguments
+	}
+
+	return fmt.Sprintf("An agent wants to call the tool %s.\nTool description: %s\nArguments: %s", tool.Name, tool.Description, args)
+}
+
+// askMember gets one member's verdict. A member that fails or gives an unusable answer escalates, with the reason as its error.
+func askMember(ctx context.Context, judge Judge, member EnsembleMember, subject string, supervisionRequestId uuid.UUID) EnsembleVerdict {
+	verdict := EnsembleVerdict{
+		Id:                   uuid.New(),
+		SupervisionRequestId: supervisionRequestId,
+		Member:               member.Name,
+		Model:                member.Model,
+		Decision:             Escalate,
+	}
+	fail := func(err error) EnsembleVerdict {
+		message := err.Error()
+		verdict.Decision = Escalate
+		verdict.Error = &message
+		verdict.CreatedAt = time.Now()
+		return verdict
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ensembleJudgeTimeout)
+	defer cancel()
+
+	answer, err := judge.Judge(ctx, member.Model, member.Prompt, subject)
+	if err != nil {
+		return fail(err)
+	}
+
+	var parsed struct {
+		Decision   Decision `json:"decision"`
+		Confidence *float64 `json:"confidence"`
+		Rationale  *string  `json:"rationale"`
+	}
+	if err := json.Unmarshal([]byte(answer), &parsed); err != nil {
+		return fail(fmt.Errorf("error parsing verdict: %w", err))
+	}
+	if _, ok := decisionSeverity[parsed.Decision]; !ok {
+		return fail(fmt.Errorf("unknown decision in verdict: %s", parsed.Decision))
+	}
+	if parsed.Confidence != nil && (*parsed.Confidence < 0 || *parsed.Confidence > 1) {
+		parsed.Confidence = nil
+	}
+
+	verdict.Decision = parsed.Decision
+	verdict.Confidence = parsed.Confidence
+	verdict.Rationale = parsed.Rationale
+	verdict.CreatedAt = time.Now()
+	return verdict
+}
+
+// askEnsemble asks every member for its verdict in parallel, returning them in the order of the members
+func askEnsemble(ctx context.Context, judge Judge, members []EnsembleMember, subject string, supervisionRequestId uuid.UUID) []EnsembleVerdict {
+	verdicts := make([]EnsembleVerdict, len(members))
+
+	var wg sync.WaitGroup
+	for i, member := range members {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			verdicts[i] = askMember(ctx, judge, member, subject, supervisionRequestId)
+		}()
+	}
+	wg.Wait()
+
+	return verdicts
+}
+
+// mostSevere returns the most severe decision of a set of verdicts
+func mostSevere(verdicts []EnsembleVerdict) Decision {
+	decision := Approve
+	for _, verdict := range verdicts {
+		if decisionSeverity[verdict.Decision] > decisionSeverity[decision] {
+			decision = verdict.Decision
+		}
+	}
+	return decision
+}
+
+// majorityDecision returns the decision most of a set of verdicts gave, escalating ties
+func majorityDecision(verdicts []EnsembleVerdict) Decision {
+	votes := make(map[Decision]int)
+	for _, verdict := range verdicts {
+		votes[verdict.Decision]++
+	}
+
+	decision := Escalate
+	most := 0
+	tied := false
+	for candidate, count := range votes {
+		switch {
+		case count > most:
+			decision, most, tied = candidate, count, false
+		case count == most:
+			tied = true
+		}
+	}
+	if tied {
+		return Escalate
+	}
+	return decision
+}
+
+// aggregateVerdicts decides from the verdicts of an ensemble's members. The confidence is the share
+// of members that gave the decision.
+func aggregateVerdicts(verdicts []EnsembleVerdict, aggregation EnsembleAggregation) (Decision, float64) {
+	if len(verdicts) == 0 {
+		return Escalate, 0
+	}
+
+	var decision Decision
+	switch aggregation {
+	case UnanimousApprove:
+		dissenting := make([]EnsembleVerdict, 0, len(verdicts))
+		for _, verdict := range verdicts {
+			if verdict.Decision != Approve {
+				dissenting = append(dissenting, verdict)
+			}
+		}
+		decision = Approve
+		if len(dissenting) > 0 {
+			decision = majorityDecision(dissenting)
+		}
+	case MaxRisk:
+		decision = mostSevere(verdicts)
+	default:
+		decision = majorityDecision(verdicts)
+	}
+
+	agreeing := 0
+	for _, verdict := range verdicts {
+		if verdict.Decision == decision {
+			agreeing++
+		}
+	}
+	return decision, float64(agreeing) / float64(len(verdicts))
+}
+
+// ensembleExplanation explains an ensemble's decision with the votes of its members
+func ensembleExplanation(verdicts []EnsembleVerdict, aggregation EnsembleAggregation, decision Decision, confidence float64) ResultExplanation {
+	votes := make([]string, 0, len(verdicts))
+	for _, verdict := range verdicts {
+		vote := fmt.Sprintf("%s: %s", verdict.Member, verdict.Decision)
+		if verdict.Error != nil {
+			vote += " (failed)"
+		}
+		votes = append(votes, vote)
+	}
+
+	rationale := fmt.Sprintf("%s by %s of %d members (%s)", decision, aggregation, len(verdicts), strings.Join(votes, ", "))
+	return ResultExplanation{Rationale: &rationale, Confidence: &confidence}
+}
+
+// judgeSupervisionRequest asks an ensemble supervisor's members for their verdicts on a supervision
+// request, records them and resolves the request with their aggregate. Without a judge every
+// request is escalated.
+func judgeSupervisionRequest(ctx context.Context, supervisionRequest SupervisionRequest, supervisor Supervisor, judge Judge, breakers *CircuitBreakers, store Store) error {
+	requestId := *supervisionRequest.Id
+	aggregation := ensembleAggregation(supervisor.Attributes)
+
+	result := SupervisionResult{
+		Decision:             Escalate,
+		SupervisionRequestId: requestId,
+	}
+
+	var variant *PromptVariant
+	members, err := parseEnsembleMembers(supervisor.Attributes)
+	if err == nil {
+		var variants []PromptVariant
+		variants, err = parsePromptVariants(supervisor.Attributes)
+		variant = choosePromptVariant(variants, requestId)
+		members = withPromptVariant(members, variant)
+	}
+	var handling failureHandling
+	if err == nil {
+		handling, err = parseFailureHandling(supervisor.Attributes)
+	}
+	subject := ""
+	if err == nil {
+		subject, err = ensembleSubject(ctx, requestId, store)
+	}
+
+	decidedByPolicy := false
+	switch {
+	case judge == nil:
+		result.Reasoning = "Escalated because no judge model is configured, set OPENAI_API_KEY to enable ensemble supervisors"
+	case err != nil:
+		result.Reasoning = fmt.Sprintf("Escalated because the ensemble couldn't judge the request: %v", err)
+	case !breakers.allow(supervisor, handling, time.Now()):
+		applyFailurePolicy(&result, handling.policy, "the supervisor's circuit breaker is open after it failed repeatedly")
+		decidedByPolicy = true
+	default:
+		verdicts := askEnsemble(ctx, judge, members, subject, requestId)
+		if variant != nil {
+			for i := range verdicts {
+				verdicts[i].Variant = &variant.Name
+			}
+		}
+		if err := store.CreateEnsembleVerdicts(ctx, verdicts); err != nil {
+			return fmt.Errorf("error creating ensemble verdicts: %w", err)
+		}
+
+		// The ensemble only failed if none of its members could give a verdict
+		failed := true
+		for _, verdict := range verdicts {
+			failed = failed && verdict.Error != nil
+		}
+		breakers.record(supervisor, handling, failed, time.Now())
+		if failed {
+			applyFailurePolicy(&result, handling.policy, "none of the ensemble's members gave a verdict")
+			decidedByPolicy = true
+			break
+		}
+
+		decision, confidence := aggregateVerdicts(verdicts, aggregation)
+		explanation := ensembleExplanation(verdicts, aggregation, decision, confidence)
+		result.Decision = decision
+		result.Reasoning = *explanation.Rationale
+		result.Explanation = &explanation
+	}
+
+	if !decidedByPolicy {
+		if err := applyConfidenceThreshold(ctx, requestId, supervisor, &result, store); err != nil {
+			return err
+		}
+	}
+	if err := escalatePlanDeviation(ctx, requestId, supervisor, &result, store); err != nil {
+		return err
+	}
+
+	result.CreatedAt = time.Now()
+	_, winner, err := resolveSupervisionRequest(ctx, requestId, result, SystemActor, store)
+	if err != nil {
+		return err
+	}
+	if winner != nil {
+		log.Printf("Supervision request %s was resolved before its ensemble decided", requestId)
+	}
+
+	return nil
+}
+
+func apiGetSupervisionRequestEnsembleVerdictsHandler(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	supervisionRequest, err := store.GetSupervisionRequest(ctx, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision request", err.Error())
+		return
+	}
+
+	if supervisionRequest == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervision request not found", "")
+		return
+	}
+
+	verdicts, err := store.GetEnsembleVerdicts(ctx, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting ensemble verdicts", err.Error())
+		return
+	}
+
+	respondJSON(w, verdicts, http.StatusOK)
+}
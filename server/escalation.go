@@ -0,0 +1,179 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// validateChainTiers checks a chain's tiers are for supervisors of the chain, don't go down along
+// it, and that the supervisors of each tier are next to each other
+func validateChainTiers(chain ChainRequest) error {
+	if chain.SupervisorTiers == nil {
+		return nil
+	}
+
+	var supervisorIds []uuid.UUID
+	if chain.SupervisorIds != nil {
+		supervisorIds = *chain.SupervisorIds
+	}
+
+	tiers := make(map[uuid.UUID]int)
+	for _, tier := range *chain.SupervisorTiers {
+		if !slices.Contains(supervisorIds, tier.SupervisorId) {
+			return fmt.Errorf("tier for supervisor %s, which isn't in the chain", tier.SupervisorId)
+		}
+		if _, ok := tiers[tier.SupervisorId]; ok {
+			return fmt.Errorf("duplicate tier for supervisor %s", tier.SupervisorId)
+		}
+		if tier.Tier < 0 {
+			return fmt.Errorf("tier of supervisor %s must be at least 0", tier.SupervisorId)
+		}
+		tiers[tier.SupervisorId] = tier.Tier
+	}
+
+	previous := -1
+	untiered := false
+	for _, supervisorId := range supervisorIds {
+		tier, ok := tiers[supervisorId]
+		if !ok {
+			untiered = previous >= 0
+			continue
+		}
+		if tier < previous {
+			return fmt.Errorf("supervisor %s is in tier %d after a supervisor of tier %d, tiers can't go down along the chain", supervisorId, tier, previous)
+		}
+		if tier == previous && untiered {
+			return fmt.Errorf("supervisors of tier %d must be next to each other in the chain", tier)
+		}
+		previous = tier
+		untiered = false
+	}
+	return nil
+}
+
+// chainTiers returns the tier of each position of a chain. Supervisors without a tier get one of their
+// own, below zero so it can't be any other's.
+func chainTiers(chain SupervisorChain) []int {
+	tiers := make(map[uuid.UUID]int)
+	if chain.SupervisorTiers != nil {
+		for _, tier := range *chain.SupervisorTiers {
+			tiers[tier.SupervisorId] = tier.Tier
+		}
+	}
+
+	positions := make([]int, len(chain.Supervisors))
+	for i, supervisor := range chain.Supervisors {
+		positions[i] = -i - 1
+		if supervisor.Id == nil {
+			continue
+		}
+		if tier, ok := tiers[*supervisor.Id]; ok {
+			positions[i] = tier
+		}
+	}
+	return positions
+}
+
+// skipsTier reports whether an escalating result skips the rest of its supervisor's tier, which it
+// does when the supervisor decided to escalate itself rather than by a timeout or its confidence
+func skipsTier(result SupervisionResult) bool {
+	return result.Decision == Escalate && result.TimeoutFallback == nil && result.OverriddenDecision == nil
+}
+
+// escalationTarget returns the position of the chain an escalating result of the supervisor at a
+// position sends the tool call to, which is past the end of the chain if there's no one left
+func escalationTarget(chain SupervisorChain, position int, result SupervisionResult) int {
+	target := position + 1
+	if !skipsTier(result) {
+		return target
+	}
+
+	tiers := chainTiers(chain)
+	for target < len(tiers) && tiers[target] == tiers[position] {
+		target++
+	}
+	return target
+}
+
+// EscalationPath returns the escalations of a chain execution's supervisors, in chain order
+func EscalationPath(chain SupervisorChain, requests []SupervisionRequestState) []Escalation {
+	escalating := make([]SupervisionRequestState, 0)
+	for _, request := range requests {
+		if request.Result != nil && request.Result.Decision == Escalate && request.SupervisionRequest.Id != nil {
+			escalating = append(escalating, request)
+		}
+	}
+	slices.SortFunc(escalating, func(a, b SupervisionRequestState) int {
+		return a.SupervisionRequest.PositionInChain - b.SupervisionRequest.PositionInChain
+	})
+
+	path := make([]Escalation, 0, len(escalating))
+	for _, request := range escalating {
+		from := request.SupervisionRequest.PositionInChain
+		escalation := Escalation{
+			SupervisionRequestId: *request.SupervisionRequest.Id,
+			FromSupervisorId:     request.SupervisionRequest.SupervisorId,
+			FromPosition:         from,
+			SkippedSupervisorIds: make([]uuid.UUID, 0),
+			Reasoning:            request.Result.Reasoning,
+			EscalatedAt:          request.Result.CreatedAt,
+		}
+
+		target := escalationTarget(chain, from, *request.Result)
+		for position := from + 1; position < target && position < len(chain.Supervisors); position++ {
+			if id := chain.Supervisors[position].Id; id != nil {
+				escalation.SkippedSupervisorIds = append(escalation.SkippedSupervisorIds, *id)
+			}
+		}
+		if target < len(chain.Supervisors) {
+			escalation.ToPosition = &target
+			escalation.ToSupervisorId = chain.Supervisors[target].Id
+		}
+
+		path = append(path, escalation)
+	}
+	return path
+}
+
+// escalationTo returns the escalation that sent a chain execution to a position, if one did
+func escalationTo(state ChainExecutionState, position int) *Escalation {
+	if state.EscalationPath == nil {
+		return nil
+	}
+	for _, escalation := range *state.EscalationPath {
+		if escalation.ToPosition != nil && *escalation.ToPosition == position {
+			return &escalation
+		}
+	}
+	return nil
+}
+
+// skippingEscalation returns the escalation that skipped a position of a chain execution, if one did
+func skippingEscalation(state ChainExecutionState, position int) *Escalation {
+	if state.EscalationPath == nil {
+		return nil
+	}
+	for _, escalation := range *state.EscalationPath {
+		if position > escalation.FromPosition && position <= escalation.FromPosition+len(escalation.SkippedSupervisorIds) {
+			return &escalation
+		}
+	}
+	return nil
+}
+
+// notifyEscalated tells the project of a tool call that it was escalated to another supervisor
+func notifyEscalated(ctx context.Context, escalation Escalation, toolCallId uuid.UUID, store Store) {
+	project, err := getProjectForToolCall(ctx, toolCallId, store)
+	if err != nil || project == nil {
+		return
+	}
+
+	notification := Notification{Event: Escalated, ProjectId: project.Id, Escalation: &escalation}
+	if _, err := dispatchNotification(ctx, notification, store); err != nil {
+		log.Printf("Error sending escalation of supervision request %s: %v", escalation.SupervisionRequestId, err)
+	}
+}
@@ -0,0 +1,55 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// getSupervisorForSupervisionRequest returns the supervisor a supervision request was made to, or nil if either is gone
+func getSupervisorForSupervisionRequest(ctx context.Context, supervisionRequestId uuid.UUID, store Store) (*Supervisor, error) {
+	supervisionRequest, err := store.GetSupervisionRequest(ctx, supervisionRequestId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting supervision request: %w", err)
+	}
+	if supervisionRequest == nil {
+		return nil, nil
+	}
+
+	supervisor, err := store.GetSupervisor(ctx, supervisionRequest.SupervisorId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting supervisor: %w", err)
+	}
+
+	return supervisor, nil
+}
+
+// isAutomated reports whether a supervisor decides without a person, so its results have to explain themselves
+func isAutomated(supervisorType SupervisorType) bool {
+	return supervisorType == ClientSupervisor || supervisorType == EnsembleSupervisor || supervisorType == LlmSupervisor
+}
+
+// validateExplanation checks that automated supervisors explain their results with the rules that
+// matched or a rationale. Explanations are optional for everyone else but still have to make sense.
+func validateExplanation(explanation *ResultExplanation, supervisorType SupervisorType) error {
+	if explanation == nil {
+		if isAutomated(supervisorType) {
+			return fmt.Errorf("results of %s supervisors need an explanation", strings.TrimSuffix(string(supervisorType), "_supervisor"))
+		}
+		return nil
+	}
+
+	hasRules := explanation.MatchedRuleIds != nil && len(*explanation.MatchedRuleIds) > 0
+	hasRationale := explanation.Rationale != nil && strings.TrimSpace(*explanation.Rationale) != ""
+	if !hasRules && !hasRationale {
+		return fmt.Errorf("explanations need the rules that matched or a rationale")
+	}
+
+	if explanation.Confidence != nil && (*explanation.Confidence < 0 || *explanation.Confidence > 1) {
+		return fmt.Errorf("confidence must be between 0 and 1, got %v", *explanation.Confidence)
+	}
+
+	return nil
+}
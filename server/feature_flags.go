@@ -0,0 +1,242 @@
+package asteroid
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Feature flags of behaviors that are still being rolled out, or that the hosted service may have to
+// turn off for some projects
+const (
+	planApprovalFlag       = "plan_approval"
+	argumentRulesFlag      = "argument_rules"
+	proxyResponseCacheFlag = "proxy_response_cache"
+)
+
+type featureFlag struct {
+	description    string
+	defaultEnabled bool
+}
+
+// featureFlags are every flag the server checks. A flag is at its default for every project until
+// it's rolled out.
+var featureFlags = map[string]featureFlag{
+	planApprovalFlag: {
+		description:    "Tool calls an approved plan covers are approved without being reviewed again",
+		defaultEnabled: true,
+	},
+	argumentRulesFlag: {
+		description:    "Supervision requests a project's argument rules match are decided by the rule",
+		defaultEnabled: true,
+	},
+	proxyResponseCacheFlag: {
+		description:    "Proxied chat completions repeating an earlier request word for word are answered from a cache instead of upstream",
+		defaultEnabled: false,
+	},
+}
+
+// rolloutBucket places a project in [0, 100) for a flag. Each flag orders projects differently, so
+// the same projects aren't always the first to get a new behavior.
+func rolloutBucket(flag string, projectId uuid.UUID) int {
+	sum := sha256.Sum256([]byte(flag + ":" + projectId.String()))
+	return int(binary.BigEndian.Uint32(sum[:4]) % 100)
+}
+
+// evaluateFeatureFlag returns whether a flag is on for a project under a rollout, nil if the flag
+// isn't rolled out, and why
+func evaluateFeatureFlag(name string, rollout *FeatureFlagRollout, project Project) (bool, FeatureFlagReason) {
+	if rollout == nil {
+		return featureFlags[name].defaultEnabled, FlagDefault
+	}
+
+	var organization *FeatureFlagTarget
+	for _, target := range rollout.Targets {
+		if target.ProjectId != nil && *target.ProjectId == project.Id {
+			return target.Enabled, ProjectTargeted
+		}
+		if target.OrganizationId != nil && project.OrganizationId != nil && *target.OrganizationId == *project.OrganizationId {
+			organization = &target
+		}
+	}
+	if organization != nil {
+		return organization.Enabled, OrganizationTargeted
+	}
+
+	if rolloutBucket(name, project.Id) < rollout.Percentage {
+		return true, InRollout
+	}
+	return false, OutsideRollout
+}
+
+// featureEnabled reports whether a flag is on for a project. Flags are at their default when there's
+// no project.
+func featureEnabled(ctx context.Context, project *Project, name string, store Store) (bool, error) {
+	if project == nil {
+		return featureFlags[name].defaultEnabled, nil
+	}
+
+	rollout, err := store.GetFeatureFlagRollout(ctx, name)
+	if err != nil {
+		return false, fmt.Errorf("error getting rollout of feature flag %s: %w", name, err)
+	}
+
+	enabled, _ := evaluateFeatureFlag(name, rollout, *project)
+	return enabled, nil
+}
+
+// featureFlagNames returns the names of every flag, sorted
+func featureFlagNames() []string {
+	names := make([]string, 0, len(featureFlags))
+	for name := range featureFlags {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// validateFeatureFlagTargets checks each target is for one project or one organization, and that no
+// two are for the same one
+func validateFeatureFlagTargets(targets []FeatureFlagTarget) error {
+	seen := make(map[uuid.UUID]bool)
+	for _, target := range targets {
+		if (target.ProjectId == nil) == (target.OrganizationId == nil) {
+			return fmt.Errorf("a target must have either a project_id or an organization_id")
+		}
+
+		id := target.ProjectId
+		if id == nil {
+			id = target.OrganizationId
+		}
+		if seen[*id] {
+			return fmt.Errorf("more than one target for %s", *id)
+		}
+		seen[*id] = true
+	}
+	return nil
+}
+
+func apiGetFeatureFlagsHandler(w http.ResponseWriter, r *http.Request, store Store) {
+	ctx := r.Context()
+
+	rollouts, err := store.GetFeatureFlagRollouts(ctx)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting feature flag rollouts", err.Error())
+		return
+	}
+
+	flags := make([]FeatureFlag, 0, len(featureFlags))
+	for _, name := range featureFlagNames() {
+		flag := FeatureFlag{Name: name, Description: featureFlags[name].description, DefaultEnabled: featureFlags[name].defaultEnabled}
+		for _, rollout := range rollouts {
+			if rollout.Flag == name {
+				flag.Rollout = &rollout
+			}
+		}
+		flags = append(flags, flag)
+	}
+
+	respondJSON(w, flags, http.StatusOK)
+}
+
+func apiSetFeatureFlagRolloutHandler(w http.ResponseWriter, r *http.Request, name string, store Store) {
+	ctx := r.Context()
+
+	flag, ok := featureFlags[name]
+	if !ok {
+		sendErrorResponse(w, http.StatusNotFound, fmt.Sprintf("unknown feature flag: %s", name), "")
+		return
+	}
+
+	var request SetFeatureFlagRolloutJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if request.Percentage < 0 || request.Percentage > 100 {
+		sendErrorResponse(w, http.StatusBadRequest, "percentage must be between 0 and 100", "")
+		return
+	}
+
+	rollout := FeatureFlagRollout{
+		Flag:       name,
+		Percentage: request.Percentage,
+		Targets:    make([]FeatureFlagTarget, 0),
+		UpdatedAt:  time.Now(),
+		UpdatedBy:  actorFromContext(ctx),
+	}
+	if request.Targets != nil {
+		rollout.Targets = *request.Targets
+	}
+
+	if err := validateFeatureFlagTargets(rollout.Targets); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid feature flag target", err.Error())
+		return
+	}
+
+	if err := store.SetFeatureFlagRollout(ctx, rollout); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting feature flag rollout", err.Error())
+		return
+	}
+
+	respondJSON(w, FeatureFlag{Name: name, Description: flag.description, DefaultEnabled: flag.defaultEnabled, Rollout: &rollout}, http.StatusOK)
+}
+
+func apiDeleteFeatureFlagRolloutHandler(w http.ResponseWriter, r *http.Request, name string, store Store) {
+	ctx := r.Context()
+
+	if _, ok := featureFlags[name]; !ok {
+		sendErrorResponse(w, http.StatusNotFound, fmt.Sprintf("unknown feature flag: %s", name), "")
+		return
+	}
+
+	if err := store.DeleteFeatureFlagRollout(ctx, name); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error deleting feature flag rollout", err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func apiGetProjectFeatureFlagsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	rollouts, err := store.GetFeatureFlagRollouts(ctx)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting feature flag rollouts", err.Error())
+		return
+	}
+
+	flags := make([]ProjectFeatureFlag, 0, len(featureFlags))
+	for _, name := range featureFlagNames() {
+		var rollout *FeatureFlagRollout
+		for _, candidate := range rollouts {
+			if candidate.Flag == name {
+				rollout = &candidate
+			}
+		}
+		enabled, reason := evaluateFeatureFlag(name, rollout, *project)
+		flags = append(flags, ProjectFeatureFlag{Name: name, Enabled: enabled, Reason: reason})
+	}
+
+	respondJSON(w, flags, http.StatusOK)
+}
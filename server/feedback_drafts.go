@@ -0,0 +1,305 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sashabaranov/go-openai"
+)
+
+const (
+	// feedbackDraftTimeout is how long the model has to draft feedback while the reviewer waits
+	feedbackDraftTimeout = 30 * time.Second
+	// feedbackPrecedentCandidates is how many of a tool's latest decisions the precedents are picked from
+	feedbackPrecedentCandidates = 50
+	// maxFeedbackPrecedents is how many precedents the model is shown
+	maxFeedbackPrecedents = 3
+)
+
+// feedbackDraftInstructions is the system prompt of the model drafting a reviewer's feedback
+const feedbackDraftInstructions = `You help a person who reviews the tool calls of an AI agent write the reasoning of their ` +
+	`decision. Write it as they would, in a few plain sentences, for the agent and for whoever reads the decision later. ` +
+	`Back it with the policies and precedents you're shown where they apply and cite them by their refs in square ` +
+	`brackets, like [P1] or [C2], listing the refs you cite. Don't cite any that don't bear on the decision, and ` +
+	`don't make up others.`
+
+// feedbackDraftModel returns the model that drafts feedback, FEEDBACK_DRAFT_MODEL or else gpt-4o-mini
+func feedbackDraftModel() string {
+	if model := os.Getenv("FEEDBACK_DRAFT_MODEL"); model != "" {
+		return model
+	}
+	return openai.GPT4oMini
+}
+
+// feedbackAnswer is the answer the model gives in the feedback schema
+type feedbackAnswer struct {
+	Feedback string   `json:"feedback"`
+	Cited    []string `json:"cited"`
+}
+
+var feedbackDraftSchema = json.RawMessage(`{
+	"type": "object",
+	"properties": {
+		"feedback": {"type": "string"},
+		"cited": {"type": "array", "items": {"type": "string"}}
+	},
+	"required": ["feedback", "cited"],
+	"additionalProperties": false
+}`)
+
+// getFeedbackPolicies returns the policies that apply to a tool call: the risk tier of its tool and
+// each argument rule of the project its arguments meet, whether or not the rule decided it
+func getFeedbackPolicies(ctx context.Context, toolCall AsteroidToolCall, tool Tool, chainId uuid.UUID, project *Project, store Store) ([]FeedbackPolicy, error) {
+	policies := make([]FeedbackPolicy, 0)
+
+	tier, err := getToolRiskTier(ctx, tool, store)
+	if err != nil {
+		return nil, err
+	}
+	if tier != nil {
+		policies = append(policies, FeedbackPolicy{
+			Kind:        ToolRiskTier,
+			Name:        tool.Name,
+			Description: fmt.Sprintf("%s is a %s risk tool", tool.Name, *tier),
+		})
+	}
+
+	if project != nil {
+		rules, err := store.GetProjectArgumentRules(ctx, project.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting argument rules: %w", err)
+		}
+		for _, rule := range rules {
+			if matchArgumentRule([]ArgumentRule{rule}, tool.Name, chainId, toolCall.Arguments) == nil {
+				continue
+			}
+			policies = append(policies, FeedbackPolicy{
+				Kind:        MatchingArgumentRule,
+				Name:        rule.Name,
+				Description: fmt.Sprintf("Argument rule %s decides %s when the arguments meet its %d conditions, which they do", rule.Name, rule.Decision, len(rule.Conditions)),
+			})
+		}
+	}
+
+	for i := range policies {
+		policies[i].Ref = fmt.Sprintf("P%d", i+1)
+	}
+	return policies, nil
+}
+
+// pickFeedbackPrecedents returns the decided calls of a tool whose arguments are most like a tool
+// call's, most alike first and newest first among equals. The call's own decisions aren't precedents.
+func pickFeedbackPrecedents(toolCall AsteroidToolCall, candidates []FeedbackPrecedent) []FeedbackPrecedent {
+	arguments := stringOrEmpty(toolCall.Arguments)
+
+	precedents := make([]FeedbackPrecedent, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.ToolCallId == toolCall.Id {
+			continue
+		}
+		candidate.Similarity = argumentSimilarity(stringOrEmpty(candidate.Arguments), arguments)
+		precedents = append(precedents, candidate)
+	}
+
+	slices.SortStableFunc(precedents, func(a, b FeedbackPrecedent) int {
+		switch {
+		case a.Similarity > b.Similarity:
+			return -1
+		case a.Similarity < b.Similarity:
+			return 1
+		}
+		return 0
+	})
+	if len(precedents) > maxFeedbackPrecedents {
+		precedents = precedents[:maxFeedbackPrecedents]
+	}
+
+	for i := range precedents {
+		precedents[i].Ref = fmt.Sprintf("C%d", i+1)
+	}
+	return precedents
+}
+
+// feedbackPrompt describes the tool call, the reviewer's decision and what the draft can cite
+func feedbackPrompt(data llmPromptData, request FeedbackDraftRequest, policies []FeedbackPolicy, precedents []FeedbackPrecedent) string {
+	var prompt strings.Builder
+	fmt.Fprintf(&prompt, "The agent called %s", data.ToolName)
+	if data.ToolDescription != "" {
+		fmt.Fprintf(&prompt, " (%s)", data.ToolDescription)
+	}
+	fmt.Fprintf(&prompt, " with the arguments %s.\n", data.Arguments)
+	if data.Message != "" {
+		fmt.Fprintf(&prompt, "Its message with the call: %s\n", data.Message)
+	}
+
+	fmt.Fprintf(&prompt, "\nThe reviewer is going to %s the call.\n", request.Decision)
+	if request.Notes != nil && strings.TrimSpace(*request.Notes) != "" {
+		fmt.Fprintf(&prompt, "What they wrote so far: %s\n", *request.Notes)
+	}
+
+	if len(policies) > 0 {
+		prompt.WriteString("\nPolicies:\n")
+		for _, policy := range policies {
+			fmt.Fprintf(&prompt, "[%s] %s\n", policy.Ref, policy.Description)
+		}
+	}
+
+	if len(precedents) > 0 {
+		prompt.WriteString("\nEarlier decisions on similar calls of the tool:\n")
+		for _, precedent := range precedents {
+			fmt.Fprintf(&prompt, "[%s] %s with the arguments %s, agreeing on %.0f%% of them: %s\n",
+				precedent.Ref, precedent.Decision, stringOrEmpty(precedent.Arguments), precedent.Similarity*100, precedent.Reasoning)
+		}
+	}
+
+	return prompt.String()
+}
+
+func apiDraftReviewFeedbackHandler(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, judge Judge, store Store) {
+	ctx := r.Context()
+
+	var request FeedbackDraftRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if _, ok := decisionSeverity[request.Decision]; !ok {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown decision: %s", request.Decision), "")
+		return
+	}
+
+	supervisionRequest, err := store.GetSupervisionRequest(ctx, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision request", err.Error())
+		return
+	}
+
+	if supervisionRequest == nil || supervisionRequest.ChainexecutionId == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervision request not found", "")
+		return
+	}
+
+	result, err := store.GetSupervisionResultFromRequestID(ctx, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision result", err.Error())
+		return
+	}
+
+	if result != nil {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("Supervision request %s was already decided", supervisionRequestId), "")
+		return
+	}
+
+	if judge == nil {
+		sendErrorResponse(w, http.StatusServiceUnavailable, "no model is configured to draft feedback", "set OPENAI_API_KEY to enable drafting")
+		return
+	}
+
+	chainId, toolCallId, err := store.GetChainExecution(ctx, *supervisionRequest.ChainexecutionId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting chain execution", err.Error())
+		return
+	}
+
+	if chainId == nil || toolCallId == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call of the supervision request not found", "")
+		return
+	}
+
+	toolCall, err := store.GetToolCall(ctx, *toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
+		return
+	}
+
+	if toolCall == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call of the supervision request not found", "")
+		return
+	}
+	// Policies, precedents and the prompt are about the call's arguments, not the record the store keeps
+	toolCall.Arguments = storedToolCallArguments(*toolCall)
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool", err.Error())
+		return
+	}
+
+	if tool == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool of the supervision request not found", "")
+		return
+	}
+
+	project, err := getProjectForRun(ctx, tool.RunId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	policies, err := getFeedbackPolicies(ctx, *toolCall, *tool, *chainId, project, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting policies", err.Error())
+		return
+	}
+
+	precedents := make([]FeedbackPrecedent, 0)
+	if project != nil {
+		candidates, err := store.GetToolDecisionPrecedents(ctx, project.Id, tool.Name, feedbackPrecedentCandidates)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting precedents", err.Error())
+			return
+		}
+		precedents = pickFeedbackPrecedents(*toolCall, candidates)
+	}
+
+	data := toolPromptData(*tool, toolCall.Arguments)
+	message, err := store.GetToolCallMessage(ctx, toolCall.Id)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting message of tool call", err.Error())
+		return
+	}
+	if message != nil {
+		data.Message = message.Content
+	}
+
+	model := feedbackDraftModel()
+	draftCtx, cancel := context.WithTimeout(ctx, feedbackDraftTimeout)
+	defer cancel()
+
+	answer, err := judge.Decide(draftCtx, model, feedbackDraftInstructions, feedbackPrompt(data, request, policies, precedents), feedbackDraftSchema)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadGateway, "error drafting feedback", err.Error())
+		return
+	}
+
+	var parsed feedbackAnswer
+	if err := json.Unmarshal([]byte(answer), &parsed); err != nil {
+		sendErrorResponse(w, http.StatusBadGateway, "error parsing drafted feedback", err.Error())
+		return
+	}
+
+	// A ref is cited if the model lists it or the feedback has it
+	for i := range policies {
+		policies[i].Cited = slices.Contains(parsed.Cited, policies[i].Ref) || strings.Contains(parsed.Feedback, "["+policies[i].Ref+"]")
+	}
+	for i := range precedents {
+		precedents[i].Cited = slices.Contains(parsed.Cited, precedents[i].Ref) || strings.Contains(parsed.Feedback, "["+precedents[i].Ref+"]")
+	}
+
+	respondJSON(w, FeedbackDraft{
+		SupervisionRequestId: supervisionRequestId,
+		Decision:             request.Decision,
+		Feedback:             parsed.Feedback,
+		Model:                model,
+		Policies:             policies,
+		Precedents:           precedents,
+	}, http.StatusOK)
+}
@@ -0,0 +1,330 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// geminiPart is a part of the content of Gemini's generateContent API. Only the fields of the parts
+// Asteroid reads are decoded: text, inline and file data, functionCall and functionResponse.
+type geminiPart struct {
+	Text             string                  `json:"text,omitempty"`
+	InlineData       *geminiBlob             `json:"inlineData,omitempty"`
+	FileData         *geminiFileData         `json:"fileData,omitempty"`
+	FunctionCall     *geminiFunctionCall     `json:"functionCall,omitempty"`
+	FunctionResponse *geminiFunctionResponse `json:"functionResponse,omitempty"`
+}
+
+type geminiBlob struct {
+	MimeType string `json:"mimeType"`
+	Data     string `json:"data"`
+}
+
+type geminiFileData struct {
+	MimeType string `json:"mimeType,omitempty"`
+	FileUri  string `json:"fileUri"`
+}
+
+type geminiFunctionCall struct {
+	Id   string          `json:"id,omitempty"`
+	Name string          `json:"name"`
+	Args json.RawMessage `json:"args,omitempty"`
+}
+
+type geminiFunctionResponse struct {
+	Id       string          `json:"id,omitempty"`
+	Name     string          `json:"name"`
+	Response json.RawMessage `json:"response,omitempty"`
+}
+
+type geminiContent struct {
+	Role  string       `json:"role,omitempty"`
+	Parts []geminiPart `json:"parts"`
+}
+
+// geminiRequest keeps its system instruction and contents raw so they're stored as they were sent
+type geminiRequest struct {
+	SystemInstruction json.RawMessage   `json:"systemInstruction,omitempty"`
+	Contents          []json.RawMessage `json:"contents"`
+}
+
+type geminiCandidate struct {
+	Content      geminiContent `json:"content"`
+	FinishReason string        `json:"finishReason,omitempty"`
+	Index        int           `json:"index"`
+}
+
+type geminiResponse struct {
+	Candidates []geminiCandidate `json:"candidates"`
+}
+
+// GeminiConverter converts chats made with Gemini's generateContent API
+type GeminiConverter struct {
+	store ToolStore
+}
+
+func (c *GeminiConverter) ToAsteroidMessages(
+	ctx context.Context,
+	requestData, responseData []byte,
+	runId uuid.UUID,
+) ([]AsteroidMessage, error) {
+	var chatRequest geminiRequest
+	if err := json.Unmarshal(requestData, &chatRequest); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal chat request: %w", err)
+	}
+
+	asteroidMsgs := make([]AsteroidMessage, 0, len(chatRequest.Contents)+2)
+
+	// Like Anthropic's system prompt, the system instruction is a field of the request
+	if len(chatRequest.SystemInstruction) > 0 {
+		var system geminiContent
+		if err := json.Unmarshal(chatRequest.SystemInstruction, &system); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal system instruction: %w", err)
+		}
+		converted, err := c.ConvertMessage(ctx, AsteroidMessageRoleSystem, system.Parts, chatRequest.SystemInstruction, runId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert system instruction: %w", err)
+		}
+		asteroidMsgs = append(asteroidMsgs, converted)
+	}
+
+	for _, raw := range chatRequest.Contents {
+		var content geminiContent
+		if err := json.Unmarshal(raw, &content); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal content: %w", err)
+		}
+		converted, err := c.ConvertMessage(ctx, geminiRole(content.Role), content.Parts, raw, runId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert message: %w", err)
+		}
+		asteroidMsgs = append(asteroidMsgs, converted)
+	}
+
+	// TODO support multiple candidates, like OpenAI chats only the first is a message of the chat
+	choices, err := c.ToAsteroidChoices(ctx, responseData, runId)
+	if err != nil {
+		return nil, err
+	}
+	if len(choices) > 0 {
+		asteroidMsgs = append(asteroidMsgs, choices[0].Message)
+	}
+
+	return asteroidMsgs, nil
+}
+
+// ToAsteroidChoices converts each candidate of the response to a choice
+func (c *GeminiConverter) ToAsteroidChoices(
+	ctx context.Context,
+	responseData []byte,
+	runId uuid.UUID,
+) ([]AsteroidChoice, error) {
+	var chatResponse geminiResponse
+	if err := json.Unmarshal(responseData, &chatResponse); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal chat response: %w", err)
+	}
+
+	choices := make([]AsteroidChoice, 0, len(chatResponse.Candidates))
+	for _, candidate := range chatResponse.Candidates {
+		content, err := json.Marshal(candidate.Content)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling candidate content: %w", err)
+		}
+
+		message, err := c.ConvertMessage(ctx, geminiRole(candidate.Content.Role), candidate.Content.Parts, content, runId)
+		if err != nil {
+			return nil, fmt.Errorf("error converting message: %w", err)
+		}
+
+		finishReason := geminiFinishReason(candidate.FinishReason)
+		if message.ToolCalls != nil && len(*message.ToolCalls) > 0 {
+			finishReason = ToolCalls
+		}
+
+		choices = append(choices, AsteroidChoice{
+			AsteroidId:   uuid.New().String(),
+			Index:        candidate.Index,
+			Message:      message,
+			FinishReason: finishReason,
+		})
+	}
+
+	return choices, nil
+}
+
+// geminiRole maps the role of a content to a message role. Gemini calls the assistant the model,
+// and contents without a role are the user's.
+func geminiRole(role string) AsteroidMessageRole {
+	switch role {
+	case "model":
+		return AsteroidMessageRoleAssistant
+	case "function", "tool":
+		return AsteroidMessageRoleTool
+	default:
+		return AsteroidMessageRoleUser
+	}
+}
+
+// geminiFinishReason maps the finish reason of a candidate to the finish reason of a choice. Gemini
+// finishes with STOP when it calls functions, so those are told apart by the candidate's parts.
+func geminiFinishReason(finishReason string) AsteroidChoiceFinishReason {
+	switch finishReason {
+	case "MAX_TOKENS":
+		return Length
+	case "SAFETY", "RECITATION", "BLOCKLIST", "PROHIBITED_CONTENT", "SPII":
+		return ContentFilter
+	default:
+		return Stop
+	}
+}
+
+// ConvertMessage converts a message from its role and parts. Text parts, and the responses of
+// functions, are joined into the message's content and functionCall parts become its tool calls. A
+// user message of only function responses is a tool message.
+func (c *GeminiConverter) ConvertMessage(
+	ctx context.Context,
+	role AsteroidMessageRole,
+	parts []geminiPart,
+	raw []byte,
+	runId uuid.UUID,
+) (AsteroidMessage, error) {
+	texts := make([]string, 0, len(parts))
+	toolCalls := make([]AsteroidToolCall, 0)
+	msgType := Text
+	var imageContent string
+	functionResponses := 0
+
+	for _, part := range parts {
+		switch {
+		case part.FunctionCall != nil:
+			toolCall, err := c.ConvertToolCall(ctx, *part.FunctionCall, runId)
+			if err != nil {
+				return AsteroidMessage{}, fmt.Errorf("error converting tool calls: %w", err)
+			}
+			toolCalls = append(toolCalls, *toolCall)
+		case part.FunctionResponse != nil:
+			functionResponses++
+			if len(part.FunctionResponse.Response) > 0 {
+				texts = append(texts, string(part.FunctionResponse.Response))
+			}
+		case part.InlineData != nil && strings.HasPrefix(part.InlineData.MimeType, "image/"):
+			msgType = ImageUrl
+			imageContent = fmt.Sprintf("data:%s;base64,%s", part.InlineData.MimeType, part.InlineData.Data)
+		case part.FileData != nil && strings.HasPrefix(part.FileData.MimeType, "image/"):
+			msgType = ImageUrl
+			imageContent = part.FileData.FileUri
+		case part.Text != "":
+			texts = append(texts, part.Text)
+		}
+	}
+
+	if role == AsteroidMessageRoleUser && functionResponses > 0 && functionResponses == len(parts) {
+		role = AsteroidMessageRoleTool
+	}
+
+	msgContent := imageContent
+	var language *string
+	if msgType == Text {
+		msgContent = NormalizeContent(strings.Join(texts, "\n"))
+		detected := DetectLanguage(msgContent)
+		language = &detected
+	}
+
+	b64 := base64.StdEncoding.EncodeToString(raw)
+	id := uuid.New()
+
+	return AsteroidMessage{
+		Id:        &id,
+		Role:      role,
+		ToolCalls: &toolCalls,
+		Type:      &msgType,
+		Content:   msgContent,
+		Data:      &b64,
+		Language:  language,
+	}, nil
+}
+
+func (c *GeminiConverter) ConvertToolCall(
+	ctx context.Context,
+	call geminiFunctionCall,
+	runId uuid.UUID,
+) (*AsteroidToolCall, error) {
+	tool, err := c.store.GetToolFromNameAndRunId(ctx, call.Name, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool: %w", err)
+	}
+	if tool == nil {
+		return nil, fmt.Errorf("tool not found: %s", call.Name)
+	}
+
+	// Gemini sends the args as an object, like Anthropic
+	arguments := "{}"
+	if len(call.Args) > 0 {
+		arguments = string(call.Args)
+	}
+	name := call.Name
+
+	// Only some Gemini models give function calls an ID. Calls without one get an ID of ours, which
+	// agents get back in the tool_call_id of the stored chat.
+	callId := call.Id
+	if callId == "" {
+		callId = uuid.New().String()
+	}
+
+	return &AsteroidToolCall{
+		CallId:    &callId,
+		Id:        uuid.New(),
+		ToolId:    *tool.Id,
+		Name:      &name,
+		Arguments: &arguments,
+	}, nil
+}
+
+// ValidateB64EncodedRequest checks the request is a generateContent request. Like Anthropic chats the
+// request is stored as it was sent.
+func (c *GeminiConverter) ValidateB64EncodedRequest(encodedData string) ([]byte, error) {
+	decodedRequest, err := decodeB64JSON(encodedData)
+	if err != nil {
+		return nil, err
+	}
+
+	var v geminiRequest
+	if err := json.Unmarshal(decodedRequest, &v); err != nil {
+		return nil, fmt.Errorf("invalid request format: %w", err)
+	}
+	if len(v.SystemInstruction) > 0 {
+		var system geminiContent
+		if err := json.Unmarshal(v.SystemInstruction, &system); err != nil {
+			return nil, fmt.Errorf("invalid request format: systemInstruction must be a content: %w", err)
+		}
+	}
+	for i, raw := range v.Contents {
+		var content geminiContent
+		if err := json.Unmarshal(raw, &content); err != nil {
+			return nil, fmt.Errorf("invalid request format: content %d: %w", i, err)
+		}
+	}
+
+	return decodedRequest, nil
+}
+
+func (c *GeminiConverter) ValidateB64EncodedResponse(encodedData string) ([]byte, error) {
+	decodedResponse, err := decodeB64JSON(encodedData)
+	if err != nil {
+		return nil, err
+	}
+
+	var v geminiResponse
+	if err := json.Unmarshal(decodedResponse, &v); err != nil {
+		return nil, fmt.Errorf("invalid response format: %w", err)
+	}
+	if len(v.Candidates) == 0 {
+		return nil, fmt.Errorf("invalid response format: the response has no candidates")
+	}
+
+	return decodedResponse, nil
+}
@@ -21,6 +21,71 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for AlertMetric.
+const (
+	DecisionVolume AlertMetric = "decision_volume"
+	EscalationRate AlertMetric = "escalation_rate"
+	RejectionRate  AlertMetric = "rejection_rate"
+)
+
+// Defines values for AlertRuleKind.
+const (
+	NewTool    AlertRuleKind = "new_tool"
+	RateChange AlertRuleKind = "rate_change"
+)
+
+// Defines values for ApiKeyScope.
+const (
+	AdminProjects    ApiKeyScope = "admin:projects"
+	AdminSupervisors ApiKeyScope = "admin:supervisors"
+	ReadRuns         ApiKeyScope = "read:runs"
+	WriteDecisions   ApiKeyScope = "write:decisions"
+	WriteRuns        ApiKeyScope = "write:runs"
+)
+
+// Defines values for ArchiveFileStatus.
+const (
+	Altered ArchiveFileStatus = "altered"
+	Intact  ArchiveFileStatus = "intact"
+	Missing ArchiveFileStatus = "missing"
+)
+
+// Defines values for ArgumentChangeOp.
+const (
+	Added   ArgumentChangeOp = "added"
+	Changed ArgumentChangeOp = "changed"
+	Removed ArgumentChangeOp = "removed"
+)
+
+// Defines values for ArgumentConditionOperator.
+const (
+	Contains       ArgumentConditionOperator = "contains"
+	EndsWith       ArgumentConditionOperator = "ends_with"
+	Equals         ArgumentConditionOperator = "equals"
+	Exists         ArgumentConditionOperator = "exists"
+	GreaterOrEqual ArgumentConditionOperator = "greater_or_equal"
+	GreaterThan    ArgumentConditionOperator = "greater_than"
+	LessOrEqual    ArgumentConditionOperator = "less_or_equal"
+	LessThan       ArgumentConditionOperator = "less_than"
+	Matches        ArgumentConditionOperator = "matches"
+	NotEquals      ArgumentConditionOperator = "not_equals"
+	OneOf          ArgumentConditionOperator = "one_of"
+	StartsWith     ArgumentConditionOperator = "starts_with"
+)
+
+// Defines values for ArgumentDiffBasis.
+const (
+	PreviousApproval ArgumentDiffBasis = "previous_approval"
+	SafeBaseline     ArgumentDiffBasis = "safe_baseline"
+)
+
+// Defines values for AssignmentStrategy.
+const (
+	LeastLoaded AssignmentStrategy = "least_loaded"
+	RoundRobin  AssignmentStrategy = "round_robin"
+	SkillMatch  AssignmentStrategy = "skill_match"
+)
+
 // Defines values for AsteroidChoiceFinishReason.
 const (
 	ContentFilter AsteroidChoiceFinishReason = "content_filter"
@@ -41,6 +106,113 @@ const (
 	AsteroidMessageRoleUser      AsteroidMessageRole = "user"
 )
 
+// Defines values for AuditAction.
+const (
+	AuditActionAutonomyChanged        AuditAction = "autonomy_changed"
+	AuditActionChainEdited            AuditAction = "chain_edited"
+	AuditActionChatStreamCutOff       AuditAction = "chat_stream_cut_off"
+	AuditActionClarificationAnswered  AuditAction = "clarification_answered"
+	AuditActionClarificationRequested AuditAction = "clarification_requested"
+	AuditActionDecisionConflict       AuditAction = "decision_conflict"
+	AuditActionDecisionRecorded       AuditAction = "decision_recorded"
+	AuditActionExecutionRepaired      AuditAction = "execution_repaired"
+	AuditActionIncidentModeEnded      AuditAction = "incident_mode_ended"
+	AuditActionIncidentModeStarted    AuditAction = "incident_mode_started"
+	AuditActionKillSwitchActivated    AuditAction = "kill_switch_activated"
+	AuditActionKillSwitchDeactivated  AuditAction = "kill_switch_deactivated"
+	AuditActionKillSwitchRequested    AuditAction = "kill_switch_requested"
+	AuditActionPlanDecided            AuditAction = "plan_decided"
+	AuditActionRedactionsViewed       AuditAction = "redactions_viewed"
+	AuditActionResultDecided          AuditAction = "result_decided"
+	AuditActionReviewAssigned         AuditAction = "review_assigned"
+	AuditActionReviewClaimed          AuditAction = "review_claimed"
+	AuditActionReviewReassigned       AuditAction = "review_reassigned"
+	AuditActionReviewRecovered        AuditAction = "review_recovered"
+	AuditActionReviewReleased         AuditAction = "review_released"
+	AuditActionReviewReminded         AuditAction = "review_reminded"
+	AuditActionRunPaused              AuditAction = "run_paused"
+	AuditActionRunResumed             AuditAction = "run_resumed"
+	AuditActionTrustRelaxed           AuditAction = "trust_relaxed"
+	AuditActionTrustTightened         AuditAction = "trust_tightened"
+	AuditActionUtteranceBargedIn      AuditAction = "utterance_barged_in"
+)
+
+// Defines values for AuditChainBreakKind.
+const (
+	AnchorMismatch       AuditChainBreakKind = "anchor_mismatch"
+	HashMismatch         AuditChainBreakKind = "hash_mismatch"
+	PreviousHashMismatch AuditChainBreakKind = "previous_hash_mismatch"
+	SequenceGap          AuditChainBreakKind = "sequence_gap"
+)
+
+// Defines values for BackfillStatus.
+const (
+	BackfillCompleted BackfillStatus = "backfill_completed"
+	BackfillPaused    BackfillStatus = "backfill_paused"
+	BackfillRunning   BackfillStatus = "backfill_running"
+)
+
+// Defines values for CapabilityKind.
+const (
+	ApiVersionCapability      CapabilityKind = "api_version_capability"
+	ChatFormatCapability      CapabilityKind = "chat_format_capability"
+	DecisionCapability        CapabilityKind = "decision_capability"
+	SupervisorTypeCapability  CapabilityKind = "supervisor_type_capability"
+	VerdictBehaviorCapability CapabilityKind = "verdict_behavior_capability"
+)
+
+// Defines values for ChatFormat.
+const (
+	Anthropic ChatFormat = "anthropic"
+	Gemini    ChatFormat = "gemini"
+	Openai    ChatFormat = "openai"
+)
+
+// Defines values for ChatStreamStatus.
+const (
+	CutOff    ChatStreamStatus = "cut_off"
+	Stored    ChatStreamStatus = "stored"
+	Streaming ChatStreamStatus = "streaming"
+)
+
+// Defines values for CircuitBreakerState.
+const (
+	Closed   CircuitBreakerState = "closed"
+	HalfOpen CircuitBreakerState = "half_open"
+	Open     CircuitBreakerState = "open"
+)
+
+// Defines values for ComputerActionScrollDirection.
+const (
+	Down  ComputerActionScrollDirection = "down"
+	Left  ComputerActionScrollDirection = "left"
+	Right ComputerActionScrollDirection = "right"
+	Up    ComputerActionScrollDirection = "up"
+)
+
+// Defines values for ComputerActionType.
+const (
+	DoubleClick   ComputerActionType = "double_click"
+	Key           ComputerActionType = "key"
+	LeftClick     ComputerActionType = "left_click"
+	LeftClickDrag ComputerActionType = "left_click_drag"
+	MiddleClick   ComputerActionType = "middle_click"
+	MouseMove     ComputerActionType = "mouse_move"
+	RightClick    ComputerActionType = "right_click"
+	Screenshot    ComputerActionType = "screenshot"
+	Scroll        ComputerActionType = "scroll"
+	Type          ComputerActionType = "type"
+	Wait          ComputerActionType = "wait"
+)
+
+// Defines values for ConsentStatus.
+const (
+	AwaitingConsent ConsentStatus = "awaiting_consent"
+	ConsentDeclined ConsentStatus = "consent_declined"
+	ConsentExpired  ConsentStatus = "consent_expired"
+	ConsentGranted  ConsentStatus = "consent_granted"
+)
+
 // Defines values for Decision.
 const (
 	Approve   Decision = "approve"
@@ -50,6 +222,54 @@ const (
 	Terminate Decision = "terminate"
 )
 
+// Defines values for DiffOp.
+const (
+	Delete DiffOp = "delete"
+	Equal  DiffOp = "equal"
+	Insert DiffOp = "insert"
+)
+
+// Defines values for EnsembleAggregation.
+const (
+	Majority         EnsembleAggregation = "majority"
+	MaxRisk          EnsembleAggregation = "max_risk"
+	UnanimousApprove EnsembleAggregation = "unanimous_approve"
+)
+
+// Defines values for FailurePolicy.
+const (
+	EscalateOnFailure FailurePolicy = "escalate_on_failure"
+	FailClosed        FailurePolicy = "fail_closed"
+	FailOpen          FailurePolicy = "fail_open"
+)
+
+// Defines values for FeatureFlagReason.
+const (
+	FlagDefault          FeatureFlagReason = "flag_default"
+	InRollout            FeatureFlagReason = "in_rollout"
+	OrganizationTargeted FeatureFlagReason = "organization_targeted"
+	OutsideRollout       FeatureFlagReason = "outside_rollout"
+	ProjectTargeted      FeatureFlagReason = "project_targeted"
+)
+
+// Defines values for FeedbackPolicyKind.
+const (
+	MatchingArgumentRule FeedbackPolicyKind = "matching_argument_rule"
+	ToolRiskTier         FeedbackPolicyKind = "tool_risk_tier"
+)
+
+// Defines values for IngestionPayloadType.
+const (
+	Chat           IngestionPayloadType = "chat"
+	ToolDefinition IngestionPayloadType = "tool_definition"
+)
+
+// Defines values for KillSwitchAction.
+const (
+	Activate   KillSwitchAction = "activate"
+	Deactivate KillSwitchAction = "deactivate"
+)
+
 // Defines values for MessageRole.
 const (
 	MessageRoleAssistant MessageRole = "assistant"
@@ -65,346 +285,4727 @@ const (
 	Text     MessageType = "text"
 )
 
+// Defines values for MeteringMetric.
+const (
+	BytesStored         MeteringMetric = "bytes_stored"
+	SupervisorTokens    MeteringMetric = "supervisor_tokens"
+	ToolCallsSupervised MeteringMetric = "tool_calls_supervised"
+)
+
+// Defines values for NotificationEvent.
+const (
+	AlertFired      NotificationEvent = "alert_fired"
+	Escalated       NotificationEvent = "escalated"
+	Rejected        NotificationEvent = "rejected"
+	ReviewRequested NotificationEvent = "review_requested"
+	TimedOut        NotificationEvent = "timed_out"
+)
+
+// Defines values for PayloadTemplateTarget.
+const (
+	Notifications PayloadTemplateTarget = "notifications"
+	Webhooks      PayloadTemplateTarget = "webhooks"
+)
+
+// Defines values for PlanDeviationKind.
+const (
+	ArgumentMismatch PlanDeviationKind = "argument_mismatch"
+	OutOfOrder       PlanDeviationKind = "out_of_order"
+	UnplannedTool    PlanDeviationKind = "unplanned_tool"
+)
+
+// Defines values for QuotaMetric.
+const (
+	PendingReviews QuotaMetric = "pending_reviews"
+	RunsPerDay     QuotaMetric = "runs_per_day"
+	StoredBytes    QuotaMetric = "stored_bytes"
+)
+
+// Defines values for QuotaState.
+const (
+	HardLimitExceeded QuotaState = "hard_limit_exceeded"
+	SoftLimitExceeded QuotaState = "soft_limit_exceeded"
+	WithinQuota       QuotaState = "within_quota"
+)
+
+// Defines values for RedactionDetector.
+const (
+	ApiCredential    RedactionDetector = "api_credential"
+	CreditCardNumber RedactionDetector = "credit_card_number"
+	EmailAddress     RedactionDetector = "email_address"
+)
+
+// Defines values for ReminderAction.
+const (
+	EscalateToSession ReminderAction = "escalate_to_session"
+	NotifyAssignee    ReminderAction = "notify_assignee"
+	NotifyQueue       ReminderAction = "notify_queue"
+)
+
+// Defines values for ReplayChoicePick.
+const (
+	NextRequest ReplayChoicePick = "next_request"
+	OnlyChoice  ReplayChoicePick = "only_choice"
+)
+
+// Defines values for ResourceKind.
+const (
+	Arn      ResourceKind = "arn"
+	DbTable  ResourceKind = "db_table"
+	FilePath ResourceKind = "file_path"
+	Url      ResourceKind = "url"
+)
+
+// Defines values for ResourceMatch.
+const (
+	Exact  ResourceMatch = "exact"
+	Prefix ResourceMatch = "prefix"
+)
+
+// Defines values for ResultSupervisorAction.
+const (
+	FlagResult       ResultSupervisorAction = "flag_result"
+	HoldResult       ResultSupervisorAction = "hold_result"
+	QuarantineResult ResultSupervisorAction = "quarantine_result"
+)
+
+// Defines values for Reversibility.
+const (
+	Irreversible Reversibility = "irreversible"
+	Reversible   Reversibility = "reversible"
+	Unknown      Reversibility = "unknown"
+)
+
+// Defines values for RiskTier.
+const (
+	Critical RiskTier = "critical"
+	High     RiskTier = "high"
+	Low      RiskTier = "low"
+	Medium   RiskTier = "medium"
+)
+
+// Defines values for RunArchiveFormat.
+const (
+	Json  RunArchiveFormat = "json"
+	TarGz RunArchiveFormat = "tar_gz"
+)
+
+// Defines values for RunExportLineType.
+const (
+	ExportCompleted RunExportLineType = "export_completed"
+	RunExported     RunExportLineType = "run_exported"
+)
+
+// Defines values for RunLimitKind.
+const (
+	MaxChatRequests        RunLimitKind = "max_chat_requests"
+	MaxPendingSupervisions RunLimitKind = "max_pending_supervisions"
+	ToolCallsPerMinute     RunLimitKind = "tool_calls_per_minute"
+)
+
+// Defines values for RunLimitSource.
+const (
+	ProjectRunLimit    RunLimitSource = "project_run_limit"
+	ServerDefaultLimit RunLimitSource = "server_default_limit"
+)
+
+// Defines values for RunPriority.
+const (
+	Batch       RunPriority = "batch"
+	Interactive RunPriority = "interactive"
+	Realtime    RunPriority = "realtime"
+)
+
+// Defines values for SearchHitKind.
+const (
+	DecisionHit SearchHitKind = "decision_hit"
+	MessageHit  SearchHitKind = "message_hit"
+	ToolCallHit SearchHitKind = "tool_call_hit"
+)
+
+// Defines values for ServiceNowSupervisorAttributesChangeType.
+const (
+	Emergency ServiceNowSupervisorAttributesChangeType = "emergency"
+	Normal    ServiceNowSupervisorAttributesChangeType = "normal"
+	Standard  ServiceNowSupervisorAttributesChangeType = "standard"
+)
+
 // Defines values for Status.
 const (
-	Assigned  Status = "assigned"
-	Completed Status = "completed"
-	Failed    Status = "failed"
-	Pending   Status = "pending"
-	Timeout   Status = "timeout"
+	Assigned              Status = "assigned"
+	AwaitingClarification Status = "awaiting_clarification"
+	Completed             Status = "completed"
+	Failed                Status = "failed"
+	Paused                Status = "paused"
+	Pending               Status = "pending"
+	Timeout               Status = "timeout"
+)
+
+// Defines values for StoredContentKind.
+const (
+	ChatMessage  StoredContentKind = "chat_message"
+	ChatRequest  StoredContentKind = "chat_request"
+	ChatResponse StoredContentKind = "chat_response"
 )
 
 // Defines values for SupervisorType.
 const (
-	ClientSupervisor SupervisorType = "client_supervisor"
-	HumanSupervisor  SupervisorType = "human_supervisor"
-	NoSupervisor     SupervisorType = "no_supervisor"
+	ClientSupervisor      SupervisorType = "client_supervisor"
+	ComputerUseSupervisor SupervisorType = "computer_use_supervisor"
+	ConsentSupervisor     SupervisorType = "consent_supervisor"
+	EnsembleSupervisor    SupervisorType = "ensemble_supervisor"
+	HumanSupervisor       SupervisorType = "human_supervisor"
+	LlmSupervisor         SupervisorType = "llm_supervisor"
+	NoSupervisor          SupervisorType = "no_supervisor"
+	PolicySupervisor      SupervisorType = "policy_supervisor"
+	ServicenowSupervisor  SupervisorType = "servicenow_supervisor"
 )
 
-// AsteroidChat The raw b64 encoded JSON of the request and response data sent/received from the LLM.
-type AsteroidChat struct {
-	RequestData  string `json:"request_data"`
-	ResponseData string `json:"response_data"`
+// Defines values for TaskTimelineEvent.
+const (
+	ChatCompletion TaskTimelineEvent = "chat_completion"
+	ExternalEvent  TaskTimelineEvent = "external_event"
+	RunStarted     TaskTimelineEvent = "run_started"
+	ToolCallEvent  TaskTimelineEvent = "tool_call_event"
+)
+
+// Defines values for TimeoutFallback.
+const (
+	AutoApprove    TimeoutFallback = "auto_approve"
+	AutoReject     TimeoutFallback = "auto_reject"
+	EscalateToNext TimeoutFallback = "escalate_to_next"
+)
+
+// Defines values for TimerKind.
+const (
+	DecisionTimeout TimerKind = "decision_timeout"
+	ReviewReminder  TimerKind = "review_reminder"
+)
+
+// Defines values for ToolCallHistoryEvent.
+const (
+	AgentAnswered     ToolCallHistoryEvent = "agent_answered"
+	AskedAgent        ToolCallHistoryEvent = "asked_agent"
+	AssignedToSession ToolCallHistoryEvent = "assigned_to_session"
+	Created           ToolCallHistoryEvent = "created"
+	Decided           ToolCallHistoryEvent = "decided"
+	DecisionLost      ToolCallHistoryEvent = "decision_lost"
+	HandedOver        ToolCallHistoryEvent = "handed_over"
+	Recovered         ToolCallHistoryEvent = "recovered"
+	Reminded          ToolCallHistoryEvent = "reminded"
+	StatusChanged     ToolCallHistoryEvent = "status_changed"
+)
+
+// Defines values for ToolCallResultStatus.
+const (
+	ResultAccepted    ToolCallResultStatus = "result_accepted"
+	ResultFlagged     ToolCallResultStatus = "result_flagged"
+	ResultHeld        ToolCallResultStatus = "result_held"
+	ResultQuarantined ToolCallResultStatus = "result_quarantined"
+)
+
+// Defines values for TranscriptSpeaker.
+const (
+	TranscriptSpeakerAgent  TranscriptSpeaker = "agent"
+	TranscriptSpeakerCaller TranscriptSpeaker = "caller"
+)
+
+// Defines values for Transport.
+const (
+	Grpc      Transport = "grpc"
+	Http      Transport = "http"
+	Sse       Transport = "sse"
+	Websocket Transport = "websocket"
+)
+
+// Defines values for TruncationStrategy.
+const (
+	DropOldest TruncationStrategy = "drop_oldest"
+	Fail       TruncationStrategy = "fail"
+	MiddleOut  TruncationStrategy = "middle_out"
+	Summarize  TruncationStrategy = "summarize"
+)
+
+// Defines values for VerdictBehavior.
+const (
+	Block    VerdictBehavior = "block"
+	Clarify  VerdictBehavior = "clarify"
+	Continue VerdictBehavior = "continue"
+)
+
+// Defines values for WatchEvent.
+const (
+	NewToolCall      WatchEvent = "new_tool_call"
+	RunFinished      WatchEvent = "run_finished"
+	ToolCallRejected WatchEvent = "tool_call_rejected"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Abandoned WebhookDeliveryStatus = "abandoned"
+	Delivered WebhookDeliveryStatus = "delivered"
+	Queued    WebhookDeliveryStatus = "queued"
+)
+
+// Defines values for WebhookEvent.
+const (
+	BargeIn              WebhookEvent = "barge_in"
+	ChainFailed          WebhookEvent = "chain_failed"
+	DecisionMade         WebhookEvent = "decision_made"
+	RunCompleted         WebhookEvent = "run_completed"
+	SupervisionRequested WebhookEvent = "supervision_requested"
+	ToolCallResultHeld   WebhookEvent = "tool_call_result_held"
+)
+
+// Agent A registered build of an agent. Runs that reference an agent can only register the tools it declares, and its tool policies apply on top of the project's.
+type Agent struct {
+	Capabilities []string           `json:"capabilities"`
+	CreatedAt    time.Time          `json:"created_at"`
+	Id           openapi_types.UUID `json:"id"`
+	Name         string             `json:"name"`
+	ProjectId    openapi_types.UUID `json:"project_id"`
+
+	// ToolPolicies Policies for this build, which replace the project's policy for a tool unless the organization locked it
+	ToolPolicies []ToolPolicy `json:"tool_policies"`
+
+	// Tools Names of the tools this build may use, or * for any tool
+	Tools   []string `json:"tools"`
+	Version string   `json:"version"`
 }
 
-// AsteroidChoice defines model for AsteroidChoice.
-type AsteroidChoice struct {
-	AsteroidId   string                     `json:"asteroid_id"`
-	FinishReason AsteroidChoiceFinishReason `json:"finish_reason"`
-	Index        int                        `json:"index"`
-	Message      AsteroidMessage            `json:"message"`
+// AgentToolTrust defines model for AgentToolTrust.
+type AgentToolTrust struct {
+	AgentId      openapi_types.UUID `json:"agent_id"`
+	ApprovalRate float64            `json:"approval_rate"`
+	Approvals    int                `json:"approvals"`
+
+	// AutonomyLevel How much of a run's supervision is left to automated supervisors, decided when its tool calls' chains are selected. 0 is fully manual, chains with a human supervisor are the only ones consulted when a tool has any. 1 leaves tools below medium risk to automated chains and 2 those below high risk. 3 is fully automatic, chains with a human supervisor are skipped. Tools without a risk tier are treated as needing humans below 3. Runs are supervised at the higher of their own level and the one their agent earned with the tool. Runs without either level are supervised by every chain, and chains of an active incident always apply.
+	AutonomyLevel *AutonomyLevel `json:"autonomy_level,omitempty"`
+
+	// ConsecutiveApprovals Approvals since the last rejection
+	ConsecutiveApprovals int       `json:"consecutive_approvals"`
+	Rejections           int       `json:"rejections"`
+	ToolName             string    `json:"tool_name"`
+	UpdatedAt            time.Time `json:"updated_at"`
 }
 
-// AsteroidChoiceFinishReason defines model for AsteroidChoice.FinishReason.
-type AsteroidChoiceFinishReason string
+// Alert defines model for Alert.
+type Alert struct {
+	Details map[string]interface{} `json:"details"`
+	FiredAt time.Time              `json:"fired_at"`
+	Id      openapi_types.UUID     `json:"id"`
 
-// AsteroidMessage defines model for AsteroidMessage.
-type AsteroidMessage struct {
-	Content   string     `json:"content"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Key What the alert is for, the tool's name for new_tool and the start of the window for rate_change. A rule fires once per key.
+	Key string `json:"key"`
 
-	// Data The raw b64 encoded JSON of the message objects in its original form
-	Data      *string             `json:"data,omitempty"`
-	Id        *openapi_types.UUID `json:"id,omitempty"`
-	Role      AsteroidMessageRole `json:"role"`
-	ToolCalls *[]AsteroidToolCall `json:"tool_calls,omitempty"`
-	Type      *MessageType        `json:"type,omitempty"`
+	// Kind new_tool alerts when a tool is called in the project for the first time. rate_change alerts when a metric of a window changed by the rule's factor from the window before it.
+	Kind      AlertRuleKind      `json:"kind"`
+	Message   string             `json:"message"`
+	ProjectId openapi_types.UUID `json:"project_id"`
+	RuleName  string             `json:"rule_name"`
 }
 
-// AsteroidMessageRole defines model for AsteroidMessage.Role.
-type AsteroidMessageRole string
+// AlertMetric rejection_rate and escalation_rate are the share of a window's decisions that rejected or escalated, decision_volume is the number of decisions
+type AlertMetric string
 
-// AsteroidToolCall defines model for AsteroidToolCall.
-type AsteroidToolCall struct {
-	// Arguments Arguments in JSON format
-	Arguments *string            `json:"arguments,omitempty"`
-	CallId    *string            `json:"call_id,omitempty"`
-	CreatedAt *time.Time         `json:"created_at,omitempty"`
-	Id        openapi_types.UUID `json:"id"`
-	Name      *string            `json:"name,omitempty"`
-	ToolId    openapi_types.UUID `json:"tool_id"`
+// AlertRule defines model for AlertRule.
+type AlertRule struct {
+	// Factor How much a rate_change rule's metric has to change by, 2 for doubling. Factors below 1 alert when the metric drops, 0.5 for halving.
+	Factor *float64 `json:"factor,omitempty"`
+
+	// Kind new_tool alerts when a tool is called in the project for the first time. rate_change alerts when a metric of a window changed by the rule's factor from the window before it.
+	Kind AlertRuleKind `json:"kind"`
+
+	// Metric rejection_rate and escalation_rate are the share of a window's decisions that rejected or escalated, decision_volume is the number of decisions
+	Metric *AlertMetric `json:"metric,omitempty"`
+
+	// MinDecisions Decisions a window needs for a rate_change rule to compare it, 10 by default. Only the earlier window needs them for decision_volume, so drops to no decisions are alerted on.
+	MinDecisions *int `json:"min_decisions,omitempty"`
+
+	// Name Unique within the project
+	Name string `json:"name"`
+
+	// ToolNames Tools a new_tool rule watches, every tool if unset
+	ToolNames *[]string `json:"tool_names,omitempty"`
+
+	// WindowSeconds Length of the windows a rate_change rule compares, 3600 by default
+	WindowSeconds *int `json:"window_seconds,omitempty"`
 }
 
-// ChainExecution defines model for ChainExecution.
-type ChainExecution struct {
-	ChainId    openapi_types.UUID `json:"chain_id"`
+// AlertRuleKind new_tool alerts when a tool is called in the project for the first time. rate_change alerts when a metric of a window changed by the rule's factor from the window before it.
+type AlertRuleKind string
+
+// ApiKey defines model for ApiKey.
+type ApiKey struct {
 	CreatedAt  time.Time          `json:"created_at"`
+	ExpiresAt  *time.Time         `json:"expires_at,omitempty"`
 	Id         openapi_types.UUID `json:"id"`
-	ToolcallId openapi_types.UUID `json:"toolcall_id"`
+	LastUsedAt *time.Time         `json:"last_used_at,omitempty"`
+	Name       string             `json:"name"`
+
+	// Prefix The first characters of the key, to tell keys apart
+	Prefix string `json:"prefix"`
+
+	// ProjectId The project the key is limited to. Keys without one can reach every project.
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+	RevokedAt *time.Time          `json:"revoked_at,omitempty"`
+
+	// RotatedTo The key this one was rotated into
+	RotatedTo *openapi_types.UUID `json:"rotated_to,omitempty"`
+	Scopes    []ApiKeyScope       `json:"scopes"`
+
+	// UserId The user the key belongs to. Rotating the key keeps it theirs.
+	UserId *openapi_types.UUID `json:"user_id,omitempty"`
 }
 
-// ChainExecutionState defines model for ChainExecutionState.
-type ChainExecutionState struct {
-	Chain               SupervisorChain           `json:"chain"`
-	ChainExecution      ChainExecution            `json:"chain_execution"`
-	SupervisionRequests []SupervisionRequestState `json:"supervision_requests"`
+// ApiKeyScope What an API key may do. read:runs allows every read, the others each allow one kind of write.
+type ApiKeyScope string
+
+// Archive The archive of a UTC day. Its files are JSON lines under archives/YYYY-MM-DD/ in the bucket, with a manifest.json written last that lists each file's record count, size and SHA-256.
+type Archive struct {
+	// Day UTC day in YYYY-MM-DD form
+	Day        string    `json:"day"`
+	ExportedAt time.Time `json:"exported_at"`
+
+	// Location Where the archive's manifest was written
+	Location string `json:"location"`
+
+	// ManifestSha256 Hex SHA-256 of the manifest as it was written
+	ManifestSha256 string `json:"manifest_sha256"`
+
+	// Records Number of records in the archive's files
+	Records int `json:"records"`
+
+	// RetainUntil Time until which the bucket's object lock keeps the archive from being changed or deleted
+	RetainUntil time.Time `json:"retain_until"`
 }
 
-// ChainRequest defines model for ChainRequest.
-type ChainRequest struct {
-	// SupervisorIds Array of supervisor IDs to create chains with
-	SupervisorIds *[]openapi_types.UUID `json:"supervisor_ids,omitempty"`
+// ArchiveFileStatus intact when the file hashes to its hash in the manifest, missing when the bucket doesn't have it and altered when it hashes to something else
+type ArchiveFileStatus string
+
+// ArchiveFileVerification defines model for ArchiveFileVerification.
+type ArchiveFileVerification struct {
+	// MissingRecords Number of records of the day in the database that the file doesn't have
+	MissingRecords int    `json:"missing_records"`
+	Name           string `json:"name"`
+
+	// Status intact when the file hashes to its hash in the manifest, missing when the bucket doesn't have it and altered when it hashes to something else
+	Status ArchiveFileStatus `json:"status"`
 }
 
-// ChatIds defines model for ChatIds.
-type ChatIds struct {
-	ChatId    openapi_types.UUID `json:"chat_id"`
-	ChoiceIds []ChoiceIds        `json:"choice_ids"`
+// ArchiveManifest The manifest.json of an archive. Audit events are archived in chain order with their sequence and hashes, so the chain can be checked across the archives of consecutive days.
+type ArchiveManifest struct {
+	AuditFirstSequence *int64                `json:"audit_first_sequence,omitempty"`
+	AuditLastHash      *string               `json:"audit_last_hash,omitempty"`
+	AuditLastSequence  *int64                `json:"audit_last_sequence,omitempty"`
+	AuditPreviousHash  *string               `json:"audit_previous_hash,omitempty"`
+	Day                string                `json:"day"`
+	Files              []ArchiveManifestFile `json:"files"`
+	FormatVersion      int                   `json:"format_version"`
+	GeneratedAt        time.Time             `json:"generated_at"`
 }
 
-// ChoiceIds defines model for ChoiceIds.
-type ChoiceIds struct {
-	ChoiceId    string        `json:"choice_id"`
-	MessageId   string        `json:"message_id"`
-	ToolCallIds []ToolCallIds `json:"tool_call_ids"`
+// ArchiveManifestFile defines model for ArchiveManifestFile.
+type ArchiveManifestFile struct {
+	Bytes   int64  `json:"bytes"`
+	Name    string `json:"name"`
+	Records int    `json:"records"`
+	Sha256  string `json:"sha256"`
 }
 
-// Decision defines model for Decision.
-type Decision string
+// ArchiveVerification defines model for ArchiveVerification.
+type ArchiveVerification struct {
+	// Complete Whether the manifest and every file are intact and no records of the day are missing
+	Complete bool                      `json:"complete"`
+	Day      string                    `json:"day"`
+	Files    []ArchiveFileVerification `json:"files"`
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Details *string `json:"details,omitempty"`
-	Error   string  `json:"error"`
+	// ManifestIntact Whether the manifest hashes to the hash recorded when the archive was exported
+	ManifestIntact bool      `json:"manifest_intact"`
+	VerifiedAt     time.Time `json:"verified_at"`
 }
 
-// HubStats defines model for HubStats.
-type HubStats struct {
-	AssignedReviews       map[string]int `json:"assigned_reviews"`
-	AssignedReviewsCount  int            `json:"assigned_reviews_count"`
-	BusyClients           int            `json:"busy_clients"`
-	CompletedReviewsCount int            `json:"completed_reviews_count"`
-	ConnectedClients      int            `json:"connected_clients"`
-	FreeClients           int            `json:"free_clients"`
-	PendingReviewsCount   int            `json:"pending_reviews_count"`
-	ReviewDistribution    map[string]int `json:"review_distribution"`
+// ArgumentBaseline Arguments known to be safe for a tool, which reviewers see its calls compared with
+type ArgumentBaseline struct {
+	// Arguments The arguments in JSON format, like those of a tool call
+	Arguments string `json:"arguments"`
+
+	// Description Why the arguments are safe, for reviewers
+	Description *string `json:"description,omitempty"`
+	ToolName    string  `json:"tool_name"`
 }
 
-// MessageRole defines model for MessageRole.
-type MessageRole string
+// ArgumentChange defines model for ArgumentChange.
+type ArgumentChange struct {
+	// Current The field's value in this call as JSON, unset if it was removed
+	Current *string          `json:"current,omitempty"`
+	Op      ArgumentChangeOp `json:"op"`
 
-// MessageType defines model for MessageType.
-type MessageType string
+	// Path A JSON pointer to the field, like /recipients/0. Empty when the arguments differ as a whole, like when either isn't an object or isn't valid JSON.
+	Path string `json:"path"`
 
-// Project defines model for Project.
-type Project struct {
-	CreatedAt     time.Time          `json:"created_at"`
-	Id            openapi_types.UUID `json:"id"`
-	Name          string             `json:"name"`
-	RunResultTags []string           `json:"run_result_tags"`
+	// Previous The field's value in the approved call or baseline as JSON, unset if it was added
+	Previous *string `json:"previous,omitempty"`
+
+	// Segments A word level diff of the two values, for strings that changed
+	Segments *[]DiffSegment `json:"segments,omitempty"`
 }
 
-// ReviewPayload Contains all the information needed for a human reviewer to make a supervision decision
-type ReviewPayload struct {
-	ChainState ChainExecutionState `json:"chain_state"`
+// ArgumentChangeOp defines model for ArgumentChangeOp.
+type ArgumentChangeOp string
 
-	// Messages The messages in the run
-	Messages []AsteroidMessage `json:"messages"`
+// ArgumentCondition A test of one field of a tool call's arguments. Fields that are missing or of the wrong type for the operator don't meet the condition.
+type ArgumentCondition struct {
+	// Operator How a field is tested. contains tests strings for a substring and arrays for an item equal to value. exists only tests the field is there, whatever its value.
+	Operator ArgumentConditionOperator `json:"operator"`
 
-	// RunId The ID of the run this review is for
-	RunId              openapi_types.UUID `json:"run_id"`
-	SupervisionRequest SupervisionRequest `json:"supervision_request"`
-	Toolcall           AsteroidToolCall   `json:"toolcall"`
+	// Path A JSON pointer to the field, like /amount or /recipients/0. Empty for the arguments as a whole.
+	Path string `json:"path"`
+
+	// Value What the field is compared with. Numbers for less_than, less_or_equal, greater_than and greater_or_equal, a regular expression for matches. For equals and not_equals it's compared with numbers as a number and with anything that isn't a string as its JSON. Numbers can be given as JSON numbers or strings, they're returned as strings.
+	Value *string `json:"value,omitempty"`
+
+	// Values The values one_of compares the field with, like value for equals, numbers can be JSON numbers
+	Values *[]string `json:"values,omitempty"`
 }
 
-// Run defines model for Run.
-type Run struct {
-	CreatedAt time.Time          `json:"created_at"`
+// ArgumentConditionOperator How a field is tested. contains tests strings for a substring and arrays for an item equal to value. exists only tests the field is there, whatever its value.
+type ArgumentConditionOperator string
+
+// ArgumentDiff defines model for ArgumentDiff.
+type ArgumentDiff struct {
+	// Basis What a call's arguments are compared with. previous_approval is the latest call of the tool in the run approved before it, safe_baseline the arguments registered as safe for the tool in its project. Unset when there's neither.
+	Basis *ArgumentDiffBasis `json:"basis,omitempty"`
+
+	// Changes The fields that differ, with the fields of objects in alphabetical order
+	Changes []ArgumentChange `json:"changes"`
+
+	// PreviousToolCallId The approved call the arguments are compared with, if the basis is previous_approval
+	PreviousToolCallId *openapi_types.UUID `json:"previous_tool_call_id,omitempty"`
+	ToolCallId         openapi_types.UUID  `json:"tool_call_id"`
+}
+
+// ArgumentDiffBasis What a call's arguments are compared with. previous_approval is the latest call of the tool in the run approved before it, safe_baseline the arguments registered as safe for the tool in its project. Unset when there's neither.
+type ArgumentDiffBasis string
+
+// ArgumentField A field of a tool's arguments as its JSON Schema describes it, with the call's value
+type ArgumentField struct {
+	// Depth How deeply the field is nested, 0 for fields of the arguments themselves
+	Depth       int     `json:"depth"`
+	Description *string `json:"description,omitempty"`
+
+	// Enum The values the field is allowed to have, if the schema lists them
+	Enum *[]interface{} `json:"enum,omitempty"`
+
+	// Format The schema's format for the field, like email or date-time
+	Format *string `json:"format,omitempty"`
+
+	// Label The schema's title for the field, or else its name made readable
+	Label string `json:"label"`
+
+	// Path A JSON pointer to the field, like /recipients/0
+	Path string `json:"path"`
+
+	// Problem How the call's value doesn't fit the schema, like being of another type, not being one of the enum values or a required field being left out
+	Problem *string `json:"problem,omitempty"`
+
+	// Required Whether the object the field is in requires it
+	Required bool `json:"required"`
+
+	// Type The JSON Schema type of the field, like string, integer or array, empty if the schema doesn't say
+	Type string `json:"type"`
+
+	// Value The call's value of the field as JSON, unset if the call leaves it out
+	Value *string `json:"value,omitempty"`
+}
+
+// ArgumentForm defines model for ArgumentForm.
+type ArgumentForm struct {
+	// Error Why the arguments couldn't be read as the schema describes, like not being valid JSON
+	Error *string `json:"error,omitempty"`
+
+	// Fields The fields of the arguments, each object's fields in alphabetical order and followed by the fields nested in it
+	Fields     []ArgumentField    `json:"fields"`
+	ToolCallId openapi_types.UUID `json:"tool_call_id"`
+
+	// UnknownPaths JSON pointers to the arguments' fields the schema doesn't describe
+	UnknownPaths []string `json:"unknown_paths"`
+}
+
+// ArgumentRule Approves or rejects calls of a tool whose arguments meet every condition, without asking the supervisors of its chains. A project's rules are tried in order and the first that matches decides.
+type ArgumentRule struct {
+	// ChainId Only decide the tool call for this chain, unset for every chain of the tool
+	ChainId    *openapi_types.UUID `json:"chain_id,omitempty"`
+	Conditions []ArgumentCondition `json:"conditions"`
+	Decision   Decision            `json:"decision"`
+	Name       string              `json:"name"`
+
+	// ToolName The tool the rule applies to, or * for every tool
+	ToolName string `json:"tool_name"`
+}
+
+// AssignmentStrategy How a human supervisor's reviews are assigned to connected reviewer sessions with capacity.
+// round_robin takes turns, least_loaded picks the session with the fewest reviews and
+// skill_match picks the least loaded session with a skill among the tool's category or
+// categories attributes. Reviewers list their skills in the skills query parameter of the
+// WebSocket, unless an admin tagged their session with SetReviewer. Without a matching session
+// skill matched reviews go to any session, unless require_skill_match is set. Defaults to
+// least_loaded. Whatever the strategy, only sessions with the skills the project's routing
+// rules require are considered.
+type AssignmentStrategy string
+
+// AsteroidChat The raw b64 encoded JSON of the request and response data sent/received from the LLM.
+type AsteroidChat struct {
+	// Format The LLM API a chat's request and response are in, OpenAI's Chat Completions, Anthropic's Messages or Gemini's generateContent
+	Format       *ChatFormat `json:"format,omitempty"`
+	RequestData  string      `json:"request_data"`
+	ResponseData string      `json:"response_data"`
+}
+
+// AsteroidChoice defines model for AsteroidChoice.
+type AsteroidChoice struct {
+	AsteroidId   string                     `json:"asteroid_id"`
+	FinishReason AsteroidChoiceFinishReason `json:"finish_reason"`
+	Index        int                        `json:"index"`
+	Message      AsteroidMessage            `json:"message"`
+}
+
+// AsteroidChoiceFinishReason defines model for AsteroidChoice.FinishReason.
+type AsteroidChoiceFinishReason string
+
+// AsteroidMessage defines model for AsteroidMessage.
+type AsteroidMessage struct {
+	Content   string     `json:"content"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Data The raw b64 encoded JSON of the message objects in its original form
+	Data *string             `json:"data,omitempty"`
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+
+	// Language ISO 639-1 code of the detected language of the message content, "und" if it could not be determined
+	Language *string `json:"language,omitempty"`
+
+	// Redactions Where the content was redacted before it was stored
+	Redactions *[]RedactedSpan     `json:"redactions,omitempty"`
+	Role       AsteroidMessageRole `json:"role"`
+	ToolCalls  *[]AsteroidToolCall `json:"tool_calls,omitempty"`
+	Type       *MessageType        `json:"type,omitempty"`
+}
+
+// AsteroidMessageRole defines model for AsteroidMessage.Role.
+type AsteroidMessageRole string
+
+// AsteroidToolCall defines model for AsteroidToolCall.
+type AsteroidToolCall struct {
+	// Arguments Arguments in JSON format
+	Arguments *string            `json:"arguments,omitempty"`
+	CallId    *string            `json:"call_id,omitempty"`
+	CreatedAt *time.Time         `json:"created_at,omitempty"`
 	Id        openapi_types.UUID `json:"id"`
-	Result    *string            `json:"result,omitempty"`
-	Status    *Status            `json:"status,omitempty"`
-	TaskId    openapi_types.UUID `json:"task_id"`
+	Name      *string            `json:"name,omitempty"`
+
+	// Redactions Where the arguments were redacted before they were stored
+	Redactions *[]RedactedSpan    `json:"redactions,omitempty"`
+	ToolId     openapi_types.UUID `json:"tool_id"`
 }
 
-// RunExecution defines model for RunExecution.
-type RunExecution struct {
-	Chains   []ChainExecutionState `json:"chains"`
-	Status   Status                `json:"status"`
-	Toolcall AsteroidToolCall      `json:"toolcall"`
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditAnchor A hash of the audit log published to an external service
+type AuditAnchor struct {
+	AnchoredAt time.Time          `json:"anchored_at"`
+	Hash       string             `json:"hash"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// Receipt What the service responded with, e.g. a timestamp token
+	Receipt  *string `json:"receipt,omitempty"`
+	Sequence int64   `json:"sequence"`
+
+	// Url Where the hash was anchored
+	Url string `json:"url"`
 }
 
-// RunState defines model for RunState.
-type RunState = []RunExecution
+// AuditChainBreak defines model for AuditChainBreak.
+type AuditChainBreak struct {
+	AnchorId *openapi_types.UUID `json:"anchor_id,omitempty"`
+	EventId  *openapi_types.UUID `json:"event_id,omitempty"`
 
-// Status defines model for Status.
-type Status string
+	// Kind hash_mismatch is an event whose fields don't hash to its stored hash, previous_hash_mismatch
+	// one that doesn't chain to the event before it, sequence_gap a missing sequence and
+	// anchor_mismatch an anchored hash the chain no longer has
+	Kind     AuditChainBreakKind `json:"kind"`
+	Sequence int64               `json:"sequence"`
+}
 
-// SupervisionRequest defines model for SupervisionRequest.
-type SupervisionRequest struct {
-	ChainexecutionId *openapi_types.UUID `json:"chainexecution_id,omitempty"`
-	Id               *openapi_types.UUID `json:"id,omitempty"`
-	PositionInChain  int                 `json:"position_in_chain"`
-	Status           *SupervisionStatus  `json:"status,omitempty"`
-	SupervisorId     openapi_types.UUID  `json:"supervisor_id"`
+// AuditChainBreakKind hash_mismatch is an event whose fields don't hash to its stored hash, previous_hash_mismatch
+// one that doesn't chain to the event before it, sequence_gap a missing sequence and
+// anchor_mismatch an anchored hash the chain no longer has
+type AuditChainBreakKind string
+
+// AuditChainHead The newest event of the audit log's hash chain
+type AuditChainHead struct {
+	Hash     string `json:"hash"`
+	Sequence int64  `json:"sequence"`
 }
 
-// SupervisionRequestState defines model for SupervisionRequestState.
-type SupervisionRequestState struct {
-	Result             *SupervisionResult `json:"result,omitempty"`
-	Status             SupervisionStatus  `json:"status"`
-	SupervisionRequest SupervisionRequest `json:"supervision_request"`
+// AuditChainVerification defines model for AuditChainVerification.
+type AuditChainVerification struct {
+	AnchorsChecked int               `json:"anchors_checked"`
+	Breaks         []AuditChainBreak `json:"breaks"`
+
+	// Checked Number of events checked
+	Checked int64 `json:"checked"`
+
+	// Head The newest event of the audit log's hash chain
+	Head       AuditChainHead `json:"head"`
+	Intact     bool           `json:"intact"`
+	VerifiedAt time.Time      `json:"verified_at"`
 }
 
-// SupervisionResult defines model for SupervisionResult.
-type SupervisionResult struct {
-	CreatedAt            time.Time           `json:"created_at"`
-	Decision             Decision            `json:"decision"`
-	Id                   *openapi_types.UUID `json:"id,omitempty"`
-	Reasoning            string              `json:"reasoning"`
-	SupervisionRequestId openapi_types.UUID  `json:"supervision_request_id"`
-	ToolcallId           *openapi_types.UUID `json:"toolcall_id,omitempty"`
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	Action AuditAction `json:"action"`
+
+	// Actor Who acted, e.g. api_key:<name>, user:<id> for a key of a user, session:<key> for a reviewer connection, or system
+	Actor     string                 `json:"actor"`
+	CreatedAt time.Time              `json:"created_at"`
+	Details   map[string]interface{} `json:"details"`
+
+	// Hash Hex SHA-256 of the previous hash and the event's fields
+	Hash string             `json:"hash"`
+	Id   openapi_types.UUID `json:"id"`
+
+	// PreviousHash Hash of the event before this one, or 64 zeros for the first event
+	PreviousHash string             `json:"previous_hash"`
+	ResourceId   openapi_types.UUID `json:"resource_id"`
+
+	// ResourceType The kind of resource acted on, e.g. supervision_request
+	ResourceType string `json:"resource_type"`
+
+	// Sequence Position of the event in the audit log's hash chain, starting at 1
+	Sequence int64 `json:"sequence"`
 }
 
-// SupervisionStatus defines model for SupervisionStatus.
-type SupervisionStatus struct {
-	CreatedAt            time.Time           `json:"created_at"`
-	Id                   int                 `json:"id"`
-	Status               Status              `json:"status"`
-	SupervisionRequestId *openapi_types.UUID `json:"supervision_request_id,omitempty"`
+// AutonomyLevel How much of a run's supervision is left to automated supervisors, decided when its tool calls' chains are selected. 0 is fully manual, chains with a human supervisor are the only ones consulted when a tool has any. 1 leaves tools below medium risk to automated chains and 2 those below high risk. 3 is fully automatic, chains with a human supervisor are skipped. Tools without a risk tier are treated as needing humans below 3. Runs are supervised at the higher of their own level and the one their agent earned with the tool. Runs without either level are supervised by every chain, and chains of an active incident always apply.
+type AutonomyLevel = int
+
+// Backfill defines model for Backfill.
+type Backfill struct {
+	ChainId     *openapi_types.UUID `json:"chain_id,omitempty"`
+	CompletedAt *time.Time          `json:"completed_at,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	CreatedBy   string              `json:"created_by"`
+
+	// Evaluated How many of the tool calls were evaluated so far
+	Evaluated     int                `json:"evaluated"`
+	From          time.Time          `json:"from"`
+	Id            openapi_types.UUID `json:"id"`
+	ProjectId     openapi_types.UUID `json:"project_id"`
+	RatePerMinute int                `json:"rate_per_minute"`
+	Status        BackfillStatus     `json:"status"`
+	To            time.Time          `json:"to"`
+
+	// TotalCalls How many tool calls the backfill evaluates
+	TotalCalls int `json:"total_calls"`
+
+	// Truncated Whether the range had more calls than a backfill evaluates, in which case only the oldest are
+	Truncated bool `json:"truncated"`
 }
 
-// Supervisor defines model for Supervisor.
-type Supervisor struct {
-	Attributes  map[string]interface{} `json:"attributes"`
-	Code        string                 `json:"code"`
-	CreatedAt   time.Time              `json:"created_at"`
-	Description string                 `json:"description"`
-	Id          *openapi_types.UUID    `json:"id,omitempty"`
-	Name        string                 `json:"name"`
+// BackfillReport defines model for BackfillReport.
+type BackfillReport struct {
+	ActuallyApproved  int `json:"actually_approved"`
+	ActuallyEscalated int `json:"actually_escalated"`
+	ActuallyRejected  int `json:"actually_rejected"`
 
-	// Type The type of supervisor. ClientSupervisor means that the supervision is done client side and the server is merely informed. Other supervisor types are handled serverside, e.g. HumanSupervisor means that a human will review the request via the Asteroid UI.
-	Type SupervisorType `json:"type"`
+	// Agreed Decided calls the shadow chains would have treated the same way
+	Agreed     int                `json:"agreed"`
+	BackfillId openapi_types.UUID `json:"backfill_id"`
+
+	// Differences Every decided call the shadow chains would have treated differently
+	Differences []BackfillResult `json:"differences"`
+	Evaluated   int              `json:"evaluated"`
+
+	// MissedRejections Calls that were rejected but the shadow chains would have approved
+	MissedRejections int `json:"missed_rejections"`
+
+	// NewlyEscalated Calls that went through without escalation but the shadow chains would have escalated
+	NewlyEscalated int `json:"newly_escalated"`
+
+	// NewlyRejected Calls that went through but the shadow chains would have rejected
+	NewlyRejected int            `json:"newly_rejected"`
+	Status        BackfillStatus `json:"status"`
+	TotalCalls    int            `json:"total_calls"`
+
+	// Undecided Calls that were never decided, which aren't compared
+	Undecided int `json:"undecided"`
+
+	// Unpredicted Calls the shadow chains couldn't tell a decision for, which aren't compared
+	Unpredicted   int `json:"unpredicted"`
+	WouldApprove  int `json:"would_approve"`
+	WouldEscalate int `json:"would_escalate"`
+	WouldReject   int `json:"would_reject"`
 }
 
-// SupervisorChain defines model for SupervisorChain.
-type SupervisorChain struct {
-	ChainId     openapi_types.UUID `json:"chain_id"`
-	Supervisors []Supervisor       `json:"supervisors"`
+// BackfillRequest defines model for BackfillRequest.
+type BackfillRequest struct {
+	// ChainId The chain to shadow every tool call with. Without one, each call is shadowed by the chains its tool selects.
+	ChainId *openapi_types.UUID `json:"chain_id,omitempty"`
+	From    time.Time           `json:"from"`
+
+	// RatePerMinute How many tool calls are evaluated a minute, at most 600
+	RatePerMinute *int      `json:"rate_per_minute,omitempty"`
+	To            time.Time `json:"to"`
 }
 
-// SupervisorType The type of supervisor. ClientSupervisor means that the supervision is done client side and the server is merely informed. Other supervisor types are handled serverside, e.g. HumanSupervisor means that a human will review the request via the Asteroid UI.
-type SupervisorType string
+// BackfillResult defines model for BackfillResult.
+type BackfillResult struct {
+	Actual *Decision `json:"actual,omitempty"`
+
+	// ActuallyEscalated Whether any supervisor escalated the call
+	ActuallyEscalated bool      `json:"actually_escalated"`
+	CalledAt          time.Time `json:"called_at"`
+	Confidence        *float64  `json:"confidence,omitempty"`
+	EvaluatedAt       time.Time `json:"evaluated_at"`
+	Predicted         *Decision `json:"predicted,omitempty"`
+
+	// Reasoning Why the shadow chains would have decided the call so, or why they couldn't tell
+	Reasoning  string             `json:"reasoning"`
+	ToolCallId openapi_types.UUID `json:"tool_call_id"`
+	ToolName   string             `json:"tool_name"`
+}
 
-// Task defines model for Task.
-type Task struct {
-	CreatedAt   time.Time          `json:"created_at"`
-	Description *string            `json:"description,omitempty"`
-	Id          openapi_types.UUID `json:"id"`
-	Name        string             `json:"name"`
-	ProjectId   openapi_types.UUID `json:"project_id"`
+// BackfillStatus defines model for BackfillStatus.
+type BackfillStatus string
+
+// BatchDecision A review decided by a batch
+type BatchDecision struct {
+	ResultId             openapi_types.UUID `json:"result_id"`
+	SupervisionRequestId openapi_types.UUID `json:"supervision_request_id"`
+	ToolCallId           openapi_types.UUID `json:"tool_call_id"`
 }
 
-// Tool defines model for Tool.
-type Tool struct {
-	Attributes        map[string]interface{} `json:"attributes"`
-	Code              string                 `json:"code"`
-	Description       string                 `json:"description"`
-	Id                *openapi_types.UUID    `json:"id,omitempty"`
-	IgnoredAttributes *[]string              `json:"ignored_attributes,omitempty"`
-	Name              string                 `json:"name"`
-	RunId             openapi_types.UUID     `json:"run_id"`
+// BatchDecisionRequest defines model for BatchDecisionRequest.
+type BatchDecisionRequest struct {
+	Decision Decision `json:"decision"`
+
+	// Precedents The results of earlier decisions every decision of the batch cites, as on a SupervisionResult
+	Precedents *[]openapi_types.UUID `json:"precedents,omitempty"`
+	Reasoning  string                `json:"reasoning"`
+
+	// ToolCallIds The tool calls to decide, at most 100
+	ToolCallIds []openapi_types.UUID `json:"tool_call_ids"`
 }
 
-// ToolCallIds defines model for ToolCallIds.
-type ToolCallIds struct {
-	ToolCallId *string `json:"tool_call_id,omitempty"`
-	ToolId     *string `json:"tool_id,omitempty"`
+// BlastRadius Estimated from the resources the tool call's arguments refer to and what happened to earlier
+// tool calls of the project that touched them
+type BlastRadius struct {
+	Resources           []BlastRadiusResource `json:"resources"`
+	Reversibility       Reversibility         `json:"reversibility"`
+	ReversibilityReason string                `json:"reversibility_reason"`
+
+	// RiskTier How much damage a tool can do, which decides how heavily its calls are supervised
+	RiskTier *RiskTier `json:"risk_tier,omitempty"`
+	Summary  string    `json:"summary"`
 }
 
-// CreateProjectJSONBody defines parameters for CreateProject.
-type CreateProjectJSONBody struct {
-	Name          string   `json:"name"`
-	RunResultTags []string `json:"run_result_tags"`
+// BlastRadiusResource defines model for BlastRadiusResource.
+type BlastRadiusResource struct {
+	Identifier    string       `json:"identifier"`
+	Kind          ResourceKind `json:"kind"`
+	LastTouchedAt *time.Time   `json:"last_touched_at,omitempty"`
+
+	// PriorIncidents Incidents of the project during which the resource was touched
+	PriorIncidents int `json:"prior_incidents"`
+
+	// PriorRejections How many of those were rejected or terminated
+	PriorRejections int `json:"prior_rejections"`
+
+	// PriorToolCalls Earlier tool calls of the project that touched the resource
+	PriorToolCalls int `json:"prior_tool_calls"`
 }
 
-// CreateTaskJSONBody defines parameters for CreateTask.
-type CreateTaskJSONBody struct {
-	Description *string `json:"description,omitempty"`
-	Name        string  `json:"name"`
+// CapabilityIncompatibility defines model for CapabilityIncompatibility.
+type CapabilityIncompatibility struct {
+	Capability CapabilityKind `json:"capability"`
+	Reason     string         `json:"reason"`
+	Value      string         `json:"value"`
 }
 
-// UpdateRunResultJSONBody defines parameters for UpdateRunResult.
-type UpdateRunResultJSONBody struct {
-	Result *string `json:"result,omitempty"`
+// CapabilityKind defines model for CapabilityKind.
+type CapabilityKind string
+
+// CapabilityNegotiation defines model for CapabilityNegotiation.
+type CapabilityNegotiation struct {
+	// Compatible Whether there's no incompatibility
+	Compatible        bool                        `json:"compatible"`
+	Incompatibilities []CapabilityIncompatibility `json:"incompatibilities"`
+	Server            ServerCapabilities          `json:"server"`
 }
 
-// CreateRunToolJSONBody defines parameters for CreateRunTool.
-type CreateRunToolJSONBody struct {
-	Attributes        map[string]interface{} `json:"attributes"`
-	Code              string                 `json:"code"`
-	Description       string                 `json:"description"`
-	IgnoredAttributes *[]string              `json:"ignored_attributes,omitempty"`
-	Name              string                 `json:"name"`
+// ChainExecution defines model for ChainExecution.
+type ChainExecution struct {
+	ChainId openapi_types.UUID `json:"chain_id"`
+
+	// ChainVersion The version of the chain the execution started with, which it finishes under
+	ChainVersion int                `json:"chain_version"`
+	CreatedAt    time.Time          `json:"created_at"`
+	Id           openapi_types.UUID `json:"id"`
+	ToolcallId   openapi_types.UUID `json:"toolcall_id"`
 }
 
-// CreateToolSupervisorChainsJSONBody defines parameters for CreateToolSupervisorChains.
-type CreateToolSupervisorChainsJSONBody = []ChainRequest
+// ChainExecutionState defines model for ChainExecutionState.
+type ChainExecutionState struct {
+	Chain          SupervisorChain `json:"chain"`
+	ChainExecution ChainExecution  `json:"chain_execution"`
 
-// CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
-type CreateProjectJSONRequestBody CreateProjectJSONBody
+	// EscalationPath The escalations of the chain's supervisors so far, in chain order
+	EscalationPath      *[]Escalation             `json:"escalation_path,omitempty"`
+	SupervisionRequests []SupervisionRequestState `json:"supervision_requests"`
+}
 
-// CreateSupervisorJSONRequestBody defines body for CreateSupervisor for application/json ContentType.
-type CreateSupervisorJSONRequestBody = Supervisor
+// ChainOrder defines model for ChainOrder.
+type ChainOrder struct {
+	// SupervisorIds Each of the chain's supervisors, in their new order
+	SupervisorIds []openapi_types.UUID `json:"supervisor_ids"`
+}
 
-// CreateTaskJSONRequestBody defines body for CreateTask for application/json ContentType.
-type CreateTaskJSONRequestBody CreateTaskJSONBody
+// ChainRequest defines model for ChainRequest.
+type ChainRequest struct {
+	// MinConfidence Results of client supervisors with a lower confidence, or none, escalate to the next supervisor in the chain whatever their decision
+	MinConfidence *float64 `json:"min_confidence,omitempty"`
 
-// UpdateRunResultJSONRequestBody defines body for UpdateRunResult for application/json ContentType.
-type UpdateRunResultJSONRequestBody UpdateRunResultJSONBody
+	// SupervisorIds Array of supervisor IDs to create chains with
+	SupervisorIds      *[]openapi_types.UUID `json:"supervisor_ids,omitempty"`
+	SupervisorTiers    *[]SupervisorTier     `json:"supervisor_tiers,omitempty"`
+	SupervisorTimeouts *[]SupervisorTimeout  `json:"supervisor_timeouts,omitempty"`
+	Timeout            *ChainTimeout         `json:"timeout,omitempty"`
+}
 
-// UpdateRunStatusJSONRequestBody defines body for UpdateRunStatus for application/json ContentType.
-type UpdateRunStatusJSONRequestBody = Status
+// ChainSupervisorInsertion defines model for ChainSupervisorInsertion.
+type ChainSupervisorInsertion struct {
+	// Position Where the supervisor goes in the chain, at the end by default
+	Position     *int               `json:"position,omitempty"`
+	SupervisorId openapi_types.UUID `json:"supervisor_id"`
 
-// CreateRunToolJSONRequestBody defines body for CreateRunTool for application/json ContentType.
-type CreateRunToolJSONRequestBody CreateRunToolJSONBody
+	// Tier The supervisor's escalation tier, a tier of its own if unset
+	Tier    *int          `json:"tier,omitempty"`
+	Timeout *ChainTimeout `json:"timeout,omitempty"`
+}
 
-// CreateNewChatJSONRequestBody defines body for CreateNewChat for application/json ContentType.
-type CreateNewChatJSONRequestBody = AsteroidChat
+// ChainTimeout defines model for ChainTimeout.
+type ChainTimeout struct {
+	// Fallback What's decided when a supervisor doesn't decide in time. escalate_to_next escalates to the next supervisor in the chain, and rejects when the supervisor was the last one.
+	Fallback       TimeoutFallback `json:"fallback"`
+	TimeoutSeconds int             `json:"timeout_seconds"`
+}
 
-// CreateSupervisionResultJSONRequestBody defines body for CreateSupervisionResult for application/json ContentType.
-type CreateSupervisionResultJSONRequestBody = SupervisionResult
+// ChangeRequest defines model for ChangeRequest.
+type ChangeRequest struct {
+	// Approval The approval of the change request as ServiceNow last reported it, like requested, approved or rejected
+	Approval string `json:"approval"`
 
-// CreateToolSupervisorChainsJSONRequestBody defines body for CreateToolSupervisorChains for application/json ContentType.
-type CreateToolSupervisorChainsJSONRequestBody = CreateToolSupervisorChainsJSONBody
+	// CheckedAt When ServiceNow was last asked for the change request's approval
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
 
-// CreateSupervisionRequestJSONRequestBody defines body for CreateSupervisionRequest for application/json ContentType.
-type CreateSupervisionRequestJSONRequestBody = SupervisionRequest
+	// ClosedAt When the change request stopped being followed, as it decided the supervision request or the supervision request was decided otherwise
+	ClosedAt  *time.Time         `json:"closed_at,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get the OpenAPI schema
-	// (GET /openapi.yaml)
-	GetOpenAPI(w http.ResponseWriter, r *http.Request)
-	// Get all projects
-	// (GET /project)
-	GetProjects(w http.ResponseWriter, r *http.Request)
-	// Create a new project
-	// (POST /project)
-	CreateProject(w http.ResponseWriter, r *http.Request)
-	// Get a project
-	// (GET /project/{projectId})
-	GetProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
-	// Get all supervisors
-	// (GET /project/{projectId}/supervisor)
-	GetSupervisors(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
-	// Create a new supervisor
-	// (POST /project/{projectId}/supervisor)
-	CreateSupervisor(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
-	// Get all tasks for a project
-	// (GET /project/{projectId}/tasks)
-	GetProjectTasks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
-	// Create a new task
-	// (POST /project/{projectId}/tasks)
-	CreateTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
-	// Get all tools for a project
-	// (GET /project/{projectId}/tools)
-	GetProjectTools(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
-	// Get a run
-	// (GET /run/{runId})
-	GetRun(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Link Where the change request is shown in ServiceNow
+	Link string `json:"link"`
+
+	// Number The change request's number, like CHG0030001
+	Number string `json:"number"`
+
+	// State The state of the change request as ServiceNow last reported it
+	State                string             `json:"state"`
+	SupervisionRequestId openapi_types.UUID `json:"supervision_request_id"`
+
+	// SysId The change request's sys_id in ServiceNow
+	SysId string `json:"sys_id"`
+}
+
+// ChatFormat The LLM API a chat's request and response are in, OpenAI's Chat Completions, Anthropic's Messages or Gemini's generateContent
+type ChatFormat string
+
+// ChatIds defines model for ChatIds.
+type ChatIds struct {
+	ChatId    openapi_types.UUID `json:"chat_id"`
+	ChoiceIds []ChoiceIds        `json:"choice_ids"`
+}
+
+// ChatStream defines model for ChatStream.
+type ChatStream struct {
+	Choices   []ChatStreamChoice `json:"choices"`
+	CreatedAt time.Time          `json:"created_at"`
+	CutOff    *ChatStreamCutOff  `json:"cut_off,omitempty"`
+
+	// Done Whether the [DONE] event was received
+	Done bool `json:"done"`
+
+	// Events Number of events applied
+	Events int                `json:"events"`
+	Id     openapi_types.UUID `json:"id"`
+	RunId  openapi_types.UUID `json:"run_id"`
+
+	// Status streaming streams still take events, cut_off streams were stopped by a chat supervisor and
+	// stored streams have been completed
+	Status ChatStreamStatus `json:"status"`
+}
+
+// ChatStreamChoice defines model for ChatStreamChoice.
+type ChatStreamChoice struct {
+	Content      string               `json:"content"`
+	FinishReason *string              `json:"finish_reason,omitempty"`
+	Index        int                  `json:"index"`
+	ToolCalls    []ChatStreamToolCall `json:"tool_calls"`
+}
+
+// ChatStreamCutOff defines model for ChatStreamCutOff.
+type ChatStreamCutOff struct {
+	ChoiceIndex int       `json:"choice_index"`
+	CutOffAt    time.Time `json:"cut_off_at"`
+
+	// Match The text the supervisor's pattern matched
+	Match  string  `json:"match"`
+	Reason *string `json:"reason,omitempty"`
+
+	// Supervisor Name of the chat supervisor that matched
+	Supervisor string `json:"supervisor"`
+}
+
+// ChatStreamRequest defines model for ChatStreamRequest.
+type ChatStreamRequest struct {
+	// RequestData The base64 encoded chat completion request
+	RequestData string `json:"request_data"`
+}
+
+// ChatStreamStatus streaming streams still take events, cut_off streams were stopped by a chat supervisor and
+// stored streams have been completed
+type ChatStreamStatus string
+
+// ChatStreamToolCall defines model for ChatStreamToolCall.
+type ChatStreamToolCall struct {
+	// Arguments Arguments received so far, which aren't valid JSON until the tool call is complete
+	Arguments string  `json:"arguments"`
+	CallId    *string `json:"call_id,omitempty"`
+	Name      string  `json:"name"`
+}
+
+// ChatSupervisor Checks the choices of streamed chat completions as they're generated. A choice's text is its
+// content followed by the arguments of its tool calls, one per line. Also checks what voice
+// agents say, an utterance at a time, as it's transcribed.
+type ChatSupervisor struct {
+	Name string `json:"name"`
+
+	// Pattern RE2 regular expression that cuts a stream off when it matches the text of a choice
+	Pattern string `json:"pattern"`
+
+	// Reason Why a matching generation is cut off, returned to the agent
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ChoiceIds defines model for ChoiceIds.
+type ChoiceIds struct {
+	ChoiceId    string        `json:"choice_id"`
+	MessageId   string        `json:"message_id"`
+	ToolCallIds []ToolCallIds `json:"tool_call_ids"`
+}
+
+// CircuitBreaker defines model for CircuitBreaker.
+type CircuitBreaker struct {
+	ConsecutiveFailures int `json:"consecutive_failures"`
+
+	// FailurePolicy How an automated supervisor decides a request it fails to decide, because its model errors or
+	// times out, because its circuit breaker is open or because it was still deciding after
+	// STUCK_EXECUTION_SECONDS, as when the server stopped midway. fail_open approves, fail_closed rejects and
+	// escalate_on_failure escalates to the next supervisor. Defaults to escalate_on_failure.
+	FailurePolicy FailurePolicy `json:"failure_policy"`
+
+	// Failures Requests the supervisor failed to decide since the server started
+	Failures int `json:"failures"`
+
+	// OpenedAt When the breaker last opened
+	OpenedAt *time.Time `json:"opened_at,omitempty"`
+
+	// RetryAt When an open breaker tries the supervisor again
+	RetryAt *time.Time `json:"retry_at,omitempty"`
+
+	// ShortCircuited Requests decided by the failure policy without asking, while the breaker was open
+	ShortCircuited int `json:"short_circuited"`
+
+	// State closed asks the supervisor, open decides with its failure policy without asking, and half_open
+	// is trying the supervisor again after the cooldown
+	State CircuitBreakerState `json:"state"`
+
+	// Successes Requests the supervisor decided since the server started
+	Successes      int                `json:"successes"`
+	SupervisorId   openapi_types.UUID `json:"supervisor_id"`
+	SupervisorName string             `json:"supervisor_name"`
+
+	// Trips How often the breaker opened
+	Trips int `json:"trips"`
+}
+
+// CircuitBreakerSettings When an automated supervisor's circuit breaker opens. Once failure_threshold requests in a row
+// failed, the supervisor isn't asked for cooldown_seconds and its failure policy decides instead.
+// The first request after the cooldown tries the supervisor again, closing the breaker if it
+// succeeds and opening it for another cooldown if it fails.
+type CircuitBreakerSettings struct {
+	// CooldownSeconds Defaults to 60
+	CooldownSeconds *int `json:"cooldown_seconds,omitempty"`
+
+	// FailureThreshold Defaults to 5
+	FailureThreshold *int `json:"failure_threshold,omitempty"`
+}
+
+// CircuitBreakerState closed asks the supervisor, open decides with its failure policy without asking, and half_open
+// is trying the supervisor again after the cooldown
+type CircuitBreakerState string
+
+// Clarification defines model for Clarification.
+type Clarification struct {
+	Answer     *string    `json:"answer,omitempty"`
+	AnsweredAt *time.Time `json:"answered_at,omitempty"`
+	AskedAt    time.Time  `json:"asked_at"`
+
+	// AskedBy The audit actor of the reviewer who asked
+	AskedBy string             `json:"asked_by"`
+	Id      openapi_types.UUID `json:"id"`
+
+	// Question A question a reviewer asks the agent before deciding
+	Question             ClarificationQuestion `json:"question"`
+	SupervisionRequestId openapi_types.UUID    `json:"supervision_request_id"`
+}
+
+// ClarificationAnswer defines model for ClarificationAnswer.
+type ClarificationAnswer struct {
+	Answer string `json:"answer"`
+}
+
+// ClarificationQuestion A question a reviewer asks the agent before deciding
+type ClarificationQuestion struct {
+	// Arguments Paths of the tool call arguments the question is about, e.g. $.query
+	Arguments *[]string `json:"arguments,omitempty"`
+
+	// Choices If set, the answer has to be one of these
+	Choices  *[]string `json:"choices,omitempty"`
+	Question string    `json:"question"`
+}
+
+// ClientCapabilities What an SDK supports. Values are strings, since an SDK may know some the server doesn't.
+type ClientCapabilities struct {
+	// ApiVersion Version of the API the SDK was built against
+	ApiVersion *string `json:"api_version,omitempty"`
+
+	// ChatFormats Chat formats the SDK sends chats in
+	ChatFormats *[]string `json:"chat_formats,omitempty"`
+
+	// Decisions Decisions the SDK can act on
+	Decisions *[]string `json:"decisions,omitempty"`
+
+	// Sdk Name of the SDK, e.g. its package name
+	Sdk        string `json:"sdk"`
+	SdkVersion string `json:"sdk_version"`
+
+	// SupervisorTypes Supervisor types the SDK creates
+	SupervisorTypes *[]string `json:"supervisor_types,omitempty"`
+
+	// VerdictBehaviors Verdict behaviors the SDK can act on
+	VerdictBehaviors *[]string `json:"verdict_behaviors,omitempty"`
+}
+
+// ComputerAction defines model for ComputerAction.
+type ComputerAction struct {
+	// Action What a computer use tool call does. Arguments are read in the shape of Anthropic's computer tool, an action with coordinates as [x, y], or of OpenAI's computer use, a type with x and y.
+	Action     ComputerActionType `json:"action"`
+	Coordinate *ScreenshotPoint   `json:"coordinate,omitempty"`
+
+	// Element The element of a page an action targets, for agents that know it
+	Element         *ComputerElement               `json:"element,omitempty"`
+	ScrollAmount    *int                           `json:"scroll_amount,omitempty"`
+	ScrollDirection *ComputerActionScrollDirection `json:"scroll_direction,omitempty"`
+	StartCoordinate *ScreenshotPoint               `json:"start_coordinate,omitempty"`
+
+	// Text The text typed, or the keys pressed like ctrl+s
+	Text *string `json:"text,omitempty"`
+}
+
+// ComputerActionScrollDirection defines model for ComputerAction.ScrollDirection.
+type ComputerActionScrollDirection string
+
+// ComputerActionType What a computer use tool call does. Arguments are read in the shape of Anthropic's computer tool, an action with coordinates as [x, y], or of OpenAI's computer use, a type with x and y.
+type ComputerActionType string
+
+// ComputerElement The element of a page an action targets, for agents that know it
+type ComputerElement struct {
+	// InputType The type attribute of input elements, like password or email
+	InputType *string `json:"input_type,omitempty"`
+
+	// Name The element's accessible name or label
+	Name *string `json:"name,omitempty"`
+
+	// Role The element's accessibility role, like textbox or button
+	Role     *string `json:"role,omitempty"`
+	Selector *string `json:"selector,omitempty"`
+
+	// Url The URL of the page the element is on
+	Url *string `json:"url,omitempty"`
+}
+
+// ComputerUseRule A condition of a computer use supervisor on the action of a tool call. A rule matches if the
+// action is one of its actions, and matches every other condition it has. Patterns are regular
+// expressions.
+type ComputerUseRule struct {
+	Actions  *[]ComputerActionType `json:"actions,omitempty"`
+	Decision Decision              `json:"decision"`
+
+	// ElementPattern Matched against the targeted element's selector, role and name
+	ElementPattern *string `json:"element_pattern,omitempty"`
+
+	// InputTypes Input types of the targeted element, matched case insensitively
+	InputTypes *[]string `json:"input_types,omitempty"`
+
+	// Name Identifies the rule in the explanations of results
+	Name   string            `json:"name"`
+	Region *ScreenshotRegion `json:"region,omitempty"`
+
+	// TextPattern Matched against the text typed or keys pressed
+	TextPattern *string `json:"text_pattern,omitempty"`
+
+	// UrlPattern Matched against the URL of the targeted element's page
+	UrlPattern *string `json:"url_pattern,omitempty"`
+}
+
+// ConfidenceBucket defines model for ConfidenceBucket.
+type ConfidenceBucket struct {
+	// Agreed Results whose decision the humans made too
+	Agreed         int     `json:"agreed"`
+	AgreementRate  float64 `json:"agreement_rate"`
+	MaxConfidence  float64 `json:"max_confidence"`
+	MeanConfidence float64 `json:"mean_confidence"`
+	MinConfidence  float64 `json:"min_confidence"`
+	Results        int     `json:"results"`
+}
+
+// ConfidenceCalibration How often a supervisor's decisions agreed with the final decision of the humans later in the same chain, by the confidence it gave. Results humans never decided aren't counted.
+type ConfidenceCalibration struct {
+	Buckets      []ConfidenceBucket `json:"buckets"`
+	Results      int                `json:"results"`
+	SupervisorId openapi_types.UUID `json:"supervisor_id"`
+}
+
+// ConfigAgent defines model for ConfigAgent.
+type ConfigAgent struct {
+	Capabilities []string           `json:"capabilities"`
+	Name         string             `json:"name"`
+	ToolPolicies []ConfigToolPolicy `json:"tool_policies"`
+	Tools        []string           `json:"tools"`
+	Version      string             `json:"version"`
+}
+
+// ConfigReload Names of the settings a reload found changed, without their values
+type ConfigReload struct {
+	// Applied Changed settings now in effect
+	Applied    []string  `json:"applied"`
+	File       string    `json:"file"`
+	ReloadedAt time.Time `json:"reloaded_at"`
+
+	// RestartRequired Changed settings that take effect on the next restart
+	RestartRequired []string `json:"restart_required"`
+}
+
+// ConfigSupervisor defines model for ConfigSupervisor.
+type ConfigSupervisor struct {
+	Attributes  map[string]interface{} `json:"attributes"`
+	Code        string                 `json:"code"`
+	Description string                 `json:"description"`
+
+	// Key Identifies the supervisor within the bundle
+	Key  string `json:"key"`
+	Name string `json:"name"`
+
+	// Type The type of supervisor. ClientSupervisor means that the supervision is done client side and the server is merely informed. Other supervisor types are handled serverside, e.g. HumanSupervisor means that a human will review the request via the Asteroid UI, ConsentSupervisor means the end user affected by the tool call must consent to it through a link, EnsembleSupervisor means several LLM judges are asked in parallel and their verdicts aggregated, PolicySupervisor means the decision of the first of its rules (attribute rules, a list of PolicyRule) that the events posted to the run match, or its default_decision attribute (approve unless set) if none do, LlmSupervisor means the server asks one model with a prompt rendered from a template and records the decision and rationale it answers with (attributes as in LlmSupervisorAttributes), and ComputerUseSupervisor means the server validates the computer use action of the tool call's arguments, deciding by the first of its rules (attribute rules, a list of ComputerUseRule) that the action matches. ServicenowSupervisor means the server opens a change request in the configured ServiceNow instance (attributes as in ServiceNowSupervisorAttributes) and decides by its approval there, approving the tool call once the change request is approved and rejecting it once the change request is rejected or canceled.
+	Type SupervisorType `json:"type"`
+}
+
+// ConfigToolPolicy A tool policy whose chains list supervisor keys instead of IDs
+type ConfigToolPolicy struct {
+	Chains [][]string `json:"chains"`
+
+	// RiskTier How much damage a tool can do, which decides how heavily its calls are supervised
+	RiskTier RiskTier `json:"risk_tier"`
+	ToolName string   `json:"tool_name"`
+}
+
+// ConsentPrompt What the end user sees. Leaves out everything but the action they're asked about.
+type ConsentPrompt struct {
+	// Arguments Arguments of the tool call in JSON format
+	Arguments       string        `json:"arguments"`
+	ExpiresAt       time.Time     `json:"expires_at"`
+	Status          ConsentStatus `json:"status"`
+	ToolDescription string        `json:"tool_description"`
+	ToolName        string        `json:"tool_name"`
+}
+
+// ConsentRequest defines model for ConsentRequest.
+type ConsentRequest struct {
+	Comment   *string            `json:"comment,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	ExpiresAt time.Time          `json:"expires_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Link Where the end user gives or refuses consent
+	Link                 string             `json:"link"`
+	RespondedAt          *time.Time         `json:"responded_at,omitempty"`
+	Status               ConsentStatus      `json:"status"`
+	SupervisionRequestId openapi_types.UUID `json:"supervision_request_id"`
+	Token                string             `json:"token"`
+}
+
+// ConsentStatus defines model for ConsentStatus.
+type ConsentStatus string
+
+// ContextTruncation defines model for ContextTruncation.
+type ContextTruncation struct {
+	ChatId    *openapi_types.UUID `json:"chat_id,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+
+	// DroppedMessages The original messages that were removed from the request
+	DroppedMessages  []map[string]interface{} `json:"dropped_messages"`
+	FinalTokens      int                      `json:"final_tokens"`
+	Id               openapi_types.UUID       `json:"id"`
+	MaxContextTokens int                      `json:"max_context_tokens"`
+	Model            string                   `json:"model"`
+	OriginalTokens   int                      `json:"original_tokens"`
+	RunId            openapi_types.UUID       `json:"run_id"`
+
+	// Strategy How to bring a request within its context window. fail rejects the request, drop_oldest drops the oldest non-system messages, middle_out keeps the first user message and drops from just after it, summarize replaces dropped messages with an LLM generated summary.
+	Strategy TruncationStrategy `json:"strategy"`
+
+	// Summary The summary that replaced the dropped messages, if the summarize strategy was used
+	Summary *string `json:"summary,omitempty"`
+}
+
+// ContextWindowPolicy defines model for ContextWindowPolicy.
+type ContextWindowPolicy struct {
+	// MaxContextTokens Maximum estimated prompt tokens, 0 uses the known context window of the model
+	MaxContextTokens int `json:"max_context_tokens"`
+
+	// Model The model the policy applies to, or * for every model without a more specific policy
+	Model string `json:"model"`
+
+	// Strategy How to bring a request within its context window. fail rejects the request, drop_oldest drops the oldest non-system messages, middle_out keeps the first user message and drops from just after it, summarize replaces dropped messages with an LLM generated summary.
+	Strategy TruncationStrategy `json:"strategy"`
+}
+
+// CreatedApiKey defines model for CreatedApiKey.
+type CreatedApiKey struct {
+	ApiKey ApiKey `json:"api_key"`
+
+	// Key The secret to send as a bearer token. It can't be retrieved again.
+	Key string `json:"key"`
+}
+
+// CreatedWebhook defines model for CreatedWebhook.
+type CreatedWebhook struct {
+	// Secret The secret deliveries are signed with. It can't be retrieved again.
+	Secret  string  `json:"secret"`
+	Webhook Webhook `json:"webhook"`
+}
+
+// CustomVerdict defines model for CustomVerdict.
+type CustomVerdict struct {
+	// Behavior What happens to a tool call given a custom verdict. block rejects it, continue approves it
+	// and clarify leaves it undecided until the agent answers the reviewer's question.
+	Behavior VerdictBehavior `json:"behavior"`
+
+	// Description Shown to reviewers choosing a verdict
+	Description *string `json:"description,omitempty"`
+
+	// Name e.g. approve-with-conditions, can't be one of the built in decisions
+	Name string `json:"name"`
+}
+
+// DatabaseLatency Round trips to the database, timed by pinging it while the report was made
+type DatabaseLatency struct {
+	// Failed Pings that failed, which aren't timed
+	Failed  int     `json:"failed"`
+	MaxMs   float64 `json:"max_ms"`
+	P50Ms   float64 `json:"p50_ms"`
+	P95Ms   float64 `json:"p95_ms"`
+	P99Ms   float64 `json:"p99_ms"`
+	Samples int     `json:"samples"`
+}
+
+// Decision defines model for Decision.
+type Decision string
+
+// DecisionConflict Returned when a decision is made for a supervision request that was already resolved
+type DecisionConflict struct {
+	AttemptedDecision    Decision           `json:"attempted_decision"`
+	Error                string             `json:"error"`
+	SupervisionRequestId openapi_types.UUID `json:"supervision_request_id"`
+	WinningResult        SupervisionResult  `json:"winning_result"`
+}
+
+// Diagnostics defines model for Diagnostics.
+type Diagnostics struct {
+	// Database Round trips to the database, timed by pinging it while the report was made
+	Database    DatabaseLatency `json:"database"`
+	GeneratedAt time.Time       `json:"generated_at"`
+	Hub         HubDiagnostics  `json:"hub"`
+
+	// InstanceId Instance ID of the replica that answered
+	InstanceId string `json:"instance_id"`
+
+	// OldestPendingReviewSeconds How long the longest waiting pending supervision request has waited, absent if none is pending
+	OldestPendingReviewSeconds *float64 `json:"oldest_pending_review_seconds,omitempty"`
+
+	// Queues The review queue of each priority class, interactive first
+	Queues []PriorityQueueStats `json:"queues"`
+
+	// StuckExecutions Supervision requests an LLM or ensemble supervisor was still deciding after the threshold, which the stuck_executions worker decided with the supervisor's failure policy. Counted by the replica that answered while it ran the worker, since it started.
+	StuckExecutions StuckExecutionRepairs `json:"stuck_executions"`
+	Webhooks        WebhookDiagnostics    `json:"webhooks"`
+}
+
+// DiffOp defines model for DiffOp.
+type DiffOp string
+
+// DiffSegment A run of tokens that were kept, inserted or deleted
+type DiffSegment struct {
+	Op   DiffOp `json:"op"`
+	Text string `json:"text"`
+}
+
+// DivergentMessage defines model for DivergentMessage.
+type DivergentMessage struct {
+	Message *AsteroidMessage   `json:"message,omitempty"`
+	RunId   openapi_types.UUID `json:"run_id"`
+}
+
+// DryRunCall defines model for DryRunCall.
+type DryRunCall struct {
+	Actual *Decision `json:"actual,omitempty"`
+
+	// ActuallyEscalated Whether any supervisor escalated the call
+	ActuallyEscalated bool      `json:"actually_escalated"`
+	CreatedAt         time.Time `json:"created_at"`
+	Predicted         Decision  `json:"predicted"`
+
+	// RuleIndex Index of the rule that matched, not set if none did
+	RuleIndex  *int               `json:"rule_index,omitempty"`
+	ToolCallId openapi_types.UUID `json:"tool_call_id"`
+	ToolName   string             `json:"tool_name"`
+}
+
+// DryRunReport defines model for DryRunReport.
+type DryRunReport struct {
+	ActuallyApproved  int `json:"actually_approved"`
+	ActuallyEscalated int `json:"actually_escalated"`
+	ActuallyRejected  int `json:"actually_rejected"`
+
+	// Agreed Decided calls the candidate would have treated the same way
+	Agreed int `json:"agreed"`
+
+	// Differences Every decided call the candidate would have treated differently
+	Differences []DryRunCall `json:"differences"`
+	From        time.Time    `json:"from"`
+
+	// MissedRejections Calls that were rejected but the candidate would have approved
+	MissedRejections int `json:"missed_rejections"`
+
+	// NewlyEscalated Calls that went through without escalation but the candidate would have escalated
+	NewlyEscalated int `json:"newly_escalated"`
+
+	// NewlyRejected Calls that went through but the candidate would have rejected
+	NewlyRejected int                `json:"newly_rejected"`
+	ProjectId     openapi_types.UUID `json:"project_id"`
+	To            time.Time          `json:"to"`
+	TotalCalls    int                `json:"total_calls"`
+
+	// Truncated Whether the range had more calls than a dry run evaluates, in which case only the oldest were
+	Truncated bool `json:"truncated"`
+
+	// Undecided Calls that were never decided, which aren't compared
+	Undecided     int `json:"undecided"`
+	WouldApprove  int `json:"would_approve"`
+	WouldEscalate int `json:"would_escalate"`
+	WouldReject   int `json:"would_reject"`
+}
+
+// DryRunRequest defines model for DryRunRequest.
+type DryRunRequest struct {
+	From time.Time `json:"from"`
+
+	// Rules Evaluated in order, the first rule that matches a call decides it. Calls no rule matches are approved.
+	Rules []DryRunRule `json:"rules"`
+	To    time.Time    `json:"to"`
+}
+
+// DryRunRule A rule of a candidate supervisor. Client supervisors run in the SDK, so dry runs take the candidate as rules the server can evaluate.
+type DryRunRule struct {
+	// ArgumentsPattern Regular expression the tool call's JSON arguments must match. Matches every call if not given.
+	ArgumentsPattern *string  `json:"arguments_pattern,omitempty"`
+	Decision         Decision `json:"decision"`
+
+	// ToolName Name of the tool the rule applies to, or * for every tool
+	ToolName string `json:"tool_name"`
+}
+
+// DurableTimer Something to do at a later time, stored so it survives restarts
+type DurableTimer struct {
+	Attributes *map[string]interface{} `json:"attributes,omitempty"`
+	CreatedAt  time.Time               `json:"created_at"`
+	FireAt     time.Time               `json:"fire_at"`
+
+	// FiredAt Unset until the timer fired
+	FiredAt *time.Time         `json:"fired_at,omitempty"`
+	Id      openapi_types.UUID `json:"id"`
+
+	// Kind What a durable timer does when it fires. review_reminder takes the ReminderAction in its action
+	// attribute, notify_assignee unless set, on an undecided review. decision_timeout decides a
+	// request its supervisor didn't decide in time with the TimeoutFallback in its fallback attribute.
+	Kind                 TimerKind          `json:"kind"`
+	SupervisionRequestId openapi_types.UUID `json:"supervision_request_id"`
+}
+
+// EnsembleAggregation How an ensemble supervisor decides from its members' verdicts. majority takes the decision most
+// members gave and escalates ties, unanimous_approve approves only if every member approved and
+// otherwise takes the decision most of the members that didn't approve gave, and max_risk takes
+// the most severe decision given. Decisions from least to most severe are approve, escalate, reject and terminate.
+// Members that fail to give a verdict count as escalating. Defaults to majority.
+type EnsembleAggregation string
+
+// EnsembleMember One of the LLM judges of an ensemble supervisor
+type EnsembleMember struct {
+	// Model The model the judge is asked, through the upstream provider of proxy mode
+	Model string `json:"model"`
+
+	// Name Unique within the ensemble
+	Name string `json:"name"`
+
+	// Prompt Instructions the judge reviews tool calls with
+	Prompt string `json:"prompt"`
+}
+
+// EnsembleVerdict The verdict one member of an ensemble supervisor gave on a supervision request
+type EnsembleVerdict struct {
+	Confidence *float64  `json:"confidence,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	Decision   Decision  `json:"decision"`
+
+	// Error Why the member gave no usable verdict, in which case it counted as escalating
+	Error                *string            `json:"error,omitempty"`
+	Id                   openapi_types.UUID `json:"id"`
+	Member               string             `json:"member"`
+	Model                string             `json:"model"`
+	Rationale            *string            `json:"rationale,omitempty"`
+	SupervisionRequestId openapi_types.UUID `json:"supervision_request_id"`
+
+	// Variant The prompt variant the member reviewed with, if the supervisor has any
+	Variant *string `json:"variant,omitempty"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Details *string `json:"details,omitempty"`
+	Error   string  `json:"error"`
+}
+
+// Escalation An escalation of a tool call from one supervisor of a chain to a later one
+type Escalation struct {
+	EscalatedAt      time.Time          `json:"escalated_at"`
+	FromPosition     int                `json:"from_position"`
+	FromSupervisorId openapi_types.UUID `json:"from_supervisor_id"`
+	Reasoning        string             `json:"reasoning"`
+
+	// SkippedSupervisorIds The rest of the escalating supervisor's tier, which doesn't review the tool call
+	SkippedSupervisorIds []openapi_types.UUID `json:"skipped_supervisor_ids"`
+
+	// SupervisionRequestId The supervision request whose result escalated
+	SupervisionRequestId openapi_types.UUID `json:"supervision_request_id"`
+	ToPosition           *int               `json:"to_position,omitempty"`
+
+	// ToSupervisorId Unset if there was no one left in the chain to escalate to
+	ToSupervisorId *openapi_types.UUID `json:"to_supervisor_id,omitempty"`
+}
+
+// ExportedRun defines model for ExportedRun.
+type ExportedRun struct {
+	Run       Run                `json:"run"`
+	ToolCalls []ExportedToolCall `json:"tool_calls"`
+}
+
+// ExportedToolCall defines model for ExportedToolCall.
+type ExportedToolCall struct {
+	Decision *Decision        `json:"decision,omitempty"`
+	ToolCall AsteroidToolCall `json:"tool_call"`
+}
+
+// FailurePolicy How an automated supervisor decides a request it fails to decide, because its model errors or
+// times out, because its circuit breaker is open or because it was still deciding after
+// STUCK_EXECUTION_SECONDS, as when the server stopped midway. fail_open approves, fail_closed rejects and
+// escalate_on_failure escalates to the next supervisor. Defaults to escalate_on_failure.
+type FailurePolicy string
+
+// FeatureFlag defines model for FeatureFlag.
+type FeatureFlag struct {
+	// DefaultEnabled Whether the flag is on for projects while it isn't rolled out
+	DefaultEnabled bool                `json:"default_enabled"`
+	Description    string              `json:"description"`
+	Name           string              `json:"name"`
+	Rollout        *FeatureFlagRollout `json:"rollout,omitempty"`
+}
+
+// FeatureFlagReason Why a flag is on or off for a project. flag_default while the flag isn't rolled out,
+// project_targeted or organization_targeted when a target decided it, and in_rollout or
+// outside_rollout by the project's place in the rollout percentage.
+type FeatureFlagReason string
+
+// FeatureFlagRollout defines model for FeatureFlagRollout.
+type FeatureFlagRollout struct {
+	Flag string `json:"flag"`
+
+	// Percentage Percentage of projects the flag is on for, outside its targets
+	Percentage int                 `json:"percentage"`
+	Targets    []FeatureFlagTarget `json:"targets"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+	UpdatedBy  string              `json:"updated_by"`
+}
+
+// FeatureFlagTarget Turns a flag on or off for one project or for every project of an organization, whatever its
+// percentage. A project's target wins over its organization's.
+type FeatureFlagTarget struct {
+	Enabled        bool                `json:"enabled"`
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+	ProjectId      *openapi_types.UUID `json:"project_id,omitempty"`
+}
+
+// FeedbackDraft defines model for FeedbackDraft.
+type FeedbackDraft struct {
+	Decision Decision `json:"decision"`
+
+	// Feedback The drafted reasoning, citing policies and precedents by their refs
+	Feedback string           `json:"feedback"`
+	Model    string           `json:"model"`
+	Policies []FeedbackPolicy `json:"policies"`
+
+	// Precedents The decided calls of the tool whose arguments are most like the call's, most alike first
+	Precedents           []FeedbackPrecedent `json:"precedents"`
+	SupervisionRequestId openapi_types.UUID  `json:"supervision_request_id"`
+}
+
+// FeedbackDraftRequest defines model for FeedbackDraftRequest.
+type FeedbackDraftRequest struct {
+	Decision Decision `json:"decision"`
+
+	// Notes What the reviewer has written so far, for the draft to build on
+	Notes *string `json:"notes,omitempty"`
+}
+
+// FeedbackPolicy defines model for FeedbackPolicy.
+type FeedbackPolicy struct {
+	// Cited Whether the draft cites the policy
+	Cited       bool   `json:"cited"`
+	Description string `json:"description"`
+
+	// Kind tool_risk_tier is the risk tier the tool's policy gives it, matching_argument_rule an argument rule of the project the call's arguments meet
+	Kind FeedbackPolicyKind `json:"kind"`
+	Name string             `json:"name"`
+
+	// Ref How the draft cites the policy, like P1
+	Ref string `json:"ref"`
+}
+
+// FeedbackPolicyKind tool_risk_tier is the risk tier the tool's policy gives it, matching_argument_rule an argument rule of the project the call's arguments meet
+type FeedbackPolicyKind string
+
+// FeedbackPrecedent A decided call of the same tool in the project
+type FeedbackPrecedent struct {
+	Arguments *string `json:"arguments,omitempty"`
+
+	// Cited Whether the draft cites the precedent
+	Cited     bool      `json:"cited"`
+	DecidedAt time.Time `json:"decided_at"`
+	Decision  Decision  `json:"decision"`
+	Reasoning string    `json:"reasoning"`
+
+	// Ref How the draft cites the precedent, like C1
+	Ref string `json:"ref"`
+
+	// Similarity The share of argument values the precedent agrees with the tool call on, from 0 to 1
+	Similarity float64            `json:"similarity"`
+	ToolCallId openapi_types.UUID `json:"tool_call_id"`
+}
+
+// HandoffBundle defines model for HandoffBundle.
+type HandoffBundle struct {
+	CreatedAt time.Time          `json:"created_at"`
+	CreatedBy string             `json:"created_by"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Items Pending reviews, highest priority first
+	Items      []HandoffItem `json:"items"`
+	Name       string        `json:"name"`
+	RestoredAt *time.Time    `json:"restored_at,omitempty"`
+
+	// RestoredTo Session the bundle was last restored to
+	RestoredTo *string `json:"restored_to,omitempty"`
+}
+
+// HandoffItem defines model for HandoffItem.
+type HandoffItem struct {
+	// AssignedSession Session the review was assigned to when the snapshot was taken, if any
+	AssignedSession *string `json:"assigned_session,omitempty"`
+
+	// Priority How much damage a tool can do, which decides how heavily its calls are supervised
+	Priority *RiskTier `json:"priority,omitempty"`
+
+	// Status paused is only used for runs, while their organization's kill switch is active.
+	// awaiting_clarification is only used for supervision requests and tool calls whose reviewer
+	// asked the agent a question that hasn't been answered yet.
+	Status               Status              `json:"status"`
+	SupervisionRequestId openapi_types.UUID  `json:"supervision_request_id"`
+	ToolCallId           *openapi_types.UUID `json:"tool_call_id,omitempty"`
+}
+
+// HandoffRestoreResult defines model for HandoffRestoreResult.
+type HandoffRestoreResult struct {
+	HandoffBundleId openapi_types.UUID   `json:"handoff_bundle_id"`
+	Reassigned      []openapi_types.UUID `json:"reassigned"`
+	Session         string               `json:"session"`
+
+	// Skipped Reviews resolved since the snapshot
+	Skipped []openapi_types.UUID `json:"skipped"`
+}
+
+// HubDiagnostics defines model for HubDiagnostics.
+type HubDiagnostics struct {
+	// AssignedReviews Reviews assigned to the sessions
+	AssignedReviews int `json:"assigned_reviews"`
+
+	// Backplane Whether the hub shares reviews with other replicas
+	Backplane bool `json:"backplane"`
+
+	// Clients Reviewer connections
+	Clients int `json:"clients"`
+
+	// RealtimeChannelDepth Human reviews of realtime runs waiting to be assigned
+	RealtimeChannelDepth  int `json:"realtime_channel_depth"`
+	ReviewChannelCapacity int `json:"review_channel_capacity"`
+
+	// ReviewChannelDepth Human reviews waiting to be assigned
+	ReviewChannelDepth int `json:"review_channel_depth"`
+
+	// Sessions Reviewer sessions, which can each have several connections
+	Sessions int `json:"sessions"`
+}
+
+// HubStats defines model for HubStats.
+type HubStats struct {
+	AssignedReviews       map[string]int `json:"assigned_reviews"`
+	AssignedReviewsCount  int            `json:"assigned_reviews_count"`
+	BusyClients           int            `json:"busy_clients"`
+	CompletedReviewsCount int            `json:"completed_reviews_count"`
+	ConnectedClients      int            `json:"connected_clients"`
+
+	// ConnectedSessions Number of reviewer sessions, each of which can have several connections
+	ConnectedSessions   *int           `json:"connected_sessions,omitempty"`
+	FreeClients         int            `json:"free_clients"`
+	PendingReviewsCount int            `json:"pending_reviews_count"`
+	ReviewDistribution  map[string]int `json:"review_distribution"`
+}
+
+// IncidentMode defines model for IncidentMode.
+type IncidentMode struct {
+	// ChainId The chain added to every tool while incident mode is on
+	ChainId      openapi_types.UUID `json:"chain_id"`
+	EndedAt      *time.Time         `json:"ended_at,omitempty"`
+	EndedBy      *string            `json:"ended_by,omitempty"`
+	Id           openapi_types.UUID `json:"id"`
+	ProjectId    openapi_types.UUID `json:"project_id"`
+	Reason       *string            `json:"reason,omitempty"`
+	StartedAt    time.Time          `json:"started_at"`
+	StartedBy    string             `json:"started_by"`
+	SupervisorId openapi_types.UUID `json:"supervisor_id"`
+}
+
+// IngestionHook defines model for IngestionHook.
+type IngestionHook struct {
+	// FailOpen Store the payload untransformed if the hook fails, instead of refusing it
+	FailOpen     *bool                  `json:"fail_open,omitempty"`
+	Name         string                 `json:"name"`
+	PayloadTypes []IngestionPayloadType `json:"payload_types"`
+
+	// TimeoutMs How long the hook has to respond, defaults to 1000 and can't be more than 5000
+	TimeoutMs *int `json:"timeout_ms,omitempty"`
+
+	// Url Absolute http or https URL the payload is posted to
+	Url string `json:"url"`
+}
+
+// IngestionHookRequest What an ingestion hook is sent
+type IngestionHookRequest struct {
+	Hook string `json:"hook"`
+
+	// Payload For chats, an object with the request and response
+	Payload map[string]interface{} `json:"payload"`
+
+	// PayloadType chat is a chat's request and response, tool_definition is a tool registered for a run
+	PayloadType IngestionPayloadType `json:"payload_type"`
+	ProjectId   openapi_types.UUID   `json:"project_id"`
+	RunId       openapi_types.UUID   `json:"run_id"`
+}
+
+// IngestionHookResponse What an ingestion hook responds with
+type IngestionHookResponse struct {
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// IngestionPayloadType chat is a chat's request and response, tool_definition is a tool registered for a run
+type IngestionPayloadType string
+
+// IntegrityMismatch defines model for IntegrityMismatch.
+type IntegrityMismatch struct {
+	ComputedHash string `json:"computed_hash"`
+
+	// Id The ID of the chat or message
+	Id         openapi_types.UUID `json:"id"`
+	Kind       StoredContentKind  `json:"kind"`
+	StoredHash string             `json:"stored_hash"`
+}
+
+// IntegrityReport defines model for IntegrityReport.
+type IntegrityReport struct {
+	// Checked How many stored payloads were re-hashed
+	Checked    int                 `json:"checked"`
+	CheckedAt  time.Time           `json:"checked_at"`
+	Mismatches []IntegrityMismatch `json:"mismatches"`
+	RunId      openapi_types.UUID  `json:"run_id"`
+
+	// Unhashed Payloads stored before hashing was added, which can't be checked
+	Unhashed int `json:"unhashed"`
+}
+
+// KillSwitch defines model for KillSwitch.
+type KillSwitch struct {
+	Active bool `json:"active"`
+
+	// ConfirmedBy Who confirmed the last change
+	ConfirmedBy    *string            `json:"confirmed_by,omitempty"`
+	OrganizationId openapi_types.UUID `json:"organization_id"`
+	PendingRequest *KillSwitchRequest `json:"pending_request,omitempty"`
+	Reason         *string            `json:"reason,omitempty"`
+
+	// RequestedBy Who asked for the last change
+	RequestedBy *string    `json:"requested_by,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// KillSwitchAction defines model for KillSwitchAction.
+type KillSwitchAction string
+
+// KillSwitchRequest defines model for KillSwitchRequest.
+type KillSwitchRequest struct {
+	Action      KillSwitchAction `json:"action"`
+	ConfirmedAt *time.Time       `json:"confirmed_at,omitempty"`
+	ConfirmedBy *string          `json:"confirmed_by,omitempty"`
+
+	// ExpiresAt The request can't be confirmed after this
+	ExpiresAt        time.Time          `json:"expires_at"`
+	Id               openapi_types.UUID `json:"id"`
+	OrganizationId   openapi_types.UUID `json:"organization_id"`
+	Reason           *string            `json:"reason,omitempty"`
+	RequestedAt      time.Time          `json:"requested_at"`
+	RequestedBy      string             `json:"requested_by"`
+	RequestedByKeyId openapi_types.UUID `json:"requested_by_key_id"`
+}
+
+// LlmSupervisorAttributes The attributes of an LLM supervisor
+type LlmSupervisorAttributes struct {
+	// Decisions The decisions the model can answer with, which make up the schema it has to answer in.
+	// approve, reject and escalate unless set, and modify isn't one since the model can't
+	// give modified arguments.
+	Decisions *[]Decision `json:"decisions,omitempty"`
+
+	// Model The model that's asked, through the upstream provider of proxy mode
+	Model string `json:"model"`
+
+	// PromptTemplate Go text/template the model's prompt is rendered from, with the fields .ToolName,
+	// .ToolDescription, .Arguments and .Message, the content of the message that made the call
+	PromptTemplate string `json:"prompt_template"`
+}
+
+// LocalizedMessages defines model for LocalizedMessages.
+type LocalizedMessages struct {
+	Locale string `json:"locale"`
+
+	// Messages Text by message key, e.g. decision.approve or event.reassigned
+	Messages map[string]string `json:"messages"`
+}
+
+// MessageDiff defines model for MessageDiff.
+type MessageDiff struct {
+	CreatedAt       time.Time          `json:"created_at"`
+	Id              openapi_types.UUID `json:"id"`
+	MessageId       openapi_types.UUID `json:"message_id"`
+	ModifiedContent string             `json:"modified_content"`
+	OriginalContent string             `json:"original_content"`
+	Segments        []DiffSegment      `json:"segments"`
+}
+
+// MessageRole defines model for MessageRole.
+type MessageRole string
+
+// MessageTranslation defines model for MessageTranslation.
+type MessageTranslation struct {
+	// Backend The translation backend that produced the translation
+	Backend string `json:"backend"`
+
+	// Content The original (normalized) message content
+	Content           string             `json:"content"`
+	CreatedAt         time.Time          `json:"created_at"`
+	MessageId         openapi_types.UUID `json:"message_id"`
+	SourceLanguage    string             `json:"source_language"`
+	TargetLanguage    string             `json:"target_language"`
+	TranslatedContent string             `json:"translated_content"`
+}
+
+// MessageType defines model for MessageType.
+type MessageType string
+
+// MeteringEvent defines model for MeteringEvent.
+type MeteringEvent struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// IdempotencyKey Identifies what was metered, so billing systems can drop events they've already seen
+	IdempotencyKey string `json:"idempotency_key"`
+
+	// Metric What a metering event measures. tool_calls_supervised counts tool calls the first time they're
+	// sent for supervision, supervisor_tokens counts the tokens LLM supervisors report with their
+	// results, and bytes_stored counts the bytes of each chat stored.
+	Metric         MeteringMetric      `json:"metric"`
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+	ProjectId      openapi_types.UUID  `json:"project_id"`
+	Quantity       int64               `json:"quantity"`
+
+	// ResourceId The tool call, supervision result or chat that was metered
+	ResourceId openapi_types.UUID `json:"resource_id"`
+
+	// Sequence Increases with every event recorded, used to page through events
+	Sequence int64 `json:"sequence"`
+}
+
+// MeteringEventPage defines model for MeteringEventPage.
+type MeteringEventPage struct {
+	Events []MeteringEvent `json:"events"`
+
+	// NextAfter Pass as after to get the next page, the same as after when there are no new events
+	NextAfter int64 `json:"next_after"`
+}
+
+// MeteringMetric What a metering event measures. tool_calls_supervised counts tool calls the first time they're
+// sent for supervision, supervisor_tokens counts the tokens LLM supervisors report with their
+// results, and bytes_stored counts the bytes of each chat stored.
+type MeteringMetric string
+
+// ModelUsage Tokens a model's chats used. Providers report usage for a whole response, so a response with several choices counts once.
+type ModelUsage struct {
+	Chats            int `json:"chats"`
+	CompletionTokens int `json:"completion_tokens"`
+
+	// CostUsd What the tokens cost in US dollars, absent if the model has no known price
+	CostUsd *float64 `json:"cost_usd,omitempty"`
+
+	// Model The model the provider reported answering, empty if it reported none
+	Model        string `json:"model"`
+	PromptTokens int    `json:"prompt_tokens"`
+}
+
+// Notification What's POSTed to a project's notification webhook
+type Notification struct {
+	Alert *Alert `json:"alert,omitempty"`
+
+	// Escalation An escalation of a tool call from one supervisor of a chain to a later one
+	Escalation *Escalation        `json:"escalation,omitempty"`
+	Event      NotificationEvent  `json:"event"`
+	ProjectId  openapi_types.UUID `json:"project_id"`
+	Result     *SupervisionResult `json:"result,omitempty"`
+	SentAt     time.Time          `json:"sent_at"`
+}
+
+// NotificationEvent defines model for NotificationEvent.
+type NotificationEvent string
+
+// NotificationSettings defines model for NotificationSettings.
+type NotificationSettings struct {
+	Events []NotificationEvent `json:"events"`
+
+	// WebhookUrl URL that notifications are POSTed to
+	WebhookUrl *string `json:"webhook_url,omitempty"`
+}
+
+// OpenApiTool defines model for OpenApiTool.
+type OpenApiTool struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+
+	// RiskTier How much damage a tool can do, which decides how heavily its calls are supervised
+	RiskTier RiskTier `json:"risk_tier"`
+	Tool     Tool     `json:"tool"`
+}
+
+// OpenApiToolImport defines model for OpenApiToolImport.
+type OpenApiToolImport struct {
+	// Document The OpenAPI 3 document, as JSON or YAML
+	Document string `json:"document"`
+
+	// Operations Only registers the operations with these tool names, unless unset
+	Operations *[]string `json:"operations,omitempty"`
+}
+
+// OpenApiToolImportResult defines model for OpenApiToolImportResult.
+type OpenApiToolImportResult struct {
+	Skipped []SkippedOpenApiOperation `json:"skipped"`
+	Tools   []OpenApiTool             `json:"tools"`
+}
+
+// Organization defines model for Organization.
+type Organization struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+}
+
+// PayloadTemplate A Go text/template the body of a target's requests is rendered with instead of the default JSON. The template is executed with that JSON decoded, so fields are referred to by their JSON names, e.g. {{ .event }} or {{ .result.reasoning }}. Besides the built in functions it can use json, upper, lower, trim, replace, truncate, join, contains and default. It can't define or call other templates, and only ranges over the payload's fields.
+type PayloadTemplate struct {
+	// ContentType Content-Type of the rendered body, application/json by default. JSON bodies have to render to valid JSON.
+	ContentType *string `json:"content_type,omitempty"`
+
+	// Target webhooks renders the deliveries of the project's webhooks, which are signed as rendered. notifications renders what's POSTed to the notification webhook_url, so it can be a Slack incoming webhook or an email gateway.
+	Target   PayloadTemplateTarget `json:"target"`
+	Template string                `json:"template"`
+}
+
+// PayloadTemplatePreview defines model for PayloadTemplatePreview.
+type PayloadTemplatePreview struct {
+	Body        string `json:"body"`
+	ContentType string `json:"content_type"`
+}
+
+// PayloadTemplateTarget webhooks renders the deliveries of the project's webhooks, which are signed as rendered. notifications renders what's POSTed to the notification webhook_url, so it can be a Slack incoming webhook or an email gateway.
+type PayloadTemplateTarget string
+
+// Plan defines model for Plan.
+type Plan struct {
+	ArgumentTolerance float64    `json:"argument_tolerance"`
+	CreatedAt         time.Time  `json:"created_at"`
+	DecidedAt         *time.Time `json:"decided_at,omitempty"`
+
+	// DecidedBy Unset until the plan is decided
+	DecidedBy *string            `json:"decided_by,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+	Reasoning *string            `json:"reasoning,omitempty"`
+	RunId     openapi_types.UUID `json:"run_id"`
+	Steps     []PlanStep         `json:"steps"`
+}
+
+// PlanDecision Steps without a decision of their own get the plan's. Only approve and reject can be decided,
+// and a rejected step's tool call is supervised as usual.
+type PlanDecision struct {
+	Decision  Decision            `json:"decision"`
+	Reasoning *string             `json:"reasoning,omitempty"`
+	Steps     *[]PlanStepDecision `json:"steps,omitempty"`
+}
+
+// PlanDeviation Recorded for a tool call a run makes once it has an approved plan that the call doesn't
+// follow. Approvals of the tool call by automated supervisors are escalated.
+type PlanDeviation struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Diff From the step's arguments to the tool call's
+	Diff *[]DiffSegment `json:"diff,omitempty"`
+
+	// ExpectedPosition The step the run was expected to make next, unset if it made them all
+	ExpectedPosition *int               `json:"expected_position,omitempty"`
+	Id               openapi_types.UUID `json:"id"`
+
+	// Kind How a tool call strays from its run's approved plan. out_of_order matches a step other than
+	// the one expected next, argument_mismatch is made with a planned tool but arguments outside
+	// the plan's tolerance, and unplanned_tool is made with a tool no remaining step uses.
+	Kind   PlanDeviationKind  `json:"kind"`
+	PlanId openapi_types.UUID `json:"plan_id"`
+
+	// Similarity The share of the step's argument values the tool call agrees on
+	Similarity *float64 `json:"similarity,omitempty"`
+
+	// StepPosition The step the tool call was compared with, unset for unplanned tools
+	StepPosition *int               `json:"step_position,omitempty"`
+	ToolCallId   openapi_types.UUID `json:"tool_call_id"`
+}
+
+// PlanDeviationKind How a tool call strays from its run's approved plan. out_of_order matches a step other than
+// the one expected next, argument_mismatch is made with a planned tool but arguments outside
+// the plan's tolerance, and unplanned_tool is made with a tool no remaining step uses.
+type PlanDeviationKind string
+
+// PlanRequest defines model for PlanRequest.
+type PlanRequest struct {
+	// ArgumentTolerance The share of a step's argument values a tool call may differ in and still match it, 0 by
+	// default so only the exact arguments match
+	ArgumentTolerance *float64          `json:"argument_tolerance,omitempty"`
+	Steps             []PlanStepRequest `json:"steps"`
+}
+
+// PlanStep defines model for PlanStep.
+type PlanStep struct {
+	Arguments   string    `json:"arguments"`
+	Decision    *Decision `json:"decision,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Position    int       `json:"position"`
+
+	// ToolCallId The tool call the step covered, unset until the run made it
+	ToolCallId *openapi_types.UUID `json:"tool_call_id,omitempty"`
+	ToolName   string              `json:"tool_name"`
+}
+
+// PlanStepDecision defines model for PlanStepDecision.
+type PlanStepDecision struct {
+	Decision Decision `json:"decision"`
+	Position int      `json:"position"`
+}
+
+// PlanStepRequest defines model for PlanStepRequest.
+type PlanStepRequest struct {
+	// Arguments The arguments the tool call will be made with, in JSON format
+	Arguments   string  `json:"arguments"`
+	Description *string `json:"description,omitempty"`
+
+	// ToolName The name the tool call will be made with
+	ToolName string `json:"tool_name"`
+}
+
+// PolicyRule A condition of a policy supervisor on the events posted to a run. A rule matches if the run
+// has an event of its type, from its source if it has one, that occurred within its window if
+// it has one.
+type PolicyRule struct {
+	Decision  Decision `json:"decision"`
+	EventType string   `json:"event_type"`
+
+	// Name Identifies the rule in the explanations of results
+	Name          string  `json:"name"`
+	Source        *string `json:"source,omitempty"`
+	WithinSeconds *int    `json:"within_seconds,omitempty"`
+}
+
+// Preapproval defines model for Preapproval.
+type Preapproval struct {
+	Chains         []PreapprovalChain `json:"chains"`
+	LikelyDecision Decision           `json:"likely_decision"`
+	ToolName       string             `json:"tool_name"`
+}
+
+// PreapprovalChain defines model for PreapprovalChain.
+type PreapprovalChain struct {
+	ChainId openapi_types.UUID `json:"chain_id"`
+
+	// Confidence How sure the prediction of the deciding supervisor is
+	Confidence     *float64  `json:"confidence,omitempty"`
+	LikelyDecision *Decision `json:"likely_decision,omitempty"`
+	Reasoning      string    `json:"reasoning"`
+
+	// SupervisorId The supervisor that would likely decide, or that couldn't be predicted
+	SupervisorId *openapi_types.UUID `json:"supervisor_id,omitempty"`
+}
+
+// PreapprovalRequest defines model for PreapprovalRequest.
+type PreapprovalRequest struct {
+	// Arguments Arguments in JSON format
+	Arguments *string `json:"arguments,omitempty"`
+
+	// ToolName A tool registered on the run
+	ToolName string `json:"tool_name"`
+}
+
+// PrecedentChain defines model for PrecedentChain.
+type PrecedentChain struct {
+	// Agreement The share of the citing decisions that decided as the precedent did
+	Agreement float64 `json:"agreement"`
+
+	// Citations How many decisions are in citing_result_ids
+	Citations int `json:"citations"`
+
+	// CitingResultIds The decisions citing it directly or through others, oldest first
+	CitingResultIds []openapi_types.UUID `json:"citing_result_ids"`
+
+	// Depth The longest run of decisions each citing the one before, from the precedent
+	Depth           int       `json:"depth"`
+	DirectCitations int       `json:"direct_citations"`
+	LastCitedAt     time.Time `json:"last_cited_at"`
+
+	// Precedent A decision of a tool call, as cited as a precedent
+	Precedent PrecedentDecision `json:"precedent"`
+
+	// SuggestedRule Approves or rejects calls of a tool whose arguments meet every condition, without asking the supervisors of its chains. A project's rules are tried in order and the first that matches decides.
+	SuggestedRule *ArgumentRule `json:"suggested_rule,omitempty"`
+}
+
+// PrecedentDecision A decision of a tool call, as cited as a precedent
+type PrecedentDecision struct {
+	Arguments *string   `json:"arguments,omitempty"`
+	DecidedAt time.Time `json:"decided_at"`
+	Decision  Decision  `json:"decision"`
+
+	// Precedents The results the decision cites itself
+	Precedents []openapi_types.UUID `json:"precedents"`
+	Reasoning  string               `json:"reasoning"`
+	ResultId   openapi_types.UUID   `json:"result_id"`
+	ToolCallId openapi_types.UUID   `json:"tool_call_id"`
+	ToolName   string               `json:"tool_name"`
+
+	// UserId The user who decided, if one did
+	UserId *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// PriorityQueueStats The review queue of one priority class
+type PriorityQueueStats struct {
+	// Assigned Supervision requests assigned to a reviewer or being decided by an automated supervisor
+	Assigned int `json:"assigned"`
+
+	// Capacity How many LLM and ensemble supervisors can decide requests of the class at once
+	Capacity int `json:"capacity"`
+
+	// InFlight LLM and ensemble supervisors deciding requests of the class right now
+	InFlight int `json:"in_flight"`
+
+	// OldestPendingAt When the longest waiting pending request started waiting
+	OldestPendingAt *time.Time `json:"oldest_pending_at,omitempty"`
+
+	// Pending Supervision requests waiting to be processed
+	Pending int `json:"pending"`
+
+	// Priority The priority class of a run, chosen when it's created. The supervision requests of interactive
+	// runs are processed ahead of those of batch runs, and batch runs only get a share of the capacity
+	// for asking LLM and ensemble supervisors, so backfills can't hold up production traffic.
+	// Defaults to interactive. realtime is for approvals a person is waiting on in a chat, which are
+	// processed ahead of both. Their human reviews go to reviewers as soon as they're created rather
+	// than on the processor's next pass, through a delivery of the hub's own, and their queries use
+	// the database connections reserved by REALTIME_DB_CONNECTIONS.
+	Priority RunPriority `json:"priority"`
+}
+
+// Project defines model for Project.
+type Project struct {
+	CreatedAt      time.Time           `json:"created_at"`
+	Id             openapi_types.UUID  `json:"id"`
+	Name           string              `json:"name"`
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+	RunResultTags  []string            `json:"run_result_tags"`
+}
+
+// ProjectBootstrapResult defines model for ProjectBootstrapResult.
+type ProjectBootstrapResult struct {
+	NotificationSettings NotificationSettings `json:"notification_settings"`
+	Project              Project              `json:"project"`
+
+	// Supervisors The IDs of the created supervisors by template key
+	Supervisors  map[string]openapi_types.UUID `json:"supervisors"`
+	ToolPolicies []ToolPolicy                  `json:"tool_policies"`
+}
+
+// ProjectConfigBundle A project's supervision configuration. Tools and their chains are registered by each run, so the bundle carries the tool policies that create those chains.
+type ProjectConfigBundle struct {
+	Agents                []ConfigAgent         `json:"agents"`
+	ContextWindowPolicies []ContextWindowPolicy `json:"context_window_policies"`
+	ExportedAt            time.Time             `json:"exported_at"`
+	FormatVersion         int                   `json:"format_version"`
+	NotificationSettings  *NotificationSettings `json:"notification_settings,omitempty"`
+	ProjectName           string                `json:"project_name"`
+	RunResultTags         []string              `json:"run_result_tags"`
+	Supervisors           []ConfigSupervisor    `json:"supervisors"`
+	ToolPolicies          []ConfigToolPolicy    `json:"tool_policies"`
+}
+
+// ProjectFeatureFlag defines model for ProjectFeatureFlag.
+type ProjectFeatureFlag struct {
+	Enabled bool   `json:"enabled"`
+	Name    string `json:"name"`
+
+	// Reason Why a flag is on or off for a project. flag_default while the flag isn't rolled out,
+	// project_targeted or organization_targeted when a target decided it, and in_rollout or
+	// outside_rollout by the project's place in the rollout percentage.
+	Reason FeatureFlagReason `json:"reason"`
+}
+
+// ProjectImportResult defines model for ProjectImportResult.
+type ProjectImportResult struct {
+	Project Project `json:"project"`
+
+	// Supervisors The IDs of the created supervisors by bundle key
+	Supervisors map[string]openapi_types.UUID `json:"supervisors"`
+}
+
+// ProjectTemplate defines model for ProjectTemplate.
+type ProjectTemplate struct {
+	Description          string               `json:"description"`
+	Name                 string               `json:"name"`
+	NotificationSettings NotificationSettings `json:"notification_settings"`
+
+	// RiskTiers The chains for each risk tier, as lists of supervisor keys
+	RiskTiers   map[string][][]string `json:"risk_tiers"`
+	Supervisors []TemplateSupervisor  `json:"supervisors"`
+
+	// Tools The risk tier of each standard tool, * covers tools not listed
+	Tools map[string]RiskTier `json:"tools"`
+}
+
+// ProjectUsage defines model for ProjectUsage.
+type ProjectUsage struct {
+	Chats            int `json:"chats"`
+	CompletionTokens int `json:"completion_tokens"`
+
+	// CostUsd What the tokens of models with a known price cost in US dollars
+	CostUsd      float64            `json:"cost_usd"`
+	Models       []ModelUsage       `json:"models"`
+	ProjectId    openapi_types.UUID `json:"project_id"`
+	PromptTokens int                `json:"prompt_tokens"`
+
+	// Since The start of the window, chats created since are counted
+	Since time.Time `json:"since"`
+
+	// UnpricedModels Models with no known price, whose tokens the cost leaves out
+	UnpricedModels []string `json:"unpriced_models"`
+	Window         string   `json:"window"`
+}
+
+// PromptVariant A prompt an ensemble supervisor's members review with instead of their own, for comparing prompts
+// on live traffic. Each supervision request is reviewed with one variant, picked in proportion to
+// the variants' weights. A variant without a prompt keeps each member's own, which makes it the control.
+type PromptVariant struct {
+	// Name Unique within the supervisor
+	Name   string  `json:"name"`
+	Prompt *string `json:"prompt,omitempty"`
+
+	// Weight Share of traffic relative to the other variants. Defaults to 1.
+	Weight *int `json:"weight,omitempty"`
+}
+
+// PromptVariantReport How the prompt variants of an ensemble supervisor compare. Agreement only counts results humans
+// later in the same chain decided, and compares the decision the ensemble gave before any
+// confidence threshold escalated it.
+type PromptVariantReport struct {
+	SupervisorId openapi_types.UUID   `json:"supervisor_id"`
+	Variants     []PromptVariantStats `json:"variants"`
+}
+
+// PromptVariantStats defines model for PromptVariantStats.
+type PromptVariantStats struct {
+	// Agreed Results whose decision the humans made too
+	Agreed        int     `json:"agreed"`
+	AgreementRate float64 `json:"agreement_rate"`
+
+	// HumanDecided Results the humans after the supervisor decided too
+	HumanDecided  int     `json:"human_decided"`
+	RejectionRate float64 `json:"rejection_rate"`
+
+	// Rejections Results that rejected or terminated
+	Rejections int    `json:"rejections"`
+	Results    int    `json:"results"`
+	Variant    string `json:"variant"`
+}
+
+// ProxyRefusal Why a streamed proxy response was refused instead of continuing with its tool calls
+type ProxyRefusal struct {
+	ChatId    openapi_types.UUID     `json:"chat_id"`
+	ToolCalls []ProxyRefusedToolCall `json:"tool_calls"`
+}
+
+// ProxyRefusedToolCall defines model for ProxyRefusedToolCall.
+type ProxyRefusedToolCall struct {
+	// CallId The ID the upstream provider gave the tool call
+	CallId     *string            `json:"call_id,omitempty"`
+	Decision   *Decision          `json:"decision,omitempty"`
+	Name       string             `json:"name"`
+	Reasoning  *string            `json:"reasoning,omitempty"`
+	ToolCallId openapi_types.UUID `json:"tool_call_id"`
+}
+
+// Quota defines model for Quota.
+type Quota struct {
+	// HardLimit Requests that would go past this are refused
+	HardLimit *int64 `json:"hard_limit,omitempty"`
+
+	// Metric runs_per_day counts runs created since midnight UTC, stored_bytes the size of stored chats, and pending_reviews supervision requests waiting on a server side supervisor
+	Metric QuotaMetric `json:"metric"`
+
+	// SoftLimit Past this, requests still succeed but carry a warning header
+	SoftLimit *int64 `json:"soft_limit,omitempty"`
+}
+
+// QuotaExceeded Returned with 429 when a request would go past a hard limit
+type QuotaExceeded struct {
+	Error string     `json:"error"`
+	Usage QuotaUsage `json:"usage"`
+}
+
+// QuotaMetric runs_per_day counts runs created since midnight UTC, stored_bytes the size of stored chats, and pending_reviews supervision requests waiting on a server side supervisor
+type QuotaMetric string
+
+// QuotaState defines model for QuotaState.
+type QuotaState string
+
+// QuotaUsage defines model for QuotaUsage.
+type QuotaUsage struct {
+	HardLimit *int64 `json:"hard_limit,omitempty"`
+
+	// Metric runs_per_day counts runs created since midnight UTC, stored_bytes the size of stored chats, and pending_reviews supervision requests waiting on a server side supervisor
+	Metric QuotaMetric `json:"metric"`
+
+	// Scope project or organization
+	Scope     string             `json:"scope"`
+	ScopeId   openapi_types.UUID `json:"scope_id"`
+	SoftLimit *int64             `json:"soft_limit,omitempty"`
+	State     QuotaState         `json:"state"`
+	Used      int64              `json:"used"`
+}
+
+// RedactedSpan A placeholder in text, where something was redacted before it was stored
+type RedactedSpan struct {
+	// End Offset of the end of the placeholder in UTF-16 code units, exclusive
+	End         int    `json:"end"`
+	Placeholder string `json:"placeholder"`
+	Redactor    string `json:"redactor"`
+
+	// Start Offset of the placeholder in UTF-16 code units, the way JavaScript indexes strings
+	Start int `json:"start"`
+}
+
+// Redaction What a placeholder in a stored chat stands for
+type Redaction struct {
+	ChatId    openapi_types.UUID `json:"chat_id"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Original The redacted text, or the JSON of a field value redacted by its path
+	Original    string             `json:"original"`
+	Placeholder string             `json:"placeholder"`
+	Redactor    string             `json:"redactor"`
+	RunId       openapi_types.UUID `json:"run_id"`
+}
+
+// RedactionDetector Built in ways of finding sensitive text. credit_card_number finds card numbers that pass the
+// Luhn check, api_credential finds keys and tokens with well known prefixes, like those of
+// OpenAI, Anthropic, AWS, GitHub, Slack and Google, and email_address finds email addresses.
+type RedactionDetector string
+
+// Redactor Redacts sensitive data from chats before they're stored, both from message content and from
+// tool call arguments. Each redactor has exactly one of a detector, a pattern or a field path.
+// What's redacted is replaced with a placeholder like [REDACTED:name:1a2b3c4d], and the original
+// is kept apart, only readable with admin:projects. Agents and upstream providers get chats
+// unredacted, but supervisors and reviewers only see the placeholders.
+type Redactor struct {
+	// Detector Built in ways of finding sensitive text. credit_card_number finds card numbers that pass the
+	// Luhn check, api_credential finds keys and tokens with well known prefixes, like those of
+	// OpenAI, Anthropic, AWS, GitHub, Slack and Google, and email_address finds email addresses.
+	Detector *RedactionDetector `json:"detector,omitempty"`
+
+	// FieldPath Dot separated field names whose values are redacted whole, wherever the path ends a path
+	// to a value, so password redacts every password field and card.number only the number of
+	// a card
+	FieldPath *string `json:"field_path,omitempty"`
+
+	// Name Letters, digits, underscores and hyphens, as it's part of the placeholders
+	Name string `json:"name"`
+
+	// Pattern RE2 regular expression whose matches are redacted
+	Pattern *string `json:"pattern,omitempty"`
+}
+
+// ReminderAction What a reminder does. notify_assignee reminds the session the review is assigned to,
+// notify_queue reminds every connected reviewer session and escalate_to_session hands the
+// review to another session, like a manager's.
+type ReminderAction string
+
+// ReminderRequest defines model for ReminderRequest.
+type ReminderRequest struct {
+	// AfterSeconds How long from now to send the reminder
+	AfterSeconds int `json:"after_seconds"`
+
+	// Note Sent to the reviewer with the reminder
+	Note *string `json:"note,omitempty"`
+}
+
+// ReminderStep A step of a human supervisor's reminder schedule, taken if its review is still undecided by then
+type ReminderStep struct {
+	// Action What a reminder does. notify_assignee reminds the session the review is assigned to,
+	// notify_queue reminds every connected reviewer session and escalate_to_session hands the
+	// review to another session, like a manager's.
+	Action ReminderAction `json:"action"`
+
+	// AfterSeconds How long after the supervision request was created
+	AfterSeconds int `json:"after_seconds"`
+
+	// Session The session escalate_to_session hands the review to
+	Session *string `json:"session,omitempty"`
+}
+
+// ReplayChoicePick How the choice a step went on with was told apart. only_choice is a response with a single choice; next_request is the choice whose message the next chat's request sends back to the provider.
+type ReplayChoicePick string
+
+// ResourceKind defines model for ResourceKind.
+type ResourceKind string
+
+// ResourceMatch defines model for ResourceMatch.
+type ResourceMatch string
+
+// ResourceReference An external resource a tool call's arguments refer to
+type ResourceReference struct {
+	// Argument Where in the arguments the resource was found, e.g. $.options.bucket
+	Argument  string    `json:"argument"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Identifier The resource, normalized so the same resource always has the same identifier
+	Identifier string             `json:"identifier"`
+	Kind       ResourceKind       `json:"kind"`
+	RunId      openapi_types.UUID `json:"run_id"`
+	ToolCallId openapi_types.UUID `json:"tool_call_id"`
+}
+
+// ResultExplanation Why an automated supervisor decided as it did, shown to the humans who review the tool call after it. Needs the rules that matched, a rationale or both.
+type ResultExplanation struct {
+	// ArgumentRule The project argument rule that decided the tool call in place of the supervisor. Set by the server, ignored in submitted results.
+	ArgumentRule *string  `json:"argument_rule,omitempty"`
+	Confidence   *float64 `json:"confidence,omitempty"`
+
+	// MatchedRuleIds Rules of a rule based supervisor that matched the tool call
+	MatchedRuleIds *[]string `json:"matched_rule_ids,omitempty"`
+
+	// Rationale The model's explanation of its decision, for LLM supervisors
+	Rationale *string `json:"rationale,omitempty"`
+}
+
+// ResultSupervisor Checks the results of executed tool calls before they're fed back into the conversation. The
+// first supervisor that matches a result decides its verdict, and results none match are accepted.
+type ResultSupervisor struct {
+	// Action What happens to a tool call result a result supervisor matches. flag_result lets the result
+	// through marked as flagged, quarantine_result withholds it from the conversation, and
+	// hold_result withholds it until a reviewer decides it.
+	Action ResultSupervisorAction `json:"action"`
+	Name   string                 `json:"name"`
+
+	// Pattern RE2 regular expression matched against the result
+	Pattern string `json:"pattern"`
+
+	// Reason Why a matching result is flagged, quarantined or held
+	Reason *string `json:"reason,omitempty"`
+
+	// ToolName Only checks the results of this tool, unless unset
+	ToolName *string `json:"tool_name,omitempty"`
+}
+
+// ResultSupervisorAction What happens to a tool call result a result supervisor matches. flag_result lets the result
+// through marked as flagged, quarantine_result withholds it from the conversation, and
+// hold_result withholds it until a reviewer decides it.
+type ResultSupervisorAction string
+
+// Reversibility defines model for Reversibility.
+type Reversibility string
+
+// ReviewClaim defines model for ReviewClaim.
+type ReviewClaim struct {
+	ClaimedAt            time.Time          `json:"claimed_at"`
+	SupervisionRequestId openapi_types.UUID `json:"supervision_request_id"`
+	UserId               openapi_types.UUID `json:"user_id"`
+}
+
+// ReviewPayload Contains all the information needed for a human reviewer to make a supervision decision
+type ReviewPayload struct {
+	ArgumentDiff *ArgumentDiff `json:"argument_diff,omitempty"`
+	ArgumentForm *ArgumentForm `json:"argument_form,omitempty"`
+
+	// Artifacts The artifacts the run's agent uploaded, without their content
+	Artifacts *[]RunArtifact `json:"artifacts,omitempty"`
+
+	// BlastRadius Estimated from the resources the tool call's arguments refer to and what happened to earlier
+	// tool calls of the project that touched them
+	BlastRadius *BlastRadius        `json:"blast_radius,omitempty"`
+	ChainState  ChainExecutionState `json:"chain_state"`
+
+	// Clarifications The questions reviewers asked the agent about this request and its answers, oldest first
+	Clarifications  *[]Clarification         `json:"clarifications,omitempty"`
+	ComputerAction  *ComputerAction          `json:"computer_action,omitempty"`
+	DependencyGraph *ToolCallDependencyGraph `json:"dependency_graph,omitempty"`
+
+	// Documents The reference documents attached to the run, with their content
+	Documents *[]RunDocument `json:"documents,omitempty"`
+
+	// Messages The messages in the run
+	Messages []AsteroidMessage `json:"messages"`
+
+	// PlanDeviation Recorded for a tool call a run makes once it has an approved plan that the call doesn't
+	// follow. Approvals of the tool call by automated supervisors are escalated.
+	PlanDeviation *PlanDeviation `json:"plan_deviation,omitempty"`
+
+	// RunId The ID of the run this review is for
+	RunId openapi_types.UUID `json:"run_id"`
+
+	// Screenshot The screenshot a browser agent took before a tool call, with what changed since the previous
+	// screenshot of its run and where the call clicks, if its arguments say
+	Screenshot         *ToolCallScreenshot `json:"screenshot,omitempty"`
+	SupervisionRequest SupervisionRequest  `json:"supervision_request"`
+	Toolcall           AsteroidToolCall    `json:"toolcall"`
+}
+
+// Reviewer defines model for Reviewer.
+type Reviewer struct {
+	// Locale The locale the session gets review event messages in, e.g. de, replacing the one it connects with
+	Locale *string `json:"locale,omitempty"`
+	Name   *string `json:"name,omitempty"`
+
+	// Session The session key, taken from the path when setting a reviewer
+	Session string `json:"session"`
+
+	// Skills e.g. database, payments or security, matched case insensitively
+	Skills    []string   `json:"skills"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// RiskTier How much damage a tool can do, which decides how heavily its calls are supervised
+type RiskTier string
+
+// RoutingRule Conditions a tool call has to meet for the rule to apply, a rule without any applies to every tool call
+type RoutingRule struct {
+	// Category A category the tool was registered with in its category or categories attributes
+	Category *string `json:"category,omitempty"`
+
+	// Irreversible Applies only to tool calls estimated to be irreversible, like destructive SQL
+	Irreversible *bool `json:"irreversible,omitempty"`
+
+	// MinRiskTier How much damage a tool can do, which decides how heavily its calls are supervised
+	MinRiskTier    *RiskTier `json:"min_risk_tier,omitempty"`
+	RequiredSkills []string  `json:"required_skills"`
+
+	// ToolName Name of the tool, or * for every tool
+	ToolName *string `json:"tool_name,omitempty"`
+}
+
+// Run defines model for Run.
+type Run struct {
+	// AgentId Agent build the run was made by
+	AgentId *openapi_types.UUID `json:"agent_id,omitempty"`
+
+	// AutonomyLevel How much of a run's supervision is left to automated supervisors, decided when its tool calls' chains are selected. 0 is fully manual, chains with a human supervisor are the only ones consulted when a tool has any. 1 leaves tools below medium risk to automated chains and 2 those below high risk. 3 is fully automatic, chains with a human supervisor are skipped. Tools without a risk tier are treated as needing humans below 3. Runs are supervised at the higher of their own level and the one their agent earned with the tool. Runs without either level are supervised by every chain, and chains of an active incident always apply.
+	AutonomyLevel *AutonomyLevel     `json:"autonomy_level,omitempty"`
+	CreatedAt     time.Time          `json:"created_at"`
+	Id            openapi_types.UUID `json:"id"`
+
+	// Priority The priority class of a run, chosen when it's created. The supervision requests of interactive
+	// runs are processed ahead of those of batch runs, and batch runs only get a share of the capacity
+	// for asking LLM and ensemble supervisors, so backfills can't hold up production traffic.
+	// Defaults to interactive. realtime is for approvals a person is waiting on in a chat, which are
+	// processed ahead of both. Their human reviews go to reviewers as soon as they're created rather
+	// than on the processor's next pass, through a delivery of the hub's own, and their queries use
+	// the database connections reserved by REALTIME_DB_CONNECTIONS.
+	Priority *RunPriority `json:"priority,omitempty"`
+	Result   *string      `json:"result,omitempty"`
+
+	// Status paused is only used for runs, while their organization's kill switch is active.
+	// awaiting_clarification is only used for supervision requests and tool calls whose reviewer
+	// asked the agent a question that hasn't been answered yet.
+	Status *Status            `json:"status,omitempty"`
+	TaskId openapi_types.UUID `json:"task_id"`
+}
+
+// RunArchive A run with everything needed to recreate it and its supervision elsewhere
+type RunArchive struct {
+	// Chats The run's chats, oldest first
+	Chats      []RunArchiveChat `json:"chats"`
+	ExportedAt time.Time        `json:"exported_at"`
+
+	// FormatVersion Version of the archive's layout, 1
+	FormatVersion int `json:"format_version"`
+	Run           Run `json:"run"`
+
+	// ToolCalls The run's tool calls, oldest first
+	ToolCalls []RunArchiveToolCall `json:"tool_calls"`
+	Tools     []RunArchiveTool     `json:"tools"`
+}
+
+// RunArchiveChainExecution defines model for RunArchiveChainExecution.
+type RunArchiveChainExecution struct {
+	ChainExecution ChainExecution `json:"chain_execution"`
+
+	// SupervisionRequests The chain's supervision requests, in their order in the chain
+	SupervisionRequests []RunArchiveSupervisionRequest `json:"supervision_requests"`
+}
+
+// RunArchiveChat defines model for RunArchiveChat.
+type RunArchiveChat struct {
+	// Chat The raw b64 encoded JSON of the request and response data sent/received from the LLM.
+	Chat AsteroidChat `json:"chat"`
+
+	// Choices The chat's choices, for reading the archive. Imports use the chat.
+	Choices []AsteroidChoice `json:"choices"`
+
+	// Messages The chat's messages, for reading the archive. Their IDs are made up when exporting, tool calls match the run's by call_id. Imports use the chat.
+	Messages []AsteroidMessage `json:"messages"`
+}
+
+// RunArchiveFormat json by default, tar_gz for a gzipped tar file holding the JSON as run.json
+type RunArchiveFormat string
+
+// RunArchiveSupervisionRequest defines model for RunArchiveSupervisionRequest.
+type RunArchiveSupervisionRequest struct {
+	Result *SupervisionResult `json:"result,omitempty"`
+
+	// Statuses Every status the request had, oldest first
+	Statuses           []SupervisionStatus `json:"statuses"`
+	SupervisionRequest SupervisionRequest  `json:"supervision_request"`
+}
+
+// RunArchiveTool defines model for RunArchiveTool.
+type RunArchiveTool struct {
+	Chains []SupervisorChain `json:"chains"`
+	Tool   Tool              `json:"tool"`
+}
+
+// RunArchiveToolCall defines model for RunArchiveToolCall.
+type RunArchiveToolCall struct {
+	ChainExecutions []RunArchiveChainExecution `json:"chain_executions"`
+	Decision        *Decision                  `json:"decision,omitempty"`
+	ToolCall        AsteroidToolCall           `json:"tool_call"`
+}
+
+// RunArtifact A file an agent produced during a run, kept in the blob store
+type RunArtifact struct {
+	ContentType string             `json:"content_type"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Id          openapi_types.UUID `json:"id"`
+	Name        string             `json:"name"`
+	RunId       openapi_types.UUID `json:"run_id"`
+
+	// Sha256 Hex encoded SHA-256 of the content
+	Sha256    string `json:"sha256"`
+	SizeBytes int64  `json:"size_bytes"`
+
+	// ToolCallId The tool call that produced the artifact, if it was uploaded for one
+	ToolCallId *openapi_types.UUID `json:"tool_call_id,omitempty"`
+	UploadedBy string              `json:"uploaded_by"`
+}
+
+// RunComparison defines model for RunComparison.
+type RunComparison struct {
+	// Divergence The first message at which the runs' latest chats differ in role, content or tool calls. A
+	// run's message is unset if its conversation ended before it.
+	Divergence *RunDivergence       `json:"divergence,omitempty"`
+	Runs       []RunComparisonEntry `json:"runs"`
+}
+
+// RunComparisonEntry defines model for RunComparisonEntry.
+type RunComparisonEntry struct {
+	Chats            int `json:"chats"`
+	CompletionTokens int `json:"completion_tokens"`
+
+	// Decisions How many tool calls ended up with each outcome
+	Decisions TaskDecisionCounts `json:"decisions"`
+
+	// Messages The messages of the run's latest chat
+	Messages     int `json:"messages"`
+	PromptTokens int `json:"prompt_tokens"`
+
+	// RejectionRate The share of the run's decided tool calls that were rejected or terminated
+	RejectionRate float64            `json:"rejection_rate"`
+	RunId         openapi_types.UUID `json:"run_id"`
+
+	// Status paused is only used for runs, while their organization's kill switch is active.
+	// awaiting_clarification is only used for supervision requests and tool calls whose reviewer
+	// asked the agent a question that hasn't been answered yet.
+	Status    *Status            `json:"status,omitempty"`
+	TaskId    openapi_types.UUID `json:"task_id"`
+	ToolCalls int                `json:"tool_calls"`
+
+	// Tools How many times the run called each tool, by tool name
+	Tools map[string]int `json:"tools"`
+}
+
+// RunDivergence The first message at which the runs' latest chats differ in role, content or tool calls. A
+// run's message is unset if its conversation ended before it.
+type RunDivergence struct {
+	Index    int                `json:"index"`
+	Messages []DivergentMessage `json:"messages"`
+}
+
+// RunDocument defines model for RunDocument.
+type RunDocument struct {
+	// Content Only included when getting a single document or a review payload
+	Content                    *string            `json:"content,omitempty"`
+	ContentType                string             `json:"content_type"`
+	CreatedAt                  time.Time          `json:"created_at"`
+	Id                         openapi_types.UUID `json:"id"`
+	IncludeInSupervisorContext bool               `json:"include_in_supervisor_context"`
+	Name                       string             `json:"name"`
+	RunId                      openapi_types.UUID `json:"run_id"`
+	SizeBytes                  int64              `json:"size_bytes"`
+}
+
+// RunEvent defines model for RunEvent.
+type RunEvent struct {
+	Attributes *map[string]interface{} `json:"attributes,omitempty"`
+	CreatedAt  time.Time               `json:"created_at"`
+	Id         openapi_types.UUID      `json:"id"`
+	Message    *string                 `json:"message,omitempty"`
+	OccurredAt time.Time               `json:"occurred_at"`
+	RunId      openapi_types.UUID      `json:"run_id"`
+	Source     string                  `json:"source"`
+	Type       string                  `json:"type"`
+}
+
+// RunEventRequest defines model for RunEventRequest.
+type RunEventRequest struct {
+	Attributes *map[string]interface{} `json:"attributes,omitempty"`
+	Message    *string                 `json:"message,omitempty"`
+
+	// OccurredAt Defaults to when the event is posted
+	OccurredAt *time.Time `json:"occurred_at,omitempty"`
+
+	// Source The system the event comes from, e.g. ci, monitoring or ticketing
+	Source string `json:"source"`
+
+	// Type e.g. deployment_failed, matched case insensitively by policy rules
+	Type string `json:"type"`
+}
+
+// RunExecution defines model for RunExecution.
+type RunExecution struct {
+	Chains []ChainExecutionState `json:"chains"`
+
+	// ModifiedArguments The arguments to execute the tool call with, once it's been decided and a supervisor modified them. The tool call keeps the arguments the agent gave.
+	ModifiedArguments *string `json:"modified_arguments,omitempty"`
+
+	// Status paused is only used for runs, while their organization's kill switch is active.
+	// awaiting_clarification is only used for supervision requests and tool calls whose reviewer
+	// asked the agent a question that hasn't been answered yet.
+	Status   Status           `json:"status"`
+	Toolcall AsteroidToolCall `json:"toolcall"`
+}
+
+// RunExportLine A line of a run export, either a run or the end of the export
+type RunExportLine struct {
+	// Exported How many runs this stream exported, only sent at the end
+	Exported *int `json:"exported,omitempty"`
+
+	// ResumeToken Resumes the export after this run
+	ResumeToken *string           `json:"resume_token,omitempty"`
+	Run         *ExportedRun      `json:"run,omitempty"`
+	Type        RunExportLineType `json:"type"`
+}
+
+// RunExportLineType defines model for RunExportLineType.
+type RunExportLineType string
+
+// RunImportResult defines model for RunImportResult.
+type RunImportResult struct {
+	RunId openapi_types.UUID `json:"run_id"`
+
+	// SkippedSupervisionRequests Supervision requests left out because they were still open
+	SkippedSupervisionRequests int `json:"skipped_supervision_requests"`
+
+	// ToolCallIds The new ID of each archived tool call, by its ID in the archive
+	ToolCallIds map[string]openapi_types.UUID `json:"tool_call_ids"`
+}
+
+// RunLimitExceeded Returned with 429 when a run is at one of its limits
+type RunLimitExceeded struct {
+	Error string        `json:"error"`
+	Usage RunLimitUsage `json:"usage"`
+}
+
+// RunLimitKind defines model for RunLimitKind.
+type RunLimitKind string
+
+// RunLimitSource Whether a limit is the run's project's or the server's default, for projects that don't set it
+type RunLimitSource string
+
+// RunLimitUsage defines model for RunLimitUsage.
+type RunLimitUsage struct {
+	// Exceeded Whether the run's next request of the kind is refused
+	Exceeded bool         `json:"exceeded"`
+	Limit    RunLimitKind `json:"limit"`
+
+	// Source Whether a limit is the run's project's or the server's default, for projects that don't set it
+	Source RunLimitSource `json:"source"`
+	Used   int64          `json:"used"`
+	Value  int            `json:"value"`
+}
+
+// RunLimits Limits on each run of a project, so a runaway agent can't flood reviewers or the store
+type RunLimits struct {
+	// MaxChatRequests How many chat requests a run can make in all
+	MaxChatRequests *int `json:"max_chat_requests,omitempty"`
+
+	// MaxPendingSupervisions How many of a run's supervision requests can wait on a server side supervisor at once
+	MaxPendingSupervisions *int `json:"max_pending_supervisions,omitempty"`
+
+	// ToolCallsPerMinute How many tool calls a run can make in a minute
+	ToolCallsPerMinute *int `json:"tool_calls_per_minute,omitempty"`
+}
+
+// RunPause defines model for RunPause.
+type RunPause struct {
+	PausedAt time.Time `json:"paused_at"`
+
+	// PausedBy The actor that paused the run
+	PausedBy string `json:"paused_by"`
+
+	// PreviousStatus paused is only used for runs, while their organization's kill switch is active.
+	// awaiting_clarification is only used for supervision requests and tool calls whose reviewer
+	// asked the agent a question that hasn't been answered yet.
+	PreviousStatus Status             `json:"previous_status"`
+	Reason         *string            `json:"reason,omitempty"`
+	RunId          openapi_types.UUID `json:"run_id"`
+}
+
+// RunPriority The priority class of a run, chosen when it's created. The supervision requests of interactive
+// runs are processed ahead of those of batch runs, and batch runs only get a share of the capacity
+// for asking LLM and ensemble supervisors, so backfills can't hold up production traffic.
+// Defaults to interactive. realtime is for approvals a person is waiting on in a chat, which are
+// processed ahead of both. Their human reviews go to reviewers as soon as they're created rather
+// than on the processor's next pass, through a delivery of the hub's own, and their queries use
+// the database connections reserved by REALTIME_DB_CONNECTIONS.
+type RunPriority string
+
+// RunReplay A run's chats in the order they were made, for sending each request to the provider again. Payloads are stored as JSONB, so they come back with the provider's JSON normalized, key order and whitespace aside.
+type RunReplay struct {
+	RunId openapi_types.UUID `json:"run_id"`
+	Steps []RunReplayStep    `json:"steps"`
+}
+
+// RunReplayStep defines model for RunReplayStep.
+type RunReplayStep struct {
+	// Chat The raw b64 encoded JSON of the request and response data sent/received from the LLM.
+	Chat AsteroidChat `json:"chat"`
+
+	// Choices How many choices the response has
+	Choices int `json:"choices"`
+
+	// Index The step's position in the run, 0 for its first chat
+	Index int `json:"index"`
+
+	// PickedBy How the choice a step went on with was told apart. only_choice is a response with a single choice; next_request is the choice whose message the next chat's request sends back to the provider.
+	PickedBy *ReplayChoicePick `json:"picked_by,omitempty"`
+
+	// PickedChoice The index of the response's choice the agent went on with, unset if it can't be told, like a last response with several choices
+	PickedChoice *int `json:"picked_choice,omitempty"`
+}
+
+// RunState defines model for RunState.
+type RunState = []RunExecution
+
+// RunUsage defines model for RunUsage.
+type RunUsage struct {
+	Chats            int `json:"chats"`
+	CompletionTokens int `json:"completion_tokens"`
+
+	// CostUsd What the tokens of models with a known price cost in US dollars
+	CostUsd      float64            `json:"cost_usd"`
+	Models       []ModelUsage       `json:"models"`
+	PromptTokens int                `json:"prompt_tokens"`
+	RunId        openapi_types.UUID `json:"run_id"`
+
+	// UnpricedModels Models with no known price, whose tokens the cost leaves out
+	UnpricedModels []string `json:"unpriced_models"`
+}
+
+// ScreenshotPoint defines model for ScreenshotPoint.
+type ScreenshotPoint struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// ScreenshotRegion defines model for ScreenshotRegion.
+type ScreenshotRegion struct {
+	Height int `json:"height"`
+	Width  int `json:"width"`
+	X      int `json:"x"`
+	Y      int `json:"y"`
+}
+
+// SearchHit defines model for SearchHit.
+type SearchHit struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Kind What a search hit is. message_hit is a message of a run, tool_call_hit a tool call matching by its name or arguments and decision_hit a supervisor's decision matching by its reasoning.
+	Kind SearchHitKind `json:"kind"`
+
+	// MessageId The message that matched or that made the tool call, unset for decisions
+	MessageId *openapi_types.UUID `json:"message_id,omitempty"`
+	ProjectId openapi_types.UUID  `json:"project_id"`
+
+	// Rank How well the hit matches, higher is better
+	Rank  float32            `json:"rank"`
+	RunId openapi_types.UUID `json:"run_id"`
+
+	// Snippet The matched text around the match, with matched words between [[ and ]]
+	Snippet string `json:"snippet"`
+
+	// SupervisionRequestId The supervision request that was decided, only set for decisions
+	SupervisionRequestId *openapi_types.UUID `json:"supervision_request_id,omitempty"`
+
+	// ToolCallId The tool call that matched or was decided, unset for messages
+	ToolCallId *openapi_types.UUID `json:"tool_call_id,omitempty"`
+
+	// ToolName The tool that was called, unset for messages
+	ToolName *string `json:"tool_name,omitempty"`
+}
+
+// SearchHitKind What a search hit is. message_hit is a message of a run, tool_call_hit a tool call matching by its name or arguments and decision_hit a supervisor's decision matching by its reasoning.
+type SearchHitKind string
+
+// ServerCapabilities defines model for ServerCapabilities.
+type ServerCapabilities struct {
+	ApiVersion       string            `json:"api_version"`
+	ChatFormats      []ChatFormat      `json:"chat_formats"`
+	Decisions        []Decision        `json:"decisions"`
+	SupervisorTypes  []SupervisorType  `json:"supervisor_types"`
+	Transports       []Transport       `json:"transports"`
+	VerdictBehaviors []VerdictBehavior `json:"verdict_behaviors"`
+}
+
+// ServiceNowSupervisorAttributes The attributes of a servicenow_supervisor
+type ServiceNowSupervisorAttributes struct {
+	// AssignmentGroup The sys_id or name of the group the change requests are assigned to
+	AssignmentGroup *string `json:"assignment_group,omitempty"`
+	Category        *string `json:"category,omitempty"`
+
+	// ChangeType The type of the change requests, normal by default
+	ChangeType *ServiceNowSupervisorAttributesChangeType `json:"change_type,omitempty"`
+}
+
+// ServiceNowSupervisorAttributesChangeType The type of the change requests, normal by default
+type ServiceNowSupervisorAttributesChangeType string
+
+// SkippedOpenApiOperation defines model for SkippedOpenApiOperation.
+type SkippedOpenApiOperation struct {
+	Method   string `json:"method"`
+	Path     string `json:"path"`
+	Reason   string `json:"reason"`
+	ToolName string `json:"tool_name"`
+}
+
+// Status paused is only used for runs, while their organization's kill switch is active.
+// awaiting_clarification is only used for supervision requests and tool calls whose reviewer
+// asked the agent a question that hasn't been answered yet.
+type Status string
+
+// StoredContentKind defines model for StoredContentKind.
+type StoredContentKind string
+
+// StuckExecutionRepairs Supervision requests an LLM or ensemble supervisor was still deciding after the threshold, which the stuck_executions worker decided with the supervisor's failure policy. Counted by the replica that answered while it ran the worker, since it started.
+type StuckExecutionRepairs struct {
+	// ByDecision Repaired requests by the decision their failure policy made
+	ByDecision map[string]int `json:"by_decision"`
+
+	// LastCheckedAt When the replica last looked for stuck requests, unset if it never ran the worker
+	LastCheckedAt    *time.Time `json:"last_checked_at,omitempty"`
+	Repaired         int        `json:"repaired"`
+	ThresholdSeconds int        `json:"threshold_seconds"`
+}
+
+// SupervisionRequest defines model for SupervisionRequest.
+type SupervisionRequest struct {
+	ChainexecutionId *openapi_types.UUID `json:"chainexecution_id,omitempty"`
+	Id               *openapi_types.UUID `json:"id,omitempty"`
+	PositionInChain  int                 `json:"position_in_chain"`
+
+	// Priority The priority class of a run, chosen when it's created. The supervision requests of interactive
+	// runs are processed ahead of those of batch runs, and batch runs only get a share of the capacity
+	// for asking LLM and ensemble supervisors, so backfills can't hold up production traffic.
+	// Defaults to interactive. realtime is for approvals a person is waiting on in a chat, which are
+	// processed ahead of both. Their human reviews go to reviewers as soon as they're created rather
+	// than on the processor's next pass, through a delivery of the hub's own, and their queries use
+	// the database connections reserved by REALTIME_DB_CONNECTIONS.
+	Priority     *RunPriority       `json:"priority,omitempty"`
+	Status       *SupervisionStatus `json:"status,omitempty"`
+	SupervisorId openapi_types.UUID `json:"supervisor_id"`
+}
+
+// SupervisionRequestState defines model for SupervisionRequestState.
+type SupervisionRequestState struct {
+	Result             *SupervisionResult `json:"result,omitempty"`
+	Status             SupervisionStatus  `json:"status"`
+	SupervisionRequest SupervisionRequest `json:"supervision_request"`
+}
+
+// SupervisionResult defines model for SupervisionResult.
+type SupervisionResult struct {
+	CreatedAt time.Time `json:"created_at"`
+	Decision  Decision  `json:"decision"`
+
+	// Explanation Why an automated supervisor decided as it did, shown to the humans who review the tool call after it. Needs the rules that matched, a rationale or both.
+	Explanation *ResultExplanation  `json:"explanation,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+
+	// ModifiedArguments The arguments to execute the tool call with instead of its own, as a JSON object. Required with modify, and checked against the tool's JSON Schema if it has one.
+	ModifiedArguments *string `json:"modified_arguments,omitempty"`
+
+	// OriginalArguments The arguments of the tool call when it was modified, set by the server
+	OriginalArguments  *string   `json:"original_arguments,omitempty"`
+	OverriddenDecision *Decision `json:"overridden_decision,omitempty"`
+
+	// Precedents The results of earlier decisions in the project the decision cites, as in approved per precedent, at most 5
+	Precedents *[]openapi_types.UUID `json:"precedents,omitempty"`
+
+	// Question A question a reviewer asks the agent before deciding
+	Question             *ClarificationQuestion `json:"question,omitempty"`
+	Reasoning            string                 `json:"reasoning"`
+	SupervisionRequestId openapi_types.UUID     `json:"supervision_request_id"`
+
+	// TimeoutFallback What's decided when a supervisor doesn't decide in time. escalate_to_next escalates to the next supervisor in the chain, and rejects when the supervisor was the last one.
+	TimeoutFallback *TimeoutFallback    `json:"timeout_fallback,omitempty"`
+	ToolcallId      *openapi_types.UUID `json:"toolcall_id,omitempty"`
+
+	// Usage Tokens an LLM supervisor used to reach its decision
+	Usage *SupervisorUsage `json:"usage,omitempty"`
+
+	// UserId The user who made the decision, set by the server when their API key or connection belongs to one
+	UserId *openapi_types.UUID `json:"user_id,omitempty"`
+
+	// Verdict One of the project's custom verdicts. The decision follows from the verdict's behavior,
+	// so it can be left out.
+	Verdict *string `json:"verdict,omitempty"`
+
+	// VerdictBehavior What happens to a tool call given a custom verdict. block rejects it, continue approves it
+	// and clarify leaves it undecided until the agent answers the reviewer's question.
+	VerdictBehavior *VerdictBehavior `json:"verdict_behavior,omitempty"`
+}
+
+// SupervisionStatus defines model for SupervisionStatus.
+type SupervisionStatus struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        int       `json:"id"`
+
+	// Status paused is only used for runs, while their organization's kill switch is active.
+	// awaiting_clarification is only used for supervision requests and tool calls whose reviewer
+	// asked the agent a question that hasn't been answered yet.
+	Status               Status              `json:"status"`
+	SupervisionRequestId *openapi_types.UUID `json:"supervision_request_id,omitempty"`
+}
+
+// Supervisor defines model for Supervisor.
+type Supervisor struct {
+	// Attributes Human supervisors read assignment_strategy, an AssignmentStrategy, require_skill_match and
+	// reminders, a list of ReminderStep.
+	// Consent supervisors read consent_ttl_minutes.
+	// Ensemble supervisors read members, a list of EnsembleMember, aggregation, an EnsembleAggregation,
+	// and prompt_variants, a list of PromptVariant.
+	// LLM supervisors read LlmSupervisorAttributes.
+	// Ensemble and LLM supervisors also read failure_policy, a FailurePolicy, and circuit_breaker,
+	// a CircuitBreakerSettings.
+	// Policy supervisors read rules, a list of PolicyRule, and default_decision.
+	// Computer use supervisors read rules, a list of ComputerUseRule, default_decision,
+	// invalid_decision, what's decided on arguments that aren't a valid action (escalate unless
+	// set), and block_password_typing, which rejects typing into password fields unless false.
+	Attributes  map[string]interface{} `json:"attributes"`
+	Code        string                 `json:"code"`
+	CreatedAt   time.Time              `json:"created_at"`
+	Description string                 `json:"description"`
+	Id          *openapi_types.UUID    `json:"id,omitempty"`
+	Name        string                 `json:"name"`
+
+	// Type The type of supervisor. ClientSupervisor means that the supervision is done client side and the server is merely informed. Other supervisor types are handled serverside, e.g. HumanSupervisor means that a human will review the request via the Asteroid UI, ConsentSupervisor means the end user affected by the tool call must consent to it through a link, EnsembleSupervisor means several LLM judges are asked in parallel and their verdicts aggregated, PolicySupervisor means the decision of the first of its rules (attribute rules, a list of PolicyRule) that the events posted to the run match, or its default_decision attribute (approve unless set) if none do, LlmSupervisor means the server asks one model with a prompt rendered from a template and records the decision and rationale it answers with (attributes as in LlmSupervisorAttributes), and ComputerUseSupervisor means the server validates the computer use action of the tool call's arguments, deciding by the first of its rules (attribute rules, a list of ComputerUseRule) that the action matches. ServicenowSupervisor means the server opens a change request in the configured ServiceNow instance (attributes as in ServiceNowSupervisorAttributes) and decides by its approval there, approving the tool call once the change request is approved and rejecting it once the change request is rejected or canceled.
+	Type SupervisorType `json:"type"`
+}
+
+// SupervisorChain defines model for SupervisorChain.
+type SupervisorChain struct {
+	ChainId            openapi_types.UUID   `json:"chain_id"`
+	MinConfidence      *float64             `json:"min_confidence,omitempty"`
+	SupervisorTiers    *[]SupervisorTier    `json:"supervisor_tiers,omitempty"`
+	SupervisorTimeouts *[]SupervisorTimeout `json:"supervisor_timeouts,omitempty"`
+	Supervisors        []Supervisor         `json:"supervisors"`
+	Timeout            *ChainTimeout        `json:"timeout,omitempty"`
+
+	// Version Starts at 1 and increases with every edit of the chain's supervisors
+	Version int `json:"version"`
+}
+
+// SupervisorTestCase An example tool call and the decision a supervisor is expected to give it
+type SupervisorTestCase struct {
+	// Arguments Arguments in JSON format
+	Arguments        *string  `json:"arguments,omitempty"`
+	ExpectedDecision Decision `json:"expected_decision"`
+
+	// Name Unique within the supervisor
+	Name            string  `json:"name"`
+	ToolDescription *string `json:"tool_description,omitempty"`
+	ToolName        string  `json:"tool_name"`
+}
+
+// SupervisorTestReport defines model for SupervisorTestReport.
+type SupervisorTestReport struct {
+	Failed       int                    `json:"failed"`
+	Passed       int                    `json:"passed"`
+	Results      []SupervisorTestResult `json:"results"`
+	Skipped      int                    `json:"skipped"`
+	SupervisorId openapi_types.UUID     `json:"supervisor_id"`
+}
+
+// SupervisorTestResult defines model for SupervisorTestResult.
+type SupervisorTestResult struct {
+	Decision         *Decision `json:"decision,omitempty"`
+	ExpectedDecision Decision  `json:"expected_decision"`
+	Name             string    `json:"name"`
+	Passed           bool      `json:"passed"`
+	Reasoning        string    `json:"reasoning"`
+	Skipped          bool      `json:"skipped"`
+}
+
+// SupervisorTier The escalation tier of one supervisor of a chain. Supervisors of a tier are next to each other
+// in the chain, and tiers only go up along it. A supervisor without a tier is a tier of its own.
+//
+// A supervisor deciding escalate skips the rest of its tier, sending the tool call to the first
+// supervisor of the next tier. Escalations a supervisor didn't decide itself, by a timeout's
+// escalate_to_next fallback or a result below the chain's min_confidence, go to the next
+// supervisor in the chain, so the others of a tier can cover for one that couldn't decide.
+type SupervisorTier struct {
+	SupervisorId openapi_types.UUID `json:"supervisor_id"`
+	Tier         int                `json:"tier"`
+}
+
+// SupervisorTimeout The timeout of one supervisor of a chain, in place of the chain's
+type SupervisorTimeout struct {
+	// Fallback What's decided when a supervisor doesn't decide in time. escalate_to_next escalates to the next supervisor in the chain, and rejects when the supervisor was the last one.
+	Fallback       TimeoutFallback    `json:"fallback"`
+	SupervisorId   openapi_types.UUID `json:"supervisor_id"`
+	TimeoutSeconds int                `json:"timeout_seconds"`
+}
+
+// SupervisorType The type of supervisor. ClientSupervisor means that the supervision is done client side and the server is merely informed. Other supervisor types are handled serverside, e.g. HumanSupervisor means that a human will review the request via the Asteroid UI, ConsentSupervisor means the end user affected by the tool call must consent to it through a link, EnsembleSupervisor means several LLM judges are asked in parallel and their verdicts aggregated, PolicySupervisor means the decision of the first of its rules (attribute rules, a list of PolicyRule) that the events posted to the run match, or its default_decision attribute (approve unless set) if none do, LlmSupervisor means the server asks one model with a prompt rendered from a template and records the decision and rationale it answers with (attributes as in LlmSupervisorAttributes), and ComputerUseSupervisor means the server validates the computer use action of the tool call's arguments, deciding by the first of its rules (attribute rules, a list of ComputerUseRule) that the action matches. ServicenowSupervisor means the server opens a change request in the configured ServiceNow instance (attributes as in ServiceNowSupervisorAttributes) and decides by its approval there, approving the tool call once the change request is approved and rejecting it once the change request is rejected or canceled.
+type SupervisorType string
+
+// SupervisorUsage Tokens an LLM supervisor used to reach its decision
+type SupervisorUsage struct {
+	CompletionTokens int64   `json:"completion_tokens"`
+	Model            *string `json:"model,omitempty"`
+	PromptTokens     int64   `json:"prompt_tokens"`
+}
+
+// Task defines model for Task.
+type Task struct {
+	CreatedAt   time.Time          `json:"created_at"`
+	Description *string            `json:"description,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+	Name        string             `json:"name"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+}
+
+// TaskDecisionCounts How many tool calls ended up with each outcome
+type TaskDecisionCounts struct {
+	Approved   int `json:"approved"`
+	Modified   int `json:"modified"`
+	Rejected   int `json:"rejected"`
+	Terminated int `json:"terminated"`
+	Undecided  int `json:"undecided"`
+}
+
+// TaskSummary defines model for TaskSummary.
+type TaskSummary struct {
+	Chats int `json:"chats"`
+
+	// CompletionTokens Completion tokens reported by the model provider across every chat
+	CompletionTokens int `json:"completion_tokens"`
+
+	// Decisions How many tool calls ended up with each outcome
+	Decisions TaskDecisionCounts `json:"decisions"`
+
+	// PromptTokens Prompt tokens reported by the model provider across every chat
+	PromptTokens int                `json:"prompt_tokens"`
+	Runs         int                `json:"runs"`
+	RunsByStatus map[string]int     `json:"runs_by_status"`
+	TaskId       openapi_types.UUID `json:"task_id"`
+	ToolCalls    int                `json:"tool_calls"`
+}
+
+// TaskTimelineEntry defines model for TaskTimelineEntry.
+type TaskTimelineEntry struct {
+	// ChatId Set for chat_completion
+	ChatId *openapi_types.UUID `json:"chat_id,omitempty"`
+
+	// CompletionTokens Set for chat_completion
+	CompletionTokens *int              `json:"completion_tokens,omitempty"`
+	CreatedAt        time.Time         `json:"created_at"`
+	Event            TaskTimelineEvent `json:"event"`
+
+	// PromptTokens Set for chat_completion
+	PromptTokens  *int                  `json:"prompt_tokens,omitempty"`
+	RunEvent      *RunEvent             `json:"run_event,omitempty"`
+	RunId         openapi_types.UUID    `json:"run_id"`
+	ToolCallEvent *ToolCallHistoryEntry `json:"tool_call_event,omitempty"`
+
+	// ToolCallId Set for tool_call_event
+	ToolCallId *openapi_types.UUID `json:"tool_call_id,omitempty"`
+}
+
+// TaskTimelineEvent defines model for TaskTimelineEvent.
+type TaskTimelineEvent string
+
+// TemplateSupervisor defines model for TemplateSupervisor.
+type TemplateSupervisor struct {
+	Description string `json:"description"`
+
+	// Key Identifies the supervisor within the template
+	Key  string `json:"key"`
+	Name string `json:"name"`
+
+	// Type The type of supervisor. ClientSupervisor means that the supervision is done client side and the server is merely informed. Other supervisor types are handled serverside, e.g. HumanSupervisor means that a human will review the request via the Asteroid UI, ConsentSupervisor means the end user affected by the tool call must consent to it through a link, EnsembleSupervisor means several LLM judges are asked in parallel and their verdicts aggregated, PolicySupervisor means the decision of the first of its rules (attribute rules, a list of PolicyRule) that the events posted to the run match, or its default_decision attribute (approve unless set) if none do, LlmSupervisor means the server asks one model with a prompt rendered from a template and records the decision and rationale it answers with (attributes as in LlmSupervisorAttributes), and ComputerUseSupervisor means the server validates the computer use action of the tool call's arguments, deciding by the first of its rules (attribute rules, a list of ComputerUseRule) that the action matches. ServicenowSupervisor means the server opens a change request in the configured ServiceNow instance (attributes as in ServiceNowSupervisorAttributes) and decides by its approval there, approving the tool call once the change request is approved and rejecting it once the change request is rejected or canceled.
+	Type SupervisorType `json:"type"`
+}
+
+// TimeoutFallback What's decided when a supervisor doesn't decide in time. escalate_to_next escalates to the next supervisor in the chain, and rejects when the supervisor was the last one.
+type TimeoutFallback string
+
+// TimerKind What a durable timer does when it fires. review_reminder takes the ReminderAction in its action
+// attribute, notify_assignee unless set, on an undecided review. decision_timeout decides a
+// request its supervisor didn't decide in time with the TimeoutFallback in its fallback attribute.
+type TimerKind string
+
+// Tool defines model for Tool.
+type Tool struct {
+	Attributes        map[string]interface{} `json:"attributes"`
+	Code              string                 `json:"code"`
+	Description       string                 `json:"description"`
+	Id                *openapi_types.UUID    `json:"id,omitempty"`
+	IgnoredAttributes *[]string              `json:"ignored_attributes,omitempty"`
+	Name              string                 `json:"name"`
+
+	// Parameters The JSON Schema of the tool's arguments, if it was registered with one
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+	RunId      openapi_types.UUID      `json:"run_id"`
+}
+
+// ToolCallDependencyEdge defines model for ToolCallDependencyEdge.
+type ToolCallDependencyEdge struct {
+	DependsOnToolCallId openapi_types.UUID `json:"depends_on_tool_call_id"`
+	ToolCallId          openapi_types.UUID `json:"tool_call_id"`
+}
+
+// ToolCallDependencyGraph defines model for ToolCallDependencyGraph.
+type ToolCallDependencyGraph struct {
+	Edges []ToolCallDependencyEdge `json:"edges"`
+	Nodes []ToolCallDependencyNode `json:"nodes"`
+
+	// ToolCallId The tool call the graph was requested for
+	ToolCallId openapi_types.UUID `json:"tool_call_id"`
+}
+
+// ToolCallDependencyNode defines model for ToolCallDependencyNode.
+type ToolCallDependencyNode struct {
+	Decision *Decision `json:"decision,omitempty"`
+	Name     string    `json:"name"`
+
+	// Status paused is only used for runs, while their organization's kill switch is active.
+	// awaiting_clarification is only used for supervision requests and tool calls whose reviewer
+	// asked the agent a question that hasn't been answered yet.
+	Status     Status             `json:"status"`
+	ToolCallId openapi_types.UUID `json:"tool_call_id"`
+}
+
+// ToolCallHistoryEntry defines model for ToolCallHistoryEntry.
+type ToolCallHistoryEntry struct {
+	// Actor Who caused the change, e.g. agent, system, api_key:<name> or session:<key>
+	Actor     string                  `json:"actor"`
+	ChainId   *openapi_types.UUID     `json:"chain_id,omitempty"`
+	CreatedAt time.Time               `json:"created_at"`
+	Decision  *Decision               `json:"decision,omitempty"`
+	Details   *map[string]interface{} `json:"details,omitempty"`
+
+	// Event What happened to the tool call. created is when the agent made the call, status_changed a supervision request changing status, assigned_to_session and handed_over a review being given to a reviewer session, decided a decision being stored and decision_lost a decision that arrived after another one, recovered a review put back in the queue after a restart and reminded a reminder sent about it
+	Event ToolCallHistoryEvent `json:"event"`
+
+	// Status paused is only used for runs, while their organization's kill switch is active.
+	// awaiting_clarification is only used for supervision requests and tool calls whose reviewer
+	// asked the agent a question that hasn't been answered yet.
+	Status               *Status             `json:"status,omitempty"`
+	SupervisionRequestId *openapi_types.UUID `json:"supervision_request_id,omitempty"`
+	SupervisorId         *openapi_types.UUID `json:"supervisor_id,omitempty"`
+}
+
+// ToolCallHistoryEvent What happened to the tool call. created is when the agent made the call, status_changed a supervision request changing status, assigned_to_session and handed_over a review being given to a reviewer session, decided a decision being stored and decision_lost a decision that arrived after another one, recovered a review put back in the queue after a restart and reminded a reminder sent about it
+type ToolCallHistoryEvent string
+
+// ToolCallIds defines model for ToolCallIds.
+type ToolCallIds struct {
+	ToolCallId *string `json:"tool_call_id,omitempty"`
+	ToolId     *string `json:"tool_id,omitempty"`
+}
+
+// ToolCallResult defines model for ToolCallResult.
+type ToolCallResult struct {
+	CreatedAt time.Time  `json:"created_at"`
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+
+	// DecidedBy The reviewer that decided a held result, unset until one does
+	DecidedBy *string `json:"decided_by,omitempty"`
+	Reason    *string `json:"reason,omitempty"`
+	Result    string  `json:"result"`
+
+	// Status The verdict on a tool call result. result_accepted and result_flagged results can be fed back
+	// into the conversation, result_quarantined results can't, and result_held results wait for a
+	// reviewer to decide one of the others.
+	Status ToolCallResultStatus `json:"status"`
+
+	// Supervisor The result supervisor that matched the result, unset if none did
+	Supervisor *string            `json:"supervisor,omitempty"`
+	ToolCallId openapi_types.UUID `json:"tool_call_id"`
+}
+
+// ToolCallResultReport defines model for ToolCallResultReport.
+type ToolCallResultReport struct {
+	// Result What the tool returned, as the agent would feed it back into the conversation
+	Result string `json:"result"`
+}
+
+// ToolCallResultStatus The verdict on a tool call result. result_accepted and result_flagged results can be fed back
+// into the conversation, result_quarantined results can't, and result_held results wait for a
+// reviewer to decide one of the others.
+type ToolCallResultStatus string
+
+// ToolCallResultVerdict defines model for ToolCallResultVerdict.
+type ToolCallResultVerdict struct {
+	Reason *string `json:"reason,omitempty"`
+
+	// Status The verdict on a tool call result. result_accepted and result_flagged results can be fed back
+	// into the conversation, result_quarantined results can't, and result_held results wait for a
+	// reviewer to decide one of the others.
+	Status ToolCallResultStatus `json:"status"`
+}
+
+// ToolCallScreenshot The screenshot a browser agent took before a tool call, with what changed since the previous
+// screenshot of its run and where the call clicks, if its arguments say
+type ToolCallScreenshot struct {
+	// Artifact A file an agent produced during a run, kept in the blob store
+	Artifact RunArtifact `json:"artifact"`
+
+	// ChangedFraction The share of the screenshot's pixels that changed
+	ChangedFraction *float64          `json:"changed_fraction,omitempty"`
+	ChangedRegion   *ScreenshotRegion `json:"changed_region,omitempty"`
+	ClickPoint      *ScreenshotPoint  `json:"click_point,omitempty"`
+
+	// DataUrl The screenshot as a data URL, only included for LLM supervisors
+	DataUrl *string `json:"data_url,omitempty"`
+	Height  *int    `json:"height,omitempty"`
+
+	// PreviousArtifactId The screenshot of the run's previous tool call, if it has one
+	PreviousArtifactId *openapi_types.UUID `json:"previous_artifact_id,omitempty"`
+
+	// Width Unset if the screenshot couldn't be decoded
+	Width *int `json:"width,omitempty"`
+}
+
+// ToolPolicy Supervisor chains that are created for a tool when a run of the project registers it
+type ToolPolicy struct {
+	Chains []ChainRequest `json:"chains"`
+
+	// Locked Only for organization policies. Projects can add chains to a locked policy or raise its risk tier, but not replace it.
+	Locked *bool `json:"locked,omitempty"`
+
+	// RiskTier How much damage a tool can do, which decides how heavily its calls are supervised
+	RiskTier RiskTier `json:"risk_tier"`
+
+	// ToolName Name of the tool, or * for every tool without its own policy
+	ToolName string `json:"tool_name"`
+}
+
+// TranscriptBargeIn A chat supervisor matching an agent's utterance, which the agent should stop saying
+type TranscriptBargeIn struct {
+	BargedInAt time.Time `json:"barged_in_at"`
+
+	// Match The text of the utterance the supervisor's pattern matched
+	Match  string  `json:"match"`
+	Reason *string `json:"reason,omitempty"`
+
+	// SegmentId The segment after which the utterance matched
+	SegmentId openapi_types.UUID `json:"segment_id"`
+
+	// Supervisor Name of the chat supervisor that matched
+	Supervisor  string `json:"supervisor"`
+	UtteranceId string `json:"utterance_id"`
+}
+
+// TranscriptSegment defines model for TranscriptSegment.
+type TranscriptSegment struct {
+	// BargeIn A chat supervisor matching an agent's utterance, which the agent should stop saying
+	BargeIn   *TranscriptBargeIn `json:"barge_in,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	OffsetMs  *int               `json:"offset_ms,omitempty"`
+	RunId     openapi_types.UUID `json:"run_id"`
+
+	// Speaker Who said a segment of a transcript. Only the agent's utterances are supervised.
+	Speaker     TranscriptSpeaker `json:"speaker"`
+	Text        string            `json:"text"`
+	UtteranceId string            `json:"utterance_id"`
+}
+
+// TranscriptSegmentRequest defines model for TranscriptSegmentRequest.
+type TranscriptSegmentRequest struct {
+	// OffsetMs When the segment was said, in milliseconds since the call started
+	OffsetMs *int `json:"offset_ms,omitempty"`
+
+	// Speaker Who said a segment of a transcript. Only the agent's utterances are supervised.
+	Speaker TranscriptSpeaker `json:"speaker"`
+
+	// Text What was said since the utterance's previous segment
+	Text string `json:"text"`
+
+	// UtteranceId Groups the segments of one utterance, in the order they're posted
+	UtteranceId string `json:"utterance_id"`
+}
+
+// TranscriptSegmentResult defines model for TranscriptSegmentResult.
+type TranscriptSegmentResult struct {
+	// BargeIn A chat supervisor matching an agent's utterance, which the agent should stop saying
+	BargeIn *TranscriptBargeIn `json:"barge_in,omitempty"`
+	Segment TranscriptSegment  `json:"segment"`
+}
+
+// TranscriptSpeaker Who said a segment of a transcript. Only the agent's utterances are supervised.
+type TranscriptSpeaker string
+
+// Transport How the API can be reached. http is the REST API, websocket and sse the review hub and its
+// event stream, and grpc the agent API, served when the server has a gRPC port.
+type Transport string
+
+// TruncationStrategy How to bring a request within its context window. fail rejects the request, drop_oldest drops the oldest non-system messages, middle_out keeps the first user message and drops from just after it, summarize replaces dropped messages with an LLM generated summary.
+type TruncationStrategy string
+
+// TrustPolicy Lets agent builds earn autonomy with a tool. Every approvals_to_relax approvals in a row of the build's calls to the tool raise its autonomy level with the tool by one, up to max_autonomy_level, and any rejection takes it back to the level of the run.
+type TrustPolicy struct {
+	ApprovalsToRelax int  `json:"approvals_to_relax"`
+	Enabled          bool `json:"enabled"`
+
+	// MaxAutonomyLevel How much of a run's supervision is left to automated supervisors, decided when its tool calls' chains are selected. 0 is fully manual, chains with a human supervisor are the only ones consulted when a tool has any. 1 leaves tools below medium risk to automated chains and 2 those below high risk. 3 is fully automatic, chains with a human supervisor are skipped. Tools without a risk tier are treated as needing humans below 3. Runs are supervised at the higher of their own level and the one their agent earned with the tool. Runs without either level are supervised by every chain, and chains of an active incident always apply.
+	MaxAutonomyLevel AutonomyLevel `json:"max_autonomy_level"`
+}
+
+// User A person who reviews tool calls. Their API keys and connections record what they decide.
+type User struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Email     string     `json:"email"`
+
+	// Id Set by the server
+	Id   *openapi_types.UUID `json:"id,omitempty"`
+	Name string              `json:"name"`
+}
+
+// VerdictBehavior What happens to a tool call given a custom verdict. block rejects it, continue approves it
+// and clarify leaves it undecided until the agent answers the reviewer's question.
+type VerdictBehavior string
+
+// WaitingSupervisionRequest defines model for WaitingSupervisionRequest.
+type WaitingSupervisionRequest struct {
+	AgeSeconds      int64   `json:"age_seconds"`
+	AssignedSession *string `json:"assigned_session,omitempty"`
+
+	// ClaimedBy The user who claimed the request, if one did
+	ClaimedBy      *openapi_types.UUID `json:"claimed_by,omitempty"`
+	NextReminderAt *time.Time          `json:"next_reminder_at,omitempty"`
+
+	// Status paused is only used for runs, while their organization's kill switch is active.
+	// awaiting_clarification is only used for supervision requests and tool calls whose reviewer
+	// asked the agent a question that hasn't been answered yet.
+	Status               Status             `json:"status"`
+	SupervisionRequestId openapi_types.UUID `json:"supervision_request_id"`
+	SupervisorId         openapi_types.UUID `json:"supervisor_id"`
+	WaitingSince         time.Time          `json:"waiting_since"`
+}
+
+// WatchEvent new_tool_call when the agent makes a tool call, tool_call_rejected when a supervisor rejects one and run_finished when the run's status is set to completed
+type WatchEvent string
+
+// WatchNotification defines model for WatchNotification.
+type WatchNotification struct {
+	CreatedAt time.Time `json:"created_at"`
+	Decision  *Decision `json:"decision,omitempty"`
+
+	// Event new_tool_call when the agent makes a tool call, tool_call_rejected when a supervisor rejects one and run_finished when the run's status is set to completed
+	Event WatchEvent         `json:"event"`
+	Id    openapi_types.UUID `json:"id"`
+	RunId openapi_types.UUID `json:"run_id"`
+
+	// Sequence Increases with every notification, for listing the ones after it
+	Sequence       int64               `json:"sequence"`
+	Session        string              `json:"session"`
+	SubscriptionId openapi_types.UUID  `json:"subscription_id"`
+	ToolCallId     *openapi_types.UUID `json:"tool_call_id,omitempty"`
+	ToolName       *string             `json:"tool_name,omitempty"`
+}
+
+// WatchSubscription defines model for WatchSubscription.
+type WatchSubscription struct {
+	AgentId   *openapi_types.UUID `json:"agent_id,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+	Events    []WatchEvent        `json:"events"`
+	Id        openapi_types.UUID  `json:"id"`
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+	RunId     *openapi_types.UUID `json:"run_id,omitempty"`
+	Session   string              `json:"session"`
+	ToolName  *string             `json:"tool_name,omitempty"`
+}
+
+// WatchSubscriptionRequest Watches one of a run, an agent or a tool. Tools are named, and need the project whose runs' calls of the tool are watched.
+type WatchSubscriptionRequest struct {
+	AgentId   *openapi_types.UUID `json:"agent_id,omitempty"`
+	Events    []WatchEvent        `json:"events"`
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+	RunId     *openapi_types.UUID `json:"run_id,omitempty"`
+	ToolName  *string             `json:"tool_name,omitempty"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Enabled   bool               `json:"enabled"`
+	Events    []WebhookEvent     `json:"events"`
+	Id        openapi_types.UUID `json:"id"`
+	ProjectId openapi_types.UUID `json:"project_id"`
+	Url       string             `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    int        `json:"attempts"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+
+	// Event supervision_requested when a supervisor is asked to review a tool call, decision_made when a supervisor decides one, chain_failed when that decision is a rejection or termination that stops the chain, and run_completed when a run's status is set to completed. barge_in when a chat supervisor matches what a voice agent is saying, which is attempted once as soon as it happens rather than retried, since a late barge-in would cut off whatever the agent says next. tool_call_result_held when a result supervisor holds a tool call's result for a reviewer to decide.
+	Event          WebhookEvent       `json:"event"`
+	Id             openapi_types.UUID `json:"id"`
+	LastError      *string            `json:"last_error,omitempty"`
+	LastStatusCode *int               `json:"last_status_code,omitempty"`
+
+	// NextAttemptAt When the delivery is attempted next, while it's queued
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+
+	// Payload The body POSTed to a webhook. Which of the optional fields are set depends on the event.
+	Payload WebhookPayload `json:"payload"`
+
+	// Status queued until the webhook responds with a 2xx status, then delivered. Deliveries that failed every attempt are abandoned.
+	Status    WebhookDeliveryStatus `json:"status"`
+	WebhookId openapi_types.UUID    `json:"webhook_id"`
+}
+
+// WebhookDeliveryStats defines model for WebhookDeliveryStats.
+type WebhookDeliveryStats struct {
+	Abandoned int `json:"abandoned"`
+	Delivered int `json:"delivered"`
+
+	// FailureRate The share of attempted deliveries that failed their last attempt
+	FailureRate float64            `json:"failure_rate"`
+	ProjectId   openapi_types.UUID `json:"project_id"`
+	Queued      int                `json:"queued"`
+
+	// Retrying Queued deliveries that already failed an attempt
+	Retrying  int                `json:"retrying"`
+	WebhookId openapi_types.UUID `json:"webhook_id"`
+}
+
+// WebhookDeliveryStatus queued until the webhook responds with a 2xx status, then delivered. Deliveries that failed every attempt are abandoned.
+type WebhookDeliveryStatus string
+
+// WebhookDiagnostics defines model for WebhookDiagnostics.
+type WebhookDiagnostics struct {
+	Abandoned int `json:"abandoned"`
+	Delivered int `json:"delivered"`
+
+	// FailureRate The share of attempted deliveries that failed their last attempt
+	FailureRate float64 `json:"failure_rate"`
+	Queued      int     `json:"queued"`
+
+	// Webhooks Each webhook with deliveries in the window, highest failure rate first
+	Webhooks []WebhookDeliveryStats `json:"webhooks"`
+
+	// WindowSeconds How far back deliveries are counted, by when they were created
+	WindowSeconds int `json:"window_seconds"`
+}
+
+// WebhookEvent supervision_requested when a supervisor is asked to review a tool call, decision_made when a supervisor decides one, chain_failed when that decision is a rejection or termination that stops the chain, and run_completed when a run's status is set to completed. barge_in when a chat supervisor matches what a voice agent is saying, which is attempted once as soon as it happens rather than retried, since a late barge-in would cut off whatever the agent says next. tool_call_result_held when a result supervisor holds a tool call's result for a reviewer to decide.
+type WebhookEvent string
+
+// WebhookPayload The body POSTed to a webhook. Which of the optional fields are set depends on the event.
+type WebhookPayload struct {
+	// BargeIn A chat supervisor matching an agent's utterance, which the agent should stop saying
+	BargeIn *TranscriptBargeIn `json:"barge_in,omitempty"`
+
+	// Event supervision_requested when a supervisor is asked to review a tool call, decision_made when a supervisor decides one, chain_failed when that decision is a rejection or termination that stops the chain, and run_completed when a run's status is set to completed. barge_in when a chat supervisor matches what a voice agent is saying, which is attempted once as soon as it happens rather than retried, since a late barge-in would cut off whatever the agent says next. tool_call_result_held when a result supervisor holds a tool call's result for a reviewer to decide.
+	Event WebhookEvent `json:"event"`
+
+	// Id The delivery's ID, the same for every attempt so receivers can deduplicate
+	Id         openapi_types.UUID  `json:"id"`
+	OccurredAt time.Time           `json:"occurred_at"`
+	ProjectId  openapi_types.UUID  `json:"project_id"`
+	Result     *SupervisionResult  `json:"result,omitempty"`
+	RunId      *openapi_types.UUID `json:"run_id,omitempty"`
+
+	// RunStatus paused is only used for runs, while their organization's kill switch is active.
+	// awaiting_clarification is only used for supervision requests and tool calls whose reviewer
+	// asked the agent a question that hasn't been answered yet.
+	RunStatus          *Status             `json:"run_status,omitempty"`
+	SupervisionRequest *SupervisionRequest `json:"supervision_request,omitempty"`
+	ToolCallId         *openapi_types.UUID `json:"tool_call_id,omitempty"`
+	ToolCallResult     *ToolCallResult     `json:"tool_call_result,omitempty"`
+}
+
+// WebhookRequest defines model for WebhookRequest.
+type WebhookRequest struct {
+	// Enabled Defaults to true. Disabled webhooks aren't sent new events.
+	Enabled *bool          `json:"enabled,omitempty"`
+	Events  []WebhookEvent `json:"events"`
+
+	// Url Absolute http or https URL the events are POSTed to
+	Url string `json:"url"`
+}
+
+// WorkerLease Which replica may run a background worker, until the lease expires unless it's renewed
+type WorkerLease struct {
+	AcquiredAt time.Time `json:"acquired_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+
+	// Holder Instance ID of the replica holding the lease
+	Holder    string    `json:"holder"`
+	RenewedAt time.Time `json:"renewed_at"`
+	Worker    string    `json:"worker"`
+}
+
+// WorkerReport defines model for WorkerReport.
+type WorkerReport struct {
+	// InstanceId Instance ID of the replica that answered, from INSTANCE_ID or its host name
+	InstanceId   string         `json:"instance_id"`
+	LeaseSeconds int            `json:"lease_seconds"`
+	Workers      []WorkerStatus `json:"workers"`
+}
+
+// WorkerStatus defines model for WorkerStatus.
+type WorkerStatus struct {
+	// LastError Why the lease couldn't last be acquired or renewed
+	LastError *string `json:"last_error,omitempty"`
+
+	// Lease Which replica may run a background worker, until the lease expires unless it's renewed
+	Lease *WorkerLease `json:"lease,omitempty"`
+
+	// Running Whether this replica runs the worker
+	Running bool `json:"running"`
+
+	// StartedAt When this replica last started the worker
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	Worker    string     `json:"worker"`
+}
+
+// CreateApiKeyJSONBody defines parameters for CreateApiKey.
+type CreateApiKeyJSONBody struct {
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Name      string     `json:"name"`
+
+	// ProjectId Limits the key to the resources of a project. Keys of a project can only create keys of the same project.
+	ProjectId *openapi_types.UUID `json:"project_id,omitempty"`
+	Scopes    []ApiKeyScope       `json:"scopes"`
+
+	// UserId The user the key belongs to, whose decisions it records as theirs
+	UserId *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
+// RotateApiKeyJSONBody defines parameters for RotateApiKey.
+type RotateApiKeyJSONBody struct {
+	// GraceSeconds How long the old key keeps working
+	GraceSeconds *int `json:"grace_seconds,omitempty"`
+}
+
+// RespondToConsentJSONBody defines parameters for RespondToConsent.
+type RespondToConsentJSONBody struct {
+	Comment *string `json:"comment,omitempty"`
+	Granted bool    `json:"granted"`
+}
+
+// SetFeatureFlagRolloutJSONBody defines parameters for SetFeatureFlagRollout.
+type SetFeatureFlagRolloutJSONBody struct {
+	Percentage int                  `json:"percentage"`
+	Targets    *[]FeatureFlagTarget `json:"targets,omitempty"`
+}
+
+// UpdateMessageContentJSONBody defines parameters for UpdateMessageContent.
+type UpdateMessageContentJSONBody struct {
+	Content string `json:"content"`
+}
+
+// GetMessageTranslationParams defines parameters for GetMessageTranslation.
+type GetMessageTranslationParams struct {
+	// TargetLanguage ISO 639-1 code of the language to translate into, defaults to en
+	TargetLanguage *string `form:"target_language,omitempty" json:"target_language,omitempty"`
+}
+
+// GetMeteringEventsParams defines parameters for GetMeteringEvents.
+type GetMeteringEventsParams struct {
+	// After Only return events recorded after the event with this sequence number
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+
+	// Limit Maximum number of events to return, defaults to and can't be more than 1000
+	Limit          *int                `form:"limit,omitempty" json:"limit,omitempty"`
+	OrganizationId *openapi_types.UUID `form:"organization_id,omitempty" json:"organization_id,omitempty"`
+	ProjectId      *openapi_types.UUID `form:"project_id,omitempty" json:"project_id,omitempty"`
+}
+
+// CreateOrganizationJSONBody defines parameters for CreateOrganization.
+type CreateOrganizationJSONBody struct {
+	Name string `json:"name"`
+}
+
+// RequestKillSwitchJSONBody defines parameters for RequestKillSwitch.
+type RequestKillSwitchJSONBody struct {
+	Action KillSwitchAction `json:"action"`
+	Reason *string          `json:"reason,omitempty"`
+}
+
+// SetOrganizationQuotasJSONBody defines parameters for SetOrganizationQuotas.
+type SetOrganizationQuotasJSONBody = []Quota
+
+// SetOrganizationToolPoliciesJSONBody defines parameters for SetOrganizationToolPolicies.
+type SetOrganizationToolPoliciesJSONBody = []ToolPolicy
+
+// CreateProjectJSONBody defines parameters for CreateProject.
+type CreateProjectJSONBody struct {
+	Name           string              `json:"name"`
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+	RunResultTags  []string            `json:"run_result_tags"`
+}
+
+// BootstrapProjectJSONBody defines parameters for BootstrapProject.
+type BootstrapProjectJSONBody struct {
+	Name           string              `json:"name"`
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+	RunResultTags  *[]string           `json:"run_result_tags,omitempty"`
+
+	// Template Name of the template to bootstrap from
+	Template string `json:"template"`
+}
+
+// ImportProjectConfigJSONBody defines parameters for ImportProjectConfig.
+type ImportProjectConfigJSONBody struct {
+	// Bundle A project's supervision configuration. Tools and their chains are registered by each run, so the bundle carries the tool policies that create those chains.
+	Bundle ProjectConfigBundle `json:"bundle"`
+
+	// Name Name of the new project, the bundle's project name if not given
+	Name           *string             `json:"name,omitempty"`
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+}
+
+// RegisterAgentJSONBody defines parameters for RegisterAgent.
+type RegisterAgentJSONBody struct {
+	Capabilities *[]string     `json:"capabilities,omitempty"`
+	Name         string        `json:"name"`
+	ToolPolicies *[]ToolPolicy `json:"tool_policies,omitempty"`
+	Tools        []string      `json:"tools"`
+	Version      string        `json:"version"`
+}
+
+// SetProjectAlertRulesJSONBody defines parameters for SetProjectAlertRules.
+type SetProjectAlertRulesJSONBody = []AlertRule
+
+// GetProjectAlertsParams defines parameters for GetProjectAlerts.
+type GetProjectAlertsParams struct {
+	// Limit Maximum number of alerts to return, 100 by default
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// SetProjectArgumentBaselinesJSONBody defines parameters for SetProjectArgumentBaselines.
+type SetProjectArgumentBaselinesJSONBody = []ArgumentBaseline
+
+// SetProjectArgumentRulesJSONBody defines parameters for SetProjectArgumentRules.
+type SetProjectArgumentRulesJSONBody = []ArgumentRule
+
+// SetProjectChatSupervisorsJSONBody defines parameters for SetProjectChatSupervisors.
+type SetProjectChatSupervisorsJSONBody = []ChatSupervisor
+
+// SetContextWindowPoliciesJSONBody defines parameters for SetContextWindowPolicies.
+type SetContextWindowPoliciesJSONBody = []ContextWindowPolicy
+
+// StartIncidentModeJSONBody defines parameters for StartIncidentMode.
+type StartIncidentModeJSONBody struct {
+	Reason *string `json:"reason,omitempty"`
+
+	// SupervisorId Human supervisor that reviews calls during the incident. One is created if not given.
+	SupervisorId *openapi_types.UUID `json:"supervisor_id,omitempty"`
+}
+
+// SetProjectIngestionHooksJSONBody defines parameters for SetProjectIngestionHooks.
+type SetProjectIngestionHooksJSONBody = []IngestionHook
+
+// SetProjectOrganizationJSONBody defines parameters for SetProjectOrganization.
+type SetProjectOrganizationJSONBody struct {
+	OrganizationId *openapi_types.UUID `json:"organization_id,omitempty"`
+}
+
+// SetProjectPayloadTemplatesJSONBody defines parameters for SetProjectPayloadTemplates.
+type SetProjectPayloadTemplatesJSONBody = []PayloadTemplate
+
+// SetProjectQuotasJSONBody defines parameters for SetProjectQuotas.
+type SetProjectQuotasJSONBody = []Quota
+
+// SetProjectRedactorsJSONBody defines parameters for SetProjectRedactors.
+type SetProjectRedactorsJSONBody = []Redactor
+
+// GetProjectResourceReferencesParams defines parameters for GetProjectResourceReferences.
+type GetProjectResourceReferencesParams struct {
+	Identifier string `form:"identifier" json:"identifier"`
+
+	// Match Defaults to exact
+	Match *ResourceMatch `form:"match,omitempty" json:"match,omitempty"`
+	Kind  *ResourceKind  `form:"kind,omitempty" json:"kind,omitempty"`
+}
+
+// SetProjectResultSupervisorsJSONBody defines parameters for SetProjectResultSupervisors.
+type SetProjectResultSupervisorsJSONBody = []ResultSupervisor
+
+// SetProjectRoutingRulesJSONBody defines parameters for SetProjectRoutingRules.
+type SetProjectRoutingRulesJSONBody = []RoutingRule
+
+// ExportProjectRunsParams defines parameters for ExportProjectRuns.
+type ExportProjectRunsParams struct {
+	// ResumeToken The resume_token of the last run received from an earlier export of the project
+	ResumeToken *string `form:"resume_token,omitempty" json:"resume_token,omitempty"`
+}
+
+// CreateTaskJSONBody defines parameters for CreateTask.
+type CreateTaskJSONBody struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+}
+
+// GetProjectToolCallResultsParams defines parameters for GetProjectToolCallResults.
+type GetProjectToolCallResultsParams struct {
+	Status *ToolCallResultStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// SetProjectToolPoliciesJSONBody defines parameters for SetProjectToolPolicies.
+type SetProjectToolPoliciesJSONBody = []ToolPolicy
+
+// GetProjectUsageParams defines parameters for GetProjectUsage.
+type GetProjectUsageParams struct {
+	// Window How far back to count chats, as a number of hours (24h) or days (7d). 30d by default.
+	Window *string `form:"window,omitempty" json:"window,omitempty"`
+}
+
+// SetProjectVerdictsJSONBody defines parameters for SetProjectVerdicts.
+type SetProjectVerdictsJSONBody = []CustomVerdict
+
+// CreateHandoffBundleJSONBody defines parameters for CreateHandoffBundle.
+type CreateHandoffBundleJSONBody struct {
+	Name string `json:"name"`
+}
+
+// RestoreHandoffBundleJSONBody defines parameters for RestoreHandoffBundle.
+type RestoreHandoffBundleJSONBody struct {
+	// Session Session key of the reviewer taking over
+	Session string `json:"session"`
+}
+
+// GetWatchNotificationsParams defines parameters for GetWatchNotifications.
+type GetWatchNotificationsParams struct {
+	// After The sequence of the last notification already seen
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+
+	// Limit At most 100, and 100 by default
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// UploadRunArtifactParams defines parameters for UploadRunArtifact.
+type UploadRunArtifactParams struct {
+	// Name The artifact's file name
+	Name string `form:"name" json:"name"`
+
+	// ToolCallId The tool call of the run that produced the artifact
+	ToolCallId *openapi_types.UUID `form:"tool_call_id,omitempty" json:"tool_call_id,omitempty"`
+}
+
+// AttachRunDocumentJSONBody defines parameters for AttachRunDocument.
+type AttachRunDocumentJSONBody struct {
+	Content string `json:"content"`
+
+	// ContentType Defaults to text/plain
+	ContentType *string `json:"content_type,omitempty"`
+
+	// IncludeInSupervisorContext Also give the document to LLM supervisors, defaults to false
+	IncludeInSupervisorContext *bool  `json:"include_in_supervisor_context,omitempty"`
+	Name                       string `json:"name"`
+}
+
+// ExportRunParams defines parameters for ExportRun.
+type ExportRunParams struct {
+	Format *RunArchiveFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// PauseRunJSONBody defines parameters for PauseRun.
+type PauseRunJSONBody struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// CreateProxyChatCompletionJSONBody defines parameters for CreateProxyChatCompletion.
+type CreateProxyChatCompletionJSONBody = map[string]interface{}
+
+// UpdateRunResultJSONBody defines parameters for UpdateRunResult.
+type UpdateRunResultJSONBody struct {
+	Result *string `json:"result,omitempty"`
+}
+
+// CreateRunToolJSONBody defines parameters for CreateRunTool.
+type CreateRunToolJSONBody struct {
+	Attributes        map[string]interface{} `json:"attributes"`
+	Code              string                 `json:"code"`
+	Description       string                 `json:"description"`
+	IgnoredAttributes *[]string              `json:"ignored_attributes,omitempty"`
+	Name              string                 `json:"name"`
+
+	// Parameters The JSON Schema of the tool's arguments, like the parameters an LLM is given for it. Reviewers are shown its calls' arguments as fields described by it.
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// CompareRunsParams defines parameters for CompareRuns.
+type CompareRunsParams struct {
+	// RunId The runs to compare, at least two
+	RunId []openapi_types.UUID `form:"run_id" json:"run_id"`
+}
+
+// SearchParams defines parameters for Search.
+type SearchParams struct {
+	Q string `form:"q" json:"q"`
+
+	// Project Only search the runs of this project
+	Project *openapi_types.UUID `form:"project,omitempty" json:"project,omitempty"`
+
+	// Kind Only return hits of this kind
+	Kind *SearchHitKind `form:"kind,omitempty" json:"kind,omitempty"`
+
+	// Limit Maximum number of hits to return, 50 by default and at most 200
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetSupervisionReviewPayloadParams defines parameters for GetSupervisionReviewPayload.
+type GetSupervisionReviewPayloadParams struct {
+	// ForSupervisor Only include the documents meant for LLM supervisors, for building their context
+	ForSupervisor *bool `form:"for_supervisor,omitempty" json:"for_supervisor,omitempty"`
+
+	// ScreenshotDiff Compare the tool call's screenshot with the one of the run's previous tool call
+	ScreenshotDiff *bool `form:"screenshot_diff,omitempty" json:"screenshot_diff,omitempty"`
+}
+
+// SetSupervisorTestCasesJSONBody defines parameters for SetSupervisorTestCases.
+type SetSupervisorTestCasesJSONBody = []SupervisorTestCase
+
+// CreateRunJSONBody defines parameters for CreateRun.
+type CreateRunJSONBody struct {
+	// AgentId Agent build making the run, which must belong to the task's project
+	AgentId *openapi_types.UUID `json:"agent_id,omitempty"`
+
+	// AutonomyLevel How much of a run's supervision is left to automated supervisors, decided when its tool calls' chains are selected. 0 is fully manual, chains with a human supervisor are the only ones consulted when a tool has any. 1 leaves tools below medium risk to automated chains and 2 those below high risk. 3 is fully automatic, chains with a human supervisor are skipped. Tools without a risk tier are treated as needing humans below 3. Runs are supervised at the higher of their own level and the one their agent earned with the tool. Runs without either level are supervised by every chain, and chains of an active incident always apply.
+	AutonomyLevel *AutonomyLevel `json:"autonomy_level,omitempty"`
+
+	// Priority The priority class of a run, chosen when it's created. The supervision requests of interactive
+	// runs are processed ahead of those of batch runs, and batch runs only get a share of the capacity
+	// for asking LLM and ensemble supervisors, so backfills can't hold up production traffic.
+	// Defaults to interactive. realtime is for approvals a person is waiting on in a chat, which are
+	// processed ahead of both. Their human reviews go to reviewers as soon as they're created rather
+	// than on the processor's next pass, through a delivery of the hub's own, and their queries use
+	// the database connections reserved by REALTIME_DB_CONNECTIONS.
+	Priority *RunPriority `json:"priority,omitempty"`
+}
+
+// CreateToolSupervisorChainsJSONBody defines parameters for CreateToolSupervisorChains.
+type CreateToolSupervisorChainsJSONBody = []ChainRequest
+
+// SetToolCallDependenciesJSONBody defines parameters for SetToolCallDependencies.
+type SetToolCallDependenciesJSONBody struct {
+	DependsOn []openapi_types.UUID `json:"depends_on"`
+}
+
+// CreateApiKeyJSONRequestBody defines body for CreateApiKey for application/json ContentType.
+type CreateApiKeyJSONRequestBody CreateApiKeyJSONBody
+
+// RotateApiKeyJSONRequestBody defines body for RotateApiKey for application/json ContentType.
+type RotateApiKeyJSONRequestBody RotateApiKeyJSONBody
+
+// NegotiateCapabilitiesJSONRequestBody defines body for NegotiateCapabilities for application/json ContentType.
+type NegotiateCapabilitiesJSONRequestBody = ClientCapabilities
+
+// AnswerClarificationJSONRequestBody defines body for AnswerClarification for application/json ContentType.
+type AnswerClarificationJSONRequestBody = ClarificationAnswer
+
+// RespondToConsentJSONRequestBody defines body for RespondToConsent for application/json ContentType.
+type RespondToConsentJSONRequestBody RespondToConsentJSONBody
+
+// SetFeatureFlagRolloutJSONRequestBody defines body for SetFeatureFlagRollout for application/json ContentType.
+type SetFeatureFlagRolloutJSONRequestBody SetFeatureFlagRolloutJSONBody
+
+// UpdateMessageContentJSONRequestBody defines body for UpdateMessageContent for application/json ContentType.
+type UpdateMessageContentJSONRequestBody UpdateMessageContentJSONBody
+
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody CreateOrganizationJSONBody
+
+// RequestKillSwitchJSONRequestBody defines body for RequestKillSwitch for application/json ContentType.
+type RequestKillSwitchJSONRequestBody RequestKillSwitchJSONBody
+
+// SetOrganizationQuotasJSONRequestBody defines body for SetOrganizationQuotas for application/json ContentType.
+type SetOrganizationQuotasJSONRequestBody = SetOrganizationQuotasJSONBody
+
+// SetOrganizationToolPoliciesJSONRequestBody defines body for SetOrganizationToolPolicies for application/json ContentType.
+type SetOrganizationToolPoliciesJSONRequestBody = SetOrganizationToolPoliciesJSONBody
+
+// DecidePlanJSONRequestBody defines body for DecidePlan for application/json ContentType.
+type DecidePlanJSONRequestBody = PlanDecision
+
+// CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
+type CreateProjectJSONRequestBody CreateProjectJSONBody
+
+// BootstrapProjectJSONRequestBody defines body for BootstrapProject for application/json ContentType.
+type BootstrapProjectJSONRequestBody BootstrapProjectJSONBody
+
+// ImportProjectConfigJSONRequestBody defines body for ImportProjectConfig for application/json ContentType.
+type ImportProjectConfigJSONRequestBody ImportProjectConfigJSONBody
+
+// RegisterAgentJSONRequestBody defines body for RegisterAgent for application/json ContentType.
+type RegisterAgentJSONRequestBody RegisterAgentJSONBody
+
+// SetProjectAlertRulesJSONRequestBody defines body for SetProjectAlertRules for application/json ContentType.
+type SetProjectAlertRulesJSONRequestBody = SetProjectAlertRulesJSONBody
+
+// SetProjectArgumentBaselinesJSONRequestBody defines body for SetProjectArgumentBaselines for application/json ContentType.
+type SetProjectArgumentBaselinesJSONRequestBody = SetProjectArgumentBaselinesJSONBody
+
+// SetProjectArgumentRulesJSONRequestBody defines body for SetProjectArgumentRules for application/json ContentType.
+type SetProjectArgumentRulesJSONRequestBody = SetProjectArgumentRulesJSONBody
+
+// CreateBackfillJSONRequestBody defines body for CreateBackfill for application/json ContentType.
+type CreateBackfillJSONRequestBody = BackfillRequest
+
+// SetProjectChatSupervisorsJSONRequestBody defines body for SetProjectChatSupervisors for application/json ContentType.
+type SetProjectChatSupervisorsJSONRequestBody = SetProjectChatSupervisorsJSONBody
+
+// SetContextWindowPoliciesJSONRequestBody defines body for SetContextWindowPolicies for application/json ContentType.
+type SetContextWindowPoliciesJSONRequestBody = SetContextWindowPoliciesJSONBody
+
+// StartIncidentModeJSONRequestBody defines body for StartIncidentMode for application/json ContentType.
+type StartIncidentModeJSONRequestBody StartIncidentModeJSONBody
+
+// SetProjectIngestionHooksJSONRequestBody defines body for SetProjectIngestionHooks for application/json ContentType.
+type SetProjectIngestionHooksJSONRequestBody = SetProjectIngestionHooksJSONBody
+
+// SetProjectNotificationSettingsJSONRequestBody defines body for SetProjectNotificationSettings for application/json ContentType.
+type SetProjectNotificationSettingsJSONRequestBody = NotificationSettings
+
+// SetProjectOrganizationJSONRequestBody defines body for SetProjectOrganization for application/json ContentType.
+type SetProjectOrganizationJSONRequestBody SetProjectOrganizationJSONBody
+
+// SetProjectPayloadTemplatesJSONRequestBody defines body for SetProjectPayloadTemplates for application/json ContentType.
+type SetProjectPayloadTemplatesJSONRequestBody = SetProjectPayloadTemplatesJSONBody
+
+// PreviewPayloadTemplateJSONRequestBody defines body for PreviewPayloadTemplate for application/json ContentType.
+type PreviewPayloadTemplateJSONRequestBody = PayloadTemplate
+
+// SetProjectQuotasJSONRequestBody defines body for SetProjectQuotas for application/json ContentType.
+type SetProjectQuotasJSONRequestBody = SetProjectQuotasJSONBody
+
+// SetProjectRedactorsJSONRequestBody defines body for SetProjectRedactors for application/json ContentType.
+type SetProjectRedactorsJSONRequestBody = SetProjectRedactorsJSONBody
+
+// SetProjectResultSupervisorsJSONRequestBody defines body for SetProjectResultSupervisors for application/json ContentType.
+type SetProjectResultSupervisorsJSONRequestBody = SetProjectResultSupervisorsJSONBody
+
+// SetProjectRoutingRulesJSONRequestBody defines body for SetProjectRoutingRules for application/json ContentType.
+type SetProjectRoutingRulesJSONRequestBody = SetProjectRoutingRulesJSONBody
+
+// SetProjectRunLimitsJSONRequestBody defines body for SetProjectRunLimits for application/json ContentType.
+type SetProjectRunLimitsJSONRequestBody = RunLimits
+
+// CreateSupervisorJSONRequestBody defines body for CreateSupervisor for application/json ContentType.
+type CreateSupervisorJSONRequestBody = Supervisor
+
+// DryRunSupervisorJSONRequestBody defines body for DryRunSupervisor for application/json ContentType.
+type DryRunSupervisorJSONRequestBody = DryRunRequest
+
+// CreateTaskJSONRequestBody defines body for CreateTask for application/json ContentType.
+type CreateTaskJSONRequestBody CreateTaskJSONBody
+
+// SetProjectToolPoliciesJSONRequestBody defines body for SetProjectToolPolicies for application/json ContentType.
+type SetProjectToolPoliciesJSONRequestBody = SetProjectToolPoliciesJSONBody
+
+// SetProjectTrustPolicyJSONRequestBody defines body for SetProjectTrustPolicy for application/json ContentType.
+type SetProjectTrustPolicyJSONRequestBody = TrustPolicy
+
+// SetProjectVerdictsJSONRequestBody defines body for SetProjectVerdicts for application/json ContentType.
+type SetProjectVerdictsJSONRequestBody = SetProjectVerdictsJSONBody
+
+// CreateProjectWebhookJSONRequestBody defines body for CreateProjectWebhook for application/json ContentType.
+type CreateProjectWebhookJSONRequestBody = WebhookRequest
+
+// CreateHandoffBundleJSONRequestBody defines body for CreateHandoffBundle for application/json ContentType.
+type CreateHandoffBundleJSONRequestBody CreateHandoffBundleJSONBody
+
+// RestoreHandoffBundleJSONRequestBody defines body for RestoreHandoffBundle for application/json ContentType.
+type RestoreHandoffBundleJSONRequestBody RestoreHandoffBundleJSONBody
+
+// SetReviewerJSONRequestBody defines body for SetReviewer for application/json ContentType.
+type SetReviewerJSONRequestBody = Reviewer
+
+// CreateWatchSubscriptionJSONRequestBody defines body for CreateWatchSubscription for application/json ContentType.
+type CreateWatchSubscriptionJSONRequestBody = WatchSubscriptionRequest
+
+// UpdateRunAutonomyJSONRequestBody defines body for UpdateRunAutonomy for application/json ContentType.
+type UpdateRunAutonomyJSONRequestBody = AutonomyLevel
+
+// CreateChatStreamJSONRequestBody defines body for CreateChatStream for application/json ContentType.
+type CreateChatStreamJSONRequestBody = ChatStreamRequest
+
+// AttachRunDocumentJSONRequestBody defines body for AttachRunDocument for application/json ContentType.
+type AttachRunDocumentJSONRequestBody AttachRunDocumentJSONBody
+
+// CreateRunEventJSONRequestBody defines body for CreateRunEvent for application/json ContentType.
+type CreateRunEventJSONRequestBody = RunEventRequest
+
+// ImportRunOpenApiToolsJSONRequestBody defines body for ImportRunOpenApiTools for application/json ContentType.
+type ImportRunOpenApiToolsJSONRequestBody = OpenApiToolImport
+
+// PauseRunJSONRequestBody defines body for PauseRun for application/json ContentType.
+type PauseRunJSONRequestBody PauseRunJSONBody
+
+// CreateRunPlanJSONRequestBody defines body for CreateRunPlan for application/json ContentType.
+type CreateRunPlanJSONRequestBody = PlanRequest
+
+// PreapproveToolCallJSONRequestBody defines body for PreapproveToolCall for application/json ContentType.
+type PreapproveToolCallJSONRequestBody = PreapprovalRequest
+
+// CreateProxyChatCompletionJSONRequestBody defines body for CreateProxyChatCompletion for application/json ContentType.
+type CreateProxyChatCompletionJSONRequestBody = CreateProxyChatCompletionJSONBody
+
+// UpdateRunResultJSONRequestBody defines body for UpdateRunResult for application/json ContentType.
+type UpdateRunResultJSONRequestBody UpdateRunResultJSONBody
+
+// UpdateRunStatusJSONRequestBody defines body for UpdateRunStatus for application/json ContentType.
+type UpdateRunStatusJSONRequestBody = Status
+
+// CreateRunToolJSONRequestBody defines body for CreateRunTool for application/json ContentType.
+type CreateRunToolJSONRequestBody CreateRunToolJSONBody
+
+// CreateTranscriptSegmentJSONRequestBody defines body for CreateTranscriptSegment for application/json ContentType.
+type CreateTranscriptSegmentJSONRequestBody = TranscriptSegmentRequest
+
+// CreateNewChatJSONRequestBody defines body for CreateNewChat for application/json ContentType.
+type CreateNewChatJSONRequestBody = AsteroidChat
+
+// DraftReviewFeedbackJSONRequestBody defines body for DraftReviewFeedback for application/json ContentType.
+type DraftReviewFeedbackJSONRequestBody = FeedbackDraftRequest
+
+// CreateSupervisionRequestReminderJSONRequestBody defines body for CreateSupervisionRequestReminder for application/json ContentType.
+type CreateSupervisionRequestReminderJSONRequestBody = ReminderRequest
+
+// CreateSupervisionResultJSONRequestBody defines body for CreateSupervisionResult for application/json ContentType.
+type CreateSupervisionResultJSONRequestBody = SupervisionResult
+
+// SetSupervisorTestCasesJSONRequestBody defines body for SetSupervisorTestCases for application/json ContentType.
+type SetSupervisorTestCasesJSONRequestBody = SetSupervisorTestCasesJSONBody
+
+// CreateRunJSONRequestBody defines body for CreateRun for application/json ContentType.
+type CreateRunJSONRequestBody CreateRunJSONBody
+
+// ImportRunJSONRequestBody defines body for ImportRun for application/json ContentType.
+type ImportRunJSONRequestBody = RunArchive
+
+// ReorderChainSupervisorsJSONRequestBody defines body for ReorderChainSupervisors for application/json ContentType.
+type ReorderChainSupervisorsJSONRequestBody = ChainOrder
+
+// InsertChainSupervisorJSONRequestBody defines body for InsertChainSupervisor for application/json ContentType.
+type InsertChainSupervisorJSONRequestBody = ChainSupervisorInsertion
+
+// CreateToolSupervisorChainsJSONRequestBody defines body for CreateToolSupervisorChains for application/json ContentType.
+type CreateToolSupervisorChainsJSONRequestBody = CreateToolSupervisorChainsJSONBody
+
+// BatchDecideToolCallsJSONRequestBody defines body for BatchDecideToolCalls for application/json ContentType.
+type BatchDecideToolCallsJSONRequestBody = BatchDecisionRequest
+
+// CreateSupervisionRequestJSONRequestBody defines body for CreateSupervisionRequest for application/json ContentType.
+type CreateSupervisionRequestJSONRequestBody = SupervisionRequest
+
+// SetToolCallDependenciesJSONRequestBody defines body for SetToolCallDependencies for application/json ContentType.
+type SetToolCallDependenciesJSONRequestBody SetToolCallDependenciesJSONBody
+
+// ReportToolCallResultJSONRequestBody defines body for ReportToolCallResult for application/json ContentType.
+type ReportToolCallResultJSONRequestBody = ToolCallResultReport
+
+// DecideToolCallResultJSONRequestBody defines body for DecideToolCallResult for application/json ContentType.
+type DecideToolCallResultJSONRequestBody = ToolCallResultVerdict
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody = User
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = WebhookRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the health of the server in one report, for debugging a deployment
+	// (GET /admin/diagnostics)
+	GetDiagnostics(w http.ResponseWriter, r *http.Request)
+	// Get an agent build
+	// (GET /agent/{agentId})
+	GetAgent(w http.ResponseWriter, r *http.Request, agentId openapi_types.UUID)
+	// Get how much an agent build is trusted with each tool it was supervised on
+	// (GET /agent/{agentId}/trust)
+	GetAgentTrust(w http.ResponseWriter, r *http.Request, agentId openapi_types.UUID)
+	// Get all API keys, without their secrets
+	// (GET /api_key)
+	GetApiKeys(w http.ResponseWriter, r *http.Request)
+	// Create an API key. The secret is only ever returned in this response.
+	// (POST /api_key)
+	CreateApiKey(w http.ResponseWriter, r *http.Request)
+	// Revoke an API key
+	// (DELETE /api_key/{apiKeyId})
+	RevokeApiKey(w http.ResponseWriter, r *http.Request, apiKeyId openapi_types.UUID)
+	// Rotate an API key, creating a key with the same name, scopes and project. The old key keeps working for the grace period, so agents can switch over without downtime.
+	// (POST /api_key/{apiKeyId}/rotate)
+	RotateApiKey(w http.ResponseWriter, r *http.Request, apiKeyId openapi_types.UUID)
+	// Get the daily compliance archives that were exported, oldest first
+	// (GET /archives)
+	GetArchives(w http.ResponseWriter, r *http.Request)
+	// Export the archive of a day now
+	// (POST /archives/{day})
+	ExportArchive(w http.ResponseWriter, r *http.Request, day string)
+	// Verify the archive of a day is complete and unchanged
+	// (GET /archives/{day}/verify)
+	VerifyArchive(w http.ResponseWriter, r *http.Request, day string)
+	// Get an artifact, without its content
+	// (GET /artifact/{artifactId})
+	GetRunArtifact(w http.ResponseWriter, r *http.Request, artifactId openapi_types.UUID)
+	// Download the content of an artifact, with the Content-Type it was uploaded with
+	// (GET /artifact/{artifactId}/content)
+	DownloadRunArtifact(w http.ResponseWriter, r *http.Request, artifactId openapi_types.UUID)
+	// Get the hashes of the audit log that were anchored with the external service, oldest first
+	// (GET /audit_log/anchors)
+	GetAuditAnchors(w http.ResponseWriter, r *http.Request)
+	// Anchor the current head of the audit log now
+	// (POST /audit_log/anchors)
+	AnchorAuditLog(w http.ResponseWriter, r *http.Request)
+	// Verify the audit log's hash chain and its anchors
+	// (GET /audit_log/verify)
+	VerifyAuditLog(w http.ResponseWriter, r *http.Request)
+	// Get a backfill and its progress
+	// (GET /backfill/{backfillId})
+	GetBackfill(w http.ResponseWriter, r *http.Request, backfillId openapi_types.UUID)
+	// Pause a running backfill
+	// (POST /backfill/{backfillId}/pause)
+	PauseBackfill(w http.ResponseWriter, r *http.Request, backfillId openapi_types.UUID)
+	// Get how a backfill's shadow chains would have decided its tool calls next to what actually happened. The report covers the calls evaluated so far, and is final once the backfill completed.
+	// (GET /backfill/{backfillId}/report)
+	GetBackfillReport(w http.ResponseWriter, r *http.Request, backfillId openapi_types.UUID)
+	// Resume a paused backfill where it left off
+	// (POST /backfill/{backfillId}/resume)
+	ResumeBackfill(w http.ResponseWriter, r *http.Request, backfillId openapi_types.UUID)
+	// Get what the server supports
+	// (GET /capabilities)
+	GetCapabilities(w http.ResponseWriter, r *http.Request)
+	// Check an SDK's capabilities against the server's
+	// (POST /capabilities)
+	NegotiateCapabilities(w http.ResponseWriter, r *http.Request)
+	// Get the choices assembled so far by a chat stream
+	// (GET /chat_stream/{streamId})
+	GetChatStream(w http.ResponseWriter, r *http.Request, streamId openapi_types.UUID)
+	// Post server-sent events of a chat completion to its stream
+	// (POST /chat_stream/{streamId}/chunks)
+	AppendChatStreamChunks(w http.ResponseWriter, r *http.Request, streamId openapi_types.UUID)
+	// Store the chat assembled by a stream
+	// (POST /chat_stream/{streamId}/complete)
+	CompleteChatStream(w http.ResponseWriter, r *http.Request, streamId openapi_types.UUID)
+	// Get the circuit breakers of the automated supervisors asked since the server started
+	// (GET /circuit_breakers)
+	GetCircuitBreakers(w http.ResponseWriter, r *http.Request)
+	// Answer a reviewer's question
+	// (POST /clarification/{clarificationId}/answer)
+	AnswerClarification(w http.ResponseWriter, r *http.Request, clarificationId openapi_types.UUID)
+	// Reload the server's configuration
+	// (POST /config/reload)
+	ReloadConfig(w http.ResponseWriter, r *http.Request)
+	// Get what an end user is asked to consent to. Needs no API key, the token is the credential.
+	// (GET /consent/{token})
+	GetConsentPrompt(w http.ResponseWriter, r *http.Request, token string)
+	// Record the end user's response. Needs no API key, the token is the credential.
+	// (POST /consent/{token})
+	RespondToConsent(w http.ResponseWriter, r *http.Request, token string)
+	// Get a document attached to a run, with its content
+	// (GET /document/{documentId})
+	GetRunDocument(w http.ResponseWriter, r *http.Request, documentId openapi_types.UUID)
+	// Get the server's feature flags and how far each is rolled out
+	// (GET /feature_flags)
+	GetFeatureFlags(w http.ResponseWriter, r *http.Request)
+	// Remove a feature flag's rollout, so it's back to its default everywhere
+	// (DELETE /feature_flags/{flag})
+	DeleteFeatureFlagRollout(w http.ResponseWriter, r *http.Request, flag string)
+	// Roll a feature flag out
+	// (PUT /feature_flags/{flag})
+	SetFeatureFlagRollout(w http.ResponseWriter, r *http.Request, flag string)
+	// Modify the content of a stored message, recording a word level diff of the change
+	// (PUT /message/{messageId}/content)
+	UpdateMessageContent(w http.ResponseWriter, r *http.Request, messageId openapi_types.UUID)
+	// Get the diffs of every modification made to a message, oldest first
+	// (GET /message/{messageId}/diffs)
+	GetMessageDiffs(w http.ResponseWriter, r *http.Request, messageId openapi_types.UUID)
+	// Get a machine translation of a stored message
+	// (GET /message/{messageId}/translation)
+	GetMessageTranslation(w http.ResponseWriter, r *http.Request, messageId openapi_types.UUID, params GetMessageTranslationParams)
+	// Get the reviewer-facing text of a locale, like decision labels and review event messages
+	// (GET /messages/{locale})
+	GetLocalizedMessages(w http.ResponseWriter, r *http.Request, locale string)
+	// Export metering events in the order they were recorded
+	// (GET /metering_events)
+	GetMeteringEvents(w http.ResponseWriter, r *http.Request, params GetMeteringEventsParams)
+	// Get the OpenAPI schema
+	// (GET /openapi.yaml)
+	GetOpenAPI(w http.ResponseWriter, r *http.Request)
+	// Get all organizations
+	// (GET /organization)
+	GetOrganizations(w http.ResponseWriter, r *http.Request)
+	// Create a new organization
+	// (POST /organization)
+	CreateOrganization(w http.ResponseWriter, r *http.Request)
+	// Get an organization
+	// (GET /organization/{organizationId})
+	GetOrganization(w http.ResponseWriter, r *http.Request, organizationId openapi_types.UUID)
+	// Get the state of an organization's kill switch, including any request awaiting confirmation
+	// (GET /organization/{organizationId}/kill_switch)
+	GetKillSwitch(w http.ResponseWriter, r *http.Request, organizationId openapi_types.UUID)
+	// Ask to activate or deactivate an organization's kill switch. Nothing changes until a second API key confirms the request. While active, runs are paused, approvals are held and new runs, tools, chats and supervision requests are refused with 423.
+	// (POST /organization/{organizationId}/kill_switch/request)
+	RequestKillSwitch(w http.ResponseWriter, r *http.Request, organizationId openapi_types.UUID)
+	// Confirm a kill switch request. Must be made with a different API key to the one that made the request.
+	// (POST /organization/{organizationId}/kill_switch/request/{killSwitchRequestId}/confirm)
+	ConfirmKillSwitch(w http.ResponseWriter, r *http.Request, organizationId openapi_types.UUID, killSwitchRequestId openapi_types.UUID)
+	// Get the quotas of an organization, which limit all its projects together
+	// (GET /organization/{organizationId}/quotas)
+	GetOrganizationQuotas(w http.ResponseWriter, r *http.Request, organizationId openapi_types.UUID)
+	// Replace the quotas of an organization, which limit all its projects together
+	// (PUT /organization/{organizationId}/quotas)
+	SetOrganizationQuotas(w http.ResponseWriter, r *http.Request, organizationId openapi_types.UUID)
+	// Get the default tool policies every project of the organization inherits
+	// (GET /organization/{organizationId}/tool_policies)
+	GetOrganizationToolPolicies(w http.ResponseWriter, r *http.Request, organizationId openapi_types.UUID)
+	// Replace the default tool policies of an organization
+	// (PUT /organization/{organizationId}/tool_policies)
+	SetOrganizationToolPolicies(w http.ResponseWriter, r *http.Request, organizationId openapi_types.UUID)
+	// Get a plan with its steps and the tool calls they covered
+	// (GET /plan/{planId})
+	GetPlan(w http.ResponseWriter, r *http.Request, planId openapi_types.UUID)
+	// Approve or reject a plan as a whole or step by step
+	// (POST /plan/{planId}/decision)
+	DecidePlan(w http.ResponseWriter, r *http.Request, planId openapi_types.UUID)
+	// Get the tool calls of a plan's run that deviated from it, oldest first
+	// (GET /plan/{planId}/deviations)
+	GetPlanDeviations(w http.ResponseWriter, r *http.Request, planId openapi_types.UUID)
+	// Get all projects
+	// (GET /project)
+	GetProjects(w http.ResponseWriter, r *http.Request)
+	// Create a new project
+	// (POST /project)
+	CreateProject(w http.ResponseWriter, r *http.Request)
+	// Create a project with the supervisors, tool policies and notification settings of a template
+	// (POST /project/bootstrap)
+	BootstrapProject(w http.ResponseWriter, r *http.Request)
+	// Create a project from a configuration bundle exported by this or another instance
+	// (POST /project/import)
+	ImportProjectConfig(w http.ResponseWriter, r *http.Request)
+	// Get the templates projects can be bootstrapped from
+	// (GET /project/templates)
+	GetProjectTemplates(w http.ResponseWriter, r *http.Request)
+	// Get a project
+	// (GET /project/{projectId})
+	GetProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the agent builds registered for a project
+	// (GET /project/{projectId}/agents)
+	GetProjectAgents(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Register a build of an agent with the capabilities and tools it declares
+	// (POST /project/{projectId}/agents)
+	RegisterAgent(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the rules that alert on changes in a project's behavior
+	// (GET /project/{projectId}/alert_rules)
+	GetProjectAlertRules(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the alert rules of a project
+	// (PUT /project/{projectId}/alert_rules)
+	SetProjectAlertRules(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the alerts a project's rules fired, newest first
+	// (GET /project/{projectId}/alerts)
+	GetProjectAlerts(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectAlertsParams)
+	// Get the arguments known to be safe for a project's tools, which calls are compared with when no earlier call of their tool in the run was approved
+	// (GET /project/{projectId}/argument_baselines)
+	GetProjectArgumentBaselines(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the argument baselines of a project
+	// (PUT /project/{projectId}/argument_baselines)
+	SetProjectArgumentBaselines(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the rules that approve or reject a project's tool calls by their arguments, before any supervisor reviews them
+	// (GET /project/{projectId}/argument_rules)
+	GetProjectArgumentRules(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the argument rules of a project
+	// (PUT /project/{projectId}/argument_rules)
+	SetProjectArgumentRules(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the project's backfills, newest first
+	// (GET /project/{projectId}/backfills)
+	GetProjectBackfills(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Supervise the project's historical tool calls from a date range, such as those of imported runs, with shadow chains at a throttled rate
+	// (POST /project/{projectId}/backfills)
+	CreateBackfill(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the supervisors that check a project's streamed chat completions
+	// (GET /project/{projectId}/chat_supervisors)
+	GetProjectChatSupervisors(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the chat supervisors of a project
+	// (PUT /project/{projectId}/chat_supervisors)
+	SetProjectChatSupervisors(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the context window policies applied to proxied chat requests for a project
+	// (GET /project/{projectId}/context_window_policies)
+	GetContextWindowPolicies(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the context window policies for a project
+	// (PUT /project/{projectId}/context_window_policies)
+	SetContextWindowPolicies(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the tool policies of a project merged with those inherited from its organization
+	// (GET /project/{projectId}/effective_tool_policies)
+	GetProjectEffectiveToolPolicies(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Export a project's supervision configuration as a bundle that can be imported elsewhere. Supervisor IDs are replaced by keys, so the bundle doesn't depend on this instance.
+	// (GET /project/{projectId}/export)
+	ExportProjectConfig(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get whether each feature flag is on for a project, and why
+	// (GET /project/{projectId}/feature_flags)
+	GetProjectFeatureFlags(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// End incident mode
+	// (DELETE /project/{projectId}/incident_mode)
+	EndIncidentMode(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get every incident mode period of a project, newest first
+	// (GET /project/{projectId}/incident_mode)
+	GetProjectIncidents(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Start incident mode. Until it ends, every tool in the project gets an extra chain that needs a human to review each call, on top of whatever its policy prescribes.
+	// (POST /project/{projectId}/incident_mode)
+	StartIncidentMode(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the hooks that transform a project's payloads before they're stored
+	// (GET /project/{projectId}/ingestion_hooks)
+	GetProjectIngestionHooks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the ingestion hooks of a project
+	// (PUT /project/{projectId}/ingestion_hooks)
+	SetProjectIngestionHooks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the notification settings of a project
+	// (GET /project/{projectId}/notification_settings)
+	GetProjectNotificationSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Set the notification settings of a project
+	// (PUT /project/{projectId}/notification_settings)
+	SetProjectNotificationSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Move a project into an organization, or out of one if organization_id is omitted
+	// (PUT /project/{projectId}/organization)
+	SetProjectOrganization(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the templates a project's webhook deliveries and notifications are rendered with
+	// (GET /project/{projectId}/payload_templates)
+	GetProjectPayloadTemplates(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the payload templates of a project. Each template is rendered with a sample payload and rejected if that fails.
+	// (PUT /project/{projectId}/payload_templates)
+	SetProjectPayloadTemplates(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Render a payload template with a sample payload of its target, without saving it
+	// (POST /project/{projectId}/payload_templates/preview)
+	PreviewPayloadTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the chains of decisions citing earlier ones as precedents, most cited first
+	// (GET /project/{projectId}/precedents)
+	GetProjectPrecedentChains(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the usage of every quota that applies to a project, including its organization's
+	// (GET /project/{projectId}/quota_usage)
+	GetProjectQuotaUsage(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the quotas of a project
+	// (GET /project/{projectId}/quotas)
+	GetProjectQuotas(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the quotas of a project
+	// (PUT /project/{projectId}/quotas)
+	SetProjectQuotas(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the redactors that run over a project's chats before they're stored
+	// (GET /project/{projectId}/redactors)
+	GetProjectRedactors(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the redactors of a project
+	// (PUT /project/{projectId}/redactors)
+	SetProjectRedactors(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Find the tool calls of a project that touched an external resource, newest first
+	// (GET /project/{projectId}/resource_references)
+	GetProjectResourceReferences(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectResourceReferencesParams)
+	// Get the result supervisors of a project
+	// (GET /project/{projectId}/result_supervisors)
+	GetProjectResultSupervisors(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the result supervisors of a project
+	// (PUT /project/{projectId}/result_supervisors)
+	SetProjectResultSupervisors(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the rules that route a project's reviews to reviewers with the skills they need
+	// (GET /project/{projectId}/routing_rules)
+	GetProjectRoutingRules(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the routing rules of a project
+	// (PUT /project/{projectId}/routing_rules)
+	SetProjectRoutingRules(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the limits a project sets on each of its runs, over the server's defaults
+	// (GET /project/{projectId}/run_limits)
+	GetProjectRunLimits(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the limits a project sets on each of its runs. Limits left out fall back to the server's defaults.
+	// (PUT /project/{projectId}/run_limits)
+	SetProjectRunLimits(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Stream every run of a project with its tool calls, as JSON lines
+	// (GET /project/{projectId}/runs/export)
+	ExportProjectRuns(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ExportProjectRunsParams)
+	// Get all supervisors
+	// (GET /project/{projectId}/supervisor)
+	GetSupervisors(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Create a new supervisor
+	// (POST /project/{projectId}/supervisor)
+	CreateSupervisor(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Run a candidate supervisor config against the project's tool calls from a date range and report what it would have decided next to what actually happened. Nothing is stored.
+	// (POST /project/{projectId}/supervisor_dry_run)
+	DryRunSupervisor(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get all tasks for a project
+	// (GET /project/{projectId}/tasks)
+	GetProjectTasks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Create a new task
+	// (POST /project/{projectId}/tasks)
+	CreateTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// List the tool call results reported in a project, newest first
+	// (GET /project/{projectId}/tool_call_results)
+	GetProjectToolCallResults(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectToolCallResultsParams)
+	// Get the supervisor chains applied to tools when runs of a project register them
+	// (GET /project/{projectId}/tool_policies)
+	GetProjectToolPolicies(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the tool policies of a project
+	// (PUT /project/{projectId}/tool_policies)
+	SetProjectToolPolicies(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get all tools for a project
+	// (GET /project/{projectId}/tools)
+	GetProjectTools(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get how a project's agents earn autonomy
+	// (GET /project/{projectId}/trust_policy)
+	GetProjectTrustPolicy(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Set how a project's agents earn autonomy
+	// (PUT /project/{projectId}/trust_policy)
+	SetProjectTrustPolicy(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the tokens a project's chats used and what they cost, by model, over a recent window
+	// (GET /project/{projectId}/usage)
+	GetProjectUsage(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectUsageParams)
+	// Get the verdicts a project's supervisors can give besides the built in decisions
+	// (GET /project/{projectId}/verdicts)
+	GetProjectVerdicts(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Replace the custom verdicts of a project
+	// (PUT /project/{projectId}/verdicts)
+	SetProjectVerdicts(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get the webhooks of a project, without their secrets
+	// (GET /project/{projectId}/webhooks)
+	GetProjectWebhooks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Register a webhook that the project's events are POSTed to
+	// (POST /project/{projectId}/webhooks)
+	CreateProjectWebhook(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get all review queue handoff bundles, newest first
+	// (GET /review_queue/handoff)
+	GetHandoffBundles(w http.ResponseWriter, r *http.Request)
+	// Snapshot the pending reviews, their assignments and priorities into a named handoff bundle
+	// (POST /review_queue/handoff)
+	CreateHandoffBundle(w http.ResponseWriter, r *http.Request)
+	// Get a handoff bundle
+	// (GET /review_queue/handoff/{handoffBundleId})
+	GetHandoffBundle(w http.ResponseWriter, r *http.Request, handoffBundleId openapi_types.UUID)
+	// Reassign the reviews in a handoff bundle that are still unresolved to a reviewer session. The session doesn't need to be connected; its first connection is sent the reviews.
+	// (POST /review_queue/handoff/{handoffBundleId}/restore)
+	RestoreHandoffBundle(w http.ResponseWriter, r *http.Request, handoffBundleId openapi_types.UUID)
+	// Get every undecided supervision request with how long it has been waiting, oldest first
+	// (GET /review_queue/waiting)
+	GetWaitingSupervisionRequests(w http.ResponseWriter, r *http.Request)
+	// Tag a reviewer session with skills, replacing the skills it declares itself
+	// (PUT /reviewer/{session})
+	SetReviewer(w http.ResponseWriter, r *http.Request, session string)
+	// List what a reviewer session was notified of, oldest first
+	// (GET /reviewer/{session}/watch_notifications)
+	GetWatchNotifications(w http.ResponseWriter, r *http.Request, session string, params GetWatchNotificationsParams)
+	// Get the runs, tools and agents a reviewer session watches
+	// (GET /reviewer/{session}/watch_subscriptions)
+	GetWatchSubscriptions(w http.ResponseWriter, r *http.Request, session string)
+	// Watch a run, a tool or an agent
+	// (POST /reviewer/{session}/watch_subscriptions)
+	CreateWatchSubscription(w http.ResponseWriter, r *http.Request, session string)
+	// Get the reviewer sessions admins have tagged with skills
+	// (GET /reviewers)
+	GetReviewers(w http.ResponseWriter, r *http.Request)
+	// Get a run
+	// (GET /run/{runId})
+	GetRun(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Get the artifacts a run's agent uploaded, without their content
+	// (GET /run/{runId}/artifacts)
+	GetRunArtifacts(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Upload an artifact the agent produced, like a generated file or a browser screenshot
+	// (POST /run/{runId}/artifacts)
+	UploadRunArtifact(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID, params UploadRunArtifactParams)
+	// Change how autonomously a run may act
+	// (PUT /run/{runId}/autonomy)
+	UpdateRunAutonomy(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Start streaming a chat completion of a run
+	// (POST /run/{runId}/chat_streams)
+	CreateChatStream(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Get the reference documents attached to a run, without their content
+	// (GET /run/{runId}/documents)
+	GetRunDocuments(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Attach a reference document, like ticket text, a runbook or a spec, to a run
+	// (POST /run/{runId}/documents)
+	AttachRunDocument(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Get the events external systems posted to a run, oldest first
+	// (GET /run/{runId}/events)
+	GetRunEvents(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Post an event from an external system, like CI, monitoring or ticketing, to a run
+	// (POST /run/{runId}/events)
+	CreateRunEvent(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Export a run with its chats, tools, tool calls and the full history of their supervision, as an archive another instance can import
+	// (GET /run/{runId}/export)
+	ExportRun(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID, params ExportRunParams)
+	// Re-hash the stored chats and messages of a run and report any that changed
+	// (GET /run/{runId}/integrity)
+	VerifyRunIntegrity(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Get how close a run is to each limit that applies to it
+	// (GET /run/{runId}/limits)
+	GetRunLimitUsage(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Register every operation of an OpenAPI document as a tool of a run
+	// (POST /run/{runId}/openapi_tools)
+	ImportRunOpenApiTools(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Get why a paused run was paused
+	// (GET /run/{runId}/pause)
+	GetRunPause(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Pause a run while it's investigated
+	// (POST /run/{runId}/pause)
+	PauseRun(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Get the plans an agent submitted for a run, oldest first
+	// (GET /run/{runId}/plans)
+	GetRunPlans(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Submit the tool calls a run intends to make for review before it makes them
+	// (POST /run/{runId}/plans)
+	CreateRunPlan(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Ask how a tool call would likely be decided, without making it
+	// (POST /run/{runId}/preapproval)
+	PreapproveToolCall(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Proxy an OpenAI chat completion request upstream, applying the project's context window policy and logging the chat against the run
+	// (POST /run/{runId}/proxy/chat/completions)
+	CreateProxyChatCompletion(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Get what was redacted from a run's chats
+	// (GET /run/{runId}/redactions)
+	GetRunRedactions(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Get a run's provider requests and responses in order, with the choice picked at each step
+	// (GET /run/{runId}/replay)
+	GetRunReplay(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
 	// Update a run with a result
 	// (PUT /run/{runId}/result)
 	UpdateRunResult(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Resume a paused run
+	// (POST /run/{runId}/resume)
+	ResumeRun(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
 	// Get the status of a run
 	// (GET /run/{runId}/status)
 	GetRunStatus(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
@@ -417,6 +5018,18 @@ type ServerInterface interface {
 	// Create a new tool for a run
 	// (POST /run/{runId}/tool)
 	CreateRunTool(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Get the transcript of a voice agent's run, oldest segment first
+	// (GET /run/{runId}/transcript)
+	GetRunTranscript(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Add a segment of a voice agent's transcript to its run
+	// (POST /run/{runId}/transcript)
+	CreateTranscriptSegment(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Get the record of every truncation applied to proxied chat requests for a run
+	// (GET /run/{runId}/truncations)
+	GetRunTruncations(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
+	// Get the tokens a run's chats used and what they cost, by model
+	// (GET /run/{runId}/usage)
+	GetRunUsage(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
 	// Create a new chat completion request from an existing run
 	// (POST /run/{run_id}/chat)
 	CreateNewChat(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID)
@@ -426,9 +5039,48 @@ type ServerInterface interface {
 	// Get the messages for a run
 	// (GET /run/{run_id}/messages/{index})
 	GetRunMessages(w http.ResponseWriter, r *http.Request, runId openapi_types.UUID, index int)
+	// Compare runs side by side
+	// (GET /run_comparison)
+	CompareRuns(w http.ResponseWriter, r *http.Request, params CompareRunsParams)
+	// Search the content of messages, the names and arguments of tool calls and the reasoning of decisions across runs, best matches first
+	// (GET /search)
+	Search(w http.ResponseWriter, r *http.Request, params SearchParams)
 	// Get hub stats
 	// (GET /stats)
 	GetHubStats(w http.ResponseWriter, r *http.Request)
+	// Get the review queue of each priority class
+	// (GET /stats/queues)
+	GetQueueStats(w http.ResponseWriter, r *http.Request)
+	// Get the audit log of a supervision request, oldest first
+	// (GET /supervision_request/{supervisionRequestId}/audit_log)
+	GetSupervisionRequestAuditLog(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
+	// Get the ServiceNow change request a ServiceNow supervisor opened for a supervision request
+	// (GET /supervision_request/{supervisionRequestId}/change_request)
+	GetSupervisionRequestChangeRequest(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
+	// Release the claim of the API key's user on a supervision request
+	// (DELETE /supervision_request/{supervisionRequestId}/claim)
+	ReleaseSupervisionRequest(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
+	// Claim a waiting supervision request for the user of the API key
+	// (POST /supervision_request/{supervisionRequestId}/claim)
+	ClaimSupervisionRequest(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
+	// Get the questions reviewers asked the agent about a supervision request, oldest first
+	// (GET /supervision_request/{supervisionRequestId}/clarifications)
+	GetSupervisionRequestClarifications(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
+	// Get the consent request issued for a supervision request by a consent supervisor, including the link to pass on to the end user
+	// (GET /supervision_request/{supervisionRequestId}/consent)
+	GetSupervisionRequestConsent(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
+	// Get the verdicts each member of an ensemble supervisor gave on a supervision request
+	// (GET /supervision_request/{supervisionRequestId}/ensemble_verdicts)
+	GetSupervisionRequestEnsembleVerdicts(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
+	// Draft the feedback of a decision a reviewer is about to make
+	// (POST /supervision_request/{supervisionRequestId}/feedback_draft)
+	DraftReviewFeedback(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
+	// Get the reminders of a supervision request, fired or not
+	// (GET /supervision_request/{supervisionRequestId}/reminders)
+	GetSupervisionRequestReminders(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
+	// Remind the reviewer of a supervision request later, if it's still undecided by then
+	// (POST /supervision_request/{supervisionRequestId}/reminders)
+	CreateSupervisionRequestReminder(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
 	// Get a supervision result
 	// (GET /supervision_request/{supervisionRequestId}/result)
 	GetSupervisionResult(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
@@ -437,13 +5089,31 @@ type ServerInterface interface {
 	CreateSupervisionResult(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
 	// Get the review payload for a supervision request
 	// (GET /supervision_request/{supervisionRequestId}/review_payload)
-	GetSupervisionReviewPayload(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
+	GetSupervisionReviewPayload(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID, params GetSupervisionReviewPayloadParams)
 	// Get a supervision request status
 	// (GET /supervision_request/{supervisionRequestId}/status)
 	GetSupervisionRequestStatus(w http.ResponseWriter, r *http.Request, supervisionRequestId openapi_types.UUID)
 	// Get a supervisor
 	// (GET /supervisor/{supervisorId})
 	GetSupervisor(w http.ResponseWriter, r *http.Request, supervisorId openapi_types.UUID)
+	// Get how well an automated supervisor's confidence predicts the decisions of humans after it
+	// (GET /supervisor/{supervisorId}/calibration)
+	GetSupervisorCalibration(w http.ResponseWriter, r *http.Request, supervisorId openapi_types.UUID)
+	// Get the circuit breaker of an automated supervisor
+	// (GET /supervisor/{supervisorId}/circuit_breaker)
+	GetSupervisorCircuitBreaker(w http.ResponseWriter, r *http.Request, supervisorId openapi_types.UUID)
+	// Compare the prompt variants of an ensemble supervisor
+	// (GET /supervisor/{supervisorId}/prompt_variants/report)
+	GetSupervisorPromptVariantReport(w http.ResponseWriter, r *http.Request, supervisorId openapi_types.UUID)
+	// Get the example tool calls a supervisor is tested with
+	// (GET /supervisor/{supervisorId}/test_cases)
+	GetSupervisorTestCases(w http.ResponseWriter, r *http.Request, supervisorId openapi_types.UUID)
+	// Replace the example tool calls a supervisor is tested with
+	// (PUT /supervisor/{supervisorId}/test_cases)
+	SetSupervisorTestCases(w http.ResponseWriter, r *http.Request, supervisorId openapi_types.UUID)
+	// Run a supervisor's test cases and report the ones it fails
+	// (POST /supervisor/{supervisorId}/test_cases/run)
+	RunSupervisorTestCases(w http.ResponseWriter, r *http.Request, supervisorId openapi_types.UUID)
 	// Get the Swagger UI
 	// (GET /swagger-ui)
 	GetSwaggerDocs(w http.ResponseWriter, r *http.Request)
@@ -456,43 +5126,4738 @@ type ServerInterface interface {
 	// Create a new run for a task
 	// (POST /task/{taskId}/run)
 	CreateRun(w http.ResponseWriter, r *http.Request, taskId openapi_types.UUID)
+	// Create a run of a task from a run archive exported by this or another instance
+	// (POST /task/{taskId}/run/import)
+	ImportRun(w http.ResponseWriter, r *http.Request, taskId openapi_types.UUID)
+	// Get the token usage and decisions of every run of a task added up
+	// (GET /task/{taskId}/summary)
+	GetTaskSummary(w http.ResponseWriter, r *http.Request, taskId openapi_types.UUID)
+	// Get what happened in every run of a task, oldest first
+	// (GET /task/{taskId}/timeline)
+	GetTaskTimeline(w http.ResponseWriter, r *http.Request, taskId openapi_types.UUID)
 	// Get a tool
 	// (GET /tool/{toolId})
 	GetTool(w http.ResponseWriter, r *http.Request, toolId openapi_types.UUID)
+	// Reorder the supervisors of a chain
+	// (PUT /tool/{toolId}/chain/{chainId}/order)
+	ReorderChainSupervisors(w http.ResponseWriter, r *http.Request, toolId openapi_types.UUID, chainId openapi_types.UUID)
+	// Remove a supervisor from a chain
+	// (DELETE /tool/{toolId}/chain/{chainId}/supervisor/{supervisorId})
+	RemoveChainSupervisor(w http.ResponseWriter, r *http.Request, toolId openapi_types.UUID, chainId openapi_types.UUID, supervisorId openapi_types.UUID)
+	// Insert a supervisor into a chain
+	// (POST /tool/{toolId}/chain/{chainId}/supervisors)
+	InsertChainSupervisor(w http.ResponseWriter, r *http.Request, toolId openapi_types.UUID, chainId openapi_types.UUID)
+	// Get a chain as it was at one of its versions
+	// (GET /tool/{toolId}/chain/{chainId}/version/{version})
+	GetSupervisorChainVersion(w http.ResponseWriter, r *http.Request, toolId openapi_types.UUID, chainId openapi_types.UUID, version int)
 	// Get all supervisors for a tool, in chain format
 	// (GET /tool/{toolId}/supervisors)
 	GetToolSupervisorChains(w http.ResponseWriter, r *http.Request, toolId openapi_types.UUID)
 	// Create new chains with supervisors for a tool
 	// (POST /tool/{toolId}/supervisors)
 	CreateToolSupervisorChains(w http.ResponseWriter, r *http.Request, toolId openapi_types.UUID)
+	// Decide the reviews of several tool calls at once
+	// (POST /tool_call/decisions:batch)
+	BatchDecideToolCalls(w http.ResponseWriter, r *http.Request)
 	// Get a tool call
 	// (GET /tool_call/{toolCallId})
 	GetToolCall(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
+	// Get the fields of a tool call's arguments as its tool's JSON Schema describes them, for rendering the arguments as a form
+	// (GET /tool_call/{toolCallId}/argument_form)
+	GetToolCallArgumentForm(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
+	// Get the artifacts uploaded for a tool call, without their content
+	// (GET /tool_call/{toolCallId}/artifacts)
+	GetToolCallArtifacts(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
 	// Create a supervision request for a supervisor in a chain on a tool call
 	// (POST /tool_call/{toolCallId}/chain/{chainId}/supervisor/{supervisorId}/supervision_request)
 	CreateSupervisionRequest(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID, chainId openapi_types.UUID, supervisorId openapi_types.UUID)
+	// Get the dependency graph a tool call belongs to
+	// (GET /tool_call/{toolCallId}/dependencies)
+	GetToolCallDependencies(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
+	// Declare the tool calls whose output this tool call uses, replacing any previous declaration
+	// (PUT /tool_call/{toolCallId}/dependencies)
+	SetToolCallDependencies(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
+	// Get how a tool call's arguments differ from those of the latest call of the same tool in its run that was approved before it, or else from the tool's argument baseline in its project
+	// (GET /tool_call/{toolCallId}/diff)
+	GetToolCallArgumentDiff(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
+	// Get every state a tool call passed through, oldest first, with who caused each change. Reconstructed from supervision statuses, results and the audit log.
+	// (GET /tool_call/{toolCallId}/history)
+	GetToolCallHistory(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
+	// Get the external resources found in a tool call's arguments
+	// (GET /tool_call/{toolCallId}/resources)
+	GetToolCallResources(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
+	// Get the result reported for a tool call and its verdict
+	// (GET /tool_call/{toolCallId}/result)
+	GetToolCallResult(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
+	// Report the result of an executed tool call for supervision
+	// (POST /tool_call/{toolCallId}/result)
+	ReportToolCallResult(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
+	// Decide a tool call result held for review
+	// (POST /tool_call/{toolCallId}/result/verdict)
+	DecideToolCallResult(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
+	// Upload the screenshot a browser agent took before making a tool call
+	// (POST /tool_call/{toolCallId}/screenshot)
+	UploadToolCallScreenshot(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
 	// Get the state of a tool call
 	// (GET /tool_call/{toolCallId}/state)
 	GetToolCallState(w http.ResponseWriter, r *http.Request, toolCallId string)
 	// Get a tool call status
 	// (GET /tool_call/{toolCallId}/status)
 	GetToolCallStatus(w http.ResponseWriter, r *http.Request, toolCallId openapi_types.UUID)
+	// Get the supervision requests a user claimed that are still waiting for a decision, oldest first
+	// (GET /user/{userId}/queue)
+	GetUserQueue(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
+	// Get the users who review tool calls
+	// (GET /users)
+	GetUsers(w http.ResponseWriter, r *http.Request)
+	// Create a user. Their API keys record the decisions they make as theirs.
+	// (POST /users)
+	CreateUser(w http.ResponseWriter, r *http.Request)
+	// Stop watching, deleting the subscription's notifications
+	// (DELETE /watch_subscription/{watchSubscriptionId})
+	DeleteWatchSubscription(w http.ResponseWriter, r *http.Request, watchSubscriptionId openapi_types.UUID)
+	// Delete a webhook and its deliveries
+	// (DELETE /webhook/{webhookId})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID)
+	// Change the URL, events or enabled state of a webhook
+	// (PUT /webhook/{webhookId})
+	UpdateWebhook(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID)
+	// Get the latest deliveries of a webhook, newest first
+	// (GET /webhook/{webhookId}/deliveries)
+	GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID)
+	// Get which replica runs each background worker
+	// (GET /workers)
+	GetWorkers(w http.ResponseWriter, r *http.Request)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetDiagnostics operation middleware
+func (siw *ServerInterfaceWrapper) GetDiagnostics(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDiagnostics(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAgent operation middleware
+func (siw *ServerInterfaceWrapper) GetAgent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "agentId" -------------
+	var agentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "agentId", r.PathValue("agentId"), &agentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "agentId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAgent(w, r, agentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAgentTrust operation middleware
+func (siw *ServerInterfaceWrapper) GetAgentTrust(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "agentId" -------------
+	var agentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "agentId", r.PathValue("agentId"), &agentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "agentId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAgentTrust(w, r, agentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetApiKeys(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateApiKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateApiKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeApiKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeApiKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "apiKeyId" -------------
+	var apiKeyId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyId", r.PathValue("apiKeyId"), &apiKeyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apiKeyId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeApiKey(w, r, apiKeyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateApiKey operation middleware
+func (siw *ServerInterfaceWrapper) RotateApiKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "apiKeyId" -------------
+	var apiKeyId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "apiKeyId", r.PathValue("apiKeyId"), &apiKeyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apiKeyId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateApiKey(w, r, apiKeyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetArchives operation middleware
+func (siw *ServerInterfaceWrapper) GetArchives(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArchives(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportArchive operation middleware
+func (siw *ServerInterfaceWrapper) ExportArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "day" -------------
+	var day string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "day", r.PathValue("day"), &day, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "day", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportArchive(w, r, day)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyArchive operation middleware
+func (siw *ServerInterfaceWrapper) VerifyArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "day" -------------
+	var day string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "day", r.PathValue("day"), &day, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "day", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyArchive(w, r, day)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunArtifact operation middleware
+func (siw *ServerInterfaceWrapper) GetRunArtifact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "artifactId" -------------
+	var artifactId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "artifactId", r.PathValue("artifactId"), &artifactId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifactId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunArtifact(w, r, artifactId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DownloadRunArtifact operation middleware
+func (siw *ServerInterfaceWrapper) DownloadRunArtifact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "artifactId" -------------
+	var artifactId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "artifactId", r.PathValue("artifactId"), &artifactId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifactId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadRunArtifact(w, r, artifactId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuditAnchors operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditAnchors(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuditAnchors(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AnchorAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) AnchorAuditLog(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AnchorAuditLog(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) VerifyAuditLog(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyAuditLog(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBackfill operation middleware
+func (siw *ServerInterfaceWrapper) GetBackfill(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "backfillId" -------------
+	var backfillId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backfillId", r.PathValue("backfillId"), &backfillId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backfillId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBackfill(w, r, backfillId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PauseBackfill operation middleware
+func (siw *ServerInterfaceWrapper) PauseBackfill(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "backfillId" -------------
+	var backfillId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backfillId", r.PathValue("backfillId"), &backfillId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backfillId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseBackfill(w, r, backfillId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBackfillReport operation middleware
+func (siw *ServerInterfaceWrapper) GetBackfillReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "backfillId" -------------
+	var backfillId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backfillId", r.PathValue("backfillId"), &backfillId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backfillId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBackfillReport(w, r, backfillId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResumeBackfill operation middleware
+func (siw *ServerInterfaceWrapper) ResumeBackfill(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "backfillId" -------------
+	var backfillId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "backfillId", r.PathValue("backfillId"), &backfillId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "backfillId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeBackfill(w, r, backfillId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCapabilities operation middleware
+func (siw *ServerInterfaceWrapper) GetCapabilities(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCapabilities(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// NegotiateCapabilities operation middleware
+func (siw *ServerInterfaceWrapper) NegotiateCapabilities(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.NegotiateCapabilities(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetChatStream operation middleware
+func (siw *ServerInterfaceWrapper) GetChatStream(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "streamId" -------------
+	var streamId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "streamId", r.PathValue("streamId"), &streamId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "streamId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChatStream(w, r, streamId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AppendChatStreamChunks operation middleware
+func (siw *ServerInterfaceWrapper) AppendChatStreamChunks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "streamId" -------------
+	var streamId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "streamId", r.PathValue("streamId"), &streamId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "streamId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AppendChatStreamChunks(w, r, streamId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CompleteChatStream operation middleware
+func (siw *ServerInterfaceWrapper) CompleteChatStream(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "streamId" -------------
+	var streamId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "streamId", r.PathValue("streamId"), &streamId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "streamId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompleteChatStream(w, r, streamId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCircuitBreakers operation middleware
+func (siw *ServerInterfaceWrapper) GetCircuitBreakers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCircuitBreakers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AnswerClarification operation middleware
+func (siw *ServerInterfaceWrapper) AnswerClarification(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "clarificationId" -------------
+	var clarificationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clarificationId", r.PathValue("clarificationId"), &clarificationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clarificationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AnswerClarification(w, r, clarificationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReloadConfig operation middleware
+func (siw *ServerInterfaceWrapper) ReloadConfig(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReloadConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConsentPrompt operation middleware
+func (siw *ServerInterfaceWrapper) GetConsentPrompt(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", r.PathValue("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConsentPrompt(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RespondToConsent operation middleware
+func (siw *ServerInterfaceWrapper) RespondToConsent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", r.PathValue("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RespondToConsent(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunDocument operation middleware
+func (siw *ServerInterfaceWrapper) GetRunDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "documentId" -------------
+	var documentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "documentId", r.PathValue("documentId"), &documentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunDocument(w, r, documentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFeatureFlags operation middleware
+func (siw *ServerInterfaceWrapper) GetFeatureFlags(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFeatureFlags(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteFeatureFlagRollout operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFeatureFlagRollout(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "flag" -------------
+	var flag string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flag", r.PathValue("flag"), &flag, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flag", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteFeatureFlagRollout(w, r, flag)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetFeatureFlagRollout operation middleware
+func (siw *ServerInterfaceWrapper) SetFeatureFlagRollout(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "flag" -------------
+	var flag string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flag", r.PathValue("flag"), &flag, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "flag", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetFeatureFlagRollout(w, r, flag)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMessageContent operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMessageContent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "messageId" -------------
+	var messageId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "messageId", r.PathValue("messageId"), &messageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "messageId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMessageContent(w, r, messageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMessageDiffs operation middleware
+func (siw *ServerInterfaceWrapper) GetMessageDiffs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "messageId" -------------
+	var messageId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "messageId", r.PathValue("messageId"), &messageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "messageId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMessageDiffs(w, r, messageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMessageTranslation operation middleware
+func (siw *ServerInterfaceWrapper) GetMessageTranslation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "messageId" -------------
+	var messageId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "messageId", r.PathValue("messageId"), &messageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "messageId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMessageTranslationParams
+
+	// ------------- Optional query parameter "target_language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target_language", r.URL.Query(), &params.TargetLanguage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "target_language", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMessageTranslation(w, r, messageId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLocalizedMessages operation middleware
+func (siw *ServerInterfaceWrapper) GetLocalizedMessages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "locale" -------------
+	var locale string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "locale", r.PathValue("locale"), &locale, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "locale", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLocalizedMessages(w, r, locale)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMeteringEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetMeteringEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMeteringEventsParams
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "organization_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organization_id", r.URL.Query(), &params.OrganizationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMeteringEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOpenAPI operation middleware
+func (siw *ServerInterfaceWrapper) GetOpenAPI(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOpenAPI(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizations(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganization(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganization operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", r.PathValue("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganization(w, r, organizationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetKillSwitch operation middleware
+func (siw *ServerInterfaceWrapper) GetKillSwitch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", r.PathValue("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetKillSwitch(w, r, organizationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequestKillSwitch operation middleware
+func (siw *ServerInterfaceWrapper) RequestKillSwitch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", r.PathValue("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestKillSwitch(w, r, organizationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ConfirmKillSwitch operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmKillSwitch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", r.PathValue("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "killSwitchRequestId" -------------
+	var killSwitchRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "killSwitchRequestId", r.PathValue("killSwitchRequestId"), &killSwitchRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "killSwitchRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfirmKillSwitch(w, r, organizationId, killSwitchRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationQuotas operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationQuotas(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", r.PathValue("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationQuotas(w, r, organizationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetOrganizationQuotas operation middleware
+func (siw *ServerInterfaceWrapper) SetOrganizationQuotas(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", r.PathValue("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetOrganizationQuotas(w, r, organizationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationToolPolicies operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationToolPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", r.PathValue("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationToolPolicies(w, r, organizationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetOrganizationToolPolicies operation middleware
+func (siw *ServerInterfaceWrapper) SetOrganizationToolPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", r.PathValue("organizationId"), &organizationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetOrganizationToolPolicies(w, r, organizationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPlan operation middleware
+func (siw *ServerInterfaceWrapper) GetPlan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "planId" -------------
+	var planId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "planId", r.PathValue("planId"), &planId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "planId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlan(w, r, planId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DecidePlan operation middleware
+func (siw *ServerInterfaceWrapper) DecidePlan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "planId" -------------
+	var planId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "planId", r.PathValue("planId"), &planId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "planId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DecidePlan(w, r, planId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPlanDeviations operation middleware
+func (siw *ServerInterfaceWrapper) GetPlanDeviations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "planId" -------------
+	var planId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "planId", r.PathValue("planId"), &planId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "planId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlanDeviations(w, r, planId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjects operation middleware
+func (siw *ServerInterfaceWrapper) GetProjects(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjects(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProject operation middleware
+func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProject(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BootstrapProject operation middleware
+func (siw *ServerInterfaceWrapper) BootstrapProject(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BootstrapProject(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportProjectConfig operation middleware
+func (siw *ServerInterfaceWrapper) ImportProjectConfig(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportProjectConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectTemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectTemplates(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectTemplates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProject operation middleware
+func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProject(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectAgents operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectAgents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectAgents(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RegisterAgent operation middleware
+func (siw *ServerInterfaceWrapper) RegisterAgent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterAgent(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectAlertRules operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectAlertRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectAlertRules(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectAlertRules operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectAlertRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectAlertRules(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectAlerts operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectAlerts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectAlertsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectAlerts(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectArgumentBaselines operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectArgumentBaselines(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectArgumentBaselines(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectArgumentBaselines operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectArgumentBaselines(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectArgumentBaselines(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectArgumentRules operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectArgumentRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectArgumentRules(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectArgumentRules operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectArgumentRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectArgumentRules(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectBackfills operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectBackfills(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectBackfills(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateBackfill operation middleware
+func (siw *ServerInterfaceWrapper) CreateBackfill(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBackfill(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectChatSupervisors operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectChatSupervisors(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectChatSupervisors(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectChatSupervisors operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectChatSupervisors(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectChatSupervisors(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetContextWindowPolicies operation middleware
+func (siw *ServerInterfaceWrapper) GetContextWindowPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetContextWindowPolicies(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetContextWindowPolicies operation middleware
+func (siw *ServerInterfaceWrapper) SetContextWindowPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetContextWindowPolicies(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectEffectiveToolPolicies operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectEffectiveToolPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectEffectiveToolPolicies(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportProjectConfig operation middleware
+func (siw *ServerInterfaceWrapper) ExportProjectConfig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportProjectConfig(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectFeatureFlags operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectFeatureFlags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectFeatureFlags(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EndIncidentMode operation middleware
+func (siw *ServerInterfaceWrapper) EndIncidentMode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EndIncidentMode(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectIncidents operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectIncidents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectIncidents(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartIncidentMode operation middleware
+func (siw *ServerInterfaceWrapper) StartIncidentMode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartIncidentMode(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectIngestionHooks operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectIngestionHooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectIngestionHooks(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectIngestionHooks operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectIngestionHooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectIngestionHooks(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectNotificationSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectNotificationSettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectNotificationSettings(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectNotificationSettings operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectNotificationSettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectNotificationSettings(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectOrganization operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectOrganization(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectPayloadTemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectPayloadTemplates(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectPayloadTemplates(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectPayloadTemplates operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectPayloadTemplates(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectPayloadTemplates(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PreviewPayloadTemplate operation middleware
+func (siw *ServerInterfaceWrapper) PreviewPayloadTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewPayloadTemplate(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectPrecedentChains operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectPrecedentChains(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectPrecedentChains(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectQuotaUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectQuotaUsage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectQuotaUsage(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectQuotas operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectQuotas(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectQuotas(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectQuotas operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectQuotas(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectQuotas(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectRedactors operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectRedactors(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectRedactors(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectRedactors operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectRedactors(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectRedactors(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectResourceReferences operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectResourceReferences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectResourceReferencesParams
+
+	// ------------- Required query parameter "identifier" -------------
+
+	if paramValue := r.URL.Query().Get("identifier"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "identifier"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "identifier", r.URL.Query(), &params.Identifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "match" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "match", r.URL.Query(), &params.Match)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "match", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectResourceReferences(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectResultSupervisors operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectResultSupervisors(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectResultSupervisors(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectResultSupervisors operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectResultSupervisors(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectResultSupervisors(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectRoutingRules operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectRoutingRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectRoutingRules(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectRoutingRules operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectRoutingRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectRoutingRules(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectRunLimits operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectRunLimits(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectRunLimits(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectRunLimits operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectRunLimits(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectRunLimits(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportProjectRuns operation middleware
+func (siw *ServerInterfaceWrapper) ExportProjectRuns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportProjectRunsParams
+
+	// ------------- Optional query parameter "resume_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resume_token", r.URL.Query(), &params.ResumeToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resume_token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportProjectRuns(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisors operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisors(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisors(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSupervisor operation middleware
+func (siw *ServerInterfaceWrapper) CreateSupervisor(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSupervisor(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DryRunSupervisor operation middleware
+func (siw *ServerInterfaceWrapper) DryRunSupervisor(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DryRunSupervisor(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectTasks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectTasks(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTask operation middleware
+func (siw *ServerInterfaceWrapper) CreateTask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTask(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectToolCallResults operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectToolCallResults(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectToolCallResultsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectToolCallResults(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectToolPolicies operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectToolPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectToolPolicies(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectToolPolicies operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectToolPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectToolPolicies(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectTools operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectTools(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectTools(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectTrustPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectTrustPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectTrustPolicy(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectTrustPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectTrustPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectTrustPolicy(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectUsage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectUsageParams
+
+	// ------------- Optional query parameter "window" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window", r.URL.Query(), &params.Window)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "window", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectUsage(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectVerdicts operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectVerdicts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectVerdicts(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetProjectVerdicts operation middleware
+func (siw *ServerInterfaceWrapper) SetProjectVerdicts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProjectVerdicts(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectWebhooks(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProjectWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateProjectWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProjectWebhook(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHandoffBundles operation middleware
+func (siw *ServerInterfaceWrapper) GetHandoffBundles(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHandoffBundles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateHandoffBundle operation middleware
+func (siw *ServerInterfaceWrapper) CreateHandoffBundle(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateHandoffBundle(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHandoffBundle operation middleware
+func (siw *ServerInterfaceWrapper) GetHandoffBundle(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "handoffBundleId" -------------
+	var handoffBundleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "handoffBundleId", r.PathValue("handoffBundleId"), &handoffBundleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "handoffBundleId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHandoffBundle(w, r, handoffBundleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreHandoffBundle operation middleware
+func (siw *ServerInterfaceWrapper) RestoreHandoffBundle(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "handoffBundleId" -------------
+	var handoffBundleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "handoffBundleId", r.PathValue("handoffBundleId"), &handoffBundleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "handoffBundleId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreHandoffBundle(w, r, handoffBundleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWaitingSupervisionRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetWaitingSupervisionRequests(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWaitingSupervisionRequests(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetReviewer operation middleware
+func (siw *ServerInterfaceWrapper) SetReviewer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "session" -------------
+	var session string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session", r.PathValue("session"), &session, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetReviewer(w, r, session)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWatchNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetWatchNotifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "session" -------------
+	var session string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session", r.PathValue("session"), &session, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWatchNotificationsParams
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWatchNotifications(w, r, session, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWatchSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) GetWatchSubscriptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "session" -------------
+	var session string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session", r.PathValue("session"), &session, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWatchSubscriptions(w, r, session)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWatchSubscription operation middleware
+func (siw *ServerInterfaceWrapper) CreateWatchSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "session" -------------
+	var session string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session", r.PathValue("session"), &session, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWatchSubscription(w, r, session)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReviewers operation middleware
+func (siw *ServerInterfaceWrapper) GetReviewers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReviewers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRun operation middleware
+func (siw *ServerInterfaceWrapper) GetRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRun(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) GetRunArtifacts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunArtifacts(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadRunArtifact operation middleware
+func (siw *ServerInterfaceWrapper) UploadRunArtifact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadRunArtifactParams
+
+	// ------------- Required query parameter "name" -------------
+
+	if paramValue := r.URL.Query().Get("name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tool_call_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tool_call_id", r.URL.Query(), &params.ToolCallId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tool_call_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadRunArtifact(w, r, runId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRunAutonomy operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRunAutonomy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRunAutonomy(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateChatStream operation middleware
+func (siw *ServerInterfaceWrapper) CreateChatStream(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateChatStream(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunDocuments operation middleware
+func (siw *ServerInterfaceWrapper) GetRunDocuments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunDocuments(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AttachRunDocument operation middleware
+func (siw *ServerInterfaceWrapper) AttachRunDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AttachRunDocument(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetRunEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunEvents(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRunEvent operation middleware
+func (siw *ServerInterfaceWrapper) CreateRunEvent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRunEvent(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportRun operation middleware
+func (siw *ServerInterfaceWrapper) ExportRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportRunParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportRun(w, r, runId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyRunIntegrity operation middleware
+func (siw *ServerInterfaceWrapper) VerifyRunIntegrity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyRunIntegrity(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunLimitUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetRunLimitUsage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunLimitUsage(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportRunOpenApiTools operation middleware
+func (siw *ServerInterfaceWrapper) ImportRunOpenApiTools(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportRunOpenApiTools(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunPause operation middleware
+func (siw *ServerInterfaceWrapper) GetRunPause(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunPause(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PauseRun operation middleware
+func (siw *ServerInterfaceWrapper) PauseRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseRun(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunPlans operation middleware
+func (siw *ServerInterfaceWrapper) GetRunPlans(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunPlans(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRunPlan operation middleware
+func (siw *ServerInterfaceWrapper) CreateRunPlan(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRunPlan(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PreapproveToolCall operation middleware
+func (siw *ServerInterfaceWrapper) PreapproveToolCall(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreapproveToolCall(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProxyChatCompletion operation middleware
+func (siw *ServerInterfaceWrapper) CreateProxyChatCompletion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProxyChatCompletion(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunRedactions operation middleware
+func (siw *ServerInterfaceWrapper) GetRunRedactions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunRedactions(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunReplay operation middleware
+func (siw *ServerInterfaceWrapper) GetRunReplay(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunReplay(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRunResult operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRunResult(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRunResult(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResumeRun operation middleware
+func (siw *ServerInterfaceWrapper) ResumeRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeRun(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetRunStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunStatus(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRunStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRunStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRunStatus(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunTools operation middleware
+func (siw *ServerInterfaceWrapper) GetRunTools(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunTools(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRunTool operation middleware
+func (siw *ServerInterfaceWrapper) CreateRunTool(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRunTool(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunTranscript operation middleware
+func (siw *ServerInterfaceWrapper) GetRunTranscript(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunTranscript(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTranscriptSegment operation middleware
+func (siw *ServerInterfaceWrapper) CreateTranscriptSegment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTranscriptSegment(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunTruncations operation middleware
+func (siw *ServerInterfaceWrapper) GetRunTruncations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunTruncations(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetRunUsage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunUsage(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateNewChat operation middleware
+func (siw *ServerInterfaceWrapper) CreateNewChat(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "run_id" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "run_id", r.PathValue("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateNewChat(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunChatCount operation middleware
+func (siw *ServerInterfaceWrapper) GetRunChatCount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "run_id" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "run_id", r.PathValue("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunChatCount(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRunMessages operation middleware
+func (siw *ServerInterfaceWrapper) GetRunMessages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "run_id" -------------
+	var runId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "run_id", r.PathValue("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "index" -------------
+	var index int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "index", r.PathValue("index"), &index, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "index", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunMessages(w, r, runId, index)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CompareRuns operation middleware
+func (siw *ServerInterfaceWrapper) CompareRuns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CompareRunsParams
+
+	// ------------- Required query parameter "run_id" -------------
+
+	if paramValue := r.URL.Query().Get("run_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "run_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "run_id", r.URL.Query(), &params.RunId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompareRuns(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Search operation middleware
+func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "project" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Search(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHubStats operation middleware
+func (siw *ServerInterfaceWrapper) GetHubStats(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHubStats(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetQueueStats operation middleware
+func (siw *ServerInterfaceWrapper) GetQueueStats(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQueueStats(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisionRequestAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisionRequestAuditLog(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisionRequestAuditLog(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisionRequestChangeRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisionRequestChangeRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisionRequestChangeRequest(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReleaseSupervisionRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReleaseSupervisionRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReleaseSupervisionRequest(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ClaimSupervisionRequest operation middleware
+func (siw *ServerInterfaceWrapper) ClaimSupervisionRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClaimSupervisionRequest(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisionRequestClarifications operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisionRequestClarifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisionRequestClarifications(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisionRequestConsent operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisionRequestConsent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisionRequestConsent(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisionRequestEnsembleVerdicts operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisionRequestEnsembleVerdicts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisionRequestEnsembleVerdicts(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DraftReviewFeedback operation middleware
+func (siw *ServerInterfaceWrapper) DraftReviewFeedback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DraftReviewFeedback(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisionRequestReminders operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisionRequestReminders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisionRequestReminders(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSupervisionRequestReminder operation middleware
+func (siw *ServerInterfaceWrapper) CreateSupervisionRequestReminder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSupervisionRequestReminder(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisionResult operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisionResult(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisionResult(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// CreateSupervisionResult operation middleware
+func (siw *ServerInterfaceWrapper) CreateSupervisionResult(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSupervisionResult(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// GetSupervisionReviewPayload operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisionReviewPayload(w http.ResponseWriter, r *http.Request) {
 
-// GetOpenAPI operation middleware
-func (siw *ServerInterfaceWrapper) GetOpenAPI(w http.ResponseWriter, r *http.Request) {
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSupervisionReviewPayloadParams
+
+	// ------------- Optional query parameter "for_supervisor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "for_supervisor", r.URL.Query(), &params.ForSupervisor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "for_supervisor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "screenshot_diff" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "screenshot_diff", r.URL.Query(), &params.ScreenshotDiff)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "screenshot_diff", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisionReviewPayload(w, r, supervisionRequestId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisionRequestStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisionRequestStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisionRequestId" -------------
+	var supervisionRequestId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisionRequestStatus(w, r, supervisionRequestId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisor operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisor(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisorId" -------------
+	var supervisorId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisorId", r.PathValue("supervisorId"), &supervisorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisorId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisor(w, r, supervisorId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisorCalibration operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisorCalibration(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisorId" -------------
+	var supervisorId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisorId", r.PathValue("supervisorId"), &supervisorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisorId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisorCalibration(w, r, supervisorId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisorCircuitBreaker operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisorCircuitBreaker(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisorId" -------------
+	var supervisorId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisorId", r.PathValue("supervisorId"), &supervisorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisorId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisorCircuitBreaker(w, r, supervisorId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisorPromptVariantReport operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisorPromptVariantReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisorId" -------------
+	var supervisorId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisorId", r.PathValue("supervisorId"), &supervisorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisorId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisorPromptVariantReport(w, r, supervisorId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSupervisorTestCases operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisorTestCases(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisorId" -------------
+	var supervisorId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisorId", r.PathValue("supervisorId"), &supervisorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisorId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupervisorTestCases(w, r, supervisorId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetSupervisorTestCases operation middleware
+func (siw *ServerInterfaceWrapper) SetSupervisorTestCases(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisorId" -------------
+	var supervisorId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisorId", r.PathValue("supervisorId"), &supervisorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisorId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSupervisorTestCases(w, r, supervisorId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RunSupervisorTestCases operation middleware
+func (siw *ServerInterfaceWrapper) RunSupervisorTestCases(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "supervisorId" -------------
+	var supervisorId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisorId", r.PathValue("supervisorId"), &supervisorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisorId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunSupervisorTestCases(w, r, supervisorId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSwaggerDocs operation middleware
+func (siw *ServerInterfaceWrapper) GetSwaggerDocs(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetOpenAPI(w, r)
+		siw.Handler.GetSwaggerDocs(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -502,11 +9867,22 @@ func (siw *ServerInterfaceWrapper) GetOpenAPI(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetProjects operation middleware
-func (siw *ServerInterfaceWrapper) GetProjects(w http.ResponseWriter, r *http.Request) {
+// GetTask operation middleware
+func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProjects(w, r)
+		siw.Handler.GetTask(w, r, taskId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -516,11 +9892,22 @@ func (siw *ServerInterfaceWrapper) GetProjects(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreateProject operation middleware
-func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.Request) {
+// GetTaskRuns operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskRuns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateProject(w, r)
+		siw.Handler.GetTaskRuns(w, r, taskId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -530,22 +9917,22 @@ func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetProject operation middleware
-func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
+// CreateRun operation middleware
+func (siw *ServerInterfaceWrapper) CreateRun(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProject(w, r, projectId)
+		siw.Handler.CreateRun(w, r, taskId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -555,22 +9942,22 @@ func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetSupervisors operation middleware
-func (siw *ServerInterfaceWrapper) GetSupervisors(w http.ResponseWriter, r *http.Request) {
+// ImportRun operation middleware
+func (siw *ServerInterfaceWrapper) ImportRun(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSupervisors(w, r, projectId)
+		siw.Handler.ImportRun(w, r, taskId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -580,22 +9967,22 @@ func (siw *ServerInterfaceWrapper) GetSupervisors(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// CreateSupervisor operation middleware
-func (siw *ServerInterfaceWrapper) CreateSupervisor(w http.ResponseWriter, r *http.Request) {
+// GetTaskSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskSummary(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateSupervisor(w, r, projectId)
+		siw.Handler.GetTaskSummary(w, r, taskId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -605,22 +9992,22 @@ func (siw *ServerInterfaceWrapper) CreateSupervisor(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetProjectTasks operation middleware
-func (siw *ServerInterfaceWrapper) GetProjectTasks(w http.ResponseWriter, r *http.Request) {
+// GetTaskTimeline operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskTimeline(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProjectTasks(w, r, projectId)
+		siw.Handler.GetTaskTimeline(w, r, taskId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -630,22 +10017,22 @@ func (siw *ServerInterfaceWrapper) GetProjectTasks(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTask operation middleware
-func (siw *ServerInterfaceWrapper) CreateTask(w http.ResponseWriter, r *http.Request) {
+// GetTool operation middleware
+func (siw *ServerInterfaceWrapper) GetTool(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "toolId" -------------
+	var toolId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolId", r.PathValue("toolId"), &toolId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTask(w, r, projectId)
+		siw.Handler.GetTool(w, r, toolId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -655,22 +10042,31 @@ func (siw *ServerInterfaceWrapper) CreateTask(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetProjectTools operation middleware
-func (siw *ServerInterfaceWrapper) GetProjectTools(w http.ResponseWriter, r *http.Request) {
+// ReorderChainSupervisors operation middleware
+func (siw *ServerInterfaceWrapper) ReorderChainSupervisors(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "toolId" -------------
+	var toolId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolId", r.PathValue("toolId"), &toolId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "chainId" -------------
+	var chainId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "chainId", r.PathValue("chainId"), &chainId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "chainId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProjectTools(w, r, projectId)
+		siw.Handler.ReorderChainSupervisors(w, r, toolId, chainId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -680,22 +10076,40 @@ func (siw *ServerInterfaceWrapper) GetProjectTools(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetRun operation middleware
-func (siw *ServerInterfaceWrapper) GetRun(w http.ResponseWriter, r *http.Request) {
+// RemoveChainSupervisor operation middleware
+func (siw *ServerInterfaceWrapper) RemoveChainSupervisor(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "runId" -------------
-	var runId openapi_types.UUID
+	// ------------- Path parameter "toolId" -------------
+	var toolId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolId", r.PathValue("toolId"), &toolId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "chainId" -------------
+	var chainId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "chainId", r.PathValue("chainId"), &chainId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "chainId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "supervisorId" -------------
+	var supervisorId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisorId", r.PathValue("supervisorId"), &supervisorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisorId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRun(w, r, runId)
+		siw.Handler.RemoveChainSupervisor(w, r, toolId, chainId, supervisorId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -705,22 +10119,31 @@ func (siw *ServerInterfaceWrapper) GetRun(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateRunResult operation middleware
-func (siw *ServerInterfaceWrapper) UpdateRunResult(w http.ResponseWriter, r *http.Request) {
+// InsertChainSupervisor operation middleware
+func (siw *ServerInterfaceWrapper) InsertChainSupervisor(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "runId" -------------
-	var runId openapi_types.UUID
+	// ------------- Path parameter "toolId" -------------
+	var toolId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolId", r.PathValue("toolId"), &toolId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "chainId" -------------
+	var chainId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "chainId", r.PathValue("chainId"), &chainId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "chainId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateRunResult(w, r, runId)
+		siw.Handler.InsertChainSupervisor(w, r, toolId, chainId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -730,22 +10153,40 @@ func (siw *ServerInterfaceWrapper) UpdateRunResult(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetRunStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetRunStatus(w http.ResponseWriter, r *http.Request) {
+// GetSupervisorChainVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetSupervisorChainVersion(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "runId" -------------
-	var runId openapi_types.UUID
+	// ------------- Path parameter "toolId" -------------
+	var toolId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolId", r.PathValue("toolId"), &toolId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "chainId" -------------
+	var chainId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "chainId", r.PathValue("chainId"), &chainId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "chainId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRunStatus(w, r, runId)
+		siw.Handler.GetSupervisorChainVersion(w, r, toolId, chainId, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -755,22 +10196,22 @@ func (siw *ServerInterfaceWrapper) GetRunStatus(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateRunStatus operation middleware
-func (siw *ServerInterfaceWrapper) UpdateRunStatus(w http.ResponseWriter, r *http.Request) {
+// GetToolSupervisorChains operation middleware
+func (siw *ServerInterfaceWrapper) GetToolSupervisorChains(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "runId" -------------
-	var runId openapi_types.UUID
+	// ------------- Path parameter "toolId" -------------
+	var toolId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolId", r.PathValue("toolId"), &toolId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateRunStatus(w, r, runId)
+		siw.Handler.GetToolSupervisorChains(w, r, toolId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -780,22 +10221,22 @@ func (siw *ServerInterfaceWrapper) UpdateRunStatus(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetRunTools operation middleware
-func (siw *ServerInterfaceWrapper) GetRunTools(w http.ResponseWriter, r *http.Request) {
+// CreateToolSupervisorChains operation middleware
+func (siw *ServerInterfaceWrapper) CreateToolSupervisorChains(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "runId" -------------
-	var runId openapi_types.UUID
+	// ------------- Path parameter "toolId" -------------
+	var toolId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolId", r.PathValue("toolId"), &toolId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRunTools(w, r, runId)
+		siw.Handler.CreateToolSupervisorChains(w, r, toolId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -805,22 +10246,36 @@ func (siw *ServerInterfaceWrapper) GetRunTools(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreateRunTool operation middleware
-func (siw *ServerInterfaceWrapper) CreateRunTool(w http.ResponseWriter, r *http.Request) {
+// BatchDecideToolCalls operation middleware
+func (siw *ServerInterfaceWrapper) BatchDecideToolCalls(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BatchDecideToolCalls(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetToolCall operation middleware
+func (siw *ServerInterfaceWrapper) GetToolCall(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "runId" -------------
-	var runId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "runId", r.PathValue("runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRunTool(w, r, runId)
+		siw.Handler.GetToolCall(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -830,22 +10285,22 @@ func (siw *ServerInterfaceWrapper) CreateRunTool(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// CreateNewChat operation middleware
-func (siw *ServerInterfaceWrapper) CreateNewChat(w http.ResponseWriter, r *http.Request) {
+// GetToolCallArgumentForm operation middleware
+func (siw *ServerInterfaceWrapper) GetToolCallArgumentForm(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "run_id" -------------
-	var runId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "run_id", r.PathValue("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateNewChat(w, r, runId)
+		siw.Handler.GetToolCallArgumentForm(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -855,22 +10310,22 @@ func (siw *ServerInterfaceWrapper) CreateNewChat(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetRunChatCount operation middleware
-func (siw *ServerInterfaceWrapper) GetRunChatCount(w http.ResponseWriter, r *http.Request) {
+// GetToolCallArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) GetToolCallArtifacts(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "run_id" -------------
-	var runId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "run_id", r.PathValue("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRunChatCount(w, r, runId)
+		siw.Handler.GetToolCallArtifacts(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -880,31 +10335,40 @@ func (siw *ServerInterfaceWrapper) GetRunChatCount(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetRunMessages operation middleware
-func (siw *ServerInterfaceWrapper) GetRunMessages(w http.ResponseWriter, r *http.Request) {
+// CreateSupervisionRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateSupervisionRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "run_id" -------------
-	var runId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "run_id", r.PathValue("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "index" -------------
-	var index int
+	// ------------- Path parameter "chainId" -------------
+	var chainId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "index", r.PathValue("index"), &index, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "chainId", r.PathValue("chainId"), &chainId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "index", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "chainId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "supervisorId" -------------
+	var supervisorId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "supervisorId", r.PathValue("supervisorId"), &supervisorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisorId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRunMessages(w, r, runId, index)
+		siw.Handler.CreateSupervisionRequest(w, r, toolCallId, chainId, supervisorId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -914,11 +10378,22 @@ func (siw *ServerInterfaceWrapper) GetRunMessages(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetHubStats operation middleware
-func (siw *ServerInterfaceWrapper) GetHubStats(w http.ResponseWriter, r *http.Request) {
+// GetToolCallDependencies operation middleware
+func (siw *ServerInterfaceWrapper) GetToolCallDependencies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHubStats(w, r)
+		siw.Handler.GetToolCallDependencies(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -928,22 +10403,22 @@ func (siw *ServerInterfaceWrapper) GetHubStats(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetSupervisionResult operation middleware
-func (siw *ServerInterfaceWrapper) GetSupervisionResult(w http.ResponseWriter, r *http.Request) {
+// SetToolCallDependencies operation middleware
+func (siw *ServerInterfaceWrapper) SetToolCallDependencies(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "supervisionRequestId" -------------
-	var supervisionRequestId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSupervisionResult(w, r, supervisionRequestId)
+		siw.Handler.SetToolCallDependencies(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -953,22 +10428,22 @@ func (siw *ServerInterfaceWrapper) GetSupervisionResult(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// CreateSupervisionResult operation middleware
-func (siw *ServerInterfaceWrapper) CreateSupervisionResult(w http.ResponseWriter, r *http.Request) {
+// GetToolCallArgumentDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetToolCallArgumentDiff(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "supervisionRequestId" -------------
-	var supervisionRequestId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateSupervisionResult(w, r, supervisionRequestId)
+		siw.Handler.GetToolCallArgumentDiff(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -978,22 +10453,22 @@ func (siw *ServerInterfaceWrapper) CreateSupervisionResult(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetSupervisionReviewPayload operation middleware
-func (siw *ServerInterfaceWrapper) GetSupervisionReviewPayload(w http.ResponseWriter, r *http.Request) {
+// GetToolCallHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetToolCallHistory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "supervisionRequestId" -------------
-	var supervisionRequestId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSupervisionReviewPayload(w, r, supervisionRequestId)
+		siw.Handler.GetToolCallHistory(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1003,22 +10478,22 @@ func (siw *ServerInterfaceWrapper) GetSupervisionReviewPayload(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// GetSupervisionRequestStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetSupervisionRequestStatus(w http.ResponseWriter, r *http.Request) {
+// GetToolCallResources operation middleware
+func (siw *ServerInterfaceWrapper) GetToolCallResources(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "supervisionRequestId" -------------
-	var supervisionRequestId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "supervisionRequestId", r.PathValue("supervisionRequestId"), &supervisionRequestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisionRequestId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSupervisionRequestStatus(w, r, supervisionRequestId)
+		siw.Handler.GetToolCallResources(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1028,22 +10503,22 @@ func (siw *ServerInterfaceWrapper) GetSupervisionRequestStatus(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// GetSupervisor operation middleware
-func (siw *ServerInterfaceWrapper) GetSupervisor(w http.ResponseWriter, r *http.Request) {
+// GetToolCallResult operation middleware
+func (siw *ServerInterfaceWrapper) GetToolCallResult(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "supervisorId" -------------
-	var supervisorId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "supervisorId", r.PathValue("supervisorId"), &supervisorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisorId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSupervisor(w, r, supervisorId)
+		siw.Handler.GetToolCallResult(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1053,11 +10528,22 @@ func (siw *ServerInterfaceWrapper) GetSupervisor(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetSwaggerDocs operation middleware
-func (siw *ServerInterfaceWrapper) GetSwaggerDocs(w http.ResponseWriter, r *http.Request) {
+// ReportToolCallResult operation middleware
+func (siw *ServerInterfaceWrapper) ReportToolCallResult(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSwaggerDocs(w, r)
+		siw.Handler.ReportToolCallResult(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1067,22 +10553,22 @@ func (siw *ServerInterfaceWrapper) GetSwaggerDocs(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetTask operation middleware
-func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Request) {
+// DecideToolCallResult operation middleware
+func (siw *ServerInterfaceWrapper) DecideToolCallResult(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "taskId" -------------
-	var taskId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTask(w, r, taskId)
+		siw.Handler.DecideToolCallResult(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1092,22 +10578,22 @@ func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// GetTaskRuns operation middleware
-func (siw *ServerInterfaceWrapper) GetTaskRuns(w http.ResponseWriter, r *http.Request) {
+// UploadToolCallScreenshot operation middleware
+func (siw *ServerInterfaceWrapper) UploadToolCallScreenshot(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "taskId" -------------
-	var taskId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTaskRuns(w, r, taskId)
+		siw.Handler.UploadToolCallScreenshot(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1117,22 +10603,22 @@ func (siw *ServerInterfaceWrapper) GetTaskRuns(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreateRun operation middleware
-func (siw *ServerInterfaceWrapper) CreateRun(w http.ResponseWriter, r *http.Request) {
+// GetToolCallState operation middleware
+func (siw *ServerInterfaceWrapper) GetToolCallState(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "taskId" -------------
-	var taskId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRun(w, r, taskId)
+		siw.Handler.GetToolCallState(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1142,22 +10628,22 @@ func (siw *ServerInterfaceWrapper) CreateRun(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetTool operation middleware
-func (siw *ServerInterfaceWrapper) GetTool(w http.ResponseWriter, r *http.Request) {
+// GetToolCallStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetToolCallStatus(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "toolId" -------------
-	var toolId openapi_types.UUID
+	// ------------- Path parameter "toolCallId" -------------
+	var toolCallId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "toolId", r.PathValue("toolId"), &toolId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTool(w, r, toolId)
+		siw.Handler.GetToolCallStatus(w, r, toolCallId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1167,22 +10653,22 @@ func (siw *ServerInterfaceWrapper) GetTool(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// GetToolSupervisorChains operation middleware
-func (siw *ServerInterfaceWrapper) GetToolSupervisorChains(w http.ResponseWriter, r *http.Request) {
+// GetUserQueue operation middleware
+func (siw *ServerInterfaceWrapper) GetUserQueue(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "toolId" -------------
-	var toolId openapi_types.UUID
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "toolId", r.PathValue("toolId"), &toolId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetToolSupervisorChains(w, r, toolId)
+		siw.Handler.GetUserQueue(w, r, userId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1192,22 +10678,25 @@ func (siw *ServerInterfaceWrapper) GetToolSupervisorChains(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// CreateToolSupervisorChains operation middleware
-func (siw *ServerInterfaceWrapper) CreateToolSupervisorChains(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// GetUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetUsers(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "toolId" -------------
-	var toolId openapi_types.UUID
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsers(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "toolId", r.PathValue("toolId"), &toolId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolId", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateToolSupervisorChains(w, r, toolId)
+		siw.Handler.CreateUser(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1217,22 +10706,22 @@ func (siw *ServerInterfaceWrapper) CreateToolSupervisorChains(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// GetToolCall operation middleware
-func (siw *ServerInterfaceWrapper) GetToolCall(w http.ResponseWriter, r *http.Request) {
+// DeleteWatchSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWatchSubscription(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "toolCallId" -------------
-	var toolCallId openapi_types.UUID
+	// ------------- Path parameter "watchSubscriptionId" -------------
+	var watchSubscriptionId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "watchSubscriptionId", r.PathValue("watchSubscriptionId"), &watchSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watchSubscriptionId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetToolCall(w, r, toolCallId)
+		siw.Handler.DeleteWatchSubscription(w, r, watchSubscriptionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1242,40 +10731,47 @@ func (siw *ServerInterfaceWrapper) GetToolCall(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreateSupervisionRequest operation middleware
-func (siw *ServerInterfaceWrapper) CreateSupervisionRequest(w http.ResponseWriter, r *http.Request) {
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "toolCallId" -------------
-	var toolCallId openapi_types.UUID
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", r.PathValue("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "chainId" -------------
-	var chainId openapi_types.UUID
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, webhookId)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "chainId", r.PathValue("chainId"), &chainId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "chainId", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Path parameter "supervisorId" -------------
-	var supervisorId openapi_types.UUID
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "supervisorId", r.PathValue("supervisorId"), &supervisorId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", r.PathValue("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisorId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateSupervisionRequest(w, r, toolCallId, chainId, supervisorId)
+		siw.Handler.UpdateWebhook(w, r, webhookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1285,22 +10781,22 @@ func (siw *ServerInterfaceWrapper) CreateSupervisionRequest(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetToolCallState operation middleware
-func (siw *ServerInterfaceWrapper) GetToolCallState(w http.ResponseWriter, r *http.Request) {
+// GetWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "toolCallId" -------------
-	var toolCallId string
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", r.PathValue("webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetToolCallState(w, r, toolCallId)
+		siw.Handler.GetWebhookDeliveries(w, r, webhookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1310,22 +10806,11 @@ func (siw *ServerInterfaceWrapper) GetToolCallState(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetToolCallStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetToolCallStatus(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "toolCallId" -------------
-	var toolCallId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "toolCallId", r.PathValue("toolCallId"), &toolCallId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "toolCallId", Err: err})
-		return
-	}
+// GetWorkers operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkers(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetToolCallStatus(w, r, toolCallId)
+		siw.Handler.GetWorkers(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1455,41 +10940,230 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	m.HandleFunc("GET "+options.BaseURL+"/admin/diagnostics", wrapper.GetDiagnostics)
+	m.HandleFunc("GET "+options.BaseURL+"/agent/{agentId}", wrapper.GetAgent)
+	m.HandleFunc("GET "+options.BaseURL+"/agent/{agentId}/trust", wrapper.GetAgentTrust)
+	m.HandleFunc("GET "+options.BaseURL+"/api_key", wrapper.GetApiKeys)
+	m.HandleFunc("POST "+options.BaseURL+"/api_key", wrapper.CreateApiKey)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api_key/{apiKeyId}", wrapper.RevokeApiKey)
+	m.HandleFunc("POST "+options.BaseURL+"/api_key/{apiKeyId}/rotate", wrapper.RotateApiKey)
+	m.HandleFunc("GET "+options.BaseURL+"/archives", wrapper.GetArchives)
+	m.HandleFunc("POST "+options.BaseURL+"/archives/{day}", wrapper.ExportArchive)
+	m.HandleFunc("GET "+options.BaseURL+"/archives/{day}/verify", wrapper.VerifyArchive)
+	m.HandleFunc("GET "+options.BaseURL+"/artifact/{artifactId}", wrapper.GetRunArtifact)
+	m.HandleFunc("GET "+options.BaseURL+"/artifact/{artifactId}/content", wrapper.DownloadRunArtifact)
+	m.HandleFunc("GET "+options.BaseURL+"/audit_log/anchors", wrapper.GetAuditAnchors)
+	m.HandleFunc("POST "+options.BaseURL+"/audit_log/anchors", wrapper.AnchorAuditLog)
+	m.HandleFunc("GET "+options.BaseURL+"/audit_log/verify", wrapper.VerifyAuditLog)
+	m.HandleFunc("GET "+options.BaseURL+"/backfill/{backfillId}", wrapper.GetBackfill)
+	m.HandleFunc("POST "+options.BaseURL+"/backfill/{backfillId}/pause", wrapper.PauseBackfill)
+	m.HandleFunc("GET "+options.BaseURL+"/backfill/{backfillId}/report", wrapper.GetBackfillReport)
+	m.HandleFunc("POST "+options.BaseURL+"/backfill/{backfillId}/resume", wrapper.ResumeBackfill)
+	m.HandleFunc("GET "+options.BaseURL+"/capabilities", wrapper.GetCapabilities)
+	m.HandleFunc("POST "+options.BaseURL+"/capabilities", wrapper.NegotiateCapabilities)
+	m.HandleFunc("GET "+options.BaseURL+"/chat_stream/{streamId}", wrapper.GetChatStream)
+	m.HandleFunc("POST "+options.BaseURL+"/chat_stream/{streamId}/chunks", wrapper.AppendChatStreamChunks)
+	m.HandleFunc("POST "+options.BaseURL+"/chat_stream/{streamId}/complete", wrapper.CompleteChatStream)
+	m.HandleFunc("GET "+options.BaseURL+"/circuit_breakers", wrapper.GetCircuitBreakers)
+	m.HandleFunc("POST "+options.BaseURL+"/clarification/{clarificationId}/answer", wrapper.AnswerClarification)
+	m.HandleFunc("POST "+options.BaseURL+"/config/reload", wrapper.ReloadConfig)
+	m.HandleFunc("GET "+options.BaseURL+"/consent/{token}", wrapper.GetConsentPrompt)
+	m.HandleFunc("POST "+options.BaseURL+"/consent/{token}", wrapper.RespondToConsent)
+	m.HandleFunc("GET "+options.BaseURL+"/document/{documentId}", wrapper.GetRunDocument)
+	m.HandleFunc("GET "+options.BaseURL+"/feature_flags", wrapper.GetFeatureFlags)
+	m.HandleFunc("DELETE "+options.BaseURL+"/feature_flags/{flag}", wrapper.DeleteFeatureFlagRollout)
+	m.HandleFunc("PUT "+options.BaseURL+"/feature_flags/{flag}", wrapper.SetFeatureFlagRollout)
+	m.HandleFunc("PUT "+options.BaseURL+"/message/{messageId}/content", wrapper.UpdateMessageContent)
+	m.HandleFunc("GET "+options.BaseURL+"/message/{messageId}/diffs", wrapper.GetMessageDiffs)
+	m.HandleFunc("GET "+options.BaseURL+"/message/{messageId}/translation", wrapper.GetMessageTranslation)
+	m.HandleFunc("GET "+options.BaseURL+"/messages/{locale}", wrapper.GetLocalizedMessages)
+	m.HandleFunc("GET "+options.BaseURL+"/metering_events", wrapper.GetMeteringEvents)
 	m.HandleFunc("GET "+options.BaseURL+"/openapi.yaml", wrapper.GetOpenAPI)
+	m.HandleFunc("GET "+options.BaseURL+"/organization", wrapper.GetOrganizations)
+	m.HandleFunc("POST "+options.BaseURL+"/organization", wrapper.CreateOrganization)
+	m.HandleFunc("GET "+options.BaseURL+"/organization/{organizationId}", wrapper.GetOrganization)
+	m.HandleFunc("GET "+options.BaseURL+"/organization/{organizationId}/kill_switch", wrapper.GetKillSwitch)
+	m.HandleFunc("POST "+options.BaseURL+"/organization/{organizationId}/kill_switch/request", wrapper.RequestKillSwitch)
+	m.HandleFunc("POST "+options.BaseURL+"/organization/{organizationId}/kill_switch/request/{killSwitchRequestId}/confirm", wrapper.ConfirmKillSwitch)
+	m.HandleFunc("GET "+options.BaseURL+"/organization/{organizationId}/quotas", wrapper.GetOrganizationQuotas)
+	m.HandleFunc("PUT "+options.BaseURL+"/organization/{organizationId}/quotas", wrapper.SetOrganizationQuotas)
+	m.HandleFunc("GET "+options.BaseURL+"/organization/{organizationId}/tool_policies", wrapper.GetOrganizationToolPolicies)
+	m.HandleFunc("PUT "+options.BaseURL+"/organization/{organizationId}/tool_policies", wrapper.SetOrganizationToolPolicies)
+	m.HandleFunc("GET "+options.BaseURL+"/plan/{planId}", wrapper.GetPlan)
+	m.HandleFunc("POST "+options.BaseURL+"/plan/{planId}/decision", wrapper.DecidePlan)
+	m.HandleFunc("GET "+options.BaseURL+"/plan/{planId}/deviations", wrapper.GetPlanDeviations)
 	m.HandleFunc("GET "+options.BaseURL+"/project", wrapper.GetProjects)
 	m.HandleFunc("POST "+options.BaseURL+"/project", wrapper.CreateProject)
+	m.HandleFunc("POST "+options.BaseURL+"/project/bootstrap", wrapper.BootstrapProject)
+	m.HandleFunc("POST "+options.BaseURL+"/project/import", wrapper.ImportProjectConfig)
+	m.HandleFunc("GET "+options.BaseURL+"/project/templates", wrapper.GetProjectTemplates)
 	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}", wrapper.GetProject)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/agents", wrapper.GetProjectAgents)
+	m.HandleFunc("POST "+options.BaseURL+"/project/{projectId}/agents", wrapper.RegisterAgent)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/alert_rules", wrapper.GetProjectAlertRules)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/alert_rules", wrapper.SetProjectAlertRules)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/alerts", wrapper.GetProjectAlerts)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/argument_baselines", wrapper.GetProjectArgumentBaselines)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/argument_baselines", wrapper.SetProjectArgumentBaselines)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/argument_rules", wrapper.GetProjectArgumentRules)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/argument_rules", wrapper.SetProjectArgumentRules)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/backfills", wrapper.GetProjectBackfills)
+	m.HandleFunc("POST "+options.BaseURL+"/project/{projectId}/backfills", wrapper.CreateBackfill)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/chat_supervisors", wrapper.GetProjectChatSupervisors)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/chat_supervisors", wrapper.SetProjectChatSupervisors)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/context_window_policies", wrapper.GetContextWindowPolicies)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/context_window_policies", wrapper.SetContextWindowPolicies)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/effective_tool_policies", wrapper.GetProjectEffectiveToolPolicies)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/export", wrapper.ExportProjectConfig)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/feature_flags", wrapper.GetProjectFeatureFlags)
+	m.HandleFunc("DELETE "+options.BaseURL+"/project/{projectId}/incident_mode", wrapper.EndIncidentMode)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/incident_mode", wrapper.GetProjectIncidents)
+	m.HandleFunc("POST "+options.BaseURL+"/project/{projectId}/incident_mode", wrapper.StartIncidentMode)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/ingestion_hooks", wrapper.GetProjectIngestionHooks)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/ingestion_hooks", wrapper.SetProjectIngestionHooks)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/notification_settings", wrapper.GetProjectNotificationSettings)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/notification_settings", wrapper.SetProjectNotificationSettings)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/organization", wrapper.SetProjectOrganization)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/payload_templates", wrapper.GetProjectPayloadTemplates)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/payload_templates", wrapper.SetProjectPayloadTemplates)
+	m.HandleFunc("POST "+options.BaseURL+"/project/{projectId}/payload_templates/preview", wrapper.PreviewPayloadTemplate)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/precedents", wrapper.GetProjectPrecedentChains)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/quota_usage", wrapper.GetProjectQuotaUsage)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/quotas", wrapper.GetProjectQuotas)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/quotas", wrapper.SetProjectQuotas)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/redactors", wrapper.GetProjectRedactors)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/redactors", wrapper.SetProjectRedactors)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/resource_references", wrapper.GetProjectResourceReferences)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/result_supervisors", wrapper.GetProjectResultSupervisors)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/result_supervisors", wrapper.SetProjectResultSupervisors)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/routing_rules", wrapper.GetProjectRoutingRules)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/routing_rules", wrapper.SetProjectRoutingRules)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/run_limits", wrapper.GetProjectRunLimits)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/run_limits", wrapper.SetProjectRunLimits)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/runs/export", wrapper.ExportProjectRuns)
 	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/supervisor", wrapper.GetSupervisors)
 	m.HandleFunc("POST "+options.BaseURL+"/project/{projectId}/supervisor", wrapper.CreateSupervisor)
+	m.HandleFunc("POST "+options.BaseURL+"/project/{projectId}/supervisor_dry_run", wrapper.DryRunSupervisor)
 	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/tasks", wrapper.GetProjectTasks)
 	m.HandleFunc("POST "+options.BaseURL+"/project/{projectId}/tasks", wrapper.CreateTask)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/tool_call_results", wrapper.GetProjectToolCallResults)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/tool_policies", wrapper.GetProjectToolPolicies)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/tool_policies", wrapper.SetProjectToolPolicies)
 	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/tools", wrapper.GetProjectTools)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/trust_policy", wrapper.GetProjectTrustPolicy)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/trust_policy", wrapper.SetProjectTrustPolicy)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/usage", wrapper.GetProjectUsage)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/verdicts", wrapper.GetProjectVerdicts)
+	m.HandleFunc("PUT "+options.BaseURL+"/project/{projectId}/verdicts", wrapper.SetProjectVerdicts)
+	m.HandleFunc("GET "+options.BaseURL+"/project/{projectId}/webhooks", wrapper.GetProjectWebhooks)
+	m.HandleFunc("POST "+options.BaseURL+"/project/{projectId}/webhooks", wrapper.CreateProjectWebhook)
+	m.HandleFunc("GET "+options.BaseURL+"/review_queue/handoff", wrapper.GetHandoffBundles)
+	m.HandleFunc("POST "+options.BaseURL+"/review_queue/handoff", wrapper.CreateHandoffBundle)
+	m.HandleFunc("GET "+options.BaseURL+"/review_queue/handoff/{handoffBundleId}", wrapper.GetHandoffBundle)
+	m.HandleFunc("POST "+options.BaseURL+"/review_queue/handoff/{handoffBundleId}/restore", wrapper.RestoreHandoffBundle)
+	m.HandleFunc("GET "+options.BaseURL+"/review_queue/waiting", wrapper.GetWaitingSupervisionRequests)
+	m.HandleFunc("PUT "+options.BaseURL+"/reviewer/{session}", wrapper.SetReviewer)
+	m.HandleFunc("GET "+options.BaseURL+"/reviewer/{session}/watch_notifications", wrapper.GetWatchNotifications)
+	m.HandleFunc("GET "+options.BaseURL+"/reviewer/{session}/watch_subscriptions", wrapper.GetWatchSubscriptions)
+	m.HandleFunc("POST "+options.BaseURL+"/reviewer/{session}/watch_subscriptions", wrapper.CreateWatchSubscription)
+	m.HandleFunc("GET "+options.BaseURL+"/reviewers", wrapper.GetReviewers)
 	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}", wrapper.GetRun)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/artifacts", wrapper.GetRunArtifacts)
+	m.HandleFunc("POST "+options.BaseURL+"/run/{runId}/artifacts", wrapper.UploadRunArtifact)
+	m.HandleFunc("PUT "+options.BaseURL+"/run/{runId}/autonomy", wrapper.UpdateRunAutonomy)
+	m.HandleFunc("POST "+options.BaseURL+"/run/{runId}/chat_streams", wrapper.CreateChatStream)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/documents", wrapper.GetRunDocuments)
+	m.HandleFunc("POST "+options.BaseURL+"/run/{runId}/documents", wrapper.AttachRunDocument)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/events", wrapper.GetRunEvents)
+	m.HandleFunc("POST "+options.BaseURL+"/run/{runId}/events", wrapper.CreateRunEvent)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/export", wrapper.ExportRun)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/integrity", wrapper.VerifyRunIntegrity)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/limits", wrapper.GetRunLimitUsage)
+	m.HandleFunc("POST "+options.BaseURL+"/run/{runId}/openapi_tools", wrapper.ImportRunOpenApiTools)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/pause", wrapper.GetRunPause)
+	m.HandleFunc("POST "+options.BaseURL+"/run/{runId}/pause", wrapper.PauseRun)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/plans", wrapper.GetRunPlans)
+	m.HandleFunc("POST "+options.BaseURL+"/run/{runId}/plans", wrapper.CreateRunPlan)
+	m.HandleFunc("POST "+options.BaseURL+"/run/{runId}/preapproval", wrapper.PreapproveToolCall)
+	m.HandleFunc("POST "+options.BaseURL+"/run/{runId}/proxy/chat/completions", wrapper.CreateProxyChatCompletion)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/redactions", wrapper.GetRunRedactions)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/replay", wrapper.GetRunReplay)
 	m.HandleFunc("PUT "+options.BaseURL+"/run/{runId}/result", wrapper.UpdateRunResult)
+	m.HandleFunc("POST "+options.BaseURL+"/run/{runId}/resume", wrapper.ResumeRun)
 	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/status", wrapper.GetRunStatus)
 	m.HandleFunc("PUT "+options.BaseURL+"/run/{runId}/status", wrapper.UpdateRunStatus)
 	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/tool", wrapper.GetRunTools)
 	m.HandleFunc("POST "+options.BaseURL+"/run/{runId}/tool", wrapper.CreateRunTool)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/transcript", wrapper.GetRunTranscript)
+	m.HandleFunc("POST "+options.BaseURL+"/run/{runId}/transcript", wrapper.CreateTranscriptSegment)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/truncations", wrapper.GetRunTruncations)
+	m.HandleFunc("GET "+options.BaseURL+"/run/{runId}/usage", wrapper.GetRunUsage)
 	m.HandleFunc("POST "+options.BaseURL+"/run/{run_id}/chat", wrapper.CreateNewChat)
 	m.HandleFunc("GET "+options.BaseURL+"/run/{run_id}/chat_count", wrapper.GetRunChatCount)
 	m.HandleFunc("GET "+options.BaseURL+"/run/{run_id}/messages/{index}", wrapper.GetRunMessages)
+	m.HandleFunc("GET "+options.BaseURL+"/run_comparison", wrapper.CompareRuns)
+	m.HandleFunc("GET "+options.BaseURL+"/search", wrapper.Search)
 	m.HandleFunc("GET "+options.BaseURL+"/stats", wrapper.GetHubStats)
+	m.HandleFunc("GET "+options.BaseURL+"/stats/queues", wrapper.GetQueueStats)
+	m.HandleFunc("GET "+options.BaseURL+"/supervision_request/{supervisionRequestId}/audit_log", wrapper.GetSupervisionRequestAuditLog)
+	m.HandleFunc("GET "+options.BaseURL+"/supervision_request/{supervisionRequestId}/change_request", wrapper.GetSupervisionRequestChangeRequest)
+	m.HandleFunc("DELETE "+options.BaseURL+"/supervision_request/{supervisionRequestId}/claim", wrapper.ReleaseSupervisionRequest)
+	m.HandleFunc("POST "+options.BaseURL+"/supervision_request/{supervisionRequestId}/claim", wrapper.ClaimSupervisionRequest)
+	m.HandleFunc("GET "+options.BaseURL+"/supervision_request/{supervisionRequestId}/clarifications", wrapper.GetSupervisionRequestClarifications)
+	m.HandleFunc("GET "+options.BaseURL+"/supervision_request/{supervisionRequestId}/consent", wrapper.GetSupervisionRequestConsent)
+	m.HandleFunc("GET "+options.BaseURL+"/supervision_request/{supervisionRequestId}/ensemble_verdicts", wrapper.GetSupervisionRequestEnsembleVerdicts)
+	m.HandleFunc("POST "+options.BaseURL+"/supervision_request/{supervisionRequestId}/feedback_draft", wrapper.DraftReviewFeedback)
+	m.HandleFunc("GET "+options.BaseURL+"/supervision_request/{supervisionRequestId}/reminders", wrapper.GetSupervisionRequestReminders)
+	m.HandleFunc("POST "+options.BaseURL+"/supervision_request/{supervisionRequestId}/reminders", wrapper.CreateSupervisionRequestReminder)
 	m.HandleFunc("GET "+options.BaseURL+"/supervision_request/{supervisionRequestId}/result", wrapper.GetSupervisionResult)
 	m.HandleFunc("POST "+options.BaseURL+"/supervision_request/{supervisionRequestId}/result", wrapper.CreateSupervisionResult)
 	m.HandleFunc("GET "+options.BaseURL+"/supervision_request/{supervisionRequestId}/review_payload", wrapper.GetSupervisionReviewPayload)
 	m.HandleFunc("GET "+options.BaseURL+"/supervision_request/{supervisionRequestId}/status", wrapper.GetSupervisionRequestStatus)
 	m.HandleFunc("GET "+options.BaseURL+"/supervisor/{supervisorId}", wrapper.GetSupervisor)
+	m.HandleFunc("GET "+options.BaseURL+"/supervisor/{supervisorId}/calibration", wrapper.GetSupervisorCalibration)
+	m.HandleFunc("GET "+options.BaseURL+"/supervisor/{supervisorId}/circuit_breaker", wrapper.GetSupervisorCircuitBreaker)
+	m.HandleFunc("GET "+options.BaseURL+"/supervisor/{supervisorId}/prompt_variants/report", wrapper.GetSupervisorPromptVariantReport)
+	m.HandleFunc("GET "+options.BaseURL+"/supervisor/{supervisorId}/test_cases", wrapper.GetSupervisorTestCases)
+	m.HandleFunc("PUT "+options.BaseURL+"/supervisor/{supervisorId}/test_cases", wrapper.SetSupervisorTestCases)
+	m.HandleFunc("POST "+options.BaseURL+"/supervisor/{supervisorId}/test_cases/run", wrapper.RunSupervisorTestCases)
 	m.HandleFunc("GET "+options.BaseURL+"/swagger-ui", wrapper.GetSwaggerDocs)
 	m.HandleFunc("GET "+options.BaseURL+"/task/{taskId}", wrapper.GetTask)
 	m.HandleFunc("GET "+options.BaseURL+"/task/{taskId}/run", wrapper.GetTaskRuns)
 	m.HandleFunc("POST "+options.BaseURL+"/task/{taskId}/run", wrapper.CreateRun)
+	m.HandleFunc("POST "+options.BaseURL+"/task/{taskId}/run/import", wrapper.ImportRun)
+	m.HandleFunc("GET "+options.BaseURL+"/task/{taskId}/summary", wrapper.GetTaskSummary)
+	m.HandleFunc("GET "+options.BaseURL+"/task/{taskId}/timeline", wrapper.GetTaskTimeline)
 	m.HandleFunc("GET "+options.BaseURL+"/tool/{toolId}", wrapper.GetTool)
+	m.HandleFunc("PUT "+options.BaseURL+"/tool/{toolId}/chain/{chainId}/order", wrapper.ReorderChainSupervisors)
+	m.HandleFunc("DELETE "+options.BaseURL+"/tool/{toolId}/chain/{chainId}/supervisor/{supervisorId}", wrapper.RemoveChainSupervisor)
+	m.HandleFunc("POST "+options.BaseURL+"/tool/{toolId}/chain/{chainId}/supervisors", wrapper.InsertChainSupervisor)
+	m.HandleFunc("GET "+options.BaseURL+"/tool/{toolId}/chain/{chainId}/version/{version}", wrapper.GetSupervisorChainVersion)
 	m.HandleFunc("GET "+options.BaseURL+"/tool/{toolId}/supervisors", wrapper.GetToolSupervisorChains)
 	m.HandleFunc("POST "+options.BaseURL+"/tool/{toolId}/supervisors", wrapper.CreateToolSupervisorChains)
+	m.HandleFunc("POST "+options.BaseURL+"/tool_call/decisions:batch", wrapper.BatchDecideToolCalls)
 	m.HandleFunc("GET "+options.BaseURL+"/tool_call/{toolCallId}", wrapper.GetToolCall)
+	m.HandleFunc("GET "+options.BaseURL+"/tool_call/{toolCallId}/argument_form", wrapper.GetToolCallArgumentForm)
+	m.HandleFunc("GET "+options.BaseURL+"/tool_call/{toolCallId}/artifacts", wrapper.GetToolCallArtifacts)
 	m.HandleFunc("POST "+options.BaseURL+"/tool_call/{toolCallId}/chain/{chainId}/supervisor/{supervisorId}/supervision_request", wrapper.CreateSupervisionRequest)
+	m.HandleFunc("GET "+options.BaseURL+"/tool_call/{toolCallId}/dependencies", wrapper.GetToolCallDependencies)
+	m.HandleFunc("PUT "+options.BaseURL+"/tool_call/{toolCallId}/dependencies", wrapper.SetToolCallDependencies)
+	m.HandleFunc("GET "+options.BaseURL+"/tool_call/{toolCallId}/diff", wrapper.GetToolCallArgumentDiff)
+	m.HandleFunc("GET "+options.BaseURL+"/tool_call/{toolCallId}/history", wrapper.GetToolCallHistory)
+	m.HandleFunc("GET "+options.BaseURL+"/tool_call/{toolCallId}/resources", wrapper.GetToolCallResources)
+	m.HandleFunc("GET "+options.BaseURL+"/tool_call/{toolCallId}/result", wrapper.GetToolCallResult)
+	m.HandleFunc("POST "+options.BaseURL+"/tool_call/{toolCallId}/result", wrapper.ReportToolCallResult)
+	m.HandleFunc("POST "+options.BaseURL+"/tool_call/{toolCallId}/result/verdict", wrapper.DecideToolCallResult)
+	m.HandleFunc("POST "+options.BaseURL+"/tool_call/{toolCallId}/screenshot", wrapper.UploadToolCallScreenshot)
 	m.HandleFunc("GET "+options.BaseURL+"/tool_call/{toolCallId}/state", wrapper.GetToolCallState)
 	m.HandleFunc("GET "+options.BaseURL+"/tool_call/{toolCallId}/status", wrapper.GetToolCallStatus)
+	m.HandleFunc("GET "+options.BaseURL+"/user/{userId}/queue", wrapper.GetUserQueue)
+	m.HandleFunc("GET "+options.BaseURL+"/users", wrapper.GetUsers)
+	m.HandleFunc("POST "+options.BaseURL+"/users", wrapper.CreateUser)
+	m.HandleFunc("DELETE "+options.BaseURL+"/watch_subscription/{watchSubscriptionId}", wrapper.DeleteWatchSubscription)
+	m.HandleFunc("DELETE "+options.BaseURL+"/webhook/{webhookId}", wrapper.DeleteWebhook)
+	m.HandleFunc("PUT "+options.BaseURL+"/webhook/{webhookId}", wrapper.UpdateWebhook)
+	m.HandleFunc("GET "+options.BaseURL+"/webhook/{webhookId}/deliveries", wrapper.GetWebhookDeliveries)
+	m.HandleFunc("GET "+options.BaseURL+"/workers", wrapper.GetWorkers)
 
 	return m
 }
@@ -1497,60 +11171,851 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RcW2/cNvb/KgT//0fVk3SDPvitdYqtF00a2Om+FMGAHh2P2WhIhaTsGAN/9wVvEiVR",
-	"EjWekb3Yl8S2eDnnd+6HlPZ4w3clZ8CUxOd7LDd3sCPmx5+lAsFpfnFHlP49B7kRtFSUM3yOP98BEuQB",
-	"3fz0DgHb8Bxy9K/rPz4ifouUfgbfKpAKEZYjAbLkTALKiSJIAlMrARug95CjW8F3ZsLvv384wxkuBS9B",
-	"KAqGBrfKWk/Uv99ysdPU4Bsi4ad3OMPqsQR8jqUSlG3xU4b9ZulzzKRvFRWQ4/O/2nt21/tSz+Y3f8NG",
-	"6R0boDjdgN6yzQRxz9c017/2KL6ljMq7tQAiNbR7DKzaaUqk4iXOcAFsq+5whm8rttHwrzekKDQfnBfm",
-	"Z4kzvOFMAVPrW1ooEDhjVVF8ieBDWQ7fAzooU7AFoR/tQEqyNRz8v4BbfI7/b9Wox8rpxsrz+8EN7wIY",
-	"8uv3axbv8juG6IeGoDakjtkonBsBREG+tlpbSz8nCn5QdAcxpfG6Mk/HHUvIEi4RZYgqibigW8pIgfTe",
-	"mt9ppbWaUQ+sKgNdX7d5AS0FeZQK9BaV1CLHREoqFWEqUBanJ+apRRXH1CLQpfM9pgp2MlUPPnNeXGiN",
-	"fKrXJUKQx+b38XWclD/roT1j1BzXyj2qLDUZfQMU22rnPVxbxD/7R1p4RrZOCBGINDpDNnyI0iUKnZEd",
-	"RPc0IktapAOqHeJmu8GBwsRAvrgjlP36HTaVBa5nkPr5OpGjE4KluQrkdCAufoWs4atF9TRC14ooGIBp",
-	"yh6uqxLEPZVcmDUNYoYMCPEfW6EjracMS7emjh4uwKUb+nUz+crOtez17L2Dp+V2YPM+U4Oouk37cMoa",
-	"qTXNo9YtyKP21s1AdPleIsWRlSYyNEj0QE2ArdGY1rMu3zHK1aUlqqcDKtlSTErhmUsSls1C9M4J4lFe",
-	"y+tt4kLwS0aYcTOjHsoFyKHHdcyZxaD382ksevJaxHS3jjH9HjZGY8N4S8pS8HswWaEZl2EFYkeZtoYM",
-	"73hObx9xhkFuSKH/Fou0vwrBxZXLKvuI5qAItVG4NxX01MiTDtd2WIyp36obbboylqRKumWQrwXcU3iw",
-	"f8tzqi2JFJ9aY/uZY2+j7nLrDa9a+Vow+aaSj+tNQX2E7o/QWlCASltuwxmDjR48uuatABgfUQLLKdum",
-	"7GmHrHOqJXJT++iDAeyqcY+lDH+roArElelqQbT+0OKwA3NfQjjOxTD4QwANCj+mkC73u0pObGMWFSaQ",
-	"wSIKvhtqqpxy7dx3tvww/68rUUTX+iS4oa3v6V4gwxOVDpiyKtRakW3bRU6HpFCDzBatHCaz6U53i5iQ",
-	"rowYP5HHgpO8H2UvOFMmipKiMHURZZZlyhliALpsuuUCEXRX7QhDVitA6DC8I18BERRkCCj3jjeL5pjS",
-	"Z1bpCVCdrbgQIOOlnn+qSwHTwqhYmBPMKon7lZAGmubxnS/f122TSu9NpQMJUamhC4vIISWKJFnz87ow",
-	"hZ5f/nV0LkZR1pJisFkNUCClqC5WbFHbtLYRNTjNQzWdPNtRGlgivz6jLnGzJwuRq2qyUJuTTUYNqavc",
-	"s6E4lo4F+uM4q4kZwKauzJL4b4EZYfy6ZtxHHRcUw8CpzZfQwvzgY6OWKN0Br+IhLWKacVHW5VNqSZE4",
-	"rOSS2mXZuq5a+3lLouAbbhodaBVv842iPT1GcEwBhkrZHriN3Sd7UDPhOJg804knOeIRM+mzdRSXmwcl",
-	"1RhLdek1x00TyZn+Jeqp+wCsl2goDezbycOChKdhY0Isjd85ViQ82LbHlPfwcOc2n4x2TbMsUtMqW8e0",
-	"iq5m6obncLwThDCp25+g2ZvQSG+wiPbSXQ0QUtrRRNcJNsBkIXzjyF/4+PCMnnDjzuf3JblIa0Va2wt3",
-	"GufLF5T9hF1PavcVz9CFKa2b2WgHhEmk7ogyqX1Y41CJcs4A2XIcSZqDOSk140Dcg9BDdiCgeHTlFORn",
-	"6A91ByJsZmo6JCIC0B1heQG5m60XzBCcbc/Qb7rmilPlC7IHWhS+4AjPbu8pMb/7LAz9eXmGszrTscSv",
-	"G3Jwhs2C7T8xHv4eS3Y+E/n1WBHmtFZY2u7A4W4tWCCLFOUxfdS57/F825EQoltmuk1tMlKbExMtj0PQ",
-	"ravHqJcLyHTYDCHtO8s9wMOO8dRxXJ/Ubn/PHMTf8r53+bc2Xs7QW98RqI3v50+XpmhQhV6p8+d7Ow2f",
-	"4/u3Z2/O3miCeAmMlBSf43+cvTl7q7WPqDvDzMo9O3skO6NcWzBWphk2fZvLHJ/jf4L6owRmd/D3IMz8",
-	"H9+86ZPuxiLrng3bstrtiHi0axl2OoN0XbuVWoB6ly96zqpsWnBDZLkunRygK7geQMqyoBszefW3u1/h",
-	"9k4NM74l2I8xXXvCv1OptOBKT18fBFIUzeOGfb/JF1t7Rdi+MG7Cj8v8PZVfeP44i+e2Wi/Xe5zuNLYn",
-	"KlHB0zPlO+VDegJ08KJbXrFcc/bjm7fL7OiigN7z3Uwux5S3fdoU2f8XkvuA39FWq3CIIAYPXmWjGhsY",
-	"7WrvfrjMnxIM+Ln2m2S2g5hbrN8th7WXNeO1hvX9wyjU2ocLsgMFOk/+a4914m38ug9957iWAO6aUzZD",
-	"Ub8MSHUlWxXXkICvgyx7CSc9WgsM+umwFoi7atniwwsk2G1RmYzHhusw7T40PKSCnOKsT+w6g6rmlXvP",
-	"VkEUVaIha1NEfpUJnvSzGbeEpZlqbYaNWQ5epastCkudO57sO17D6yuycEPPsVK/qVJwIDWMZXeH5XIn",
-	"dg8arMYxDBunsqB2ZD5okJwXSQZpxi1ikJwXswzSUDZgDvrZsDnonRZPQkTFVntRsYmU8qpip0wn9fIR",
-	"UM2fF/ZtVxWbSCHt1QUvNk1jmtQMykeV2Ko5SFtk+wyXVUQ//ixzouCq8udZx/Khg9cDng73iH1h211e",
-	"YZJjYbUKZy7R6h89wm316+pFc7o0YtDX/hToZGbtj6/iVibrw61XZeDmmMCQpv35i5r7lL0FEjxBPRII",
-	"b8q03sVNy8FYGXrzuHqnwN1Vb+W69iPK/d+fIbSBmJEdHEfxRhJlB+/R/PzJjltOc46ScvKbciZy/I7s",
-	"tJpGEnnOi8M7sgfvmFI6WMoGbSH0CmuaP6027mXaJBOxB1ons5GP8GDe7T2Nb269PrywIvl3byKS/QgP",
-	"SAsBudt5lLPXkVe9ohSjpeJdsPzlAPPiNmEIvlOpKNtORsVa/5sXKUaCoxbhhXuf4Fl60r3XFFGJancD",
-	"Qsc9wyswJSjYEOevgXfw0XSZZ2x46rOysmdbfg94f6l6tTcvZE9V0h/8Hewl0pPJ2/Nx9a3vib/G9Lx+",
-	"meDFdSGLLuxfyx9et2c4Rqmkf31sSHnqV8xO6N/rPSLC+a26QZbIpd35JbsnBR0qlbVi3NW0BacA5nfr",
-	"KiM3KFd72bvg226rTJ3ANZd4T1lG9y9C9wGqS9fgJpxrbVgraT8YQpFEFogcq5hXipOMLIbwEud0bcmc",
-	"7riuI5RXcmoXSH8i056lL0OKMNu+zLuQZfPSW5KdhS/KnbIZ3dooFqLsbU5Hfp3LRA1s4eh53adhMpqK",
-	"PjvzpP8ybmCmzk23ROOvr5y4Q9p/USXVtVvh+ibqpCNvDX+9kuSiESAXE6dSnTsZJ5YRF+P3JEaFMHw5",
-	"YQ7mGpEjYP1AtlsQP1R0FFw76j3fyKQbqm48+vNywM8EA2I3UxWRX1d7/e+E1Otz+lN1yswdjPiRd1TG",
-	"8TPuFLlabp8v0RZ2ujadwu+qYsuUn+4IN7U5LjRd8d64fuSCUwfw9IrvGHhP9sbx0kmfLpkT+qmiYmP4",
-	"GT3ivFjt9b9TNuj7/y/QrV48qTIN6/E7Ae4rcAec1liwj+ACQtGtOm98jYmx86rZ0jdK689wpbqI4KtS",
-	"OqxQMe+iqTcBzosMUWaXCz5Md2iIPoYcJ+6nDQnrsOI2/RMF4fcrekI6bsF78EfCeupi8Rn3i64FX6tT",
-	"XE3GbpXWLy5Z07MvOE16zgv3UY5Tec/Ilx0GTuHclyWXd6d65wSfivzXWAPHajhKN0ork+M42L6oV0Z/",
-	"VnvzX9vzdgqZ1cD3BxbjIt6rdoSfYOXjFS0zOn6+U3Hylp9voL62np+t8/8XT10/Tp24xhoi7W4XFzol",
-	"IC4p4GzAC/WbnwPOof6e1lQ0uHafbDrlDdvgWztjTtnSPHwvDuw9reW88xxvPN3lCxF/qduPQewdi3v9",
-	"bt1LhT9Npf32gdlq3lvVlSjwOV6Rkq7u3+KnL0//CQAA//+5tfBfGF8AAA==",
+	"H4sIAAAAAAAC/+z9+5PcNpIvjv4riL7fCO2eS7dke2buXZ+4P7QleawztqTplsdnYnuiAlVEVWGaBZQJ",
+	"sFs1Dv/vN/IBECTBKla/vbu/2OoiiUcikUjk45O/nizsZmuNMt6dfPPriVus1UbiP89Wynj4R6ncotZb",
+	"r605+ebkTNRqpZ1XtSrFvNFVKexSSCMkvH8qzhvjhF9LL2q1VLUyCxWfioU0wppqF9sQfq2Et7ZyQntR",
+	"qkUla+UKIU0ptHf4SGxtpRdaOSG322onrBHebqFX+Hhb23+qhX/hTi/NSXGyre1W1V4rnMNCbuVcVzr8",
+	"rb3a4D/8bqtOvjlxvtZmdfJbEX6QdS138PeiVtKrciaRBEtbb+BfJ6X06guvN+qkGLahy867TaPL3GtG",
+	"blR2DDyV2cR2gDazQJvhQn3kJ2Jpgcza0WoV4matF2tRq20lF6pLQyL1Dj+RRPzGVMo5fM3WK2n0vyR0",
+	"ICq7uFKwSCdFS9b/p1bLk29O/l8vW656ySz18pO1FY5pl6M38sBwEu/lRrmw1PhOMhWxkTvROFUIW4v/",
+	"RYM2O3wtHdTBtb5WtcPuBu/+VpzU6pdG16o8+eY/T3AdklXitWxbKLocF6bVX6sOe/0jDsjOoWEYEe49",
+	"INinunHIgV2+xt00lU/kdlvba1nNaulVl5ttM68SVjbNZq7q9JuUgNp4teLHjbfGbnazSl2r6tDKn/Hb",
+	"P+DLsLmscWrReH2tZp2eeqImPBJOG2bVSjovagWEIoIPBxefjgwe12J0Ezbb8siN32OSuDZpTylFOyMc",
+	"I0Z/2ToDy7JMpeoMp5TKS03ElWWpoVNZfUxe8XWjMs0tdU193bf0u1K74Ur/DOcFLK+EWQiNQquIm/6F",
+	"E0BF+FEYdTOD3/CIgBecl7UPIuJGm9Le4ItAttliLc1KnYozUTeVEjArJyww01bV4krt6NQYjlKb8iBb",
+	"w1jPm0r9BV7+rTjZKOfk6l5kO4x2jEcPCqX2Y54IUb0dYBHZIlnoUab6UflaL4aLFrkYORTXQ7mFrGTy",
+	"W0271q3hX6An8Aq9cHDYaxCaUVuA1lQJspybUWUR35pd26rZKGANaJAkFbQYm8GFVKbZAFG6Y4MH3ZEh",
+	"CTotJ/NvlyEu8XBjLeXC23pIle/tjdg0i7WQKQci+71wYoO0FGsJqo3gZ/NdIb5CnkWBrM3qVHyHzTsx",
+	"V5W9EV/yxrhZK4Pz53bK2m5dIV6d/hE/X8vqGr5GUkyQ8rfk8sAOBz9jzoGPtJnFlRoS7U14FBlEGKVK",
+	"x4pIn5BIO7vZAlNpX4gvX4n5TpRqKZvKn4oPoGEClZSsK63qbpN+rTZE7C4DFMJZIig0b2zCoNAPLgCw",
+	"pyHybrTRG2C2L3NnUNi63Wn+ZPQvDQgpv9Ym1bxG1TtoJ0OvT6gJyVYYIlVupF+slSuEulY16UFCL0Vj",
+	"nPJHKUREr5lTC2vKTPc/KLPy667Mdbl14kVyhfj6T6/SRUoJ+KdXQwr2ZFwqzEblVGTSwXjbMwPec7SN",
+	"WL/VTixkValSdJeE1WY8M5wXcOqddibYbYs3ZCLieHeXMGu/JoK8cILkhljWdpOeWHO1tMjNpx05FkZ+",
+	"Upwkfedl1Vb/Re2Gguo2Nxn1eatr5R7i/AcFbta4Iwe0586klvpzZofElVusZS0XXtXxHnGldgXsca+q",
+	"Cv6Ai6Wss5uwe2wPu+DnoVngpkpvtFel8PZU/AUah+1uGy+sUXgBrpVcrHmP8venJ8VhytXq2l4dSbfa",
+	"elx8b/PjhzHjhQoGdyOd4A+ENt5OGZRb2G3vbr33WEAmvYCPcoKncaoepTU8jISGc9GsHFL5HMaszSo+",
+	"vFJqiwYFv1a6dhOom9OpWOgwh8WpHr68JXPMa7rSiLOP73CocIUt7SkwRflNDbYTWVUgTYk/4GfSg61f",
+	"Awsj7+AruGQgEYGtb2rt1WlHAeL2TooTfNj9oz2LixNZbrT5xjVbVV9rZ+v2N+ZOl5c39WKtr1V+rSQ9",
+	"JHn406fXopS7U/HOO7HUlaIT9f9cfHgvKm2UE40pVR0+ci///ve///2LH3/84s2bl0Eqz5vFlfIFbiYQ",
+	"t9LopXL+9J/OGpy9V4Yuh6hNVtp5JhZ0+MKJWi1sXYqFbYwvhNP/Io314vuzL776459yxqNSZm4qPBcY",
+	"VjtKOCs2I3LU1scK38oupGd7RJ95FCvUTKoXLlICdy8TItdqeG/m1vKrP/4po7iqz4EaQVDGtiXupgM9",
+	"EIVzRpyorPMrYVHbWSBXjNzmvdRm1hivqwyv6Y0S+IzNWi2vvHCC9iSaqlgmJL3SETxXIDjCUY1aYaW8",
+	"Kk+KSavVkxvAMskCDqneUqk3sy6vZMUKDfs7XakLL32TIbQ2Xi6SWwJQFe4aa4U6rfYO/wrkD4MrxEY7",
+	"B3SIXxIJRWmVMy+8WMtrBRwAO0ZWZPvFd7VP2nd2o0CzXQlVOdXRY2hkqPVhTyfFCbezT7bAXP+mar3U",
+	"7Y7o7lFubnYE7zFv8yamf3o5l06R6IiESyd/sk/JHx6KcX32noWDBR1Re7m5YjDbPWzyI69tXjx3xSfb",
+	"7+nDU3HWlNrD+WM8X33oCWrIi7XURti6xGuVX9MRK5z6pWFTf8kcgfcpICZ9AprPHP5QaDeWi9q6zn7E",
+	"lUmMYbBCWaO+hPHNULmbhX470lUb/6c/ZFeMPkUVFAaZXbzknVu1vq3VtbaNG++BD5bB7yQEJ6tS3YUG",
+	"NsqpVDTu2dDGnQx8pYyq72b17HVTsCjstBxmOIFtcTaD3T7fefrHhMUY3ZyJqBh+1R6O+6fLO7MV5jS0",
+	"2MCeKe4XaLDQlfJZzVH5NWvA7cFsStYUUWShQYQOAXhibE7qwUutGOZhzq2tlDT3zp4DEZ5h0XhI0tAn",
+	"Tr09d+Bn+IsnG86m9KwH1SUcsNlJX+MY77IDiOHj+g2nFSjY7SzPKatmo4z/VjoFCnLGNcJvOHFl7I0B",
+	"KsyVcHKpEt9d6+q71upG1U44pVALAIuHC9aZEgX5UMyGLsY0/DACbUiVJ5oVotJXcJRax+o/DAV7zCmN",
+	"nYaH674TvtOXrGmWBU4zTmyv/ezwbu74aeK0963Ma7LDDLdvU9dZtznZI1RVvnDiWlaNIuWDrU+gYAMN",
+	"CzLWCb0M+natNvZaZa/ednt4D6aj/bCFr7bSr3NefVzCrdUGvfKW1SBVlbygL2u10FuN7b86FW83W79L",
+	"91lYoVIvl6qGCUlxs7aV4u/xVaVxH2vUq6QJCroNP13LSpc4lBG/TDhcJxNYCfKjqZIIbWsx5101TnRZ",
+	"lnmSO7Ua2RJn4gaul+gPRRpEp/WNpfE44llqjJ0efO+Y6kJ/o5fLCxrCUI72eBrXGZnkMB9/QE4KunqY",
+	"fct6YZh5VZ1bsobciznaeOXQRWcNL1JPMrxwLQediu/gDaZQcljB2gWTc23B3LPbqmimhV0oPfpQgJM2",
+	"SpEqvwjjyqmS4aPJGyk09iF8eJcdJTdgjIBpZTdXmFki/eKmOs1xJ7LZHucqUV73BP+poDsSOVsq5dzM",
+	"r6Up6J+2nqlfGlkVYoVWrxofonYRfmhfkaJWq6aSNZy1tXKgCmKrG/JMnIrvbC3wZccKip/xn9q/6A2M",
+	"nXw8bfoDv8KH0uzorolswhKFdxfZK2h3t9PjG8hKXysT9n7so92ZaG7bvaiVqJVvaqNKeJkfjogl2uB5",
+	"oUTPgPVndhlm6JIFgekwS+C7SDGiSiFMd/DpmI9w6YzJBmbivRJiwPJZl6dsuQs2uypPYed5qQ39ECnI",
+	"+olr5mGtwKYAw+RHRsCsiACwbZAmp0J9RpMeRo9Rgx2WhnNFgcIjvbpWNS4/fdmxQxBZT4qTlvNOipPI",
+	"9OHfgaVPipOU7ZM/kzcwAMHNWIlSpoz/DhRAZRB3AFAdGQENPjCjvUIVBH7mGiSddlNFFjTxLX7wWxDk",
+	"+05PFrt0ihfxih8eggxHFkG1T1bbtZwrrxeyIpvA1JOsp0dlLgXxGo3KGRwSo46B7gk/VBg7UqWAcx7e",
+	"QSoC68SeQsTNFL9Hf1TH+Rc6X7fLsm8ftus44lMYHKTDuZ8O58p7R1QSz2jSkdpwu6BE1Y1pyRx9lQXq",
+	"4rOoUHVJn8SJStfeTdKmYZcGF5j4CbWwoFLWYBY2pDB293BuvTrj2LulULnIHdc9vaRLyfY8ERfIwoI+",
+	"nyuHdIj7hBeBjuKBR0Ft/TovP0ulthy1EGWaQUFaiFdIt3YHdsns12rjVHU9Yj/vXbAGdCGq7jm4OkNC",
+	"zxM6N9EsGjcT7Wt2u8CIEkEwapTKd0tNvXB8n0z88K3qpDZSoy6/35Ei56o60InXvlL9PmyNFmxccww8",
+	"28hSoS9OzqtsV/dyqxpxQM8rtckzTZ/hosl6Sb5Pnib3Ra4ONPZaMqfstqoAHSw8MiqwF3BF1FwwBIel",
+	"F7MCfVCppRe28Zcj/qAg8PbZc/gK2OEybUJ/jgKMh/Ya+iW3tOkmhbfClFKy0yALwfsEpwi8WQiFqneX",
+	"qwNVndztUf/yo+ksTzqSzO0zLKeolLzGqQNxDx4mrM0Rt/PLyRsFi519h8t3tt4M9QxV17aeYpVZ2KYq",
+	"gUJz2iUwt5R+QVQy9VuOa+/7ObKSxNurrPSlYUE+X5rhCxdey+oqqHkuLUu0+S7Vc0j00hF1aRJhNkmr",
+	"oTNmJMr9CK2hOGkM2vdmsMYZSqTiJZpCIzFetCrdgJfDmtz+EtHTYXix+kPex3UhsDIX9U1yh8I4g72y",
+	"tRncoHWxZUC87ZMdPN73ixh4I91ViA5JohygObSFgnvKQYhwmwZRNyFIwdea+KBlmSQoDBQv1uwxXLBU",
+	"+TQU6CKrvmKoIn3ZKkYoA2LWBn4cpAT8yvMkR1yrqk3RWiNxjrHj9+07FM75jj7+csjkIbbkoDUrvLfP",
+	"W9Ox4g7FADyO0XWYH6TRJ5CkhLTBkAclKZuAU3NwQrFkZlmudk6vDJDqwtfSq9Vu7Ka8bjbSJKz4wrEl",
+	"m92t2BApWQtrDIVF0xuqFo7sKhRXJiDfZKE9xLHXtjHlrLZzbYSXV0CHpjYgdBU4MysrS1WKrV5csUSg",
+	"hpI7nrpRznNPaKC5NO5KV9UMeTz5FFsU3GKnHSnwCyE3lrccK9MLIImtd8LWl4b/gLWS3td63niwDp1H",
+	"RwUoksG1DO3FkBH+65cGFnUra7lRXgW74KX5Wc0vLEUKceISGKsgmEl4uVqpMjSajvlC+dDzqfg5CA3a",
+	"2CA4+GUmBv0eV8SJlYWVgswjfjH2zbw1S4monXDKn4o3FAgLzHpp0hU6FT8HIwZOmJmpINNHd/UTinTz",
+	"uGrbeG1Wl4YkGQ+Eb4TG6VLVquxeqxL2QTNIO6KT4iSZQf525byqrS5fr8fU+lreiPmf/iCUWVjgGjy6",
+	"WHzB8II3s1Zua42jqAzhlPGgIyuMP4hBsz/88OPpQMq2l4p9UgdG+B29ybsfXHTQWdoG2FhU6llO1Voa",
+	"4PRvelKm02e/vbxkCcS1epFxOkl+zidMRo8y2q1ntZKOpHJYcuftFtcawrnh/GgMJU0Eb1044jlPySsD",
+	"gReVV/VJYZqqyrGCNqX6nPeuJwkye48cns+P/HqfgOl8Q39t4/357qPoj+2A+m54nGyWnLcJqA68cty+",
+	"4CmlJjftQTHSK21kFcIOJzDt5OBss2qYIN2hvrv4IP709X988aWAYYYBlsrT6RQ+7I+c6ViIy5PGlJcn",
+	"7GTDCwPfA7CReqONGol6LmWbzTcWD8n9sMsUvkjtVPiz87ae7mo750YutjIbs1DbSnW20s55tHo0TtUn",
+	"xQkc4s5L45NtxTsKnxL/ZWVpsusmK2ncHuSFvIa9mxlxuDHva4f3w6fddrjrcMZRDOzdVnEYQ1E1HlRw",
+	"NhJQkNVj2yvUvWzPu2ZuT2PSOHlxAz/1+RTcTvTkflkV+ek2Vuo2h7V9OeHmLAc0pfZni2BtDLsjplqF",
+	"CJ00/25hzbLSGCBDKtUsaMDtL7VKfkNdxN1ov1jP+DAd/A7LcS2Hv5cqfaLNQpdwqG1sqWboyMn8rgyN",
+	"eFHJNpCp03P3iTQOlrE8SRKlW0+/rxvnZ7Wq5Ofkb69Xa696c17Ya1V3f9poHsy2kpRSVwYXvZ85Xyu5",
+	"mS0aP7PLJXzWwD28cdRGA4N2zQb/arxXtTQLMJvXK1XOUO2jm6oqteduXVP5pJuW0WcwIPxNfcaITSTJ",
+	"VurOgBeV1JvuDECzHIslQO4xi3XO7HRGMV7B4gOvisquxBZSJt2a7kvSCPXZqxpORwfXq8XQCi+xgyMl",
+	"xGgw59SEXrVQeuv3eOd5uKwAl9FdpU5Xp0JiAprzcrMV3l7lA/CPDFdt6mqftEJqYzQM02uavIiDYJpR",
+	"P0WH6qOS4zWw37e1kleZowMbmGo4w/DlqS9PyoPtji9kwx5F8x69ODc7NjGBLPn8RiD0bKNdvGHCNrhG",
+	"fQgNZWwGpMgYXFfOBqCDBn8qRCdwOTZ3aazhyPhgOyTTE1sbqZ/UJcjTma3kVsgYvJNGiF8aXsw4Zgwp",
+	"WazjaJLAcWMFpHqpGh50bqydcZ4kLuP+g3RIkRXbF0ZFEdL9eyVH/M6G7CVEgb5cesG5FjiJgQwaFSd3",
+	"4af+1tvPT/vjkIlGbsbx+vn73BxY8ggttbfFc7A7bXdjeRycmBDeLKaIujWv4bTR4YrjTTaEIz9EvHCM",
+	"Cm5ngsMsBrSPhJ4QOQyTeHvNV9fekka17CAZWIP7rTgZQTn4eW0FKp3hfNrq2ZXafXPZvHr19QL0ZPyX",
+	"KjBrk3/WJf3IIUCQ/ogmfXilCJYtfvdK7TovR/MnW0TRzGZrEe9d93NNvyVaStjOBzPqgogi2RC8CcjS",
+	"0Vt1hwvKIPekN6BEgerI7ZACjCT90x/Ev1RtXS8DHj8YMYjZpl6o2WRViN8f9+GGtNbwKvGasIbZLdjO",
+	"Ez38kELUB8dyuLpdaoSI4awMLwhpBkPWvPhyiuDJ6UcJW4bdVYSt2adNl7Ypaksi6rtrvlf0pzBM48Al",
+	"uCfrxrxwKZ0xtV0tPWrZjbcbmEXqTyvYjxUzA13rzXIv2M1GAfyqQqvRqXgFrS6bqoJEaIMxpPweOxP6",
+	"rpKIKIPGcGuUQ3t2U/nQL3sI16i47k7Fl8Gb7hEzg/BUNqrUzUbU2l115xNGaUrxFecw0BdrvVrj+6fi",
+	"63bQ/KFeTBq3u9LbLUyb4Duie5LHoRVPjzhESCeMUiUwHDYXBv81g+xhk9wDvE7XCBhodIjoWkB2CEWl",
+	"B2lD+hw8I1A+JTHINToUgFDcRRgix+1zO91+57vUI0nQfUwMTixcYDpfuFMLWd3IHYP5MZaK/ExQIF8n",
+	"sCCvcgf5t3JxtdQ5y1LqY53gB6UsneNOh9ucKOGbeT6nSkFgCLwwsh/Bq5SG45EjHI1E8VPhrFjKOqv4",
+	"gMfk3s1gx2JZSa9mWwUKt2m8Gkm8m5QyG5Y/5MsWJ952xrB3et56mVhWR8id0JnCRKnLSO98mJ2vG/Rq",
+	"Hoh2qhE6Zi1LsbG1it3ALsn0VMCJRDlcC+lY6OEWrkr0l9UqExx1EB8MmQJJN1ycJNs4JVc6wZRrOwx+",
+	"EBkjLN+52to6r6E2sqp2sxBqmueV+FrECTvwXsAWG3ltVavcur3h46zlBbeWDOyDoh6dGJgaH0Q2viQ3",
+	"kG+4y7JJWOOpe4fisJVZ5IK236LYLZNhThtlaNRXu6k25nbl4KzN3dw6kmw4cTADqHLWxWbsTud12Aw+",
+	"2Mdp1cS88fsnFtklR3KjbvqssqdfA13Vtlmt28MvQscdHknbzfhQUm6cNpKD3cYmi3sVrR1xOWy4Mcx7",
+	"h9fSYDwDvx7yUmWt0JzEIep5I6XZ1qrU++nVp0yMR0SEJxmR3AhVcnrnSOEgjPI0oFfCsu97h9Yo90ZP",
+	"YKcyYlQcpyK4O8xef4MhdmlaZIRuTnJmpW7KAlGODth8uAVz4qAr6/afHnTh26sCZiJxg9WSeSUByUPR",
+	"SQkSP7dgXRxLig+148/aSFHmtXjPoVuNmwTldZxallGgAowegucdVmRkR1+UghoqhPRiY50Xf3r1Kq/V",
+	"2NvCQUQVY/9K4mkyogccEz+YVwnyehjQJrmZxS9i/HU24HyBIIHH6f7WLHU5sOaO43HGJTqqm46AnEow",
+	"Co6BFkbju0dPm6BxBHoJR/GWN/Thrit/7yF76vhk/jYuuRPMGdcwJcCIaOssxj4ubtGYgmMiSvC6MdxF",
+	"/Cm6Y+Mv8TKadUR8Cy6KN0lI7RCOHyyjcVHmO7xKBI9Iuq3YmzuR5Bkb21GrdV/JcSPjKJLp5Fcnodvo",
+	"kXGbWOVtrRaqHEfGoHGh9SMA0LZQsirq7C4xP+J6iYXGO58EU6yQ4qKdOAvKRFk/vAb9gKV0w+9dMbcn",
+	"1JrvQpbZrT1Bvnz16g7D28MBnbjrdBrZRa+k8+ey1DmEiLfOa7LyxTjSYF51XQtLJ/evVkvK3cLUc9Bo",
+	"13K7VRygzSt8aRLydCtTMKqYbTBo2K/VJpMhEAcy2ZmWTPWcP84vO0IyYUmC3eFIovTl/tdJAOlQRdHu",
+	"aua1OoikcK7d1SfNF5Nms5H17rBI705iZFhFQsS27QNcEkk3kAxoq9RLntKtQgZC4yFWALqdMSMcecJr",
+	"iHxgA2qGtd+FR33eK5uacP0CNmKgEUZ28Fiyqh/1ue++3jVQWqd613ZbCwrslH5vH914x96eZQE6fXfF",
+	"GU6Ov0hWOjOkDCWGC5LjstehEsjuncFbpk924UiZmoM7tG00MNWeTRnTEvfvrqT38E1sdv/EQjRKRI7Z",
+	"6oAAN+u02qrb6NjqPsTQNcaP6zxoIwQ7I1Q1qLuzuVrLa1iG5GlOgWqH+16trNd7gNdgiar90GuUlm6F",
+	"7q1p7srQfUcfIdzHeScj4p2qrw8L3gt863VanmYYP4INFSktcrPIMgXcEt6GSMC7umfw5QSyMJOcTg+D",
+	"NODL/VqJGI0oOJyTw+hIBGovKDsggA5n5dIDhhCDZLm1ZhwvNB3oCG3SfyaliPYb47srBpcYNbJsB1kr",
+	"7m5ss11BlfLDgaSclHt+69TryOfWAxO0L7kOI7xwnURP8o8VPfjSqabvt7GT7OYb3k6mb/OOho/f0jIc",
+	"0o9DTFm28yHxR1f/A9JhsOiJtM7eBt7KxXoPvQuOo9BYrGdI7LvdDXqDG53b6JUPSpF0LTPd2Z2317dF",
+	"pZXxHV5i/35lORaJm0H7hyGjIfGLCgGSRn1Om2DiMCfeJKl+ur0njhRwiX7yL7N+8taQdGgFz4C0MMNk",
+	"XO/eUE0alBppOMOdrp3pya9VffzesHW4LuxteqNs42/XOn6a64BbnSS8YjO/jTFk2+U741SdPya3HJe0",
+	"LzA7WbOVVa7DUEUIA1GmHCm3ko2r6DDMtIXmm9FQKncSmxPnGXxRYCQ7xadgKtuNSUvU7B/kbddjVHyM",
+	"S49PbVf9sk9VBZa7g9UOqYHvwuvt8NOyOvuKCPUG3v+6aIcyMguzUqNCMEIn7QHUklUi5LGkT0jTdeKC",
+	"khXe25tQkY+wdTECHEE++GVVFi1wVER0UCNqH8a7zmQeAtikvcL1FXuW7kqVMVSxO9IXTuQwvfYb7Svr",
+	"9o0hQw/n7XarAihOQBQpuGpCai1P4+nC17YefQSTDJ8jZM+Ndmr6TB5Oi620udqbjtklEHrPcKuna5hr",
+	"mI+wMQded23pZea319//+dWrr1+9evVlrl0X1Nths/joVpx+z1Zzt3P7nJfdudPLhwiaTdAZs6dz/3ER",
+	"eJnbSpQngY5T7hYhyT47nR9++BEr4EiYmH/h8ggAhGZeiA9bZc7evXACmhWvyV0CSn8hzoxf13arFy+c",
+	"4ORVBI75swLR+sKJAED/mtNW2+wRu1VGapheaOOkOFnhd3k7wlr6d6UbylK0X0y+2VqN0bxH2ALwE+h5",
+	"wrXAh6tg7GZseS4wVzA3G/j0mOGFtmig91VMOSQxTu++8R+WS/i0tOYAfv5/vvnw/u0/Qo4U5owTxETW",
+	"joOvuQk5KYQ/k7d1Ti78OdlKMi2upyXQSJERHXJDu+EmPGmmZhH5YtLe7zDEUeAKA6yKY/AlbpE53452",
+	"PHe+TzAGnFhEkZL0e4AixKMjm262Z2q8HY7aQpTdlnfmwaXU95X1rfRe1SYg3GT5c3xh2qbydbyTM7Zz",
+	"IU5QtA6bwJJOii7Zwnw7tNq/HKPqcR8WZkhAgtqIqB04p0U8mcRoUso+LJj9gx0r+kRJ35hiif9ywnmI",
+	"IvbyivNaXCGYJPGVADOw3YaQgf6qEPoT5YeGrzD6Y66UETFmoZOQGYfSLgKKFDtW5ymz+24HGRHkd7T1",
+	"dYL8WphBrhXWhVrTLs7nWLCJaSEpk0pLIC32bKHXcDtyvINQFqMBB6k35EDHGIwIZB6r8ADKHX38wpEM",
+	"0BjBdmlYmA3wEOOYw4299cQVmEmyVTUW8TsVZ5WzlJbpyE9+DR1dGswyccLJXSGkERF4QCBqMQgvvirB",
+	"mGppCJiwzAHpjZcBJcmVsea9/SoHSk/VFxo4s5mEArZHqGkWMP18EJWYE0WU2y8Vh6FUCZAYrwNnUy0a",
+	"aHdZtDjzAcBxlc20yzNVmHmepYLqOHri5Nma4XvGHg+iRSYdtWGLT1Nlw/A6g+l3nZ20rheN9phinLNu",
+	"p/X2l1JXTa1G4pv56WxrK7046Jr9jt7+SC+3n2fkFp87rm/Ogy+IDRgS0mnYJwEdQtWihSgZDtdiUMp+",
+	"y8WcqEJXWfpgsj2hVr7ejTcvDTYYu/C1VoMZyhV5Lqb16Na29rMFLagq9xAyiX6DHpn0glauBwSKh0Ol",
+	"OvSAOwCMfjSA/iB0Upftoh/HNYuFcu4YLghzOWrxjzfgJl+MilVf6+1I5Idd+h5PRXY6BFPQGepwIK2V",
+	"obcBi/zeTYmc7Loh+4T5HJYaF8p7bVZunNVzKbCAcknNdGjioCL9IjLlzK9r5da2KoOWSPjEorY3l4ZE",
+	"QNHnCa5vEm2dC2urElB22RyMhhM4nnucT7wEHTivJJypbWHsaHNZer4Wh1b37N1CgIE0wOmGaSKq26XB",
+	"dVA8Gpg6vKc91/Ug0PHYB36D481j5vZmmMvPigia4k/5+PUByfe38sc88x5ilrxtkQzJsGZ9ShYkKMPa",
+	"oEsxs3Z9qUWlNavlDL6+NNoJX++GwMa0TplV7ajqNDoqAmMwa5wbzuvpKb5VDizE3YzEydGjI20/yOe3",
+	"+GK+G/FnYEI/Fd6PkKOMJ3GztrSv7mAOx300JcwhJeNfw0d3sRofZeCNw0zolRA7KxbTEZ/FZZ64/L3R",
+	"8XsH+/lrQs5+tHuYQwoJEreYXCWYFri96C46+UL5Ufq1GyRcd4tttEPQTsi5bTyDUvw/p4hKfASiepFa",
+	"W3sRnUvhlKdzgOiGkAZU57Et1ODUUd2ljLp/reKb+dXSgAiexpKNFrq/ePMXEE5bW0Npt79RSQmEEAjl",
+	"tkjP4VehHj7gx2Mx6VT5YfCpIexvEnQ4HMXfumFi4HOA/0NPoPLNG115Eph5BJEkNjGXsbqm+iTwNLbr",
+	"FJzH8CEcu0ctT1uVP5uMjI9iPwuCVhD2uD5cebXfPHfx5i/M0FidRy6u5EqJAI3eb96VV5nawlktE55l",
+	"ZtbaPLBcRzJBtDO7o2bXDw51WZaAV0R85W4U7Su35dVJlyrZDWQ328arukXKvA1UU7cVAm0FHdnWJQZd",
+	"HwyCWdRKGbe2/qPVVFJSVWrDpvkpPb/l12GhF7WtqhnVNBzBeKBXSl2rAUJos0VXww1hjy/9SXFS69Xa",
+	"Z9URvAjN7jRRsOrsM43vtooKAQFzXKkdlglzTpXkbV74uvp/u4PnsRxHSs0s3mhhL35VNC49lUAinoqz",
+	"TrkvrL4SoPLXkqrfpE7S2BYVCCaOj0j4LUnRfvifnwux+0fBhTejGzYdTyG4yg5+/xmV1F0XWB6Wc7ao",
+	"9OIqLGr8a6PLslLxTwp0i38yBtKV2p0E5oFvbOPUbEOpzm3bs7KWK3qP1/qkOLmROs9BfQbOcgJvBjL+",
+	"bUEKtuTysl4pzzVd2cKJRkU8vLQfHFPabBu/B/IKnrTFEKBP/CIMItTO2UrnsNKsrakK1j6c4tEpQWAM",
+	"XpkhxhtlO7TXqSCUthfArqe0h2Hqom4L/8J+mtvP0MG88d6OQJdWKiDNDR5mgUqh95/Of4jpILA8Plk0",
+	"BDTLbtDRrfiTUyMladqqMmwJTndkcvWytPPkon017lewvWOVkmBc1lyzgt/GAatgZqcfHd36wheUPBju",
+	"0WFEGjE9T8VHsgQHQYA270vTGr2z1+wEtnqavzR75txHCRheuNmoKf9HrrvB6hrp6LgNVZkwYmClApkQ",
+	"6TemvLR7MptTBdsPH8YbQa+3ItYCQXgebZwyTnt9rarjrgH5DfsuJCa5tsRNzHIA/Oc29J1zTrObV60m",
+	"rER7RJ7T+3xGHrkc8eyE7Z4em7mRNXV1XPPJfs8s/JaqP0zymuyt5PM6hnV/2yyuVC58cgQyKMSOE+xu",
+	"6AQHzBBuWEnQW5s1V2GzuAlq1momgAZs5OfZ0UgDGyXNLb7St/gosOZh4JNe84OptW0lYCM9mg2ntn+F",
+	"X8tKz2uZNze0dm7ZNfO2+dw0jrROrpHVIL+bF7+SXsUEAMSJ4qDtACkShyW0Fyt5raAmErEUN9EB0mlB",
+	"bBrj8x7TOXLwMfK9x/vZnOLRFT3eEXHAOdCueJjJ6HquzlZZ3NtFz05xvFjOO0DRTHtUVh+OEtygrZMw",
+	"V6zhyFGOX7/zsi/JEEspE/ruz26c3ucK6kPlrQnxzHTsSkGDHbwvllBoiiNay7ZKH2XAcCX1oZGHIuty",
+	"Jhhopu0G1W8j1HJJ6EfT6bjU1Vh1D6qDdZRFulZ0Sx2vgjoYOqUyY9gOjj6ok5hBxO3d3jKB0+tOpjhp",
+	"IxYH4x1f926USm+hYjm3o0GUF7bM0/9QCWO4IB5SnhIlHRiOZfC8MWW+oO/41p9QRifJLspV0qELbdRE",
+	"2lHHKy+SokiJOb4aiTzJXFzw+hEcSqiVcGYXVthLqILKGrsJYe++e+PydSy70mn69ur/fSvIiGNxgJjI",
+	"bV9FmMQIQZ0y/mNtN3tLdShTImi5cApMMD8QwDAIMbyheQz2CaB9wWDAsVjkw0W/wekxromzNBCrF792",
+	"sF6S+rzVtXJHCbCJ4cVEshQ10FazQzv2iGVM8O/a9Rx0kgbXdaa7Z5nHceTsZnOfxd9uQ/17SsSJnLrS",
+	"saTusnEMmz0O6E4VaB6DX+4EM3WlJqg9+52i1EjMdYns1sFpn8ZQQyAwCQZIbVazltr8r9mqloYRdPmX",
+	"Ui0qbTo/Ub8jsbPWwHX7E+HyjoEuTCbmbRi7rDGAeMYReiPAUbFcYXitg/G6sdddRKYQON0/WVpyDxU3",
+	"I6sZLuTIpWQiDfi+iXaPfc1tbKmq5FHbQpjr3s+PyvFoSwnvja2MXBCLD3cBlnJpuviQFqNW20ouOEuR",
+	"lzWuVxHr4eMn+l9tVVr0ojZuanGomGZCFEzmlyX+kJ69xc6w4OH8FOrjZ21Ke9MqTj2QgCwn9C1UmI0v",
+	"VMQV26LiQAW6XCFeCZS06EGCkuiCWxQ32Heslcm02MNnw9XDR/g5K3d7al/Tu225AYQed1u10Eu9EDG4",
+	"7l6Zr2/a4TlmFzn2k10uWs2zrf6L2uUSmbHwzMGqNvT52GUB94Na1MojQCycmhJurHMla/SVXSlzKt55",
+	"cBG/wKKltfK1VtfBQHl62BXIA6UR7Jnpz2q+tjZTAI0GuHfwpar0taK62rDGVEeckG2PG31xctOOYx9l",
+	"w3D78w2fF2Hc2Sk3ztsNe+SHMw4u+kNj4Aa+Da8P74y9kANMRvY2hhBBvIalsEYpOIZgumONKyBhkvsX",
+	"QOwv2qrxRUv0NmqH4060aQ2JUw3XkSQ5cr6RXkJi0g/SK5O7D56j6QWjYEOyQcnfFJiJgRHUW21WHLnZ",
+	"RkpT3jPKe7BhD6tvY+BqJqSqNW2E2NZObo7n+pAZ6Sc/zzZuopV5+8dXR7z8H3885uX/mP6yk5CAM8XY",
+	"Hd4sAuXiHOL4Yt+RFtlFT3xtLRBbwAKPKOARio9OX70EURRBwXMKZmj4dajPOmSnkLjClXei2Vuzo4Nq",
+	"d+WgDUgFBCFb1UqWO4TtqygDd2BRUpstHOi38irW9YhX+Q4XjxuNsL6zOuJXT0Z5wg/6vECD3HNJydBg",
+	"MIosb2i5MtZ5vcikAIWdf5CcPanyW3ESE8qOq6HazA/19X0zT8eMPlrnsUxtDhrhHT8U7960gb3bSi8k",
+	"MVhSiXeoqmMNldlWmZKoiPVpR6PNwRcEdSixE/gHAXTg9U5wI1lOh7BNeA8RQeYOYxOWiBUF24S/zMI9",
+	"DaTLL41q1CjqMIxf4CsEPbxYC8Sp1H4nFpV0CMzlVc31mDAJYCoI2kdu6K/QPNxzXTa20DeLqxZ47DAK",
+	"E7wfYd/OsXqwS3QPN1H56LDMIFu75Z8e40aCJh0W7bYghs3MKr/TlssP21QGq18ahMzQiPYEDatKjYla",
+	"vVxeqFU+FAnCRsiTiApzcnu+UltfCOqAXO7Ux1CI2u3BXU4TSELj9usjdnvCr+bJca3qlTKeQTkyN6z2",
+	"wZTK9qGdY67PvRHzd9nh1rvzxozkHz/HYgS1eqQyAU2VwBL0hW+pPkex21Sqk8lfCGO9cKqVdqUuR4pM",
+	"PE0xgNTAly2I0i7fOM/8XktZLaQpNfDLrcpY3aYs1d4eb1GSKtmzOZvgURVW7qM6VXZ+j16ZKjuKh61K",
+	"le1yb0WqIwsI3qHG34MU6ivrHR7Jk+v0AcNk5fhjVNB6miJWowUHx6sKHlvG6ndTuCqcFCPuxuNEFRy0",
+	"WaEbqjsFfOMiqd3cP52dkJxDEPKD/akgjjO2G6Us61aKnR4nmzGYOhvjdOeqUoEOe8g9EsmNk6Mg7ii3",
+	"WgXsVLweYv7CXtemTY5yNogAR+E6XSkoHXbi0uy1hWzFRTYMO3ivxwNiz3MoJt2iJRgFEJsSm8bxgp+K",
+	"Hzsh5Lj2qJd59AznLcC3sbd0dLPxJDMcddQb9/gu4MVpFYOmRPa+aWo5rxSAs2YQdi7sRlHshreitIRP",
+	"QzGbhFIT4JCs0MAh9TU61TlyyuUsV7eOhbqFgr/UtTr6gzxeyE/GKZ9iJQHBBL4/Gbxj4uE+pZAKrlco",
+	"eHGvudLY+x7DW6DpQa/iW+PUZl6ps9WqVqs98cQgCPjdIeiHI0e4hs2rwOrjXgR3hDsVG/lPsuaA0CHx",
+	"Ei2uG+v8peGPMHQYMx/C0eUEsFshGiONhgyqcGgG2e5IadHL4DPElsLTkuDAIgbt2AiiS5PHgUdOqbEW",
+	"XOgQxhbSWj7PqPQ5tHZp8EtoxcEYkqZJRIk2BxapVCnp0F+XfpMcVy0Ue8HqKPYaDeGnl+bHdJxgh4fm",
+	"oLfWDUTB1SDUuTVtVqciRY0Iy9LNegu/oq7RIzob9GHuWXNQYCYa3pCPPrSeJEBS/WdTrlSotp5hroFg",
+	"muRXxlYxxx0i1oqo9sOzZsugWTAdXRII5ra2n8nZPN119pPRvzQqDckM4x8pPJ4NzAMzcN2QPpaMnUyi",
+	"rlM5nfDsJ7nags+ae9236xMPZrZUCTxE/x9vq9Glop1rTd5rkgFJ2Z+MMblgwK2if+7ijsmXnGTyIBGM",
+	"FY2D0zoQsH/L0jHxobs773AYbeKGGz4ajfmh7BE5Ejx+BzfTtay1HEtOJa4U/E5KPWL7WPgmxu5EHgOP",
+	"hDS7O6KJMK3afZK4pg4dlsAF5wzznCvK6KWu8lHFY/68rEct23dbzmV4OzCpSaWbvklnDuzhhJIMC8iF",
+	"hYOySIC53TnFO+RxGlptN7O0JkQG4gheOR79a385SHelMarqUB0RLncZz/12E3bzpaj0A+1dBvIIDqvO",
+	"HeZeSo0Md9p4jYoO2j6Gx5M3tWMyOzwSe2CRvB0uUU7jpr1aU3U+Y5HdKrX03aIx3qZFZg4PcCTFaqjs",
+	"Dlmpz4KjrNEtqNvh9uwu/Ewo+udNJmy1bg6eKfDd7QCfQ8+T4Z5hNAchnget3kul2djpVC9ZO6kxP0h2",
+	"9F3oyrFrSw7yLt5bZNxGAdQtrRI7VwvZ4JHtWMNEAe2EhcqpekPZE933+lB6mhAaEUcgvoYbhfCOA94T",
+	"IZ9dmotPP73+y+zt/337+qdP7z68n128ff3h/ZsLxJq9CXiYEVmRI151eSN3pzgBREOL16OCfmNQN7pO",
+	"OLoVBWafWRNACdN7V7YKVPcGkWmhe5mI4+FwoVmEb8t8mr1SfKekb2r1XSVXOd7EscyUAX3rgGV8WckV",
+	"LoZBMw0beh2HimnPWIWA1qFKWNas5ftQhsho/hW0O6EIUDLfc/5iNBU8TSPpkyK7XZK29wL/JqRCZWHJ",
+	"AVFMs1N8YcZdJrF2/F2XjMWl4e9mMfkdWq1X0uh/Uam8+IADsujv6IvTni7e2syYjLgBbeOdLlX8jbOR",
+	"uTfIrK/kIkIPhLe2ql4o4+Wqz6vJnE5aX08YGgZ1Z4aMkRJhCPBSd1CHmPq8ZYuedZ05fvBxO/5M0GJ8",
+	"xhdbYvEh+xeCx4kii+biOhevV68OlrXir6aeYMmsP+GnOWWo2ZZHa5vhm/mEksxI1g4R24l0eu80e2A3",
+	"8XSGGluDsCZE++5mQghyWh/4uTUcxx/xqp2yXNFW3UPo84SRxVnC9bx5brRxwvLbnYZeZBFVEiE6FHsd",
+	"1p+oqh/lLe0t034xpkqoH/amlst7Ko2/5CbzSncJHeHpyZpiIRYcP8fJ5iid2vr6LIk0Zq1lwU3Gb+VH",
+	"Z+cHcozn5h8q/F92oixSTwddLWQHqgutloSQxKE+L1xBv0r8+agAvTj6MMbjbkj3dINITACRFRJDWliS",
+	"DikPsuao4/Q2HGqsH0Wr7ODCYthmrb1XJhaVCFXmkJERi7PRVTmGM5VSbK9Xqsd5g3ku9MFwBRoRvOiS",
+	"pKBbaV5THDLdEQfPzLjOppb5W8X40Bk87OOXJ4dLqCxbR05WpyP6HaZ8KGfeHSdenWICudA0yhr9FprJ",
+	"D++AnoTNcK6tDvBQEF0ctv6M/J0myoLoj+7WtA8CIfXmKuU7ilZ3YKH+zaC3Ec2pLy4yrvJOCJddtmFh",
+	"0HNQB3nM+1PZB/3fhqXjUPNcjWN9cAP2frvZcYweJhQqGOYrF+qNriR6kvKWrLWsKaAhcBRBqHS7IJwi",
+	"1+IUtXZNUIjQtvkKJNqX0yLRj4zZzG7ZXmxmcnakpqRkYTvE2Letv5emtMvlt4TuMZSntylHV6tx3Xiy",
+	"fyEe5f3rBqUPsMOqEGu9Wivn2/j9o3QBnv47rzZHoRrVigIcjsa5wY+8HU7sIglUIayVtnBr+JBMmBM8",
+	"Ebm43WRZAnH2MARSZBiq6yhPcuZotPunQWuE0wgfwr5pLUpGbt3akmUKHNsGXTBZf0txEhb4GAyUaUAL",
+	"94SwcJdtPl5plMa2Z6XOiTnOY4JVd8nW9NaMeOoYnwetWOdScLyjoeWTMc9JLnyLnNEhzy0t78Isc39F",
+	"8of0aUfdoUM74OxidLOxxncOC67xWadbBadMg3HZiFVQTADe8kARz3Uzp9PPRU8/Hm+Ej8p5YC6fP4FB",
+	"fqPjJXhV00Z4DsdYK1mBSJwBjplRgEaz9Zlii98DbF8cHyJ10ocUPhgSyAjXP1mWXI/QRuxvIbdywZLj",
+	"4MuTBnfEWOLyjRMwvFJEl72hnDQMD8doHVkdIHOPq8OqJf0XQx4cmfw4AUcXM+XEke1BqXCTNkY+/i+3",
+	"eIOO+s3NFuMg6/PG7WYJfw/fiLUbpzTHC6TKQ22G18ZZoy2XWw+ZBFnDLhNmOYJPipNlrdT+EXbzPPfO",
+	"mRml1I7iN1nW33oB+2w8ICknIqY8zEpV+0Nnhr1l3rcLOrMYX/wxAo0yX25DvDMLXULSHyP5ZdDj9lU4",
+	"10bIsqRDoo3+DY4lbhv9hxHd++AxqY7GsaIv7qbnH5ngsq+oLtV8OxaJq95zV7kjQqsuT4puekc/ZCAu",
+	"dGf4nXHluWdFNWe+z8KftD7QoX4Om4Vh4HeIMdoYrCQKM1NlCIGCDF/yTRcp0iEiohHeRVZZ2FN0FDtr",
+	"AcQn3c7iND/S52MY6rC6tvGzzaFkdJwWFwdi6LZClIl7+ctXr16hZT1CkWyIXtKIP756lS+dlgXdP5s7",
+	"WzVeibX3W2Fr/L9DXO6U+hpMYc5Pu9vxtQ7665P0IJckpuF89SEd3iYqaScYhq13n2COG1viYQff2Zpq",
+	"/GANDRpea12JpfVMyUvikp3aTiad7m355lhZc8vsaQbz6Wx8bqs3j/jnlPVrYwAnLSDzdwzk7S5jslr7",
+	"j+BJA0zpPBgfrD0GRyMXvHDZJS8EA1UutdGxZBj+KGq10s6rmgs6SlE3qXEXWm2BLsP3WVvuO9i0tfa7",
+	"H7WLJd8HiJbbBkTvWrr1noNteCy3wBo4Y1sHVLgph+8UVwLK7vI1lZ+OOR7449ho+3C6ZPrn86b9sOhN",
+	"O7/YTLuxPG6sZ63KvAjeYPY+dhlknwvZwV9AnyPXJ270uGL+vLhHnTR9xsgcM0eAETaG55Spm8eTZ2Jw",
+	"CT54HQ5WNJaVSd5qPIgCeQ/e/aKoab+Iw+kQp0Pd3JL/RVfVxY3O7hOCRsm77jHWvt6Q/pKRV1bENwgj",
+	"RjrPKOc5Yt4qEiCq6PHY27f+7UzDOblf1+Rm98ywLUA7YYbHh6D01rxPoiKsz/5lPRsUF8PPKGm4VPGP",
+	"nCwdkuyWtdkGw+lw0FGehx7fHYAXzgVo08nUbrrIp6FIrHb3ndZ3G/aexJrH+Sa6DL33BUBnvP2FKM+r",
+	"bG5NRpHvszfBg4DDP1SbFmP+rJNmOlz/Ng2Vg6EgZ2xPdtieKpSfkoQ/14KXUuVEqlJKSSck5jeQHN1s",
+	"8UXaGFysCrMl6H1tTi9NzNhL8vRiiHtjKuUclUOFB4Rex/GRmJARLelxNC/8pcE8PnxZqzK6Rzlma1oa",
+	"exqL3Ts3J+TQoV54P9lzlO0z82qzrbLVpv9ssfjSy/BGSw6IS8CvQfmslSlJ56ztpkjL1qiqdOIUAsgh",
+	"T7u4NPjvN20nhThNag2aUpwyJlMRatd4LpaHXdOzADpQtlFOl4ejZbqZd+2ss1vBLmSl/6UCQlTGGlvB",
+	"K/krfIpcfcC+N1KP4uQTRHbPd3HGV2rH9VPDTjkNqa8UnGj8accDs/+qwoNPhpqjAk8eQLzux989OWEO",
+	"+50q4cNunDG37MfQ3veSI7S06cpwCrF2yH3G+W1xapkxZeaSDOpgBhyv1zkXVgyKits5rzYnxUnjVM22",
+	"V+elyYc/cyOfamlcNQIBDx4MZUYud779UvCLtGG3tS2bAAeevDWin/jREpqBbuLfDPAGbtR/j1ulpdy9",
+	"wNEfyYzONvVCzSppVg1HgQ/eoRjgA+8wffaydV/CpczVH8iw25bK2e6KuMxTGS8YNQLjwdkB/NaU2p4U",
+	"J3pDveL/Z2Cay/OfV/Dvt9f5ulsPJ3Z0qTZbi3Cks0PVf24C0uxGobkF8VvmuqowSxE3nEMFpqztluSz",
+	"o2ot1yqi0zqlTJ7lfK0Xh2RPINSP9PZjxIGDS0kazw7i+LI2/k9/GHEvMxuOWYJixFjRy57EfEk2hwrf",
+	"o/YUM5ED3Zdz2HvLaICJXAhcI6cQLpGo1cLWaFJoHLmMuAQs6Vm0jifF4alnk57DiIasFtc8oXDfLJqQ",
+	"csKGTDbRxyxUpro+6qTrtJgNAAP0fbz65Sw5Dks/883QipXybQLbNqp7GAca3wvRTwzAYaww6ub2axA/",
+	"TEa6j3Y/xl2YrZ+94deYczZKuqaGwk1tUmdMZ1UlgQp0UCNaIC2M3OBSTpdYowWtIcmGKERa8p4QW0OL",
+	"uIvwl+4VzEV0ddbHdX1paGNxBeD5zis3Y+ta0hz+HuF+cQfSS6fDUOH+RLueu1iNIe0qL/ZBO//JZROn",
+	"PtH0ZLx6wJCoQsip+MjXnTjdBhph8/fN2lYqsZw7K2T8kwgTgwHWVi9UJKs1iyymFna9NwQC88/2FGpZ",
+	"WOdnjSv35AvEFXaYpP3ThShtVcnapWDP7dV0TXndVA5kW+uFmhZvO60SSLhOEnlVyXdszLRRm61HfB/t",
+	"2+fGmqS74XVzlDYjFzYief/7HL1z2/m9hSN7MQLO8DPdpz9+uPhE8l4muVom+VS0pSd6lrtK1QeNpmf4",
+	"0m8xs3aCrS+BlIDvgja075N0qlFOHx01cEv0+eIEi0vd2i5LM+wHAXCThxY2KotBNnGcSjSApfABsYAB",
+	"/hMGV84oPRTXcrYcrY6VdnnBBTbvfLJmV61/ujL3zbIOdPKUS99hWMoHi4w9jf75LfRhq8zZVoPpJge5",
+	"7de2HPFz+7xf8NZVGg+9j0PMwRScFGGgPKx0EAfm/G6T9+KVdtFsRi+p2MDHd+JrEd5DkABEU7S1+PvZ",
+	"jz9kTdxbRdA/LofQVe2ii5eO6vb1eMw71qqN3BA4Gxo6G+PUHeq8xrlOotVYhHUSxzxpa1zQ+9z+hzDX",
+	"aSWO9zWccvShqVPL+2OaPyQ3r0e9s04DLB/JdMjNJMQnjNqEz0TWKjy35Y7BjdDM0AYvuI6JGLk0iZPy",
+	"axXCinBvnAq8FoamtRNUI6Ethy7pRVGqhS355s2WZolO8qWqKQOkzfTFD3hDoBX111/FKSnuv/0G2xH+",
+	"pqPvNOYJid9+OxXfKodQJJ0CS8vGMC6cRg8YqKLinw709Ga7VXUhKnsD//O13hShDl4hAjBxIf5ptSnQ",
+	"VIV1c0EbZyokNbUwRAMNvJRTRUHqTBrW4RFgEXGlOZ88CZl64ZgwWUWWzDwxSqgHFE1PvwCTTlsEhdcQ",
+	"1rogfFU6a17C3IHacQ5I8LkttXKMyW75e/jXtax0Sct9mbWA+Jizv7d+SJdXE9yChHv37wzuKPlkwqb4",
+	"SNpFxi5qy7xLsE/s/YPqvF1QqxOGNQZ0EMqQ8AIEhM1Y1K2bJ/rCBVXXJYDgoeqbbLfyaU/dCK3f9FVq",
+	"aDynSoMyUzDuLWwiyA8QF5VcXAltFnaDQR70KuwBaYTaSF2JlfQKAHU6l9Gk1kpnWFk97mMlM2I6Jrh6",
+	"W6lajuIf3hvOYXnLb3KBFH1wXUgwAOnZApLf9og5kJx6TNlRtZ1+RMMaXXi1neZXiZE8mUUMPR8++ypp",
+	"0sJn/XhgtXVJwcsIJEv7R9cCrt/BsgX0f+FOBepsERbXBJSnwPC8PMWlgWeyLQABQ37h0nrcTiTmJCyQ",
+	"2sgqJ9nvPwP5dis37ug+BseAFuVaj1zgz9liywafll4Y+4jBAmTMCUEC0rT4w7hJfLC64GcMJHhploD9",
+	"c3MqzvBlWWVKpM93Wfgy0kPidTN7+N5GYrAbthcyHGocM8O0qf3edof7wp0U9+DVxDASyjRJ4QkzOeRe",
+	"bRmN3aDxPnxH8MZXZP0t6GbCNqTg09+ITpGi44suTwkS7XBWCBIFlpgs0KZm0WfWJ02oT5iWEuq7WSjj",
+	"Bw+0OXUV2k5gLUJxD46soTWALdQYoAAlVdK95861lbKpHkzmQcI+x91OktTp0uXxBZNZO1/LXQKFXjcG",
+	"liMVBacCUiPscgYSpU6qWiARWf1eS0Og4taolqWJlePhE0JHY81KvLtIkdIWS92025Uhv6htOj1EPMNI",
+	"149rM8Pve23jb8aKGrQkvL/gsBunXFdVSieZnphh0BgFm/Y0qkONhzNmVak9O0SO7Y90CTdyxzWd4BIG",
+	"FCGQRia1h+LY892lCfdJZ9uiOeqzXKTkxm8u8/tsMsD17c7FJG5277FIrY+xP7Q0TvixGKPjNYNDyD6H",
+	"MGq7omKPBzhKSbGAi6wqg1hqlVo60REXbxqC7uSSbtsWi7b9qkjIuW8ZUp3x7qrYPoKOj/qgDpVy3n62",
+	"Ga5RolR0TxLYfXNFa0IniTZ09+fFKQ4Euv16YNWGYzFyow4N46jaLgfWGNGXxur+xJLcJMMYqinF8ub6",
+	"AxQAEvPXSDsFZMBOWSQdyh6aS8O6Kn4JrcOZBaMr2iOMAgJYdYL3rUFHuvTCLhZNHc53bfD1G21KeyP0",
+	"8tK079/XBUJdR4vFPuTV0YCaWLYnVGz4DCcQmxYwnxId1+MhV9luafZp/d0oz788GDDA/JHM7NA2q5Xk",
+	"28JIovIRh0Xb1mv4MqeIA8BTtZvduaLS9L3S77EI0zpADprC3uTtg9K8W5ZiqOy5JqTrUtnN9mreojgn",
+	"O1O77Nk/OOPvQOQDl+r92O2fuuUVKAQKSyHSiCIMdni4gIecjJFWHj1OO0/SrNvhH1jd2xwrbdT34RNj",
+	"z4lwNsh7ZInLAOsTOXtkggRzNsK8eFcb9/91Ln+MSJpmOcgWQVn2cdWoqO0Uy6P2Y87CmErYdgoD0oYH",
+	"wxXeGWc/E6YyeOtA4gbPUcPwa7Xw1Y5Yk0Ln8PbkilC8cgA9djRe0gj6zKekkDpXuG6HSPFMNM5whaOs",
+	"Qj5VRyABO0VqYW6zDuGHb1XS4TvHV1NugRMPnA70YipuXLNaUbZPzerK3pAU3oBUT3FQaLOlQY5dBlRI",
+	"WTGsTZFskT5J9m64cRvsWcfqKtPwUYkcSLtJdhbx2BvSYyA+HkL9ZXWnWwyNUB61d6pa3mnvHMKb5JV+",
+	"CDS3/aoH5SiMnofwEGL62oq1einaKuDH4kWGaRb7KnpPQZA8ADz8kfH4/tqoRkVwp9yiIwogwvYISzOL",
+	"YI2LSrpMNcgEe67nrhgWo+kipskWMQmrX4QDCk6k+W6sNkf+rEhgw0ZOIYhNxQS8YXkyDpTHrtuhhpOz",
+	"wthhjzb8bOfazJaVXq0z5/DeTqNSmO+yhiaFsTfZTukYm4XcaZlFC2HwxnAcBSi0rTJpv4IRbMLzyUmz",
+	"3M7Epe8CsW1ru1DOjZbSnggg2ZjA25kjhB+0A21hn07SZUv4J797bCgP/dRhNbfMQG5MOD29XHWvgMdF",
+	"YeXASqP7M+1iDx2/tdY7X8vtWJBW6j6fuSTKcWoQY4yMbKNPD+szNgwz2aL78icPUj2HOtJucaJgRx5A",
+	"sFAIO6KcjAEJ8Vw4ugYARJmN4f/nC5yfdMnQ77gYWaM9q/4aLs+rFru4r1a1wR9pGg5euVcNRd2dCpgI",
+	"xSqRv5vu/hx1FS9g8x3p2XVjMLojgeldyLrWqdMrTIlvsLgqwmNdA2o8W9Z6dVR8LU39bDXizcRgm89+",
+	"Rtax41f3NX3/M34+XuZBcS2v4yr14Vuza1W7UQv7Q2zXcfXsDrJssLWPWL0Wl2As9vNWC7fUqyM2Z281",
+	"umvao92QUoe29BgfFoHf9+zuvXW49paOGV/oCJsxtSAWfTBmROVBxIb3zGZ/DPHv60RhwZc9TybJ/j10",
+	"SmN0+wb8W9ZBu1dhEgPs95I97tXpcqT/9wjup6PCTXgWhcIaaCeoNGv7LaFhhVzuyL+NzAoLc1hq7aXM",
+	"1MSI4fTjdGMSn/PSlLKmyINC/C/ysVJ8GSY6IVEmIEdka6F0JVuy7kWMnj9aY4m5gE+Xf2eXlAfnQohF",
+	"kmGXSc47IunuiLzbNi8yWzzqqOSugxl4xQnC3oyFEsk6QrHQMVVwKmaUfvA1KoRcRHvyZbYxSNZy1tKn",
+	"O4Ifk5XoJjsWXAmLV41wY5wXlZLXVAP0iLyX4oRmNiFkIE1U448C/Y5JWkw4ckiGyC8jO2Wz9X8bK+h9",
+	"FiB68lXhXziu7e1i+YlhbgZFtVKRKooZQwsGtusujTUCwsiFr+VyqRen4i0Km0wdZO26JcTRuMV1xgux",
+	"1QCuB9sJdrutYQbCWwqG4rfcC3GjwGDgwG/OPybxuDzZK6W2bOmn6b1wNIUWPQrTNgK8UG2zQbR5d9NP",
+	"Rv/SqOBU71ZAH99wede0ytusLqLfiGgqalVJrymFAnqkOLRAlG7N1y9PT4qjXdwHWauFsczXIOpWjQ+A",
+	"YBmWYxaCkojBM0ARWpx0Hazeaygh4C4NVT4PlJabAOgdzb+IP0xt9mzl8EccAZb+Z/hIaXaXpvUlg4NK",
+	"ubWtWmywUmifY4nj66EHihzh9U/ITpbig1FiPZzs2OfBZR0rMwBLk601QotDwrZDaFovjt61NmtTjL6g",
+	"Wc0664TDEhue8XKPDykZQwAfVMN60uXo2CjqHmTyEWOLH7l9A5O+jem3tfCq3mgj/Wg5DvwufzRft4J+",
+	"/8kUXmzb64x2MN8+nYvAA4NVG+Gpz7tztWycrMZqFhM0nSoZkK5FYMB8oiWCrSQHD8hlbRpMAMIzqYOc",
+	"kcVkONpdddSm5AkeUeA9jOlgkfds60O1d18I5bs3IwiAKPc6sXL35b88YDcYcy3eLXK856UbPbz+2lgv",
+	"c/Wd6nJW6Y32uQ3LbpIkzmZlxVY6j0imIbW0caqcgj0zFcQJh9oiODm79GND/BjGUrROHYp/ds1iocDy",
+	"2ng0scKOu5E1rIJYK0lh3sfC5fD4R+n79jP0mZfKvqlN0PP+8NV/hMLhQRfsklcKWBhBs+5vbVXXth5x",
+	"FPP98CB5+fbUmx+1HNoZneYYClDdGDfbqnpWylZ9aUz/KrTRpUFH4k+fXheMojMjfB08o/S/UNejBy32",
+	"fyl6hVOE2+fTs0Bdp+prVQusG96efZ3I/3TQLa45DmdYqyUb9Y80AcWhA/TGYZa/wMOTlItnKnBJkWy/",
+	"9tfRLkZu/90t/GC7cGFzOdFJRfDUDZgNSYUWpkMGppt+wqRcoP/BOdFK4W5R5aTW81Ig0CSZGbcZRpPb",
+	"QOeqlAuvyoutzMbyYFY8qN6s5GMOzQ0Cfjm7UT7CvNfcUNDhNaHBEf8OZUYuHejDculUtF4oE8EHeoP4",
+	"6dN3X3z5J7GwpRKN0bAd1edF1Th9nQ8/SL4fORBh7CNCDE0qhwZ7eIRokJE78X/ktbzAdoQ2pfqsnKC+",
+	"JtRBi+PsTimMEUsY7VnlUXQlynpKJyBTcUeWSTTV3kmve8BggAA3Oha0w7xJ7Mso9gTzAiFqiMBA2UwJ",
+	"H+9Qp2UkmkGPd+Kp29Zi6eZRt/rrKGNEuhxM14ss8kZ5FQbeJeW3AVvjBrL07FIsNUXJOGWcJvuH+uxP",
+	"4XwttZ8t4CSgyxi+6kDzKQX9wmrcVjr4l7o0PzRrQ2UhCiG3egaNKOO1rPhjMP+TZ5uMiKi63KiqioZG",
+	"tdSflStCcX3r4OC+NIgk864QZ8ava7vVi0Kc/XxRiD9r/30zLxjPAFr+s7WrijP5EMhgJsuyVs7xEPA3",
+	"wb/1c/aGsz4pTrozgbfTZrOH63nCOX2tDZ64hN6l9JLCYsnIy8KXgQp5Exdibv2aXush8OJM4cGlSTJc",
+	"I2I62QoDd2E+CibpQdyw4aTAkvmlACkivVe1EbaOuwr2z+ml+TkUy+HdhbZG5NUySbyMIghX8D/P3745",
+	"e/3p7Ztv4BrxzZfyq/nXiz+U/yhCfENEGb40GvgD7KhbWfuCLFa1kiW4NLmDcqPNN6wggH1yFQHNB7cy",
+	"hxgBSNJL05gw6gLV904WOaIFUIieo16dUv0jweUzeNp9tteNNNiYEHUAtJ0F8K4ul7yxXji1lTXquLQK",
+	"QMBgFgpZm3Ui7BCEkQ/2FqHGr+EUdrSwa2ARIelzjBqBvXtj65KbcQzTGn+mrqn+WF2esiSI6Z7h7+Wl",
+	"kfhGHmcmb+X9QXmPweqlXuH52iCyycLWilZlvduulXHoTNTAetvEM5KuTVa4Ex9nduDbr0StVk0la0h/",
+	"qrk+NBE2JiMnlJ1WBS0vkDca5nS2/+Cu+TVERWC8l92Mg/gUP3Zp7d20mrXuxJsWl4a/p/jW8DGtayxf",
+	"OSjj2SnbMPM21AIVa8l9Xxr6huo/kHmcX2JxLSEGVa7AF9AVq70ZBT8ljzEBDUw6HpGrRKnxVJilV3Wa",
+	"iDZSew8FqbE4GadYFIV1OGDcx9GrXKVx44P3IJI3KSsXG9/PTd0p7GOrkJvcV/gpjx4EOxocu86oyGwg",
+	"nMoGRAZWOqf8RpdwFVk9GsP2Sob5ygSKTSqq09sLvxW9iY6v1dDWnHq8EGaB9KKD6zZaJP5TsrX2bgIR",
+	"98CR6xhryuQXdFvJ3WuExv2oF1fjPiCCzw1YCTfk2WE1CkuzgLQGMXmKQnrG72Ppui4grxROm1UVmvzf",
+	"iK0wS/yISXcsG2NZEEaU7lXPc3jQAJp92AXhLO4BI7QDCzDR3MTInqfM04A/EZqhopOyhqaXulIzVvPL",
+	"+cyDyrC3sR9Drb3QGupEyNqgge799lwtVZ1PkDwzQn32qoaqCQFJPM2f6SDH1NAOcVI+dyZ3YKg65u52",
+	"08Vjd8AHS9uYkjH4/p9Ti5+703mzuFL+/q51nFVcj+bW4IAK0ZaPCIGq6GRsCVThbWQtXfswaf2WuDMd",
+	"vjkOQute7efxrhdrHSYzi0s94XIHPqa3bb72iBMon0+SJkFi6mBZCLeGKxdvVfbrQdZPEHFdyBwUwtqf",
+	"ivdKlW0iuQtVgkBvAlexoDBmWSGiItxassHFPO+YQzfkn2AHDK9id92Mzu4QtSGlMEIBxdmfigvl+fxi",
+	"A24h9MqgeUTDATnfaE9qEVDZjeAldnOj74BowuTC2eeTPs+RtniI47zn0nUXNCX7wPk0PQwnrtYegPIX",
+	"LsUJCCgJwZ9FUSs9UPzsJhnh6SR+bwiQCcaEKODQ2WuXLVZpgvPfuzYvVUlHkTbM4QtrrlXtQpA9KLRU",
+	"FyBPVD4xAeCG2M3hrK9VXeoFVzMLQzJwk8bP8OIgFwu1HYEkm6osdQnTKk17Clofd98JrCNXUhvnExLv",
+	"L++X8ztjW5RrhQTTTiwruVqBQPilkbU0XhvyzK9VVR6ZfI7YfossI6DPkGItewDMk+pWB5odUM6ya5G/",
+	"zK3ldovRcbYDqcR0iRyVsBxz2ylSjEPZRaV8OtdLE/K7N7K+IimeIXD4GvQ7uBmjqI8J1yn/I/teGngp",
+	"+xFhASWpi+0W6OpyyaCpqEp3KCfFSdLHiFYFo9JzXXEmXIItjw9Qsuq682dj0F441qBWN68rqTcZBz/8",
+	"fGxJ/vbWERTVyVV32zTb43SIkT7bFot0Knm2BTJ8HKuB/jpiIjMklDY0QpANBt2GjPVId8jICQFVUHZu",
+	"Y0n67shBH3AVpyTLYym83xLMNBja1I+/g3fxY6+XcjEOtsSPA44F6OYrZbxotkAyLHjMUY+chxUrdk2K",
+	"ZzlvzBn3kTt455ipX8tSNweb+hbePadXf2MwmNkk9ySCarzF4xLSBoKfclHJOgHvzRIImY4hh4N1lGoI",
+	"4+UDSSXnRB7draGuvePyJXugKPYOOx1fPp0Mq4PXs2nn6Wt+vT1HS7VVpsTKUKtabtdTsgshZuhN/O7P",
+	"+NlvRQTtH0035+tirFDghPRekuJmA/sVSQGhW7DaG247R6y0UGZGxeOnQqeQLpP6PXNe1VaH8p25vhGB",
+	"skyBZSdjhXYvbfvK29eNCUwYjFfk6DwcFLColTJubf1UBrhov8ifDUcVdWkhCq2tFhyTNoXkbYTc4ZPj",
+	"pCsyks6S2+neEqXnLAH21WfNAcTAs47deqVaE2Oo6BXZL5ZeDVj+KYCM9sF67UbA5/Yox5MMf1j8lWyh",
+	"LUoNOFAwroqTaRKlKMtPV7rKpVXQxKSXcI8roHIAw5HWwqlFU2u/K6JKvpAOzuPoIqx2R13p7ly7PVAr",
+	"TifLEiE1Kg9G0SzWopQbsBdGRdiI0oYEgaBQru2NWCt5rSvy1dNlDqHw02JnQSmsMPtjo0rdbE6Kk7Ve",
+	"rdF0or1eyDyC6rltYOHy2IKvA7JgFwKVy1xvFMP1Rtg8b7Egw64I9/KYGGEQf7zCLGvL7pb0St4PNPVq",
+	"ZetdFu2Qn7W3egrOianfnEDC9OKXbR3+DUNo64ZnTXepNj0cAU+DvHw2vWQr5zWZlgjfIm2InUBw2NcN",
+	"1voXF39Niu8k6bAbbWa3Kk4UuHTW7rPp+2LPFRPKZqfA4xhg8r9w6duVnAB10x1ddts0WYwzUHJz5xx6",
+	"uLEiStkB+cYcACxJf/CIk423xm52s0pdq8PnC7/9A778sBE/t8I/SWun5QK9/GF1+oLeApaQ7ur2UTzh",
+	"68O2W7gKLNb6OrfbaE1jiVQKx+MbmLeiVtS40K1und68VOUU+vzHayhmdFK87nAc7G0U9HZGr9fSPyAS",
+	"Q3fsf6MHYatKGsILJyq5s40vxJf5fI/GTJjQMG9hjHCtRLwr9cYTHY6ttdVt864oC4zryFnFIcvyQI5F",
+	"hymSa+cYIqlKX5h+ix3Ru0dWDLt6kY/sLvjOo2tBWPT0J31z/GKOaPYH8lc6hBiZ2UFq+yyN/dTbRNjE",
+	"XJ11lJIepQa+Q2b/WskyaOm8G08FIUtg5dhATn967J2SvOHHX2d5lOGlPcP8hAv/7g2pm3iiNltS9mkz",
+	"YO3VRPshC39rLprvBLv5RuZ8eX836SHf+PTS1i7dflb5jsVwn3K9ymJwD6pnq3+xCXD1L6zJBz8KcLUL",
+	"MOoGemIMrsS0jNN/OpIlrK3zn9RWXjnft3kGLH2Xqql45ueY5i0qePScFpe6F2tZ3k68JwNIVI0RlJ47",
+	"Wg4m3f7j5PczR77y6JGI2q2jZBRQ+64lRveAYWdO1kOHz22O2OGBNARLuSVa+L0YgdqWiuF0R+nGxuqM",
+	"ioqbXhq2+m5rWzYLVYqyqdkkAvZLDN7lE3Re2TmFLh+siPiYOQZ7AbcmtuHW8qs//ilj9lCfhTJYLFNc",
+	"fH/2xVd//FNELYrm3GFr+l+KU8OmZSUdV+pDJqvlE69HweUM4C4Z/B0o7Kmo+GHHFn/DRfKOSXkI6Ird",
+	"6osJHSKJu91MuWW9JuwMly0Soq9VvQrBG4fM6e3LxB1HSYl2GG+Nrw9DnmH7B6dEbT0AVE+QVYcRl6S7",
+	"CgLrNSZjHuFeaG31eF/zylEE4Qg06kEInSGawAF0eOo5ASkIeh0lIysM+R7BEJgCVHCECHkwM0X/BpuX",
+	"H3vxsHL8kcUaBlkcvadISlUSIgyZ0Oa7tkL2QcCrKB4SowrfOhMldwrMT0KA9gbbsviAcUb23ZuOuBiy",
+	"FsUO8diE9GzXZnK4FymLu6SeVo1ZGiF3x9ZEIxzvqTi7NMSloV3t0sJ9rhPEIZQp02TNXLgR5ifmVzXd",
+	"thPrFRJF/NRLCnV+yLWU+C6Hso0PznxMkDaLqikx9UWha4kdNBzYHNytlMrETieu2TwS3fdkiglPZQZO",
+	"uhZphsEqj8WUPEIQHad93PVc3z/LKQf82+ssnyTujlHJ5utGZRp9wEVlvs8uUqgcdVS/x6zseLWmadWx",
+	"u4vLzfHH3eFPXrfxrJ3bL98RNO6l2CV4XjcBQ55c0jqUEJsMq9dSO6OD7JxXm6T5hYVzE1zM7PBe6EJs",
+	"rNHeQnt4JgBQG6PUj65fzsOstpXdUciU1JUq9zmV4YDmUmoYyX3YL9xhgrGVPmD1PQI5OB+7NDAH2lIv",
+	"Nazy5Op6NkQQDyrc+XURyhiDgU8p00bOY+XoNHKTO4ZGNmhOTJoifLxhmgbdoAExaCTG/Gjd8L4CR3wb",
+	"FMILFQczutRbW/sftMm6tSod0ovrJlhUC6E0Jg7Sj+xZT2Ai6LUh1gQ7J/ZUvkBUGAwC4kTg8E0RcnqN",
+	"Fww8qsw4LthGkSqZRxsLGi81HlPTtMtXwprkenrLAw0uKN7eB+6YLfE/wQeD1dy7RTufpjG3DViKmNbB",
+	"JzRjLXsESOa8MfuhpI85ta7Q0Dyb5t7JFuCo1NIL23gxVwvJ1vgd3e4oodFulTloWblvCGujbjheDS9I",
+	"7IMo08pKDFbx7k2b34UvHXF16kzgADVHeOMHvdH+NqBTjcEsQx9QBWAuiLbj7httKozytoBT4ft+QmF7",
+	"cUTwpo02jeesnliBJiGl40cI4ZEha2eHYIcXI1rCz2vFQhEJFpIv6SbYloywdZK/hJYM9tWA3Y5fCyXv",
+	"LFQoxHujT/wx/NIMOCYAgVFzM26Mf943ixHMKDXKM2F67Zwwe5RJFiQ/ZMcRpERAfxteeSJ60xT2CHl/",
+	"rW425Steo2OwnIoTxFOYUEg40Jze5y6KkwS9iwe7j20zgpB+F9bEkiBcKJfWG5EecItKgC8iFWQhgUWW",
+	"lbUd+Avmsazlfsjt48fxgiA56T3qHLqk3AFtBCka+9O1RzfeeLdB3xhx+OMQAM5tH5ZbUgZr//jyAmN8",
+	"cInJMUMQ0Qqc/cjCOc74CEfdcFNu4ecjiyPSJ/PdiBaNKDKM+wNvjpcBBfZR19o2bnasUtumm90b7lI8",
+	"JVuapJMdDnZkD35MgtZySatpHbnIjoVYrK1Thk5LvFvwnZnuDVlehUPUeFVLDKZEyyCFKsSqYkKuI4Y4",
+	"ISWJOcYowKuUotj+TWrwSnkhu3bxUBbs0qC3313BJXRfTTeUJ5BcuYQwR5YkiO3cbNndRMjiDFd+adLL",
+	"djKnU1ErWQEncsi+CLVuYYdsVe0sahUJACPuFBAuIZBY1urSZCiCmccc45GmMDmxshRX1ya1CGdBHriY",
+	"PcqLI2oJJxek30kTSt5yX7YOB9lWOgSHoww9KUoFYO31LtB33cwDOHpb1OmXRmGgbuPUJcKvh+DwEOXO",
+	"mTcoohB74/zt2Q+f3v34dvbm29nrD+/fv3396d2H9xfdfLxATzS2RTqfFCfIB2PnOuFPjMQmhkDBoJZS",
+	"pFSrVUPgDOkgjmQ1H0J8vPegICjf9FRwYhoxNAPVSYfBJN+GelY7NJUgp7U4KqGhF/RyAi4AHugdDw8I",
+	"fbPWXrmtXCghQcTn7ONHOW3U9igvIFEVQVomOADZzoadjIiepMGHjfZKTnF8I6SiEnzIWrqRCpHsa8jV",
+	"tFDbF2hVozL+bapRIV4h72jv2KUy7hPE6gV8Mu2lfR9Opf2YJpQfJI4/Ogt5tjHgLbHepLArReqfYVE4",
+	"VwjEElGRKul8D33FqWtVyyoQ+NJMsLazN4XpcyDeK4LHTuXWvdEs5435n0Ix0wrFHHRbT5c4z6VYy5iw",
+	"eoziK2263Uerc66fEe/mbsJ1DHbT7kCv52qVtWWvY12RYd83uvTr/KM7jza0XoQRZIevwHb0vb6naq5T",
+	"0HZil+HazY6Z0SilFk0qwTFpITjKnm0+yNklY9kEC8yUYkzH1G6qpRmB30KYUtTndBiwKwRkoKlaaHAW",
+	"eN+FgF9WVvqczDlG6zB6u1V+hIZMNnCfClnbhoHs8HdOKA7vAJwjDvJGKSP+8z9RRfrHP44DPBgOIQfJ",
+	"RvE00rUFZNgCf4vlOzrcLWGlzhBa9kniSY6roj7Sd5wuhcCMdLX/dsrYUO0lNa16FXiAufOgv7W7F8dQ",
+	"Jx2+hdys3WkY6oz+FjL8kNxh27WAl9J8yQgAw0Zsg/l0deL7Am4LC8+fd2AJw7NBU7HSRfeikwy3Y/mm",
+	"v9OespeeC7T8vJZbicAjbdHL1Cu91ZmasG0baAojDjrKqek5CH9P1PARQTlp/PBoIViMxrhNDDf5loZN",
+	"+1oahxkPkxv9FD7JtcewSrO5WstrfUwVyL/Rl9/yhwe1l3RVMyTqhooNh9Vb9g4l8nuxvtYL9d7eJAhC",
+	"nXCHjI0tPqfN56gNY29mnTpoPXZFdFX0/a9q22xHoxFmGqWjSXJe8YOQt2JWKrHc1iqFl83GTSW5zLlN",
+	"YlZqlo9ZQBG628Zh9DoPyIFJYkoHVBaekZMai27Csw3F7O0ymz5nOb0gDxmii2/1h62qZT50YaP82pZj",
+	"wFvrA7V99x8rE9AE8dUijIL73Fvk9yJaXLskZ6OtZnMg/oEZUmgxvFnrSsWMuLb4xgsnrrAEzo0GcyKc",
+	"D2TAuzSSjXOzDqbMsIOsiZOg4KNVnO4swTJ3aQZwMxGUhg7dtXR031aG8WZUKXaqh1HFLoT2YkKBPRgf",
+	"c1KcBO4+KU5AAaarEZEJnmanlz9T0Ir1muLf+s7F1G8ShEgwCYS/+UwbabxZXMU7+rnaSl1PdYlLg+Zc",
+	"W2eLBVKJD1hd1JV0B3E3lu0rkvhWB2NJMkpAt7xSLcZktNV1TnggeFMrDjk6Fa+pdGmAZazVttILSQsb",
+	"F5MYUntRSzIZUVcF1/3Rnsql5rH25rtZmoZz24BnIrYqW4LykNPifLruTRDvMLlSx4j7hHB2I5FpP4do",
+	"tEAS+EJU1l6FrQT0T6RkaoEyiP3epdbkMLaaZ5qnSuSFFLP5wKV1+E3SS9FZoawUm5AUiMFKkRmn3qsm",
+	"vhYslhCyih3lSXNLJIOJbrFcOuGxVTIPlLMcznPackQz4z0nat6JJo+XUnmYSvmQqNtYYW6TUai6cMGH",
+	"IUdTfOEjAp3vNwQzrVGpfXCdOSG53g9S+lSc8yLRNziGHdeqJenawTaFLoLP6ALnzBJzLZ2wZiwcM5Qn",
+	"mT41u+xPiXy+hNvClCrQGtJBJM72fq3qWpelMrPbLP+2VgtVjg86hdSVdaVVYpwJPpoAw9w58BbaK67L",
+	"Ydhnq0qxVbWIXRYCjDHWefHHbhr8YZtL724YdL6jQAP/Gj46WCzzDvCerC/OlrKqwEt58ApM738XXk/C",
+	"d6cjik6IkGuvmtFTkSCRDvkAHiL0d7S7tuDOAz6N0fK6Fmcf37HTNXFbi7mCug24ySemk/I9O5fnEy+H",
+	"bSDconHebgIUs6MQisibS1tV9sa1GG78HsZz0zW+uDTOsr8OvHUhZnREBvStAEebJKaCuybHQiLvUwY+",
+	"cN60t767nzd6RA08Npbn1vsrl5DCnR82gXYwxfdlm/QM/b2CKWh/LEViW3G+BnsHnjTiLP5+EX/mMRMI",
+	"2IxRwQFtOVReAcEpKk2hj2ktl9NL89oaDFQfjGBBD2beVxxoBiD1bzPROfQ+FeXvdBVe/hEfFUKuVrVa",
+	"RTzo+Pws+f3SYJVUcvCFmudpo51S56eXpocDT4P5odrkrF/pBKCb/reycpYa4KvVjK5W0P939MvH8AOc",
+	"+rpeNNrP5rWScEeEmlSv6bdv6acLShGEjunD4VAxE6YzQXzxvAk15UKgbNieuGiELgtydEKL4fWfnKJm",
+	"+00Wl0aba1npsv1J3BCuTbhjW9NJLIE7c63AGILVvXQpCBhX/FsoZ8MA6ZfGKf/vHBtW2cXVLNT6Ahsd",
+	"It7QRZ+yZJ2gXwlGv1sWzHGTYikr19Wf2o24sOX9JVN29uqv948CMSX1om8bzyPLpyPtyXXOoEPCFKkw",
+	"2i/HXofbZw7MZKqeDre7AzUsBs7K1ESuVX0rLwLDN+5zUJBqdLvW8dP9Hdym4VyLPM5JuWvJwEYR7S68",
+	"rBEQWnyJe1IbYBanXAIJKFSpfWIj7+CZdYptjJlhIpe0I+kSZz/vfVLOv5ZurOCRBKtqWiuG3dBRJeuk",
+	"zWmsJkIwC96Klb5WIlOSfM99KyC94+0DL3TMxhmWD13N7lJ+v9v9T0b/0lAcF9+ROl6ZvLH/kOg6wiPA",
+	"Iqb9IjfLwwt6rtAZN5AobBjP27mkc2PP+DJ5mw2MowkGoMEeJh9NvtP7NoPR/BLvQOi9nd8UyuatPre0",
+	"4NydfzM+q946Jkk2B27Lg9WIn+bZdDiBhMwpdadccfgoyV5iWdFBe7zGoqSYipZIHnSmoig8FW2L7GTF",
+	"b2TNpe28pShmS/HfKS4kR3HrWC12ZUWzFRJrFUKBrLOOdyXgQ1MH2oV/tbat00tzac4Gxbowkjoob0Co",
+	"GIbrw8fQUBHDrrtWJ467xpjaS9OlQqzgBw2cireRcq4rqktdgkqJw1HQo6qWmKgoBZ+DL9ylSQsmYqvB",
+	"HBKAN2A3oFHgpnOCdbWRguPyw9g6Y+7Sn4vI4dqkqwe3+QWYzAKSFSnGC9tUyTxynqJjxUhxEpCrM2W+",
+	"xg7hvrDBJg5xe9Q2hgzPS7CX0YtBVTSm/oAEd7Bh3YZ6ZDhLHEkHkp72E7LbWtFO5gB5D0YlpDXkXlca",
+	"LvrxF7FR0vDlq1+dVDtRwqIs8BvKMAtqEZvRtBMbVSvEsQGCQS7QB6qq23YB46AgDChBWqmSv4YGGT0C",
+	"TRb5UYXiPTe6qtit3kG4vNYS/w6JAeKnd4VgE0SmRcrUR2OhXC7VIvHeJqFgjfPBWgH7WfskNabS5qqI",
+	"hoZBFyEqHgwB/2zKlQrxJ1dUp28rawixq5I8mmAEjNYMVRZ8Z8/OIOqkdtnKxyBPqaDhv8U72T5rwL+3",
+	"C4+wHgEzJCnpEuIwOb2hf81v43zEv7ENPdyo4Y4O7gksLFfaomtBSabDvCTdFToxKD4+VmNH24yolSnR",
+	"mY4WUSm82mzxUKESdgsMDO3q66ZMyjjq4I/nO8m/JfFJ5AEYse+wmSGxd+ybA5otpOdsk0VqU2FbRt+z",
+	"klZTLdpDc767zcr2rDLJ8nLvsVDbRQzH2jcdi3XgZC+mKZ5kcO6tGliVNkIM/V7SLFSGxPvjyP49xleW",
+	"yoXIyZBLBx3WquC/h5oC4p0Mw69QVwmuHWIVkKAatZx9H6UgeguYTqXK0yQeh2RiN5QN5VT3J2O7fwcL",
+	"aOfHENDS/ZXMhN3fqmrTb49LSDWu93k+3i4bldNztAzPEsq/4AictjWKi8I0RFAz03qaGcDUTB7NhKx0",
+	"lAV59b+fpnIs8tfhjI/cwQvwkfflC39YQ+BRSQM5P0Unfjsgo+13WWTANSelkhMKYLMl4Uy3lsYv7GaY",
+	"wB+28wggIPum98Ftjj1NwDKzz2MV+QkxQ3GUyZCS/judpS2PEfWi2WzkfSCn9ksHhVdCllWtCDknnEB0",
+	"GLeZr4vaOscmvdEsxzuCsQ62dnfQ5K651wEHcNz8k9l8l2T/Tw/BG6zk/WGg9tgtNMwzGQz7uDy3pOt0",
+	"Lcd4E25TlTZqD7Zv1mt/wQkm+EI7jim+9gmsPd56ZqfcQnyrgOJ4iL8jea65tOEB9j5m4Ih1NWUgEXby",
+	"tkXkp02Xcdm+187betcCR+9NgAoT7ndWHHlodTxUMQuJ2jrIu2F6KYoYh+WG0ObuWgwGqz57VRsZfsip",
+	"Wp/41rLPs39ILbhSGZCDd6Eev+vZ8lMLf7gzPbY7EUZc5J2KowhvfQtNNgEscSUzglcy8dIqlxr+DNqa",
+	"TsXA0hd+cKnlTowa7tpbhGsxN3uh6PATBjzHmLzAVrLxdsbKwQnVNZtRa/BSb2h5HtIbVe9NiiubWkI4",
+	"AsyX6BDD95a6htsfmVFmIZgDC0bSqEMoBxWZDQX66PZ4aeKNrhDGer3czTjxIL30F+jXNyIqNdzdaZs7",
+	"F6x+4bInL028e3m3x3pLi9gG5/fYJIw3Gm/jgPsII535p8l2PLQ86bO1RbqhONODB+5J/9crYwkfNh3G",
+	"9IqCe/wrtdwor+qRwMs0CjWxanQNGm2Fhn7tR4qqG5DrztBIWTnTKSWJK5IVOoP6yG/LHF4E1V12M9Q+",
+	"uifb0bnAx82y83UxOpBpk/tzqBndnZ0qjwFUH6FZjtFsead239sy2+5x2dVKYKlsZkmUOZSRcrS60VsL",
+	"ml7B5Ju2Au9ZNtzdxTq6i28DxXtv/Ml7cU+eQVZjHIrYhbd17ryzYtECuJEVjz0KmGxXMHA12A317Ert",
+	"vrlsXr36egHjwn8pKl6MpYL52ZXa0aPsveOYSKXHSpAolZe6Oh52/FYqfbhEPFoE7Z3jIzrXgqCsE0dN",
+	"4cjrbO2Gnyl1c7tVpnWXRDlzGsHXdKIkIku24ekLRAQhOs6Id1Nw7hSMAp+C0ZreLmI2M2iKzL6omIKD",
+	"TZUz9B/HchFzBZ9CjJKBkYbfVeT8osUHb/0n9FVANUuRDyrrfPomR27WCAZMaZfSoGMbDvkC3TPXePDH",
+	"IW0B25gVNqDFL41qVPgWwwNk7VnVRh2NvsV/1gyDPbeNF9p3FDsme5Q5ga5JimxCspPiJCEYa4GEYdqZ",
+	"LH6NmY4r5h74/ywkeKJhj6eI/6YRj6qQwF3vykyEe1/25pWH7LPf9nDyfSdwlbf8ZgyOM7IjMlLLimtV",
+	"lRx5EfJDG+N1JcihmC8Lvg918641l7sEzeUx7ktR6rjEU5QXv1a9aUav6SPokEyV6WkJXSqMReC1xB7F",
+	"Q7OVqBmKu2DgygBOB9EuYqlAgEZBwUI2rR50criaOY7i8DTGoAY+tbk3BLfb6pLU9in/fyYXC7X10c2I",
+	"vy0ruVqp8KcLqTpLsFbLxdWlyc6qCJ//0shaGq9Nt4kXvkg7SbYJoYwSCOmlafeVDRdo26YiUeRR/17c",
+	"mUrkjjCR9odkaO2PMJK9Uo9o/bc2UarPM6N79y4btMcTE7TRFjotzxEuPhdSzGt7g+EkK4oWsVehnJVM",
+	"ob/w0nuDsVx81lP6P6WFEWzvpUlajl5/w0igqm4VBwjKWVyFC3Zy5RZO7jLBYTIpjHmwPCi/GpFPytmy",
+	"JhPQhAp17QwALFN/VqE4XXsUTwjYDx3XEbpur5rZh7qDFoBAs20A3Jv2OeHzwZklvZw1dXV4/Z2QiHsr",
+	"fjr/gUHCYk0v2Ii9hKCcPN+HwxcRncMKjqOYdVgnBd+nFlJm7GQNT3G4RDjAfhg5H1fdlW/DFecYkWNL",
+	"VR52yEceHduaFLg0DhhCThNt2mSiqIejSCQKJMUeulmZ0UTlcrH8tyn8M1pBvTiBtCVVjhSFW9oudA3B",
+	"cWgw3X4MNRLgLJFlGWdshRTUaADvsLWopXaKxIh2VxxrO2+8MNYjNIdEAJLTbKUC+GQWYkT3Cg0NjpQE",
+	"Tz6fc/A+AWvyWF3R1uJ/4WTJPUvLw8HGHFjMk5mm1bC9oR343jrLCOaF4/tW1iv1zuTAo7EGQFowKUC7",
+	"hUrCL5xovFe1NAsV8s5aTcatUZVx3m5BMhOETw/hBTovAbTiGKUaxzFi7gL/BdM5Dm2IZbOV8CzEhJVH",
+	"KtNOrTCVdFQa0XO+07VkaQfU9nuEBWA/V/UXK1Wzc+3GwYxeqlIW67zdoUBnhGFtiu7K7ufAC2psqBNh",
+	"GzNtJiHTdZj5YesC2uXSKT/b3B0h2G0xr3X6BC/4AwzZ+Zy/0B23st06gf115u64t8P3o/6ijqL+dGg4",
+	"gp8U9hEiXEldYjD8RleV5kjxRI1ExbB1Wu8L678fsmeudmGcybAiPVNlhOc1ZVd2e/lzbZutS2njQvZA",
+	"IocHOP8vENSKCyIet8+76z9xyfMmlzvtZtfKiIkrxh/0JxgaOjCVlkGGZndcYhm5k/JX4qenAtWYeAym",
+	"ZyRXS2B52Y2qDfY1YGSVj1ZtETizgYWYCfDxXbhpY2QqJCWsvd+GmlTnby8+wUuFuFFzByoTmRodFVoL",
+	"Nsp1M8eftYf8IKx6SVX56Pa9qreLdobUHhe6aMMCFIZRr/GSsDr/+FrAyLt3bhjZSXESh3JSnDinTooT",
+	"6GCEBI0hgJgA2DBCCyvmNVUTDoZkjgbhKsyoKNxoU9qbU4QnaBPm2wSLQpS13c5sVUID8G96zD8Ya77g",
+	"6qABKLgQG12WlZqBGtfWkKRYdsy84DfJrIwtYlj/PxsXFAbtC+Ew5lH/SwVN1eHLW1XGrjhLgCKTV8qo",
+	"GrV9+nKXshZM76Q4SeaCEjKME49w7m6M6M6PXUB+UN4xH8wbXZVOKFkbIRtvjd3seJSo356Kt6jrxiIx",
+	"M4wAqeTn9icqEFPbm6DXYKMvXCj1nngbWgU/dlap65A+EV+b78gU32zha6hJFV6f4evE0liCMhQW56CQ",
+	"YH/jTqnx9n6ZS0QbTu1QbhQsE4SsjGRzDsd7sEoIv/0DvtyXf6GzIjfUbHc5SfmTywnHs1DyB6CIQrWe",
+	"tDz6pxR0iNBDu9VyFrYuyVAE51ZI+BveR28Ts7iBXTAebDKMy+uje902HL2/Ahvajvh2jrh9+KF9Dji+",
+	"/EYis6tL9jCWTgkrJMo4EDEgBLVpFO895HeCiyGo1F0oNqF9Es9EXohW9ofMIp84M164iPPalfc4CK4x",
+	"Dl3DP6mvrNz5maBbp4BIApR4kow4Ibsi+sSCQyzHGotK6s0e900E3uIXu4eHXorWk3GYe9RnH8OyjmLt",
+	"Z+yPLk4C/i7qxFPnNBVwq59JGv043V6LDn/k9tzPcG0d8XcbddOGGA1d2nBSdKzdrZMpZlINQzTDTgQG",
+	"QYdGY2ZLbbRbp0oUV0LEWQmNIYaw4SMOcmd3dcbZCdNNci/SfkY2nV+s31sfkfieEP1ySpBGsnLTb/DH",
+	"XNGB30yu5tS7HNSLSShHVdUq7XxI2rNGuajlnRRT5NQ+8eSaeRzQAwXhHYVsEmlVMNpbb3ztbNpolLYa",
+	"0X7TAq7zRdJg9hAw/iEjlHDM0+3gXdbsW8EnjvPY+jfHcPY4Zx2x6Ll1dbdYz+Rs76k7EhN3gwOXi5kE",
+	"G7SI3o1TAY4SumPD0EvS7I3ic5kpGVDqG+Ne8K0iTUyGr2+wxywm+VE8did+2Wjzjr76Mluo7IG44oiV",
+	"5+llV1fN19beU7bo3gvSsTSmgT3yrmRv6rGJp/BZsqPau9uhvUWTfMOFTLNx+2qzHU2evNX5jn09RCJZ",
+	"f8km0hxx+sdL1ePjECnXzVNISIFKOVNrP9h/rBqLVfTxA1ViPk0RqyDQxajpBQLsr+aMZVYnkoiLsk6/",
+	"E/QYpb0i3NCD2ydQJw20Z32YTaKoR04c0vpYNofR58pAzaUprRnLbI6Mm38ckEtr6dWBCJB21blNSkmT",
+	"XhAaGSOeAOOFd6cFhBwpbJjFRrLAfb1jbLDuVP6KXw2GLqtayXIXpiBNMvRh83dhmw7HdMRg3DNx9Omy",
+	"FckK99ZrItPkgt+oz8TcwcPjOrBlrGH61efPMTTZgzCIIzsVb/J8QDcFpiMh5IQZdO0lceL52Wavbzw9",
+	"LVfGOq8X/yV3xD4W54XKrOlbuVjHdcTlS4bFXjNyCXBdSOdjURiYLZnxUyz8I2Wry2kc1GNqvRq6M5ay",
+	"JlN0MmCg8YJq8CCmWzAacFnvNhz8QOhRr//iENf1OCAh+J7tNmJayRh2stYSOFOpllQwLHdNLjFkHTMM",
+	"ht+HZEt0BFAiC3MgU0362AZ2lvgCbC0CYEWM+Hc+uILSzNjGzKJlJgm12mvBORXBLxq+yEbdYB4rZrhe",
+	"YzFruvpox4E1Ifimo3sgxE9SHF+3RmOqiy+wLH6tfE0VLdBrLQViS+GovoBRYRDPAnHqljgIEF9pmI/c",
+	"USH9047lq43PDZQYRIJDXaGO7eyFC28tbZ0ma8Qo3q58zLJPmsHANZw6KxMCo2YRIzSsQM9mtj+ut6dy",
+	"ZQXh3JY78fHDxSfiXBmEz6n4GZcrhCNvKW8pwHKjk1gBR2KKo7CmhSk7zcdQ3davfgcFfDjdoAK/cOLd",
+	"GzwNhZMblUS5hTMPceEXCt6maL5SlQ0Wy/KTQjLtYtHUx142jr0z374G0hHXbcZauKv9/jZ1km5rC0y2",
+	"x3FB6fmbQrwcpCpfusB7jpVRf1BiMujy6RsC8SNPct2oU/FGO3w3bE4XkPgx1cqoG9p4Lh8oes/mh2zY",
+	"9dnc2arxiiI5QHB6v3UQdJ3CF8patbLmcKBPalrIUhhLz/2gsrDhP3OJAdyyYiN3FKqPOsqK6liHQn+t",
+	"Bl1BW4AdrmsVaw/gpbhWRt2ocmhsW9CAj7MpUAdHfQMnUc6j/S4A+r17Ex3/PGn4JJj1cWZ5GYITO2os",
+	"RLjD5iJ+Lw6+6JCr03eHKOOLPZbPFGANszFpe0jUKQVZUJzLu/cXn87ev347g9cJV3NtnRcBFH1gpgHS",
+	"7itYGAh2xB7E91txurfccDr3/mjarsdpOlbRp2ud6u+uXbJhYj4BfAKBXWGZBboRw87JU24aLWiX02lk",
+	"staBn9eKNUbt4vrWDUNmRlYcykcOydxnO0taxCnyJ7cpgHn03gkTHi4gfKLN0g6H/TeqwCC+DPwe4XfP",
+	"Pr6DUWlfQUu9n2MJiZPrL09fnb5CPWarjNzqk29Ovj59dfolVwZGBnkpy402L8vuRX6lMnSkfZvGYJCZ",
+	"0RVibW8EApsnYWtcTze8upaUOqdKep2PwUuT3DV5oltYnrVtariQqjJpvpRezoFbSfj7GuHO5ZUqOpEh",
+	"7tJwwE+bOR5iDWNlWpFUpg21RqlGWHiVfg3gwqnMuTQdoSOcInCfzal4r1TpBFL1G9Y1OAXQhrrR70oI",
+	"slU+tZ4UJ6HMLy7AV69enSCmp/GsOcst9gzfv/wnpwzQ9jro6066QX7rqSqdxyFIb0dDJNIpWfl4jQi4",
+	"1AY9ZYQNSC7oUs2b1YrCIUu1rewuxB/LlYP9ABz6D+jjJd7pXv6K/3tX/pbw3IBKZxyx+mD0oQ4ylOEH",
+	"xckfXv3h3np7C8L4nOcy2itmDy2ByTNrEn2SGLSY0neFMcc9ZKH/hKP15JtQDZycbidM+pNUYhGKRTuP",
+	"Q5bVf2SW8qWvG+cPLigGe951VSedw9SdtRV1OTyJByuAL2KZSriIPEMGAHm4aRbrHidg9DWMPQBAIcor",
+	"zCEgRLUx4cKaJ2ccAozZyypb/Re1c4/DJ9jXFP74gbHAQ4RpbotWVXxcxIQ7skM7taiVdyn5qet/UCXp",
+	"DCleo5WVXyPCK+e/teXuKDr0Lq+3uMFMhELu02ujWW+AKqB8INfK2aZeKC7NwQ2cCljwzk9oucGsXzI2",
+	"iyt+I1p+wreTEt0WdnsESBbR/AI+yl6kD9ZLDbNuq50WHBqS1ND1EWCf9Axdu6PBsgISFM0vr2l2d+xv",
+	"g2315b1JOeLYMmyqjJSj3REdCChlXz2elP1Wxkr91PfXj9f3p7Vq585I+Yg6IVa1NIzQiOsobB24uydl",
+	"iMBYXZQoSborCRc4CXDHUJl/hgAROt6DaGynORmUiOaXv0r8lTW0UlWKnHFd6XSuru1VKp06PPWHjKWJ",
+	"177GD8vHP2G5/7EzliaU0HZEVh8+K5l893ZYJivysraxqv4jDWTseDrHkdzz8bSq5UJ1/ZVoU8Vky6Hv",
+	"Mr1+4uJSWhRcwcmVv5GfKTvmT6/+8P999ao4VJNpID6fVFx+QtTcm8iQTy4un3a7wgj+4/EFNkFaotAq",
+	"2MSM9rEQvkJbciBO8NdEnBSt5JfUbkglQ3UG9mwRDgAurEy60acx/iZ0b4LeXCixVbW2JZZAQ3WdvF/u",
+	"RntwB2J1dlZJS3tjELX50oweBvVira+V26uoh3ceRVOnzqao6nFcectGKTWoleCz1WhdDnMlozJGOKjP",
+	"VP2gCIatEJ4RidWU2vdo9fLXUu7w0AwSs2eTrHUoYVQ3xhWJMggLLqHJ4HEJACsIjvXTp9eilFGJ5v7E",
+	"vIHEVvbPg3WqLTDk16q+0Y7w3BInaRtDUMrdqQiUosjkWnuvDPv2TcnayVxdGk4UZeaisYSs9LANeFQl",
+	"xTgsrFlWkB2WsYK9ReKGBR0cqT0oHJ67NuLvf//737/48ccv3ryBGW1OityhV8rd3vMuc749mICPPDvK",
+	"o5HRHl220wBQD0U897bqFOLH0EbZ8UOUHjvln0QGwzByjAaD+eOrrx53MN29x7FmPUFD/N3Zqni1hYkY",
+	"ezNJirwE6/hyt8cuL7kyWxwRxHChH8yv4/hwG6/V4srh/WIjjV4q54VcSW0cW3qlW/NVlGOLLg2bjlox",
+	"SOJjqSvV+TY0yIVPAwhhNNmvpRPGxosu3d8vDQmQduzaiY12TptVTl78DUnxbOXFq/uWFzhfbmGf7Lju",
+	"vPds5Mejq4qJkICRPHv5QPyclw/axTMat1RjWny/vNQgcLeXv4Z/HfCspEiED8jKaTejpArPH/tqwR0f",
+	"9Lfwe0UHPC2MsV2P88ZMNQ3ENboH40Bu5V8mFMxywBt7YyCo8NZsYBde+S8IsaW7JnHUc22AjsNx72WD",
+	"Fy1pnwNDgOx4ROvgewswCnMCBicp0MrTDnOGFQz4tj4ABfUZFl94TS98ARWEgkOo2cL37C96ej4GaTar",
+	"7OqlNIu1rfdfOeHlM37vUa6dbYeTrp7wuggTGfGsS7du4x5w+qKyq+T2Sd/zAuFboQiW4PqjB++lxcgd",
+	"9KN1PqB8UUI1A9y7dVv9/QZaTq6j4eLJnQvpxdlPb959mp29f/39h/MZQLRemhaMMH8LJRWy8+G795/e",
+	"nv/t7AcIWk6Cv0M/IR/m0iAhtBNXausjpjVSCUP8FgrQsTKqI60cEuUHuzp5yLteyihjjAHLHBb38TU2",
+	"7HhMY3t8TSkOJyx3VlmiUZOwa+oauHGtZDncPntuVlHCHLhUMYRUwvggiNdSJ8UorFEBh1ojjg9sHXbn",
+	"eLuiULa4b1sEamzvhcPXyYxiwuaikmuy8hTLWKsN1lXGEmlOYbwa4jrcyLp0Yl4reZUkiFwavvRJLxCW",
+	"WVhzKlhGUiYPXABV2bm44YaPbYi1LIVsfdUkGfbcxUY31Kv73VAI93voPpQ+H/DFHt07vMKrwqRgbLoo",
+	"xPM8Bbftpa6ql7+Gfx3Qu7/l1x6SZLGPrC0/PHtk5Sp0vF/bFoGMkf7b2q5q5dIFSDINJioq7eLcXVHJ",
+	"LvnLrWzcRH/cfQ1mzCP3EYbyXPhMIGHKZ8FuT2C0jOxMZ22IBe5yPi6YkOFp/GiU5cfZsI7x9YcEEEfi",
+	"PwJ7cE/7FomH/SxlEgTctXIJMi3XsrQ3ARmekhfX8lrF2joY8NRWX8eCp95yduXCN7KqdrG6FTn2iAAC",
+	"yxy5iDYMyrKsGgLdtGIpazKwaieWGq4BNoAARz5rsz4vzSj/PA+RWSvXbJ6JzDzHsTwboUmk+R+pSVIz",
+	"HCG9OB0gkZD8tP2GKrmASqeWmFG8V4wu5FbOdaVj5IkaK0qT4N7vtip12xaxhtGcATUZeBRhCJAlXXoh",
+	"vjL2xgVXibo0PoAuYzQgvuQi1DJIBLwoXLz5SyhLgUi+yWU8AeH2ssKYAG9HEg9epxN+QD6/wHF1ehtZ",
+	"bZoB4u92Xu4L4gDaGqbsmi0SbZBkMGb0QAo6ZVL8V24kgTbA3CBaHbwpxVucruHKWfu5kt4VQP+lNqWw",
+	"jb80scEXpWgQ6Lo71lAuO3Qnk0GEdzALi/NKQqfSo0N/x074tTRlpU4FRCE7ZvDGh2xSvuCFPJRayfKb",
+	"ujHZFJT3amW9ll4N+OF28Vt7A5wqrYwfssKhiNT7Y8bY9y7Me+QOOcqPEcFZG2hfek3t9SMzYQmENLBb",
+	"By0k9+7QRzZBBsvhk2n95a/0/wO3ytdr6S/wxYfc0kkvGdK9JtQKevzI51bS915djiwdViNOvnNqM6+i",
+	"agVGnACNEUh5vEk8LNfdlaY8F7xcrBtDyDOPNZgxcRrhJzTJGrZjzXf0j2DdoiQVOLigGcpLoTcJMIRK",
+	"m1K0s94qOhJv1rZSMVZZ+HVtmxVWNRdIABUjEk8FxEBwMdWtY/MVw+FzP7iql2aIeFIkaYHIPGyEa6Om",
+	"nVg0fmaXy6xZGVT4st0Wr2lt9klRKArwEoeVdZ5lXGWPKCWn7m/Gl02QAchfAT0/gUX7nbmWlWb+ey6y",
+	"55HV5nQUaZQUVRjuGxxgI9IR9IWDHcKraJe8V8I1EtGILOUk5GXiPklFbajnIKvO0g2OBApwQ9oxjZIS",
+	"kvC8LXfBZn5yPQSnqrw0vLCzpa5gNxDktaCiXqTghdSvaAsokro9oRareUEQ35RDnJEyr5mOj3fIvyvd",
+	"Hh57Eq/VU8ag/w53+IWPLAuftboOKjn79rKuF432M3QvqXr/nZiw4WpHdyaKOvyXqu2gRA8+d6gRhNuM",
+	"mGNJS7lVpZBObJSv9QJF0NreXBq79MqQspAc2+AadMEE5ta29l/wgFWZ2zqgGtPzb8N8HiNaoNvnlIAB",
+	"/kIEshfCbjEGWzn27Y8os73vWr+Xtxsu1hOoFwDuWhGUrk4ntIyQBJkjsHxGoMivnT9BzNOFdZqQ7338",
+	"cHopDYrB6iTVAbedIo1wtMUyGhSzsLLKdcrxRHA4qL+BxLs0ehltLc6TxZXRJihgmr5Em6+8lroC4Ke2",
+	"oRgLkY9SgEG/Tmn0YDfypA/q9tGVzc40s3EKuIQhIvnJtErm70c/dFL6PLE9NpTa6cbfR9QlW4/tLPyg",
+	"Vs5W11hWTBrEqR7EduBKy1x1n/3GWwxdeVmrgI+YFwhtkLxTHjKvEIr19Yf337378+y7dz+8ZUMfmnjw",
+	"DuPaUhpUXFsaLrDNQNut9Lw0dWPcN+LN2x8/zH788OZtIc7f/vWnd+dvZ2cf383+8vbvhTi7+PT2/MO7",
+	"N7OzNz++e0+/vf7w/uLt+0+zb88u3mLklLj46ePb87+9u/hwPnv94f3rn87P375//ffi0nx79un197P8",
+	"Yxz02//78cP5p9n5T+8vZh/fns8u3r7+8P7NqfiAUSixIHqYvAdtUy2XCqvuX5oosGqFR8GpeG89BbO4",
+	"cKkTGu4G3AT8rml7JLUIs5hcl4ZWxxVCOgoAk/FNvHtcvPvz9z99nA6ec47tvcalf1BFGHug3sZNhYGk",
+	"aQn2x5ZUKSeTx8QpX1DVirhiBmwt7boNvCkxlrQ1f3JgmOztw8RQCSMy/uWv3l4ps99CSa9+rC0hMD/k",
+	"siUd5ahFL4gtv/HYcp27DxJyn7mSPMZGgMsCASVSkGImPnh6eO8Y2yaZUrGRK2VCWc5FrUplvJZVmvnP",
+	"o5lo3MQGj02TGXW4bq0pP9kwgvtKHV/YTSjjOsD/QISFfJWPHqBGePN2UBqPyM2B1Xp60vNg6CdQVeJW",
+	"iWnZxGiJntJWMgTtJARtRM0ch/3lq8cd9qJHRM4vx7F89fXjL2bI+RW8ERK1x9YrafS/sPsXTlzBHYiz",
+	"y0E8LTylunZPF+BN4ZP1eZEgkdyH+ILjqLSLZoPnUfjX4SSoN/zmAydBxW7G8tbi80fevWFgB8Iyw/g6",
+	"12kuk0VB+XdMiWpX7O6es6WSHooHLCscxogBK6tv5ixI31Fz32Frj2E+SjqcYjuicHWetIBJ96pjj9iO",
+	"oqKXfkrWtTUXhED7m3aithUYD22TLm6rB3YI/vJX+F8PNOg2pH+DXyfEOLdVRUM4DDPE74Yo+kffV++t",
+	"cIDSl9J2IBVhaEJ23nlBxLYN+U8RuzqYpGCPMRAOZdFgqFMu/OXgdsPhHKvINXlgWKod7tf9CVBkI/xG",
+	"kFQRp2Sr6oUyXq4w4TUwQCG2GvMTqCiyrsW7N5fGWSzCHWCwk08JA0X7TsvcFvwcFIAb6QCvZaG2nmPD",
+	"pPCyXikIrWlqE9qwNfqDqCwEN4Q/Jufd9FvqRUdupJx7H0puSwb4K6IbfXkI2qg4oZm728iiT/jpQTjr",
+	"ZGxPrT13BGn+4EX2lB0J91SGxnRb1Myiz1JuWUjP6LwxejJslHNypV7+yv/o5SYfFlTxuzv7CpqMDvjT",
+	"tpRe/Uh9vI7qy33dRONn+9Ebw4tPvV2YDm/0cpljDX4sNrbUS/0EZ2oYwJiq+iMMbDdIiA7+fWalgq/K",
+	"hMB1AxeTSl2rSpR6uQz+MzLlJSzNfe9ha/h8b9JyQt7H0SM76zkd2pbnJGhCz22RI3wXjA6GS/nExJQ0",
+	"JIEluvCCEtd8JE+6Xdbi8YTRGAdhHHgVK6If4KNPydsHwHDeXXwQf/r6P774UixsqQKPV9KsGiA11qmh",
+	"xpTQxtsiqJlYwwZNfporFta7lhx0RM1COydPhZeTIUjutA9TjJLgOfD24+NLJFyGNwuwyIyiTNDtfyMX",
+	"a21U59OMZH1G+8q9/LWyC1mp30bv/zzECDnVgmbRl5gzrY14a1aVdmuIM+UKE2KlfChtQRGmoVcu8Xhp",
+	"QhNwT6CKfNbEtGqsY47mSFU5FdPJKTKGoglCHMHPan5hEUIIrCwjIS4/QGf6X6oMU3pIY9aws9xREl6K",
+	"lHn0vfYDrYCxMelCjR0lwe38xVIu8KIJvlDkb1rGQlT6qsXsFpWcKw5DynJBagALPJPbCf0QxVYgy1Xo",
+	"UmBtjS9ef5+HLaMBHneTp23iFfw9a4uLZTfJt7qqyH3o1Yq4zomtXLUh2dQAXNq30sV7OhVaxijhUNiF",
+	"IRDw60sjHQURn4q3bW0xg9AfwV+NyY3BrUGR2miPWsO3RuhSbbbWK7PYEbQ8hm5fmsboXxol5KK2ziEY",
+	"PxdXy2+eH5kSb0MJ9L2LhM5uig4PMw8j7AdFhxQe7SKSguDysvnjFL8/yUo8bfyf/pCtszqQamQL4J5Y",
+	"PzJ0kNO4u4d7CvApNhQ0KI348tWrVyPDrPRG+9xZ3xlV7svUkkLFrqYL95EmOxX9jroPPqA2kjDUR1Qz",
+	"MsFNtIlQ26bXeZ2ezPoQg2tzGJa9QYZKxsD3dVIQOGyFkUhCLkV1upObap+C+2GrDBW0yi1Sb0PSu4Kp",
+	"kRfwvZdyhoqUN/eOLXnvcW5xaY/HXONsZ6T5MiW2N5tAl06fh0qTdF6+L+PJSLGRXN2Lxyh3cUigDFYh",
+	"JcrzqXPxH48JM9XhruQ0hEWL1nn1WTvvRupbIOq97bLXCIv29/DLX9O/DviBBxz8QEdDdyvvZ5pHV5g7",
+	"HHsAEnPamky5+nVX6e73v7088BKCFWYUrLCPH/6iq+qC3npAbkh6ySzHX5K4CuelV8+XISh/EnYs4U+O",
+	"R4gUQptF1ZDt1exiuIuE6pDwIxoiYJl/v4z1MqmX/bjDHI+1wwH1uPo+Tmm5CPrSNEY/WwTRRmlyh094",
+	"7uF2Z/xXD7BXY23zXCwePgrHfTHC1k9RcSqJx6d8w1JxHqPplpV5BvLlKeq79ILYWDnBaw5m3UmvKKDa",
+	"YJxgpKd2Y4vczXBwGMCBwXHSs1En/rVXZLbB+GQXcVzuXAoqjyRiMTXqPtSq5eT4nzFuD7tSBZVTlrVi",
+	"0JxCyO22tteyol/XCgFIStS7qDaJtxbSVhdr6cnilcnyoI9rtYQ2ia3+8NXXXQCqY9W1nER9+etVfxuy",
+	"Pxkm/ujytsh2kBniw0j11zTt56arNOhSLx9dyr23ebGG27Z9kGwOjH17CsGXkut5RE2n6VpB+PG2IgTa",
+	"NVUB0UMPEbMhFLMaTutU/NhQRflkadB1qxDCN8iuBFQXBS6+ncqxu0iSXxrrpZt6//srvf0Ylh3saopJ",
+	"h8f0rC8AROXMDSBk16LdGK1ODOtKkXoBLPn5aPsjsUIXo3xyO036rixySPnNBMXSmLsi+glMzb+EST0/",
+	"buZw1gfm6MMyC9Su2dZWeqHVZNEFhc4/hm8eQ4DFDo8qnQ1zE3Fuz1qohWjrzpA54iiGCC8HaTFCm7Wq",
+	"tXe/N6E24KAHFG2HmOcW8u1TZ5mcerpY3pZhds9f0OW5fCj39gu0bSXNy1/hvwes7R8r+aBWdmx/RNHd",
+	"4rNHXhAY0IH8KhhXm0jlvNq6CE2XQElziNC1qjtOVpzxNJlC63N3c2hntV+G0Jhpd/D7GMPYrfgNpnNG",
+	"Frt/6BRo+k2Y7iMHaO/j7JDH2nL4E4i9yAdPvcUe+Q6N3YeLM69E3wSIljY0/dUKFQfe9dJBGDriXdoa",
+	"tz4EU8H/hzs8t/OuNbvvD4jcN+2bj6Ebdro8Rj1MZvTsBHVPHGOMIKwEJL01bCym8auS4km1H409fwqp",
+	"TTrrXlahVx6JSXg8R7DHNowvH9GybYcf6cydHIpjCe89cAhLMYiDO7x6xUndmFmtXFP5mees5kjhwct7",
+	"8/NwWMMGHyP56OgoGl6SVqo/eNxO6PFZhOyMB8VsI68OuTzZ6C/n1nrna7lN0bG6zP9teOW/Kv8XJ15t",
+	"tpXMZqLLTcyHCW8Jb0WkG0rxnPMnt6diP48RkjZBrsalPUfKPUd+/8lANQwTif/4cWrRkHOrCLXex2md",
+	"EFf0btToWbW+zVOL8GGoSEQSHNrUehOKPOV39Dt8zt8mQGn3sannjSkrNZH/qO9v6ZPfiigRxvdgItsK",
+	"LmAPH7+I5lVaG71ENW2lrzE57R4kTG9D8zSfyT6mBX2+mzhc/+Zxpf87BpLckyTBa4Psou8xZcEFi4lM",
+	"hJGhXRqSoo3z0iwOi48gZ9yEa8Cn+O4jXgc+JWfBkdcC0U5u5PYWnrf+Ggajjkf+lu9uBwn5K//jkL0z",
+	"0aseyjDEXYzLhse/Swd5vd/uuUePnXQxDitwb3fjdFVfIkb/lH1ytuLssUcoRb5inLCpW4Mn8RwZoK2D",
+	"MG90hTWrVtphAeQuEE+as7OaDlh5T+wxHlhLo6Uh3RtuSK8k3fR7zuiNa+BOvrOHjto8cnxQ3FJPifrl",
+	"+1R4P3T21OoYb73M0b8i8MbAvE8HVo4DsXX35vEc9v6jR7VpJ5h/YlGEFddyb7FB2wXreUfpgZAkmNgZ",
+	"yiVbwlVvUB8OuVRosAEvKll3MsGD2Bo9aipV+1ndVJP0sjN4+xxffpQzJ3Q35dzBlwXN5LkeOjg6RirH",
+	"4VoT46u1ac+dFy7W9HxqHWUMgA9nImuV1ApmSBxtGq9OBa4H+46XuiZgiwr4uxSN4QzeqEADHzOutNDe",
+	"XZqOxeJGzdfWXlGBFwQndM0chjNnSFDkYuilHEHFyzPwA8aZHODdW4SZJAz+pEEmMo7j2e2zNLxEJuQi",
+	"j9lE4/VAPE6WjAdxHIYwCZJ3SQuT8OWrV3DP5uiYyWgIKRpjCsf4ZQa+4R+PJrwnC+5nfFGgFUplMzEV",
+	"ipsCbIdZN+uzulDWK4Q5ns2lU5U20w57/ujb+M2jsE2v1ykchKWX+DsRp1gI6cXGOo8B/ltF2unz5TOe",
+	"gBPsmrBYq0wuVfdO+sKF7CgKB6aYADhcYXQyKSmojDBWKFlXWtX4HqukmhV1xtOoGy6xQ7EiZSeD6mmV",
+	"jtFzPMubD3mcT2LL25zqA7592sO9P5znfcYPiXf7oz7IyMmXIf7gEe9DSY9Hy0WcVjHE0MHy/fVTAKve",
+	"5taUC2fryEWWhxHNO4rVIlRUlWaXFnckLDVoX21+R5LvcS4xBxnuLhLvGVxl0qH8PiTdHS80AL251FU1",
+	"RcB9G999DOEWejvGx9DO5rnKrsSgE8Y6emXoVhp8EkdDr8SHXKxboQomzBtZYR0wRmGUwq1laW/AiKUN",
+	"gUdKUelrRV/c2KaCStYUVIFxE/yqrS9NhCHFnxzmIGBvTlVUmiGUssYIAyy2n4EBwIoVhtEKaiUXazwt",
+	"1KUh0Q5FHZsYBxOnwvHSp+IsX7S2VsIC7CJVPkNtWoq5RGCcynqh3aVZ1koVYt1sJJVxXFQa9mi/nW2t",
+	"Sr2Iwbl0MG2l8zFy3VHVisAjiIJwaQhygcxqsXJUtLcRNqVGLEiEy2P931EBt4SwtAxred3G63t7afA1",
+	"ufCNrKqdWMvtVpm8AY2iBeIOfZgUh9B8B+rk8bwsrfzJhUfyuoSaxU+W6SC9EjVWBLW1qJ8Cn+ljW6OE",
+	"tvKYCAziTPUE4Vo7b2u9kFWqsHH8STvBgipCSNjL1mGoFkWgqZJBQvCamwogBzd+idLJe6irAQS63F/M",
+	"NXdILtbSz5JNPOGsxCr5yRePUu+70+eket+w49OJPddjM5WgXORULa46yj5Vk1cllZqHwVSqDyj5PFX4",
+	"HK88oBI/hU1uocb3eelJFflFdzDPWpVf9Al3a2Ue5/bZz260Ke3NpMT91/TJz/jFo2btD3s+Kn2f5ypo",
+	"rs8qxiArwUbGGwtbewtL/lkHARZBrcYCkD7W9vPuuUiycTZ6SEE2lYNuIc3CHJ4MpWQAmvtcpdcIXx9i",
+	"2zEZRgXh9bWaTQYf4eG+DV/+TgBI4kyfX5TUeMZpB5chLK/YqHoV/EyknTP0SJt/6sYwHJ6VY5Qi20eZ",
+	"jXDohyktDxtP3c1fyVZLHsToPzsuItJ1NfbEeNPNM8BkdJoIq/sUHB8vfKpyCqtonopWlRXv3gQMSJRP",
+	"mJ9wpXZkEWrTeERpFeKPlmqrTEk1cbSLqQunl8+WP8dqCo/JxEcvGjzs93a1gwvgAY1hkr2qqs9SPt6s",
+	"EWuLKsOk80hqzso2pQwMdTfr3XPlMm3AKGj8bGNL1a2g3JOHpnzH7/4Irz6gLOz0k7350XMowYf13Z+D",
+	"AxNRP3VnZBolzwCa960puy+O8MaB/R6o8Dibvbsm0zWfLkW2qta2fJ56D9nac+PtKEDPK+prLE/kwsva",
+	"D/brfeSKjMKoAz3D8cwZsN1F+B59Je1LdNwHHzyZgsumDgW9wkqcig8G9lLINe2k4gJM6+E82ydN4ThO",
+	"mj2Vl+FTx/LKokuyf+sZWNdsnQ7v6bI83vUkfMzsGIh53IJdeXIqfkK3nvZwarmCZU4akBeuWSuF6OdC",
+	"ffa1ZG8L7hdD5eB5ZbzlDUTqCGyiApVcuwWpBW4+6IPgQvHaKra1ovB5N6b8jusKK+UwwR0i8qfopO/C",
+	"F9/jB49zUCVdTjmp4gcCZ5UJkwKf07O9quOgiTV8LY0Dadi5em3lrrKydCEGKgR+USXVZ5pjguEHMDUU",
+	"/BIr5WvDaxLw1jtLza7jIoIY8rxhs1F8PeXZmEvT+47WADraSufIPhsqStIYoMmlNugrJ7Kdiu9bulPz",
+	"4qtXf7g0lQJXe9p/Y7i85P70lMxWeUB76oRdcgtLam8rPalbSHfG8qztqrpHtls7hdLEqVmAepkgpt8n",
+	"312Ezx7wgpftL19hYQhd82wl8R6gnWcCOnDQPT3KCPcf8jPOA7cQPFlGeVLxY34XrHtxN9Ydk0P90qbP",
+	"h8EfpHLorbCfbnEnzTB+Op+W35/mfmanoID/CCH8rTdJG6wI3St2AI01VFLWIPRWj8Joad1o71V5FF+y",
+	"SjY7Bo/oI33zyLBE3U6nJnwElTPOL5MHJ+uV8s/X8RhG3rnChBzwUkF8cZ1DtgveIFOqkAb37E/bLGs9",
+	"oNI/iatuE0DRZ7snPXn7m+BZq/6DHds5dE8FheHzQxB7HQ4XUjgJwY+xHdgWlB1FhlK8ny6lro429gxk",
+	"5cstWZoe+0DP2rc/0lj6HP1AAPz9ffPIGPzd7nnq44XVmEF4Af9nH47vQ6CUkIORjuwtu6Q0FTxB2wQV",
+	"J6/BZaGPU5G3tVqosof1lrGBkeUXvQIc4h7yRjhaQoPQMFbEBmGQqlqSHUxeEbwMGZrjpwuqH6p9IUpd",
+	"q4WvdgjhxLk94T3X9iG0PxWvOacEw37alyA9Ra5qpSi8gsKHKO8n9gijcXaTZLNBLotyEL2dFErs5LpR",
+	"77kMlrXaFOho39Z2Y31wGSU0YL1SxgQfaDBngUs0vfA1TfORYijSPifrea1xN854QYN+tjGxxDp2GVnC",
+	"BS4MKAXWKCdkMidXkO66oOCyfsqcl949jyAKrNs2a5xcqQk3CqyJ9xO+/Gg1H6m7qYUfRRNef5a8hKMD",
+	"ViKphtSPOeIV3A5g87cO+7YCfD828YV7rnE5h0uIptz0P9VDj+GfpMzi78Yy+z/FP39nxT+PuQVOZcgx",
+	"YVGrUi78tGzF8/juY4iM0Nvxmk2c0+/NIR8Hzs7hxgh7rbooTlTc/vfkkP+A+fDt8UozoCELufSqvpF1",
+	"Gev202lct0j04c1aQWAR0Qj+XkmNQVxjcq/Lrg8o+vZz6i2kXxz5k1rD6mRaz1b+tVvmDiLQ2aZeqFmt",
+	"sNL7Qo1frN/B5UIvtWKMho30i3VgY2Fgh1TRF+GscF9/8xKi3csvvm0WV8q/5C9ct1Cm9Jemcaqk97fw",
+	"/hzfPxU/w/0WP/r/bWu11J+LwUtCVs7GhkmzJedQkH/c2N477DmT4bylQl529EAldSTJXukxcGP1SfuG",
+	"oCtRRKjPcjEGYonzPCkmMluY1Y/4FXaba/RKm/LoNv+iTflYuJiD1ZlyLIaPRMvZrVsHED+fULY8z5zF",
+	"7/Swjm0nh42i5WxDux6DLFVtZCWCFPl9QHtydbLj0Cqops9j41X0e72NPggtdItdZQHtEC7iOUPaDSby",
+	"+7qJ5hnoQTWzKbxzKw1tsBJPq6r1hvPMdbbj2XhUkNkGLMGT4TfP6f3HQ99MOpx0ZNPrv5+KBLAAqotz",
+	"HaAxQ4KBql1ScvBKx+LwRj3bS+sZj50yMxHQzemV4cIBcWLCKYdOKpwfqd44QxGGxJiiTDIsUtBi47HO",
+	"firOmWbGioU1hpzwoe1fGlmBgk1JrjdSe0EYb9ZQlvL+8PAByz+kwD3E7beRtemWeFoxm4zkeUvYDslu",
+	"L1wbM8OaAZMka2N+oHcfMKCh7SQnOxsjeLjPVXDS8JL7hFMeU78xB4vDBAinDi1/8I1T9bWqX7hQ3OH5",
+	"Q5V1WeH+o2p6XHAbmRJZ5cl9DPXz5dpUpEzm3FNBayMqtfQYjLuEow5ASkN61ICjT48VS26IwTJYYLLQ",
+	"RcA/W5XxWl7g0QniLRYprbRROJei1VVAUd0AxNCVwnzJrXQOMVBhebRpFNv9orVeLwPaERzhcHaXtd0y",
+	"TCuNBNM3Yx4adT9jHEIexv++NDK8HUKFYLyA47qAfy+Xp4KQUlg5ITe1CnUZ2oTn1k/AXV0aThMuyGqI",
+	"CLU0T4aGBaJtERfFW/H2/378cP5pdv7T+4vZx7fns4u3rz+8f0N9SOHUwppscloHAgfW4lCNm099cnMZ",
+	"tEo6Im2tFkpfB6QgaWLsB80rvN8eczkzX9rDyT7r5HE2vc9fmPJoCUYk+gGLJWTPMtebk5BO/J+LD+8F",
+	"la54yrvmRgmi4fOo1ffVY9bqs2BqNzvmu1TIgMbFTqtC1MrXuygflDiHv784w7/XSpaq7gnbCxYPeIdA",
+	"19+yX7I9Ql2jYbLoMcQzNTUmRok9SuRjWxWPsycGSJIOCO6wQnBVdd4YAgjb+nlgfBAydzKqh9HTUiLf",
+	"P3LGodkPVrEdzpMVn+/gNeZLqxt1k3DRGBMd3m2zst7N6sY8i6D7N/XuvDEPznDUzVFQ8K/uvXPUSzNr",
+	"/4bles1vPA8w+GdpymiMkGIhTalxtC7ZuIgASMEfrl8sYx8wPOe3oK6IJQx0Nj4ckRu8FSNVDsR7rhih",
+	"QwTLsckxXrpJ+Cef8L1HwSWV7uqYQ5Bm8CxL9FcVjW4UVxbn+oyOYBzPfSUTdwiWAdkaqbieK2f+GMXL",
+	"jz6/gVjtyT1+enoiam/NRzckAAiD0JiRX2rS5rS2ei2h6Ihjg9zjwAe3fR7vBW+9DmGez20L/6BZog+G",
+	"yoKbkHyeJ57eSGCR89I3bnJoUXeRL+jjPZerY9Gvfyeg178PqOtULeFCMi1MP5XXJ/Ma23La23yo5Q/N",
+	"bJ69OX/ANA/oQDzEL7ew9X9KmelJ/YctW++eta1/HMP9OFUXtsBEofR40uhYOTRm6cFn44om9PQs7G++",
+	"bpyfMddNWAx4nXfgA16W025yqgs8fq5bBThgbW86QS9yBX0IJWvKHDZ2s3v+gr231vdvkBks823kd8IL",
+	"Tyu+nzNTXtyFKcdkx9S85JCSvNfF9729EUtZR0/wwjaUfO7JlSGFaTZzVYPgXdumduLfvvrD+t+FrUUp",
+	"d0782/+n/PdT8fUrDKliz/HpiKOP6szco4vvViU5iCx7FjNJln4CfmYiPd9KMlfKuEzyG2bKUHEEqli6",
+	"EwsLTv35DrGSqyKkzdVqoUyoOvRcHWTXqi71YpLd4W/h1UcpvdY4bzfc5aQ6kfiBiPN5rowVBpgtM2Nr",
+	"h3VkACNezJXTJdUFFvNGV5hQFVEonmnoauJKpVlIseisDOwTRmqMPxH8iq5FUuuUjBCn4h0mnK7ltYb6",
+	"y2Qp53LBZBh3IbAnGm6+EfPKLq4YUMohXEwbNEPl+OFXrn8sa73EmskQHbtWdHAJKVAhoVw6ZcqA1ZIp",
+	"59wNL5Ib1UboWrNQQuNxaNyNqg+hKXf22EPWpTu8vW5TX7O7B59UX7pu5/Z8C9P16HXryy4DDU6R4j+H",
+	"Vx9DinNnx9x641SeqwAPA+xVVwmxciTInFrU6lkEy45XyWdUyh26Eym9IAYf8iRfOJ5JzFn7v1+cOa9q",
+	"q8svLvTKUBUpCikSEgTo/++yefXq60Vj9GcO0XP4iyquv+Rna/VZfP/j2esvLr4/++qPfwJCXp7QI0/v",
+	"ntJfc1vu6Ad+rk7FmxZLEyMfSwuJ+SsFEvurz59FYOpLQ8CavtZhYuozMYWWFBEKoYyjleO72+WBbqjc",
+	"+hOVj6eJlnGTDvcEPwp+r6KNBCO2eLrbQytYnpl0Z9u6DEMkLu2GCqhrZTh47+OHi09osx+V96RLzH5p",
+	"VKNerqUp7XK5T85/T69QLcbHEfOdLo8R9jwdLno4ZuwkCgikQP+TUTdcArO2xwXeHfl9+cKfma/7iKUb",
+	"LtX3HXp3Y9ceMfL1rLfw4ajSTgAdI16L+qyd7zPShZFbt7a8DVmZJ65yBZ/YlGK3oY1pSrGtta01rCiD",
+	"MmI/ZW8YGYYb27Mvf12ntH5X/jZ5Fz+kLfwgA4AjvzfpVuru5ZW90TKHCTlFT+qR9O42kmkr97JWGIA1",
+	"LbzxXgc5Js/OaUQPI9A4GTRz3acHUMI2JAzEu6+XV7DPwBqWrSSQysLQwe3E4b3vBiZmiHbJgZtQymyt",
+	"QmruXTfFObeU0JAKP/UFX4Soch5SfRtTK2er67HkYE7/oT9iWWGj6P25alN+/zcqdniOpklEcDtQxqfj",
+	"6oYdjgo+SBaGxd4j5n6mVzp2H2TYR7qfjnU/RYnhj3MWITdap7MxIdgz8xkdauBIqSyifoq1BOuXMoJp",
+	"WXQyyfYugqpf/srL/ltGTg2FvEv2cmcjMzNEQ9vPan5hEfuJyxVkZB43dhQq0x6f4TmP5aFyOkPzt4fk",
+	"iLvuKYE44ixS5vskV6OgAQSIUHA58mjjxF+B/0oF9lHCDlDVMmG4MONxpnt5I/1iPetUu9gvC/xi/b7z",
+	"djGFa39plFmoTs5e2mcL5aeUCcza89hhptRJ9hzWxv/pD+35pY1XKyLxALWhxbaiZMYvX71KvIUjXWNq",
+	"a85X2Pb0j8cRhT3qTxGBndUKKxC2/lNtA6JoJrqTwuozO0E65hgFaPmjMjZl+eL3IE/37kvXzOOID+/L",
+	"i87bj8aQabdTo455nlB/B5oQnYn2FncMYQZepBgr2MgcypBlHcRQ+T0zyZiNOB2d7mwQHA/bsLQPNMBo",
+	"NHjXu2SwrSKJcRaXZngoiI1yTq4QHhAcctKIioOxN6KSXtWn4hOtRa24NwzDoIv/orbOCXlpEhCgxrCO",
+	"mrP5DBnrgYy7/X6eyMyb2Ug5Zba/VZ4sTTHaeAdDenRzL+yBwHB1Ywr2DVMBDzQEhwsV2p164oRoKvlL",
+	"8k/bWkhDzUxQpvbK5fajx0FCDMrlFOjPMLIR+doTo07IcqONo2w4L1er4LIhTXQfpRrz8te6MQfMaeeN",
+	"eWBkoBEchUdnWUhf3G94q5v09g5jnGZrQyrfg4WtXbGXsvZ6KQ+EH5035iy+9yis3nZ4jDMjTqavYzwz",
+	"DqB6RTxW4ocQrimabWVlqcq+PzuM/In4Zp+OAk5ioV1nWi9cGHEREdwdxeE4ismLIDt4JL9w4jW9/8Wn",
+	"3RbqLZ21BKqVcGt7Y4S3Yt1spEmQBYPNE0mYomOEVF54usCIfYgUhAigSxOIDBpTTk35CZ+nXDhBkUym",
+	"vtSVQuVo5MrJj+4Al/2pkyfXEoGMk9vals2C6l3FcY2MpU2A1OXJkTwxTWmzC6/8FwSSMpIDOtdG1rtM",
+	"J4+qp3XETsYDxs/iHn0yxUwmwvHRJZutE87rIvF8+fUj+iPDanhrRSVriqT+46tHHMJ7C3GOcxJwIAEJ",
+	"nqCpBwnKJFBQ8YzDjoGOYbcWotJXSkixUkbVkkqXVQo1VjGv7Y1TtXCLWinj1nZ4FAzO9hDzP8lHdj+H",
+	"RC4i9RPWElTLpVp4vKP2ENapKmDIoayVcKoiCFSEcfBrZYQ1aTUuj18EsyJb5tsgVmoqL9hL6RXs8zYf",
+	"4iFunqH5H9S1qu4EUxgW8cmACn8yV8beJAOpaE7PSKd6vUaID8x/oVHaxgFoL56IG7kTcnF4uyzW0s/o",
+	"lHKPuWWyetV3tibpwEF2NK6oCyJeoLaGsQUDK71wDK74BSpZSZQT9MKZyWt1aag5TKlozJUTkiGZZV1j",
+	"yLgBdc2pzbzCpHss22M1FpC4WevFuhdOtcAhtoHnlwax9Bn/jPxuOJhT8cEsMCS9+0WAQqbanzxZHfEO",
+	"cUBRYF6C+APoFuftFn9mgYnO1tdMHLNK20IR7UJBSU+SlqxR79XN67WE8ihYqujDVpmzd/gWpQLMWxTJ",
+	"U0EwbUTTtaqAOGKjNrbe4RjL2m63oSDMpfnyldho03jlojZPBB+3jcFQqJMHEk1tB08V9NjOMJdFknA7",
+	"Y1U+LUzXM5JzF0CPFG2QeLkVBxQRnTMv9IVdaRdYLvfQvf9NfO+R7v2hw2Pu/e1knuNNP1bfaccppPdy",
+	"sY4RI2Ce/F1c99+0M7jFpXxYru0M6ZAu+30FTCWfDZCQ+NmMHuyrROXVZ/9yW0lthlQqTkghVTNtklI6",
+	"M2z9c66oQOUspWT5dcsM0M0PP/zYrU9TJmNYysqptvu5tZWS5khEpzjpJ4937ezxDEweP4tb5OmQ8hJJ",
+	"9JRC5dleamnzCpmRcHyV9RpdkLAdCpJzcwjIxwut26pFEeXfwQOLdNkDp9Xb68c8qrC3Y86pujGskz/L",
+	"g4qG1tY0czsHlEjuDnxUjUVnPIcTilgAjybRbEPSlNcbhRDv3ZNJuityeQd4iVYGMyRk+3ZSuMWFqi5M",
+	"sZZA2o9r9pFjHqwqAjb/RFp9ux+GzIcPmEpPJs7V9TOQ5Z1999E6LySLhBbYvrv9WJK+fleIjTXa2xpN",
+	"XTXLVoxInS5E+1UTcrD95KmdUPqT92hxjHV9sdbX6jv68Ni4utW/9PZY/0FxV3cADngsMgEEuqRXWjh2",
+	"W8M/pYDhgi3Ay5rsuGtbofVSe3ihbswpjOfSPJ1Jj4YumIzPaW8QK7IFL+Y8MloMxoUVqQk52IeWTVWJ",
+	"tXYeDDJ2GXKB20BvXCbZTl0a69eqFto4L81CocVHb6hWxnNx0mMgaq39brTeyVs0sS2wEAnJ/yL8RfRH",
+	"CnGYl9BOrKWD6ycCFJJXFp20BUfbSW3w2aVBshM7wHeq1HjUrWvbrMgMePbx3Wlw3rItH1oXxmIQvYrG",
+	"PapggrbaUji7UZdM/Bu5YzE334mFretmS9aMGn6A7ItwjpfSy7l0KnfK/k0BjMR5Y95Fcj1gwEnsZBzx",
+	"O77Swfx+JhvsXH2Bq0Q2Ulh7NnkmjOKiPSmFz5ZmRzZpXsrnsksOlyoLhaMCXtWj3BKSHqcEboVyUCk4",
+	"1DO6J4BavajQ+4Z8oalgN9wDadjIGUm5f+2fC3/YrTJyq2cRl/Jp7ylAssihYq4WdqNcCFKkTNf5Dk+9",
+	"hI8Llonw80b5tSUkLBi10EvOV7o0xhpVsCxuZ4k2O9jvqKZc4BzChSj28cJRa9As6nudBkxJJwK2EMF3",
+	"LFS8aUypavw3+aRgHhAJrN3VzGtVC+l9reeNx/Nn1SjnEg/vpUlHwFNrTKWc646PCo99/gLa/QLapSOr",
+	"ltqxfweeCOyR5kYXtxcuXPKQTi+cWOvVuo1sXqlgem3dWvwBe6bJooEvBwBfVV4CqQWwPN4xaTBxsC65",
+	"bJKvX2Osqqwqe0M3xsYpsqVeobaYO9jebVgtR9fUVreAqfd/i0y6oG4f+x45GMB4Cih5PsNKBLTWR9al",
+	"P6WmXF5dQTdOnMrHd+LrxCxma+FvbMohFHEbcKvi7n9mygJRmV257WYE/cDEiUY6yCjIMg4pxsbti+et",
+	"bJw6cHp/xHceNoyY+hghEA3ySZcGeUgHXsMB5Q7qm/VOSH5MlyjpwtvPMIaUZCTB5cfDkIZ7Kn7Ccsc6",
+	"FPPHWoVwCmG1h+xdkFVZiPWs1RJpQDUXxR+++joJvVpIM6FU2wsXgJRIvkPfDGJxaXLJx9AzHG3/Uuab",
+	"jlFR1golhLuCOUQoQXw/DJTvsrqGsW80HKs0KThgbOMdBjwldSrh97DSsixjmMeGwO9CZCiRLht6gDwf",
+	"IvTvw/tWK+myZUh+y3ifHtgseXhDl0/v4nlUKJdgutIuxtARHYJsWUuIYTbarQeyBakZ7DJrMGvhvtTm",
+	"WjmvV9Jn5MtA1FfSHLqofcR3HuOOBj0d48Wh0T9HBw6OLGY3QeLWRntPYe4HfDdIhCc/CTg4DOYBzMlA",
+	"DUU2mABlJlBS1kG6g1xmdNFSOK+2wJfC1qWCKP+z9mO6pQazFLSO2QLwCcII44sgcuc7IesVhzxAH2yH",
+	"ghHiaCpVS7NQxaXRSd8hlmOuUngKxZY1OkTwzgw9ioW9xqAJk0TFnoozsxNoHkscT0CUtDUnGtfIiq0z",
+	"C5hpScpXqa41qWjhhoVjPhVn+P9A2kuD6Z2AFKMcwSbj+6G6rjXK7fVoId88zFUEmn4iZxaJhAz4HJAu",
+	"bqsnc2VtWWI9n8A0JEk/rpstRDC4Em1BG3mFzoaAJ8flqbXHJ25QDqeS2dOjVrTRZPXkVpyfZXUVo0q1",
+	"4WBdKiwYN2qbgqSNkCWqggRVfireGoqy7WuJpCJemhCYS03OVSEWlcYrlik57Kr/5bZWpW7D58Fih03w",
+	"lo+rdGmI/pz0DetufB8I+wUIsbbJTAXE6HsJWNJ0MZlr1I+z2mZYQBXqXT2UBGk55YmKgiYjyKsUV6ra",
+	"dZGS/xvFuYZMojGxcuauuKhFewLSRqiIcHPV6gjhzN0Q6Jk+HPC/re3nHYb9v0wi6p9cppyHSyTlX3Mo",
+	"tCLIsQBizg+TYP9+KDAHmkfPHDXkMPZf6tXaC4l+N1Lie3oVhrY3eO0euFA7mhkO40qp7RcSUIEvzcJu",
+	"SFtiTWmjpIELKhmFcZDv3gS8Yrrm8zHg1+QiL4TjrM1Khzt66F4RQDw001UGw1UE78buVJwFVSx5BxOJ",
+	"Igx9mxwAAnCHUu3SqMopgeeV9sFkgBdzWRFF2aouGYN5Fh4uNZBMOyHFR+Crc/p9L7zx5x1Eu7+Oa3YH",
+	"MdiLNDVpGkPKFdz+ySOg/PU7KE4wmhajXbLZoJlEgAE/F4LBQ3FtSuml+M83H96//cekCqJrJZot76hR",
+	"AgWZ9d836QAiTr96xHCUsCSwZTXIBQWf9A0PsF+itXmUs+MCF+iF3IU8oCRZieKzuSxMcPKAFlPZ1Sq8",
+	"j82ndaa7RmwcTeZMqVUpF31Ap758903NriFb65U2ssIQWaiyocPNEGsUgDjE4JTkBpw660/Fe6VKd2kQ",
+	"veMbniNbKclWH66N8XrIjcmm1B5mnJNQZII5b+fyOPgm3N1UmCmiR0vxZ476gOBnNzKMOOjnAf4BF/S5",
+	"+MoRjXB3wER3Ti89rDuGOxmhN4/zWUK8vHCtQGsdA2ipYXJF61RizKc8S7GF6M5SSN/aiZ4Pb6BD9tGT",
+	"ykcyudk/fH+eizC7CZ6LiTapHN9CL08G8NXRNXpABSVVUo9xl5IHe/A6Rf6lJ78+BefGSnnHdaHWKngW",
+	"0bcRwx4TnygYRiN8Cv7Meqat+Q0x31Hsi61X0uh/hVgVwMcS7kb7xZr6TLqDf3aea657ZewNhpwqWRbc",
+	"/qXRy8EH2gk43DglO4xJL4Wx2UyDc1yDLNbWH8Y5cfPf2AM26kQnUnZ86Ae3AC37gWOTy7o/4LEZC8dn",
+	"qc6DfI4OLN42o1nMxfM4cpIVvH+jZbp4t8QMYTJGxJCchJ9C7j57e2urA8z9+y/mnQ1WenS/6Ii7DYdz",
+	"X6pODMh0GXNNcbKwpcqmT3fom3muVwauqLNu+3FRB+93V3A0rbm7BrljPxPXyqGf0YkbElPX3cBYgynY",
+	"mipmGuQE7U9FxLpM0t0xIQLsiS/aVgXGS6iqdIJGNQ/Ru3RID01hmQTtdD5Fuji8FE9dmYM2XOYstbZq",
+	"j/H7dMPu7THqzl0oIPxVyBgbtm9XD+RbLQ31c0jKtS8+iqiL3V2o1VRwjNZC0k5LOPr+eZ7+yTjxSLrG",
+	"mzDGshCkdDTw8DSeZwbyd+jglhWG5SVziNhJ8Blag8CaJ3WLjBkIMIf7CKF/03IhPcylabyneBPyBW3h",
+	"QkDhfmglwHCRIjpkx/GZBMEzxTjIFy5p23Vgm3gIDNxkjepCNbUj0nDbqldkYbTmG3zc1oJ0cueEswW9",
+	"NNMmlOdj2QrLWUe3VHABeVWp7dqanajkTtXkCwqoTwwGtdElesCUWbAvm2JaUuJ1h5pEW477Z4ab7mH0",
+	"vUE/TxTzkhnHWOA9v0DBpk8WBeNaWfjf7ObacvKNbEM4093Xd6SXpZBRamaEayJ6MWfLTbsP1I2ZUFYG",
+	"D8z2zUcpXk8+nrbbo24KyWDHIJ3AmQLvkpBsv+DUN5TJEF+g2VXTWoBz+kjwJj2RRZdSDfev4L3kTB6w",
+	"9VMfI1vueeZD0h3jShnXdeSIGMp/w9VnIbPZeQwvhdCv6jmY82eacS8nW21n+o7d77/iMhbjQ6GyctAG",
+	"dvHItyfo812ZNcq9VzfD6J3n4Bl4ThCv6b1uzPXfQqhohwCghw6xyP+zhW3MoVsfBes05s6XvkF9sSFL",
+	"NJs5JbDiXJXxWG89gCfXTf+Ex3HhMzP+6Z2Mqnfe+QPCB5SBl79qU6rPh8qH/MivP4oCEUQFdzo1dT9M",
+	"6VmeU2FwT88LRbZh5IIpZRGSwnzMVDNKBNFM1JUauZera1k1EmXDtay1jHfrUFfIJKm4iA6mTlenHGym",
+	"lwhzh4Dtmy1E32ASP6ODoX6S1izrZveRYZGDb9DpH3ayw6IUFPANS1MIicVHsdObNRasgFcX1kCeSKfU",
+	"n67xsus8ax14N5arWqmR2OvXSCcwJU+q7YjD8zbk2RRCelEp6TxkMY8UlJjAH3ELHmCUwab7x8MqoK9b",
+	"Lhq5eiV89thH83dU03ktDRC/LZEnNtLshK1bzkVH9o1ePC91mVmPeMrpEtF+4P/ZI9opWS/Wo5sZ1gL5",
+	"TmgMo7lRc0GfCLczXn5mQ3+pKuXVrHGqLsTlSVnbrfByXqnLE4F2umVjSvEFbKFT8bOtS84a3nDNMc6/",
+	"eFErcVNr71WC1Ou82mwQgM1ZoUtlsD5fnSBFYCa/I4uZUJ/lwlc7zERrTXOQN4+1uwF7nGZAxV7DO7ld",
+	"fIHvTUNp++VuhWY+tONqBRYKHx2HOCII2qdHnQyZ/msMmRRr7du+r7QpRzrmRxP9rTi377X/izZlbgQ/",
+	"ys9602wSxQrH4S0PqxB/TKvMouyXXIkW5NPzrjobpz/VpwCTL8RcuTaBMom3fAI7IBG2l5DWMiwPBtat",
+	"LXMJD2hv4mpFPx77iXuocpTNjtFAy/RYp8KXJIiHBLnM3z2cl/tRqr5v5lRL/CGr7Ic+MnT9vpkLGuTT",
+	"ldHOhaYh/FQcW77wOj57icXv99L4r/DGvVB5Wop5rW2t/S7pdsJuw7dpugWmUdYcC7a3ci6lViIJ0ECJ",
+	"weTcv1hU0o3Srk3xmfEKvPzVDQrzU2WhUvtZZVf7aDys6X8Gn/1gV49zg4POJkM049sBzzdcszPQHolG",
+	"1fOJDN89XAMwxOCTST7T3Wi+ftLdxGtbbiXvfqE/gmkILjC8dRznUIWf85i89JBmuqSjfH0Ss1JPZiPb",
+	"y2YI32EsAzPG5+AksltlGAhCe7FTY+LjAlpfqPf2pt+KTJ8llXuSlrMs/Dtn2krqDe12uEgM2fVcwX1Y",
+	"DVl2UgTua2he1NQGW3u/flxnYriAhDrKc4XWB8SUbpyqn8bD6VQdRwRZUEIKXIpoocmJ5l78LhKVM0nx",
+	"06Xw7YRfOOrFmoN8u7ei/KNw7VisyceGA87DLmUDE8zshSMtIOBnoQ+KWU1on8JPYdF4BDsxO2uUwCzY",
+	"NEpCIKNSzaHQVegnOqBhnRxfilyoYwPfZQ1R8GDKrrlHAw8uI3a8j+/AhUfvPNvd+FwOnCeRDZm9ynH8",
+	"oRAknUWBvaniOyFNI7/i6qpS6L7IIGksYzu5noJuiC11JcqeIunHnTi1XuphXEVPYaXigJSNnhtop0zr",
+	"WkZwPcmzm3U6YmABwkvFBuDHYOmS8Tn8Is7w36/T70eySDOaXHd6jxIMknY55TLQG+Mz23JZvS0smUuq",
+	"cJFHoQU7lHNcy//y9wxCsjnygsEfPeTVgrrYd7egN5755YIHSZnM+NKUi8WiOzehnWv2XRtQG8mgEnXL",
+	"ACtRaXOFwVbSoR7DFd+VKVFEd4xwv0NmVowPNWOMIHccWwd4qb+Frx9D3vY6nSJxwyciTvP3IHTDYMnI",
+	"tlHBPyCNUENcL7GS12rKdeN3yKZLpUrItZ2VtVxOjCZ7vFvSmYMocwq7AwEBnjw1tOxLAXwm0cTaKo8j",
+	"60WZwmvJWroyLSbSQsfqwwgugpUQQlmEXZBQrXKGUG5gVNEbXcmanQ9JAhMFCHD7GQi2IrH8qhprtNCt",
+	"EFcD2yfQwhbViG5nlNmd093ewJekxX7Ha/tAQXmhee7xSWDaOmMYRXmHh3Bk8cv/cxXL7os0JJ1Z9tER",
+	"hWBstNsXABcHW5S2AtaIStbvsStr0qA6RTVDVpBRqnTiw8e378/ezc4+vpv95e3fe+fOm8EcSGxFYSVb",
+	"IaAd6/uMuzl6vhwp6SMk+XGKyHn87DE0kDdNDTEen/SGLCaTC3TGUf4e1I842j3uoyUIUNLcn5uKMY5/",
+	"GKaFiWEEd+VhKV2ABtzhvNBdJxg1g6AQ4USjovFCQ1Edf6MUgB63UPp4anLZZfrOIcUS3ylsHef0ynDR",
+	"0xRcWZv2VIZzGiL/uN7ceCrZ+HZ4qFqg3PwTpZJ1t18mSiysBXxQNtUTJpEFtnjWG57o1VXyxrY8JT8W",
+	"scqR8wAn05iA/kuVa839HQcBLmnSWRChmh4K+WTQWYb0QX52qQdv7/Fi5kC+hg08WxF7UCzdEUTrFoty",
+	"v/LoEC0ObMA+HNdX96iu9uzPeXU1oGdLd+USm623guz0Ozz7jA1jxVqaNF7OFc/IArT1uzTPm+34p5fm",
+	"yUQuz7SINmtQT9TnbSVNtNAfe5OxRn1Y4r46YojFgVOMterX1iwrtGP9Ixc41KkqoamMQ6m2iCFsDXpe",
+	"jO2aCMBMiuZUsqFCkCzXXvETLlW1cra6hg+04bvDIji6wxYiIOL+DEJ5l9ASs0fr3Ym1Iji1fiwJ6yjJ",
+	"eW8nDZx8s63cVVaWk08c+Ogjf3Mg4QFDjcm6TNQM9cwcoVjjHAEnJkFSKPDHeaOrYJHWdUCYHYn8Xdp6",
+	"1raQCwGeW1spaXLByCGIvqeECreolTJubVv7DnJip979FmhomwQ4e2SIbWszyHrZP8Z/PLjLPqxfVpWE",
+	"FwRzxcTIved8pRtM57+gtfgwTN7wxvQIqHltn+MAenndkRbXha8OaYqd15/vStq6XUBbvyt/m7Jitn6M",
+	"NbL1vh1n672LYOsM0W19JM2BIg9I65cLWek50Xga3V8nHzysG3upS2UWKu0w581OHz+R7LX1XpELXpcb",
+	"hS4YIRtvN6BOJ3zygg21ON1QBYddK23w3JIK8QTcJO1/F+yl60Wj/WxeK3ml6tE4o3YCLri8rlX0eVGI",
+	"idOhziRb4YINDt7FyE2LALrUFSkoxl6apdRVUysgcmN8Hoypy+I06G95zA/J5d2ecuxNb4RZPUnh4XZJ",
+	"Q+nhxPM8UFYpT9HzlUQschN4fnsUg0e6Qw0p25kd+3vYepQ+PgsJ6C9rtbW1nybkP+K3f6NPz+nDB61f",
+	"NewuVxcPXwsp9YIn9AwZKr0+bTuDduOBG78HnvLK+dlCOuWm8dEniHnD1x8lyXTQ76RsU8Q1gEEWsVLC",
+	"c5ZS6rMESJpuCcSOiBaeouXgBHweXDUCdX0xziu3sw/fK5vcAha75aUWFvu/EbTSBC7GQisLdY+cPFVi",
+	"vawbc1zImK3vzzPSC0SUwZzaVrljn1lKAFlZowphG49AFnh07KgGKEGQmn6MFgCoVru2XDzp063fujGI",
+	"+qCqJYKczhVTmGK7iHPtkiBeByU/3ZXebvP6M8DV37/Un76Lx5UGePqMVQWAMpHdu6BvhQgFBcDYg6kV",
+	"lk/Ajcbt3Q83crVS9ReN3ntO01tv7GJspXrToffFT+/G0jrbF9rBnX18x6MCqKOXv8J/D1h5Pkl39ZC8",
+	"g+3neIV+H9p0PA0oAnvDn9POUJrt3XWyDu2CKNtHP8ZeeoSCas1RsKcggkbqI8AjNkb3CD4dNuw+6H2w",
+	"PsL91UYABxjAWOUTr8jjE2rNsoclRPJtGpcm7sFTmPyLFC/nIPCVbLw1drObVepaVYfBDujtH/BlWA9G",
+	"fJjAIwGcIl/i6tH98iB3nwr9ktZ2mHs5XMLorQ3rJHCd4NdAejj7G3Nl7I3ZB2ZZN2bf1sqKmJd6E0wG",
+	"j73xshhxhSBEJNuC5ug61R5XyuNk371xp+Kip70ErK0OoS+NNs4jyDWpX7oWAN7HZy9zCFXyAp1IvUCj",
+	"FrbVKaeHZdnZDSrrxVpfQ60tHGs7lMQvE2q4q1px8JTdKhM7osJo0kHEgkUjJ0yhUksP2iCY2C4NrQ5n",
+	"SzdGGAUqnizLkJ3nwlw5Qbut1UBRH2TMu1LbbGz+O2z+GGm3+pfejmzLuTay3mXWvLgblN4ZkfqxQw/P",
+	"G8PkGQ3/AgFDK/SUcYegXQYSPbLyC0pIL3Hgy0dOc+ep40XSWlHJeqXGhCSQirJzYNxtzdTYSNyJ8x2H",
+	"4LQJ30GITJCrsev96tsFv/bAWnDoZmz9wmifmnnGEdEJsb0HhRoh87uripUkRLN9Tqq81xtVaaMOMcSn",
+	"8N6jlAJKOnxrPDHAQUMqkDhO59lxzA25FbcEJKRNjkNGE9Sfgk2srV7+Cv89dFsO1dqeoCLX4y8z4p3u",
+	"LYrsiR63qK1HxL7npXuJyuHLX/F/8Dd5GKYp1XcfUB4EmwdzT1b9PpLplXJ87bhWNeW3Lls9ucBKVMYp",
+	"Ul7hHpSpMItUeg3vJ4r8A4WOYzcfyPHzuCmhSdABjGEUDxoeCunxApTQ9UnCAXBl4vW10o6rh6drDLGi",
+	"yf3LmidAiUZRYWsm3tOmsNIY8EJXalYig/IYI/UwvkUHzAuo2Yx3ULLT83dyRWzS9am0SO8dqsOxhj3v",
+	"sxXvF1Z7o/RatLqHkAAbe616AuDkf7bieGROZ/ch0Lc1z2bXDfIOYjAR5zouiOj/9fYmsHHXr8mXy707",
+	"s/i9awfFIwSqTBVd7r+ItpX3JeM1BlgwEfhikxfBUG74U2sw3chScTwpOuShESxMEGjXuqWThtADUYWN",
+	"oz6rRUOgYCHjJwRmLrXRbo1poYz5FoaC2dXhNTCjZi2QeELkjoAHUgHbXqjrGHL8PwrhIUujDgTLC/rI",
+	"GkNp/8hnU8G0s2l8w39l7ZBYuRdZYxDq8466IfPcy1/5HxMzN5Cx/0afPCeFjrG2nLZPzplBTO43dPDI",
+	"XeAK6UMyHkgFbsP9N9MwrhPGGmt5ow3UWjn55stipNhXl/F7msQ+Q1yP6R478PV1kKtTwzHYc5mgdaWT",
+	"zcdpJG8EnzKyrzbMkrxyT8t4B8I4RhfrASNPsZfzNjjz+JjTL283qGMLoOXqEQCbxIiJnA+Na2ZGdsqz",
+	"yaHjZgaa6cvoyvlmDq521N+z2u8bDJ50wZhPia2hIEmaMy8JuDkMBXOp0iMx+vKxtlno//TSfFp3I1Rr",
+	"hZsAa9vDUa2WFn4yuySWs62O3y3PxzBDIOQN1NurpXFyQWqTs0JpPPJpLu3g23YJYskood2piOjPwq3t",
+	"jcEhhERsfOQuzQoPCqThLGT0C6w/goY7DivKQqd/Cx8ReWGvvIbZP5DyHbtKIdofcj9MHszUclUJg6Qo",
+	"io+ujr+3yVC6dfvKhnqlMFJU0xNMN9wgC0kBScQwqnx0NSiFubiRLtV/HlktP+vBmhvLm6oH/96XI0WK",
+	"yiGHEqhF4hB87eijdSQqXAjjgc/Ca3T19mteJHzG1eAZbeWrR4yyOMNS9LW9lhVPDis5iLlayIbRQmy9",
+	"kkb/C/t/ARX1QIW40TB47QQVm+pDEOJkU1EGJHEgGGXVkcaefAv70D/aU+VXz4Jsgkf1NeFWPNjtJJT+",
+	"jX2N2VIX+PAprLjIt4d9rQHiI3W44oymq3q0JvdjEBwu9ctQeW8GjUxZ+DP+4Dt4/yGZIO1nNIiJ3hFL",
+	"rarStYVB6e+ATIgrwZLq/1x8eC8uaATPk3NgxDx+Cr5IQGbaOonSxYjPFy6dlaA+5wh5rDYEj1MrU6o6",
+	"REp3WpHwwqaD0v4cudTrpTwAvt5yaHj5kWL8Q4fHXC7jjHpxNc+XJ+OIRbOtrCxj7YDIoO3+S1CYjL99",
+	"wMkDc9Vkh24OWme63+QeZvH79UWNuWYuell4XeVWuYWsMLx8K50P6Oq0c/Bt3QfgRMu6jCrjpQlNFK3q",
+	"jqizWSggCgDnT2CRgRyDqA0vvRJO7tyloTyTpHNpks/FjULkwGMAaR8D+vHBbo93xH7EcT1ZzslTpgW/",
+	"H4NJyAP9tRWwej6LaPLuXq1uofy/pEuaMgutJh23b9L3HzjWstPf7s+13K6zxUx6VqKFNYYult5ygLpR",
+	"VN8mznZXpJbeaJt6xgdyO3SxAkp07tSUOuWEt0+v2I1DHIzy0H1kEBJ93MyajjJ3rME3nfp/po3+I5Os",
+	"dytohHT2wqnHL+TebimhHUqT4GFt0Od2AzUcWD6DpNktKvUMN8YbtagG0JyhQp9t/BY1U52gb4oGsU1q",
+	"RF7AdDGza0E6S2wv4LllNtE+KQqonUdcp99oRPl88Os09jNyncZbJ6dRwvhbfZ4qEYcLNaf6Eb6Ct2LB",
+	"mD7w9jOVl4AzN3aVhqm20LvIK6T4gfqIeANVFX5CDwg2ow3ZIRvT2i/J3td6PjSZLbGYa0T25St76F/M",
+	"pcO0kNAi57c+8xs5l1yYwuLf86uPkp3T7XN6gk4PzTdMb6zY/DSuI28V3RvSw3krncPCkLVtVuuuBYDV",
+	"kJu1FWgnLslfRzsQPFsLa5yvG1RnkKlSFZEgTUmmuabyMRu4LXV/+sw5q1bONvVimvJ5Hl9+FFsP93au",
+	"lqpWHLh/iLXCR6IOXz1npVJ99qo2shJxGeh1umNkBehz56YD5TESVnrg2hi9niaIIR79M2CXUHw0KX5A",
+	"6Dux9OgooDZ+0HlZ9mrvcUwW1qp8DreVrMXqNUQ1uHROMS6C/+4lmPQR4vlgD8pBgvK/xMpjAK8JFcUw",
+	"ApErtkLIFpV3Fucs0KmQYQ3QBbKWxmvDpQvWoL01WGm+LUN2yXVngjfArUM9trni4AjuDSujtdEZC+gG",
+	"mKdSFMG8re3nHc6ZysyPBUcQ3lRmV92/cavbSQp09Xh4B9M2dcoxKbc/XdWl5yJYniB8IZFhbUmPRDx1",
+	"Nu4QpC9AjnEzjFqKof6qbD/EphLd7OgrJLX/MrDKN78+iRDsVSXtBD094uaOpYwfN+dg2u4OASjprnq6",
+	"uj6/F3XhCbIJeDiUXYfnJXnH4azMx9mkqgp/3f/u2H3d1nZ5qj3d88VQ5KWkOkTs5c0pMHVjGCmpWySl",
+	"/2oo4QMQUt4FM0o77TY9I1iOkqLIyXuHaufktI+f0DcdFuKiJfU+IaU3cqVe/nOrVsdjNNG3W3P0p4+N",
+	"ytRGKWTccS3Ng3P/SZJ257bc8e6U4uP7P4MU+T8f3/5ZIJWfjbrymGBNydIkQE2PXzh5Xtk5BWl3qyf3",
+	"xCbtv/5GlmJe2xun6lhUz16FexBDOI4HzB2Qpl56NeV+f4EvPmSprMa8DQmf+7mJxpy/L+OzXuTXg1+K",
+	"j7GoHK4dlVL8gStGjZaJ+jSiv/diM4c1oJ7QhNU4Vb/8Ff4LdEZkwn1k/smp+q/40mPYPn+m2O5cPMk0",
+	"8zrM64WLMeKZwIbHt4sCCQ+aRHMjFRLnIxaV1BtVtkYZAqzsBsKHYIJRCK2YrjKR6YhF7ofh3CEWexzT",
+	"OvQ0hZNwRAXEbiAt8iuG80IXCmuqrTd4nOp7EuJwcA9zy6V5P64y2PaZ2Q1PFYUVLrGNU/Wj3w3PON4B",
+	"9/RakpVXbaSuxuKy4E28DOlanH18J67UznGR+l5yG+I5AOaEoHZ17U5HuBD25A0mpLlmHsf38lf87SL5",
+	"aQAy1LfSwO8/9786mRKMgl+JtH9B3Tx+ylNmKGOy+sLbrUAyabMqaMQh3D1tgAIXYi1odwchnFmUu0vk",
+	"GzVfW3v18lf+x7SFpnenLS+9+3Rryv2Px/DAuIQUTIDoHypVpa9VrVW6Zh8Zzn3iigWaPkg4209Y1SZd",
+	"i/s/Lbj1oyJ5X9137/uW9alK+4TT4yYM8Zmx9WsM30Bx9NP5DwXlGSNOsjJyXqkyvffdRB4a8vmIkHiZ",
+	"bI89+hwP8026lx7h6tDpdXdMmkwyree2pEHZZPNmO9LOIhaQ+5/T+Z9EdCH32Pqqq/b3w+EXV6saJiz4",
+	"VVHpK4XwxaB6y0rVHFd00x4mYe4YMWowvrpWuDZC4o1bb1RxaYBg4D6m9A34i/p44USlpFOn4j36wmW5",
+	"0eYb9pi7kbKkP/NMHlLkYRd7SijFGThS77SL83aKve5ZxGXIHwlvYnUXDPOa94k/rBYEbWHJqlwFfcYO",
+	"El8G8oZcWlBST4qTpq5Ovjl5Kbf65fWXJ7/947f//wAc6fnx9Z8EAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,3 +1,4 @@
 package asteroid
 
 //go:generate oapi-codegen --config=gen.cfg.yml openapi.yaml
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative sentinelpb/sentinel.proto
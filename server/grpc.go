@@ -0,0 +1,845 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asteroidai/asteroid/server/sentinelpb"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// grpcServer serves the gRPC API with the logic of the HTTP routes its calls stand for, so they're
+// authorized, limited and audited the same way
+type grpcServer struct {
+	sentinelpb.UnimplementedSentinelServer
+	hub     *Hub
+	store   Store
+	streams *ChatStreams
+}
+
+// serveGRPC serves the gRPC API on a port, with the hub, store and chat streams of the HTTP API
+func serveGRPC(port string, api Server) {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
+	if err != nil {
+		log.Fatal("Error listening for gRPC: ", err)
+	}
+
+	server := grpc.NewServer()
+	sentinelpb.RegisterSentinelServer(server, &grpcServer{hub: api.Hub, store: api.Store, streams: api.Streams})
+
+	log.Printf("gRPC server started on port %s", port)
+	if err := server.Serve(listener); err != nil {
+		log.Fatal("Error serving gRPC: ", err)
+	}
+}
+
+// authorize authenticates a call with its API key like a call of the HTTP route pattern with the
+// path parameters params, returning the context of the call with the key
+func (s *grpcServer) authorize(ctx context.Context, pattern string, params map[string]string) (context.Context, error) {
+	method, _, _ := strings.Cut(pattern, " ")
+	key, err := authorizeCall(ctx, grpcApiKey(ctx), method, pattern, func(name string) string { return params[name] }, s.store, s.streams)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	if key == nil {
+		return ctx, nil
+	}
+	return context.WithValue(ctx, apiKeyContextKey, key), nil
+}
+
+// grpcApiKey is the API key of a call, sent in the metadata the HTTP API takes it from as headers
+func grpcApiKey(ctx context.Context) string {
+	header := make(http.Header)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, name := range []string{"Authorization", ApiKeyHeader} {
+			if values := md.Get(name); len(values) > 0 {
+				header.Set(name, values[0])
+			}
+		}
+	}
+	return apiKeyFromRequest(&http.Request{Header: header})
+}
+
+// sendGRPCHeader sends the headers the HTTP API would have responded with as the call's header
+// metadata. Calls of a Supervise stream can't send any, so failing to is ignored.
+func sendGRPCHeader(ctx context.Context, header http.Header) {
+	md := metadata.MD{}
+	for name, values := range header {
+		md.Append(strings.ToLower(name), values...)
+	}
+	if md.Len() > 0 {
+		_ = grpc.SetHeader(ctx, md)
+	}
+}
+
+// grpcError converts an error of the API's logic into the status of the call
+func grpcError(err error) error {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return status.Error(grpcCode(apiErr.status), apiErr.Error())
+	}
+	return status.Errorf(codes.Internal, "internal error: %v", err)
+}
+
+// grpcCode maps the HTTP status of an error response to a gRPC code
+func grpcCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusLocked:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	default:
+		return codes.Internal
+	}
+}
+
+// grpcUUID parses an ID of a call
+func grpcUUID(field string, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
+	}
+	return id, nil
+}
+
+func (s *grpcServer) CreateRun(ctx context.Context, in *sentinelpb.CreateRunRequest) (*sentinelpb.CreateRunResponse, error) {
+	taskId, err := grpcUUID("task_id", in.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	var request CreateRunJSONBody
+	if in.AgentId != "" {
+		agentId, err := grpcUUID("agent_id", in.AgentId)
+		if err != nil {
+			return nil, err
+		}
+		request.AgentId = &agentId
+	}
+	if in.AutonomyLevel != nil {
+		level := AutonomyLevel(*in.AutonomyLevel)
+		request.AutonomyLevel = &level
+	}
+	if in.Priority != "" {
+		priority := RunPriority(in.Priority)
+		request.Priority = &priority
+	}
+
+	ctx, err = s.authorize(ctx, "POST /task/{taskId}/run", map[string]string{"taskId": taskId.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	header := make(http.Header)
+	runId, err := createRun(ctx, header, taskId, request, s.store)
+	sendGRPCHeader(ctx, header)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &sentinelpb.CreateRunResponse{RunId: runId.String()}, nil
+}
+
+func (s *grpcServer) GetRun(ctx context.Context, in *sentinelpb.GetRunRequest) (*sentinelpb.Run, error) {
+	runId, err := grpcUUID("run_id", in.RunId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.authorize(ctx, "GET /run/{runId}", map[string]string{"runId": runId.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := s.store.GetRun(ctx, runId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Error getting run: %v", err)
+	}
+	if run == nil {
+		return nil, status.Error(codes.NotFound, "Run not found")
+	}
+	return grpcRun(redactedForDemo(*run)), nil
+}
+
+func (s *grpcServer) UpdateRunStatus(ctx context.Context, in *sentinelpb.UpdateRunStatusRequest) (*emptypb.Empty, error) {
+	runId, err := grpcUUID("run_id", in.RunId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.authorize(ctx, "PUT /run/{runId}/status", map[string]string{"runId": runId.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := updateRunStatus(ctx, runId, Status(in.Status), s.store); err != nil {
+		return nil, grpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *grpcServer) CreateRunTool(ctx context.Context, in *sentinelpb.CreateRunToolRequest) (*sentinelpb.Tool, error) {
+	runId, err := grpcUUID("run_id", in.RunId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ingestion hooks transform the tool as the JSON the HTTP route is sent
+	request := CreateRunToolJSONBody{
+		Name:        in.Name,
+		Description: in.Description,
+		Attributes:  in.Attributes.AsMap(),
+		Code:        in.Code,
+	}
+	if len(in.IgnoredAttributes) > 0 {
+		request.IgnoredAttributes = &in.IgnoredAttributes
+	}
+	if in.Parameters != nil {
+		parameters := in.Parameters.AsMap()
+		request.Parameters = &parameters
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error encoding tool: %v", err)
+	}
+
+	ctx, err = s.authorize(ctx, "POST /run/{runId}/tool", map[string]string{"runId": runId.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	header := make(http.Header)
+	tool, err := createRunTool(ctx, header, runId, body, s.store)
+	sendGRPCHeader(ctx, header)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return grpcTool(redactedForDemo(*tool))
+}
+
+func (s *grpcServer) CreateChat(ctx context.Context, in *sentinelpb.CreateChatRequest) (*sentinelpb.ChatIds, error) {
+	runId, err := grpcUUID("run_id", in.RunId)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := AsteroidChat{
+		RequestData:  base64.StdEncoding.EncodeToString(in.RequestData),
+		ResponseData: base64.StdEncoding.EncodeToString(in.ResponseData),
+	}
+	if in.Format != "" {
+		format := ChatFormat(in.Format)
+		payload.Format = &format
+	}
+
+	ctx, err = s.authorize(ctx, "POST /run/{run_id}/chat", map[string]string{"run_id": runId.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	header := make(http.Header)
+	chatIds, err := createChat(ctx, header, runId, payload, s.store)
+	sendGRPCHeader(ctx, header)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return grpcChatIds(redactedForDemo(*chatIds)), nil
+}
+
+func (s *grpcServer) GetToolCall(ctx context.Context, in *sentinelpb.GetToolCallRequest) (*sentinelpb.ToolCall, error) {
+	toolCallId, err := grpcUUID("tool_call_id", in.ToolCallId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.authorize(ctx, "GET /tool_call/{toolCallId}", map[string]string{"toolCallId": toolCallId.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	toolCall, err := s.store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting tool call: %v", err)
+	}
+	if toolCall == nil {
+		return nil, status.Error(codes.NotFound, "tool call not found")
+	}
+	return grpcToolCall(redactedForDemo(*toolCall)), nil
+}
+
+func (s *grpcServer) GetToolCallStatus(ctx context.Context, in *sentinelpb.GetToolCallStatusRequest) (*sentinelpb.GetToolCallStatusResponse, error) {
+	toolCallId, err := grpcUUID("tool_call_id", in.ToolCallId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.authorize(ctx, "GET /tool_call/{toolCallId}/status", map[string]string{"toolCallId": toolCallId.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	toolCallStatus, err := getToolCallStatus(ctx, toolCallId, s.store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting tool call status: %v", err)
+	}
+	return &sentinelpb.GetToolCallStatusResponse{Status: string(toolCallStatus)}, nil
+}
+
+func (s *grpcServer) CreateSupervisionRequest(ctx context.Context, in *sentinelpb.CreateSupervisionRequestRequest) (*sentinelpb.CreateSupervisionRequestResponse, error) {
+	toolCallId, err := grpcUUID("tool_call_id", in.ToolCallId)
+	if err != nil {
+		return nil, err
+	}
+	chainId, err := grpcUUID("chain_id", in.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	supervisorId, err := grpcUUID("supervisor_id", in.SupervisorId)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{"toolCallId": toolCallId.String(), "chainId": chainId.String(), "supervisorId": supervisorId.String()}
+	ctx, err = s.authorize(ctx, "POST /tool_call/{toolCallId}/chain/{chainId}/supervisor/{supervisorId}/supervision_request", params)
+	if err != nil {
+		return nil, err
+	}
+
+	request := SupervisionRequest{SupervisorId: supervisorId, PositionInChain: int(in.PositionInChain)}
+	header := make(http.Header)
+	supervisionRequestId, err := createSupervisionRequest(ctx, header, toolCallId, chainId, supervisorId, request, s.hub, s.store)
+	sendGRPCHeader(ctx, header)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &sentinelpb.CreateSupervisionRequestResponse{SupervisionRequestId: grpcUUIDString(supervisionRequestId)}, nil
+}
+
+func (s *grpcServer) GetSupervisionRequestStatus(ctx context.Context, in *sentinelpb.GetSupervisionRequestStatusRequest) (*sentinelpb.SupervisionStatus, error) {
+	supervisionRequestId, err := grpcUUID("supervision_request_id", in.SupervisionRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.authorize(ctx, "GET /supervision_request/{supervisionRequestId}/status", map[string]string{"supervisionRequestId": supervisionRequestId.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	supervisionStatus, err := s.store.GetSupervisionRequestStatus(ctx, supervisionRequestId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting supervisor: %v", err)
+	}
+	if supervisionStatus == nil {
+		return &sentinelpb.SupervisionStatus{}, nil
+	}
+	return grpcSupervisionStatus(*supervisionStatus), nil
+}
+
+func (s *grpcServer) CreateSupervisionResult(ctx context.Context, in *sentinelpb.CreateSupervisionResultRequest) (*sentinelpb.CreateSupervisionResultResponse, error) {
+	supervisionRequestId, err := grpcUUID("supervision_request_id", in.SupervisionRequestId)
+	if err != nil {
+		return nil, err
+	}
+	if in.Result == nil {
+		return nil, status.Error(codes.InvalidArgument, "result is required")
+	}
+
+	result, err := supervisionResultFromGRPC(in.Result)
+	if err != nil {
+		return nil, err
+	}
+	result.SupervisionRequestId = supervisionRequestId
+
+	ctx, err = s.authorize(ctx, "POST /supervision_request/{supervisionRequestId}/result", map[string]string{"supervisionRequestId": supervisionRequestId.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	header := make(http.Header)
+	resultId, clarification, err := createSupervisionResult(ctx, header, supervisionRequestId, result, s.store, s.hub)
+	sendGRPCHeader(ctx, header)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	// The verdict asked the agent to clarify rather than deciding
+	if clarification != nil {
+		return &sentinelpb.CreateSupervisionResultResponse{Clarification: grpcClarification(redactedForDemo(*clarification))}, nil
+	}
+	return &sentinelpb.CreateSupervisionResultResponse{ResultId: grpcUUIDString(resultId)}, nil
+}
+
+func (s *grpcServer) GetSupervisionResult(ctx context.Context, in *sentinelpb.GetSupervisionResultRequest) (*sentinelpb.SupervisionResult, error) {
+	supervisionRequestId, err := grpcUUID("supervision_request_id", in.SupervisionRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.authorize(ctx, "GET /supervision_request/{supervisionRequestId}/result", map[string]string{"supervisionRequestId": supervisionRequestId.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	supervisionRequest, err := s.store.GetSupervisionRequest(ctx, supervisionRequestId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting supervision request: %v", err)
+	}
+	if supervisionRequest == nil {
+		return nil, status.Error(codes.NotFound, "Supervision request not found")
+	}
+
+	result, err := s.store.GetSupervisionResultFromRequestID(ctx, supervisionRequestId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting supervision result: %v", err)
+	}
+	// Undecided requests have no result, which the HTTP route answers with null
+	if result == nil {
+		return &sentinelpb.SupervisionResult{}, nil
+	}
+	return grpcSupervisionResult(redactedForDemo(*result)), nil
+}
+
+// watchedToolCall is a tool call whose decision a Supervise stream waits for
+type watchedToolCall struct {
+	id            uuid.UUID
+	correlationId string
+}
+
+// Supervise serves the requests of a stream in the order they're sent, while watched tool calls are
+// checked for decisions alongside
+func (s *grpcServer) Supervise(stream sentinelpb.Sentinel_SuperviseServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	// Streams can't be sent to concurrently
+	var sendMutex sync.Mutex
+	send := func(response *sentinelpb.SuperviseResponse) error {
+		sendMutex.Lock()
+		defer sendMutex.Unlock()
+		return stream.Send(response)
+	}
+
+	watch := make(chan watchedToolCall)
+	watching := make(chan error, 1)
+	go func() {
+		watching <- s.sendDecisions(ctx, watch, send)
+	}()
+
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			close(watch)
+			return <-watching
+		}
+		if err != nil {
+			return err
+		}
+
+		response, watched := s.superviseRequest(ctx, request)
+		for _, toolCall := range watched {
+			select {
+			case watch <- toolCall:
+			case err := <-watching:
+				return err
+			}
+		}
+		if response != nil {
+			if err := send(response); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// superviseRequest serves a request of a Supervise stream, returning its response, if there is one
+// right away, and the tool calls it asks to watch
+func (s *grpcServer) superviseRequest(ctx context.Context, request *sentinelpb.SuperviseRequest) (*sentinelpb.SuperviseResponse, []watchedToolCall) {
+	correlationId := request.CorrelationId
+
+	switch r := request.Request.(type) {
+	case *sentinelpb.SuperviseRequest_Watch:
+		// Tool calls are only watched if every one of them can be read with the stream's key
+		watched := make([]watchedToolCall, 0, len(r.Watch.ToolCallIds))
+		for _, id := range r.Watch.ToolCallIds {
+			toolCall, err := s.GetToolCall(ctx, &sentinelpb.GetToolCallRequest{ToolCallId: id})
+			if err != nil {
+				return superviseError(correlationId, err), nil
+			}
+			toolCallId, err := grpcUUID("tool_call_id", toolCall.Id)
+			if err != nil {
+				return superviseError(correlationId, err), nil
+			}
+			watched = append(watched, watchedToolCall{id: toolCallId, correlationId: correlationId})
+		}
+		return nil, watched
+
+	case *sentinelpb.SuperviseRequest_CreateSupervisionRequest:
+		created, err := s.CreateSupervisionRequest(ctx, r.CreateSupervisionRequest)
+		if err != nil {
+			return superviseError(correlationId, err), nil
+		}
+		return &sentinelpb.SuperviseResponse{
+			CorrelationId: correlationId,
+			Response:      &sentinelpb.SuperviseResponse_SupervisionRequestCreated{SupervisionRequestCreated: created},
+		}, nil
+
+	case *sentinelpb.SuperviseRequest_CreateSupervisionResult:
+		created, err := s.CreateSupervisionResult(ctx, r.CreateSupervisionResult)
+		if err != nil {
+			return superviseError(correlationId, err), nil
+		}
+		return &sentinelpb.SuperviseResponse{
+			CorrelationId: correlationId,
+			Response:      &sentinelpb.SuperviseResponse_SupervisionResultCreated{SupervisionResultCreated: created},
+		}, nil
+
+	default:
+		return superviseError(correlationId, status.Error(codes.InvalidArgument, "request is empty")), nil
+	}
+}
+
+func superviseError(correlationId string, err error) *sentinelpb.SuperviseResponse {
+	st := status.Convert(err)
+	return &sentinelpb.SuperviseResponse{
+		CorrelationId: correlationId,
+		Response:      &sentinelpb.SuperviseResponse_Error{Error: &sentinelpb.Error{Code: int32(st.Code()), Message: st.Message()}},
+	}
+}
+
+// sendDecisions sends the decision of every watched tool call once it's decided, until the watch
+// channel is closed and every tool call sent on it was decided. Tool calls are checked again when the
+// hub hears one of their supervision requests was resolved.
+func (s *grpcServer) sendDecisions(ctx context.Context, watch <-chan watchedToolCall, send func(*sentinelpb.SuperviseResponse) error) error {
+	watcher := newDecisionWatcher()
+	// Tool calls can be watched by several requests of the stream, each of them sent the decision
+	pending := make(map[uuid.UUID][]watchedToolCall)
+	defer func() {
+		for id := range pending {
+			s.hub.unwatchDecision(watcher, id)
+		}
+	}()
+
+	// check sends the decision of a tool call if it's decided, reporting whether it still waits
+	check := func(toolCall watchedToolCall) (bool, error) {
+		response := s.toolCallDecision(ctx, toolCall)
+		if response == nil {
+			return true, nil
+		}
+		return false, send(response)
+	}
+
+	for watch != nil || len(pending) > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case toolCall, ok := <-watch:
+			if !ok {
+				watch = nil
+				continue
+			}
+			s.hub.watchDecision(watcher, toolCall.id)
+			waiting, err := check(toolCall)
+			if err != nil {
+				return err
+			}
+			if waiting {
+				pending[toolCall.id] = append(pending[toolCall.id], toolCall)
+			} else if len(pending[toolCall.id]) == 0 {
+				s.hub.unwatchDecision(watcher, toolCall.id)
+			}
+		case <-watcher.notify:
+			for id := range watcher.take() {
+				remaining := pending[id][:0]
+				for _, toolCall := range pending[id] {
+					waiting, err := check(toolCall)
+					if err != nil {
+						return err
+					}
+					if waiting {
+						remaining = append(remaining, toolCall)
+					}
+				}
+				if len(remaining) > 0 {
+					pending[id] = remaining
+					continue
+				}
+				delete(pending, id)
+				s.hub.unwatchDecision(watcher, id)
+			}
+		}
+	}
+
+	return nil
+}
+
+// toolCallDecision returns the response with a watched tool call's decision, or with the error
+// that stopped it being watched, or nil if it's undecided
+func (s *grpcServer) toolCallDecision(ctx context.Context, toolCall watchedToolCall) *sentinelpb.SuperviseResponse {
+	decision, result, err := decideToolCall(ctx, toolCall.id, s.store)
+	if err != nil {
+		return superviseError(toolCall.correlationId, status.Errorf(codes.Internal, "error deciding tool call %s: %v", toolCall.id, err))
+	}
+	if decision == nil {
+		return nil
+	}
+
+	decided := &sentinelpb.ToolCallDecision{ToolCallId: toolCall.id.String(), Decision: string(*decision)}
+	if result != nil {
+		decided.Result = grpcSupervisionResult(redactedForDemo(*result))
+	}
+
+	return &sentinelpb.SuperviseResponse{
+		CorrelationId: toolCall.correlationId,
+		Response:      &sentinelpb.SuperviseResponse_Decision{Decision: decided},
+	}
+}
+
+// grpcString is the proto field of an optional string of the API, empty if unset
+func grpcString[T ~string](value *T) string {
+	if value == nil {
+		return ""
+	}
+	return string(*value)
+}
+
+func grpcUUIDString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func grpcTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func grpcStrings(values *[]string) []string {
+	if values == nil {
+		return nil
+	}
+	return *values
+}
+
+func grpcRun(run Run) *sentinelpb.Run {
+	out := &sentinelpb.Run{
+		Id:        run.Id.String(),
+		TaskId:    run.TaskId.String(),
+		CreatedAt: timestamppb.New(run.CreatedAt),
+		Status:    grpcString(run.Status),
+		Result:    stringOrEmpty(run.Result),
+		AgentId:   grpcUUIDString(run.AgentId),
+		Priority:  grpcString(run.Priority),
+	}
+	if run.AutonomyLevel != nil {
+		level := int32(*run.AutonomyLevel)
+		out.AutonomyLevel = &level
+	}
+	return out
+}
+
+func grpcTool(tool Tool) (*sentinelpb.Tool, error) {
+	attributes, err := structpb.NewStruct(tool.Attributes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error encoding tool attributes: %v", err)
+	}
+
+	out := &sentinelpb.Tool{
+		Id:                grpcUUIDString(tool.Id),
+		RunId:             tool.RunId.String(),
+		Name:              tool.Name,
+		Description:       tool.Description,
+		Attributes:        attributes,
+		IgnoredAttributes: grpcStrings(tool.IgnoredAttributes),
+		Code:              tool.Code,
+	}
+	if tool.Parameters != nil {
+		out.Parameters, err = structpb.NewStruct(*tool.Parameters)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error encoding tool parameters: %v", err)
+		}
+	}
+	return out, nil
+}
+
+// grpcToolCall converts a tool call as the store reads it back, with the arguments of the record
+// it stored
+func grpcToolCall(toolCall AsteroidToolCall) *sentinelpb.ToolCall {
+	out := &sentinelpb.ToolCall{
+		Id:        toolCall.Id.String(),
+		CallId:    stringOrEmpty(toolCall.CallId),
+		ToolId:    toolCall.ToolId.String(),
+		Name:      stringOrEmpty(toolCall.Name),
+		Arguments: stringOrEmpty(storedToolCallArguments(toolCall)),
+		CreatedAt: grpcTimestamp(toolCall.CreatedAt),
+	}
+	if toolCall.Redactions != nil {
+		for _, span := range *toolCall.Redactions {
+			out.Redactions = append(out.Redactions, &sentinelpb.RedactedSpan{
+				Redactor:    span.Redactor,
+				Placeholder: span.Placeholder,
+				Start:       int32(span.Start),
+				End:         int32(span.End),
+			})
+		}
+	}
+	return out
+}
+
+func grpcChatIds(chatIds ChatIds) *sentinelpb.ChatIds {
+	out := &sentinelpb.ChatIds{ChatId: chatIds.ChatId.String()}
+	for _, choice := range chatIds.ChoiceIds {
+		choiceIds := &sentinelpb.ChoiceIds{ChoiceId: choice.ChoiceId, MessageId: choice.MessageId}
+		for _, toolCall := range choice.ToolCallIds {
+			choiceIds.ToolCallIds = append(choiceIds.ToolCallIds, &sentinelpb.ToolCallIds{
+				ToolCallId: stringOrEmpty(toolCall.ToolCallId),
+				ToolId:     stringOrEmpty(toolCall.ToolId),
+			})
+		}
+		out.ChoiceIds = append(out.ChoiceIds, choiceIds)
+	}
+	return out
+}
+
+func grpcSupervisionStatus(supervisionStatus SupervisionStatus) *sentinelpb.SupervisionStatus {
+	return &sentinelpb.SupervisionStatus{
+		Id:                   int64(supervisionStatus.Id),
+		SupervisionRequestId: grpcUUIDString(supervisionStatus.SupervisionRequestId),
+		Status:               string(supervisionStatus.Status),
+		CreatedAt:            timestamppb.New(supervisionStatus.CreatedAt),
+	}
+}
+
+func grpcSupervisionResult(result SupervisionResult) *sentinelpb.SupervisionResult {
+	out := &sentinelpb.SupervisionResult{
+		Id:                   grpcUUIDString(result.Id),
+		SupervisionRequestId: result.SupervisionRequestId.String(),
+		ToolcallId:           grpcUUIDString(result.ToolcallId),
+		CreatedAt:            timestamppb.New(result.CreatedAt),
+		Decision:             string(result.Decision),
+		Reasoning:            result.Reasoning,
+		Verdict:              stringOrEmpty(result.Verdict),
+		VerdictBehavior:      grpcString(result.VerdictBehavior),
+		OverriddenDecision:   grpcString(result.OverriddenDecision),
+		TimeoutFallback:      grpcString(result.TimeoutFallback),
+	}
+	if result.Question != nil {
+		out.Question = grpcClarificationQuestion(*result.Question)
+	}
+	if result.Explanation != nil {
+		out.Explanation = &sentinelpb.ResultExplanation{
+			MatchedRuleIds: grpcStrings(result.Explanation.MatchedRuleIds),
+			Rationale:      stringOrEmpty(result.Explanation.Rationale),
+			Confidence:     result.Explanation.Confidence,
+			ArgumentRule:   stringOrEmpty(result.Explanation.ArgumentRule),
+		}
+	}
+	return out
+}
+
+// supervisionResultFromGRPC reads a result sent by a supervisor. The fields the server sets are
+// left for it to set, and created_at is the time of the call if unset.
+func supervisionResultFromGRPC(in *sentinelpb.SupervisionResult) (SupervisionResult, error) {
+	result := SupervisionResult{
+		Decision:  Decision(in.Decision),
+		Reasoning: in.Reasoning,
+		CreatedAt: time.Now(),
+	}
+	if in.CreatedAt != nil {
+		result.CreatedAt = in.CreatedAt.AsTime()
+	}
+	if in.ToolcallId != "" {
+		toolCallId, err := grpcUUID("toolcall_id", in.ToolcallId)
+		if err != nil {
+			return SupervisionResult{}, err
+		}
+		result.ToolcallId = &toolCallId
+	}
+	if in.Verdict != "" {
+		result.Verdict = &in.Verdict
+	}
+	if in.VerdictBehavior != "" {
+		behavior := VerdictBehavior(in.VerdictBehavior)
+		result.VerdictBehavior = &behavior
+	}
+	if in.OverriddenDecision != "" {
+		overridden := Decision(in.OverriddenDecision)
+		result.OverriddenDecision = &overridden
+	}
+	if in.Question != nil {
+		question := ClarificationQuestion{Question: in.Question.Question}
+		if len(in.Question.Choices) > 0 {
+			question.Choices = &in.Question.Choices
+		}
+		if len(in.Question.Arguments) > 0 {
+			question.Arguments = &in.Question.Arguments
+		}
+		result.Question = &question
+	}
+	if in.Explanation != nil {
+		explanation := ResultExplanation{Confidence: in.Explanation.Confidence}
+		if len(in.Explanation.MatchedRuleIds) > 0 {
+			explanation.MatchedRuleIds = &in.Explanation.MatchedRuleIds
+		}
+		if in.Explanation.Rationale != "" {
+			explanation.Rationale = &in.Explanation.Rationale
+		}
+		result.Explanation = &explanation
+	}
+	return result, nil
+}
+
+func grpcClarificationQuestion(question ClarificationQuestion) *sentinelpb.ClarificationQuestion {
+	return &sentinelpb.ClarificationQuestion{
+		Question:  question.Question,
+		Choices:   grpcStrings(question.Choices),
+		Arguments: grpcStrings(question.Arguments),
+	}
+}
+
+func grpcClarification(clarification Clarification) *sentinelpb.Clarification {
+	return &sentinelpb.Clarification{
+		Id:                   clarification.Id.String(),
+		SupervisionRequestId: clarification.SupervisionRequestId.String(),
+		Question:             grpcClarificationQuestion(clarification.Question),
+		AskedBy:              clarification.AskedBy,
+		AskedAt:              timestamppb.New(clarification.AskedAt),
+		Answer:               stringOrEmpty(clarification.Answer),
+		AnsweredAt:           grpcTimestamp(clarification.AnsweredAt),
+	}
+}
@@ -3,7 +3,10 @@ package asteroid
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
 	"slices"
 	"time"
@@ -11,8 +14,13 @@ import (
 	"github.com/google/uuid"
 )
 
-// respondJSON writes a JSON response with status 200 OK
+// respondJSON writes a JSON response with status 200 OK, with fake content in demo mode
 func respondJSON(w http.ResponseWriter, data interface{}, status int) {
+	writeJSON(w, redactForDemo(data), status)
+}
+
+// writeJSON writes a JSON response as is, even in demo mode
+func writeJSON(w http.ResponseWriter, data interface{}, status int) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 
@@ -21,12 +29,13 @@ func respondJSON(w http.ResponseWriter, data interface{}, status int) {
 	}
 }
 
-func apiCreateProjectHandler(w http.ResponseWriter, r *http.Request, store ProjectStore) {
+func apiCreateProjectHandler(w http.ResponseWriter, r *http.Request, store Store) {
 	ctx := r.Context()
 
 	var request struct {
-		Name          string   `json:"name"`
-		RunResultTags []string `json:"run_result_tags"`
+		Name           string     `json:"name"`
+		RunResultTags  []string   `json:"run_result_tags"`
+		OrganizationId *uuid.UUID `json:"organization_id"`
 	}
 	err := json.NewDecoder(r.Body).Decode(&request)
 	if err != nil {
@@ -45,15 +54,29 @@ func apiCreateProjectHandler(w http.ResponseWriter, r *http.Request, store Proje
 		return
 	}
 
+	if request.OrganizationId != nil {
+		organization, err := store.GetOrganization(ctx, *request.OrganizationId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "Error getting organization", err.Error())
+			return
+		}
+
+		if organization == nil {
+			sendErrorResponse(w, http.StatusNotFound, "Organization not found", "")
+			return
+		}
+	}
+
 	// Generate a new Project ID
 	id := uuid.New()
 
 	// Create the Project struct
 	project := Project{
-		Id:            id,
-		Name:          request.Name,
-		RunResultTags: request.RunResultTags,
-		CreatedAt:     time.Now(),
+		Id:             id,
+		Name:           request.Name,
+		RunResultTags:  request.RunResultTags,
+		OrganizationId: request.OrganizationId,
+		CreatedAt:      time.Now(),
 	}
 
 	// Store the project in the global projects map
@@ -125,21 +148,76 @@ func apiGetProjectTasksHandler(w http.ResponseWriter, r *http.Request, projectId
 }
 
 func apiCreateRunHandler(w http.ResponseWriter, r *http.Request, taskId uuid.UUID, store Store) {
-	ctx := r.Context()
+	// The body is optional, runs don't have to reference an agent
+	var request CreateRunJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil && !errors.Is(err, io.EOF) {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	runID, err := createRun(r.Context(), w.Header(), taskId, request, store)
+	if err != nil {
+		sendAPIError(w, err)
+		return
+	}
+
+	respondJSON(w, runID, http.StatusCreated)
+}
+
+// createRun creates a run of a task, unless its project is halted or out of runs. The headers of the
+// response are set on header.
+func createRun(ctx context.Context, header http.Header, taskId uuid.UUID, request CreateRunJSONBody, store Store) (uuid.UUID, error) {
+	project, err := getProjectForTask(ctx, taskId, store)
+	if err != nil {
+		return uuid.Nil, &apiError{status: http.StatusInternalServerError, message: "error getting project for task", details: err.Error()}
+	}
+
+	if err := checkKillSwitch(ctx, project, store); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := checkQuota(ctx, header, project, RunsPerDay, store); err != nil {
+		return uuid.Nil, err
+	}
+
+	if request.AgentId != nil {
+		agent, err := store.GetAgent(ctx, *request.AgentId)
+		if err != nil {
+			return uuid.Nil, &apiError{status: http.StatusInternalServerError, message: "error getting agent", details: err.Error()}
+		}
+
+		if agent == nil || project == nil || agent.ProjectId != project.Id {
+			return uuid.Nil, &apiError{status: http.StatusBadRequest, message: "agent not found in the task's project", details: request.AgentId.String()}
+		}
+	}
+
+	if request.AutonomyLevel != nil {
+		if err := validateAutonomyLevel(*request.AutonomyLevel); err != nil {
+			return uuid.Nil, &apiError{status: http.StatusBadRequest, message: "invalid autonomy level", details: err.Error()}
+		}
+	}
+
+	if request.Priority != nil {
+		if err := validateRunPriority(*request.Priority); err != nil {
+			return uuid.Nil, &apiError{status: http.StatusBadRequest, message: "invalid priority", details: err.Error()}
+		}
+	}
 
 	run := Run{
-		Id:        uuid.New(),
-		TaskId:    taskId, // Changed from ProjectId to TaskId
-		CreatedAt: time.Now(),
+		Id:            uuid.New(),
+		TaskId:        taskId, // Changed from ProjectId to TaskId
+		CreatedAt:     time.Now(),
+		AgentId:       request.AgentId,
+		AutonomyLevel: request.AutonomyLevel,
+		Priority:      request.Priority,
 	}
 
 	runID, err := store.CreateRun(ctx, run)
 	if err != nil {
-		sendErrorResponse(w, http.StatusInternalServerError, "Error creating run", err.Error())
-		return
+		return uuid.Nil, &apiError{status: http.StatusInternalServerError, message: "Error creating run", details: err.Error()}
 	}
 
-	respondJSON(w, runID, http.StatusCreated)
+	return runID, nil
 }
 
 func apiGetRunHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
@@ -172,31 +250,83 @@ func apiGetTaskRunsHandler(w http.ResponseWriter, r *http.Request, taskId uuid.U
 }
 
 func apiCreateRunToolHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
-	ctx := r.Context()
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "error reading request body", err.Error())
+		return
+	}
+
+	tool, err := createRunTool(r.Context(), w.Header(), runId, body, store)
+	if err != nil {
+		sendAPIError(w, err)
+		return
+	}
+
+	respondJSON(w, tool, http.StatusCreated)
+}
 
+// createRunTool creates a tool of a run from its JSON, unless the run is halted or paused. The
+// headers of the response are set on header.
+func createRunTool(ctx context.Context, header http.Header, runId uuid.UUID, body []byte, store Store) (*Tool, error) {
 	// Check that the run exists
 	run, err := store.GetRun(ctx, runId)
 	if err != nil {
-		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
-		return
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting run", details: err.Error()}
 	}
 
 	if run == nil {
-		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
-		return
+		return nil, &apiError{status: http.StatusNotFound, message: "Run not found"}
+	}
+
+	project, err := getProjectForRun(ctx, runId, store)
+	if err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting project for run", details: err.Error()}
+	}
+
+	if err := checkKillSwitch(ctx, project, store); err != nil {
+		return nil, err
+	}
+
+	if err := checkUnpausedRun(ctx, header, runId, store); err != nil {
+		return nil, err
 	}
 
 	var t struct {
-		Attributes        map[string]interface{} `json:"attributes"`
-		Name              string                 `json:"name"`
-		Description       string                 `json:"description"`
-		IgnoredAttributes []string               `json:"ignored_attributes"`
-		Code              string                 `json:"code"`
+		Attributes        map[string]interface{}  `json:"attributes"`
+		Name              string                  `json:"name"`
+		Description       string                  `json:"description"`
+		IgnoredAttributes []string                `json:"ignored_attributes"`
+		Code              string                  `json:"code"`
+		Parameters        *map[string]interface{} `json:"parameters"`
+	}
+	if !json.Valid(body) {
+		return nil, &apiError{status: http.StatusBadRequest, message: "Invalid JSON format"}
 	}
-	err = json.NewDecoder(r.Body).Decode(&t)
+
+	body, err = transformToolPayload(ctx, project, runId, body, store)
 	if err != nil {
-		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
-		return
+		return nil, ingestionHookError(err)
+	}
+
+	if err := json.Unmarshal(body, &t); err != nil {
+		return nil, &apiError{status: http.StatusBadRequest, message: "Invalid JSON format", details: err.Error()}
+	}
+
+	if t.Parameters != nil {
+		if err := validateToolParameters(*t.Parameters); err != nil {
+			return nil, &apiError{status: http.StatusBadRequest, message: "invalid tool parameters", details: err.Error()}
+		}
+	}
+
+	if run.AgentId != nil {
+		agent, err := store.GetAgent(ctx, *run.AgentId)
+		if err != nil {
+			return nil, &apiError{status: http.StatusInternalServerError, message: "error getting agent", details: err.Error()}
+		}
+
+		if agent != nil && !agentAllowsTool(*agent, t.Name) {
+			return nil, &apiError{status: http.StatusForbidden, message: fmt.Sprintf("agent %s version %s is not allowed to use tool %s", agent.Name, agent.Version, t.Name)}
+		}
 	}
 
 	// TODO revive this logic so that we can share tools across runs, requires schema changes
@@ -219,18 +349,21 @@ func apiCreateRunToolHandler(w http.ResponseWriter, r *http.Request, runId uuid.
 	// 	return
 	// }
 
-	tool, err := store.CreateTool(ctx, runId, t.Attributes, t.Name, t.Description, t.IgnoredAttributes, t.Code)
+	tool, err := store.CreateTool(ctx, runId, t.Attributes, t.Name, t.Description, t.IgnoredAttributes, t.Code, t.Parameters)
 	if err != nil {
-		sendErrorResponse(w, http.StatusInternalServerError, "error creating tool", err.Error())
-		return
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error creating tool", details: err.Error()}
 	}
 
 	if tool == nil {
-		sendErrorResponse(w, http.StatusInternalServerError, "error creating tool", "tool is nil")
-		return
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error creating tool", details: "tool is nil"}
 	}
 
-	respondJSON(w, tool, http.StatusCreated)
+	// Give the tool the supervisor chains its project prescribes for it
+	if _, err := applyToolPolicy(ctx, *tool, store); err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error applying tool policy", details: err.Error()}
+	}
+
+	return tool, nil
 }
 
 func apiGetSupervisorHandler(w http.ResponseWriter, r *http.Request, id uuid.UUID, store SupervisorStore) {
@@ -255,6 +388,21 @@ func apiCreateToolSupervisorChainsHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	for _, chain := range request {
+		if err := validateMinConfidence(chain); err != nil {
+			sendErrorResponse(w, http.StatusBadRequest, "invalid supervisor chain", err.Error())
+			return
+		}
+		if err := validateChainTimeouts(chain); err != nil {
+			sendErrorResponse(w, http.StatusBadRequest, "invalid supervisor chain", err.Error())
+			return
+		}
+		if err := validateChainTiers(chain); err != nil {
+			sendErrorResponse(w, http.StatusBadRequest, "invalid supervisor chain", err.Error())
+			return
+		}
+	}
+
 	// TODO do we want to return the chains here?
 	chainIds := make([]uuid.UUID, 0)
 	for _, chain := range request {
@@ -278,6 +426,26 @@ func apiCreateSupervisorHandler(w http.ResponseWriter, r *http.Request, _ uuid.U
 		return
 	}
 
+	var err error
+	switch request.Type {
+	case HumanSupervisor:
+		err = validateAssignmentAttributes(request.Attributes)
+	case EnsembleSupervisor:
+		err = validateEnsembleAttributes(request.Attributes)
+	case PolicySupervisor:
+		err = validatePolicyAttributes(request.Attributes)
+	case ComputerUseSupervisor:
+		err = validateComputerUseAttributes(request.Attributes)
+	case LlmSupervisor:
+		err = validateLlmAttributes(request.Attributes)
+	case ServicenowSupervisor:
+		err = validateServiceNowAttributes(request.Attributes)
+	}
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid supervisor attributes", err.Error())
+		return
+	}
+
 	// Create new supervisor
 	supervisorId, err := store.CreateSupervisor(ctx, request)
 	if err != nil {
@@ -309,6 +477,12 @@ func apiGetToolSupervisorChainsHandler(w http.ResponseWriter, r *http.Request, t
 		return
 	}
 
+	chains, err = selectChains(ctx, *tool, chains, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error selecting supervisor chains", err.Error())
+		return
+	}
+
 	respondJSON(w, chains, http.StatusOK)
 }
 
@@ -502,7 +676,7 @@ func apiGetToolHandler(w http.ResponseWriter, r *http.Request, id uuid.UUID, sto
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(w).Encode(tool)
+	err = json.NewEncoder(w).Encode(redactForDemo(tool))
 	if err != nil {
 		sendErrorResponse(w, http.StatusInternalServerError, "error encoding tool", err.Error())
 		return
@@ -526,10 +700,9 @@ func apiCreateSupervisionRequestHandler(
 	toolCallId uuid.UUID,
 	chainId uuid.UUID,
 	supervisorId uuid.UUID,
+	hub *Hub,
 	store Store,
 ) {
-	ctx := r.Context()
-
 	var request SupervisionRequest
 
 	err := json.NewDecoder(r.Body).Decode(&request)
@@ -538,38 +711,101 @@ func apiCreateSupervisionRequestHandler(
 		return
 	}
 
+	reviewID, err := createSupervisionRequest(r.Context(), w.Header(), toolCallId, chainId, supervisorId, request, hub, store)
+	if err != nil {
+		sendAPIError(w, err)
+		return
+	}
+
+	respondJSON(w, reviewID, http.StatusCreated)
+}
+
+// createSupervisionRequest asks a supervisor of a chain to review a tool call, unless its run or
+// project is halted, paused or at its limits. The headers of the response are set on header.
+func createSupervisionRequest(
+	ctx context.Context,
+	header http.Header,
+	toolCallId uuid.UUID,
+	chainId uuid.UUID,
+	supervisorId uuid.UUID,
+	request SupervisionRequest,
+	hub *Hub,
+	store Store,
+) (*uuid.UUID, error) {
 	// Check that the request, chain and supervisor exist
 	toolCall, err := store.GetToolCall(ctx, toolCallId)
 	if err != nil {
-		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
-		return
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting tool call", details: err.Error()}
 	}
 
 	if toolCall == nil {
-		sendErrorResponse(w, http.StatusNotFound, "Request group not found", "")
-		return
+		return nil, &apiError{status: http.StatusNotFound, message: "Request group not found"}
+	}
+
+	project, err := getProjectForToolCall(ctx, toolCallId, store)
+	if err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting project for tool call", details: err.Error()}
+	}
+
+	if err := checkKillSwitch(ctx, project, store); err != nil {
+		return nil, err
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting tool", details: err.Error()}
+	}
+
+	if tool != nil {
+		if err := checkUnpausedRun(ctx, header, tool.RunId, store); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkQuota(ctx, header, project, PendingReviews, store); err != nil {
+		return nil, err
+	}
+
+	if tool != nil {
+		if err := checkRunLimits(ctx, header, tool.RunId, project, store, MaxPendingSupervisions); err != nil {
+			return nil, err
+		}
 	}
 
 	chain, err := store.GetSupervisorChain(ctx, chainId)
 	if err != nil {
-		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor chain", err.Error())
-		return
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting supervisor chain", details: err.Error()}
 	}
 
 	if chain == nil {
-		sendErrorResponse(w, http.StatusNotFound, "Supervisor chain not found", "")
-		return
+		return nil, &apiError{status: http.StatusNotFound, message: "Supervisor chain not found"}
+	}
+
+	foundExecutionId, err := store.GetChainExecutionFromChainAndToolCall(ctx, chainId, toolCallId)
+	if err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting execution from chain ID", details: err.Error()}
+	}
+
+	// The chain may have been edited since the execution started, which then finishes under the
+	// version it started with
+	if foundExecutionId != nil {
+		chain, err = store.GetChainExecutionChain(ctx, *foundExecutionId)
+		if err != nil {
+			return nil, &apiError{status: http.StatusInternalServerError, message: "error getting supervisor chain", details: err.Error()}
+		}
+
+		if chain == nil {
+			return nil, &apiError{status: http.StatusNotFound, message: "Supervisor chain not found"}
+		}
 	}
 
 	supervisor, err := store.GetSupervisor(ctx, supervisorId)
 	if err != nil {
-		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor", err.Error())
-		return
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting supervisor", details: err.Error()}
 	}
 
 	if supervisor == nil {
-		sendErrorResponse(w, http.StatusNotFound, "Supervisor not found", "")
-		return
+		return nil, &apiError{status: http.StatusNotFound, message: "Supervisor not found"}
 	}
 
 	// Check that the supervisor is associated with the tool/request/chain
@@ -584,36 +820,24 @@ func apiCreateSupervisionRequestHandler(
 	}
 
 	if !found {
-		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Supervisor %s not associated with chain %s", supervisorId, chainId), "")
-		return
+		return nil, &apiError{status: http.StatusBadRequest, message: fmt.Sprintf("Supervisor %s not associated with chain %s", supervisorId, chainId)}
 	}
 
 	if pos != request.PositionInChain {
-		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Supervisor %s is not in the correct position in chain %s", supervisorId, chainId), "")
-		return
+		return nil, &apiError{status: http.StatusBadRequest, message: fmt.Sprintf("Supervisor %s is not in the correct position in chain %s", supervisorId, chainId)}
 	}
 
 	// Check that the chainexecution entry exists
-	foundExecutionId, err := store.GetChainExecutionFromChainAndToolCall(ctx, chainId, toolCallId)
-	if err != nil {
-		sendErrorResponse(w, http.StatusInternalServerError, "error getting execution from chain ID", err.Error())
-		return
-	}
-
 	if foundExecutionId == nil {
-		sendErrorResponse(w, http.StatusNotFound, fmt.Sprintf("chain execution not found for chain %s, tool call %s, and supervisor %s", chainId, toolCallId, supervisorId), "")
-		return
+		return nil, &apiError{status: http.StatusNotFound, message: fmt.Sprintf("chain execution not found for chain %s, tool call %s, and supervisor %s", chainId, toolCallId, supervisorId)}
 	}
 
 	if request.ChainexecutionId != nil && foundExecutionId != nil {
 		if *request.ChainexecutionId != *foundExecutionId {
-			sendErrorResponse(
-				w,
-				http.StatusInternalServerError,
-				fmt.Sprintf("chain execution ID mismatch for chain %s, tool call %s, and supervisor %s", chainId, toolCallId, supervisorId),
-				"",
-			)
-			return
+			return nil, &apiError{
+				status:  http.StatusInternalServerError,
+				message: fmt.Sprintf("chain execution ID mismatch for chain %s, tool call %s, and supervisor %s", chainId, toolCallId, supervisorId),
+			}
 		}
 	}
 
@@ -621,14 +845,106 @@ func apiCreateSupervisionRequestHandler(
 		request.ChainexecutionId = foundExecutionId
 	}
 
+	chainState, err := store.GetChainExecutionState(ctx, *foundExecutionId)
+	if err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting chain state", details: err.Error()}
+	}
+
+	// Supervisors of a tier another escalated past don't review the tool call
+	var escalation *Escalation
+	if chainState != nil {
+		if skipping := skippingEscalation(*chainState, pos); skipping != nil {
+			return nil, &apiError{status: http.StatusBadRequest, message: fmt.Sprintf("Supervisor %s was skipped by the escalation of supervisor %s", supervisorId, skipping.FromSupervisorId)}
+		}
+		escalation = escalationTo(*chainState, pos)
+	}
+
 	// Store the supervision in the database
 	reviewID, err := store.CreateSupervisionRequest(ctx, request, chainId, toolCallId)
 	if err != nil {
-		sendErrorResponse(w, http.StatusInternalServerError, "error creating supervision request", err.Error())
-		return
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error creating supervision request", details: err.Error()}
 	}
 
-	respondJSON(w, reviewID, http.StatusCreated)
+	recordMeteringEvent(ctx, project, ToolCallsSupervised, toolCallId, 1, store)
+
+	if project != nil {
+		requested := request
+		requested.Id = reviewID
+		payload := WebhookPayload{Event: SupervisionRequested, ProjectId: project.Id, ToolCallId: &toolCallId, SupervisionRequest: &requested}
+		if tool != nil {
+			payload.RunId = &tool.RunId
+		}
+		emitWebhookEvent(ctx, payload, store)
+	}
+
+	if escalation != nil {
+		go notifyEscalated(context.WithoutCancel(ctx), *escalation, toolCallId, store)
+	}
+
+	// A tool call a reviewed plan covers isn't reviewed again
+	planApproval, err := featureEnabled(ctx, project, planApprovalFlag, store)
+	if err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting feature flag", details: err.Error()}
+	}
+
+	planned := false
+	if planApproval {
+		planned, err = approvePlannedSupervisionRequest(ctx, *reviewID, toolCallId, store)
+		if err != nil {
+			return nil, &apiError{status: http.StatusInternalServerError, message: "error approving planned tool call", details: err.Error()}
+		}
+	}
+
+	if planned {
+		return reviewID, nil
+	}
+
+	// Nor is one the project's argument rules decide
+	argumentRules, err := featureEnabled(ctx, project, argumentRulesFlag, store)
+	if err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting feature flag", details: err.Error()}
+	}
+
+	ruled := false
+	if argumentRules {
+		ruled, err = decideByArgumentRule(ctx, *reviewID, *toolCall, tool, chainId, project, store)
+		if err != nil {
+			return nil, &apiError{status: http.StatusInternalServerError, message: "error applying argument rules", details: err.Error()}
+		}
+	}
+
+	if ruled {
+		return reviewID, nil
+	}
+
+	if supervisor.Type == HumanSupervisor {
+		if err := scheduleReminders(ctx, *reviewID, *supervisor, time.Now(), store); err != nil {
+			return nil, &apiError{status: http.StatusInternalServerError, message: "error scheduling reminders", details: err.Error()}
+		}
+	}
+
+	if err := scheduleDecisionTimeout(ctx, *reviewID, *chain, supervisorId, time.Now(), store); err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error scheduling decision timeout", details: err.Error()}
+	}
+
+	// Don't ask anyone to review a tool call whose input was already rejected
+	rejected, undecided, err := checkToolCallDependencies(ctx, toolCallId, store)
+	if err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error checking tool call dependencies", details: err.Error()}
+	}
+
+	if rejected != nil {
+		if err := rejectPendingSupervisionRequests(ctx, toolCallId, *rejected, store); err != nil {
+			return nil, &apiError{status: http.StatusInternalServerError, message: "error rejecting supervision request", details: err.Error()}
+		}
+	}
+
+	// Someone waiting on a realtime run's approval in a chat doesn't wait for the processor too
+	if supervisor.Type == HumanSupervisor && rejected == nil && len(undecided) == 0 {
+		dispatchRealtimeReview(ctx, *reviewID, hub, store)
+	}
+
+	return reviewID, nil
 }
 
 func apiCreateSupervisionResultHandler(
@@ -636,9 +952,8 @@ func apiCreateSupervisionResultHandler(
 	r *http.Request,
 	supervisionRequestId uuid.UUID,
 	store Store,
+	hub *Hub,
 ) {
-	ctx := r.Context()
-
 	var result SupervisionResult
 	err := json.NewDecoder(r.Body).Decode(&result)
 	if err != nil {
@@ -646,32 +961,213 @@ func apiCreateSupervisionResultHandler(
 		return
 	}
 
+	id, clarification, err := createSupervisionResult(r.Context(), w.Header(), supervisionRequestId, result, store, hub)
+	if err != nil {
+		sendAPIError(w, err)
+		return
+	}
+
+	if clarification != nil {
+		respondJSON(w, clarification, http.StatusAccepted)
+		return
+	}
+
+	respondJSON(w, id, http.StatusCreated)
+}
+
+// createSupervisionResult decides a supervision request, returning the result's ID, or the
+// clarification asked for if its verdict asks the agent to clarify rather than deciding. The headers
+// of the response are set on header.
+func createSupervisionResult(
+	ctx context.Context,
+	header http.Header,
+	supervisionRequestId uuid.UUID,
+	result SupervisionResult,
+	store Store,
+	hub *Hub,
+) (*uuid.UUID, *Clarification, error) {
+	// Only timeouts decide with a fallback, and only argument rules decide without a supervisor
+	result.TimeoutFallback = nil
+	if result.Explanation != nil {
+		result.Explanation.ArgumentRule = nil
+	}
+	result.UserId = userFromContext(ctx)
+
+	// Custom verdicts decide the tool call by their behavior
+	if result.Verdict != nil {
+		verdicts, err := getVerdictsForSupervisionRequest(ctx, supervisionRequestId, store)
+		if err != nil {
+			return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error getting verdicts", details: err.Error()}
+		}
+
+		if err := applyVerdict(&result, verdicts); err != nil {
+			return nil, nil, &apiError{status: http.StatusBadRequest, message: "invalid verdict", details: err.Error()}
+		}
+
+		// Asking the agent to clarify leaves the request undecided
+		if result.VerdictBehavior != nil && *result.VerdictBehavior == Clarify {
+			if err := validateClarificationQuestion(result.Question); err != nil {
+				return nil, nil, &apiError{status: http.StatusBadRequest, message: "invalid question", details: err.Error()}
+			}
+
+			clarification, err := requestClarification(ctx, supervisionRequestId, result, actorFromContext(ctx), store, hub)
+			if errors.Is(err, ErrSupervisionRequestResolved) || errors.Is(err, ErrClarificationPending) {
+				return nil, nil, &apiError{status: http.StatusConflict, message: err.Error()}
+			}
+			if err != nil {
+				return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error requesting clarification", details: err.Error()}
+			}
+
+			return nil, clarification, nil
+		}
+	} else {
+		result.VerdictBehavior = nil
+	}
+
+	// Automated supervisors say why they decided, for the humans reviewing the tool call after them
+	supervisor, err := getSupervisorForSupervisionRequest(ctx, supervisionRequestId, store)
+	if err != nil {
+		return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error getting supervisor", details: err.Error()}
+	}
+
+	if supervisor != nil {
+		if err := validateExplanation(result.Explanation, supervisor.Type); err != nil {
+			return nil, nil, &apiError{status: http.StatusBadRequest, message: "invalid explanation", details: err.Error()}
+		}
+
+		// Unsure automated supervisors pass the tool call on to the next supervisor whatever they decided
+		if err := applyConfidenceThreshold(ctx, supervisionRequestId, *supervisor, &result, store); err != nil {
+			return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error applying confidence threshold", details: err.Error()}
+		}
+
+		// So do those approving a tool call that strays from its run's plan
+		if err := escalatePlanDeviation(ctx, supervisionRequestId, *supervisor, &result, store); err != nil {
+			return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error checking plan deviation", details: err.Error()}
+		}
+	} else {
+		result.OverriddenDecision = nil
+	}
+
 	if result.Decision == Modify || result.Decision == Approve {
 		if result.ToolcallId == nil {
-			sendErrorResponse(w, http.StatusBadRequest, "Chosen tool call ID is required if you wish to modify or approve a given tool call", "")
-			return
+			return nil, nil, &apiError{status: http.StatusBadRequest, message: "Chosen tool call ID is required if you wish to modify or approve a given tool call"}
 		}
 
 		toolCall, err := store.GetToolCall(ctx, *result.ToolcallId)
 		if err != nil {
-			sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
-			return
+			return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error getting tool call", details: err.Error()}
 		}
 
 		if toolCall == nil {
-			sendErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Tool call %s not found", *result.ToolcallId), "")
-			return
+			return nil, nil, &apiError{status: http.StatusNotFound, message: fmt.Sprintf("Tool call %s not found", *result.ToolcallId)}
+		}
+	}
+
+	if err := checkModification(ctx, supervisionRequestId, &result, store); err != nil {
+		if errors.Is(err, ErrInvalidModification) {
+			return nil, nil, &apiError{status: http.StatusBadRequest, message: "invalid modification", details: err.Error()}
+		}
+		return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error checking modification", details: err.Error()}
+	}
+
+	// Approvals are held while the organization's kill switch is active
+	if holdsDecision(result.Decision) {
+		killSwitch, err := getActiveKillSwitchForSupervisionRequest(ctx, supervisionRequestId, store)
+		if err != nil {
+			return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error getting kill switch", details: err.Error()}
+		}
+
+		if killSwitch != nil {
+			return nil, nil, haltedError(killSwitch)
+		}
+	}
+
+	// Reviews of a paused run can't be decided until it's resumed
+	pause, err := getRunPauseForSupervisionRequest(ctx, supervisionRequestId, store)
+	if err != nil {
+		return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error getting run pause", details: err.Error()}
+	}
+
+	if pause != nil {
+		return nil, nil, runPausedError(header, pause)
+	}
+
+	// Tool calls can only be decided once everything they depend on has been
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, supervisionRequestId, store)
+	if err != nil {
+		return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error getting tool call for supervision request", details: err.Error()}
+	}
+
+	if toolCallId != nil {
+		rejected, undecided, err := checkToolCallDependencies(ctx, *toolCallId, store)
+		if err != nil {
+			return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error checking tool call dependencies", details: err.Error()}
+		}
+
+		if rejected != nil {
+			return nil, nil, &apiError{status: http.StatusConflict, message: fmt.Sprintf("Tool call %s was rejected because it depends on rejected tool call %s", *toolCallId, *rejected)}
+		}
+
+		if len(undecided) > 0 {
+			return nil, nil, &apiError{status: http.StatusConflict, message: fmt.Sprintf("Tool call %s depends on tool call %s, which has not been decided yet", *toolCallId, undecided[0])}
 		}
 	}
 
+	claim, err := claimedByOther(ctx, supervisionRequestId, result.UserId, store)
+	if err != nil {
+		return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error getting review claim", details: err.Error()}
+	}
+
+	if claim != nil {
+		return nil, nil, &apiError{status: http.StatusConflict, message: fmt.Sprintf("Supervision request %s is claimed by user %s", supervisionRequestId, claim.UserId)}
+	}
+
+	if err := checkPrecedents(ctx, supervisionRequestId, &result, store); err != nil {
+		if errors.Is(err, ErrInvalidPrecedent) {
+			return nil, nil, &apiError{status: http.StatusBadRequest, message: "invalid precedents", details: err.Error()}
+		}
+		return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error checking precedents", details: err.Error()}
+	}
+
 	// Check that the group, chain and supervisor, and request exist
-	id, err := store.CreateSupervisionResult(ctx, result, supervisionRequestId)
+	id, winner, err := resolveSupervisionRequest(ctx, supervisionRequestId, result, actorFromContext(ctx), store)
 	if err != nil {
-		sendErrorResponse(w, http.StatusInternalServerError, "error creating supervision result", err.Error())
-		return
+		return nil, nil, &apiError{status: http.StatusInternalServerError, message: "error creating supervision result", details: err.Error()}
 	}
 
-	respondJSON(w, id, http.StatusCreated)
+	if winner != nil {
+		conflict := DecisionConflict{
+			Error:                fmt.Sprintf("Supervision request %s was already resolved with decision %s", supervisionRequestId, winner.Decision),
+			SupervisionRequestId: supervisionRequestId,
+			AttemptedDecision:    result.Decision,
+			WinningResult:        *winner,
+		}
+		return nil, nil, &apiError{status: http.StatusConflict, message: conflict.Error, body: conflict}
+	}
+
+	// Reviewers who were shown the request lost the race, so tell them it's gone
+	if hub != nil {
+		result.SupervisionRequestId = supervisionRequestId
+		hub.resolveAssignedReview(result)
+	}
+
+	if id != nil && toolCallId != nil && result.Usage != nil {
+		recordSupervisorUsage(ctx, *id, *toolCallId, *result.Usage, store)
+	}
+
+	// If that rejected the tool call, everything depending on it is rejected too
+	if toolCallId != nil && (result.Decision == Reject || result.Decision == Terminate) {
+		decision, err := getToolCallDecision(ctx, *toolCallId, store)
+		if err != nil {
+			log.Printf("Error getting decision for tool call %s: %v", *toolCallId, err)
+		} else if decision != nil && (*decision == Reject || *decision == Terminate) {
+			if err := propagateRejection(ctx, *toolCallId, store); err != nil {
+				log.Printf("Error propagating rejection of tool call %s: %v", *toolCallId, err)
+			}
+		}
+	}
+
+	return id, nil, nil
 }
 
 func apiGetHubStatsHandler(w http.ResponseWriter, _ *http.Request, hub *Hub) {
@@ -694,6 +1190,10 @@ func apiGetProjectsHandler(w http.ResponseWriter, r *http.Request, store Project
 		return
 	}
 
+	// A project's key only sees its own project
+	key := apiKeyFromContext(ctx)
+	projects = slices.DeleteFunc(projects, func(project Project) bool { return !keyReachesProject(key, project.Id) })
+
 	respondJSON(w, projects, http.StatusOK)
 }
 
@@ -714,7 +1214,7 @@ func apiGetProjectHandler(w http.ResponseWriter, r *http.Request, id uuid.UUID,
 	respondJSON(w, project, http.StatusOK)
 }
 
-func apiGetSupervisionReviewPayloadHandler(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, store Store) {
+func apiGetSupervisionReviewPayloadHandler(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, params GetSupervisionReviewPayloadParams, store Store, blobs BlobStore) {
 	ctx := r.Context()
 
 	// Get the supervision request
@@ -772,19 +1272,79 @@ func apiGetSupervisionReviewPayloadHandler(w http.ResponseWriter, r *http.Reques
 	}
 
 	// Get the latest chat
-	requestData, responseData, err := store.GetChat(ctx, tool.RunId, 0)
+	requestData, responseData, format, err := store.GetChat(ctx, tool.RunId, 0)
 	if err != nil {
 		sendErrorResponse(w, http.StatusInternalServerError, "error getting messages for run", err.Error())
 		return
 	}
 
-	converter := OpenAIConverter{store}
+	converter, err := converterForFormat(&format, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error converting messages", err.Error())
+		return
+	}
 
 	asteroidMsgs, err := converter.ToAsteroidMessages(ctx, requestData, responseData, tool.RunId)
 	if err != nil {
 		sendErrorResponse(w, http.StatusInternalServerError, "error converting messages", err.Error())
 		return
 	}
+	markRedactedSpans(asteroidMsgs)
+
+	dependencyGraph, err := buildDependencyGraph(ctx, *toolCallId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error building dependency graph", err.Error())
+		return
+	}
+
+	forSupervisor := params.ForSupervisor != nil && *params.ForSupervisor
+	documents, err := getRunDocumentsWithContent(ctx, tool.RunId, forSupervisor, store, blobs)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run documents", err.Error())
+		return
+	}
+
+	artifacts, err := store.GetRunArtifacts(ctx, tool.RunId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run artifacts", err.Error())
+		return
+	}
+
+	screenshot, err := getToolCallScreenshot(ctx, *toolCall, artifacts, params.ScreenshotDiff != nil && *params.ScreenshotDiff, forSupervisor, blobs)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call screenshot", err.Error())
+		return
+	}
+
+	radiusCall := *toolCall
+	radiusCall.Arguments = storedToolCallArguments(*toolCall)
+	blastRadius, err := getBlastRadius(ctx, radiusCall, *tool, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error estimating blast radius", err.Error())
+		return
+	}
+
+	clarifications, err := store.GetClarifications(ctx, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting clarifications", err.Error())
+		return
+	}
+
+	planDeviation, err := store.GetToolCallPlanDeviation(ctx, *toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting plan deviation", err.Error())
+		return
+	}
+
+	argumentDiff, err := getArgumentDiff(ctx, *toolCall, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error diffing arguments", err.Error())
+		return
+	}
+
+	formCall := *toolCall
+	formCall.Arguments = storedToolCallArguments(*toolCall)
+	argumentForm := buildArgumentForm(formCall, tool)
 
 	// Build the review payload
 	reviewPayload := ReviewPayload{
@@ -793,13 +1353,23 @@ func apiGetSupervisionReviewPayloadHandler(w http.ResponseWriter, r *http.Reques
 		Toolcall:           *toolCall,
 		RunId:              tool.RunId,
 		Messages:           asteroidMsgs,
+		DependencyGraph:    dependencyGraph,
+		Documents:          &documents,
+		Artifacts:          &artifacts,
+		Screenshot:         screenshot,
+		ComputerAction:     getComputerAction(toolCall.Id, storedToolCallArguments(*toolCall)),
+		BlastRadius:        blastRadius,
+		Clarifications:     &clarifications,
+		PlanDeviation:      planDeviation,
+		ArgumentForm:       &argumentForm,
+		ArgumentDiff:       argumentDiff,
 	}
 
 	respondJSON(w, reviewPayload, http.StatusOK)
 }
 
 // determineChainStatus checks if a supervision chain has completed
-func determineChainStatus(requests []SupervisionRequestState, totalSupervisors int) Status {
+func determineChainStatus(requests []SupervisionRequestState, chain SupervisorChain) Status {
 	// No supervision requests means chain hasn't started
 	if len(requests) == 0 {
 		return Pending
@@ -829,12 +1399,10 @@ func determineChainStatus(requests []SupervisionRequestState, totalSupervisors i
 		return Completed
 	}
 
-	// If we didn't get an approval, we need to check if we reached the end
-	// and got a different valid completion (like Reject)
-	if highestPosition == totalSupervisors-1 {
-		if lastCompleted.Result != nil {
-			return Completed
-		}
+	// An escalation with no one left to escalate to, at the end of the chain or from its highest
+	// tier, completes it too
+	if lastCompleted.Result != nil && escalationTarget(chain, highestPosition, *lastCompleted.Result) >= len(chain.Supervisors) {
+		return Completed
 	}
 
 	return Pending
@@ -846,23 +1414,51 @@ func getToolCallStatus(ctx context.Context, toolCallId uuid.UUID, store Store) (
 		return Pending, fmt.Errorf("error getting chain executions: %w", err)
 	}
 
-	// Track status for each chain execution
-	executionStatuses := make([]Status, 0, len(chainExecutions))
-
+	states := make([]ChainExecutionState, 0, len(chainExecutions))
+	chains := make([]SupervisorChain, 0, len(chainExecutions))
 	for _, execution := range chainExecutions {
 		state, err := store.GetChainExecutionState(ctx, execution)
 		if err != nil {
 			return Pending, fmt.Errorf("error getting chain state: %w", err)
 		}
+		states = append(states, *state)
+		chains = append(chains, state.Chain)
+	}
 
-		status := determineChainStatus(state.SupervisionRequests, len(state.Chain.Supervisors))
+	// Chains the run's autonomy level skips don't hold the tool call up
+	selected, err := selectedChainIds(ctx, toolCallId, chains, store)
+	if err != nil {
+		return Pending, fmt.Errorf("error selecting chains: %w", err)
+	}
+
+	// Track status for each chain execution
+	executionStatuses := make([]Status, 0, len(chainExecutions))
+	awaitingClarification := false
+	skipped := 0
+
+	for _, state := range states {
+		if selected != nil && !selected[state.Chain.ChainId] {
+			skipped++
+			continue
+		}
+
+		status := determineChainStatus(state.SupervisionRequests, state.Chain)
 		executionStatuses = append(executionStatuses, status)
+
+		for _, request := range state.SupervisionRequests {
+			if request.Status.Status == AwaitingClarification {
+				awaitingClarification = true
+			}
+		}
 	}
 
-	// Request group is complete only if all chains are complete
+	// Request group is complete only if all chains are complete. Agents are told when a reviewer
+	// is waiting on their answer.
 	status := Pending
-	if allChainsComplete(executionStatuses) {
+	if allChainsComplete(executionStatuses) || (len(executionStatuses) == 0 && skipped > 0) {
 		status = Completed
+	} else if awaitingClarification {
+		status = AwaitingClarification
 	}
 
 	return status, nil
@@ -920,14 +1516,33 @@ func apiUpdateRunStatusHandler(w http.ResponseWriter, r *http.Request, runId uui
 		return
 	}
 
+	if err := updateRunStatus(ctx, runId, status, store); err != nil {
+		sendAPIError(w, err)
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+// updateRunStatus sets the status of a run, telling those waiting on it when it completes
+func updateRunStatus(ctx context.Context, runId uuid.UUID, status Status, store Store) error {
 	// Update the run status
 	err := store.UpdateRunStatus(ctx, runId, status)
 	if err != nil {
-		sendErrorResponse(w, http.StatusInternalServerError, "error updating run status", err.Error())
-		return
+		return &apiError{status: http.StatusInternalServerError, message: "error updating run status", details: err.Error()}
 	}
 
-	respondJSON(w, nil, http.StatusNoContent)
+	if status == Completed {
+		project, err := getProjectForRun(ctx, runId, store)
+		if err != nil {
+			log.Printf("Error getting project of run %s for webhooks: %v", runId, err)
+		} else if project != nil {
+			emitWebhookEvent(ctx, WebhookPayload{Event: RunCompleted, ProjectId: project.Id, RunId: &runId, RunStatus: &status}, store)
+		}
+		notifyWatchers(ctx, RunFinished, runId, nil, nil, store)
+	}
+
+	return nil
 }
 
 func apiUpdateRunResultHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
@@ -1006,33 +1621,114 @@ func apiGetToolCallHandler(w http.ResponseWriter, r *http.Request, id uuid.UUID,
 }
 
 func apiCreateNewChatHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
-	ctx := r.Context()
-
 	var payload AsteroidChat
 	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
 		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
 		return
 	}
 
-	converter := OpenAIConverter{store}
+	chatIds, err := createChat(r.Context(), w.Header(), runId, payload, store)
+	if err != nil {
+		sendAPIError(w, err)
+		return
+	}
+
+	respondJSON(w, chatIds, http.StatusOK)
+}
+
+// createChat validates and stores a chat of a run, unless the run can't take chats. The headers of the
+// response are set on header.
+func createChat(ctx context.Context, header http.Header, runId uuid.UUID, payload AsteroidChat, store Store) (*ChatIds, error) {
+	project, err := checkChat(ctx, header, runId, store)
+	if err != nil {
+		return nil, err
+	}
+
+	format := Openai
+	if payload.Format != nil {
+		format = *payload.Format
+	}
+
+	converter, err := converterForFormat(&format, store)
+	if err != nil {
+		return nil, &apiError{status: http.StatusBadRequest, message: "invalid chat format", details: err.Error()}
+	}
 
 	jsonRequest, err := converter.ValidateB64EncodedRequest(payload.RequestData)
 	if err != nil {
-		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Request: %s", err.Error()), "")
-		return
+		return nil, &apiError{status: http.StatusBadRequest, message: fmt.Sprintf("Request: %s", err.Error())}
 	}
 
 	jsonResponse, err := converter.ValidateB64EncodedResponse(payload.ResponseData)
 	if err != nil {
-		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Response: %s", err.Error()), "")
-		return
+		return nil, &apiError{status: http.StatusBadRequest, message: fmt.Sprintf("Response: %s", err.Error())}
+	}
+
+	return storeChat(ctx, project, runId, format, converter, jsonRequest, jsonResponse, store)
+}
+
+// admitChat returns the project of a run, responding instead if the project is halted, the run is
+// paused or at its limits, or the project is out of storage
+func admitChat(ctx context.Context, w http.ResponseWriter, runId uuid.UUID, store Store) (*Project, bool) {
+	project, err := checkChat(ctx, w.Header(), runId, store)
+	if err != nil {
+		sendAPIError(w, err)
+		return nil, false
+	}
+	return project, true
+}
+
+// checkChat is admitChat for logic the HTTP and gRPC APIs share, setting the headers of its response
+// on header
+func checkChat(ctx context.Context, header http.Header, runId uuid.UUID, store Store) (*Project, error) {
+	project, err := getProjectForRun(ctx, runId, store)
+	if err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error getting project for run", details: err.Error()}
+	}
+
+	if err := checkKillSwitch(ctx, project, store); err != nil {
+		return nil, err
+	}
+
+	if err := checkUnpausedRun(ctx, header, runId, store); err != nil {
+		return nil, err
+	}
+
+	if err := checkQuota(ctx, header, project, StoredBytes, store); err != nil {
+		return nil, err
+	}
+
+	if err := checkRunLimits(ctx, header, runId, project, store, ToolCallsPerMinute, MaxChatRequests); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// storeChat stores a validated chat and records what its choices refer to
+func storeChat(
+	ctx context.Context,
+	project *Project,
+	runId uuid.UUID,
+	format ChatFormat,
+	converter ChatFormatConverter,
+	jsonRequest, jsonResponse []byte,
+	store Store,
+) (*ChatIds, error) {
+	jsonRequest, jsonResponse, err := transformChatPayload(ctx, project, runId, jsonRequest, jsonResponse, store)
+	if err != nil {
+		return nil, ingestionHookError(err)
+	}
+
+	jsonRequest, jsonResponse, redactions, err := redactChatPayload(ctx, project, runId, jsonRequest, jsonResponse, store)
+	if err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error redacting chat", details: err.Error()}
 	}
 
 	// Parse out the choices into AsteroidChoice objects
 	asteroidChoices, err := converter.ToAsteroidChoices(ctx, jsonResponse, runId)
 	if err != nil {
-		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Error converting choices: %s", err.Error()), "")
-		return
+		return nil, &apiError{status: http.StatusBadRequest, message: fmt.Sprintf("Error converting choices: %s", err.Error())}
 	}
 
 	id, err := store.CreateChatRequest(
@@ -1041,18 +1737,27 @@ func apiCreateNewChatHandler(w http.ResponseWriter, r *http.Request, runId uuid.
 		jsonRequest,
 		jsonResponse,
 		asteroidChoices,
-		"openai",
+		string(format),
 		[]AsteroidMessage{},
+		parseChatUsage(jsonResponse, format),
 	)
 	if err != nil {
-		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Error creating chat request: %s", err.Error()), "")
-		return
+		return nil, &apiError{status: http.StatusBadRequest, message: fmt.Sprintf("Error creating chat request: %s", err.Error())}
 	}
 
+	if err := recordRedactions(ctx, *id, redactions, store); err != nil {
+		return nil, &apiError{status: http.StatusInternalServerError, message: "error recording redactions", details: err.Error()}
+	}
+
+	recordResourceReferences(ctx, runId, asteroidChoices, store)
+	recordPlanSteps(ctx, runId, asteroidChoices, store)
+	notifyNewToolCalls(ctx, runId, asteroidChoices, store)
+
+	recordMeteringEvent(ctx, project, BytesStored, *id, int64(len(jsonRequest)+len(jsonResponse)), store)
+
 	// Extract all IDs from the created chat structure
 	chatIds := extractChatIds(*id, asteroidChoices)
-
-	respondJSON(w, chatIds, http.StatusOK)
+	return &chatIds, nil
 }
 
 func extractChatIds(chatId uuid.UUID, choices []AsteroidChoice) ChatIds {
@@ -1106,19 +1811,24 @@ func apiGetRunMessagesHandler(w http.ResponseWriter, r *http.Request, runId uuid
 		return
 	}
 
-	requestData, responseData, err := store.GetChat(ctx, runId, index)
+	requestData, responseData, format, err := store.GetChat(ctx, runId, index)
 	if err != nil {
 		sendErrorResponse(w, http.StatusInternalServerError, "error getting messages for run", err.Error())
 		return
 	}
 
-	converter := OpenAIConverter{store}
+	converter, err := converterForFormat(&format, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error converting messages", err.Error())
+		return
+	}
 
 	asteroidMsgs, err := converter.ToAsteroidMessages(ctx, requestData, responseData, runId)
 	if err != nil {
 		sendErrorResponse(w, http.StatusInternalServerError, "error converting messages", err.Error())
 		return
 	}
+	markRedactedSpans(asteroidMsgs)
 
 	respondJSON(w, asteroidMsgs, http.StatusOK)
 }
@@ -1143,6 +1853,17 @@ func apiGetToolCallStateHandler(w http.ResponseWriter, r *http.Request, toolCall
 		Toolcall: *toolCall,
 	}
 
+	// A modified tool call is executed with the arguments its supervisor gave it
+	decision, result, err := decideToolCall(ctx, toolCall.Id, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error deciding tool call", err.Error())
+		return
+	}
+
+	if decision != nil && *decision == Modify && result != nil {
+		execution.ModifiedArguments = result.ModifiedArguments
+	}
+
 	// Get all chains for this tool
 	chains, err := store.GetSupervisorChains(ctx, toolCall.ToolId)
 	if err != nil {
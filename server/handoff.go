@@ -0,0 +1,231 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// getReviewPriority returns the risk tier of the tool a tool call is for. Returns nil if it has none.
+func getReviewPriority(ctx context.Context, toolCallId uuid.UUID, store Store) (*RiskTier, error) {
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool call: %w", err)
+	}
+	if toolCall == nil {
+		return nil, nil
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool: %w", err)
+	}
+	if tool == nil {
+		return nil, nil
+	}
+
+	return getToolRiskTier(ctx, *tool, store)
+}
+
+// snapshotReviewQueue lists every unresolved human review with its assignment and priority,
+// highest priority first
+func snapshotReviewQueue(ctx context.Context, store Store, hub *Hub) ([]HandoffItem, error) {
+	items := make([]HandoffItem, 0)
+	for _, status := range []Status{Assigned, Pending} {
+		requests, err := store.GetSupervisionRequestsForStatus(ctx, status)
+		if err != nil {
+			return nil, fmt.Errorf("error getting %s reviews: %w", status, err)
+		}
+
+		for _, request := range requests {
+			item := HandoffItem{
+				SupervisionRequestId: *request.Id,
+				Status:               status,
+				AssignedSession:      hub.assignedSession(*request.Id),
+			}
+
+			toolCallId, err := getToolCallForSupervisionRequest(ctx, *request.Id, store)
+			if err != nil {
+				return nil, err
+			}
+
+			if toolCallId != nil {
+				item.ToolCallId = toolCallId
+				item.Priority, err = getReviewPriority(ctx, *toolCallId, store)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			items = append(items, item)
+		}
+	}
+
+	rank := func(item HandoffItem) int {
+		if item.Priority == nil {
+			return -1
+		}
+		return riskTierRank[*item.Priority]
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return rank(items[i]) > rank(items[j])
+	})
+
+	return items, nil
+}
+
+func apiCreateHandoffBundleHandler(w http.ResponseWriter, r *http.Request, store Store, hub *Hub) {
+	ctx := r.Context()
+
+	var request CreateHandoffBundleJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if request.Name == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "name is required", "")
+		return
+	}
+
+	existing, err := store.GetHandoffBundleFromName(ctx, request.Name)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting handoff bundle", err.Error())
+		return
+	}
+
+	if existing != nil {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("handoff bundle %s already exists", request.Name), existing.Id.String())
+		return
+	}
+
+	items, err := snapshotReviewQueue(ctx, store, hub)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error snapshotting review queue", err.Error())
+		return
+	}
+
+	bundle := HandoffBundle{
+		Id:        uuid.New(),
+		Name:      request.Name,
+		CreatedAt: time.Now(),
+		CreatedBy: actorFromContext(ctx),
+		Items:     items,
+	}
+
+	if err := store.CreateHandoffBundle(ctx, bundle); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating handoff bundle", err.Error())
+		return
+	}
+
+	respondJSON(w, bundle, http.StatusCreated)
+}
+
+func apiGetHandoffBundlesHandler(w http.ResponseWriter, r *http.Request, store HandoffStore) {
+	bundles, err := store.GetHandoffBundles(r.Context())
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting handoff bundles", err.Error())
+		return
+	}
+
+	respondJSON(w, bundles, http.StatusOK)
+}
+
+func apiGetHandoffBundleHandler(w http.ResponseWriter, r *http.Request, handoffBundleId uuid.UUID, store HandoffStore) {
+	bundle, err := store.GetHandoffBundle(r.Context(), handoffBundleId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting handoff bundle", err.Error())
+		return
+	}
+
+	if bundle == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Handoff bundle not found", "")
+		return
+	}
+
+	respondJSON(w, bundle, http.StatusOK)
+}
+
+func apiRestoreHandoffBundleHandler(w http.ResponseWriter, r *http.Request, handoffBundleId uuid.UUID, store Store, hub *Hub) {
+	ctx := r.Context()
+
+	var request RestoreHandoffBundleJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if request.Session == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "session is required", "")
+		return
+	}
+
+	bundle, err := store.GetHandoffBundle(ctx, handoffBundleId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting handoff bundle", err.Error())
+		return
+	}
+
+	if bundle == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Handoff bundle not found", "")
+		return
+	}
+
+	result := HandoffRestoreResult{
+		HandoffBundleId: handoffBundleId,
+		Session:         request.Session,
+		Reassigned:      []uuid.UUID{},
+		Skipped:         []uuid.UUID{},
+	}
+
+	actor := actorFromContext(ctx)
+	for _, item := range bundle.Items {
+		// Reviews decided since the snapshot stay decided
+		existing, err := store.GetSupervisionResultFromRequestID(ctx, item.SupervisionRequestId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision result", err.Error())
+			return
+		}
+
+		supervisionRequest, err := store.GetSupervisionRequest(ctx, item.SupervisionRequestId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision request", err.Error())
+			return
+		}
+
+		if existing != nil || supervisionRequest == nil ||
+			(supervisionRequest.Status != nil && supervisionRequest.Status.Status != Pending && supervisionRequest.Status.Status != Assigned) {
+			result.Skipped = append(result.Skipped, item.SupervisionRequestId)
+			continue
+		}
+
+		previous := hub.assignedSession(item.SupervisionRequestId)
+		if err := hub.reassignReview(*supervisionRequest, request.Session); err != nil {
+			log.Printf("Error reassigning review %s to session %s: %v", item.SupervisionRequestId, request.Session, err)
+		}
+
+		details := map[string]interface{}{
+			"handoff_bundle_id": handoffBundleId,
+			"session":           request.Session,
+		}
+		if previous != nil {
+			details["previous_session"] = *previous
+		}
+		recordAuditEvent(ctx, actor, AuditActionReviewReassigned, supervisionRequestResource, item.SupervisionRequestId, details, store)
+
+		result.Reassigned = append(result.Reassigned, item.SupervisionRequestId)
+	}
+
+	if err := store.SetHandoffBundleRestored(ctx, handoffBundleId, request.Session, time.Now()); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error updating handoff bundle", err.Error())
+		return
+	}
+
+	respondJSON(w, result, http.StatusOK)
+}
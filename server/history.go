@@ -0,0 +1,161 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// AgentActor is the history actor for tool calls, which are made by the agent
+const AgentActor = "agent"
+
+// auditHistoryEvents maps the audit actions that change a tool call's state to history events
+var auditHistoryEvents = map[AuditAction]ToolCallHistoryEvent{
+	AuditActionReviewAssigned:   AssignedToSession,
+	AuditActionReviewReassigned: HandedOver,
+	AuditActionDecisionRecorded: Decided,
+	AuditActionDecisionConflict: DecisionLost,
+
+	AuditActionClarificationRequested: AskedAgent,
+	AuditActionClarificationAnswered:  AgentAnswered,
+
+	AuditActionReviewRecovered: Recovered,
+	AuditActionReviewReminded:  Reminded,
+}
+
+// getToolCallHistory reconstructs every state a tool call passed through from its supervision
+// statuses, results and audit events. Returns nil if the tool call doesn't exist.
+func getToolCallHistory(ctx context.Context, toolCallId uuid.UUID, store Store) ([]ToolCallHistoryEntry, error) {
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool call: %w", err)
+	}
+	if toolCall == nil {
+		return nil, nil
+	}
+
+	history := make([]ToolCallHistoryEntry, 0)
+	if toolCall.CreatedAt != nil {
+		history = append(history, ToolCallHistoryEntry{
+			CreatedAt: *toolCall.CreatedAt,
+			Event:     Created,
+			Actor:     AgentActor,
+		})
+	}
+
+	chainExecutions, err := store.GetChainExecutionsFromToolCall(ctx, toolCallId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chain executions: %w", err)
+	}
+
+	for _, execution := range chainExecutions {
+		state, err := store.GetChainExecutionState(ctx, execution)
+		if err != nil {
+			return nil, fmt.Errorf("error getting chain state: %w", err)
+		}
+
+		for _, request := range state.SupervisionRequests {
+			entries, err := getSupervisionRequestHistory(ctx, request, store)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range entries {
+				entries[i].ChainId = &state.Chain.ChainId
+			}
+			history = append(history, entries...)
+		}
+	}
+
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].CreatedAt.Before(history[j].CreatedAt)
+	})
+
+	return history, nil
+}
+
+// getSupervisionRequestHistory returns the history entries of one supervision request
+func getSupervisionRequestHistory(ctx context.Context, request SupervisionRequestState, store Store) ([]ToolCallHistoryEntry, error) {
+	requestId := *request.SupervisionRequest.Id
+	supervisorId := request.SupervisionRequest.SupervisorId
+	entries := make([]ToolCallHistoryEntry, 0)
+
+	statuses, err := store.GetSupervisionStatusesForRequest(ctx, requestId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting supervision statuses: %w", err)
+	}
+
+	for _, status := range statuses {
+		entries = append(entries, ToolCallHistoryEntry{
+			CreatedAt:            status.CreatedAt,
+			Event:                StatusChanged,
+			Actor:                SystemActor,
+			SupervisionRequestId: &requestId,
+			SupervisorId:         &supervisorId,
+			Status:               &status.Status,
+		})
+	}
+
+	events, err := store.GetAuditEvents(ctx, supervisionRequestResource, requestId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting audit events: %w", err)
+	}
+
+	decisionAudited := false
+	for _, event := range events {
+		historyEvent, ok := auditHistoryEvents[event.Action]
+		if !ok {
+			continue
+		}
+
+		entry := ToolCallHistoryEntry{
+			CreatedAt:            event.CreatedAt,
+			Event:                historyEvent,
+			Actor:                event.Actor,
+			SupervisionRequestId: &requestId,
+			SupervisorId:         &supervisorId,
+			Details:              &event.Details,
+		}
+		if decision, ok := event.Details["decision"].(string); ok {
+			entry.Decision = (*Decision)(&decision)
+		}
+		if event.Action == AuditActionDecisionRecorded {
+			decisionAudited = true
+		}
+
+		entries = append(entries, entry)
+	}
+
+	// Results stored before decisions were audited don't say who made them
+	if request.Result != nil && !decisionAudited {
+		entries = append(entries, ToolCallHistoryEntry{
+			CreatedAt:            request.Result.CreatedAt,
+			Event:                Decided,
+			Actor:                "unknown",
+			SupervisionRequestId: &requestId,
+			SupervisorId:         &supervisorId,
+			Decision:             &request.Result.Decision,
+			Details:              &map[string]interface{}{"reasoning": request.Result.Reasoning},
+		})
+	}
+
+	return entries, nil
+}
+
+func apiGetToolCallHistoryHandler(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID, store Store) {
+	history, err := getToolCallHistory(r.Context(), toolCallId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call history", err.Error())
+		return
+	}
+
+	if history == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call not found", "")
+		return
+	}
+
+	respondJSON(w, history, http.StatusOK)
+}
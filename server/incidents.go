@@ -0,0 +1,203 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const projectResource = "project"
+
+// withIncidentChain adds the chain of the project's active incident to a tool's chains, so every
+// call needs a human review while incident mode is on. The tool's own chains still apply.
+func withIncidentChain(ctx context.Context, tool Tool, chains []SupervisorChain, store Store) ([]SupervisorChain, error) {
+	project, err := getProjectForRun(ctx, tool.RunId, store)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return chains, nil
+	}
+
+	incident, err := store.GetActiveIncident(ctx, project.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting active incident: %w", err)
+	}
+	if incident == nil {
+		return chains, nil
+	}
+
+	for _, chain := range chains {
+		if chain.ChainId == incident.ChainId {
+			return chains, nil
+		}
+	}
+
+	chain, err := store.GetSupervisorChain(ctx, incident.ChainId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting incident chain: %w", err)
+	}
+	if chain == nil {
+		return chains, nil
+	}
+
+	return append(chains, *chain), nil
+}
+
+func apiGetProjectIncidentsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	incidents, err := store.GetProjectIncidents(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting incidents", err.Error())
+		return
+	}
+
+	respondJSON(w, incidents, http.StatusOK)
+}
+
+func apiStartIncidentModeHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request StartIncidentModeJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	active, err := store.GetActiveIncident(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting active incident", err.Error())
+		return
+	}
+
+	if active != nil {
+		sendErrorResponse(w, http.StatusConflict, "incident mode is already on", active.Id.String())
+		return
+	}
+
+	var supervisorId uuid.UUID
+	if request.SupervisorId != nil {
+		supervisor, err := store.GetSupervisor(ctx, *request.SupervisorId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor", err.Error())
+			return
+		}
+
+		if supervisor == nil {
+			sendErrorResponse(w, http.StatusNotFound, "Supervisor not found", "")
+			return
+		}
+
+		if supervisor.Type != HumanSupervisor {
+			sendErrorResponse(w, http.StatusBadRequest, "incident mode needs a human supervisor", string(supervisor.Type))
+			return
+		}
+		supervisorId = *request.SupervisorId
+	} else {
+		id, err := store.CreateSupervisor(ctx, Supervisor{
+			Name:        "Incident reviewer",
+			Description: "Reviews every tool call while the project is in incident mode",
+			Type:        HumanSupervisor,
+			Attributes:  map[string]interface{}{"incident": true},
+			CreatedAt:   time.Now(),
+		})
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error creating supervisor", err.Error())
+			return
+		}
+		supervisorId = id
+	}
+
+	chainId, err := store.CreateChain(ctx, ChainRequest{SupervisorIds: &[]uuid.UUID{supervisorId}})
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating incident chain", err.Error())
+		return
+	}
+
+	incident := IncidentMode{
+		Id:           uuid.New(),
+		ProjectId:    projectId,
+		Reason:       request.Reason,
+		SupervisorId: supervisorId,
+		ChainId:      *chainId,
+		StartedAt:    time.Now(),
+		StartedBy:    actorFromContext(ctx),
+	}
+
+	if err := store.CreateIncident(ctx, incident); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating incident", err.Error())
+		return
+	}
+
+	recordAuditEvent(ctx, incident.StartedBy, AuditActionIncidentModeStarted, projectResource, projectId,
+		map[string]interface{}{"incident_id": incident.Id, "reason": request.Reason, "supervisor_id": supervisorId}, store)
+
+	respondJSON(w, incident, http.StatusCreated)
+}
+
+func apiEndIncidentModeHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	incident, err := store.GetActiveIncident(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting active incident", err.Error())
+		return
+	}
+
+	if incident == nil {
+		sendErrorResponse(w, http.StatusNotFound, "incident mode isn't on", "")
+		return
+	}
+
+	endedAt := time.Now()
+	endedBy := actorFromContext(ctx)
+	if err := store.EndIncident(ctx, incident.Id, endedBy, endedAt); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error ending incident", err.Error())
+		return
+	}
+	incident.EndedAt = &endedAt
+	incident.EndedBy = &endedBy
+
+	recordAuditEvent(ctx, endedBy, AuditActionIncidentModeEnded, projectResource, projectId,
+		map[string]interface{}{"incident_id": incident.Id, "started_at": incident.StartedAt}, store)
+
+	respondJSON(w, incident, http.StatusOK)
+}
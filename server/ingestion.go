@@ -0,0 +1,292 @@
+package asteroid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultIngestionHookTimeout = time.Second
+	maxIngestionHookTimeout     = 5 * time.Second
+
+	// maxIngestionHookResponseBytes bounds what a hook can send back, payloads are held in memory
+	maxIngestionHookResponseBytes = 32 << 20
+)
+
+// ErrIngestionHookFailed is returned when a hook that doesn't fail open couldn't transform a payload
+var ErrIngestionHookFailed = errors.New("ingestion hook failed")
+
+var ingestionHookClient = &http.Client{
+	// A hook is called at the URL it was registered with, never one it redirects to
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// ingestionHookTimeout returns how long a hook has to respond
+func ingestionHookTimeout(hook IngestionHook) time.Duration {
+	if hook.TimeoutMs != nil {
+		return time.Duration(*hook.TimeoutMs) * time.Millisecond
+	}
+	return defaultIngestionHookTimeout
+}
+
+// validateIngestionHooks checks that hooks have unique names, absolute URLs, known payload types and bounded timeouts
+func validateIngestionHooks(hooks []IngestionHook) error {
+	names := make(map[string]bool)
+	for _, hook := range hooks {
+		if hook.Name == "" {
+			return fmt.Errorf("ingestion hooks need a name")
+		}
+		if names[hook.Name] {
+			return fmt.Errorf("duplicate ingestion hook %s", hook.Name)
+		}
+		names[hook.Name] = true
+
+		u, err := url.Parse(hook.Url)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("url of ingestion hook %s must be an absolute http or https URL", hook.Name)
+		}
+
+		if len(hook.PayloadTypes) == 0 {
+			return fmt.Errorf("ingestion hook %s needs at least one payload type", hook.Name)
+		}
+		for _, payloadType := range hook.PayloadTypes {
+			switch payloadType {
+			case Chat, ToolDefinition:
+			default:
+				return fmt.Errorf("unknown payload type: %s", payloadType)
+			}
+		}
+
+		if hook.TimeoutMs != nil && (*hook.TimeoutMs <= 0 || ingestionHookTimeout(hook) > maxIngestionHookTimeout) {
+			return fmt.Errorf("timeout_ms of ingestion hook %s must be between 1 and %d", hook.Name, maxIngestionHookTimeout.Milliseconds())
+		}
+	}
+
+	return nil
+}
+
+// getIngestionHooks returns the hooks of a project that transform a type of payload, in the order they run
+func getIngestionHooks(ctx context.Context, project *Project, payloadType IngestionPayloadType, store Store) ([]IngestionHook, error) {
+	if project == nil {
+		return nil, nil
+	}
+
+	hooks, err := store.GetIngestionHooks(ctx, project.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ingestion hooks: %w", err)
+	}
+
+	return slices.DeleteFunc(hooks, func(hook IngestionHook) bool {
+		return !slices.Contains(hook.PayloadTypes, payloadType)
+	}), nil
+}
+
+// callIngestionHook posts a payload to a hook and returns the payload it responds with
+func callIngestionHook(ctx context.Context, hook IngestionHook, request IngestionHookRequest) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, ingestionHookTimeout(hook))
+	defer cancel()
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling hook request: %w", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.Url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating hook request: %w", err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	resp, err := ingestionHookClient.Do(httpRequest)
+	if err != nil {
+		return nil, fmt.Errorf("error calling hook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return request.Payload, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("hook responded with status %d", resp.StatusCode)
+	}
+
+	var response IngestionHookResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxIngestionHookResponseBytes)).Decode(&response); err != nil {
+		return nil, fmt.Errorf("error decoding hook response: %w", err)
+	}
+	if response.Payload == nil {
+		return nil, fmt.Errorf("hook responded without a payload")
+	}
+
+	return response.Payload, nil
+}
+
+// runIngestionHooks passes a payload through each hook in turn. Hooks that fail open are skipped
+// when they fail, any other failing hook stops the payload from being stored.
+func runIngestionHooks(ctx context.Context, hooks []IngestionHook, project Project, runId uuid.UUID, payloadType IngestionPayloadType, payload map[string]interface{}) (map[string]interface{}, error) {
+	for _, hook := range hooks {
+		transformed, err := callIngestionHook(ctx, hook, IngestionHookRequest{
+			Hook:        hook.Name,
+			ProjectId:   project.Id,
+			RunId:       runId,
+			PayloadType: payloadType,
+			Payload:     payload,
+		})
+		if err != nil {
+			if hook.FailOpen != nil && *hook.FailOpen {
+				log.Printf("Ingestion hook %s of project %s failed, storing %s untransformed: %v", hook.Name, project.Id, payloadType, err)
+				continue
+			}
+			return nil, fmt.Errorf("%w: %s: %v", ErrIngestionHookFailed, hook.Name, err)
+		}
+		payload = transformed
+	}
+
+	return payload, nil
+}
+
+// transformChatPayload runs a project's chat hooks over a chat's request and response before they're stored
+func transformChatPayload(ctx context.Context, project *Project, runId uuid.UUID, request []byte, response []byte, store Store) ([]byte, []byte, error) {
+	hooks, err := getIngestionHooks(ctx, project, Chat, store)
+	if err != nil || len(hooks) == 0 {
+		return request, response, err
+	}
+
+	payload := map[string]interface{}{}
+	var requestData, responseData map[string]interface{}
+	if err := json.Unmarshal(request, &requestData); err != nil {
+		return nil, nil, fmt.Errorf("error unmarshalling chat request: %w", err)
+	}
+	if err := json.Unmarshal(response, &responseData); err != nil {
+		return nil, nil, fmt.Errorf("error unmarshalling chat response: %w", err)
+	}
+	payload["request"] = requestData
+	payload["response"] = responseData
+
+	payload, err = runIngestionHooks(ctx, hooks, *project, runId, Chat, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Hooks have to leave a chat a chat, with an object for each of the request and response
+	for _, key := range []string{"request", "response"} {
+		if _, ok := payload[key].(map[string]interface{}); !ok {
+			return nil, nil, fmt.Errorf("%w: transformed chat has no %s object", ErrIngestionHookFailed, key)
+		}
+	}
+
+	if request, err = json.Marshal(payload["request"]); err != nil {
+		return nil, nil, fmt.Errorf("error marshalling chat request: %w", err)
+	}
+	if response, err = json.Marshal(payload["response"]); err != nil {
+		return nil, nil, fmt.Errorf("error marshalling chat response: %w", err)
+	}
+
+	return request, response, nil
+}
+
+// transformToolPayload runs a project's tool definition hooks over a tool before it's registered
+func transformToolPayload(ctx context.Context, project *Project, runId uuid.UUID, body []byte, store Store) ([]byte, error) {
+	hooks, err := getIngestionHooks(ctx, project, ToolDefinition, store)
+	if err != nil || len(hooks) == 0 {
+		return body, err
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("error unmarshalling tool: %w", err)
+	}
+
+	payload, err = runIngestionHooks(ctx, hooks, *project, runId, ToolDefinition, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err = json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling tool: %w", err)
+	}
+
+	return body, nil
+}
+
+// sendIngestionHookError refuses a payload a hook failed to transform, or reports the server's own error
+func sendIngestionHookError(w http.ResponseWriter, err error) {
+	sendAPIError(w, ingestionHookError(err))
+}
+
+func ingestionHookError(err error) error {
+	if errors.Is(err, ErrIngestionHookFailed) {
+		return &apiError{status: http.StatusBadGateway, message: "ingestion hook failed", details: err.Error()}
+	}
+	return &apiError{status: http.StatusInternalServerError, message: "error running ingestion hooks", details: err.Error()}
+}
+
+func apiGetProjectIngestionHooksHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	hooks, err := store.GetIngestionHooks(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting ingestion hooks", err.Error())
+		return
+	}
+
+	respondJSON(w, hooks, http.StatusOK)
+}
+
+func apiSetProjectIngestionHooksHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var hooks []IngestionHook
+	if err := json.NewDecoder(r.Body).Decode(&hooks); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateIngestionHooks(hooks); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid ingestion hook", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := store.SetIngestionHooks(ctx, projectId, hooks); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting ingestion hooks", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
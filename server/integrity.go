@@ -0,0 +1,94 @@
+package asteroid
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StoredContent is a stored payload with the hash it was stored with, nil if it was stored before
+// payloads were hashed
+type StoredContent struct {
+	Kind StoredContentKind
+	Id   uuid.UUID
+	Data []byte
+	Hash *string
+}
+
+// ContentHash returns the hex SHA-256 of a JSON payload in canonical form. The payload is decoded and
+// encoded again first, since the database doesn't keep the formatting or key order it was stored with.
+func ContentHash(data []byte) (string, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return "", fmt.Errorf("error decoding content: %w", err)
+	}
+
+	canonical, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("error encoding content: %w", err)
+	}
+
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// verifyContent re-hashes stored payloads and reports those whose hash doesn't match
+func verifyContent(runId uuid.UUID, contents []StoredContent) IntegrityReport {
+	report := IntegrityReport{
+		RunId:      runId,
+		Mismatches: make([]IntegrityMismatch, 0),
+		CheckedAt:  time.Now(),
+	}
+
+	for _, content := range contents {
+		if content.Hash == nil {
+			report.Unhashed++
+			continue
+		}
+
+		report.Checked++
+		computed, err := ContentHash(content.Data)
+		if err != nil {
+			// Content that isn't JSON anymore has changed too
+			computed = ""
+		}
+		if computed != *content.Hash {
+			report.Mismatches = append(report.Mismatches, IntegrityMismatch{
+				Kind:         content.Kind,
+				Id:           content.Id,
+				StoredHash:   *content.Hash,
+				ComputedHash: computed,
+			})
+		}
+	}
+
+	return report
+}
+
+func apiVerifyRunIntegrityHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	contents, err := store.GetRunStoredContent(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting stored content", err.Error())
+		return
+	}
+
+	respondJSON(w, verifyContent(runId, contents), http.StatusOK)
+}
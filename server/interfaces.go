@@ -2,20 +2,73 @@ package asteroid
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-// Store defines the interface for all storage operations
+// Store defines the interface for all storage operations. The server only talks to storage through
+// it, so a backend implements every embedded interface and is picked in the db package. Beyond the
+// signatures, implementations keep these conventions:
+//
+//   - Getting a single record that doesn't exist returns nil without an error, and getting a list
+//     of records returns an empty list rather than nil
+//   - Methods documented to report whether they did something, like AnswerClarification, check and
+//     write atomically, so concurrent callers can't both succeed
+//   - CreateSupervisionResult returns ErrSupervisionRequestResolved when the request has a result
+//   - CreateAuditEvent appends events to the audit log's hash chain one at a time, setting their
+//     sequence, previous hash and hash with AuditEventHash
+//   - Chat requests, responses and messages are stored with the ContentHash of their payloads
 type Store interface {
+	ApiKeyStore
+	AuditStore
+	AgentStore
+	ClarificationStore
+	ConsentStore
+	ChangeRequestStore
+	HandoffStore
+	IncidentStore
+	IngestionHookStore
+	KillSwitchStore
+	MeteringStore
+	OrganizationStore
 	ProjectStore
+	QuotaStore
+	ResourceStore
+	ReviewerStore
 	RunStore
+	RunDocumentStore
+	RunArtifactStore
+	WebhookStore
+	TranscriptStore
+	RunPauseStore
+	BackfillStore
+	RunEventStore
+	TimerStore
+	PlanStore
+	IntegrityStore
+	ChatSupervisorStore
+	ToolCallResultStore
+	RedactionStore
+	WorkerLeaseStore
+	FeatureFlagStore
+	DiagnosticsStore
+	ArchiveStore
+	WatchStore
+	SearchStore
+	AlertStore
 	ToolStore
 	ToolRequestStore
 	SupervisorStore
 	SupervisionStore
 	TaskStore
 	ChatStore
+	ProxyStore
+}
+
+type DiagnosticsStore interface {
+	// Ping makes a round trip to the database
+	Ping(ctx context.Context) error
 }
 
 type SupervisionStore interface {
@@ -23,28 +76,41 @@ type SupervisionStore interface {
 	CreateSupervisionRequest(ctx context.Context, request SupervisionRequest, chainId uuid.UUID, toolCallId uuid.UUID) (*uuid.UUID, error)
 	GetSupervisionRequest(ctx context.Context, id uuid.UUID) (*SupervisionRequest, error)
 	GetSupervisionRequestsForStatus(ctx context.Context, status Status) ([]SupervisionRequest, error)
+	// ImportSupervisionRequest stores a supervision request of an imported run with its statuses and
+	// result in one go, so it's never pending meanwhile
+	ImportSupervisionRequest(ctx context.Context, request SupervisionRequest, chainId uuid.UUID, toolCallId uuid.UUID, statuses []SupervisionStatus, result *SupervisionResult) (uuid.UUID, error)
 
-	// Results
+	// Results. Creating a result for a request that already has one returns ErrSupervisionRequestResolved
 	GetSupervisionResultFromRequestID(ctx context.Context, requestId uuid.UUID) (*SupervisionResult, error)
 	CreateSupervisionResult(ctx context.Context, result SupervisionResult, requestId uuid.UUID) (*uuid.UUID, error)
+	// CreateSupervisionResults stores the results of all the requests of their SupervisionRequestId or none
+	CreateSupervisionResults(ctx context.Context, results []SupervisionResult) ([]uuid.UUID, error)
 
 	// Statuses
 	CreateSupervisionStatus(ctx context.Context, requestID uuid.UUID, status SupervisionStatus) error
+	GetSupervisionStatusesForRequest(ctx context.Context, requestId uuid.UUID) ([]SupervisionStatus, error)
 
 	// Util
 	CountSupervisionRequests(ctx context.Context, status Status) (int, error)
+	// GetSupervisionQueueStats counts the pending and assigned requests of each priority class that has any
+	GetSupervisionQueueStats(ctx context.Context) ([]PriorityQueueStats, error)
 
 	// GetSupervisionRequests(ctx context.Context) ([]SupervisionRequest, error)
-	// GetSupervisionStatusesForRequest(ctx context.Context, requestId uuid.UUID) ([]SupervisionStatus, error)
 
 	GetChainExecutionSupervisionRequests(ctx context.Context, chainExecutionId uuid.UUID) ([]SupervisionRequest, error)
 	GetSupervisionRequestStatus(ctx context.Context, requestId uuid.UUID) (*SupervisionStatus, error)
 
 	GetExecutionFromChainId(ctx context.Context, chainId uuid.UUID) (*uuid.UUID, error)
 	GetChainExecution(ctx context.Context, executionId uuid.UUID) (*uuid.UUID, *uuid.UUID, error)
+	// GetChainExecutionChain returns the chain of an execution at the version the execution started with
+	GetChainExecutionChain(ctx context.Context, executionId uuid.UUID) (*SupervisorChain, error)
 	GetChainExecutionFromChainAndToolCall(ctx context.Context, chainId uuid.UUID, toolCallId uuid.UUID) (*uuid.UUID, error)
 	GetChainExecutionsFromToolCall(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
 	GetChainExecutionState(ctx context.Context, executionId uuid.UUID) (*ChainExecutionState, error)
+
+	// Ensemble verdicts
+	CreateEnsembleVerdicts(ctx context.Context, verdicts []EnsembleVerdict) error
+	GetEnsembleVerdicts(ctx context.Context, supervisionRequestId uuid.UUID) ([]EnsembleVerdict, error)
 }
 
 type TaskStore interface {
@@ -53,11 +119,214 @@ type TaskStore interface {
 	GetProjectTasks(ctx context.Context, projectId uuid.UUID) ([]Task, error)
 }
 
+type ApiKeyStore interface {
+	CreateApiKey(ctx context.Context, key ApiKey, hash string) error
+	GetApiKey(ctx context.Context, id uuid.UUID) (*ApiKey, error)
+	GetApiKeyFromHash(ctx context.Context, hash string) (*ApiKey, error)
+	GetApiKeys(ctx context.Context) ([]ApiKey, error)
+	RevokeApiKey(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+	// RotateApiKey creates the key an unrevoked key that wasn't rotated yet is replaced by, making the
+	// old key expire at expiresAt unless it expires sooner. Reports false if the old key can't be rotated.
+	RotateApiKey(ctx context.Context, id uuid.UUID, next ApiKey, hash string, expiresAt time.Time) (bool, error)
+	UpdateApiKeyLastUsed(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+}
+
+type AuditStore interface {
+	CreateAuditEvent(ctx context.Context, event AuditEvent) error
+	GetAuditEvents(ctx context.Context, resourceType string, resourceId uuid.UUID) ([]AuditEvent, error)
+	GetAuditChain(ctx context.Context, afterSequence int64, limit int) ([]AuditEvent, error)
+	GetAuditChainHead(ctx context.Context) (*AuditChainHead, error)
+	CreateAuditAnchor(ctx context.Context, anchor AuditAnchor) error
+	GetAuditAnchors(ctx context.Context) ([]AuditAnchor, error)
+	GetLatestAuditAnchor(ctx context.Context) (*AuditAnchor, error)
+}
+
+type HandoffStore interface {
+	CreateHandoffBundle(ctx context.Context, bundle HandoffBundle) error
+	GetHandoffBundle(ctx context.Context, id uuid.UUID) (*HandoffBundle, error)
+	GetHandoffBundleFromName(ctx context.Context, name string) (*HandoffBundle, error)
+	GetHandoffBundles(ctx context.Context) ([]HandoffBundle, error)
+	SetHandoffBundleRestored(ctx context.Context, id uuid.UUID, session string, restoredAt time.Time) error
+}
+
+type AgentStore interface {
+	CreateAgent(ctx context.Context, agent Agent) error
+	GetAgent(ctx context.Context, id uuid.UUID) (*Agent, error)
+	GetAgentFromNameAndVersion(ctx context.Context, projectId uuid.UUID, name string, version string) (*Agent, error)
+	GetProjectAgents(ctx context.Context, projectId uuid.UUID) ([]Agent, error)
+
+	// Trust
+	GetAgentTrust(ctx context.Context, agentId uuid.UUID) ([]AgentToolTrust, error)
+	GetAgentToolTrust(ctx context.Context, agentId uuid.UUID, toolName string) (*AgentToolTrust, error)
+	// RecordAgentToolDecision counts an approval or rejection of an agent's call to a tool. Returns
+	// the updated trust and how many approvals in a row the agent had with the tool before.
+	RecordAgentToolDecision(ctx context.Context, agentId uuid.UUID, toolName string, approved bool, decidedAt time.Time) (*AgentToolTrust, int, error)
+}
+
+type ClarificationStore interface {
+	// CreateClarification asks the agent a question and marks the supervision request as awaiting its
+	// answer, unless a question about it is still open, and reports whether it did
+	CreateClarification(ctx context.Context, clarification Clarification) (bool, error)
+	GetClarification(ctx context.Context, id uuid.UUID) (*Clarification, error)
+	GetClarifications(ctx context.Context, supervisionRequestId uuid.UUID) ([]Clarification, error)
+	// AnswerClarification records an answer if the question wasn't answered yet, and reports whether it was
+	AnswerClarification(ctx context.Context, id uuid.UUID, answer string, answeredAt time.Time) (bool, error)
+}
+
+type ConsentStore interface {
+	CreateConsentRequest(ctx context.Context, consent ConsentRequest) error
+	GetConsentRequest(ctx context.Context, supervisionRequestId uuid.UUID) (*ConsentRequest, error)
+	GetConsentRequestFromToken(ctx context.Context, token string) (*ConsentRequest, error)
+	// GetExpiredConsentRequests returns the consent requests still awaiting a response after they expired
+	GetExpiredConsentRequests(ctx context.Context, now time.Time) ([]ConsentRequest, error)
+	// SetConsentResponse records a response if the consent request is still awaiting one, and reports whether it was
+	SetConsentResponse(ctx context.Context, id uuid.UUID, status ConsentStatus, comment *string, respondedAt time.Time) (bool, error)
+}
+
+// ChangeRequestStore keeps the ServiceNow change requests opened for supervision requests
+type ChangeRequestStore interface {
+	CreateChangeRequest(ctx context.Context, changeRequest ChangeRequest) error
+	GetChangeRequest(ctx context.Context, supervisionRequestId uuid.UUID) (*ChangeRequest, error)
+	// GetOpenChangeRequests returns up to limit change requests that weren't closed, least recently
+	// checked first
+	GetOpenChangeRequests(ctx context.Context, limit int) ([]ChangeRequest, error)
+	// UpdateChangeRequest stores what ServiceNow last reported of a change request, and when it was
+	// checked and closed
+	UpdateChangeRequest(ctx context.Context, changeRequest ChangeRequest) error
+}
+
+type IncidentStore interface {
+	CreateIncident(ctx context.Context, incident IncidentMode) error
+	GetActiveIncident(ctx context.Context, projectId uuid.UUID) (*IncidentMode, error)
+	GetProjectIncidents(ctx context.Context, projectId uuid.UUID) ([]IncidentMode, error)
+	EndIncident(ctx context.Context, id uuid.UUID, endedBy string, endedAt time.Time) error
+}
+
+type IngestionHookStore interface {
+	GetIngestionHooks(ctx context.Context, projectId uuid.UUID) ([]IngestionHook, error)
+	SetIngestionHooks(ctx context.Context, projectId uuid.UUID, hooks []IngestionHook) error
+}
+
+type ChatSupervisorStore interface {
+	GetChatSupervisors(ctx context.Context, projectId uuid.UUID) ([]ChatSupervisor, error)
+	SetChatSupervisors(ctx context.Context, projectId uuid.UUID, supervisors []ChatSupervisor) error
+}
+
+// ToolCallResultStore keeps the results agents report for executed tool calls, with their verdicts,
+// and the result supervisors of projects that decide them
+type ToolCallResultStore interface {
+	GetResultSupervisors(ctx context.Context, projectId uuid.UUID) ([]ResultSupervisor, error)
+	SetResultSupervisors(ctx context.Context, projectId uuid.UUID, supervisors []ResultSupervisor) error
+
+	// CreateToolCallResult stores a tool call's result, reporting false if one was already stored
+	CreateToolCallResult(ctx context.Context, result ToolCallResult) (bool, error)
+	GetToolCallResult(ctx context.Context, toolCallId uuid.UUID) (*ToolCallResult, error)
+	// DecideToolCallResult stores a reviewer's verdict on a held result, reporting false if the
+	// result isn't held
+	DecideToolCallResult(ctx context.Context, result ToolCallResult) (bool, error)
+	// GetProjectToolCallResults returns the results reported in a project, newest first, optionally
+	// only those with a status
+	GetProjectToolCallResults(ctx context.Context, projectId uuid.UUID, status *ToolCallResultStatus) ([]ToolCallResult, error)
+	// GetWithheldToolCallResults returns the quarantined and held results of a run's tool calls, by
+	// the call ID the model gave each tool call
+	GetWithheldToolCallResults(ctx context.Context, runId uuid.UUID) (map[string]ToolCallResult, error)
+}
+
+// RedactionStore keeps the redactors of projects and what they redacted from stored chats
+type RedactionStore interface {
+	GetRedactors(ctx context.Context, projectId uuid.UUID) ([]Redactor, error)
+	SetRedactors(ctx context.Context, projectId uuid.UUID, redactors []Redactor) error
+
+	CreateRedactions(ctx context.Context, redactions []Redaction) error
+	// GetRunRedactions returns what was redacted from a run's chats, oldest first
+	GetRunRedactions(ctx context.Context, runId uuid.UUID) ([]Redaction, error)
+}
+
+// WorkerLeaseStore keeps the leases that decide which replica runs each background worker
+type WorkerLeaseStore interface {
+	// AcquireWorkerLease takes or renews a worker's lease for a holder for a duration, by the
+	// database's clock, returning nil if another holder's lease hasn't expired
+	AcquireWorkerLease(ctx context.Context, worker string, holder string, duration time.Duration) (*WorkerLease, error)
+	GetWorkerLeases(ctx context.Context) ([]WorkerLease, error)
+}
+
+// FeatureFlagStore keeps how far each feature flag is rolled out
+type FeatureFlagStore interface {
+	// GetFeatureFlagRollout returns nil if the flag isn't rolled out
+	GetFeatureFlagRollout(ctx context.Context, flag string) (*FeatureFlagRollout, error)
+	GetFeatureFlagRollouts(ctx context.Context) ([]FeatureFlagRollout, error)
+	SetFeatureFlagRollout(ctx context.Context, rollout FeatureFlagRollout) error
+	DeleteFeatureFlagRollout(ctx context.Context, flag string) error
+}
+
+type KillSwitchStore interface {
+	GetKillSwitch(ctx context.Context, organizationId uuid.UUID) (*KillSwitch, error)
+	SetKillSwitch(ctx context.Context, killSwitch KillSwitch) error
+
+	// Requests waiting for a second person to confirm them
+	CreateKillSwitchRequest(ctx context.Context, request KillSwitchRequest) error
+	GetKillSwitchRequest(ctx context.Context, id uuid.UUID) (*KillSwitchRequest, error)
+	GetPendingKillSwitchRequest(ctx context.Context, organizationId uuid.UUID, now time.Time) (*KillSwitchRequest, error)
+	// ConfirmKillSwitchRequest returns false if the request was already confirmed
+	ConfirmKillSwitchRequest(ctx context.Context, id uuid.UUID, confirmedBy string, confirmedAt time.Time) (bool, error)
+
+	// SetOrganizationRunsStatus moves every run in the organization with one status to another,
+	// returning the runs it changed
+	SetOrganizationRunsStatus(ctx context.Context, organizationId uuid.UUID, from Status, to Status) ([]uuid.UUID, error)
+}
+
+type MeteringStore interface {
+	CreateMeteringEvent(ctx context.Context, event MeteringEvent) error
+	GetMeteringEvents(ctx context.Context, after int64, limit int, organizationId *uuid.UUID, projectId *uuid.UUID) ([]MeteringEvent, error)
+}
+
+type OrganizationStore interface {
+	CreateOrganization(ctx context.Context, organization Organization) error
+	GetOrganization(ctx context.Context, id uuid.UUID) (*Organization, error)
+	GetOrganizationFromName(ctx context.Context, name string) (*Organization, error)
+	GetOrganizations(ctx context.Context) ([]Organization, error)
+
+	// Tool policies inherited by every project in the organization
+	GetOrganizationToolPolicies(ctx context.Context, organizationId uuid.UUID) ([]ToolPolicy, error)
+	SetOrganizationToolPolicies(ctx context.Context, organizationId uuid.UUID, policies []ToolPolicy) error
+}
+
 type ProjectStore interface {
 	CreateProject(ctx context.Context, project Project) error
 	GetProject(ctx context.Context, id uuid.UUID) (*Project, error)
 	GetProjectFromName(ctx context.Context, name string) (*Project, error)
 	GetProjects(ctx context.Context) ([]Project, error)
+	SetProjectOrganization(ctx context.Context, projectId uuid.UUID, organizationId *uuid.UUID) error
+
+	// Tool policies
+	GetProjectToolPolicies(ctx context.Context, projectId uuid.UUID) ([]ToolPolicy, error)
+	SetProjectToolPolicies(ctx context.Context, projectId uuid.UUID, policies []ToolPolicy) error
+
+	// Argument rules, in the order they're tried
+	GetProjectArgumentRules(ctx context.Context, projectId uuid.UUID) ([]ArgumentRule, error)
+	SetProjectArgumentRules(ctx context.Context, projectId uuid.UUID, rules []ArgumentRule) error
+
+	// Argument baselines, at most one per tool name
+	GetProjectArgumentBaselines(ctx context.Context, projectId uuid.UUID) ([]ArgumentBaseline, error)
+	SetProjectArgumentBaselines(ctx context.Context, projectId uuid.UUID, baselines []ArgumentBaseline) error
+	// GetArgumentBaseline returns the baseline of a tool in a project, or nil if it has none
+	GetArgumentBaseline(ctx context.Context, projectId uuid.UUID, toolName string) (*ArgumentBaseline, error)
+
+	// Notifications
+	GetNotificationSettings(ctx context.Context, projectId uuid.UUID) (*NotificationSettings, error)
+	SetNotificationSettings(ctx context.Context, projectId uuid.UUID, settings NotificationSettings) error
+
+	// Verdicts
+	GetProjectVerdicts(ctx context.Context, projectId uuid.UUID) ([]CustomVerdict, error)
+	SetProjectVerdicts(ctx context.Context, projectId uuid.UUID, verdicts []CustomVerdict) error
+
+	// Routing rules
+	GetRoutingRules(ctx context.Context, projectId uuid.UUID) ([]RoutingRule, error)
+	SetRoutingRules(ctx context.Context, projectId uuid.UUID, rules []RoutingRule) error
+
+	// Trust policy
+	GetTrustPolicy(ctx context.Context, projectId uuid.UUID) (*TrustPolicy, error)
+	SetTrustPolicy(ctx context.Context, projectId uuid.UUID, policy TrustPolicy) error
 }
 
 type ToolRequestStore interface {
@@ -67,10 +336,19 @@ type ToolRequestStore interface {
 	// CreateToolCall(ctx context.Context, toolCallId uuid.UUID, request ToolRequest) (*uuid.UUID, error)
 	GetToolCall(ctx context.Context, id uuid.UUID) (*AsteroidToolCall, error)
 	GetToolCallFromCallId(ctx context.Context, id string) (*AsteroidToolCall, error)
+	// GetProjectToolCalls returns up to limit of a project's tool calls made in [from, to), oldest first
+	GetProjectToolCalls(ctx context.Context, projectId uuid.UUID, from time.Time, to time.Time, limit int) ([]AsteroidToolCall, error)
+	CountProjectToolCalls(ctx context.Context, projectId uuid.UUID, from time.Time, to time.Time) (int, error)
+	GetRunToolCalls(ctx context.Context, runId uuid.UUID) ([]AsteroidToolCall, error)
+
+	// Dependencies
+	SetToolCallDependencies(ctx context.Context, toolCallId uuid.UUID, dependsOn []uuid.UUID) error
+	GetToolCallDependencies(ctx context.Context, toolCallId uuid.UUID) ([]uuid.UUID, error)
+	GetToolCallDependents(ctx context.Context, toolCallId uuid.UUID) ([]uuid.UUID, error)
 }
 
 type ToolStore interface {
-	CreateTool(ctx context.Context, runId uuid.UUID, attributes map[string]interface{}, name string, description string, ignoredAttributes []string, code string) (*Tool, error)
+	CreateTool(ctx context.Context, runId uuid.UUID, attributes map[string]interface{}, name string, description string, ignoredAttributes []string, code string, parameters *map[string]interface{}) (*Tool, error)
 	GetTool(ctx context.Context, id uuid.UUID) (*Tool, error)
 	// GetToolFromValues(ctx context.Context, attributes map[string]interface{}, name string, description string, ignoredAttributes []string) (*Tool, error)
 	GetRunTools(ctx context.Context, id uuid.UUID) ([]Tool, error)
@@ -84,8 +362,69 @@ type SupervisorStore interface {
 	GetSupervisorFromValues(ctx context.Context, code string, name string, desc string, t SupervisorType, attributes map[string]interface{}) (*Supervisor, error)
 	GetSupervisors(ctx context.Context, projectId uuid.UUID) ([]Supervisor, error)
 	CreateSupervisorChain(ctx context.Context, toolId uuid.UUID, chain ChainRequest) (*uuid.UUID, error)
+	// CreateChain creates a chain that isn't linked to a tool
+	CreateChain(ctx context.Context, chain ChainRequest) (*uuid.UUID, error)
 	GetSupervisorChains(ctx context.Context, toolId uuid.UUID) ([]SupervisorChain, error)
+	// GetSupervisorChain returns a chain at its current version, the one new tool calls are supervised by
 	GetSupervisorChain(ctx context.Context, id uuid.UUID) (*SupervisorChain, error)
+	// GetSupervisorChainVersion returns a chain at an earlier or its current version, or nil if it
+	// hasn't reached the version
+	GetSupervisorChainVersion(ctx context.Context, id uuid.UUID, version int) (*SupervisorChain, error)
+	// CreateSupervisorChainVersion makes a version of a chain with the supervisors in order, and makes
+	// it the chain's current version. Returns ErrChainVersionConflict unless the current version is the
+	// one before it.
+	CreateSupervisorChainVersion(ctx context.Context, chainId uuid.UUID, version int, supervisorIds []uuid.UUID, supervisorTimeouts []SupervisorTimeout, supervisorTiers []SupervisorTier) error
+	// GetConfidenceOutcomes pairs the confident results of a supervisor with the final decision of
+	// the humans later in the same chain
+	GetConfidenceOutcomes(ctx context.Context, supervisorId uuid.UUID) ([]ConfidenceOutcome, error)
+	// GetPromptVariantOutcomes pairs the results an ensemble supervisor gave with a prompt variant
+	// with the final decision of the humans later in the same chain, if they made one
+	GetPromptVariantOutcomes(ctx context.Context, supervisorId uuid.UUID) ([]PromptVariantOutcome, error)
+	// GetSupervisorToolDecisions counts the decisions a supervisor made on calls of tools with a name
+	GetSupervisorToolDecisions(ctx context.Context, supervisorId uuid.UUID, toolName string) (map[Decision]int, error)
+	// GetToolDecisionPrecedents returns the latest decisions human supervisors made on calls of tools
+	// with a name in a project, newest first, without refs or similarities
+	GetToolDecisionPrecedents(ctx context.Context, projectId uuid.UUID, toolName string, limit int) ([]FeedbackPrecedent, error)
+	// GetPrecedentDecisions returns the decisions of a project with the given results, oldest first.
+	// Results of other projects are left out.
+	GetPrecedentDecisions(ctx context.Context, projectId uuid.UUID, resultIds []uuid.UUID) ([]PrecedentDecision, error)
+	// GetCitingDecisions returns the decisions of a project that cite precedents, oldest first
+	GetCitingDecisions(ctx context.Context, projectId uuid.UUID) ([]PrecedentDecision, error)
+	GetSupervisorTestCases(ctx context.Context, supervisorId uuid.UUID) ([]SupervisorTestCase, error)
+	// SetSupervisorTestCases replaces the test cases of a supervisor, keeping their order
+	SetSupervisorTestCases(ctx context.Context, supervisorId uuid.UUID, cases []SupervisorTestCase) error
+}
+
+type QuotaStore interface {
+	GetQuotas(ctx context.Context, scope string, scopeId uuid.UUID) ([]Quota, error)
+	SetQuotas(ctx context.Context, scope string, scopeId uuid.UUID, quotas []Quota) error
+	GetQuotaUsage(ctx context.Context, scope string, scopeId uuid.UUID, metric QuotaMetric, since time.Time) (int64, error)
+	GetRunLimits(ctx context.Context, projectId uuid.UUID) (*RunLimits, error)
+	SetRunLimits(ctx context.Context, projectId uuid.UUID, limits RunLimits) error
+	GetRunLimitUsage(ctx context.Context, runId uuid.UUID, limit RunLimitKind, since time.Time) (int64, error)
+}
+
+type ResourceStore interface {
+	CreateResourceReferences(ctx context.Context, references []ResourceReference) error
+	GetToolCallResourceReferences(ctx context.Context, toolCallId uuid.UUID) ([]ResourceReference, error)
+	GetProjectResourceReferences(ctx context.Context, projectId uuid.UUID, identifier string, prefix bool, kind *ResourceKind, limit int) ([]ResourceReference, error)
+	GetResourceHistory(ctx context.Context, projectId uuid.UUID, kind ResourceKind, identifier string, excludeToolCallId uuid.UUID) (*BlastRadiusResource, error)
+}
+
+type ReviewerStore interface {
+	GetReviewers(ctx context.Context) ([]Reviewer, error)
+	SetReviewer(ctx context.Context, reviewer Reviewer) error
+	// CreateUser reports whether the user was created, which they aren't if another user has their email
+	CreateUser(ctx context.Context, user User) (bool, error)
+	GetUser(ctx context.Context, id uuid.UUID) (*User, error)
+	GetUsers(ctx context.Context) ([]User, error)
+	// ClaimReview claims a review unless another user holds it, returning the claim that holds it
+	ClaimReview(ctx context.Context, claim ReviewClaim) (*ReviewClaim, error)
+	GetReviewClaim(ctx context.Context, supervisionRequestId uuid.UUID) (*ReviewClaim, error)
+	// GetUserReviewClaims returns a user's claims, oldest first
+	GetUserReviewClaims(ctx context.Context, userId uuid.UUID) ([]ReviewClaim, error)
+	// ReleaseReview reports whether the user held the review's claim it released
+	ReleaseReview(ctx context.Context, supervisionRequestId uuid.UUID, userId uuid.UUID) (bool, error)
 }
 
 type RunStore interface {
@@ -93,8 +432,181 @@ type RunStore interface {
 	GetRun(ctx context.Context, id uuid.UUID) (*Run, error)
 	GetRuns(ctx context.Context, taskId uuid.UUID) ([]Run, error)
 	GetTaskRuns(ctx context.Context, taskId uuid.UUID) ([]Run, error)
+	// GetProjectRunsAfter returns up to limit of a project's runs created before until that come after
+	// the run with afterId created at afterCreatedAt, ordered by creation
+	GetProjectRunsAfter(ctx context.Context, projectId uuid.UUID, afterCreatedAt time.Time, afterId uuid.UUID, until time.Time, limit int) ([]Run, error)
 	UpdateRunStatus(ctx context.Context, runId uuid.UUID, status Status) error
 	UpdateRunResult(ctx context.Context, runId uuid.UUID, result string) error
+	UpdateRunAutonomy(ctx context.Context, runId uuid.UUID, level AutonomyLevel) error
+}
+
+// BackfillStore keeps backfills and what their shadow chains would have decided
+type BackfillStore interface {
+	CreateBackfill(ctx context.Context, backfill Backfill) error
+	GetBackfill(ctx context.Context, id uuid.UUID) (*Backfill, error)
+	// GetProjectBackfills returns a project's backfills, newest first
+	GetProjectBackfills(ctx context.Context, projectId uuid.UUID) ([]Backfill, error)
+	GetBackfillsForStatus(ctx context.Context, status BackfillStatus) ([]Backfill, error)
+	// SetBackfillStatus moves a backfill from one status to another, reporting false if it didn't have the first
+	SetBackfillStatus(ctx context.Context, id uuid.UUID, from BackfillStatus, to BackfillStatus) (bool, error)
+	// GetUnevaluatedBackfillToolCalls returns up to limit of the tool calls in a backfill's range it
+	// hasn't evaluated yet, oldest first
+	GetUnevaluatedBackfillToolCalls(ctx context.Context, backfill Backfill, limit int) ([]AsteroidToolCall, error)
+	// CreateBackfillResult stores what a backfill's shadow chains would have decided for a tool call
+	// and counts it as evaluated, unless it already was
+	CreateBackfillResult(ctx context.Context, backfillId uuid.UUID, result BackfillResult) error
+	GetBackfillResults(ctx context.Context, backfillId uuid.UUID) ([]BackfillResult, error)
+}
+
+// RunPauseStore keeps the runs operators paused. Pausing a run that's already paused and resuming
+// one that isn't report false.
+type RunPauseStore interface {
+	PauseRun(ctx context.Context, pause RunPause) (bool, error)
+	GetRunPause(ctx context.Context, runId uuid.UUID) (*RunPause, error)
+	// ResumeRun gives the run a status and postpones its unfired timers by as long as it was paused
+	ResumeRun(ctx context.Context, runId uuid.UUID, status Status, resumedAt time.Time) (bool, error)
+}
+
+// RunArtifactStore keeps what's known of the artifacts agents upload, their content is in the blob
+// store. Artifacts are listed oldest first.
+type RunArtifactStore interface {
+	CreateRunArtifact(ctx context.Context, artifact RunArtifact) error
+	GetRunArtifact(ctx context.Context, id uuid.UUID) (*RunArtifact, error)
+	GetRunArtifacts(ctx context.Context, runId uuid.UUID) ([]RunArtifact, error)
+	GetToolCallArtifacts(ctx context.Context, toolCallId uuid.UUID) ([]RunArtifact, error)
+}
+
+// TranscriptStore keeps the transcripts of voice agents' runs, oldest segment first
+type TranscriptStore interface {
+	CreateTranscriptSegment(ctx context.Context, segment TranscriptSegment) error
+	GetRunTranscript(ctx context.Context, runId uuid.UUID) ([]TranscriptSegment, error)
+	GetUtteranceSegments(ctx context.Context, runId uuid.UUID, utteranceId string) ([]TranscriptSegment, error)
+}
+
+type SearchStore interface {
+	// Search returns the messages, tool calls and decisions matching a web search query, best
+	// matches first, optionally only of a project or of a kind
+	Search(ctx context.Context, query string, projectId *uuid.UUID, kind *SearchHitKind, limit int) ([]SearchHit, error)
+}
+
+// WatchStore keeps what reviewer sessions watch and what they were notified of. Notifications are
+// listed in order of their sequence.
+type WatchStore interface {
+	CreateWatchSubscription(ctx context.Context, subscription WatchSubscription) error
+	GetWatchSubscription(ctx context.Context, id uuid.UUID) (*WatchSubscription, error)
+	// GetSessionWatchSubscriptions returns a session's subscriptions, oldest first
+	GetSessionWatchSubscriptions(ctx context.Context, session string) ([]WatchSubscription, error)
+	// DeleteWatchSubscription deletes a subscription with its notifications, reporting false if there
+	// was no such subscription
+	DeleteWatchSubscription(ctx context.Context, id uuid.UUID) (bool, error)
+	// GetMatchingWatchSubscriptions returns the subscriptions watching for an event that watch the run,
+	// its agent or, within its project, the tool
+	GetMatchingWatchSubscriptions(ctx context.Context, event WatchEvent, runId uuid.UUID, agentId *uuid.UUID, projectId *uuid.UUID, toolName *string) ([]WatchSubscription, error)
+
+	// CreateWatchNotifications stores notifications, setting their sequence
+	CreateWatchNotifications(ctx context.Context, notifications []WatchNotification) error
+	// GetWatchNotificationsAfter returns up to limit notifications of any session after a sequence
+	GetWatchNotificationsAfter(ctx context.Context, after int64, limit int) ([]WatchNotification, error)
+	GetSessionWatchNotifications(ctx context.Context, session string, after int64, limit int) ([]WatchNotification, error)
+	// GetLatestWatchNotificationSequence returns the sequence of the latest notification, or 0 if there
+	// are none
+	GetLatestWatchNotificationSequence(ctx context.Context) (int64, error)
+}
+
+// WebhookStore keeps the webhooks projects register and the deliveries of their events. Webhooks are
+// listed oldest first and deliveries newest first.
+type WebhookStore interface {
+	CreateWebhook(ctx context.Context, webhook Webhook, secret string) error
+	GetWebhook(ctx context.Context, id uuid.UUID) (*Webhook, error)
+	// GetWebhookSecret returns the secret a webhook's deliveries are signed with
+	GetWebhookSecret(ctx context.Context, id uuid.UUID) (string, error)
+	GetProjectWebhooks(ctx context.Context, projectId uuid.UUID) ([]Webhook, error)
+	// GetEventWebhooks returns the enabled webhooks of a project that are sent an event
+	GetEventWebhooks(ctx context.Context, projectId uuid.UUID, event WebhookEvent) ([]Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook Webhook) error
+	// DeleteWebhook deletes a webhook with its deliveries, reporting false if there was no such webhook
+	DeleteWebhook(ctx context.Context, id uuid.UUID) (bool, error)
+
+	CreateWebhookDeliveries(ctx context.Context, deliveries []WebhookDelivery) error
+	// ClaimDueWebhookDeliveries returns up to limit queued deliveries that are due, oldest first, and
+	// postpones them by lease so no other server attempts them meanwhile
+	ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]WebhookDelivery, error)
+	// UpdateWebhookDelivery stores the outcome of attempting a delivery
+	UpdateWebhookDelivery(ctx context.Context, delivery WebhookDelivery) error
+	GetWebhookDeliveries(ctx context.Context, webhookId uuid.UUID, limit int) ([]WebhookDelivery, error)
+	// GetWebhookDeliveryStats counts the deliveries created since a time of each webhook that has any,
+	// by status, leaving the failure rate to the caller
+	GetWebhookDeliveryStats(ctx context.Context, since time.Time) ([]WebhookDeliveryStats, error)
+
+	// GetProjectPayloadTemplates returns the templates a project's webhook deliveries and
+	// notifications are rendered with
+	GetProjectPayloadTemplates(ctx context.Context, projectId uuid.UUID) ([]PayloadTemplate, error)
+	SetProjectPayloadTemplates(ctx context.Context, projectId uuid.UUID, templates []PayloadTemplate) error
+}
+
+type RunDocumentStore interface {
+	CreateRunDocument(ctx context.Context, document RunDocument) error
+	GetRunDocument(ctx context.Context, id uuid.UUID) (*RunDocument, error)
+	GetRunDocuments(ctx context.Context, runId uuid.UUID) ([]RunDocument, error)
+}
+
+type RunEventStore interface {
+	CreateRunEvent(ctx context.Context, event RunEvent) error
+	GetRunEvents(ctx context.Context, runId uuid.UUID) ([]RunEvent, error)
+}
+
+// TimerStore keeps timers, which are due once their fire_at has passed and they haven't fired
+type TimerStore interface {
+	CreateTimer(ctx context.Context, timer DurableTimer) error
+	GetDueTimers(ctx context.Context, now time.Time) ([]DurableTimer, error)
+	MarkTimerFired(ctx context.Context, id uuid.UUID, firedAt time.Time) error
+	GetSupervisionRequestTimers(ctx context.Context, supervisionRequestId uuid.UUID) ([]DurableTimer, error)
+}
+
+// IntegrityStore reads back stored payloads with the hashes they were stored with
+type IntegrityStore interface {
+	GetRunStoredContent(ctx context.Context, runId uuid.UUID) ([]StoredContent, error)
+}
+
+// AlertStore keeps the alert rules of projects and the alerts they fired, and gets what rules are
+// evaluated on. CreateAlert reports whether the alert was created, false when its rule already fired
+// for its key.
+type AlertStore interface {
+	GetAlertRules(ctx context.Context, projectId uuid.UUID) ([]AlertRule, error)
+	SetAlertRules(ctx context.Context, projectId uuid.UUID, rules []AlertRule) error
+	CreateAlert(ctx context.Context, alert Alert) (bool, error)
+	GetAlerts(ctx context.Context, projectId uuid.UUID, limit int) ([]Alert, error)
+	// GetFirstToolUses gets the tools first called in a project since a time
+	GetFirstToolUses(ctx context.Context, projectId uuid.UUID, since time.Time) ([]ToolFirstUse, error)
+	GetDecisionCounts(ctx context.Context, projectId uuid.UUID, from time.Time, to time.Time) (map[Decision]int, error)
+}
+
+// ArchiveStore gets the records created in a time range for the daily archives, and keeps the archives
+// that were exported. Archives are listed oldest first and audit events in chain order.
+type ArchiveStore interface {
+	GetRunsCreatedBetween(ctx context.Context, from time.Time, to time.Time) ([]Run, error)
+	GetSupervisionResultsCreatedBetween(ctx context.Context, from time.Time, to time.Time) ([]SupervisionResult, error)
+	GetAuditEventsCreatedBetween(ctx context.Context, from time.Time, to time.Time) ([]AuditEvent, error)
+	CreateArchive(ctx context.Context, archive Archive) error
+	GetArchive(ctx context.Context, day string) (*Archive, error)
+	GetArchives(ctx context.Context) ([]Archive, error)
+}
+
+// PlanStore keeps the plans runs submitted, their steps in order, the tool calls the steps covered and
+// those that deviated from them
+type PlanStore interface {
+	CreatePlan(ctx context.Context, plan Plan) error
+	GetPlan(ctx context.Context, id uuid.UUID) (*Plan, error)
+	GetRunPlans(ctx context.Context, runId uuid.UUID) ([]Plan, error)
+	// DecidePlan records who decided a plan and the decision of each of its steps, and reports false
+	// if the plan was already decided
+	DecidePlan(ctx context.Context, plan Plan) (bool, error)
+	// BindPlanStep records the tool call a step covered, and reports false if it already covered one
+	BindPlanStep(ctx context.Context, planId uuid.UUID, position int, toolCallId uuid.UUID) (bool, error)
+	CreatePlanDeviation(ctx context.Context, deviation PlanDeviation) error
+	GetPlanDeviations(ctx context.Context, planId uuid.UUID) ([]PlanDeviation, error)
+	// GetToolCallPlanDeviation returns the latest deviation recorded for a tool call, or nil if it has none
+	GetToolCallPlanDeviation(ctx context.Context, toolCallId uuid.UUID) (*PlanDeviation, error)
 }
 
 type ChatStore interface {
@@ -106,10 +618,39 @@ type ChatStore interface {
 		choices []AsteroidChoice,
 		format string,
 		requestMessages []AsteroidMessage,
+		usage ChatUsage,
 	) (*uuid.UUID, error)
 	// GetMessagesForRun(ctx context.Context, runId uuid.UUID, includeInvalidated bool) ([]AsteroidMessage, error)
-	GetChat(ctx context.Context, runId uuid.UUID, index int) ([]byte, []byte, error)
+	// GetChat returns the request, response and format of a run's chat, counting back from the latest
+	GetChat(ctx context.Context, runId uuid.UUID, index int) ([]byte, []byte, ChatFormat, error)
 	GetMessage(ctx context.Context, id uuid.UUID) (*AsteroidMessage, error)
+	// GetMessageRunId returns the run whose chat a message is in, or nil if the message doesn't exist
+	GetMessageRunId(ctx context.Context, id uuid.UUID) (*uuid.UUID, error)
+	// GetToolCallMessage returns the message that made a tool call
+	GetToolCallMessage(ctx context.Context, toolCallId uuid.UUID) (*AsteroidMessage, error)
 	UpdateMessage(ctx context.Context, id uuid.UUID, message AsteroidMessage) error
 	GetRunChatCount(ctx context.Context, runId uuid.UUID) (int, error)
+	GetTaskChatUsage(ctx context.Context, taskId uuid.UUID) ([]ChatUsage, error)
+	GetRunChatUsage(ctx context.Context, runId uuid.UUID) ([]ChatUsage, error)
+	// GetProjectModelUsage adds up the usage of a project's chats created since a time by model, without
+	// pricing it
+	GetProjectModelUsage(ctx context.Context, projectId uuid.UUID, since time.Time) ([]ModelUsage, error)
+
+	// Modifications
+	UpdateMessageContent(ctx context.Context, message AsteroidMessage, diff MessageDiff) error
+	GetMessageDiffs(ctx context.Context, messageId uuid.UUID) ([]MessageDiff, error)
+
+	// Translations
+	GetMessageTranslation(ctx context.Context, messageId uuid.UUID, targetLanguage string) (*MessageTranslation, error)
+	CreateMessageTranslation(ctx context.Context, translation MessageTranslation) error
+}
+
+type ProxyStore interface {
+	// Context window policies
+	GetContextWindowPolicies(ctx context.Context, projectId uuid.UUID) ([]ContextWindowPolicy, error)
+	SetContextWindowPolicies(ctx context.Context, projectId uuid.UUID, policies []ContextWindowPolicy) error
+
+	// Truncations
+	CreateContextTruncation(ctx context.Context, truncation ContextTruncation) error
+	GetRunTruncations(ctx context.Context, runId uuid.UUID) ([]ContextTruncation, error)
 }
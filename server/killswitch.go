@@ -0,0 +1,278 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// killSwitchConfirmWindow is how long a kill switch request waits for its second confirmation
+const killSwitchConfirmWindow = 15 * time.Minute
+
+const organizationResource = "organization"
+
+// getActiveKillSwitch returns the kill switch of a project's organization if it's active, or nil
+func getActiveKillSwitch(ctx context.Context, project *Project, store Store) (*KillSwitch, error) {
+	if project == nil || project.OrganizationId == nil {
+		return nil, nil
+	}
+
+	killSwitch, err := store.GetKillSwitch(ctx, *project.OrganizationId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting kill switch: %w", err)
+	}
+	if killSwitch == nil || !killSwitch.Active {
+		return nil, nil
+	}
+
+	return killSwitch, nil
+}
+
+// getActiveKillSwitchForSupervisionRequest returns the active kill switch of the organization a
+// supervision request belongs to, or nil
+func getActiveKillSwitchForSupervisionRequest(ctx context.Context, supervisionRequestId uuid.UUID, store Store) (*KillSwitch, error) {
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, supervisionRequestId, store)
+	if err != nil || toolCallId == nil {
+		return nil, err
+	}
+
+	project, err := getProjectForToolCall(ctx, *toolCallId, store)
+	if err != nil {
+		return nil, err
+	}
+
+	return getActiveKillSwitch(ctx, project, store)
+}
+
+// holdsDecision reports whether a decision has to wait while a kill switch is active. Only
+// decisions that let a tool call run are held, so reviewers can still reject and escalate.
+func holdsDecision(decision Decision) bool {
+	return decision == Approve || decision == Modify
+}
+
+// sendHaltedResponse refuses a request because the organization's kill switch is active
+func sendHaltedResponse(w http.ResponseWriter, killSwitch *KillSwitch) {
+	sendAPIError(w, haltedError(killSwitch))
+}
+
+func haltedError(killSwitch *KillSwitch) error {
+	reason := ""
+	if killSwitch.Reason != nil {
+		reason = *killSwitch.Reason
+	}
+	return &apiError{status: http.StatusLocked, message: fmt.Sprintf("organization %s is halted by its kill switch", killSwitch.OrganizationId), details: reason}
+}
+
+// checkKillSwitch refuses a request of a project whose organization's kill switch is active
+func checkKillSwitch(ctx context.Context, project *Project, store Store) error {
+	killSwitch, err := getActiveKillSwitch(ctx, project, store)
+	if err != nil {
+		return &apiError{status: http.StatusInternalServerError, message: "error getting kill switch", details: err.Error()}
+	}
+
+	if killSwitch != nil {
+		return haltedError(killSwitch)
+	}
+
+	return nil
+}
+
+func apiGetKillSwitchHandler(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	organization, err := store.GetOrganization(ctx, organizationId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting organization", err.Error())
+		return
+	}
+
+	if organization == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Organization not found", "")
+		return
+	}
+
+	killSwitch, err := store.GetKillSwitch(ctx, organizationId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting kill switch", err.Error())
+		return
+	}
+
+	// Organizations that never used their kill switch have it off
+	if killSwitch == nil {
+		killSwitch = &KillSwitch{OrganizationId: organizationId, Active: false}
+	}
+
+	killSwitch.PendingRequest, err = store.GetPendingKillSwitchRequest(ctx, organizationId, time.Now())
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting pending kill switch request", err.Error())
+		return
+	}
+
+	respondJSON(w, killSwitch, http.StatusOK)
+}
+
+func apiRequestKillSwitchHandler(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request RequestKillSwitchJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if request.Action != Activate && request.Action != Deactivate {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown kill switch action: %s", request.Action), "")
+		return
+	}
+
+	// Two-person confirmation needs to tell people apart, which it does by their API keys
+	key := apiKeyFromContext(ctx)
+	if key == nil {
+		sendErrorResponse(w, http.StatusForbidden, "kill switch changes must be made with an API key", "")
+		return
+	}
+
+	organization, err := store.GetOrganization(ctx, organizationId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting organization", err.Error())
+		return
+	}
+
+	if organization == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Organization not found", "")
+		return
+	}
+
+	killSwitch, err := store.GetKillSwitch(ctx, organizationId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting kill switch", err.Error())
+		return
+	}
+
+	active := killSwitch != nil && killSwitch.Active
+	if active == (request.Action == Activate) {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("kill switch can't %s, it is already in that state", request.Action), "")
+		return
+	}
+
+	pending, err := store.GetPendingKillSwitchRequest(ctx, organizationId, time.Now())
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting pending kill switch request", err.Error())
+		return
+	}
+
+	if pending != nil {
+		sendErrorResponse(w, http.StatusConflict, "another kill switch request is awaiting confirmation", pending.Id.String())
+		return
+	}
+
+	now := time.Now()
+	killSwitchRequest := KillSwitchRequest{
+		Id:               uuid.New(),
+		OrganizationId:   organizationId,
+		Action:           request.Action,
+		Reason:           request.Reason,
+		RequestedBy:      actorFromContext(ctx),
+		RequestedByKeyId: key.Id,
+		RequestedAt:      now,
+		ExpiresAt:        now.Add(killSwitchConfirmWindow),
+	}
+
+	if err := store.CreateKillSwitchRequest(ctx, killSwitchRequest); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating kill switch request", err.Error())
+		return
+	}
+
+	recordAuditEvent(ctx, killSwitchRequest.RequestedBy, AuditActionKillSwitchRequested, organizationResource, organizationId,
+		map[string]interface{}{"request_id": killSwitchRequest.Id, "action": request.Action, "reason": request.Reason}, store)
+
+	respondJSON(w, killSwitchRequest, http.StatusAccepted)
+}
+
+func apiConfirmKillSwitchHandler(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID, killSwitchRequestId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	key := apiKeyFromContext(ctx)
+	if key == nil {
+		sendErrorResponse(w, http.StatusForbidden, "kill switch changes must be confirmed with an API key", "")
+		return
+	}
+
+	request, err := store.GetKillSwitchRequest(ctx, killSwitchRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting kill switch request", err.Error())
+		return
+	}
+
+	if request == nil || request.OrganizationId != organizationId {
+		sendErrorResponse(w, http.StatusNotFound, "Kill switch request not found", "")
+		return
+	}
+
+	if request.ConfirmedAt != nil {
+		sendErrorResponse(w, http.StatusConflict, "kill switch request was already confirmed", "")
+		return
+	}
+
+	now := time.Now()
+	if now.After(request.ExpiresAt) {
+		sendErrorResponse(w, http.StatusConflict, "kill switch request has expired", "")
+		return
+	}
+
+	if key.Id == request.RequestedByKeyId {
+		sendErrorResponse(w, http.StatusForbidden, "kill switch request must be confirmed by a different API key to the one that made it", "")
+		return
+	}
+
+	actor := actorFromContext(ctx)
+	confirmed, err := store.ConfirmKillSwitchRequest(ctx, killSwitchRequestId, actor, now)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error confirming kill switch request", err.Error())
+		return
+	}
+
+	if !confirmed {
+		sendErrorResponse(w, http.StatusConflict, "kill switch request was already confirmed", "")
+		return
+	}
+
+	killSwitch := KillSwitch{
+		OrganizationId: organizationId,
+		Active:         request.Action == Activate,
+		Reason:         request.Reason,
+		UpdatedAt:      &now,
+		RequestedBy:    &request.RequestedBy,
+		ConfirmedBy:    &actor,
+	}
+
+	if err := store.SetKillSwitch(ctx, killSwitch); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting kill switch", err.Error())
+		return
+	}
+
+	// Activating pauses every running run, and deactivating resumes the runs it paused
+	from, to, action := Pending, Paused, AuditActionKillSwitchActivated
+	if !killSwitch.Active {
+		from, to, action = Paused, Pending, AuditActionKillSwitchDeactivated
+	}
+
+	runIds, err := store.SetOrganizationRunsStatus(ctx, organizationId, from, to)
+	if err != nil {
+		log.Printf("Error setting runs of organization %s from %s to %s: %v", organizationId, from, to, err)
+	}
+
+	recordAuditEvent(ctx, actor, action, organizationResource, organizationId, map[string]interface{}{
+		"request_id":   killSwitchRequestId,
+		"requested_by": request.RequestedBy,
+		"reason":       request.Reason,
+		"runs":         runIds,
+	}, store)
+
+	respondJSON(w, killSwitch, http.StatusOK)
+}
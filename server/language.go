@@ -0,0 +1,286 @@
+package asteroid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/sashabaranov/go-openai"
+	"golang.org/x/text/unicode/norm"
+)
+
+// UndeterminedLanguage is returned when the language of some content can't be detected
+const UndeterminedLanguage = "und"
+
+// DefaultTargetLanguage is the language reviewers get translations in unless they ask otherwise
+const DefaultTargetLanguage = "en"
+
+// ErrNoTranslator is returned when a translation is requested but no backend is configured
+var ErrNoTranslator = errors.New("no translation backend configured")
+
+// NormalizeContent puts message content into Unicode NFC form so that visually identical
+// strings from different providers compare, hash and search the same way
+func NormalizeContent(content string) string {
+	return norm.NFC.String(content)
+}
+
+// scriptLanguages maps scripts that are (mostly) used by a single language to that language
+var scriptLanguages = []struct {
+	table    *unicode.RangeTable
+	language string
+}{
+	{unicode.Hiragana, "ja"},
+	{unicode.Katakana, "ja"},
+	{unicode.Hangul, "ko"},
+	{unicode.Han, "zh"},
+	{unicode.Cyrillic, "ru"},
+	{unicode.Arabic, "ar"},
+	{unicode.Hebrew, "he"},
+	{unicode.Devanagari, "hi"},
+	{unicode.Greek, "el"},
+	{unicode.Thai, "th"},
+}
+
+// stopwords holds a handful of very common words for languages written in the Latin script
+var stopwords = map[string][]string{
+	"en": {"the", "and", "is", "are", "of", "to", "in", "that", "it", "you", "for", "with", "this", "was", "not"},
+	"es": {"el", "la", "los", "las", "de", "que", "y", "en", "un", "una", "es", "por", "con", "para", "no"},
+	"fr": {"le", "la", "les", "de", "des", "et", "est", "un", "une", "que", "pour", "dans", "pas", "vous", "avec"},
+	"de": {"der", "die", "das", "und", "ist", "nicht", "ein", "eine", "zu", "mit", "sie", "ich", "auf", "für", "den"},
+	"pt": {"o", "os", "as", "de", "que", "e", "do", "da", "em", "um", "uma", "para", "com", "não", "é"},
+	"it": {"il", "lo", "la", "gli", "di", "che", "e", "un", "una", "per", "non", "sono", "con", "del", "è"},
+	"nl": {"de", "het", "een", "en", "van", "is", "dat", "niet", "op", "te", "zijn", "met", "voor", "ik", "je"},
+}
+
+// DetectLanguage makes a best-effort guess at the ISO 639-1 code of the language of some text.
+// Non-Latin scripts are identified by character ranges, Latin-script languages by stopword frequency.
+func DetectLanguage(text string) string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return UndeterminedLanguage
+	}
+
+	// Count the letters belonging to each distinctive script
+	scriptCounts := make(map[string]int)
+	latin := 0
+	letters := 0
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if unicode.Is(unicode.Latin, r) {
+			latin++
+			continue
+		}
+		for _, sl := range scriptLanguages {
+			if unicode.Is(sl.table, r) {
+				scriptCounts[sl.language]++
+				break
+			}
+		}
+	}
+
+	if letters == 0 {
+		return UndeterminedLanguage
+	}
+
+	// Kana mixed with Han is Japanese, not Chinese
+	if scriptCounts["ja"] > 0 {
+		scriptCounts["ja"] += scriptCounts["zh"]
+		delete(scriptCounts, "zh")
+	}
+
+	best, bestCount := "", 0
+	for language, count := range scriptCounts {
+		if count > bestCount {
+			best, bestCount = language, count
+		}
+	}
+	if bestCount > latin {
+		return best
+	}
+
+	return detectLatinLanguage(text)
+}
+
+func detectLatinLanguage(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	scores := make(map[string]int)
+	for _, word := range words {
+		for language, list := range stopwords {
+			for _, stopword := range list {
+				if word == stopword {
+					scores[language]++
+					break
+				}
+			}
+		}
+	}
+
+	best, bestScore := UndeterminedLanguage, 0
+	for language, score := range scores {
+		// Break ties deterministically so the same text always gets the same language
+		if score > bestScore || (score == bestScore && score > 0 && language < best) {
+			best, bestScore = language, score
+		}
+	}
+
+	return best
+}
+
+// Translator translates message content for reviewers who don't speak the language of the agent
+type Translator interface {
+	Name() string
+	Translate(ctx context.Context, text string, sourceLanguage string, targetLanguage string) (string, error)
+}
+
+// NewTranslatorFromEnv returns the translation backend selected by TRANSLATION_BACKEND, or nil if
+// translation is disabled
+func NewTranslatorFromEnv() (Translator, error) {
+	switch backend := os.Getenv("TRANSLATION_BACKEND"); backend {
+	case "", "none":
+		return nil, nil
+	case "openai":
+		apiKey := os.Getenv("OPENAI_API_KEY")
+		if apiKey == "" {
+			return nil, fmt.Errorf("OPENAI_API_KEY must be set to use the openai translation backend")
+		}
+
+		model := os.Getenv("TRANSLATION_MODEL")
+		if model == "" {
+			model = openai.GPT4oMini
+		}
+
+		return &OpenAITranslator{client: openai.NewClient(apiKey), model: model}, nil
+	default:
+		return nil, fmt.Errorf("unknown translation backend: %s", backend)
+	}
+}
+
+// OpenAITranslator translates text using an OpenAI chat model
+type OpenAITranslator struct {
+	client *openai.Client
+	model  string
+}
+
+func (t *OpenAITranslator) Name() string {
+	return "openai:" + t.model
+}
+
+func (t *OpenAITranslator) Translate(ctx context.Context, text string, sourceLanguage string, targetLanguage string) (string, error) {
+	prompt := fmt.Sprintf(
+		"Translate the following text from the language with ISO 639-1 code %q into the language with ISO 639-1 code %q. "+
+			"Preserve formatting, code, identifiers and JSON exactly. Respond with the translation only.",
+		sourceLanguage, targetLanguage,
+	)
+
+	resp, err := t.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model: t.model,
+		Messages: []openai.ChatCompletionMessage{
+			{Role: openai.ChatMessageRoleSystem, Content: prompt},
+			{Role: openai.ChatMessageRoleUser, Content: text},
+		},
+		Temperature: 0,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error calling translation model: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("translation model returned no choices")
+	}
+
+	return resp.Choices[0].Message.Content, nil
+}
+
+func apiGetMessageTranslationHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	messageId uuid.UUID,
+	params GetMessageTranslationParams,
+	store ChatStore,
+	translator Translator,
+) {
+	ctx := r.Context()
+
+	targetLanguage := DefaultTargetLanguage
+	if params.TargetLanguage != nil && *params.TargetLanguage != "" {
+		targetLanguage = strings.ToLower(*params.TargetLanguage)
+	}
+
+	message, err := store.GetMessage(ctx, messageId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting message", err.Error())
+		return
+	}
+
+	if message == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Message not found", "")
+		return
+	}
+
+	// Serve a cached translation if we have one
+	existing, err := store.GetMessageTranslation(ctx, messageId, targetLanguage)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting message translation", err.Error())
+		return
+	}
+
+	if existing != nil {
+		respondJSON(w, existing, http.StatusOK)
+		return
+	}
+
+	content := NormalizeContent(message.Content)
+	sourceLanguage := DetectLanguage(content)
+	if message.Language != nil && *message.Language != UndeterminedLanguage {
+		sourceLanguage = *message.Language
+	}
+
+	translation := MessageTranslation{
+		MessageId:      messageId,
+		SourceLanguage: sourceLanguage,
+		TargetLanguage: targetLanguage,
+		Content:        content,
+		CreatedAt:      time.Now(),
+	}
+
+	// Nothing to do if the message is already in the language the reviewer wants
+	if sourceLanguage == targetLanguage {
+		translation.TranslatedContent = content
+		translation.Backend = "none"
+		respondJSON(w, translation, http.StatusOK)
+		return
+	}
+
+	if translator == nil {
+		sendErrorResponse(w, http.StatusServiceUnavailable, ErrNoTranslator.Error(), "set TRANSLATION_BACKEND to enable translations")
+		return
+	}
+
+	translated, err := translator.Translate(ctx, content, sourceLanguage, targetLanguage)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadGateway, "error translating message", err.Error())
+		return
+	}
+
+	translation.TranslatedContent = translated
+	translation.Backend = translator.Name()
+
+	if err := store.CreateMessageTranslation(ctx, translation); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error storing message translation", err.Error())
+		return
+	}
+
+	respondJSON(w, translation, http.StatusOK)
+}
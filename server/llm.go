@@ -0,0 +1,280 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// llmDecisionTimeout is how long the model of an LLM supervisor has to answer
+const llmDecisionTimeout = 60 * time.Second
+
+// defaultLlmDecisions are the decisions an LLM supervisor can give unless it's configured with others
+var defaultLlmDecisions = []Decision{Approve, Reject, Escalate}
+
+// llmInstructions is the system prompt of an LLM supervisor's model, the prompt template gives the rest
+const llmInstructions = `You supervise the tool calls of an AI agent. Answer with the decision you take on the tool call ` +
+	`you're shown, a confidence between 0 and 1, and a short rationale for the people who review the call after you.`
+
+// llmPromptData is what the prompt template of an LLM supervisor is rendered with
+type llmPromptData struct {
+	ToolName        string
+	ToolDescription string
+	Arguments       string
+	Message         string
+}
+
+// llmAnswer is the answer a model gives in the decision schema
+type llmAnswer struct {
+	Decision   Decision `json:"decision"`
+	Confidence float64  `json:"confidence"`
+	Rationale  string   `json:"rationale"`
+}
+
+// parseLlmAttributes reads the configuration of an LLM supervisor from its attributes
+func parseLlmAttributes(attributes map[string]interface{}) (*LlmSupervisorAttributes, *template.Template, error) {
+	jsonAttributes, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshalling attributes: %w", err)
+	}
+
+	var config LlmSupervisorAttributes
+	if err := json.Unmarshal(jsonAttributes, &config); err != nil {
+		return nil, nil, fmt.Errorf("attributes must have a model, prompt_template and optional decisions: %w", err)
+	}
+	if config.Model == "" || strings.TrimSpace(config.PromptTemplate) == "" {
+		return nil, nil, fmt.Errorf("LLM supervisors need a model and prompt_template")
+	}
+
+	prompt, err := template.New("prompt").Option("missingkey=error").Parse(config.PromptTemplate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid prompt_template: %w", err)
+	}
+
+	if config.Decisions == nil {
+		decisions := defaultLlmDecisions
+		config.Decisions = &decisions
+	}
+	return &config, prompt, nil
+}
+
+// validateLlmAttributes checks the model, prompt template and decisions of an LLM supervisor
+func validateLlmAttributes(attributes map[string]interface{}) error {
+	config, prompt, err := parseLlmAttributes(attributes)
+	if err != nil {
+		return err
+	}
+
+	if len(*config.Decisions) == 0 {
+		return fmt.Errorf("LLM supervisors need at least one decision")
+	}
+	for _, decision := range *config.Decisions {
+		if _, ok := decisionSeverity[decision]; !ok {
+			return fmt.Errorf("LLM supervisors can't decide %s", decision)
+		}
+	}
+
+	// Rendering with placeholders catches fields the template expects that it won't be given
+	if _, err := renderLlmPrompt(prompt, llmPromptData{}); err != nil {
+		return fmt.Errorf("invalid prompt_template: %w", err)
+	}
+
+	_, err = parseFailureHandling(attributes)
+	return err
+}
+
+func renderLlmPrompt(prompt *template.Template, data llmPromptData) (string, error) {
+	var rendered strings.Builder
+	if err := prompt.Execute(&rendered, data); err != nil {
+		return "", err
+	}
+	return rendered.String(), nil
+}
+
+// llmDecisionSchema is the JSON schema a model answers in, with the supervisor's decisions as the
+// only ones it can give
+func llmDecisionSchema(decisions []Decision) (json.RawMessage, error) {
+	return json.Marshal(map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"decision":   map[string]interface{}{"type": "string", "enum": decisions},
+			"confidence": map[string]interface{}{"type": "number"},
+			"rationale":  map[string]interface{}{"type": "string"},
+		},
+		"required":             []string{"decision", "confidence", "rationale"},
+		"additionalProperties": false,
+	})
+}
+
+// llmPromptDataForRequest gathers the tool call a supervision request is for and the message that made it
+func llmPromptDataForRequest(ctx context.Context, supervisionRequestId uuid.UUID, store Store) (llmPromptData, error) {
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, supervisionRequestId, store)
+	if err != nil {
+		return llmPromptData{}, err
+	}
+	if toolCallId == nil {
+		return llmPromptData{}, fmt.Errorf("supervision request %s has no tool call", supervisionRequestId)
+	}
+
+	toolCall, err := store.GetToolCall(ctx, *toolCallId)
+	if err != nil {
+		return llmPromptData{}, fmt.Errorf("error getting tool call: %w", err)
+	}
+	if toolCall == nil {
+		return llmPromptData{}, fmt.Errorf("tool call %s not found", *toolCallId)
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return llmPromptData{}, fmt.Errorf("error getting tool: %w", err)
+	}
+	if tool == nil {
+		return llmPromptData{}, fmt.Errorf("tool %s not found", toolCall.ToolId)
+	}
+
+	data := toolPromptData(*tool, storedToolCallArguments(*toolCall))
+
+	message, err := store.GetToolCallMessage(ctx, *toolCallId)
+	if err != nil {
+		return llmPromptData{}, fmt.Errorf("error getting message of tool call: %w", err)
+	}
+	if message != nil {
+		data.Message = message.Content
+	}
+
+	return data, nil
+}
+
+func toolPromptData(tool Tool, arguments *string) llmPromptData {
+	data := llmPromptData{ToolName: tool.Name, ToolDescription: tool.Description, Arguments: "{}"}
+	if arguments != nil {
+		data.Arguments = *arguments
+	}
+	return data
+}
+
+// askLlm asks an LLM supervisor's model for its decision. An answer it isn't allowed to give is an error.
+func askLlm(ctx context.Context, judge Judge, attributes map[string]interface{}, data llmPromptData) (*llmAnswer, error) {
+	config, prompt, err := parseLlmAttributes(attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := renderLlmPrompt(prompt, data)
+	if err != nil {
+		return nil, fmt.Errorf("error rendering prompt: %w", err)
+	}
+
+	schema, err := llmDecisionSchema(*config.Decisions)
+	if err != nil {
+		return nil, fmt.Errorf("error building decision schema: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, llmDecisionTimeout)
+	defer cancel()
+
+	answer, err := judge.Decide(ctx, config.Model, llmInstructions, rendered, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed llmAnswer
+	if err := json.Unmarshal([]byte(answer), &parsed); err != nil {
+		return nil, fmt.Errorf("error parsing answer: %w", err)
+	}
+
+	allowed := false
+	for _, decision := range *config.Decisions {
+		allowed = allowed || decision == parsed.Decision
+	}
+	if !allowed {
+		return nil, fmt.Errorf("model answered with a decision it can't give: %s", parsed.Decision)
+	}
+	if parsed.Confidence < 0 || parsed.Confidence > 1 {
+		return nil, fmt.Errorf("model answered with a confidence outside 0 and 1: %v", parsed.Confidence)
+	}
+
+	return &parsed, nil
+}
+
+// askLlmAdvisory asks an LLM supervisor's model about a tool call without deciding it
+func askLlmAdvisory(ctx context.Context, supervisor Supervisor, tool Tool, arguments *string, judge Judge) (advisoryVerdict, error) {
+	if judge == nil {
+		return advisoryVerdict{reasoning: fmt.Sprintf("%s can't be asked, no model is configured", supervisor.Name)}, nil
+	}
+
+	answer, err := askLlm(ctx, judge, supervisor.Attributes, toolPromptData(tool, arguments))
+	if err != nil {
+		return advisoryVerdict{reasoning: fmt.Sprintf("%s couldn't decide: %v", supervisor.Name, err)}, nil
+	}
+
+	return advisoryVerdict{decision: &answer.Decision, confidence: &answer.Confidence, reasoning: answer.Rationale}, nil
+}
+
+// decideWithLlm resolves a supervision request with the decision of an LLM supervisor's model, and
+// its rationale as the result's explanation. Requests the model can't decide, or that its circuit
+// breaker keeps from it, are decided by its failure policy.
+func decideWithLlm(ctx context.Context, supervisionRequest SupervisionRequest, supervisor Supervisor, judge Judge, breakers *CircuitBreakers, store Store) error {
+	requestId := *supervisionRequest.Id
+
+	result := SupervisionResult{
+		Decision:             Escalate,
+		SupervisionRequestId: requestId,
+	}
+
+	handling, err := parseFailureHandling(supervisor.Attributes)
+	var data llmPromptData
+	if err == nil {
+		data, err = llmPromptDataForRequest(ctx, requestId, store)
+	}
+
+	decidedByPolicy := false
+	switch {
+	case judge == nil:
+		result.Reasoning = "Escalated because no model is configured, set OPENAI_API_KEY to enable LLM supervisors"
+	case err != nil:
+		result.Reasoning = fmt.Sprintf("Escalated because the model couldn't decide the request: %v", err)
+	case !breakers.allow(supervisor, handling, time.Now()):
+		applyFailurePolicy(&result, handling.policy, "the supervisor's circuit breaker is open after it failed repeatedly")
+		decidedByPolicy = true
+	default:
+		answer, err := askLlm(ctx, judge, supervisor.Attributes, data)
+		breakers.record(supervisor, handling, err != nil, time.Now())
+		if err != nil {
+			applyFailurePolicy(&result, handling.policy, fmt.Sprintf("the model couldn't decide the request: %v", err))
+			decidedByPolicy = true
+			break
+		}
+
+		result.Decision = answer.Decision
+		result.Reasoning = answer.Rationale
+		result.Explanation = &ResultExplanation{Rationale: &answer.Rationale, Confidence: &answer.Confidence}
+	}
+
+	// The failure policy decides without a confidence, so the chain's min_confidence doesn't apply to it
+	if !decidedByPolicy {
+		if err := applyConfidenceThreshold(ctx, requestId, supervisor, &result, store); err != nil {
+			return err
+		}
+	}
+	if err := escalatePlanDeviation(ctx, requestId, supervisor, &result, store); err != nil {
+		return err
+	}
+
+	result.CreatedAt = time.Now()
+	_, winner, err := resolveSupervisionRequest(ctx, requestId, result, SystemActor, store)
+	if err != nil {
+		return err
+	}
+	if winner != nil {
+		log.Printf("Supervision request %s was resolved before its LLM supervisor decided", requestId)
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// DefaultLocale is the locale of reviewer-facing text for reviewers who didn't ask for another one
+const DefaultLocale = "en"
+
+// LocaleQueryParam is the WebSocket query parameter with the locale a reviewer wants their events in.
+// A locale admins set on the reviewer's session takes precedence.
+const LocaleQueryParam = "locale"
+
+// localizedMessages holds the reviewer-facing text of each supported locale by message key. Messages a
+// locale is missing fall back to the default locale's.
+var localizedMessages = map[string]map[string]string{
+	"en": {
+		"decision.approve":                "Approve",
+		"decision.reject":                 "Reject",
+		"decision.terminate":              "Terminate",
+		"decision.modify":                 "Modify",
+		"decision.escalate":               "Escalate",
+		"event.already_resolved":          "This review was already decided",
+		"event.already_resolved.decision": "This review was already decided: %s",
+		"event.reassigned":                "This review was handed to another reviewer",
+		"event.clarification_requested":   "Waiting for the agent to answer your question",
+		"event.held":                      "Your decision is held while the organization's kill switch is active",
+		"event.held.decision":             "Your decision (%s) is held while the organization's kill switch is active",
+		"event.run_paused":                "Your decision is held while the run is paused",
+		"event.run_paused.decision":       "Your decision (%s) is held while the run is paused",
+		"event.claimed":                   "This review was claimed by another reviewer",
+		"event.claimed.decision":          "Your decision (%s) was refused, another reviewer claimed this review",
+		"event.reminder":                  "This review is still waiting for your decision",
+		"event.queue_reminder":            "A review in the queue is still waiting for a decision",
+		"event.batch_resolved":            "These reviews were decided together",
+		"event.batch_resolved.decision":   "These reviews were decided together: %s",
+		"event.escalated":                 "This review was escalated to you",
+		"verdict_behavior.block":          "Block",
+		"verdict_behavior.continue":       "Continue",
+		"verdict_behavior.clarify":        "Ask the agent",
+	},
+	"de": {
+		"decision.approve":                "Genehmigen",
+		"decision.reject":                 "Ablehnen",
+		"decision.terminate":              "Beenden",
+		"decision.modify":                 "Ändern",
+		"decision.escalate":               "Eskalieren",
+		"event.already_resolved":          "Diese Prüfung wurde bereits entschieden",
+		"event.already_resolved.decision": "Diese Prüfung wurde bereits entschieden: %s",
+		"event.reassigned":                "Diese Prüfung wurde an eine andere Person übergeben",
+		"event.clarification_requested":   "Warten auf die Antwort des Agenten auf Ihre Frage",
+		"event.held":                      "Ihre Entscheidung wird zurückgehalten, solange der Notschalter der Organisation aktiv ist",
+		"event.held.decision":             "Ihre Entscheidung (%s) wird zurückgehalten, solange der Notschalter der Organisation aktiv ist",
+		"event.run_paused":                "Ihre Entscheidung wird zurückgehalten, solange der Lauf pausiert ist",
+		"event.run_paused.decision":       "Ihre Entscheidung (%s) wird zurückgehalten, solange der Lauf pausiert ist",
+		"event.claimed":                   "Diese Prüfung wurde von einer anderen Person übernommen",
+		"event.claimed.decision":          "Ihre Entscheidung (%s) wurde abgelehnt, eine andere Person hat diese Prüfung übernommen",
+		"event.reminder":                  "Diese Prüfung wartet noch auf Ihre Entscheidung",
+		"event.queue_reminder":            "Eine Prüfung in der Warteschlange wartet noch auf eine Entscheidung",
+		"event.batch_resolved":            "Diese Prüfungen wurden gemeinsam entschieden",
+		"event.batch_resolved.decision":   "Diese Prüfungen wurden gemeinsam entschieden: %s",
+		"event.escalated":                 "Diese Prüfung wurde an Sie eskaliert",
+		"verdict_behavior.block":          "Blockieren",
+		"verdict_behavior.continue":       "Fortfahren",
+		"verdict_behavior.clarify":        "Den Agenten fragen",
+	},
+	"fr": {
+		"decision.approve":                "Approuver",
+		"decision.reject":                 "Rejeter",
+		"decision.terminate":              "Terminer",
+		"decision.modify":                 "Modifier",
+		"decision.escalate":               "Escalader",
+		"event.already_resolved":          "Cette revue a déjà été décidée",
+		"event.already_resolved.decision": "Cette revue a déjà été décidée : %s",
+		"event.reassigned":                "Cette revue a été confiée à une autre personne",
+		"event.clarification_requested":   "En attente de la réponse de l'agent à votre question",
+		"event.held":                      "Votre décision est suspendue tant que l'arrêt d'urgence de l'organisation est actif",
+		"event.held.decision":             "Votre décision (%s) est suspendue tant que l'arrêt d'urgence de l'organisation est actif",
+		"event.run_paused":                "Votre décision est suspendue tant que l'exécution est en pause",
+		"event.run_paused.decision":       "Votre décision (%s) est suspendue tant que l'exécution est en pause",
+		"event.claimed":                   "Cette revue a été prise en charge par une autre personne",
+		"event.claimed.decision":          "Votre décision (%s) a été refusée, une autre personne a pris en charge cette revue",
+		"event.reminder":                  "Cette revue attend toujours votre décision",
+		"event.queue_reminder":            "Une revue de la file attend toujours une décision",
+		"event.batch_resolved":            "Ces revues ont été décidées ensemble",
+		"event.batch_resolved.decision":   "Ces revues ont été décidées ensemble : %s",
+		"event.escalated":                 "Cette revue vous a été escaladée",
+		"verdict_behavior.block":          "Bloquer",
+		"verdict_behavior.continue":       "Continuer",
+		"verdict_behavior.clarify":        "Interroger l'agent",
+	},
+	"es": {
+		"decision.approve":                "Aprobar",
+		"decision.reject":                 "Rechazar",
+		"decision.terminate":              "Terminar",
+		"decision.modify":                 "Modificar",
+		"decision.escalate":               "Escalar",
+		"event.already_resolved":          "Esta revisión ya fue decidida",
+		"event.already_resolved.decision": "Esta revisión ya fue decidida: %s",
+		"event.reassigned":                "Esta revisión se asignó a otra persona",
+		"event.clarification_requested":   "Esperando a que el agente responda a su pregunta",
+		"event.held":                      "Su decisión queda retenida mientras el interruptor de emergencia de la organización esté activo",
+		"event.held.decision":             "Su decisión (%s) queda retenida mientras el interruptor de emergencia de la organización esté activo",
+		"event.run_paused":                "Su decisión queda retenida mientras la ejecución esté en pausa",
+		"event.run_paused.decision":       "Su decisión (%s) queda retenida mientras la ejecución esté en pausa",
+		"event.claimed":                   "Otra persona tomó esta revisión",
+		"event.claimed.decision":          "Su decisión (%s) fue rechazada, otra persona tomó esta revisión",
+		"event.reminder":                  "Esta revisión sigue esperando su decisión",
+		"event.queue_reminder":            "Una revisión de la cola sigue esperando una decisión",
+		"event.batch_resolved":            "Estas revisiones se decidieron juntas",
+		"event.batch_resolved.decision":   "Estas revisiones se decidieron juntas: %s",
+		"event.escalated":                 "Esta revisión se le escaló a usted",
+		"verdict_behavior.block":          "Bloquear",
+		"verdict_behavior.continue":       "Continuar",
+		"verdict_behavior.clarify":        "Preguntar al agente",
+	},
+}
+
+// supportedLocales returns the locales reviewer-facing text is available in, in order
+func supportedLocales() []string {
+	locales := make([]string, 0, len(localizedMessages))
+	for locale := range localizedMessages {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// normalizeLocale returns the supported locale for a language tag like de or de-CH, or an empty
+// string if it isn't supported
+func normalizeLocale(locale string) string {
+	language, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(locale)), "-")
+	language, _, _ = strings.Cut(language, "_")
+	if _, ok := localizedMessages[language]; !ok {
+		return ""
+	}
+	return language
+}
+
+// localize returns a message in a locale, formatted with its arguments
+func localize(locale string, key string, args ...interface{}) string {
+	message, ok := localizedMessages[normalizeLocale(locale)][key]
+	if !ok {
+		message, ok = localizedMessages[DefaultLocale][key]
+	}
+	if !ok {
+		return key
+	}
+
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+// decisionLabel returns the label of a decision in a locale
+func decisionLabel(locale string, decision Decision) string {
+	return localize(locale, "decision."+string(decision))
+}
+
+// localizeReviewEvent returns the message a reviewer is shown for a review event
+func localizeReviewEvent(locale string, event ReviewEvent) string {
+	key := "event." + event.Type
+	if event.Decision == "" {
+		return localize(locale, key)
+	}
+	if _, ok := localizedMessages[DefaultLocale][key+".decision"]; ok {
+		return localize(locale, key+".decision", decisionLabel(locale, event.Decision))
+	}
+	return localize(locale, key)
+}
+
+// reviewerLocale returns the locale a reviewer session gets its events in: the one admins set on
+// the session, or else the first supported one the connection asked for
+func reviewerLocale(ctx context.Context, session string, requested []string, store ReviewerStore) (string, error) {
+	reviewers, err := store.GetReviewers(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error getting reviewers: %w", err)
+	}
+	for _, reviewer := range reviewers {
+		if reviewer.Session == session && reviewer.Locale != nil && normalizeLocale(*reviewer.Locale) != "" {
+			return normalizeLocale(*reviewer.Locale), nil
+		}
+	}
+
+	for _, locale := range requested {
+		if normalized := normalizeLocale(locale); normalized != "" {
+			return normalized, nil
+		}
+	}
+	return DefaultLocale, nil
+}
+
+// acceptedLocales returns the language tags of an Accept-Language header, in the order they're listed
+func acceptedLocales(header string) []string {
+	locales := make([]string, 0)
+	for _, part := range strings.Split(header, ",") {
+		tag, _, _ := strings.Cut(part, ";")
+		if tag = strings.TrimSpace(tag); tag != "" && tag != "*" {
+			locales = append(locales, tag)
+		}
+	}
+	return locales
+}
+
+func apiGetLocalizedMessagesHandler(w http.ResponseWriter, r *http.Request, locale string) {
+	normalized := normalizeLocale(locale)
+	if normalized == "" {
+		sendErrorResponse(w, http.StatusNotFound, "Locale not found", fmt.Sprintf("supported locales: %s", strings.Join(supportedLocales(), ", ")))
+		return
+	}
+
+	localized := make(map[string]string, len(localizedMessages[DefaultLocale]))
+	for key := range localizedMessages[DefaultLocale] {
+		localized[key] = localize(normalized, key)
+	}
+
+	respondJSON(w, LocalizedMessages{Locale: normalized, Messages: localized}, http.StatusOK)
+}
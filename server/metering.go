@@ -0,0 +1,79 @@
+package asteroid
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxMeteringEvents is the most metering events returned in one page
+const maxMeteringEvents = 1000
+
+// recordMeteringEvent stores a metering event for something a project used. Each resource is only
+// metered once per metric, and failing to meter doesn't fail the request, so errors are only logged.
+func recordMeteringEvent(ctx context.Context, project *Project, metric MeteringMetric, resourceId uuid.UUID, quantity int64, store MeteringStore) {
+	if project == nil || quantity <= 0 {
+		return
+	}
+
+	event := MeteringEvent{
+		Id:             uuid.New(),
+		IdempotencyKey: string(metric) + ":" + resourceId.String(),
+		Metric:         metric,
+		Quantity:       quantity,
+		ProjectId:      project.Id,
+		OrganizationId: project.OrganizationId,
+		ResourceId:     resourceId,
+		CreatedAt:      time.Now(),
+	}
+
+	if err := store.CreateMeteringEvent(ctx, event); err != nil {
+		log.Printf("Error recording %s metering event for %s: %v", metric, resourceId, err)
+	}
+}
+
+// recordSupervisorUsage meters the tokens an LLM supervisor reported with its result
+func recordSupervisorUsage(ctx context.Context, resultId uuid.UUID, toolCallId uuid.UUID, usage SupervisorUsage, store Store) {
+	project, err := getProjectForToolCall(ctx, toolCallId, store)
+	if err != nil {
+		log.Printf("Error getting project for tool call %s: %v", toolCallId, err)
+		return
+	}
+
+	recordMeteringEvent(ctx, project, SupervisorTokens, resultId, usage.PromptTokens+usage.CompletionTokens, store)
+}
+
+func apiGetMeteringEventsHandler(w http.ResponseWriter, r *http.Request, params GetMeteringEventsParams, store MeteringStore) {
+	var after int64
+	if params.After != nil {
+		after = *params.After
+	}
+
+	limit := maxMeteringEvents
+	if params.Limit != nil {
+		if *params.Limit <= 0 {
+			sendErrorResponse(w, http.StatusBadRequest, "limit must be positive", "")
+			return
+		}
+		limit = min(*params.Limit, maxMeteringEvents)
+	}
+
+	events, err := store.GetMeteringEvents(r.Context(), after, limit, params.OrganizationId, params.ProjectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting metering events", err.Error())
+		return
+	}
+
+	page := MeteringEventPage{
+		Events:    events,
+		NextAfter: after,
+	}
+	if len(events) > 0 {
+		page.NextAfter = events[len(events)-1].Sequence
+	}
+
+	respondJSON(w, page, http.StatusOK)
+}
@@ -0,0 +1,65 @@
+package asteroid
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+)
+
+const notificationTimeout = 10 * time.Second
+
+var notificationClient = &http.Client{
+	Timeout: notificationTimeout,
+	// Notifications only go to the webhook a project configured
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// isCallbackUrl reports whether a URL can be POSTed notifications and webhook events
+func isCallbackUrl(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// dispatchNotification POSTs a notification to the webhook of its project, if the project has one
+// and is subscribed to the notification's event. It reports whether the notification was sent.
+func dispatchNotification(ctx context.Context, notification Notification, store Store) (bool, error) {
+	settings, err := store.GetNotificationSettings(ctx, notification.ProjectId)
+	if err != nil {
+		return false, fmt.Errorf("error getting notification settings: %w", err)
+	}
+	if settings == nil || settings.WebhookUrl == nil || *settings.WebhookUrl == "" || !slices.Contains(settings.Events, notification.Event) {
+		return false, nil
+	}
+
+	notification.SentAt = time.Now()
+	body, contentType, err := renderPayload(ctx, notification.ProjectId, Notifications, notification, store)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, *settings.WebhookUrl, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("error creating notification request: %w", err)
+	}
+	request.Header.Set("Content-Type", contentType)
+
+	resp, err := notificationClient.Do(request)
+	if err != nil {
+		return false, fmt.Errorf("error calling notification webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return false, fmt.Errorf("notification webhook responded with status %d", resp.StatusCode)
+	}
+	return true, nil
+}
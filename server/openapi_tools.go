@@ -0,0 +1,430 @@
+package asteroid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxOpenApiDocumentBytes bounds the OpenAPI documents tools are imported from
+	maxOpenApiDocumentBytes = 4 << 20
+	// maxToolNameLength is the longest tool name LLM providers accept
+	maxToolNameLength = 64
+	// openApiRiskTierExtension lets an operation set the risk tier of its tool
+	openApiRiskTierExtension = "x-risk-tier"
+	// openApiBodyParameter is the argument an operation's request body is passed in
+	openApiBodyParameter = "body"
+)
+
+// openApiMethods are the methods of operations, in the order a path's tools are registered
+var openApiMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace,
+	http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete,
+}
+
+var toolNameUnsafeChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// toolDefinition is a tool as it's registered, the payload ingestion hooks transform
+type toolDefinition struct {
+	Attributes        map[string]interface{}  `json:"attributes"`
+	Name              string                  `json:"name"`
+	Description       string                  `json:"description"`
+	IgnoredAttributes []string                `json:"ignored_attributes"`
+	Code              string                  `json:"code"`
+	Parameters        *map[string]interface{} `json:"parameters"`
+}
+
+// openApiOperation is an operation of a document with the tool it's registered as
+type openApiOperation struct {
+	method   string
+	path     string
+	riskTier RiskTier
+	tool     toolDefinition
+}
+
+// openApiRiskTier guesses how risky an operation is from its method: reading is low risk,
+// creating and changing is medium and deleting is high. The operation's x-risk-tier overrides it.
+func openApiRiskTier(method string, operation *openapi3.Operation) RiskTier {
+	if declared, ok := operation.Extensions[openApiRiskTierExtension].(string); ok {
+		if _, known := riskTierRank[RiskTier(declared)]; known {
+			return RiskTier(declared)
+		}
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return Low
+	case http.MethodDelete:
+		return High
+	default:
+		return Medium
+	}
+}
+
+// openApiToolName names an operation's tool by its operationId, or by its method and path
+func openApiToolName(method, path string, operation *openapi3.Operation) string {
+	name := operation.OperationID
+	if name == "" {
+		name = strings.ToLower(method) + path
+	}
+
+	name = strings.Trim(toolNameUnsafeChars.ReplaceAllString(name, "_"), "_")
+	if len(name) > maxToolNameLength {
+		name = name[:maxToolNameLength]
+	}
+	return name
+}
+
+// openApiSchema returns a schema of the document as JSON Schema. References to the document's
+// component schemas point to $defs instead, and the names of the ones it refers to are added to refs.
+func openApiSchema(schema *openapi3.SchemaRef, refs map[string]bool) (map[string]interface{}, error) {
+	encoded, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding schema: %w", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("error decoding schema: %w", err)
+	}
+
+	rewriteSchemaRefs(decoded, refs)
+	return decoded, nil
+}
+
+func rewriteSchemaRefs(value interface{}, refs map[string]bool) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := value["$ref"].(string); ok {
+			if name, ok := strings.CutPrefix(ref, "#/components/schemas/"); ok {
+				value["$ref"] = "#/$defs/" + name
+				refs[name] = true
+			}
+		}
+		for _, nested := range value {
+			rewriteSchemaRefs(nested, refs)
+		}
+	case []interface{}:
+		for _, nested := range value {
+			rewriteSchemaRefs(nested, refs)
+		}
+	}
+}
+
+// openApiParameters returns the JSON Schema of an operation's arguments: its path and query
+// parameters, and its JSON request body as body. Component schemas it refers to are in its $defs.
+func openApiParameters(document *openapi3.T, pathItem *openapi3.PathItem, operation *openapi3.Operation) (map[string]interface{}, error) {
+	properties := make(map[string]interface{})
+	required := make([]interface{}, 0)
+	refs := make(map[string]bool)
+
+	// An operation's parameters override its path's of the same name and location
+	parameters := make(map[string]*openapi3.Parameter)
+	names := make([]string, 0)
+	for _, list := range []openapi3.Parameters{pathItem.Parameters, operation.Parameters} {
+		for _, ref := range list {
+			parameter := ref.Value
+			if parameter == nil || (parameter.In != openapi3.ParameterInPath && parameter.In != openapi3.ParameterInQuery) {
+				continue
+			}
+			if _, ok := parameters[parameter.Name]; !ok {
+				names = append(names, parameter.Name)
+			}
+			parameters[parameter.Name] = parameter
+		}
+	}
+
+	for _, name := range names {
+		parameter := parameters[name]
+		property := map[string]interface{}{"type": "string"}
+		if parameter.Schema != nil {
+			schema, err := openApiSchema(parameter.Schema, refs)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %s: %w", name, err)
+			}
+			property = schema
+		}
+		if _, ok := property["description"]; !ok && parameter.Description != "" {
+			property["description"] = parameter.Description
+		}
+		properties[name] = property
+		if parameter.Required {
+			required = append(required, name)
+		}
+	}
+
+	if operation.RequestBody != nil && operation.RequestBody.Value != nil {
+		body := operation.RequestBody.Value
+		if media := body.Content.Get("application/json"); media != nil && media.Schema != nil {
+			schema, err := openApiSchema(media.Schema, refs)
+			if err != nil {
+				return nil, fmt.Errorf("request body: %w", err)
+			}
+			if _, ok := schema["description"]; !ok && body.Description != "" {
+				schema["description"] = body.Description
+			}
+			properties[openApiBodyParameter] = schema
+			if body.Required {
+				required = append(required, openApiBodyParameter)
+			}
+		}
+	}
+
+	parametersSchema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		parametersSchema["required"] = required
+	}
+
+	// Component schemas can refer to others, so keep adding them until none are missing
+	defs := make(map[string]interface{})
+	for len(defs) < len(refs) {
+		for name := range refs {
+			if _, ok := defs[name]; ok {
+				continue
+			}
+			component, ok := document.Components.Schemas[name]
+			if !ok || component.Value == nil {
+				return nil, fmt.Errorf("unknown schema %s", name)
+			}
+			def, err := openApiSchema(openapi3.NewSchemaRef("", component.Value), refs)
+			if err != nil {
+				return nil, fmt.Errorf("schema %s: %w", name, err)
+			}
+			defs[name] = def
+		}
+	}
+	if len(defs) > 0 {
+		parametersSchema["$defs"] = defs
+	}
+
+	return parametersSchema, nil
+}
+
+// parseOpenApiTools returns the tools of every operation of an OpenAPI 3 document, ordered by path
+// and method. External references aren't followed.
+func parseOpenApiTools(data []byte) ([]openApiOperation, error) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = false
+	document, err := loader.LoadFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("error reading document: %w", err)
+	}
+
+	if !strings.HasPrefix(document.OpenAPI, "3.") {
+		return nil, fmt.Errorf("only OpenAPI 3 documents are supported")
+	}
+	if document.Paths == nil || document.Paths.Len() == 0 {
+		return nil, fmt.Errorf("the document has no operations")
+	}
+
+	paths := document.Paths.Map()
+	pathNames := make([]string, 0, len(paths))
+	for path := range paths {
+		pathNames = append(pathNames, path)
+	}
+	slices.Sort(pathNames)
+
+	operations := make([]openApiOperation, 0)
+	seen := make(map[string]string)
+	for _, path := range pathNames {
+		pathItem := paths[path]
+		for _, method := range openApiMethods {
+			operation := pathItem.GetOperation(method)
+			if operation == nil {
+				continue
+			}
+
+			name := openApiToolName(method, path, operation)
+			if name == "" {
+				return nil, fmt.Errorf("%s %s has no usable tool name", method, path)
+			}
+			if other, ok := seen[name]; ok {
+				return nil, fmt.Errorf("%s %s and %s are both named %s", other, method, path, name)
+			}
+			seen[name] = method + " " + path
+
+			parameters, err := openApiParameters(document, pathItem, operation)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", method, path, err)
+			}
+
+			description := operation.Summary
+			if description == "" {
+				description = operation.Description
+			}
+			if description == "" {
+				description = method + " " + path
+			}
+
+			riskTier := openApiRiskTier(method, operation)
+			attributes := map[string]interface{}{
+				"http_method": method,
+				"http_path":   path,
+				"risk_tier":   string(riskTier),
+			}
+			switch method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+				attributes["reversible"] = true
+			case http.MethodDelete:
+				attributes["reversible"] = false
+			}
+
+			operations = append(operations, openApiOperation{
+				method:   method,
+				path:     path,
+				riskTier: riskTier,
+				tool: toolDefinition{
+					Attributes:        attributes,
+					Name:              name,
+					Description:       description,
+					IgnoredAttributes: []string{},
+					Parameters:        &parameters,
+				},
+			})
+		}
+	}
+
+	return operations, nil
+}
+
+func apiImportRunOpenApiToolsHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request OpenApiToolImport
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxOpenApiDocumentBytes)).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	operations, err := parseOpenApiTools([]byte(request.Document))
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid OpenAPI document", err.Error())
+		return
+	}
+
+	if request.Operations != nil {
+		names := make(map[string]bool)
+		for _, operation := range operations {
+			names[operation.tool.Name] = true
+		}
+		for _, name := range *request.Operations {
+			if !names[name] {
+				sendErrorResponse(w, http.StatusBadRequest, "invalid OpenAPI document", fmt.Sprintf("the document has no operation named %s", name))
+				return
+			}
+		}
+		operations = slices.DeleteFunc(operations, func(operation openApiOperation) bool {
+			return !slices.Contains(*request.Operations, operation.tool.Name)
+		})
+	}
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	project, err := getProjectForRun(ctx, runId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project for run", err.Error())
+		return
+	}
+
+	killSwitch, err := getActiveKillSwitch(ctx, project, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting kill switch", err.Error())
+		return
+	}
+
+	if killSwitch != nil {
+		sendHaltedResponse(w, killSwitch)
+		return
+	}
+
+	if !admitUnpausedRun(ctx, w, runId, store) {
+		return
+	}
+
+	var agent *Agent
+	if run.AgentId != nil {
+		agent, err = store.GetAgent(ctx, *run.AgentId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting agent", err.Error())
+			return
+		}
+	}
+
+	result := OpenApiToolImportResult{Tools: make([]OpenApiTool, 0), Skipped: make([]SkippedOpenApiOperation, 0)}
+	for _, operation := range operations {
+		// Each tool goes through the project's ingestion hooks like tools registered one at a time
+		body, err := json.Marshal(operation.tool)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error marshalling tool", err.Error())
+			return
+		}
+
+		body, err = transformToolPayload(ctx, project, runId, body, store)
+		if err != nil {
+			sendIngestionHookError(w, err)
+			return
+		}
+
+		var t toolDefinition
+		if err := json.Unmarshal(body, &t); err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error unmarshalling tool", err.Error())
+			return
+		}
+
+		if agent != nil && !agentAllowsTool(*agent, t.Name) {
+			result.Skipped = append(result.Skipped, SkippedOpenApiOperation{
+				ToolName: t.Name,
+				Method:   operation.method,
+				Path:     operation.path,
+				Reason:   fmt.Sprintf("agent %s version %s is not allowed to use tool %s", agent.Name, agent.Version, t.Name),
+			})
+			continue
+		}
+
+		if t.Parameters != nil {
+			if err := validateToolParameters(*t.Parameters); err != nil {
+				sendErrorResponse(w, http.StatusBadRequest, "invalid tool parameters", fmt.Sprintf("%s: %s", t.Name, err.Error()))
+				return
+			}
+		}
+
+		tool, err := store.CreateTool(ctx, runId, t.Attributes, t.Name, t.Description, t.IgnoredAttributes, t.Code, t.Parameters)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error creating tool", err.Error())
+			return
+		}
+
+		if tool == nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error creating tool", "tool is nil")
+			return
+		}
+
+		if _, err := applyToolPolicy(ctx, *tool, store); err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error applying tool policy", err.Error())
+			return
+		}
+
+		result.Tools = append(result.Tools, OpenApiTool{Tool: *tool, Method: operation.method, Path: operation.path, RiskTier: operation.riskTier})
+	}
+
+	respondJSON(w, result, http.StatusCreated)
+}
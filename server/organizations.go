@@ -0,0 +1,309 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// riskTierRank orders risk tiers so a locked policy's tier can act as a floor
+var riskTierRank = map[RiskTier]int{
+	Low:      0,
+	Medium:   1,
+	High:     2,
+	Critical: 3,
+}
+
+// ResolveEffectiveToolPolicy merges the policy a project inherits from its organization with the
+// project's own. The more specific tool name wins, and at equal specificity the project overrides
+// the organization, unless the organization policy is locked. A locked policy can only be
+// strengthened: its chains are always kept, and the project can add chains or raise the risk tier.
+func ResolveEffectiveToolPolicy(organizationPolicies []ToolPolicy, projectPolicies []ToolPolicy, toolName string) *ToolPolicy {
+	inherited := ResolveToolPolicy(organizationPolicies, toolName)
+	own := ResolveToolPolicy(projectPolicies, toolName)
+
+	if inherited == nil {
+		return own
+	}
+	if own == nil {
+		return inherited
+	}
+
+	locked := inherited.Locked != nil && *inherited.Locked
+	if !locked {
+		if inherited.ToolName != WildcardToolName && own.ToolName == WildcardToolName {
+			return inherited
+		}
+		return own
+	}
+
+	merged := ToolPolicy{
+		ToolName: inherited.ToolName,
+		RiskTier: inherited.RiskTier,
+		Chains:   append([]ChainRequest{}, inherited.Chains...),
+		Locked:   inherited.Locked,
+	}
+	if riskTierRank[own.RiskTier] > riskTierRank[merged.RiskTier] {
+		merged.RiskTier = own.RiskTier
+	}
+
+	for _, chain := range own.Chains {
+		duplicate := false
+		for _, existing := range merged.Chains {
+			if sameChain(existing, chain) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			merged.Chains = append(merged.Chains, chain)
+		}
+	}
+
+	return &merged
+}
+
+func sameChain(a, b ChainRequest) bool {
+	if a.SupervisorIds == nil || b.SupervisorIds == nil {
+		return a.SupervisorIds == b.SupervisorIds
+	}
+	if len(*a.SupervisorIds) != len(*b.SupervisorIds) {
+		return false
+	}
+	for i := range *a.SupervisorIds {
+		if (*a.SupervisorIds)[i] != (*b.SupervisorIds)[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// getInheritedToolPolicies returns the tool policies of a project's organization, or none if the
+// project isn't in one
+func getInheritedToolPolicies(ctx context.Context, project Project, store Store) ([]ToolPolicy, error) {
+	if project.OrganizationId == nil {
+		return []ToolPolicy{}, nil
+	}
+
+	policies, err := store.GetOrganizationToolPolicies(ctx, *project.OrganizationId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting organization tool policies: %w", err)
+	}
+
+	return policies, nil
+}
+
+func apiCreateOrganizationHandler(w http.ResponseWriter, r *http.Request, store OrganizationStore) {
+	ctx := r.Context()
+
+	var request CreateOrganizationJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	if request.Name == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "name is required", "")
+		return
+	}
+
+	existing, err := store.GetOrganizationFromName(ctx, request.Name)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting organization", err.Error())
+		return
+	}
+
+	if existing != nil {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("organization %s already exists", request.Name), existing.Id.String())
+		return
+	}
+
+	organization := Organization{
+		Id:        uuid.New(),
+		Name:      request.Name,
+		CreatedAt: time.Now(),
+	}
+
+	if err := store.CreateOrganization(ctx, organization); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating organization", err.Error())
+		return
+	}
+
+	respondJSON(w, organization.Id.String(), http.StatusCreated)
+}
+
+func apiGetOrganizationsHandler(w http.ResponseWriter, r *http.Request, store OrganizationStore) {
+	organizations, err := store.GetOrganizations(r.Context())
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting organizations", err.Error())
+		return
+	}
+
+	respondJSON(w, organizations, http.StatusOK)
+}
+
+func apiGetOrganizationHandler(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID, store OrganizationStore) {
+	organization, err := store.GetOrganization(r.Context(), organizationId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting organization", err.Error())
+		return
+	}
+
+	if organization == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Organization not found", "")
+		return
+	}
+
+	respondJSON(w, organization, http.StatusOK)
+}
+
+func apiGetOrganizationToolPoliciesHandler(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	organization, err := store.GetOrganization(ctx, organizationId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting organization", err.Error())
+		return
+	}
+
+	if organization == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Organization not found", "")
+		return
+	}
+
+	policies, err := store.GetOrganizationToolPolicies(ctx, organizationId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting organization tool policies", err.Error())
+		return
+	}
+
+	respondJSON(w, policies, http.StatusOK)
+}
+
+func apiSetOrganizationToolPoliciesHandler(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var policies []ToolPolicy
+	if err := json.NewDecoder(r.Body).Decode(&policies); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	organization, err := store.GetOrganization(ctx, organizationId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting organization", err.Error())
+		return
+	}
+
+	if organization == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Organization not found", "")
+		return
+	}
+
+	if err := validateToolPolicies(ctx, policies, true, store); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid tool policy", err.Error())
+		return
+	}
+
+	if err := store.SetOrganizationToolPolicies(ctx, organizationId, policies); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting organization tool policies", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiSetProjectOrganizationHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request SetProjectOrganizationJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if request.OrganizationId != nil {
+		organization, err := store.GetOrganization(ctx, *request.OrganizationId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting organization", err.Error())
+			return
+		}
+
+		if organization == nil {
+			sendErrorResponse(w, http.StatusNotFound, "Organization not found", "")
+			return
+		}
+	}
+
+	if err := store.SetProjectOrganization(ctx, projectId, request.OrganizationId); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting project organization", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiGetProjectEffectiveToolPoliciesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	inherited, err := getInheritedToolPolicies(ctx, *project, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting inherited tool policies", err.Error())
+		return
+	}
+
+	own, err := store.GetProjectToolPolicies(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool policies", err.Error())
+		return
+	}
+
+	toolNames := make(map[string]bool)
+	for _, policy := range append(inherited, own...) {
+		toolNames[policy.ToolName] = true
+	}
+
+	names := make([]string, 0, len(toolNames))
+	for name := range toolNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	effective := make([]ToolPolicy, 0, len(names))
+	for _, name := range names {
+		if policy := ResolveEffectiveToolPolicy(inherited, own, name); policy != nil {
+			resolved := *policy
+			resolved.ToolName = name
+			effective = append(effective, resolved)
+		}
+	}
+
+	respondJSON(w, effective, http.StatusOK)
+}
@@ -0,0 +1,363 @@
+package asteroid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPayloadContentType = "application/json"
+	maxPayloadTemplateBytes   = 16 << 10
+	// maxRenderedPayloadBytes bounds what a template renders, which is otherwise only bounded by the
+	// payload it ranges over
+	maxRenderedPayloadBytes = 256 << 10
+)
+
+var errRenderedPayloadTooLarge = errors.New("rendered payload is too large")
+
+// payloadTemplateFuncs are all a payload template can call besides text/template's built in
+// functions. None of them reach anything but their arguments.
+var payloadTemplateFuncs = template.FuncMap{
+	"json": func(value interface{}) (string, error) {
+		encoded := encodeValue(value)
+		if encoded == nil {
+			return "", fmt.Errorf("can't encode %v as JSON", value)
+		}
+		return *encoded, nil
+	},
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+	"trim":     strings.TrimSpace,
+	"contains": func(substring, text string) bool { return strings.Contains(text, substring) },
+	"replace":  func(old, new, text string) string { return strings.ReplaceAll(text, old, new) },
+	"truncate": func(length int, text string) string {
+		runes := []rune(text)
+		if length < 0 || len(runes) <= length {
+			return text
+		}
+		return string(runes[:length])
+	},
+	"join": func(separator string, items []interface{}) string {
+		texts := make([]string, 0, len(items))
+		for _, item := range items {
+			texts = append(texts, fmt.Sprint(item))
+		}
+		return strings.Join(texts, separator)
+	},
+	"default": func(fallback, value interface{}) interface{} {
+		if value == nil || value == "" {
+			return fallback
+		}
+		return value
+	},
+}
+
+// cappedBuffer fails writes past its limit, so a template can't render without bound
+type cappedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.limit {
+		return 0, errRenderedPayloadTooLarge
+	}
+	return b.Buffer.Write(p)
+}
+
+// parsePayloadTemplate parses a payload template, refusing what could keep it rendering for long:
+// templates calling themselves and ranges over anything but the payload's fields
+func parsePayloadTemplate(source string) (*template.Template, error) {
+	if len(source) > maxPayloadTemplateBytes {
+		return nil, fmt.Errorf("template is longer than %d bytes", maxPayloadTemplateBytes)
+	}
+
+	parsed, err := template.New("payload").Funcs(payloadTemplateFuncs).Parse(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parsed.Templates()) > 1 {
+		return nil, errors.New("templates can't define other templates")
+	}
+	if parsed.Tree == nil {
+		return parsed, nil
+	}
+	if err := checkPayloadTemplateNode(parsed.Tree, parsed.Tree.Root); err != nil {
+		return nil, err
+	}
+
+	return parsed, nil
+}
+
+func checkPayloadTemplateNode(tree *parse.Tree, node parse.Node) error {
+	switch node := node.(type) {
+	case *parse.ListNode:
+		if node == nil {
+			return nil
+		}
+		for _, child := range node.Nodes {
+			if err := checkPayloadTemplateNode(tree, child); err != nil {
+				return err
+			}
+		}
+	case *parse.IfNode:
+		return checkPayloadTemplateBranch(tree, &node.BranchNode)
+	case *parse.WithNode:
+		return checkPayloadTemplateBranch(tree, &node.BranchNode)
+	case *parse.RangeNode:
+		if !rangesOverPayload(node.Pipe) {
+			location, _ := tree.ErrorContext(node)
+			return fmt.Errorf("%s: templates can only range over fields of the payload", location)
+		}
+		return checkPayloadTemplateBranch(tree, &node.BranchNode)
+	case *parse.TemplateNode:
+		location, _ := tree.ErrorContext(node)
+		return fmt.Errorf("%s: templates can't call other templates", location)
+	}
+	return nil
+}
+
+func checkPayloadTemplateBranch(tree *parse.Tree, branch *parse.BranchNode) error {
+	if err := checkPayloadTemplateNode(tree, branch.List); err != nil {
+		return err
+	}
+	return checkPayloadTemplateNode(tree, branch.ElseList)
+}
+
+// rangesOverPayload reports whether a range's pipeline is a field of the payload, like .items, $.items
+// or $item.children, rather than a number or a variable that could hold one
+func rangesOverPayload(pipe *parse.PipeNode) bool {
+	if pipe == nil || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+
+	switch arg := pipe.Cmds[0].Args[0].(type) {
+	case *parse.DotNode, *parse.FieldNode:
+		return true
+	case *parse.VariableNode:
+		return len(arg.Ident) > 1
+	default:
+		return false
+	}
+}
+
+func payloadContentType(payloadTemplate PayloadTemplate) string {
+	if payloadTemplate.ContentType == nil || *payloadTemplate.ContentType == "" {
+		return defaultPayloadContentType
+	}
+	return *payloadTemplate.ContentType
+}
+
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// validatePayloadTemplates checks there's at most one template per target and that each is valid
+func validatePayloadTemplates(templates []PayloadTemplate, projectId uuid.UUID) error {
+	targets := make(map[PayloadTemplateTarget]bool, len(templates))
+	for _, payloadTemplate := range templates {
+		if targets[payloadTemplate.Target] {
+			return fmt.Errorf("duplicate payload template for %s", payloadTemplate.Target)
+		}
+		targets[payloadTemplate.Target] = true
+
+		if _, err := validatePayloadTemplate(payloadTemplate, projectId); err != nil {
+			return fmt.Errorf("%s template: %w", payloadTemplate.Target, err)
+		}
+	}
+
+	return nil
+}
+
+// validatePayloadTemplate renders a template with a sample payload of its target, returning what it
+// rendered
+func validatePayloadTemplate(payloadTemplate PayloadTemplate, projectId uuid.UUID) ([]byte, error) {
+	if payloadTemplate.Target != Webhooks && payloadTemplate.Target != Notifications {
+		return nil, fmt.Errorf("unknown target %s", payloadTemplate.Target)
+	}
+
+	if _, _, err := mime.ParseMediaType(payloadContentType(payloadTemplate)); err != nil {
+		return nil, fmt.Errorf("invalid content_type: %w", err)
+	}
+
+	return renderPayloadTemplate(payloadTemplate, samplePayload(payloadTemplate.Target, projectId))
+}
+
+// samplePayload is a payload like the ones a target's template is rendered with, for templates to be
+// tried on before they're used
+func samplePayload(target PayloadTemplateTarget, projectId uuid.UUID) interface{} {
+	now := time.Now()
+	resultId, toolCallId := uuid.New(), uuid.New()
+	result := &SupervisionResult{
+		Id:                   &resultId,
+		CreatedAt:            now,
+		Decision:             Reject,
+		Reasoning:            "The tool call deletes files outside the workspace",
+		SupervisionRequestId: uuid.New(),
+		ToolcallId:           &toolCallId,
+	}
+
+	if target == Notifications {
+		return Notification{Event: Rejected, ProjectId: projectId, Result: result, SentAt: now}
+	}
+
+	runId := uuid.New()
+	return WebhookPayload{
+		Id:         uuid.New(),
+		Event:      DecisionMade,
+		OccurredAt: now,
+		ProjectId:  projectId,
+		RunId:      &runId,
+		ToolCallId: &toolCallId,
+		Result:     result,
+	}
+}
+
+// renderPayloadTemplate executes a template with a payload, decoded from the JSON it'd be sent as
+// without the template
+func renderPayloadTemplate(payloadTemplate PayloadTemplate, payload interface{}) ([]byte, error) {
+	parsed, err := parsePayloadTemplate(payloadTemplate.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %w", err)
+	}
+
+	output := &cappedBuffer{limit: maxRenderedPayloadBytes}
+	if err := parsed.Execute(output, decodeArguments(string(encoded))); err != nil {
+		return nil, fmt.Errorf("error rendering payload template: %w", err)
+	}
+
+	if isJSONContentType(payloadContentType(payloadTemplate)) && !json.Valid(output.Bytes()) {
+		return nil, errors.New("payload template didn't render valid JSON")
+	}
+
+	return output.Bytes(), nil
+}
+
+// renderPayload returns the body and content type a payload is sent with, rendered with the project's
+// template for the target if it has one
+func renderPayload(ctx context.Context, projectId uuid.UUID, target PayloadTemplateTarget, payload interface{}, store WebhookStore) ([]byte, string, error) {
+	templates, err := store.GetProjectPayloadTemplates(ctx, projectId)
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting payload templates: %w", err)
+	}
+
+	for _, payloadTemplate := range templates {
+		if payloadTemplate.Target != target {
+			continue
+		}
+		body, err := renderPayloadTemplate(payloadTemplate, payload)
+		if err != nil {
+			return nil, "", err
+		}
+		return body, payloadContentType(payloadTemplate), nil
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, "", fmt.Errorf("error marshalling payload: %w", err)
+	}
+	return body, defaultPayloadContentType, nil
+}
+
+func apiGetProjectPayloadTemplatesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	templates, err := store.GetProjectPayloadTemplates(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting payload templates", err.Error())
+		return
+	}
+
+	respondJSON(w, templates, http.StatusOK)
+}
+
+func apiSetProjectPayloadTemplatesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var templates []PayloadTemplate
+	if err := json.NewDecoder(r.Body).Decode(&templates); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := validatePayloadTemplates(templates, projectId); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid payload template", err.Error())
+		return
+	}
+
+	if err := store.SetProjectPayloadTemplates(ctx, projectId, templates); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting payload templates", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiPreviewPayloadTemplateHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var payloadTemplate PayloadTemplate
+	if err := json.NewDecoder(r.Body).Decode(&payloadTemplate); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	body, err := validatePayloadTemplate(payloadTemplate, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid payload template", err.Error())
+		return
+	}
+
+	respondJSON(w, PayloadTemplatePreview{ContentType: payloadContentType(payloadTemplate), Body: string(body)}, http.StatusOK)
+}
@@ -0,0 +1,565 @@
+package asteroid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const planResource = "plan"
+
+// flattenArguments collects the leaf values of decoded JSON arguments by their path, encoded as JSON
+func flattenArguments(path string, value interface{}, leaves map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			flattenArguments(path+"/"+key, field, leaves)
+		}
+	default:
+		encoded, _ := json.Marshal(v)
+		leaves[path] = string(encoded)
+	}
+}
+
+// decodeArguments decodes the JSON arguments of a tool call, or returns nil if they aren't valid JSON
+func decodeArguments(arguments string) interface{} {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(arguments)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil
+	}
+	return value
+}
+
+// argumentSimilarity returns the share of argument values a tool call agrees with a planned step on,
+// from 0 to 1. JSON objects are compared by the values at each of their paths, anything else as a whole.
+func argumentSimilarity(planned string, actual string) float64 {
+	plannedValue, actualValue := decodeArguments(planned), decodeArguments(actual)
+	if plannedValue == nil || actualValue == nil {
+		if planned == actual {
+			return 1
+		}
+		return 0
+	}
+
+	plannedLeaves, actualLeaves := map[string]string{}, map[string]string{}
+	flattenArguments("", plannedValue, plannedLeaves)
+	flattenArguments("", actualValue, actualLeaves)
+
+	paths := make(map[string]bool, len(plannedLeaves)+len(actualLeaves))
+	for path := range plannedLeaves {
+		paths[path] = true
+	}
+	for path := range actualLeaves {
+		paths[path] = true
+	}
+
+	// Two empty objects flatten to nothing and agree on everything
+	if len(paths) == 0 {
+		return 1
+	}
+
+	agreed := 0
+	for path := range paths {
+		plannedLeaf, ok := plannedLeaves[path]
+		if ok && plannedLeaf == actualLeaves[path] {
+			agreed++
+		}
+	}
+	return float64(agreed) / float64(len(paths))
+}
+
+// toolCallArguments returns the arguments of a tool call, empty if it has none
+func toolCallArguments(toolCall AsteroidToolCall) string {
+	if toolCall.Arguments == nil {
+		return ""
+	}
+	return *toolCall.Arguments
+}
+
+// storedToolCallArguments returns the arguments of a tool call read from the store, whose Arguments
+// are the stored record of the call rather than its arguments, nil if it has none
+func storedToolCallArguments(toolCall AsteroidToolCall) *string {
+	if toolCall.Arguments == nil {
+		return nil
+	}
+
+	var record struct {
+		Arguments *string `json:"arguments"`
+	}
+	if err := json.Unmarshal([]byte(*toolCall.Arguments), &record); err != nil {
+		return nil
+	}
+	return record.Arguments
+}
+
+// stepMatches reports whether a tool call is what a step of a plan intended
+func stepMatches(plan Plan, step PlanStep, toolCall AsteroidToolCall) bool {
+	if toolCall.Name == nil || *toolCall.Name != step.ToolName {
+		return false
+	}
+	// A small margin so a tolerance like 0.1 isn't missed by floating point error
+	return 1-argumentSimilarity(step.Arguments, toolCallArguments(toolCall)) <= plan.ArgumentTolerance+1e-9
+}
+
+// activePlan returns the plan a run's tool calls are compared with: the latest decided plan with an
+// approved step, or nil if the run has none
+func activePlan(plans []Plan) *Plan {
+	for i := len(plans) - 1; i >= 0; i-- {
+		if plans[i].DecidedAt == nil {
+			continue
+		}
+		for _, step := range plans[i].Steps {
+			if step.Decision != nil && *step.Decision == Approve {
+				return &plans[i]
+			}
+		}
+	}
+	return nil
+}
+
+// openPlanSteps returns the approved steps of a plan that haven't covered a tool call yet, in order
+func openPlanSteps(plan *Plan) []*PlanStep {
+	open := make([]*PlanStep, 0, len(plan.Steps))
+	for i := range plan.Steps {
+		step := &plan.Steps[i]
+		if step.Decision != nil && *step.Decision == Approve && step.ToolCallId == nil {
+			open = append(open, step)
+		}
+	}
+	return open
+}
+
+// recordPlanSteps checks each tool call of a chat against its run's active plan. A tool call that
+// matches the next open step is bound to it, anything else is recorded as a deviation. Failing either
+// only means the tool call is supervised as usual, so errors are only logged.
+func recordPlanSteps(ctx context.Context, runId uuid.UUID, choices []AsteroidChoice, store PlanStore) {
+	plans, err := store.GetRunPlans(ctx, runId)
+	if err != nil {
+		log.Printf("Error getting plans of run %s: %v", runId, err)
+		return
+	}
+
+	plan := activePlan(plans)
+	if plan == nil {
+		return
+	}
+
+	for _, choice := range choices {
+		if choice.Message.ToolCalls == nil {
+			continue
+		}
+		for _, toolCall := range *choice.Message.ToolCalls {
+			checkPlanStep(ctx, plan, toolCall, store)
+		}
+	}
+}
+
+// checkPlanStep binds a tool call to the next open step of a plan if it matches it, and records how
+// it deviates from the plan otherwise
+func checkPlanStep(ctx context.Context, plan *Plan, toolCall AsteroidToolCall, store PlanStore) {
+	for {
+		open := openPlanSteps(plan)
+		matched := -1
+		for i, step := range open {
+			if stepMatches(*plan, *step, toolCall) {
+				matched = i
+				break
+			}
+		}
+
+		if matched != 0 {
+			deviation := planDeviation(*plan, open, matched, toolCall)
+			if err := store.CreatePlanDeviation(ctx, deviation); err != nil {
+				log.Printf("Error recording deviation of tool call %s from plan %s: %v", toolCall.Id, plan.Id, err)
+			}
+			return
+		}
+
+		step := open[0]
+		bound, err := store.BindPlanStep(ctx, plan.Id, step.Position, toolCall.Id)
+		if err != nil {
+			log.Printf("Error binding tool call %s to step %d of plan %s: %v", toolCall.Id, step.Position, plan.Id, err)
+			return
+		}
+
+		if bound {
+			toolCallId := toolCall.Id
+			step.ToolCallId = &toolCallId
+			return
+		}
+
+		// Another chat of the run took the step first, so check against the rest of the plan
+		taken := uuid.Nil
+		step.ToolCallId = &taken
+	}
+}
+
+// planDeviation describes how a tool call strays from a plan, given its open steps and the one the
+// tool call matched, if any. A tool call that matches none is compared with the open step of its tool
+// whose arguments are closest.
+func planDeviation(plan Plan, open []*PlanStep, matched int, toolCall AsteroidToolCall) PlanDeviation {
+	deviation := PlanDeviation{
+		Id:         uuid.New(),
+		PlanId:     plan.Id,
+		ToolCallId: toolCall.Id,
+		Kind:       UnplannedTool,
+		CreatedAt:  time.Now(),
+	}
+	if len(open) > 0 {
+		expected := open[0].Position
+		deviation.ExpectedPosition = &expected
+	}
+
+	arguments := toolCallArguments(toolCall)
+	var compared *PlanStep
+	similarity := -1.0
+	if matched > 0 {
+		deviation.Kind = OutOfOrder
+		compared = open[matched]
+		similarity = argumentSimilarity(compared.Arguments, arguments)
+	} else {
+		for _, step := range open {
+			if toolCall.Name == nil || *toolCall.Name != step.ToolName {
+				continue
+			}
+			if stepSimilarity := argumentSimilarity(step.Arguments, arguments); stepSimilarity > similarity {
+				compared, similarity = step, stepSimilarity
+			}
+		}
+		if compared != nil {
+			deviation.Kind = ArgumentMismatch
+		}
+	}
+
+	if compared != nil {
+		position := compared.Position
+		deviation.StepPosition = &position
+		deviation.Similarity = &similarity
+		diff := DiffWords(compared.Arguments, arguments)
+		deviation.Diff = &diff
+	}
+
+	return deviation
+}
+
+// getPlanStepForToolCall returns the approved plan step that covers a tool call, or nil if it isn't
+// covered by one
+func getPlanStepForToolCall(ctx context.Context, toolCallId uuid.UUID, store Store) (*Plan, *PlanStep, error) {
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting tool call: %w", err)
+	}
+	if toolCall == nil {
+		return nil, nil, nil
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting tool: %w", err)
+	}
+	if tool == nil {
+		return nil, nil, nil
+	}
+
+	plans, err := store.GetRunPlans(ctx, tool.RunId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting plans: %w", err)
+	}
+
+	for i := range plans {
+		for j := range plans[i].Steps {
+			step := plans[i].Steps[j]
+			if step.ToolCallId != nil && *step.ToolCallId == toolCallId && step.Decision != nil && *step.Decision == Approve {
+				return &plans[i], &step, nil
+			}
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// approvePlannedSupervisionRequest approves a supervision request for a tool call that an approved
+// plan step covers, and reports whether it did
+func approvePlannedSupervisionRequest(ctx context.Context, supervisionRequestId uuid.UUID, toolCallId uuid.UUID, store Store) (bool, error) {
+	plan, step, err := getPlanStepForToolCall(ctx, toolCallId, store)
+	if err != nil || step == nil {
+		return false, err
+	}
+
+	result := SupervisionResult{
+		CreatedAt:            time.Now(),
+		Decision:             Approve,
+		Reasoning:            fmt.Sprintf("Approved as step %d of plan %s, which was reviewed before the run made the tool call", step.Position, plan.Id),
+		SupervisionRequestId: supervisionRequestId,
+	}
+
+	// A decision that beat us to it stands, so a conflict isn't an error here
+	if _, _, err := resolveSupervisionRequest(ctx, supervisionRequestId, result, SystemActor, store); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// escalatePlanDeviation escalates an automated supervisor's approval of a tool call that deviates from
+// its run's approved plan, keeping the decision it gave, so someone looks at the deviation. The last
+// supervisor of a chain has no one to escalate to, so its results stand.
+func escalatePlanDeviation(ctx context.Context, supervisionRequestId uuid.UUID, supervisor Supervisor, result *SupervisionResult, store Store) error {
+	if !isAutomated(supervisor.Type) || (result.Decision != Approve && result.Decision != Modify) {
+		return nil
+	}
+
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, supervisionRequestId, store)
+	if err != nil || toolCallId == nil {
+		return err
+	}
+
+	deviation, err := store.GetToolCallPlanDeviation(ctx, *toolCallId)
+	if err != nil {
+		return fmt.Errorf("error getting plan deviation: %w", err)
+	}
+	if deviation == nil {
+		return nil
+	}
+
+	supervisionRequest, chain, err := getSupervisionRequestChain(ctx, supervisionRequestId, store)
+	if err != nil || chain == nil || supervisionRequest.PositionInChain >= len(chain.Supervisors)-1 {
+		return err
+	}
+
+	decision := result.Decision
+	result.OverriddenDecision = &decision
+	result.Decision = Escalate
+	return nil
+}
+
+// validatePlanDecision checks a plan or a step can be decided something. Plans are only approved
+// or rejected, anything else is decided when the tool call is made.
+func validatePlanDecision(decision Decision) error {
+	switch decision {
+	case Approve, Reject:
+		return nil
+	default:
+		return fmt.Errorf("plans can't be decided %s", decision)
+	}
+}
+
+func apiCreateRunPlanHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request PlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if len(request.Steps) == 0 {
+		sendErrorResponse(w, http.StatusBadRequest, "a plan needs at least one step", "")
+		return
+	}
+
+	tolerance := 0.0
+	if request.ArgumentTolerance != nil {
+		tolerance = *request.ArgumentTolerance
+	}
+	if tolerance < 0 || tolerance > 1 {
+		sendErrorResponse(w, http.StatusBadRequest, "argument_tolerance must be between 0 and 1", "")
+		return
+	}
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	plan := Plan{
+		Id:                uuid.New(),
+		RunId:             runId,
+		ArgumentTolerance: tolerance,
+		Steps:             make([]PlanStep, 0, len(request.Steps)),
+		CreatedAt:         time.Now(),
+	}
+	for i, step := range request.Steps {
+		if step.ToolName == "" {
+			sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("step %d needs a tool_name", i), "")
+			return
+		}
+		if !json.Valid([]byte(step.Arguments)) {
+			sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("arguments of step %d must be JSON", i), "")
+			return
+		}
+
+		plan.Steps = append(plan.Steps, PlanStep{
+			Position:    i,
+			ToolName:    step.ToolName,
+			Arguments:   step.Arguments,
+			Description: step.Description,
+		})
+	}
+
+	if err := store.CreatePlan(ctx, plan); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating plan", err.Error())
+		return
+	}
+
+	respondJSON(w, plan, http.StatusCreated)
+}
+
+func apiGetRunPlansHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	plans, err := store.GetRunPlans(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting plans", err.Error())
+		return
+	}
+
+	respondJSON(w, plans, http.StatusOK)
+}
+
+func apiGetPlanHandler(w http.ResponseWriter, r *http.Request, planId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	plan, err := store.GetPlan(ctx, planId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting plan", err.Error())
+		return
+	}
+
+	if plan == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Plan not found", "")
+		return
+	}
+
+	respondJSON(w, plan, http.StatusOK)
+}
+
+func apiDecidePlanHandler(w http.ResponseWriter, r *http.Request, planId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request PlanDecision
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validatePlanDecision(request.Decision); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid decision", err.Error())
+		return
+	}
+
+	plan, err := store.GetPlan(ctx, planId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting plan", err.Error())
+		return
+	}
+
+	if plan == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Plan not found", "")
+		return
+	}
+
+	if plan.DecidedAt != nil {
+		sendErrorResponse(w, http.StatusConflict, "Plan already decided", "")
+		return
+	}
+
+	stepDecisions := make(map[int]Decision)
+	if request.Steps != nil {
+		for _, step := range *request.Steps {
+			if step.Position < 0 || step.Position >= len(plan.Steps) {
+				sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("plan has no step %d", step.Position), "")
+				return
+			}
+			if err := validatePlanDecision(step.Decision); err != nil {
+				sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid decision for step %d", step.Position), err.Error())
+				return
+			}
+			stepDecisions[step.Position] = step.Decision
+		}
+	}
+
+	actor := actorFromContext(ctx)
+	now := time.Now()
+	plan.DecidedBy = &actor
+	plan.DecidedAt = &now
+	plan.Reasoning = request.Reasoning
+
+	approved := 0
+	for i := range plan.Steps {
+		decision := request.Decision
+		if stepDecision, ok := stepDecisions[plan.Steps[i].Position]; ok {
+			decision = stepDecision
+		}
+		plan.Steps[i].Decision = &decision
+		if decision == Approve {
+			approved++
+		}
+	}
+
+	decided, err := store.DecidePlan(ctx, *plan)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error deciding plan", err.Error())
+		return
+	}
+
+	if !decided {
+		sendErrorResponse(w, http.StatusConflict, "Plan already decided", "")
+		return
+	}
+
+	recordAuditEvent(ctx, actor, AuditActionPlanDecided, planResource, planId, map[string]interface{}{
+		"run_id":         plan.RunId,
+		"steps":          len(plan.Steps),
+		"approved_steps": approved,
+	}, store)
+
+	respondJSON(w, plan, http.StatusOK)
+}
+
+func apiGetPlanDeviationsHandler(w http.ResponseWriter, r *http.Request, planId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	plan, err := store.GetPlan(ctx, planId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting plan", err.Error())
+		return
+	}
+
+	if plan == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Plan not found", "")
+		return
+	}
+
+	deviations, err := store.GetPlanDeviations(ctx, planId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting plan deviations", err.Error())
+		return
+	}
+
+	respondJSON(w, deviations, http.StatusOK)
+}
@@ -0,0 +1,263 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// adviceSeverity orders likely decisions from least to most severe. A modified call still goes
+// ahead, just not as asked, so modify sits between approve and escalate.
+var adviceSeverity = map[Decision]int{
+	Approve:   0,
+	Modify:    1,
+	Escalate:  2,
+	Reject:    3,
+	Terminate: 4,
+}
+
+// advisoryVerdict is what a supervisor would likely decide, or a nil decision if that can't be predicted
+type advisoryVerdict struct {
+	decision   *Decision
+	confidence *float64
+	reasoning  string
+}
+
+// predictFromHistory predicts a supervisor's decision on a tool from the decision it most often made on it before
+func predictFromHistory(ctx context.Context, supervisor Supervisor, toolName string, store Store) (advisoryVerdict, error) {
+	counts, err := store.GetSupervisorToolDecisions(ctx, *supervisor.Id, toolName)
+	if err != nil {
+		return advisoryVerdict{}, fmt.Errorf("error getting past decisions: %w", err)
+	}
+
+	total := 0
+	var likely Decision
+	for decision, count := range counts {
+		total += count
+		// Ties go to the more severe decision, so plans don't count on an approval that's a coin toss
+		if count > counts[likely] || (count == counts[likely] && adviceSeverity[decision] > adviceSeverity[likely]) {
+			likely = decision
+		}
+	}
+	if total == 0 {
+		return advisoryVerdict{reasoning: fmt.Sprintf("%s never decided %s before", supervisor.Name, toolName)}, nil
+	}
+
+	confidence := float64(counts[likely]) / float64(total)
+	return advisoryVerdict{
+		decision:   &likely,
+		confidence: &confidence,
+		reasoning:  fmt.Sprintf("%s decided %d of its %d past %s calls %s", supervisor.Name, counts[likely], total, toolName, likely),
+	}, nil
+}
+
+// askEnsembleAdvisory asks an ensemble supervisor's members about a tool call without recording
+// their verdicts, with a prompt variant's prompt if one is given
+func askEnsembleAdvisory(ctx context.Context, supervisor Supervisor, tool Tool, arguments *string, variant *PromptVariant, judge Judge) (advisoryVerdict, error) {
+	if judge == nil {
+		return advisoryVerdict{reasoning: fmt.Sprintf("%s can't be asked, no judge model is configured", supervisor.Name)}, nil
+	}
+
+	members, err := parseEnsembleMembers(supervisor.Attributes)
+	if err != nil {
+		return advisoryVerdict{}, err
+	}
+	members = withPromptVariant(members, variant)
+
+	verdicts := askEnsemble(ctx, judge, members, toolCallSubject(tool, arguments), uuid.Nil)
+	aggregation := ensembleAggregation(supervisor.Attributes)
+	decision, confidence := aggregateVerdicts(verdicts, aggregation)
+	explanation := ensembleExplanation(verdicts, aggregation, decision, confidence)
+
+	return advisoryVerdict{decision: &decision, confidence: &confidence, reasoning: *explanation.Rationale}, nil
+}
+
+// withChainConfidence escalates a verdict below its chain's confidence threshold, like a live result
+// would be unless its supervisor is the last of the chain
+func withChainConfidence(chain SupervisorChain, position int, verdict advisoryVerdict) advisoryVerdict {
+	if verdict.decision == nil || *verdict.decision == Escalate || verdict.confidence == nil {
+		return verdict
+	}
+	if chain.MinConfidence != nil && position < len(chain.Supervisors)-1 && *verdict.confidence < *chain.MinConfidence {
+		escalate := Escalate
+		verdict.reasoning = fmt.Sprintf("%s, below the chain's confidence threshold of %v", verdict.reasoning, *chain.MinConfidence)
+		verdict.decision = &escalate
+	}
+	return verdict
+}
+
+// adviseSupervisor predicts what one supervisor of a chain would decide on a tool call
+func adviseSupervisor(ctx context.Context, chain SupervisorChain, position int, tool Tool, arguments *string, judge Judge, store Store) (advisoryVerdict, error) {
+	supervisor := chain.Supervisors[position]
+
+	switch supervisor.Type {
+	case NoSupervisor:
+		approve := Approve
+		return advisoryVerdict{decision: &approve, reasoning: fmt.Sprintf("%s doesn't supervise", supervisor.Name)}, nil
+	case ConsentSupervisor:
+		return advisoryVerdict{reasoning: fmt.Sprintf("%s needs the consent of the end user", supervisor.Name)}, nil
+	case ServicenowSupervisor:
+		return advisoryVerdict{reasoning: fmt.Sprintf("%s needs the approval of a ServiceNow change request", supervisor.Name)}, nil
+	case EnsembleSupervisor:
+		// The variant is picked like it would be for live traffic
+		variants, err := parsePromptVariants(supervisor.Attributes)
+		if err != nil {
+			return advisoryVerdict{}, err
+		}
+		verdict, err := askEnsembleAdvisory(ctx, supervisor, tool, arguments, choosePromptVariant(variants, uuid.New()), judge)
+		return withChainConfidence(chain, position, verdict), err
+	case LlmSupervisor:
+		verdict, err := askLlmAdvisory(ctx, supervisor, tool, arguments, judge)
+		return withChainConfidence(chain, position, verdict), err
+	case PolicySupervisor:
+		events, err := store.GetRunEvents(ctx, tool.RunId)
+		if err != nil {
+			return advisoryVerdict{}, fmt.Errorf("error getting run events: %w", err)
+		}
+		decision, explanation, err := evaluatePolicy(supervisor, events, time.Now())
+		if err != nil {
+			return advisoryVerdict{}, err
+		}
+		return advisoryVerdict{decision: &decision, reasoning: *explanation.Rationale}, nil
+	case ComputerUseSupervisor:
+		decision, explanation, err := evaluateComputerUse(supervisor, arguments)
+		if err != nil {
+			return advisoryVerdict{}, err
+		}
+		return advisoryVerdict{decision: &decision, reasoning: *explanation.Rationale}, nil
+	case ClientSupervisor, HumanSupervisor:
+		return predictFromHistory(ctx, supervisor, tool.Name, store)
+	default:
+		return advisoryVerdict{}, fmt.Errorf("unknown supervisor type: %s", supervisor.Type)
+	}
+}
+
+// adviseChain walks a chain like a live tool call would, up to the first supervisor that would likely
+// decide. An argument rule that matches decides before any supervisor.
+func adviseChain(ctx context.Context, chain SupervisorChain, tool Tool, arguments *string, rules []ArgumentRule, judge Judge, store Store) (PreapprovalChain, error) {
+	advice := PreapprovalChain{ChainId: chain.ChainId}
+
+	if rule := matchArgumentRule(rules, tool.Name, chain.ChainId, arguments); rule != nil {
+		decision, confidence := rule.Decision, 1.0
+		advice.LikelyDecision = &decision
+		advice.Confidence = &confidence
+		advice.Reasoning = *argumentRuleExplanation(*rule).Rationale
+		return advice, nil
+	}
+
+	for position, supervisor := range chain.Supervisors {
+		verdict, err := adviseSupervisor(ctx, chain, position, tool, arguments, judge, store)
+		if err != nil {
+			return advice, err
+		}
+
+		advice.SupervisorId = supervisor.Id
+		advice.LikelyDecision = verdict.decision
+		advice.Confidence = verdict.confidence
+		advice.Reasoning = verdict.reasoning
+		if verdict.decision == nil || *verdict.decision != Escalate {
+			return advice, nil
+		}
+	}
+
+	if len(chain.Supervisors) == 0 {
+		approve := Approve
+		advice.LikelyDecision = &approve
+		advice.Reasoning = "the chain has no supervisors"
+	}
+	return advice, nil
+}
+
+// preapprove predicts how a call of a tool with some arguments would be decided, without making it
+func preapprove(ctx context.Context, tool Tool, arguments *string, judge Judge, store Store) (*Preapproval, error) {
+	chains, err := store.GetSupervisorChains(ctx, *tool.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting supervisor chains: %w", err)
+	}
+
+	selected, err := selectChains(ctx, tool, chains, store)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := getProjectForRun(ctx, tool.RunId, store)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []ArgumentRule
+	if project != nil {
+		rules, err = store.GetProjectArgumentRules(ctx, project.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting argument rules: %w", err)
+		}
+	}
+
+	preapproval := Preapproval{ToolName: tool.Name, LikelyDecision: Approve, Chains: make([]PreapprovalChain, 0, len(selected))}
+	for _, chain := range selected {
+		advice, err := adviseChain(ctx, chain, tool, arguments, rules, judge, store)
+		if err != nil {
+			return nil, err
+		}
+		preapproval.Chains = append(preapproval.Chains, advice)
+
+		decision := Escalate
+		if advice.LikelyDecision != nil {
+			decision = *advice.LikelyDecision
+		}
+		if adviceSeverity[decision] > adviceSeverity[preapproval.LikelyDecision] {
+			preapproval.LikelyDecision = decision
+		}
+	}
+
+	return &preapproval, nil
+}
+
+func apiPreapproveToolCallHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store, judge Judge) {
+	ctx := r.Context()
+
+	var request PreapprovalRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if request.ToolName == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "tool_name is required", "")
+		return
+	}
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	tool, err := store.GetToolFromNameAndRunId(ctx, request.ToolName, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool", err.Error())
+		return
+	}
+
+	if tool == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool not found", "")
+		return
+	}
+
+	preapproval, err := preapprove(ctx, *tool, request.Arguments, judge, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error predicting decision", err.Error())
+		return
+	}
+
+	respondJSON(w, preapproval, http.StatusOK)
+}
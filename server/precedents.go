@@ -0,0 +1,271 @@
+package asteroid
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxPrecedents is how many earlier decisions a decision can cite
+	maxPrecedents = 5
+	// promotableCitations is how many agreeing decisions have to cite a precedent before its chain
+	// suggests a rule
+	promotableCitations = 3
+)
+
+// ErrInvalidPrecedent is returned for precedents a decision can't cite
+var ErrInvalidPrecedent = errors.New("invalid precedent")
+
+// checkPrecedents checks the precedents a decision cites are at most maxPrecedents decisions of the
+// supervision request's project, dropping any cited twice. Precedents it can't cite are reported
+// with ErrInvalidPrecedent.
+func checkPrecedents(ctx context.Context, supervisionRequestId uuid.UUID, result *SupervisionResult, store Store) error {
+	if result.Precedents == nil {
+		return nil
+	}
+
+	seen := make(map[uuid.UUID]bool, len(*result.Precedents))
+	precedents := make([]uuid.UUID, 0, len(*result.Precedents))
+	for _, precedent := range *result.Precedents {
+		if !seen[precedent] {
+			seen[precedent] = true
+			precedents = append(precedents, precedent)
+		}
+	}
+
+	if len(precedents) == 0 {
+		result.Precedents = nil
+		return nil
+	}
+
+	if len(precedents) > maxPrecedents {
+		return fmt.Errorf("%w: a decision cites at most %d precedents", ErrInvalidPrecedent, maxPrecedents)
+	}
+
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, supervisionRequestId, store)
+	if err != nil {
+		return err
+	}
+	if toolCallId == nil {
+		return fmt.Errorf("%w: supervision request %s has no tool call", ErrInvalidPrecedent, supervisionRequestId)
+	}
+
+	project, err := getProjectForToolCall(ctx, *toolCallId, store)
+	if err != nil {
+		return err
+	}
+	if project == nil {
+		return fmt.Errorf("%w: tool call %s isn't of a project", ErrInvalidPrecedent, *toolCallId)
+	}
+
+	decisions, err := store.GetPrecedentDecisions(ctx, project.Id, precedents)
+	if err != nil {
+		return err
+	}
+	for _, precedent := range precedents {
+		if !slices.ContainsFunc(decisions, func(decision PrecedentDecision) bool { return decision.ResultId == precedent }) {
+			return fmt.Errorf("%w: %s isn't a decision of project %s", ErrInvalidPrecedent, precedent, project.Id)
+		}
+	}
+
+	result.Precedents = &precedents
+	return nil
+}
+
+// precedentRule returns the rule deciding calls of a precedent's tool with the argument values every
+// decision of its chain agrees on, or nil if they disagree on the tool, the decision or every value
+func precedentRule(precedent PrecedentDecision, citing []PrecedentDecision) *ArgumentRule {
+	if precedent.Decision != Approve && precedent.Decision != Reject {
+		return nil
+	}
+
+	var common map[string]string
+	for _, decision := range append([]PrecedentDecision{precedent}, citing...) {
+		if decision.ToolName != precedent.ToolName || decision.Decision != precedent.Decision {
+			return nil
+		}
+
+		arguments, ok := decodeArguments(stringOrEmpty(decision.Arguments)).(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		leaves := map[string]string{}
+		flattenArguments("", arguments, leaves)
+
+		if common == nil {
+			common = leaves
+			continue
+		}
+		for path, leaf := range common {
+			if leaves[path] != leaf {
+				delete(common, path)
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(common))
+	for path := range common {
+		// A ~ in a key would be read as an escape of the JSON pointer
+		if !strings.Contains(path, "~") {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	slices.Sort(paths)
+
+	conditions := make([]ArgumentCondition, 0, len(paths))
+	for _, path := range paths {
+		// Strings are compared as they are, anything else as its JSON
+		value := common[path]
+		var text string
+		if err := json.Unmarshal([]byte(value), &text); err == nil {
+			value = text
+		}
+		conditions = append(conditions, ArgumentCondition{Path: path, Operator: Equals, Value: &value})
+	}
+
+	return &ArgumentRule{
+		Name:       "precedent-" + precedent.ResultId.String()[:8],
+		ToolName:   precedent.ToolName,
+		Conditions: conditions,
+		Decision:   precedent.Decision,
+	}
+}
+
+// buildPrecedentChains follows the citations of decisions, oldest first, from each precedent that
+// cites none itself. The chains are returned most cited first.
+func buildPrecedentChains(decisions []PrecedentDecision) []PrecedentChain {
+	citedBy := make(map[uuid.UUID][]uuid.UUID)
+	for _, decision := range decisions {
+		for _, precedent := range decision.Precedents {
+			citedBy[precedent] = append(citedBy[precedent], decision.ResultId)
+		}
+	}
+
+	// Decisions only cite earlier ones, so following citations never comes back around
+	depths := make(map[uuid.UUID]int)
+	var depth func(id uuid.UUID) int
+	depth = func(id uuid.UUID) int {
+		if d, ok := depths[id]; ok {
+			return d
+		}
+		d := 0
+		for _, citing := range citedBy[id] {
+			d = max(d, depth(citing)+1)
+		}
+		depths[id] = d
+		return d
+	}
+
+	chains := make([]PrecedentChain, 0)
+	for _, precedent := range decisions {
+		if len(precedent.Precedents) > 0 || len(citedBy[precedent.ResultId]) == 0 {
+			continue
+		}
+
+		inChain := map[uuid.UUID]bool{}
+		pending := slices.Clone(citedBy[precedent.ResultId])
+		for len(pending) > 0 {
+			id := pending[0]
+			pending = pending[1:]
+			if !inChain[id] {
+				inChain[id] = true
+				pending = append(pending, citedBy[id]...)
+			}
+		}
+
+		chain := PrecedentChain{
+			Precedent:       precedent,
+			CitingResultIds: make([]uuid.UUID, 0, len(inChain)),
+			DirectCitations: len(citedBy[precedent.ResultId]),
+			Citations:       len(inChain),
+			Depth:           depth(precedent.ResultId),
+		}
+		citing := make([]PrecedentDecision, 0, len(inChain))
+		agreeing := 0
+		for _, decision := range decisions {
+			if !inChain[decision.ResultId] {
+				continue
+			}
+			citing = append(citing, decision)
+			chain.CitingResultIds = append(chain.CitingResultIds, decision.ResultId)
+			if decision.Decision == precedent.Decision {
+				agreeing++
+			}
+			if decision.DecidedAt.After(chain.LastCitedAt) {
+				chain.LastCitedAt = decision.DecidedAt
+			}
+		}
+		chain.Agreement = float64(agreeing) / float64(len(citing))
+
+		if agreeing >= promotableCitations {
+			chain.SuggestedRule = precedentRule(precedent, citing)
+		}
+		chains = append(chains, chain)
+	}
+
+	slices.SortStableFunc(chains, func(a, b PrecedentChain) int {
+		if a.Citations != b.Citations {
+			return cmp.Compare(b.Citations, a.Citations)
+		}
+		return b.LastCitedAt.Compare(a.LastCitedAt)
+	})
+	return chains
+}
+
+func apiGetProjectPrecedentChainsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	decisions, err := store.GetCitingDecisions(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting citing decisions", err.Error())
+		return
+	}
+
+	// The precedents that cite none themselves aren't among the citing decisions
+	citing := make(map[uuid.UUID]bool, len(decisions))
+	for _, decision := range decisions {
+		citing[decision.ResultId] = true
+	}
+	missing := make([]uuid.UUID, 0)
+	for _, decision := range decisions {
+		for _, precedent := range decision.Precedents {
+			if !citing[precedent] && !slices.Contains(missing, precedent) {
+				missing = append(missing, precedent)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		precedents, err := store.GetPrecedentDecisions(ctx, projectId, missing)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting precedents", err.Error())
+			return
+		}
+		decisions = append(decisions, precedents...)
+		slices.SortStableFunc(decisions, func(a, b PrecedentDecision) int { return a.DecidedAt.Compare(b.DecidedAt) })
+	}
+
+	respondJSON(w, buildPrecedentChains(decisions), http.StatusOK)
+}
@@ -0,0 +1,89 @@
+package asteroid
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ModelPrice is what a model's tokens cost, in US dollars per million
+type ModelPrice struct {
+	Prompt     float64 `json:"prompt"`
+	Completion float64 `json:"completion"`
+}
+
+// cost returns what a number of prompt and completion tokens cost in US dollars
+func (p ModelPrice) cost(promptTokens, completionTokens int) float64 {
+	return (float64(promptTokens)*p.Prompt + float64(completionTokens)*p.Completion) / 1e6
+}
+
+// PricingTable prices models by name. Keys are matched as prefixes of the model a provider reported,
+// longest first, so dated snapshots of a model get its price.
+type PricingTable map[string]ModelPrice
+
+// defaultPricing are the list prices of common models when they were added. Providers change them,
+// so deployments that bill on cost should keep their own table in MODEL_PRICING_FILE.
+var defaultPricing = PricingTable{
+	"gpt-4o":            {Prompt: 2.50, Completion: 10},
+	"gpt-4o-mini":       {Prompt: 0.15, Completion: 0.60},
+	"gpt-4-turbo":       {Prompt: 10, Completion: 30},
+	"gpt-4":             {Prompt: 30, Completion: 60},
+	"gpt-3.5-turbo":     {Prompt: 0.50, Completion: 1.50},
+	"o1":                {Prompt: 15, Completion: 60},
+	"o1-mini":           {Prompt: 1.10, Completion: 4.40},
+	"o3-mini":           {Prompt: 1.10, Completion: 4.40},
+	"claude-3-opus":     {Prompt: 15, Completion: 75},
+	"claude-3-5-sonnet": {Prompt: 3, Completion: 15},
+	"claude-3-7-sonnet": {Prompt: 3, Completion: 15},
+	"claude-3-5-haiku":  {Prompt: 0.80, Completion: 4},
+	"claude-3-haiku":    {Prompt: 0.25, Completion: 1.25},
+	"gemini-1.5-pro":    {Prompt: 1.25, Completion: 5},
+	"gemini-1.5-flash":  {Prompt: 0.075, Completion: 0.30},
+	"gemini-2.0-flash":  {Prompt: 0.10, Completion: 0.40},
+}
+
+// NewPricingTableFromEnv returns the default prices, with the models of the JSON file at
+// MODEL_PRICING_FILE added or replacing theirs. The file maps model names to their prompt and
+// completion prices, e.g. {"gpt-4o": {"prompt": 2.5, "completion": 10}}.
+func NewPricingTableFromEnv() (PricingTable, error) {
+	table := make(PricingTable, len(defaultPricing))
+	for model, price := range defaultPricing {
+		table[model] = price
+	}
+
+	path := os.Getenv("MODEL_PRICING_FILE")
+	if path == "" {
+		return table, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading model pricing file: %w", err)
+	}
+
+	var prices PricingTable
+	if err := json.Unmarshal(data, &prices); err != nil {
+		return nil, fmt.Errorf("error parsing model pricing file: %w", err)
+	}
+	for model, price := range prices {
+		if price.Prompt < 0 || price.Completion < 0 {
+			return nil, fmt.Errorf("negative price for model %s", model)
+		}
+		table[model] = price
+	}
+
+	return table, nil
+}
+
+// price returns the price of a model, and whether it has one
+func (t PricingTable) price(model string) (ModelPrice, bool) {
+	var best ModelPrice
+	bestLen := 0
+	for prefix, price := range t {
+		if strings.HasPrefix(model, prefix) && len(prefix) > bestLen {
+			best, bestLen = price, len(prefix)
+		}
+	}
+	return best, bestLen > 0
+}
@@ -0,0 +1,158 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+)
+
+const (
+	defaultSupervisorConcurrency      = 16
+	defaultBatchSupervisorConcurrency = 4
+)
+
+// runPriorities are the priority classes, in the order their requests are processed
+var runPriorities = []RunPriority{Realtime, Interactive, Batch}
+
+// PriorityLanes bound how many LLM and ensemble supervisors decide requests at once. Realtime and
+// interactive requests can take every slot, while batch requests only get their share, so a
+// backfill leaves room for production traffic.
+type PriorityLanes struct {
+	mutex         sync.Mutex
+	capacity      int
+	batchCapacity int
+	inFlight      map[RunPriority]int
+}
+
+// NewPriorityLanesFromEnv gives automated supervisors SUPERVISOR_CONCURRENCY slots, of which batch
+// requests can take BATCH_SUPERVISOR_CONCURRENCY
+func NewPriorityLanesFromEnv() (*PriorityLanes, error) {
+	capacity, batchCapacity, err := priorityLaneCapacities(os.Getenv)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PriorityLanes{capacity: capacity, batchCapacity: batchCapacity, inFlight: make(map[RunPriority]int)}, nil
+}
+
+// priorityLaneCapacities reads the number of slots, and of those batch requests can take, with getenv
+func priorityLaneCapacities(getenv func(string) string) (int, int, error) {
+	capacity := defaultSupervisorConcurrency
+	if value := getenv("SUPERVISOR_CONCURRENCY"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			return 0, 0, fmt.Errorf("SUPERVISOR_CONCURRENCY must be a positive number")
+		}
+		capacity = parsed
+	}
+
+	batchCapacity := min(defaultBatchSupervisorConcurrency, capacity)
+	if value := getenv("BATCH_SUPERVISOR_CONCURRENCY"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 || parsed > capacity {
+			return 0, 0, fmt.Errorf("BATCH_SUPERVISOR_CONCURRENCY must be a positive number no larger than SUPERVISOR_CONCURRENCY")
+		}
+		batchCapacity = parsed
+	}
+
+	return capacity, batchCapacity, nil
+}
+
+// resize changes the number of slots. Requests in flight keep theirs, so a lane can stay over its new
+// capacity until enough of them finish.
+func (l *PriorityLanes) resize(capacity int, batchCapacity int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.capacity = capacity
+	l.batchCapacity = batchCapacity
+}
+
+// requestPriority returns the priority class of a supervision request, interactive unless its run
+// says otherwise
+func requestPriority(request SupervisionRequest) RunPriority {
+	if request.Priority != nil && (*request.Priority == Batch || *request.Priority == Realtime) {
+		return *request.Priority
+	}
+	return Interactive
+}
+
+func validateRunPriority(priority RunPriority) error {
+	switch priority {
+	case Realtime, Interactive, Batch:
+		return nil
+	default:
+		return fmt.Errorf("unknown priority: %s", priority)
+	}
+}
+
+func (l *PriorityLanes) capacityOf(priority RunPriority) int {
+	if priority == Batch {
+		return l.batchCapacity
+	}
+	return l.capacity
+}
+
+// acquire takes a slot for a request of a priority class, reporting false if there's none free
+func (l *PriorityLanes) acquire(priority RunPriority) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	total := 0
+	for _, count := range l.inFlight {
+		total += count
+	}
+	if total >= l.capacity || l.inFlight[priority] >= l.capacityOf(priority) {
+		return false
+	}
+
+	l.inFlight[priority]++
+	return true
+}
+
+func (l *PriorityLanes) release(priority RunPriority) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.inFlight[priority]--
+}
+
+// getQueueStats returns the review queue of each priority class with the slots the class takes,
+// in the order they're processed
+func getQueueStats(ctx context.Context, lanes *PriorityLanes, store Store) ([]PriorityQueueStats, error) {
+	counted, err := store.GetSupervisionQueueStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byPriority := make(map[RunPriority]PriorityQueueStats)
+	for _, queue := range counted {
+		byPriority[queue.Priority] = queue
+	}
+
+	stats := make([]PriorityQueueStats, 0, len(runPriorities))
+	lanes.mutex.Lock()
+	for _, priority := range runPriorities {
+		queue := byPriority[priority]
+		queue.Priority = priority
+		queue.InFlight = lanes.inFlight[priority]
+		queue.Capacity = lanes.capacityOf(priority)
+		stats = append(stats, queue)
+	}
+	lanes.mutex.Unlock()
+
+	return stats, nil
+}
+
+func apiGetQueueStatsHandler(w http.ResponseWriter, r *http.Request, lanes *PriorityLanes, store Store) {
+	stats, err := getQueueStats(r.Context(), lanes, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting queue stats", err.Error())
+		return
+	}
+
+	respondJSON(w, stats, http.StatusOK)
+}
@@ -12,13 +12,21 @@ import (
 type Processor struct {
 	store           Store
 	humanReviewChan chan SupervisionRequest
+	judge           Judge
+	breakers        *CircuitBreakers
+	lanes           *PriorityLanes
+	serviceNow      *ServiceNowClient
 	interval        time.Duration
 }
 
-func NewProcessor(store Store, humanReviewChan chan SupervisionRequest) *Processor {
+func NewProcessor(store Store, humanReviewChan chan SupervisionRequest, judge Judge, breakers *CircuitBreakers, lanes *PriorityLanes, serviceNow *ServiceNowClient) *Processor {
 	return &Processor{
 		store:           store,
 		humanReviewChan: humanReviewChan,
+		judge:           judge,
+		breakers:        breakers,
+		lanes:           lanes,
+		serviceNow:      serviceNow,
 		interval:        2 * time.Second, // Configurable interval
 	}
 }
@@ -35,10 +43,15 @@ func (p *Processor) Start(ctx context.Context) {
 			if err := p.processPendingSupervisionRequests(ctx); err != nil {
 				log.Printf("Error processing pending reviews: %v", err)
 			}
+			if err := p.expireConsentRequests(ctx); err != nil {
+				log.Printf("Error expiring consent requests: %v", err)
+			}
 		}
 	}
 }
 
+// processPendingSupervisionRequests processes the pending requests of realtime runs, then those of
+// interactive runs and then those of batch runs, each in the order they started waiting
 func (p *Processor) processPendingSupervisionRequests(ctx context.Context) error {
 	supervisorRequests, err := p.store.GetSupervisionRequestsForStatus(ctx, Pending)
 	if err != nil {
@@ -46,7 +59,38 @@ func (p *Processor) processPendingSupervisionRequests(ctx context.Context) error
 	}
 
 	for _, supervisorRequest := range supervisorRequests {
-		if err := p.processReview(ctx, supervisorRequest); err != nil {
+		// Reviews are held while their organization's kill switch is active
+		killSwitch, err := getActiveKillSwitchForSupervisionRequest(ctx, *supervisorRequest.Id, p.store)
+		if err != nil {
+			log.Printf("Error getting kill switch for supervision request %s: %v", *supervisorRequest.Id, err)
+			continue
+		}
+		if killSwitch != nil {
+			continue
+		}
+
+		// and while their run is paused
+		pause, err := getRunPauseForSupervisionRequest(ctx, *supervisorRequest.Id, p.store)
+		if err != nil {
+			log.Printf("Error getting run pause for supervision request %s: %v", *supervisorRequest.Id, err)
+			continue
+		}
+		if pause != nil {
+			continue
+		}
+
+		ready, err := p.dependenciesResolved(ctx, supervisorRequest)
+		if err != nil {
+			log.Printf("Error checking dependencies for supervision request %s: %v", *supervisorRequest.Id, err)
+			continue
+		}
+
+		// Leave it pending until the tool calls it depends on have been decided
+		if !ready {
+			continue
+		}
+
+		if err := p.processReview(reviewContext(ctx, supervisorRequest), supervisorRequest); err != nil {
 			log.Printf("Error processing supervisor %s: %v", *supervisorRequest.Id, err)
 			continue
 		}
@@ -55,6 +99,32 @@ func (p *Processor) processPendingSupervisionRequests(ctx context.Context) error
 	return nil
 }
 
+// dependenciesResolved reports whether every tool call the request's tool call depends on has been
+// decided. If one of them was rejected, the request is rejected along with everything depending on it.
+func (p *Processor) dependenciesResolved(ctx context.Context, supervisionRequest SupervisionRequest) (bool, error) {
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, *supervisionRequest.Id, p.store)
+	if err != nil {
+		return false, err
+	}
+	if toolCallId == nil {
+		return true, nil
+	}
+
+	rejected, undecided, err := checkToolCallDependencies(ctx, *toolCallId, p.store)
+	if err != nil {
+		return false, err
+	}
+
+	if rejected != nil {
+		if err := rejectPendingSupervisionRequests(ctx, *toolCallId, *rejected, p.store); err != nil {
+			return false, err
+		}
+		return false, propagateRejection(ctx, *toolCallId, p.store)
+	}
+
+	return len(undecided) == 0, nil
+}
+
 func (p *Processor) processReview(ctx context.Context, supervisionRequest SupervisionRequest) error {
 	supervisorId := supervisionRequest.SupervisorId
 
@@ -74,6 +144,18 @@ func (p *Processor) processReview(ctx context.Context, supervisionRequest Superv
 		return p.processClientReview(ctx, supervisionRequest)
 	case NoSupervisor:
 		return p.processNoSupervisionReview(ctx, supervisionRequest)
+	case ConsentSupervisor:
+		return p.processConsentReview(ctx, supervisionRequest, *supervisor)
+	case ServicenowSupervisor:
+		return p.processChangeRequestReview(ctx, supervisionRequest, *supervisor)
+	case EnsembleSupervisor:
+		return p.processEnsembleReview(ctx, supervisionRequest, *supervisor)
+	case LlmSupervisor:
+		return p.processLlmReview(ctx, supervisionRequest, *supervisor)
+	case PolicySupervisor:
+		return decideByPolicy(ctx, supervisionRequest, *supervisor, p.store)
+	case ComputerUseSupervisor:
+		return decideComputerUse(ctx, supervisionRequest, *supervisor, p.store)
 	default:
 		return fmt.Errorf("unknown supervisor type: %s", supervisor.Type)
 	}
@@ -107,6 +189,128 @@ func (p *Processor) processNoSupervisionReview(ctx context.Context, supervisionR
 	return nil
 }
 
+// processConsentReview issues a consent link for the end user and waits for their response
+func (p *Processor) processConsentReview(ctx context.Context, supervisionRequest SupervisionRequest, supervisor Supervisor) error {
+	consent, err := issueConsentRequest(ctx, supervisionRequest, supervisor, p.store)
+	if err != nil {
+		return err
+	}
+
+	status := SupervisionStatus{
+		Status:               Assigned,
+		CreatedAt:            time.Now(),
+		SupervisionRequestId: supervisionRequest.Id,
+	}
+
+	if err := p.store.CreateSupervisionStatus(ctx, *supervisionRequest.Id, status); err != nil {
+		return fmt.Errorf("error creating supervision status: %w", err)
+	}
+
+	log.Printf("Consent request %s issued for supervision request %s", consent.Id, *supervisionRequest.Id)
+	return nil
+}
+
+// processChangeRequestReview opens a ServiceNow change request for the request, which the change
+// request poller decides once ServiceNow approves or rejects it
+func (p *Processor) processChangeRequestReview(ctx context.Context, supervisionRequest SupervisionRequest, supervisor Supervisor) error {
+	if p.serviceNow == nil {
+		return fmt.Errorf("supervisor %s needs ServiceNow, but SERVICENOW_INSTANCE_URL is not set", supervisor.Name)
+	}
+
+	changeRequest, err := openChangeRequest(ctx, p.serviceNow, supervisionRequest, supervisor, p.store)
+	if err != nil {
+		return err
+	}
+
+	status := SupervisionStatus{
+		Status:               Assigned,
+		CreatedAt:            time.Now(),
+		SupervisionRequestId: supervisionRequest.Id,
+	}
+
+	if err := p.store.CreateSupervisionStatus(ctx, *supervisionRequest.Id, status); err != nil {
+		return fmt.Errorf("error creating supervision status: %w", err)
+	}
+
+	log.Printf("Change request %s opened for supervision request %s", changeRequest.Number, *supervisionRequest.Id)
+	return nil
+}
+
+// processEnsembleReview assigns the request so later ticks leave it alone, then asks the ensemble
+// in the background, since its members can take a while to answer
+func (p *Processor) processEnsembleReview(ctx context.Context, supervisionRequest SupervisionRequest, supervisor Supervisor) error {
+	// Left pending for a later tick while the request's priority class has no slot free
+	priority := requestPriority(supervisionRequest)
+	if !p.lanes.acquire(priority) {
+		return nil
+	}
+
+	status := SupervisionStatus{
+		Status:               Assigned,
+		CreatedAt:            time.Now(),
+		SupervisionRequestId: supervisionRequest.Id,
+	}
+
+	if err := p.store.CreateSupervisionStatus(ctx, *supervisionRequest.Id, status); err != nil {
+		p.lanes.release(priority)
+		return fmt.Errorf("error creating supervision status: %w", err)
+	}
+
+	go func() {
+		defer p.lanes.release(priority)
+		if err := judgeSupervisionRequest(ctx, supervisionRequest, supervisor, p.judge, p.breakers, p.store); err != nil {
+			log.Printf("Error judging supervision request %s: %v", *supervisionRequest.Id, err)
+		}
+	}()
+
+	return nil
+}
+
+// processLlmReview assigns the request like an ensemble review, then asks the model in the background
+func (p *Processor) processLlmReview(ctx context.Context, supervisionRequest SupervisionRequest, supervisor Supervisor) error {
+	// Left pending for a later tick while the request's priority class has no slot free
+	priority := requestPriority(supervisionRequest)
+	if !p.lanes.acquire(priority) {
+		return nil
+	}
+
+	status := SupervisionStatus{
+		Status:               Assigned,
+		CreatedAt:            time.Now(),
+		SupervisionRequestId: supervisionRequest.Id,
+	}
+
+	if err := p.store.CreateSupervisionStatus(ctx, *supervisionRequest.Id, status); err != nil {
+		p.lanes.release(priority)
+		return fmt.Errorf("error creating supervision status: %w", err)
+	}
+
+	go func() {
+		defer p.lanes.release(priority)
+		if err := decideWithLlm(ctx, supervisionRequest, supervisor, p.judge, p.breakers, p.store); err != nil {
+			log.Printf("Error deciding supervision request %s with an LLM: %v", *supervisionRequest.Id, err)
+		}
+	}()
+
+	return nil
+}
+
+// expireConsentRequests rejects the tool calls of consent requests the end user didn't respond to in time
+func (p *Processor) expireConsentRequests(ctx context.Context) error {
+	expired, err := p.store.GetExpiredConsentRequests(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("error getting expired consent requests: %w", err)
+	}
+
+	for _, consent := range expired {
+		if _, _, err := resolveConsentRequest(ctx, consent, ConsentExpired, nil, SystemActor, p.store); err != nil {
+			log.Printf("Error expiring consent request %s: %v", consent.Id, err)
+		}
+	}
+
+	return nil
+}
+
 func (p *Processor) processClientReview(_ context.Context, supervisionRequest SupervisionRequest) error {
 	log.Printf("Processing client review for supervision request %s", *supervisionRequest.Id)
 	return nil
@@ -0,0 +1,162 @@
+package asteroid
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// PromptVariantOutcome is what an ensemble decided on a supervision request with a prompt variant,
+// and what the humans after it in the chain finally decided, if they did
+type PromptVariantOutcome struct {
+	Variant       string
+	Decision      Decision
+	HumanDecision *Decision
+}
+
+// parsePromptVariants reads the prompt variants of an ensemble supervisor from its attributes
+func parsePromptVariants(attributes map[string]interface{}) ([]PromptVariant, error) {
+	value, ok := attributes["prompt_variants"]
+	if !ok {
+		return nil, nil
+	}
+
+	jsonVariants, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling prompt variants: %w", err)
+	}
+
+	var variants []PromptVariant
+	if err := json.Unmarshal(jsonVariants, &variants); err != nil {
+		return nil, fmt.Errorf("prompt_variants must be a list of name, prompt and weight: %w", err)
+	}
+
+	return variants, nil
+}
+
+// validatePromptVariants checks that prompt variants have unique names and get some traffic
+func validatePromptVariants(variants []PromptVariant) error {
+	names := make(map[string]bool, len(variants))
+	for i, variant := range variants {
+		if variant.Name == "" {
+			return fmt.Errorf("prompt variant %d needs a name", i)
+		}
+		if names[variant.Name] {
+			return fmt.Errorf("duplicate prompt variant %s", variant.Name)
+		}
+		names[variant.Name] = true
+
+		if variant.Weight != nil && *variant.Weight < 1 {
+			return fmt.Errorf("weight of prompt variant %s must be at least 1", variant.Name)
+		}
+	}
+	return nil
+}
+
+// variantWeight returns the share of traffic a prompt variant gets
+func variantWeight(variant PromptVariant) int {
+	if variant.Weight == nil {
+		return 1
+	}
+	return *variant.Weight
+}
+
+// choosePromptVariant picks the variant a supervision request is reviewed with, in proportion to
+// the variants' weights. The pick follows from the request's ID, so reviewing it again uses the same one.
+func choosePromptVariant(variants []PromptVariant, supervisionRequestId uuid.UUID) *PromptVariant {
+	total := 0
+	for _, variant := range variants {
+		total += variantWeight(variant)
+	}
+	if total == 0 {
+		return nil
+	}
+
+	hash := fnv.New32a()
+	hash.Write(supervisionRequestId[:])
+	point := int(hash.Sum32() % uint32(total))
+
+	for i := range variants {
+		point -= variantWeight(variants[i])
+		if point < 0 {
+			return &variants[i]
+		}
+	}
+	return nil
+}
+
+// withPromptVariant returns the members of an ensemble with a variant's prompt instead of their own
+func withPromptVariant(members []EnsembleMember, variant *PromptVariant) []EnsembleMember {
+	if variant == nil || variant.Prompt == nil || *variant.Prompt == "" {
+		return members
+	}
+
+	varied := make([]EnsembleMember, len(members))
+	for i, member := range members {
+		member.Prompt = *variant.Prompt
+		varied[i] = member
+	}
+	return varied
+}
+
+// comparePromptVariants tallies the outcomes of each prompt variant, in order of name
+func comparePromptVariants(supervisorId uuid.UUID, outcomes []PromptVariantOutcome) PromptVariantReport {
+	stats := make(map[string]*PromptVariantStats)
+	for _, outcome := range outcomes {
+		variant, ok := stats[outcome.Variant]
+		if !ok {
+			variant = &PromptVariantStats{Variant: outcome.Variant}
+			stats[outcome.Variant] = variant
+		}
+
+		variant.Results++
+		if outcome.Decision == Reject || outcome.Decision == Terminate {
+			variant.Rejections++
+		}
+		if outcome.HumanDecision != nil {
+			variant.HumanDecided++
+			if outcome.Decision == *outcome.HumanDecision {
+				variant.Agreed++
+			}
+		}
+	}
+
+	report := PromptVariantReport{SupervisorId: supervisorId, Variants: make([]PromptVariantStats, 0, len(stats))}
+	for _, variant := range stats {
+		variant.RejectionRate = float64(variant.Rejections) / float64(variant.Results)
+		if variant.HumanDecided > 0 {
+			variant.AgreementRate = float64(variant.Agreed) / float64(variant.HumanDecided)
+		}
+		report.Variants = append(report.Variants, *variant)
+	}
+	sort.Slice(report.Variants, func(i, j int) bool { return report.Variants[i].Variant < report.Variants[j].Variant })
+
+	return report
+}
+
+func apiGetSupervisorPromptVariantReportHandler(w http.ResponseWriter, r *http.Request, supervisorId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	supervisor, err := store.GetSupervisor(ctx, supervisorId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor", err.Error())
+		return
+	}
+
+	if supervisor == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervisor not found", "")
+		return
+	}
+
+	outcomes, err := store.GetPromptVariantOutcomes(ctx, supervisorId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting prompt variant outcomes", err.Error())
+		return
+	}
+
+	respondJSON(w, comparePromptVariants(supervisorId, outcomes), http.StatusOK)
+}
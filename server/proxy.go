@@ -0,0 +1,314 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sashabaranov/go-openai"
+)
+
+// ChatIdHeader carries the ID of the chat a proxied completion was logged as
+const ChatIdHeader = "X-Asteroid-Chat-Id"
+
+// ChatProxy forwards chat completion requests to an upstream OpenAI compatible provider
+type ChatProxy struct {
+	client *openai.Client
+	cache  *proxyCache
+}
+
+// NewChatProxyFromEnv configures the upstream provider from OPENAI_API_KEY and the optional
+// OPENAI_BASE_URL. Returns nil if no API key is set, which disables proxy mode.
+func NewChatProxyFromEnv() *ChatProxy {
+	apiKey := os.Getenv("OPENAI_API_KEY")
+	if apiKey == "" {
+		return nil
+	}
+
+	config := openai.DefaultConfig(apiKey)
+	if baseURL := os.Getenv("OPENAI_BASE_URL"); baseURL != "" {
+		config.BaseURL = baseURL
+	}
+
+	return &ChatProxy{client: openai.NewClientWithConfig(config), cache: newProxyCache()}
+}
+
+// Summarize implements Summarizer using the same upstream model the request was made against
+func (p *ChatProxy) Summarize(ctx context.Context, model string, messages []openai.ChatCompletionMessage) (string, error) {
+	var transcript strings.Builder
+	for _, message := range messages {
+		content := message.Content
+		for _, part := range message.MultiContent {
+			if part.Type == openai.ChatMessagePartTypeText {
+				content += part.Text
+			}
+		}
+		for _, toolCall := range message.ToolCalls {
+			content += fmt.Sprintf(" [tool call %s(%s)]", toolCall.Function.Name, toolCall.Function.Arguments)
+		}
+		fmt.Fprintf(&transcript, "%s: %s\n", message.Role, content)
+	}
+
+	resp, err := p.client.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model: model,
+		Messages: []openai.ChatCompletionMessage{
+			{
+				Role: openai.ChatMessageRoleSystem,
+				Content: "Summarize the following conversation excerpt in a few sentences. " +
+					"Keep any facts, decisions, identifiers and tool results a later turn might depend on.",
+			},
+			{Role: openai.ChatMessageRoleUser, Content: transcript.String()},
+		},
+		Temperature: 0,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error calling summarization model: %w", err)
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("summarization model returned no choices")
+	}
+
+	return resp.Choices[0].Message.Content, nil
+}
+
+// getProjectForRun walks run -> task -> project. Returns nil if any of them don't exist.
+func getProjectForRun(ctx context.Context, runId uuid.UUID, store Store) (*Project, error) {
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting run: %w", err)
+	}
+	if run == nil {
+		return nil, nil
+	}
+
+	return getProjectForTask(ctx, run.TaskId, store)
+}
+
+// getProjectForTask returns the project a task belongs to, or nil if either doesn't exist
+func getProjectForTask(ctx context.Context, taskId uuid.UUID, store Store) (*Project, error) {
+	task, err := store.GetTask(ctx, taskId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting task: %w", err)
+	}
+	if task == nil {
+		return nil, nil
+	}
+
+	project, err := store.GetProject(ctx, task.ProjectId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project: %w", err)
+	}
+
+	return project, nil
+}
+
+// getProjectForToolCall walks tool call -> tool -> run -> task -> project. Returns nil if any of them don't exist.
+func getProjectForToolCall(ctx context.Context, toolCallId uuid.UUID, store Store) (*Project, error) {
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool call: %w", err)
+	}
+	if toolCall == nil {
+		return nil, nil
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool: %w", err)
+	}
+	if tool == nil {
+		return nil, nil
+	}
+
+	return getProjectForRun(ctx, tool.RunId, store)
+}
+
+func apiCreateProxyChatCompletionHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store, proxy *ChatProxy) {
+	ctx := r.Context()
+
+	if proxy == nil {
+		sendErrorResponse(w, http.StatusServiceUnavailable, "proxy mode is not configured", "set OPENAI_API_KEY to enable proxy mode")
+		return
+	}
+
+	var request openai.ChatCompletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	// Streams are answered from a whole upstream response, so its tool calls can be held for supervision
+	stream := request.Stream
+	includeUsage := request.StreamOptions != nil && request.StreamOptions.IncludeUsage
+	request.Stream = false
+	request.StreamOptions = nil
+
+	project, err := getProjectForRun(ctx, runId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project for run", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	killSwitch, err := getActiveKillSwitch(ctx, project, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting kill switch", err.Error())
+		return
+	}
+
+	if killSwitch != nil {
+		sendHaltedResponse(w, killSwitch)
+		return
+	}
+
+	if !admitUnpausedRun(ctx, w, runId, store) {
+		return
+	}
+
+	if !enforceQuota(ctx, w, project, StoredBytes, store) {
+		return
+	}
+
+	if !enforceRunLimits(ctx, w, runId, project, store, ToolCallsPerMinute, MaxChatRequests) {
+		return
+	}
+
+	// Results quarantined or held by result supervisors aren't fed back to the model
+	if err := withholdToolCallResults(ctx, runId, request.Messages, store); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error withholding tool call results", err.Error())
+		return
+	}
+
+	// Bring the request within the model's context window
+	policies, err := store.GetContextWindowPolicies(ctx, project.Id)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting context window policies", err.Error())
+		return
+	}
+
+	var truncation *ContextTruncation
+	if policy := ResolveContextWindowPolicy(policies, request.Model); policy != nil {
+		request.Messages, truncation, err = ApplyContextWindowPolicy(ctx, request, *policy, proxy)
+		if errors.Is(err, ErrContextWindowExceeded) {
+			sendErrorResponse(w, http.StatusBadRequest, "context window exceeded", err.Error())
+			return
+		}
+		if err != nil {
+			sendErrorResponse(w, http.StatusBadGateway, "error applying context window policy", err.Error())
+			return
+		}
+	}
+
+	// Projects trying the response cache get the completion of a request they already made
+	caching, err := featureEnabled(ctx, project, proxyResponseCacheFlag, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting feature flag", err.Error())
+		return
+	}
+
+	var cacheKey string
+	var response openai.ChatCompletionResponse
+	cached := false
+	if caching {
+		cacheKey, err = proxyCacheKey(project.Id, request)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting cache key", err.Error())
+			return
+		}
+		response, cached = proxy.cache.get(cacheKey)
+	}
+
+	if !cached {
+		response, err = proxy.client.CreateChatCompletion(ctx, request)
+		if err != nil {
+			sendErrorResponse(w, http.StatusBadGateway, "upstream provider error", err.Error())
+			return
+		}
+		if caching {
+			proxy.cache.put(cacheKey, response)
+		}
+	}
+
+	// Log the chat exactly as it was sent upstream
+	jsonRequest, err := json.Marshal(request)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error marshalling request", err.Error())
+		return
+	}
+
+	jsonResponse, err := json.Marshal(response)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error marshalling response", err.Error())
+		return
+	}
+
+	jsonRequest, jsonResponse, err = transformChatPayload(ctx, project, runId, jsonRequest, jsonResponse, store)
+	if err != nil {
+		sendIngestionHookError(w, err)
+		return
+	}
+
+	jsonRequest, jsonResponse, redactions, err := redactChatPayload(ctx, project, runId, jsonRequest, jsonResponse, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error redacting chat", err.Error())
+		return
+	}
+
+	converter := OpenAIConverter{store}
+
+	asteroidChoices, err := converter.ToAsteroidChoices(ctx, jsonResponse, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Error converting choices: %s", err.Error()), "")
+		return
+	}
+
+	chatId, err := store.CreateChatRequest(ctx, runId, jsonRequest, jsonResponse, asteroidChoices, "openai", []AsteroidMessage{}, parseChatUsage(jsonResponse, Openai))
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating chat request", err.Error())
+		return
+	}
+
+	if err := recordRedactions(ctx, *chatId, redactions, store); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error recording redactions", err.Error())
+		return
+	}
+
+	recordResourceReferences(ctx, runId, asteroidChoices, store)
+	recordPlanSteps(ctx, runId, asteroidChoices, store)
+
+	recordMeteringEvent(ctx, project, BytesStored, *chatId, int64(len(jsonRequest)+len(jsonResponse)), store)
+
+	if truncation != nil {
+		truncation.RunId = runId
+		truncation.ChatId = chatId
+		truncation.CreatedAt = time.Now()
+		if err := store.CreateContextTruncation(ctx, *truncation); err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error recording truncation", err.Error())
+			return
+		}
+	}
+
+	w.Header().Set(ChatIdHeader, chatId.String())
+	if cached {
+		w.Header().Set(ProxyCacheHeader, "hit")
+	} else if caching {
+		w.Header().Set(ProxyCacheHeader, "miss")
+	}
+	if stream {
+		streamProxyResponse(ctx, w, *chatId, response, asteroidChoices, includeUsage, store)
+		return
+	}
+	// The agent needs the real completion, demo mode only fakes what people see
+	writeJSON(w, response, http.StatusOK)
+}
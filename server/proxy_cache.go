@@ -0,0 +1,72 @@
+package asteroid
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sashabaranov/go-openai"
+)
+
+// ProxyCacheHeader tells whether a proxied completion came from the response cache, for projects
+// with the proxy_response_cache flag
+const ProxyCacheHeader = "X-Asteroid-Cache"
+
+// proxyCacheTTL is how long an upstream completion is kept for requests repeating it
+const proxyCacheTTL = 5 * time.Minute
+
+// proxyCache keeps upstream completions by project and request, on the replica that made them
+type proxyCache struct {
+	mutex   sync.Mutex
+	entries map[string]proxyCacheEntry
+}
+
+type proxyCacheEntry struct {
+	response  openai.ChatCompletionResponse
+	expiresAt time.Time
+}
+
+func newProxyCache() *proxyCache {
+	return &proxyCache{entries: make(map[string]proxyCacheEntry)}
+}
+
+// proxyCacheKey identifies a request of a project, which has to match another exactly to share its
+// completion
+func proxyCacheKey(projectId uuid.UUID, request openai.ChatCompletionRequest) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling request: %w", err)
+	}
+
+	sum := sha256.Sum256(append([]byte(projectId.String()+":"), data...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (c *proxyCache) get(key string) (openai.ChatCompletionResponse, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return openai.ChatCompletionResponse{}, false
+	}
+	return entry.response, true
+}
+
+// put keeps a completion, dropping the ones that expired so the cache doesn't grow without bound
+func (c *proxyCache) put(key string, response openai.ChatCompletionResponse) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = proxyCacheEntry{response: response, expiresAt: now.Add(proxyCacheTTL)}
+}
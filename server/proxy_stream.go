@@ -0,0 +1,303 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sashabaranov/go-openai"
+)
+
+const (
+	// proxyHoldPollInterval is how often a held stream checks whether its tool calls were decided
+	proxyHoldPollInterval = time.Second
+	// proxyKeepAliveInterval is how often a held stream tells the client it's still there
+	proxyKeepAliveInterval = 15 * time.Second
+	// proxyHoldTimeout is how long a stream is held before its tool calls are refused undecided
+	proxyHoldTimeout = 30 * time.Minute
+)
+
+// proxyRefusalChunk is a chat completion chunk with the refusal of held tool calls alongside the refusal text
+type proxyRefusalChunk struct {
+	openai.ChatCompletionStreamResponse
+	AsteroidRefusal ProxyRefusal `json:"asteroid_refusal"`
+}
+
+// proxyStream writes server-sent events to a client of proxy mode
+type proxyStream struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+func newProxyStream(w http.ResponseWriter) (*proxyStream, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, fmt.Errorf("response writer doesn't support streaming")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	return &proxyStream{w: w, flusher: flusher}, nil
+}
+
+func (s *proxyStream) send(chunk any) error {
+	data, err := json.Marshal(chunk)
+	if err != nil {
+		return fmt.Errorf("error marshalling chunk: %w", err)
+	}
+	if _, err := fmt.Fprintf(s.w, "data: %s\n\n", data); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+func (s *proxyStream) keepAlive() error {
+	if _, err := fmt.Fprint(s.w, ": supervision pending\n\n"); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+func (s *proxyStream) done() error {
+	if _, err := fmt.Fprint(s.w, "data: [DONE]\n\n"); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// streamChunk starts a chunk of a response with the given choices
+func streamChunk(response openai.ChatCompletionResponse, choices ...openai.ChatCompletionStreamChoice) openai.ChatCompletionStreamResponse {
+	return openai.ChatCompletionStreamResponse{
+		ID:                response.ID,
+		Object:            "chat.completion.chunk",
+		Created:           response.Created,
+		Model:             response.Model,
+		Choices:           choices,
+		SystemFingerprint: response.SystemFingerprint,
+	}
+}
+
+// heldToolCalls returns the tool calls of a response's choices, which are held until they're decided
+func heldToolCalls(choices []AsteroidChoice) []AsteroidToolCall {
+	toolCalls := make([]AsteroidToolCall, 0)
+	for _, choice := range choices {
+		if choice.Message.ToolCalls != nil {
+			toolCalls = append(toolCalls, *choice.Message.ToolCalls...)
+		}
+	}
+	return toolCalls
+}
+
+// awaitToolCallDecisions waits until every tool call is decided, sending keep-alives meanwhile. It
+// returns the tool calls that weren't approved or modified, with a nil decision for those still
+// undecided when the hold timed out, and the arguments reviewers gave modified ones by call ID.
+func awaitToolCallDecisions(ctx context.Context, stream *proxyStream, toolCalls []AsteroidToolCall, store Store) ([]ProxyRefusedToolCall, map[string]string, error) {
+	poll := time.NewTicker(proxyHoldPollInterval)
+	defer poll.Stop()
+	keepAlive := time.NewTicker(proxyKeepAliveInterval)
+	defer keepAlive.Stop()
+	timeout := time.NewTimer(proxyHoldTimeout)
+	defer timeout.Stop()
+
+	decisions := make(map[uuid.UUID]*Decision, len(toolCalls))
+	reasons := make(map[uuid.UUID]string, len(toolCalls))
+	arguments := make(map[uuid.UUID]string)
+
+	// decide checks the tool calls still undecided, and reports whether all of them are decided now
+	decide := func() (bool, error) {
+		decided := true
+		for _, toolCall := range toolCalls {
+			if decisions[toolCall.Id] != nil {
+				continue
+			}
+			decision, result, err := decideToolCall(ctx, toolCall.Id, store)
+			if err != nil {
+				return false, err
+			}
+			if decision == nil {
+				decided = false
+				continue
+			}
+			decisions[toolCall.Id] = decision
+			if result != nil {
+				reasons[toolCall.Id] = result.Reasoning
+				if *decision == Modify && result.ModifiedArguments != nil {
+					arguments[toolCall.Id] = *result.ModifiedArguments
+				}
+			}
+		}
+		return decided, nil
+	}
+
+	decided, err := decide()
+hold:
+	for err == nil && !decided {
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-keepAlive.C:
+			err = stream.keepAlive()
+		case <-poll.C:
+			decided, err = decide()
+		case <-timeout.C:
+			for _, toolCall := range toolCalls {
+				if decisions[toolCall.Id] == nil {
+					reasons[toolCall.Id] = fmt.Sprintf("supervision wasn't decided within %s", proxyHoldTimeout)
+				}
+			}
+			break hold
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refused := make([]ProxyRefusedToolCall, 0)
+	modified := make(map[string]string)
+	for _, toolCall := range toolCalls {
+		decision := decisions[toolCall.Id]
+		if decision != nil && *decision == Approve {
+			continue
+		}
+
+		// A modified tool call goes out with the reviewer's arguments, for the client to execute
+		if modifiedArguments, ok := arguments[toolCall.Id]; ok && toolCall.CallId != nil {
+			modified[*toolCall.CallId] = modifiedArguments
+			continue
+		}
+
+		name := ""
+		if toolCall.Name != nil {
+			name = *toolCall.Name
+		}
+		refusal := ProxyRefusedToolCall{ToolCallId: toolCall.Id, CallId: toolCall.CallId, Name: name, Decision: decision}
+		if reason, ok := reasons[toolCall.Id]; ok && reason != "" {
+			refusal.Reasoning = &reason
+		}
+		refused = append(refused, refusal)
+	}
+
+	return refused, modified, nil
+}
+
+// refusalText explains to the agent why its tool calls were refused
+func refusalText(refused []ProxyRefusedToolCall) string {
+	reasons := make([]string, 0, len(refused))
+	for _, toolCall := range refused {
+		reason := fmt.Sprintf("%s was not decided", toolCall.Name)
+		if toolCall.Decision != nil {
+			reason = fmt.Sprintf("%s was %s", toolCall.Name, decisionPastTense(*toolCall.Decision))
+		}
+		if toolCall.Reasoning != nil {
+			reason += ": " + *toolCall.Reasoning
+		}
+		reasons = append(reasons, reason)
+	}
+	return "The tool calls of this response were refused by supervision. " + strings.Join(reasons, "; ")
+}
+
+func decisionPastTense(decision Decision) string {
+	switch decision {
+	case Reject:
+		return "rejected"
+	case Terminate:
+		return "terminated"
+	case Modify:
+		return "modified by a reviewer"
+	case Escalate:
+		return "escalated"
+	default:
+		return string(decision)
+	}
+}
+
+// streamProxyResponse streams a response to a client of proxy mode. Content goes out straight away,
+// tool calls only once supervision approved or modified all of them, otherwise the stream ends with a
+// refusal.
+func streamProxyResponse(ctx context.Context, w http.ResponseWriter, chatId uuid.UUID, response openai.ChatCompletionResponse, choices []AsteroidChoice, includeUsage bool, store Store) {
+	stream, err := newProxyStream(w)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error streaming response", err.Error())
+		return
+	}
+
+	fail := func(err error) {
+		log.Printf("Error streaming proxy response of chat %s: %v", chatId, err)
+	}
+
+	for _, choice := range response.Choices {
+		delta := openai.ChatCompletionStreamChoiceDelta{Role: choice.Message.Role, Content: choice.Message.Content}
+		if err := stream.send(streamChunk(response, openai.ChatCompletionStreamChoice{Index: choice.Index, Delta: delta})); err != nil {
+			fail(err)
+			return
+		}
+	}
+
+	refused, modified, err := awaitToolCallDecisions(ctx, stream, heldToolCalls(choices), store)
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	if len(refused) > 0 {
+		chunk := proxyRefusalChunk{
+			ChatCompletionStreamResponse: streamChunk(response),
+			AsteroidRefusal:              ProxyRefusal{ChatId: chatId, ToolCalls: refused},
+		}
+		for _, choice := range response.Choices {
+			chunk.Choices = append(chunk.Choices, openai.ChatCompletionStreamChoice{
+				Index:        choice.Index,
+				Delta:        openai.ChatCompletionStreamChoiceDelta{Refusal: refusalText(refused)},
+				FinishReason: openai.FinishReasonStop,
+			})
+		}
+		if err := stream.send(chunk); err != nil {
+			fail(err)
+			return
+		}
+	} else {
+		for _, choice := range response.Choices {
+			toolCalls := make([]openai.ToolCall, len(choice.Message.ToolCalls))
+			for i, toolCall := range choice.Message.ToolCalls {
+				index := i
+				toolCall.Index = &index
+				if arguments, ok := modified[toolCall.ID]; ok {
+					toolCall.Function.Arguments = arguments
+				}
+				toolCalls[i] = toolCall
+			}
+			delta := openai.ChatCompletionStreamChoiceDelta{ToolCalls: toolCalls}
+			chunk := streamChunk(response, openai.ChatCompletionStreamChoice{Index: choice.Index, Delta: delta, FinishReason: choice.FinishReason})
+			if err := stream.send(chunk); err != nil {
+				fail(err)
+				return
+			}
+		}
+	}
+
+	if includeUsage {
+		usage := streamChunk(response)
+		usage.Choices = []openai.ChatCompletionStreamChoice{}
+		usage.Usage = &response.Usage
+		if err := stream.send(usage); err != nil {
+			fail(err)
+			return
+		}
+	}
+
+	if err := stream.done(); err != nil {
+		fail(err)
+	}
+}
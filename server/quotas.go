@@ -0,0 +1,277 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuotaWarningHeader is set on responses to requests that went past a soft limit
+const QuotaWarningHeader = "X-Asteroid-Quota-Warning"
+
+// quotaScope is a project or organization whose quotas apply to a request
+type quotaScope struct {
+	scope string
+	id    uuid.UUID
+}
+
+// quotaScopes returns the scopes whose quotas apply to a project: its own and its organization's
+func quotaScopes(project Project) []quotaScope {
+	scopes := []quotaScope{{scope: projectResource, id: project.Id}}
+	if project.OrganizationId != nil {
+		scopes = append(scopes, quotaScope{scope: organizationResource, id: *project.OrganizationId})
+	}
+	return scopes
+}
+
+// quotaState compares usage with a quota's limits
+func quotaState(quota Quota, used int64) QuotaState {
+	switch {
+	case quota.HardLimit != nil && used >= *quota.HardLimit:
+		return HardLimitExceeded
+	case quota.SoftLimit != nil && used >= *quota.SoftLimit:
+		return SoftLimitExceeded
+	default:
+		return WithinQuota
+	}
+}
+
+// getQuotaUsage measures every quota of a project and its organization, optionally only for one metric
+func getQuotaUsage(ctx context.Context, project Project, metric *QuotaMetric, store Store) ([]QuotaUsage, error) {
+	// Days start at midnight UTC, whatever time zone the server runs in
+	dayStart := time.Now().UTC().Truncate(24 * time.Hour)
+
+	usage := make([]QuotaUsage, 0)
+	for _, scope := range quotaScopes(project) {
+		quotas, err := store.GetQuotas(ctx, scope.scope, scope.id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting %s quotas: %w", scope.scope, err)
+		}
+
+		for _, quota := range quotas {
+			if metric != nil && quota.Metric != *metric {
+				continue
+			}
+
+			used, err := store.GetQuotaUsage(ctx, scope.scope, scope.id, quota.Metric, dayStart)
+			if err != nil {
+				return nil, fmt.Errorf("error getting %s usage: %w", quota.Metric, err)
+			}
+
+			usage = append(usage, QuotaUsage{
+				Metric:    quota.Metric,
+				Scope:     scope.scope,
+				ScopeId:   scope.id,
+				Used:      used,
+				SoftLimit: quota.SoftLimit,
+				HardLimit: quota.HardLimit,
+				State:     quotaState(quota, used),
+			})
+		}
+	}
+
+	return usage, nil
+}
+
+// enforceQuota refuses a request that would go past a hard limit on a metric, and warns about soft
+// limits it's past. Returns false if the request was refused and a response has been sent.
+func enforceQuota(ctx context.Context, w http.ResponseWriter, project *Project, metric QuotaMetric, store Store) bool {
+	if err := checkQuota(ctx, w.Header(), project, metric, store); err != nil {
+		sendAPIError(w, err)
+		return false
+	}
+	return true
+}
+
+// checkQuota is enforceQuota for logic the HTTP and gRPC APIs share, adding its warnings to header
+func checkQuota(ctx context.Context, header http.Header, project *Project, metric QuotaMetric, store Store) error {
+	if project == nil {
+		return nil
+	}
+
+	usage, err := getQuotaUsage(ctx, *project, &metric, store)
+	if err != nil {
+		return &apiError{status: http.StatusInternalServerError, message: "error getting quota usage", details: err.Error()}
+	}
+
+	for _, u := range usage {
+		switch u.State {
+		case HardLimitExceeded:
+			message := fmt.Sprintf("%s %s has used %d of its %s quota of %d", u.Scope, u.ScopeId, u.Used, u.Metric, *u.HardLimit)
+			return &apiError{status: http.StatusTooManyRequests, message: message, body: QuotaExceeded{Error: message, Usage: u}}
+		case SoftLimitExceeded:
+			log.Printf("%s %s is past its soft %s quota: %d of %d", u.Scope, u.ScopeId, u.Metric, u.Used, *u.SoftLimit)
+			header.Add(QuotaWarningHeader, fmt.Sprintf("%s %s %s %d/%d", u.Scope, u.ScopeId, u.Metric, u.Used, *u.SoftLimit))
+		}
+	}
+
+	return nil
+}
+
+// validateQuotas checks that metrics are known and unique, and that soft limits are below hard limits
+func validateQuotas(quotas []Quota) error {
+	seen := make(map[QuotaMetric]bool)
+	for _, quota := range quotas {
+		switch quota.Metric {
+		case RunsPerDay, StoredBytes, PendingReviews:
+		default:
+			return fmt.Errorf("unknown quota metric: %s", quota.Metric)
+		}
+		if seen[quota.Metric] {
+			return fmt.Errorf("duplicate quota for %s", quota.Metric)
+		}
+		seen[quota.Metric] = true
+
+		if quota.SoftLimit == nil && quota.HardLimit == nil {
+			return fmt.Errorf("quota for %s needs a soft or hard limit", quota.Metric)
+		}
+		if (quota.SoftLimit != nil && *quota.SoftLimit < 0) || (quota.HardLimit != nil && *quota.HardLimit < 0) {
+			return fmt.Errorf("limits for %s must not be negative", quota.Metric)
+		}
+		if quota.SoftLimit != nil && quota.HardLimit != nil && *quota.SoftLimit > *quota.HardLimit {
+			return fmt.Errorf("soft limit for %s must not be above its hard limit", quota.Metric)
+		}
+	}
+
+	return nil
+}
+
+func apiGetProjectQuotasHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	quotas, err := store.GetQuotas(ctx, projectResource, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting quotas", err.Error())
+		return
+	}
+
+	respondJSON(w, quotas, http.StatusOK)
+}
+
+func apiSetProjectQuotasHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var quotas []Quota
+	if err := json.NewDecoder(r.Body).Decode(&quotas); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateQuotas(quotas); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid quota", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := store.SetQuotas(ctx, projectResource, projectId, quotas); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting quotas", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiGetOrganizationQuotasHandler(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	organization, err := store.GetOrganization(ctx, organizationId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting organization", err.Error())
+		return
+	}
+
+	if organization == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Organization not found", "")
+		return
+	}
+
+	quotas, err := store.GetQuotas(ctx, organizationResource, organizationId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting quotas", err.Error())
+		return
+	}
+
+	respondJSON(w, quotas, http.StatusOK)
+}
+
+func apiSetOrganizationQuotasHandler(w http.ResponseWriter, r *http.Request, organizationId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var quotas []Quota
+	if err := json.NewDecoder(r.Body).Decode(&quotas); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateQuotas(quotas); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid quota", err.Error())
+		return
+	}
+
+	organization, err := store.GetOrganization(ctx, organizationId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting organization", err.Error())
+		return
+	}
+
+	if organization == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Organization not found", "")
+		return
+	}
+
+	if err := store.SetQuotas(ctx, organizationResource, organizationId, quotas); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting quotas", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiGetProjectQuotaUsageHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	usage, err := getQuotaUsage(ctx, *project, nil, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting quota usage", err.Error())
+		return
+	}
+
+	respondJSON(w, usage, http.StatusOK)
+}
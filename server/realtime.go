@@ -0,0 +1,66 @@
+package asteroid
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// realtimeReviewBuffer is how many reviews of realtime runs can wait for the hub to assign them
+const realtimeReviewBuffer = 100
+
+const realtimeContextKey contextKey = "realtime"
+
+// WithRealtime marks a context as handling the review of a realtime run, whose store queries use the
+// connections reserved for them
+func WithRealtime(ctx context.Context) context.Context {
+	return context.WithValue(ctx, realtimeContextKey, true)
+}
+
+// IsRealtime reports whether a context handles the review of a realtime run
+func IsRealtime(ctx context.Context) bool {
+	realtime, _ := ctx.Value(realtimeContextKey).(bool)
+	return realtime
+}
+
+// reviewContext returns the context to handle a supervision request in, marked realtime if its run is
+func reviewContext(ctx context.Context, request SupervisionRequest) context.Context {
+	if requestPriority(request) == Realtime {
+		return WithRealtime(ctx)
+	}
+	return ctx
+}
+
+// deliverRealtimeReviews assigns the reviews of realtime runs as they arrive, offering those no
+// session of this replica has capacity for to the others
+func (h *Hub) deliverRealtimeReviews() {
+	for supervisionRequest := range h.RealtimeReviewChan {
+		if !h.assignReview(supervisionRequest) {
+			h.publish(hubMessage{Kind: offerMessage, SupervisionRequest: &supervisionRequest})
+		}
+	}
+}
+
+// dispatchRealtimeReview sends the human review of a realtime run to the hub as soon as it's created,
+// rather than leaving it for the processor's next pass. Reviews of other runs, and those the hub has
+// no room for, are left to the processor.
+func dispatchRealtimeReview(ctx context.Context, supervisionRequestId uuid.UUID, hub *Hub, store Store) {
+	request, err := store.GetSupervisionRequest(ctx, supervisionRequestId)
+	if err != nil {
+		log.Printf("Error getting supervision request %s to dispatch, leaving it to the processor: %v", supervisionRequestId, err)
+		return
+	}
+	if request == nil || requestPriority(*request) != Realtime {
+		return
+	}
+	if request.Status != nil && request.Status.Status != Pending {
+		return
+	}
+
+	select {
+	case hub.RealtimeReviewChan <- *request:
+	default:
+		log.Printf("Realtime review channel is full, leaving supervision request %s to the processor", supervisionRequestId)
+	}
+}
@@ -0,0 +1,443 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/google/uuid"
+)
+
+var (
+	redactorNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+	// placeholderPattern matches what redacted text is replaced with, and names its redactor
+	placeholderPattern = regexp.MustCompile(`\[REDACTED:([A-Za-z0-9_-]+):[0-9a-f]{8}\]`)
+
+	redactionDetectorPatterns = map[RedactionDetector]*regexp.Regexp{
+		CreditCardNumber: regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`),
+		ApiCredential: regexp.MustCompile(`\b(?:sk-[A-Za-z0-9_-]{20,}|AKIA[0-9A-Z]{16}|gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,}|` +
+			`xox[abprs]-[A-Za-z0-9-]{10,}|AIza[0-9A-Za-z_-]{35}|` + apiKeyPrefix + `[0-9a-f]{64})`),
+		EmailAddress: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`),
+	}
+)
+
+// validateRedactors checks that redactors have unique names that can go in placeholders, and
+// exactly one known detector, pattern that compiles or field path each
+func validateRedactors(redactors []Redactor) error {
+	names := make(map[string]bool)
+	for _, redactor := range redactors {
+		if !redactorNamePattern.MatchString(redactor.Name) {
+			return fmt.Errorf("redactor names must be letters, digits, underscores and hyphens")
+		}
+		if names[redactor.Name] {
+			return fmt.Errorf("duplicate redactor %s", redactor.Name)
+		}
+		names[redactor.Name] = true
+
+		kinds := 0
+		if redactor.Detector != nil {
+			kinds++
+			if _, ok := redactionDetectorPatterns[*redactor.Detector]; !ok {
+				return fmt.Errorf("unknown detector of redactor %s: %s", redactor.Name, *redactor.Detector)
+			}
+		}
+		if redactor.Pattern != nil {
+			kinds++
+			pattern, err := regexp.Compile(*redactor.Pattern)
+			if err != nil {
+				return fmt.Errorf("invalid pattern of redactor %s: %w", redactor.Name, err)
+			}
+			if pattern.MatchString("") {
+				return fmt.Errorf("pattern of redactor %s matches empty text", redactor.Name)
+			}
+		}
+		if redactor.FieldPath != nil {
+			kinds++
+			if slices.Contains(strings.Split(*redactor.FieldPath, "."), "") {
+				return fmt.Errorf("field path of redactor %s has an empty field name", redactor.Name)
+			}
+		}
+		if kinds != 1 {
+			return fmt.Errorf("redactor %s needs exactly one of a detector, a pattern or a field path", redactor.Name)
+		}
+	}
+	return nil
+}
+
+type compiledRedactor struct {
+	name    string
+	pattern *regexp.Regexp
+	// luhn only redacts matches whose digits pass the Luhn check, which tells card numbers apart
+	// from other long numbers
+	luhn bool
+	path []string
+}
+
+func compileRedactors(redactors []Redactor) ([]compiledRedactor, error) {
+	compiled := make([]compiledRedactor, 0, len(redactors))
+	for _, redactor := range redactors {
+		c := compiledRedactor{name: redactor.Name}
+		switch {
+		case redactor.Detector != nil:
+			c.pattern = redactionDetectorPatterns[*redactor.Detector]
+			c.luhn = *redactor.Detector == CreditCardNumber
+			if c.pattern == nil {
+				return nil, fmt.Errorf("unknown detector of redactor %s: %s", redactor.Name, *redactor.Detector)
+			}
+		case redactor.Pattern != nil:
+			pattern, err := regexp.Compile(*redactor.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern of redactor %s: %w", redactor.Name, err)
+			}
+			c.pattern = pattern
+		case redactor.FieldPath != nil:
+			c.path = strings.Split(*redactor.FieldPath, ".")
+		default:
+			continue
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+// passesLuhn reports whether the digits of a number pass the Luhn checksum
+func passesLuhn(number string) bool {
+	sum, digits := 0, 0
+	for i := len(number) - 1; i >= 0; i-- {
+		if number[i] < '0' || number[i] > '9' {
+			continue
+		}
+		digit := int(number[i] - '0')
+		if digits%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		digits++
+	}
+	return digits >= 13 && sum%10 == 0
+}
+
+// chatRedaction redacts a chat's request and response, collecting what it redacted
+type chatRedaction struct {
+	redactors  []compiledRedactor
+	runId      uuid.UUID
+	createdAt  time.Time
+	redactions []Redaction
+	// replaced counts the values replaced with placeholders, including reused ones
+	replaced int
+	// placeholders reuses a placeholder for text a redactor already redacted from the chat, as
+	// requests repeat the conversation so far
+	placeholders map[string]string
+}
+
+func (c *chatRedaction) placeholder(redactor string, original string) string {
+	c.replaced++
+	key := redactor + "\x00" + original
+	if placeholder, ok := c.placeholders[key]; ok {
+		return placeholder
+	}
+
+	id := uuid.New()
+	placeholder := fmt.Sprintf("[REDACTED:%s:%s]", redactor, id.String()[:8])
+	c.placeholders[key] = placeholder
+	c.redactions = append(c.redactions, Redaction{
+		Id:          id,
+		RunId:       c.runId,
+		Redactor:    redactor,
+		Placeholder: placeholder,
+		Original:    original,
+		CreatedAt:   c.createdAt,
+	})
+	return placeholder
+}
+
+// redactText redacts what the patterns of the redactors match, leaving the placeholders of earlier
+// redactors be
+func (c *chatRedaction) redactText(text string) string {
+	for _, redactor := range c.redactors {
+		if redactor.pattern == nil {
+			continue
+		}
+
+		var redacted strings.Builder
+		last := 0
+		for _, placeholder := range placeholderPattern.FindAllStringIndex(text, -1) {
+			redacted.WriteString(c.redactMatches(redactor, text[last:placeholder[0]]))
+			redacted.WriteString(text[placeholder[0]:placeholder[1]])
+			last = placeholder[1]
+		}
+		redacted.WriteString(c.redactMatches(redactor, text[last:]))
+		text = redacted.String()
+	}
+	return text
+}
+
+func (c *chatRedaction) redactMatches(redactor compiledRedactor, text string) string {
+	return redactor.pattern.ReplaceAllStringFunc(text, func(match string) string {
+		if redactor.luhn && !passesLuhn(match) {
+			return match
+		}
+		return c.placeholder(redactor.name, match)
+	})
+}
+
+// fieldRedactor returns the redactor whose field path ends the path to a value, if any
+func (c *chatRedaction) fieldRedactor(path []string) *compiledRedactor {
+	for i, redactor := range c.redactors {
+		if len(redactor.path) > 0 && len(redactor.path) <= len(path) && slices.Equal(redactor.path, path[len(path)-len(redactor.path):]) {
+			return &c.redactors[i]
+		}
+	}
+	return nil
+}
+
+// redactValue redacts a decoded JSON value. Strings holding JSON objects or arrays, like the
+// arguments of OpenAI tool calls, are redacted as JSON, with their field paths continuing the path
+// to the string.
+func (c *chatRedaction) redactValue(value interface{}, path []string) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			fieldPath := append(slices.Clip(path), key)
+			if redactor := c.fieldRedactor(fieldPath); redactor != nil && value[key] != nil {
+				original, ok := value[key].(string)
+				if !ok {
+					original = stringOrEmpty(encodeValue(value[key]))
+				}
+				value[key] = c.placeholder(redactor.name, original)
+				continue
+			}
+			value[key] = c.redactValue(value[key], fieldPath)
+		}
+		return value
+	case []interface{}:
+		for i := range value {
+			value[i] = c.redactValue(value[i], path)
+		}
+		return value
+	case string:
+		trimmed := strings.TrimSpace(value)
+		if (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) && json.Valid([]byte(trimmed)) {
+			replaced := c.replaced
+			decoded := c.redactValue(decodeArguments(trimmed), path)
+			if c.replaced == replaced {
+				return value
+			}
+			if encoded := encodeValue(decoded); encoded != nil {
+				return *encoded
+			}
+		}
+		return c.redactText(value)
+	default:
+		return value
+	}
+}
+
+// redactChatPayload runs a project's redactors over a chat's request and response before they're
+// stored, returning them with placeholders in place of what was redacted, and the redactions to
+// record once the chat has an ID. Chats nothing is redacted from are returned as they were.
+func redactChatPayload(ctx context.Context, project *Project, runId uuid.UUID, request []byte, response []byte, store Store) ([]byte, []byte, []Redaction, error) {
+	if project == nil {
+		return request, response, nil, nil
+	}
+
+	redactors, err := store.GetRedactors(ctx, project.Id)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error getting redactors: %w", err)
+	}
+	if len(redactors) == 0 {
+		return request, response, nil, nil
+	}
+
+	compiled, err := compileRedactors(redactors)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	redaction := chatRedaction{redactors: compiled, runId: runId, createdAt: time.Now(), placeholders: make(map[string]string)}
+	redactedRequest, err := redaction.redactPayload(request)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error redacting chat request: %w", err)
+	}
+	redactedResponse, err := redaction.redactPayload(response)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error redacting chat response: %w", err)
+	}
+
+	if len(redaction.redactions) == 0 {
+		return request, response, nil, nil
+	}
+	return redactedRequest, redactedResponse, redaction.redactions, nil
+}
+
+func (c *chatRedaction) redactPayload(payload []byte) ([]byte, error) {
+	if !json.Valid(payload) {
+		return nil, fmt.Errorf("payload isn't valid JSON")
+	}
+
+	redacted := c.redactValue(decodeArguments(string(payload)), nil)
+	encoded := encodeValue(redacted)
+	if encoded == nil {
+		return nil, fmt.Errorf("error marshalling redacted payload")
+	}
+	return []byte(*encoded), nil
+}
+
+// recordRedactions stores what was redacted from a chat, now that it's been stored
+func recordRedactions(ctx context.Context, chatId uuid.UUID, redactions []Redaction, store Store) error {
+	if len(redactions) == 0 {
+		return nil
+	}
+
+	for i := range redactions {
+		redactions[i].ChatId = chatId
+	}
+	return store.CreateRedactions(ctx, redactions)
+}
+
+// redactedSpans finds the placeholders in text, or returns nil if there are none
+func redactedSpans(text string) *[]RedactedSpan {
+	matches := placeholderPattern.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	spans := make([]RedactedSpan, 0, len(matches))
+	offset, last := 0, 0
+	for _, match := range matches {
+		offset += utf16Length(text[last:match[0]])
+		placeholder := text[match[0]:match[1]]
+		spans = append(spans, RedactedSpan{
+			Redactor:    text[match[2]:match[3]],
+			Placeholder: placeholder,
+			Start:       offset,
+			End:         offset + len(placeholder),
+		})
+		offset += len(placeholder)
+		last = match[1]
+	}
+	return &spans
+}
+
+func utf16Length(text string) int {
+	length := 0
+	for _, r := range text {
+		length += utf16.RuneLen(r)
+	}
+	return length
+}
+
+// markRedactedSpans marks where the content and tool call arguments of messages were redacted, so
+// placeholders can be shown as such
+func markRedactedSpans(messages []AsteroidMessage) {
+	for i := range messages {
+		messages[i].Redactions = redactedSpans(messages[i].Content)
+		if messages[i].ToolCalls == nil {
+			continue
+		}
+		for j := range *messages[i].ToolCalls {
+			toolCall := &(*messages[i].ToolCalls)[j]
+			toolCall.Redactions = redactedSpans(stringOrEmpty(toolCall.Arguments))
+		}
+	}
+}
+
+func apiGetProjectRedactorsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	redactors, err := store.GetRedactors(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting redactors", err.Error())
+		return
+	}
+
+	respondJSON(w, redactors, http.StatusOK)
+}
+
+func apiSetProjectRedactorsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var redactors []Redactor
+	if err := json.NewDecoder(r.Body).Decode(&redactors); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateRedactors(redactors); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid redactor", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := store.SetRedactors(ctx, projectId, redactors); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting redactors", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiGetRunRedactionsHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	redactions, err := store.GetRunRedactions(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting redactions", err.Error())
+		return
+	}
+
+	// Who saw the originals is kept, as they're what redaction keeps from everyone else
+	recordAuditEvent(ctx, actorFromContext(ctx), AuditActionRedactionsViewed, runResource, runId, map[string]interface{}{
+		"redactions": len(redactions),
+	}, store)
+
+	respondJSON(w, redactions, http.StatusOK)
+}
@@ -0,0 +1,276 @@
+package asteroid
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const redisTimeout = 5 * time.Second
+
+// redisError is an error reply of the server, after which the connection can still be used
+type redisError string
+
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+// redisClient speaks as much of the Redis protocol as the hub's backplane needs: publishing,
+// subscribing and claiming keys
+type redisClient struct {
+	address  string
+	useTLS   bool
+	username string
+	password string
+	database int
+
+	mutex sync.Mutex
+	// conn runs commands, nil until the first one or after a connection error
+	conn *redisConn
+}
+
+type redisConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// newRedisClient takes a redis:// or rediss:// (TLS) URL, with an optional user and password and
+// database number as its path
+func newRedisClient(rawURL string) (*redisClient, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Redis URL: %w", err)
+	}
+	if u.Scheme != "redis" && u.Scheme != "rediss" {
+		return nil, fmt.Errorf("invalid Redis URL scheme %q, must be redis or rediss", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("no host in Redis URL")
+	}
+
+	c := &redisClient{address: u.Host, useTLS: u.Scheme == "rediss"}
+	if u.Port() == "" {
+		c.address = net.JoinHostPort(u.Hostname(), "6379")
+	}
+	if u.User != nil {
+		c.username = u.User.Username()
+		c.password, _ = u.User.Password()
+	}
+	if database := strings.TrimPrefix(u.Path, "/"); database != "" {
+		c.database, err = strconv.Atoi(database)
+		if err != nil || c.database < 0 {
+			return nil, fmt.Errorf("invalid Redis database %q", database)
+		}
+	}
+
+	return c, nil
+}
+
+// dial connects and authenticates a connection, and selects the database
+func (c *redisClient) dial(ctx context.Context) (*redisConn, error) {
+	dialer := net.Dialer{Timeout: redisTimeout}
+
+	var conn net.Conn
+	var err error
+	if c.useTLS {
+		host, _, _ := net.SplitHostPort(c.address)
+		conn, err = (&tls.Dialer{NetDialer: &dialer, Config: &tls.Config{ServerName: host}}).DialContext(ctx, "tcp", c.address)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", c.address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Redis: %w", err)
+	}
+
+	rc := &redisConn{conn: conn, reader: bufio.NewReader(conn)}
+	conn.SetDeadline(time.Now().Add(redisTimeout))
+
+	if c.password != "" {
+		args := []string{"AUTH", c.password}
+		if c.username != "" {
+			args = []string{"AUTH", c.username, c.password}
+		}
+		if _, err := rc.do(args...); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("error authenticating to Redis: %w", err)
+		}
+	}
+	if c.database != 0 {
+		if _, err := rc.do("SELECT", strconv.Itoa(c.database)); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("error selecting Redis database: %w", err)
+		}
+	}
+
+	conn.SetDeadline(time.Time{})
+	return rc, nil
+}
+
+// do runs a command on the client's connection, dialing it first if needed
+func (c *redisClient) do(ctx context.Context, args ...string) (any, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.conn == nil {
+		conn, err := c.dial(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.conn = conn
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(redisTimeout)
+	}
+	c.conn.conn.SetDeadline(deadline)
+
+	reply, err := c.conn.do(args...)
+	var replyErr redisError
+	if err != nil && !errors.As(err, &replyErr) {
+		// The connection may be halfway through a reply, so it's not used again
+		c.conn.conn.Close()
+		c.conn = nil
+	}
+	return reply, err
+}
+
+// publish sends a message to the subscribers of a channel
+func (c *redisClient) publish(ctx context.Context, channel string, message []byte) error {
+	_, err := c.do(ctx, "PUBLISH", channel, string(message))
+	return err
+}
+
+// setNX sets a key that expires after a while unless it's already set, reporting whether it set it
+func (c *redisClient) setNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	reply, err := c.do(ctx, "SET", key, value, "NX", "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	if err != nil {
+		return false, err
+	}
+	return reply != nil, nil
+}
+
+// subscribe calls handle with each message published to a channel, on a connection of its own, until
+// the context is done or the connection fails
+func (c *redisClient) subscribe(ctx context.Context, channel string, handle func(message []byte)) error {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.conn.Close()
+
+	// Reading blocks until a message arrives, so the connection is closed to stop it
+	stop := context.AfterFunc(ctx, func() { conn.conn.Close() })
+	defer stop()
+
+	if err := conn.write("SUBSCRIBE", channel); err != nil {
+		return err
+	}
+
+	for {
+		reply, err := conn.read()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		// Pushed messages are [message, channel, payload], confirmations [subscribe, channel, count]
+		parts, ok := reply.([]any)
+		if !ok || len(parts) != 3 {
+			continue
+		}
+		if kind, _ := parts[0].([]byte); string(kind) != "message" {
+			continue
+		}
+		if payload, ok := parts[2].([]byte); ok {
+			handle(payload)
+		}
+	}
+}
+
+func (rc *redisConn) do(args ...string) (any, error) {
+	if err := rc.write(args...); err != nil {
+		return nil, err
+	}
+	return rc.read()
+}
+
+// write sends a command as an array of bulk strings
+func (rc *redisConn) write(args ...string) error {
+	var command strings.Builder
+	fmt.Fprintf(&command, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&command, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+
+	if _, err := io.WriteString(rc.conn, command.String()); err != nil {
+		return fmt.Errorf("error writing to Redis: %w", err)
+	}
+	return nil
+}
+
+// read reads a reply: a string for simple strings, an int64 for integers, a []byte or nil for bulk
+// strings and a []any or nil for arrays. Error replies are returned as a redisError.
+func (rc *redisConn) read() (any, error) {
+	line, err := rc.reader.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("error reading from Redis: %w", err)
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, fmt.Errorf("empty reply from Redis")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, redisError(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		length, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid bulk string length from Redis: %w", err)
+		}
+		if length < 0 {
+			return nil, nil
+		}
+		data := make([]byte, length+2)
+		if _, err := io.ReadFull(rc.reader, data); err != nil {
+			return nil, fmt.Errorf("error reading from Redis: %w", err)
+		}
+		return data[:length], nil
+	case '*':
+		length, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid array length from Redis: %w", err)
+		}
+		if length < 0 {
+			return nil, nil
+		}
+		items := make([]any, length)
+		for i := range items {
+			// Error replies inside an array don't end the reply
+			items[i], err = rc.read()
+			var replyErr redisError
+			if err != nil && !errors.As(err, &replyErr) {
+				return nil, err
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unexpected reply from Redis: %q", line)
+	}
+}
@@ -0,0 +1,131 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// parseReminderSchedule reads the reminder schedule of a human supervisor from its attributes
+func parseReminderSchedule(attributes map[string]interface{}) ([]ReminderStep, error) {
+	value, ok := attributes["reminders"]
+	if !ok {
+		return nil, nil
+	}
+
+	jsonSteps, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling reminders: %w", err)
+	}
+
+	var steps []ReminderStep
+	if err := json.Unmarshal(jsonSteps, &steps); err != nil {
+		return nil, fmt.Errorf("reminders must be a list of after_seconds and action: %w", err)
+	}
+
+	return steps, nil
+}
+
+// validateReminderSchedule checks that reminder steps are in the future, and that escalations say where to
+func validateReminderSchedule(steps []ReminderStep) error {
+	for i, step := range steps {
+		if step.AfterSeconds < 1 {
+			return fmt.Errorf("after_seconds of reminder %d must be at least 1", i)
+		}
+
+		switch step.Action {
+		case NotifyAssignee, NotifyQueue:
+		case EscalateToSession:
+			if step.Session == nil || *step.Session == "" {
+				return fmt.Errorf("reminder %d escalates, so it needs a session", i)
+			}
+		default:
+			return fmt.Errorf("unknown action of reminder %d: %s", i, step.Action)
+		}
+	}
+	return nil
+}
+
+// scheduleReminders stores a timer for every step of a human supervisor's reminder schedule,
+// counted from when the supervision request was created
+func scheduleReminders(ctx context.Context, supervisionRequestId uuid.UUID, supervisor Supervisor, createdAt time.Time, store TimerStore) error {
+	steps, err := parseReminderSchedule(supervisor.Attributes)
+	if err != nil {
+		return err
+	}
+
+	for i, step := range steps {
+		attributes := map[string]interface{}{"action": step.Action, "step": i}
+		if step.Session != nil {
+			attributes["session"] = *step.Session
+		}
+
+		fireAt := createdAt.Add(time.Duration(step.AfterSeconds) * time.Second)
+		if _, err := scheduleTimer(ctx, ReviewReminder, supervisionRequestId, fireAt, attributes, store); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fireReviewReminder takes the action of a reminder on a review, if it's still undecided
+func fireReviewReminder(ctx context.Context, timer DurableTimer, hub *Hub, store Store) error {
+	waiting, _, err := isWaiting(ctx, timer.SupervisionRequestId, store)
+	if err != nil || !waiting {
+		return err
+	}
+
+	details := map[string]interface{}{"timer_id": timer.Id}
+	if timer.Attributes != nil {
+		for key, value := range *timer.Attributes {
+			details[key] = value
+		}
+	}
+
+	action := NotifyAssignee
+	if value, ok := details["action"].(string); ok && value != "" {
+		action = ReminderAction(value)
+	}
+	details["action"] = action
+
+	switch action {
+	case NotifyAssignee:
+		if session := hub.remindAssignedReview(timer.SupervisionRequestId); session != nil {
+			details["session"] = *session
+		}
+	case NotifyQueue:
+		details["sessions"] = hub.remindQueue(timer.SupervisionRequestId)
+	case EscalateToSession:
+		session, _ := details["session"].(string)
+		if session == "" {
+			return fmt.Errorf("escalating reminder has no session")
+		}
+
+		supervisionRequest, err := store.GetSupervisionRequest(ctx, timer.SupervisionRequestId)
+		if err != nil {
+			return fmt.Errorf("error getting supervision request: %w", err)
+		}
+		if supervisionRequest == nil {
+			return nil
+		}
+
+		previous := hub.assignedSession(timer.SupervisionRequestId)
+		if err := hub.reassignReview(*supervisionRequest, session); err != nil {
+			return fmt.Errorf("error reassigning review to session %s: %w", session, err)
+		}
+
+		reassigned := map[string]interface{}{"session": session, "reminder_timer_id": timer.Id}
+		if previous != nil {
+			reassigned["previous_session"] = *previous
+		}
+		recordAuditEvent(ctx, SystemActor, AuditActionReviewReassigned, supervisionRequestResource, timer.SupervisionRequestId, reassigned, store)
+	default:
+		return fmt.Errorf("unknown reminder action: %s", action)
+	}
+
+	recordAuditEvent(ctx, SystemActor, AuditActionReviewReminded, supervisionRequestResource, timer.SupervisionRequestId, details, store)
+	return nil
+}
@@ -0,0 +1,130 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// replayChat is a chat of a run read for replaying it
+type replayChat struct {
+	request  []byte
+	response []byte
+	format   ChatFormat
+	choices  []AsteroidChoice
+	// messages are the messages of the chat's request, without its response
+	messages []AsteroidMessage
+}
+
+func getReplayChat(ctx context.Context, runId uuid.UUID, index int, store Store) (*replayChat, error) {
+	requestData, responseData, format, err := store.GetChat(ctx, runId, index)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chat: %w", err)
+	}
+
+	converter, err := converterForFormat(&format, store)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := converter.ToAsteroidMessages(ctx, requestData, responseData, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error converting messages: %w", err)
+	}
+	// The converted messages end with the response's first choice
+	if len(messages) > 0 {
+		messages = messages[:len(messages)-1]
+	}
+
+	choices, err := converter.ToAsteroidChoices(ctx, responseData, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error converting choices: %w", err)
+	}
+
+	return &replayChat{request: requestData, response: responseData, format: format, choices: choices, messages: messages}, nil
+}
+
+// pickedChoice returns the choice of a chat the agent went on with. Of several choices, that's the
+// one whose message is the latest assistant message of the next chat's request, so it's nil for a
+// run's last chat and when the next request doesn't send back any of them.
+func pickedChoice(chat replayChat, next *replayChat) (*int, *ReplayChoicePick) {
+	if len(chat.choices) == 1 {
+		picked, by := 0, OnlyChoice
+		return &picked, &by
+	}
+	if next == nil {
+		return nil, nil
+	}
+
+	for i := len(next.messages) - 1; i >= 0; i-- {
+		if next.messages[i].Role != AsteroidMessageRoleAssistant {
+			continue
+		}
+		for index, choice := range chat.choices {
+			if sameMessage([]AsteroidMessage{choice.Message}, next.messages[i:i+1], 0) {
+				by := NextRequest
+				return &index, &by
+			}
+		}
+		break
+	}
+	return nil, nil
+}
+
+func apiGetRunReplayHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	count, err := store.GetRunChatCount(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting chat count", err.Error())
+		return
+	}
+
+	// Chats are counted back from the latest
+	chats := make([]replayChat, 0, count)
+	for index := count - 1; index >= 0; index-- {
+		chat, err := getReplayChat(ctx, runId, index, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error reading chat", err.Error())
+			return
+		}
+		chats = append(chats, *chat)
+	}
+
+	replay := RunReplay{RunId: runId, Steps: make([]RunReplayStep, 0, len(chats))}
+	for i, chat := range chats {
+		var next *replayChat
+		if i+1 < len(chats) {
+			next = &chats[i+1]
+		}
+
+		format := chat.format
+		step := RunReplayStep{
+			Index: i,
+			Chat: AsteroidChat{
+				Format:       &format,
+				RequestData:  base64.StdEncoding.EncodeToString(chat.request),
+				ResponseData: base64.StdEncoding.EncodeToString(chat.response),
+			},
+			Choices: len(chat.choices),
+		}
+		step.PickedChoice, step.PickedBy = pickedChoice(chat, next)
+		replay.Steps = append(replay.Steps, step)
+	}
+
+	respondJSON(w, replay, http.StatusOK)
+}
@@ -0,0 +1,291 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxResourceReferences is the most references a resource query returns
+const maxResourceReferences = 1000
+
+var (
+	arnPattern = regexp.MustCompile(`^arn:(aws[a-zA-Z-]*):([a-zA-Z0-9-]+):([a-zA-Z0-9-]*):([0-9]{12}|aws)?:(.+)$`)
+
+	// sqlTablePattern finds the tables a SQL statement reads or writes, optionally schema qualified and quoted
+	sqlTablePattern = regexp.MustCompile("(?i)\\b(?:from|join|into|update|table)\\s+([`\"\\[]?[a-z_][\\w$]*[`\"\\]]?(?:\\.[`\"\\[]?[a-z_][\\w$]*[`\"\\]]?)*)")
+	sqlPattern      = regexp.MustCompile(`(?is)^\s*(select|insert|update|delete|with|create|alter|drop|truncate|merge)\b`)
+	windowsPath     = regexp.MustCompile(`^[a-zA-Z]:\\`)
+)
+
+// tableArgumentNames are arguments whose values name database tables
+var tableArgumentNames = map[string]bool{"table": true, "table_name": true, "tables": true}
+
+// sqlArgumentNames are arguments whose values are SQL, even when they don't start like a statement
+var sqlArgumentNames = map[string]bool{"query": true, "sql": true, "statement": true}
+
+type resourceMatch struct {
+	kind       ResourceKind
+	identifier string
+}
+
+// normalizeURL drops credentials, queries and fragments, and the case of the scheme and host
+func normalizeURL(u *url.URL) string {
+	host := strings.ToLower(u.Host)
+	p := u.Path
+	if p != "" {
+		p = strings.TrimSuffix(path.Clean(p), "/")
+	}
+	return strings.ToLower(u.Scheme) + "://" + host + p
+}
+
+// normalizePath cleans a file path, so the same file always has the same identifier
+func normalizePath(p string) string {
+	return path.Clean(strings.ReplaceAll(p, `\`, "/"))
+}
+
+// normalizeTable unquotes and lowercases a table name
+func normalizeTable(table string) string {
+	return strings.ToLower(strings.NewReplacer("`", "", `"`, "", "[", "", "]", "").Replace(table))
+}
+
+// classifyResource reports what kind of resource a string identifies, if any, and its normalized identifier
+func classifyResource(value string) []resourceMatch {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.ContainsAny(value, "\n\r\t") {
+		return nil
+	}
+
+	if m := arnPattern.FindStringSubmatch(value); m != nil {
+		arn := fmt.Sprintf("arn:%s:%s:%s:%s:%s", strings.ToLower(m[1]), strings.ToLower(m[2]), strings.ToLower(m[3]), m[4], m[5])
+		matches := []resourceMatch{{kind: Arn, identifier: arn}}
+
+		// S3 ARNs name the same objects as s3:// URLs, so they're found by either
+		if strings.ToLower(m[2]) == "s3" && m[3] == "" && m[4] == "" {
+			bucket, key, _ := strings.Cut(m[5], "/")
+			matches = append(matches, resourceMatch{kind: Url, identifier: normalizeURL(&url.URL{Scheme: "s3", Host: bucket, Path: "/" + key})})
+		}
+		return matches
+	}
+
+	if !strings.ContainsRune(value, ' ') {
+		if u, err := url.Parse(value); err == nil && u.Scheme != "" && u.Host != "" {
+			if strings.EqualFold(u.Scheme, "file") {
+				return []resourceMatch{{kind: FilePath, identifier: normalizePath(u.Path)}}
+			}
+			return []resourceMatch{{kind: Url, identifier: normalizeURL(u)}}
+		}
+	}
+
+	if (strings.HasPrefix(value, "/") && len(value) > 1 && !strings.HasPrefix(value, "//")) ||
+		strings.HasPrefix(value, "~/") || windowsPath.MatchString(value) {
+		return []resourceMatch{{kind: FilePath, identifier: normalizePath(value)}}
+	}
+
+	return nil
+}
+
+// sqlTables returns the tables a SQL statement refers to
+func sqlTables(statement string) []resourceMatch {
+	var matches []resourceMatch
+	for _, m := range sqlTablePattern.FindAllStringSubmatch(statement, -1) {
+		matches = append(matches, resourceMatch{kind: DbTable, identifier: normalizeTable(m[1])})
+	}
+	return matches
+}
+
+// extractResourceReferences finds the external resources in a tool call's JSON arguments, keyed by
+// where each was found. Arguments that aren't JSON are treated as a single string.
+func extractResourceReferences(arguments string) map[resourceMatch][]string {
+	found := make(map[resourceMatch][]string)
+	add := func(argument string, matches []resourceMatch) {
+		for _, match := range matches {
+			if !slices.Contains(found[match], argument) {
+				found[match] = append(found[match], argument)
+			}
+		}
+	}
+
+	var walk func(argument string, key string, value interface{})
+	walk = func(argument string, key string, value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for k, child := range v {
+				walk(argument+"."+k, strings.ToLower(k), child)
+			}
+		case []interface{}:
+			for i, child := range v {
+				walk(fmt.Sprintf("%s[%d]", argument, i), key, child)
+			}
+		case string:
+			switch {
+			case tableArgumentNames[key]:
+				if table := normalizeTable(strings.TrimSpace(v)); table != "" {
+					add(argument, []resourceMatch{{kind: DbTable, identifier: table}})
+				}
+			case sqlArgumentNames[key] || sqlPattern.MatchString(v):
+				add(argument, sqlTables(v))
+			default:
+				add(argument, classifyResource(v))
+			}
+		}
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(arguments), &parsed); err != nil {
+		parsed = arguments
+	}
+	walk("$", "", parsed)
+
+	return found
+}
+
+// getResourceReferences returns the references stored for a tool call's arguments
+func getResourceReferences(runId uuid.UUID, toolCall AsteroidToolCall, createdAt time.Time) []ResourceReference {
+	if toolCall.Arguments == nil {
+		return nil
+	}
+
+	references := make([]ResourceReference, 0)
+	for match, arguments := range extractResourceReferences(*toolCall.Arguments) {
+		for _, argument := range arguments {
+			references = append(references, ResourceReference{
+				ToolCallId: toolCall.Id,
+				RunId:      runId,
+				Kind:       match.kind,
+				Identifier: match.identifier,
+				Argument:   argument,
+				CreatedAt:  createdAt,
+			})
+		}
+	}
+
+	sort.Slice(references, func(i, j int) bool {
+		if references[i].Identifier != references[j].Identifier {
+			return references[i].Identifier < references[j].Identifier
+		}
+		return references[i].Argument < references[j].Argument
+	})
+
+	return references
+}
+
+// recordResourceReferences stores the resources the tool calls of a chat refer to. Failing to link
+// resources doesn't fail the chat, so errors are only logged.
+func recordResourceReferences(ctx context.Context, runId uuid.UUID, choices []AsteroidChoice, store ResourceStore) {
+	now := time.Now()
+
+	references := make([]ResourceReference, 0)
+	for _, choice := range choices {
+		if choice.Message.ToolCalls == nil {
+			continue
+		}
+		for _, toolCall := range *choice.Message.ToolCalls {
+			references = append(references, getResourceReferences(runId, toolCall, now)...)
+		}
+	}
+
+	if len(references) == 0 {
+		return
+	}
+
+	if err := store.CreateResourceReferences(ctx, references); err != nil {
+		log.Printf("Error recording resource references for run %s: %v", runId, err)
+	}
+}
+
+// normalizeResourceQuery normalizes an identifier being searched for the same way stored ones are
+func normalizeResourceQuery(identifier string, kind *ResourceKind) string {
+	if kind != nil && *kind == DbTable {
+		return normalizeTable(strings.TrimSpace(identifier))
+	}
+
+	for _, match := range classifyResource(identifier) {
+		if kind == nil || match.kind == *kind {
+			return match.identifier
+		}
+	}
+
+	return strings.TrimSpace(identifier)
+}
+
+func apiGetToolCallResourcesHandler(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
+		return
+	}
+
+	if toolCall == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call not found", "")
+		return
+	}
+
+	references, err := store.GetToolCallResourceReferences(ctx, toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting resource references", err.Error())
+		return
+	}
+
+	respondJSON(w, references, http.StatusOK)
+}
+
+func apiGetProjectResourceReferencesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, params GetProjectResourceReferencesParams, store Store) {
+	ctx := r.Context()
+
+	if strings.TrimSpace(params.Identifier) == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "identifier is required", "")
+		return
+	}
+
+	match := Exact
+	if params.Match != nil {
+		match = *params.Match
+	}
+	if match != Exact && match != Prefix {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown match: %s", match), "")
+		return
+	}
+
+	if params.Kind != nil {
+		switch *params.Kind {
+		case Url, Arn, FilePath, DbTable:
+		default:
+			sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown resource kind: %s", *params.Kind), "")
+			return
+		}
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	identifier := normalizeResourceQuery(params.Identifier, params.Kind)
+	references, err := store.GetProjectResourceReferences(ctx, projectId, identifier, match == Prefix, params.Kind, maxResourceReferences)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting resource references", err.Error())
+		return
+	}
+
+	respondJSON(w, references, http.StatusOK)
+}
@@ -0,0 +1,351 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sashabaranov/go-openai"
+)
+
+const toolCallResultResource = "tool_call_result"
+
+// maxToolCallResultBytes bounds the results agents report, which are stored whole
+const maxToolCallResultBytes = 1 << 20
+
+// validateResultSupervisors checks that result supervisors have unique names, known actions and
+// patterns that compile
+func validateResultSupervisors(supervisors []ResultSupervisor) error {
+	names := make(map[string]bool)
+	for _, supervisor := range supervisors {
+		if supervisor.Name == "" {
+			return fmt.Errorf("result supervisors need a name")
+		}
+		if names[supervisor.Name] {
+			return fmt.Errorf("duplicate result supervisor %s", supervisor.Name)
+		}
+		names[supervisor.Name] = true
+
+		if supervisor.Pattern == "" {
+			return fmt.Errorf("result supervisor %s needs a pattern", supervisor.Name)
+		}
+		if _, err := regexp.Compile(supervisor.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern of result supervisor %s: %w", supervisor.Name, err)
+		}
+
+		switch supervisor.Action {
+		case FlagResult, QuarantineResult, HoldResult:
+		default:
+			return fmt.Errorf("unknown action of result supervisor %s: %s", supervisor.Name, supervisor.Action)
+		}
+	}
+	return nil
+}
+
+// superviseToolCallResult returns the verdict of a project's result supervisors on a tool call's
+// result. The first supervisor of the tool that matches decides it.
+func superviseToolCallResult(supervisors []ResultSupervisor, toolName string, result *ToolCallResult) error {
+	result.Status = ResultAccepted
+	for _, supervisor := range supervisors {
+		if supervisor.ToolName != nil && *supervisor.ToolName != toolName {
+			continue
+		}
+
+		pattern, err := regexp.Compile(supervisor.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern of result supervisor %s: %w", supervisor.Name, err)
+		}
+		if !pattern.MatchString(result.Result) {
+			continue
+		}
+
+		switch supervisor.Action {
+		case FlagResult:
+			result.Status = ResultFlagged
+		case QuarantineResult:
+			result.Status = ResultQuarantined
+		case HoldResult:
+			result.Status = ResultHeld
+		}
+		name := supervisor.Name
+		result.Supervisor = &name
+		result.Reason = supervisor.Reason
+		return nil
+	}
+	return nil
+}
+
+// withheldToolResultContent is what the model is shown instead of a tool call result it's kept from
+func withheldToolResultContent(result ToolCallResult) string {
+	if result.Status == ResultHeld {
+		return "[The result of this tool call is held for review and can't be shown yet]"
+	}
+	if result.Reason != nil && *result.Reason != "" {
+		return fmt.Sprintf("[The result of this tool call was quarantined: %s]", *result.Reason)
+	}
+	return "[The result of this tool call was quarantined]"
+}
+
+// withholdToolCallResults replaces the content of tool messages whose results were quarantined or are
+// held for review, so proxied requests don't feed them back to the model
+func withholdToolCallResults(ctx context.Context, runId uuid.UUID, messages []openai.ChatCompletionMessage, store Store) error {
+	withheld, err := store.GetWithheldToolCallResults(ctx, runId)
+	if err != nil {
+		return fmt.Errorf("error getting withheld tool call results: %w", err)
+	}
+	if len(withheld) == 0 {
+		return nil
+	}
+
+	for i := range messages {
+		if messages[i].Role != openai.ChatMessageRoleTool {
+			continue
+		}
+		if result, ok := withheld[messages[i].ToolCallID]; ok {
+			messages[i].Content = withheldToolResultContent(result)
+			messages[i].MultiContent = nil
+		}
+	}
+	return nil
+}
+
+func apiReportToolCallResultHandler(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var report ToolCallResultReport
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxToolCallResultBytes)).Decode(&report); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	toolCall, err := store.GetToolCall(ctx, toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
+		return
+	}
+
+	if toolCall == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call not found", "")
+		return
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool", err.Error())
+		return
+	}
+
+	if tool == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call not found", "")
+		return
+	}
+
+	project, err := getProjectForRun(ctx, tool.RunId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project for tool call", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call not found", "")
+		return
+	}
+
+	supervisors, err := store.GetResultSupervisors(ctx, project.Id)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting result supervisors", err.Error())
+		return
+	}
+
+	result := ToolCallResult{
+		ToolCallId: toolCallId,
+		Result:     report.Result,
+		CreatedAt:  time.Now(),
+	}
+	if err := superviseToolCallResult(supervisors, tool.Name, &result); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error supervising tool call result", err.Error())
+		return
+	}
+
+	created, err := store.CreateToolCallResult(ctx, result)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating tool call result", err.Error())
+		return
+	}
+
+	if !created {
+		sendErrorResponse(w, http.StatusConflict, "Tool call result already reported", "")
+		return
+	}
+
+	// Reviewers are told of held results, as nothing else brings them to their attention
+	if result.Status == ResultHeld {
+		emitWebhookEvent(ctx, WebhookPayload{
+			Event:          ToolCallResultHeld,
+			ProjectId:      project.Id,
+			RunId:          &tool.RunId,
+			ToolCallId:     &toolCallId,
+			ToolCallResult: &result,
+		}, store)
+	}
+
+	respondJSON(w, result, http.StatusCreated)
+}
+
+func apiGetToolCallResultHandler(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	result, err := store.GetToolCallResult(ctx, toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call result", err.Error())
+		return
+	}
+
+	if result == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call result not found", "")
+		return
+	}
+
+	respondJSON(w, result, http.StatusOK)
+}
+
+func apiDecideToolCallResultHandler(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var verdict ToolCallResultVerdict
+	if err := json.NewDecoder(r.Body).Decode(&verdict); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	switch verdict.Status {
+	case ResultAccepted, ResultFlagged, ResultQuarantined:
+	default:
+		sendErrorResponse(w, http.StatusBadRequest, "invalid verdict", fmt.Sprintf("status must be %s, %s or %s", ResultAccepted, ResultFlagged, ResultQuarantined))
+		return
+	}
+
+	result, err := store.GetToolCallResult(ctx, toolCallId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call result", err.Error())
+		return
+	}
+
+	if result == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call result not found", "")
+		return
+	}
+
+	if result.Status != ResultHeld {
+		sendErrorResponse(w, http.StatusConflict, "Tool call result isn't held for review", "")
+		return
+	}
+
+	actor := actorFromContext(ctx)
+	now := time.Now()
+	result.Status = verdict.Status
+	result.Reason = verdict.Reason
+	result.DecidedBy = &actor
+	result.DecidedAt = &now
+
+	decided, err := store.DecideToolCallResult(ctx, *result)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error deciding tool call result", err.Error())
+		return
+	}
+
+	if !decided {
+		sendErrorResponse(w, http.StatusConflict, "Tool call result isn't held for review", "")
+		return
+	}
+
+	recordAuditEvent(ctx, actor, AuditActionResultDecided, toolCallResultResource, toolCallId, map[string]interface{}{
+		"status":     result.Status,
+		"supervisor": result.Supervisor,
+	}, store)
+
+	respondJSON(w, result, http.StatusOK)
+}
+
+func apiGetProjectToolCallResultsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, params GetProjectToolCallResultsParams, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	results, err := store.GetProjectToolCallResults(ctx, projectId, params.Status)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call results", err.Error())
+		return
+	}
+
+	respondJSON(w, results, http.StatusOK)
+}
+
+func apiGetProjectResultSupervisorsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	supervisors, err := store.GetResultSupervisors(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting result supervisors", err.Error())
+		return
+	}
+
+	respondJSON(w, supervisors, http.StatusOK)
+}
+
+func apiSetProjectResultSupervisorsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var supervisors []ResultSupervisor
+	if err := json.NewDecoder(r.Body).Decode(&supervisors); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateResultSupervisors(supervisors); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid result supervisor", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := store.SetResultSupervisors(ctx, projectId, supervisors); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting result supervisors", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
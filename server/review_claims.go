@@ -0,0 +1,95 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// claimedByOther returns the claim on a review if a user other than the deciding one holds it.
+// Decisions of no user, like those of agents' keys, don't take claims into account.
+func claimedByOther(ctx context.Context, supervisionRequestId uuid.UUID, userId *uuid.UUID, store ReviewerStore) (*ReviewClaim, error) {
+	claim, err := store.GetReviewClaim(ctx, supervisionRequestId)
+	if err != nil {
+		return nil, err
+	}
+	if claim == nil || userId == nil || claim.UserId == *userId {
+		return nil, nil
+	}
+	return claim, nil
+}
+
+func apiClaimSupervisionRequestHandler(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	userId := userFromContext(ctx)
+	if userId == nil {
+		sendErrorResponse(w, http.StatusForbidden, "the API key doesn't belong to a user", "")
+		return
+	}
+
+	supervisionRequest, err := store.GetSupervisionRequest(ctx, supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision request", err.Error())
+		return
+	}
+
+	if supervisionRequest == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervision request not found", "")
+		return
+	}
+
+	waiting, _, err := isWaiting(ctx, supervisionRequestId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision status", err.Error())
+		return
+	}
+
+	if !waiting {
+		sendErrorResponse(w, http.StatusConflict, "supervision request isn't waiting for a decision", "")
+		return
+	}
+
+	claim, err := store.ClaimReview(ctx, ReviewClaim{SupervisionRequestId: supervisionRequestId, UserId: *userId, ClaimedAt: time.Now()})
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error claiming supervision request", err.Error())
+		return
+	}
+
+	if claim.UserId != *userId {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("Supervision request %s is claimed by user %s", supervisionRequestId, claim.UserId), "")
+		return
+	}
+
+	recordAuditEvent(ctx, userActor(*userId), AuditActionReviewClaimed, supervisionRequestResource, supervisionRequestId,
+		map[string]interface{}{"user_id": *userId}, store)
+	respondJSON(w, claim, http.StatusOK)
+}
+
+func apiReleaseSupervisionRequestHandler(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	userId := userFromContext(ctx)
+	if userId == nil {
+		sendErrorResponse(w, http.StatusForbidden, "the API key doesn't belong to a user", "")
+		return
+	}
+
+	released, err := store.ReleaseReview(ctx, supervisionRequestId, *userId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error releasing supervision request", err.Error())
+		return
+	}
+
+	if !released {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("Supervision request %s isn't claimed by user %s", supervisionRequestId, *userId), "")
+		return
+	}
+
+	recordAuditEvent(ctx, userActor(*userId), AuditActionReviewReleased, supervisionRequestResource, supervisionRequestId,
+		map[string]interface{}{"user_id": *userId}, store)
+	respondJSON(w, nil, http.StatusNoContent)
+}
@@ -0,0 +1,199 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// normalizeSkills lowercases and deduplicates skills, so they match however they were written
+func normalizeSkills(skills []string) []string {
+	normalized := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		for _, parsed := range parseSkills(skill) {
+			if !slices.Contains(normalized, parsed) {
+				normalized = append(normalized, parsed)
+			}
+		}
+	}
+	return normalized
+}
+
+// validateRoutingRules checks that every rule requires a skill and names a known risk tier
+func validateRoutingRules(rules []RoutingRule) error {
+	for i, rule := range rules {
+		if len(normalizeSkills(rule.RequiredSkills)) == 0 {
+			return fmt.Errorf("routing rule %d doesn't require any skills", i)
+		}
+		if rule.MinRiskTier != nil {
+			if _, ok := riskTierRank[*rule.MinRiskTier]; !ok {
+				return fmt.Errorf("unknown risk tier of routing rule %d: %s", i, *rule.MinRiskTier)
+			}
+		}
+	}
+	return nil
+}
+
+// routingRuleMatches reports whether a rule applies to a tool call
+func routingRuleMatches(rule RoutingRule, tool Tool, riskTier *RiskTier, reversibility Reversibility) bool {
+	if rule.ToolName != nil && *rule.ToolName != "*" && *rule.ToolName != tool.Name {
+		return false
+	}
+	if rule.Category != nil && !hasSkills(toolCategories(tool), parseSkills(*rule.Category)) {
+		return false
+	}
+	if rule.MinRiskTier != nil && (riskTier == nil || riskTierRank[*riskTier] < riskTierRank[*rule.MinRiskTier]) {
+		return false
+	}
+	if rule.Irreversible != nil && *rule.Irreversible != (reversibility == Irreversible) {
+		return false
+	}
+	return true
+}
+
+// getRequiredSkills returns the skills the routing rules of a tool call's project require of its reviewers
+func getRequiredSkills(ctx context.Context, toolCall AsteroidToolCall, tool Tool, store Store) ([]string, error) {
+	project, err := getProjectForRun(ctx, tool.RunId, store)
+	if err != nil || project == nil {
+		return nil, err
+	}
+
+	rules, err := store.GetRoutingRules(ctx, project.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting routing rules: %w", err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	riskTier, err := getToolRiskTier(ctx, tool, store)
+	if err != nil {
+		return nil, err
+	}
+
+	var arguments string
+	if stored := storedToolCallArguments(toolCall); stored != nil {
+		arguments = *stored
+	}
+	reversibility, _ := assessReversibility(tool, arguments)
+
+	skills := make([]string, 0)
+	for _, rule := range rules {
+		if !routingRuleMatches(rule, tool, riskTier, reversibility) {
+			continue
+		}
+		skills = append(skills, rule.RequiredSkills...)
+	}
+
+	return normalizeSkills(skills), nil
+}
+
+func apiGetProjectRoutingRulesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	rules, err := store.GetRoutingRules(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting routing rules", err.Error())
+		return
+	}
+
+	respondJSON(w, rules, http.StatusOK)
+}
+
+func apiSetProjectRoutingRulesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var rules []RoutingRule
+	if err := json.NewDecoder(r.Body).Decode(&rules); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateRoutingRules(rules); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid routing rule", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	for i := range rules {
+		rules[i].RequiredSkills = normalizeSkills(rules[i].RequiredSkills)
+	}
+
+	if err := store.SetRoutingRules(ctx, projectId, rules); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting routing rules", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiGetReviewersHandler(w http.ResponseWriter, r *http.Request, store ReviewerStore) {
+	reviewers, err := store.GetReviewers(r.Context())
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting reviewers", err.Error())
+		return
+	}
+
+	respondJSON(w, reviewers, http.StatusOK)
+}
+
+func apiSetReviewerHandler(w http.ResponseWriter, r *http.Request, session string, store ReviewerStore) {
+	var reviewer Reviewer
+	if err := json.NewDecoder(r.Body).Decode(&reviewer); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if session == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "session is required", "")
+		return
+	}
+
+	if reviewer.Locale != nil && *reviewer.Locale != "" {
+		locale := normalizeLocale(*reviewer.Locale)
+		if locale == "" {
+			sendErrorResponse(w, http.StatusBadRequest, "unsupported locale", fmt.Sprintf("supported locales: %s", strings.Join(supportedLocales(), ", ")))
+			return
+		}
+		reviewer.Locale = &locale
+	}
+
+	now := time.Now()
+	reviewer.Session = session
+	reviewer.Skills = normalizeSkills(reviewer.Skills)
+	reviewer.UpdatedAt = &now
+
+	if err := store.SetReviewer(r.Context(), reviewer); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting reviewer", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
@@ -0,0 +1,544 @@
+package asteroid
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	runArchiveFormatVersion = 1
+	// runArchiveFileName is the file a tar_gz archive holds the run in
+	runArchiveFileName = "run.json"
+	maxRunArchiveBytes = 64 << 20
+)
+
+// archiveRun reads a run with everything it takes to recreate it and its supervision
+func archiveRun(ctx context.Context, run Run, store Store) (*RunArchive, error) {
+	archive := RunArchive{
+		FormatVersion: runArchiveFormatVersion,
+		ExportedAt:    time.Now(),
+		Run:           run,
+		Tools:         make([]RunArchiveTool, 0),
+		Chats:         make([]RunArchiveChat, 0),
+		ToolCalls:     make([]RunArchiveToolCall, 0),
+	}
+
+	tools, err := store.GetRunTools(ctx, run.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tools: %w", err)
+	}
+	for _, tool := range tools {
+		chains, err := store.GetSupervisorChains(ctx, *tool.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting chains of tool %s: %w", *tool.Id, err)
+		}
+		archive.Tools = append(archive.Tools, RunArchiveTool{Tool: tool, Chains: chains})
+	}
+
+	count, err := store.GetRunChatCount(ctx, run.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chat count: %w", err)
+	}
+	// Chats are counted back from the latest
+	for index := count - 1; index >= 0; index-- {
+		chat, err := archiveChat(ctx, run.Id, index, store)
+		if err != nil {
+			return nil, err
+		}
+		archive.Chats = append(archive.Chats, *chat)
+	}
+
+	toolCalls, err := store.GetRunToolCalls(ctx, run.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool calls: %w", err)
+	}
+	for _, toolCall := range toolCalls {
+		archived, err := archiveToolCall(ctx, toolCall, store)
+		if err != nil {
+			return nil, err
+		}
+		archive.ToolCalls = append(archive.ToolCalls, *archived)
+	}
+
+	return &archive, nil
+}
+
+func archiveChat(ctx context.Context, runId uuid.UUID, index int, store Store) (*RunArchiveChat, error) {
+	requestData, responseData, format, err := store.GetChat(ctx, runId, index)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chat: %w", err)
+	}
+
+	converter, err := converterForFormat(&format, store)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := converter.ToAsteroidMessages(ctx, requestData, responseData, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error converting messages: %w", err)
+	}
+
+	choices, err := converter.ToAsteroidChoices(ctx, responseData, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error converting choices: %w", err)
+	}
+
+	return &RunArchiveChat{
+		Chat: AsteroidChat{
+			Format:       &format,
+			RequestData:  base64.StdEncoding.EncodeToString(requestData),
+			ResponseData: base64.StdEncoding.EncodeToString(responseData),
+		},
+		Messages: messages,
+		Choices:  choices,
+	}, nil
+}
+
+func archiveToolCall(ctx context.Context, toolCall AsteroidToolCall, store Store) (*RunArchiveToolCall, error) {
+	decision, err := getToolCallDecision(ctx, toolCall.Id, store)
+	if err != nil {
+		return nil, fmt.Errorf("error getting decision of tool call %s: %w", toolCall.Id, err)
+	}
+
+	archived := RunArchiveToolCall{ToolCall: toolCall, Decision: decision, ChainExecutions: make([]RunArchiveChainExecution, 0)}
+
+	executionIds, err := store.GetChainExecutionsFromToolCall(ctx, toolCall.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chain executions of tool call %s: %w", toolCall.Id, err)
+	}
+
+	for _, executionId := range executionIds {
+		state, err := store.GetChainExecutionState(ctx, executionId)
+		if err != nil {
+			return nil, fmt.Errorf("error getting chain execution %s: %w", executionId, err)
+		}
+
+		execution := RunArchiveChainExecution{ChainExecution: state.ChainExecution, SupervisionRequests: make([]RunArchiveSupervisionRequest, 0, len(state.SupervisionRequests))}
+		for _, requestState := range state.SupervisionRequests {
+			statuses, err := store.GetSupervisionStatusesForRequest(ctx, *requestState.SupervisionRequest.Id)
+			if err != nil {
+				return nil, fmt.Errorf("error getting statuses of supervision request %s: %w", *requestState.SupervisionRequest.Id, err)
+			}
+			execution.SupervisionRequests = append(execution.SupervisionRequests, RunArchiveSupervisionRequest{
+				SupervisionRequest: requestState.SupervisionRequest,
+				Statuses:           statuses,
+				Result:             requestState.Result,
+			})
+		}
+
+		archived.ChainExecutions = append(archived.ChainExecutions, execution)
+	}
+
+	return &archived, nil
+}
+
+// writeRunArchiveTarGz writes an archive as a gzipped tar file holding it as runArchiveFileName
+func writeRunArchiveTarGz(w io.Writer, data []byte, modTime time.Time) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	header := &tar.Header{Name: runArchiveFileName, Mode: 0o644, Size: int64(len(data)), ModTime: modTime}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	if _, err := tw.Write(data); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// readRunArchiveTarGz reads the archive out of a gzipped tar file written by writeRunArchiveTarGz
+func readRunArchiveTarGz(r io.Reader) ([]byte, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("archive isn't gzipped: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("archive doesn't hold %s", runArchiveFileName)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("archive isn't a tar file: %w", err)
+		}
+		if header.Name != runArchiveFileName {
+			continue
+		}
+		if header.Size > maxRunArchiveBytes {
+			return nil, fmt.Errorf("%s is larger than %d bytes", runArchiveFileName, maxRunArchiveBytes)
+		}
+		return io.ReadAll(io.LimitReader(tr, maxRunArchiveBytes))
+	}
+}
+
+// isTerminalStatus reports whether a supervision request with the status is done being supervised
+func isTerminalStatus(status Status) bool {
+	return status == Completed || status == Failed || status == Timeout
+}
+
+// validateRunArchive checks an archive can be imported before anything is created, so a bad archive
+// doesn't leave half a run behind
+func validateRunArchive(archive RunArchive) error {
+	if archive.FormatVersion != runArchiveFormatVersion {
+		return fmt.Errorf("archives of format version %d can't be imported, only %d", archive.FormatVersion, runArchiveFormatVersion)
+	}
+
+	chainSupervisors := make(map[uuid.UUID]map[uuid.UUID]bool)
+	toolIds := make(map[uuid.UUID]bool, len(archive.Tools))
+	for _, tool := range archive.Tools {
+		if tool.Tool.Id == nil {
+			return fmt.Errorf("tool %s has no ID", tool.Tool.Name)
+		}
+		toolIds[*tool.Tool.Id] = true
+
+		for _, chain := range tool.Chains {
+			supervisors := make(map[uuid.UUID]bool, len(chain.Supervisors))
+			for _, supervisor := range chain.Supervisors {
+				if supervisor.Id == nil {
+					return fmt.Errorf("supervisor %s of chain %s has no ID", supervisor.Name, chain.ChainId)
+				}
+				supervisors[*supervisor.Id] = true
+			}
+			chainSupervisors[chain.ChainId] = supervisors
+		}
+	}
+
+	for i, chat := range archive.Chats {
+		converter, err := converterForFormat(chat.Chat.Format, nil)
+		if err != nil {
+			return fmt.Errorf("chat %d: %w", i, err)
+		}
+		if _, err := converter.ValidateB64EncodedRequest(chat.Chat.RequestData); err != nil {
+			return fmt.Errorf("request of chat %d: %w", i, err)
+		}
+		if _, err := converter.ValidateB64EncodedResponse(chat.Chat.ResponseData); err != nil {
+			return fmt.Errorf("response of chat %d: %w", i, err)
+		}
+	}
+
+	for _, toolCall := range archive.ToolCalls {
+		if !toolIds[toolCall.ToolCall.ToolId] {
+			return fmt.Errorf("tool call %s is of a tool that isn't in the archive", toolCall.ToolCall.Id)
+		}
+		if toolCall.ToolCall.CallId == nil {
+			return fmt.Errorf("tool call %s has no call_id", toolCall.ToolCall.Id)
+		}
+
+		for _, execution := range toolCall.ChainExecutions {
+			supervisors, ok := chainSupervisors[execution.ChainExecution.ChainId]
+			if !ok {
+				return fmt.Errorf("tool call %s was supervised by chain %s, which isn't in the archive", toolCall.ToolCall.Id, execution.ChainExecution.ChainId)
+			}
+			for _, request := range execution.SupervisionRequests {
+				if !supervisors[request.SupervisionRequest.SupervisorId] {
+					return fmt.Errorf("supervisor %s isn't in chain %s", request.SupervisionRequest.SupervisorId, execution.ChainExecution.ChainId)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// importSupervisor returns the ID of a supervisor with the values of an archived one, creating it if
+// there's none
+func importSupervisor(ctx context.Context, supervisor Supervisor, store Store) (uuid.UUID, error) {
+	existing, err := store.GetSupervisorFromValues(ctx, supervisor.Code, supervisor.Name, supervisor.Description, supervisor.Type, supervisor.Attributes)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error getting supervisor: %w", err)
+	}
+	if existing != nil && existing.Id != nil {
+		return *existing.Id, nil
+	}
+
+	supervisor.Id = nil
+	supervisor.CreatedAt = time.Now()
+	id, err := store.CreateSupervisor(ctx, supervisor)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("error creating supervisor: %w", err)
+	}
+	return id, nil
+}
+
+// importRun recreates an archived run as a run of a task. The archive has to be valid.
+func importRun(ctx context.Context, taskId uuid.UUID, archive RunArchive, store Store) (*RunImportResult, error) {
+	run := archive.Run
+	run.TaskId, run.AgentId = taskId, nil
+	runId, err := store.CreateRun(ctx, run)
+	if err != nil {
+		return nil, fmt.Errorf("error creating run: %w", err)
+	}
+	if run.Status != nil && *run.Status != Pending {
+		if err := store.UpdateRunStatus(ctx, runId, *run.Status); err != nil {
+			return nil, fmt.Errorf("error setting run status: %w", err)
+		}
+	}
+	if run.Result != nil {
+		if err := store.UpdateRunResult(ctx, runId, *run.Result); err != nil {
+			return nil, fmt.Errorf("error setting run result: %w", err)
+		}
+	}
+
+	// Tools and their chains come first, so the chats' tool calls are linked to them
+	supervisorIds := make(map[uuid.UUID]uuid.UUID)
+	chainIds := make(map[uuid.UUID]uuid.UUID)
+	for _, archived := range archive.Tools {
+		tool := archived.Tool
+		ignoredAttributes := []string{}
+		if tool.IgnoredAttributes != nil {
+			ignoredAttributes = *tool.IgnoredAttributes
+		}
+		created, err := store.CreateTool(ctx, runId, tool.Attributes, tool.Name, tool.Description, ignoredAttributes, tool.Code, tool.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("error creating tool %s: %w", tool.Name, err)
+		}
+
+		for _, chain := range archived.Chains {
+			ids := make([]uuid.UUID, 0, len(chain.Supervisors))
+			for _, supervisor := range chain.Supervisors {
+				id, ok := supervisorIds[*supervisor.Id]
+				if !ok {
+					id, err = importSupervisor(ctx, supervisor, store)
+					if err != nil {
+						return nil, err
+					}
+					supervisorIds[*supervisor.Id] = id
+				}
+				ids = append(ids, id)
+			}
+
+			request := ChainRequest{SupervisorIds: &ids, MinConfidence: chain.MinConfidence, Timeout: chain.Timeout}
+			if chain.SupervisorTimeouts != nil {
+				timeouts := make([]SupervisorTimeout, 0, len(*chain.SupervisorTimeouts))
+				for _, timeout := range *chain.SupervisorTimeouts {
+					timeout.SupervisorId = supervisorIds[timeout.SupervisorId]
+					timeouts = append(timeouts, timeout)
+				}
+				request.SupervisorTimeouts = &timeouts
+			}
+			if chain.SupervisorTiers != nil {
+				tiers := make([]SupervisorTier, 0, len(*chain.SupervisorTiers))
+				for _, tier := range *chain.SupervisorTiers {
+					tier.SupervisorId = supervisorIds[tier.SupervisorId]
+					tiers = append(tiers, tier)
+				}
+				request.SupervisorTiers = &tiers
+			}
+
+			chainId, err := store.CreateSupervisorChain(ctx, *created.Id, request)
+			if err != nil {
+				return nil, fmt.Errorf("error creating chain of tool %s: %w", tool.Name, err)
+			}
+			chainIds[chain.ChainId] = *chainId
+		}
+	}
+
+	// Tool calls are recreated from the chats and matched to the archived ones by their call_id
+	callIds := make(map[string][]uuid.UUID)
+	for _, archived := range archive.Chats {
+		converter, err := converterForFormat(archived.Chat.Format, store)
+		if err != nil {
+			return nil, err
+		}
+		requestData, err := converter.ValidateB64EncodedRequest(archived.Chat.RequestData)
+		if err != nil {
+			return nil, err
+		}
+		responseData, err := converter.ValidateB64EncodedResponse(archived.Chat.ResponseData)
+		if err != nil {
+			return nil, err
+		}
+
+		choices, err := converter.ToAsteroidChoices(ctx, responseData, runId)
+		if err != nil {
+			return nil, fmt.Errorf("error converting choices: %w", err)
+		}
+
+		format := Openai
+		if archived.Chat.Format != nil {
+			format = *archived.Chat.Format
+		}
+		if _, err := store.CreateChatRequest(ctx, runId, requestData, responseData, choices, string(format), []AsteroidMessage{}, parseChatUsage(responseData, format)); err != nil {
+			return nil, fmt.Errorf("error creating chat: %w", err)
+		}
+
+		for _, choice := range choices {
+			if choice.Message.ToolCalls == nil {
+				continue
+			}
+			for _, toolCall := range *choice.Message.ToolCalls {
+				if toolCall.CallId != nil {
+					callIds[*toolCall.CallId] = append(callIds[*toolCall.CallId], toolCall.Id)
+				}
+			}
+		}
+	}
+
+	result := RunImportResult{RunId: runId, ToolCallIds: make(map[string]uuid.UUID, len(archive.ToolCalls))}
+	for _, archived := range archive.ToolCalls {
+		ids := callIds[*archived.ToolCall.CallId]
+		if len(ids) == 0 {
+			return nil, fmt.Errorf("tool call %s isn't in the archive's chats", archived.ToolCall.Id)
+		}
+		toolCallId := ids[0]
+		callIds[*archived.ToolCall.CallId] = ids[1:]
+		result.ToolCallIds[archived.ToolCall.Id.String()] = toolCallId
+
+		for _, execution := range archived.ChainExecutions {
+			for _, request := range execution.SupervisionRequests {
+				// Requests that were still open would be reviewed again
+				if len(request.Statuses) == 0 || !isTerminalStatus(request.Statuses[len(request.Statuses)-1].Status) {
+					result.SkippedSupervisionRequests++
+					continue
+				}
+
+				supervisionRequest := SupervisionRequest{
+					SupervisorId:    supervisorIds[request.SupervisionRequest.SupervisorId],
+					PositionInChain: request.SupervisionRequest.PositionInChain,
+				}
+
+				var supervisionResult *SupervisionResult
+				if request.Result != nil {
+					copied := *request.Result
+					copied.Id = nil
+					if copied.ToolcallId != nil {
+						copied.ToolcallId = &toolCallId
+					}
+					supervisionResult = &copied
+				}
+
+				_, err := store.ImportSupervisionRequest(ctx, supervisionRequest, chainIds[execution.ChainExecution.ChainId], toolCallId, request.Statuses, supervisionResult)
+				if err != nil {
+					return nil, fmt.Errorf("error importing supervision of tool call %s: %w", archived.ToolCall.Id, err)
+				}
+			}
+		}
+	}
+
+	return &result, nil
+}
+
+func apiExportRunHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, params ExportRunParams, store Store) {
+	ctx := r.Context()
+
+	format := Json
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if format != Json && format != TarGz {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown archive format: %s", format), "")
+		return
+	}
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	archive, err := archiveRun(ctx, *run, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error exporting run", err.Error())
+		return
+	}
+
+	if format == Json {
+		respondJSON(w, archive, http.StatusOK)
+		return
+	}
+
+	data, err := json.Marshal(archive)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error marshalling run archive", err.Error())
+		return
+	}
+
+	var buffer bytes.Buffer
+	if err := writeRunArchiveTarGz(&buffer, data, archive.ExportedAt); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error writing run archive", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fmt.Sprintf("run-%s.tar.gz", runId)}))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buffer.Bytes())
+}
+
+func apiImportRunHandler(w http.ResponseWriter, r *http.Request, taskId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	body := http.MaxBytesReader(w, r.Body, maxRunArchiveBytes)
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	var data []byte
+	var err error
+	if mediaType == "application/gzip" {
+		data, err = readRunArchiveTarGz(body)
+	} else {
+		data, err = io.ReadAll(body)
+	}
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			sendErrorResponse(w, http.StatusRequestEntityTooLarge, "run archive is too large", fmt.Sprintf("archives can be at most %d bytes", maxRunArchiveBytes))
+			return
+		}
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid run archive", err.Error())
+		return
+	}
+
+	var archive RunArchive
+	if err := json.Unmarshal(data, &archive); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	task, err := store.GetTask(ctx, taskId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting task", err.Error())
+		return
+	}
+
+	if task == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Task not found", "")
+		return
+	}
+
+	if err := validateRunArchive(archive); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid run archive", err.Error())
+		return
+	}
+
+	result, err := importRun(ctx, taskId, archive, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error importing run", err.Error())
+		return
+	}
+
+	respondJSON(w, result, http.StatusCreated)
+}
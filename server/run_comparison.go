@@ -0,0 +1,209 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// maxComparedRuns bounds how many runs are compared at once, as each one's latest chat is converted
+const maxComparedRuns = 10
+
+// compareRun sums up a run and returns the messages of its latest chat, which are empty if it has
+// none
+func compareRun(ctx context.Context, run Run, store Store) (*RunComparisonEntry, []AsteroidMessage, error) {
+	entry := RunComparisonEntry{
+		RunId:  run.Id,
+		TaskId: run.TaskId,
+		Status: run.Status,
+		Tools:  make(map[string]int),
+	}
+
+	usage, err := store.GetRunChatUsage(ctx, run.Id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting chat usage: %w", err)
+	}
+	entry.Chats = len(usage)
+	for _, chat := range usage {
+		entry.PromptTokens += chat.PromptTokens
+		entry.CompletionTokens += chat.CompletionTokens
+	}
+
+	toolCalls, err := store.GetRunToolCalls(ctx, run.Id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting tool calls: %w", err)
+	}
+	entry.ToolCalls = len(toolCalls)
+	for _, toolCall := range toolCalls {
+		if toolCall.Name != nil {
+			entry.Tools[*toolCall.Name]++
+		}
+
+		decision, err := getToolCallDecision(ctx, toolCall.Id, store)
+		if err != nil {
+			return nil, nil, err
+		}
+		countDecision(&entry.Decisions, decision)
+	}
+
+	decisions := entry.Decisions
+	if decided := decisions.Approved + decisions.Rejected + decisions.Terminated + decisions.Modified; decided > 0 {
+		entry.RejectionRate = float64(decisions.Rejected+decisions.Terminated) / float64(decided)
+	}
+
+	if entry.Chats == 0 {
+		return &entry, nil, nil
+	}
+
+	// The latest chat holds the whole conversation so far
+	requestData, responseData, format, err := store.GetChat(ctx, run.Id, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting chat: %w", err)
+	}
+
+	converter, err := converterForFormat(&format, store)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages, err := converter.ToAsteroidMessages(ctx, requestData, responseData, run.Id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error converting messages: %w", err)
+	}
+	entry.Messages = len(messages)
+
+	return &entry, messages, nil
+}
+
+// findDivergence returns the first message at which the runs' conversations differ, or nil if they
+// are the same throughout
+func findDivergence(runIds []uuid.UUID, conversations [][]AsteroidMessage) *RunDivergence {
+	longest := 0
+	for _, messages := range conversations {
+		longest = max(longest, len(messages))
+	}
+
+	for index := range longest {
+		diverged := false
+		for _, messages := range conversations[1:] {
+			if !sameMessage(conversations[0], messages, index) {
+				diverged = true
+				break
+			}
+		}
+		if !diverged {
+			continue
+		}
+
+		divergence := RunDivergence{Index: index, Messages: make([]DivergentMessage, 0, len(runIds))}
+		for i, messages := range conversations {
+			message := DivergentMessage{RunId: runIds[i]}
+			if index < len(messages) {
+				message.Message = &messages[index]
+			}
+			divergence.Messages = append(divergence.Messages, message)
+		}
+		return &divergence
+	}
+	return nil
+}
+
+// sameMessage reports whether two conversations have the same message at an index, comparing roles,
+// content and the tools called with their arguments. A conversation that ended before it differs
+// from one that didn't.
+func sameMessage(a, b []AsteroidMessage, index int) bool {
+	if index >= len(a) || index >= len(b) {
+		return index >= len(a) && index >= len(b)
+	}
+
+	first, second := a[index], b[index]
+	if first.Role != second.Role || first.Content != second.Content {
+		return false
+	}
+
+	var firstCalls, secondCalls []AsteroidToolCall
+	if first.ToolCalls != nil {
+		firstCalls = *first.ToolCalls
+	}
+	if second.ToolCalls != nil {
+		secondCalls = *second.ToolCalls
+	}
+	if len(firstCalls) != len(secondCalls) {
+		return false
+	}
+	for i := range firstCalls {
+		if stringOrEmpty(firstCalls[i].Name) != stringOrEmpty(secondCalls[i].Name) ||
+			stringOrEmpty(firstCalls[i].Arguments) != stringOrEmpty(secondCalls[i].Arguments) {
+			return false
+		}
+	}
+	return true
+}
+
+func stringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func apiCompareRunsHandler(w http.ResponseWriter, r *http.Request, params CompareRunsParams, store Store) {
+	ctx := r.Context()
+
+	if len(params.RunId) < 2 || len(params.RunId) > maxComparedRuns {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid runs", fmt.Sprintf("between 2 and %d runs can be compared", maxComparedRuns))
+		return
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	for _, runId := range params.RunId {
+		if seen[runId] {
+			sendErrorResponse(w, http.StatusBadRequest, "invalid runs", fmt.Sprintf("run %s is listed more than once", runId))
+			return
+		}
+		seen[runId] = true
+	}
+
+	key := apiKeyFromContext(ctx)
+	comparison := RunComparison{Runs: make([]RunComparisonEntry, 0, len(params.RunId))}
+	conversations := make([][]AsteroidMessage, 0, len(params.RunId))
+	for _, runId := range params.RunId {
+		run, err := store.GetRun(ctx, runId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+			return
+		}
+
+		if run == nil {
+			sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+			return
+		}
+
+		// A project's key can't compare the runs of other projects, which are answered as if they
+		// didn't exist
+		project, err := getProjectForRun(ctx, runId, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting project for run", err.Error())
+			return
+		}
+
+		if project == nil || !keyReachesProject(key, project.Id) {
+			sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+			return
+		}
+
+		entry, messages, err := compareRun(ctx, *run, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error comparing run", err.Error())
+			return
+		}
+		comparison.Runs = append(comparison.Runs, *entry)
+		conversations = append(conversations, messages)
+	}
+
+	comparison.Divergence = findDivergence(params.RunId, conversations)
+
+	respondJSON(w, comparison, http.StatusOK)
+}
@@ -0,0 +1,259 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// parsePolicyRules reads the rules of a policy supervisor from its attributes
+func parsePolicyRules(attributes map[string]interface{}) ([]PolicyRule, error) {
+	value, ok := attributes["rules"]
+	if !ok {
+		return nil, nil
+	}
+
+	jsonRules, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling policy rules: %w", err)
+	}
+
+	var rules []PolicyRule
+	if err := json.Unmarshal(jsonRules, &rules); err != nil {
+		return nil, fmt.Errorf("rules must be a list of name, event_type and decision: %w", err)
+	}
+
+	return rules, nil
+}
+
+// policyDefaultDecision returns what a policy supervisor decides when none of its rules match
+func policyDefaultDecision(attributes map[string]interface{}) Decision {
+	if decision, ok := attributes["default_decision"].(string); ok && decision != "" {
+		return Decision(decision)
+	}
+	return Approve
+}
+
+// validatePolicyDecision checks a policy can decide something. Policies can't modify tool calls.
+func validatePolicyDecision(decision Decision) error {
+	switch decision {
+	case Approve, Reject, Terminate, Escalate:
+		return nil
+	default:
+		return fmt.Errorf("policies can't decide %s", decision)
+	}
+}
+
+// validatePolicyAttributes checks a policy supervisor's rules and default decision
+func validatePolicyAttributes(attributes map[string]interface{}) error {
+	rules, err := parsePolicyRules(attributes)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]bool, len(rules))
+	for i, rule := range rules {
+		if rule.Name == "" {
+			return fmt.Errorf("policy rule %d needs a name", i)
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("duplicate policy rule %s", rule.Name)
+		}
+		names[rule.Name] = true
+
+		if rule.EventType == "" {
+			return fmt.Errorf("policy rule %s needs an event_type", rule.Name)
+		}
+		if rule.WithinSeconds != nil && *rule.WithinSeconds < 1 {
+			return fmt.Errorf("within_seconds of policy rule %s must be at least 1", rule.Name)
+		}
+		if err := validatePolicyDecision(rule.Decision); err != nil {
+			return fmt.Errorf("policy rule %s: %w", rule.Name, err)
+		}
+	}
+
+	return validatePolicyDecision(policyDefaultDecision(attributes))
+}
+
+// matchesEvent reports whether a policy rule matches a run event at a given time
+func matchesEvent(rule PolicyRule, event RunEvent, now time.Time) bool {
+	if !strings.EqualFold(rule.EventType, event.Type) {
+		return false
+	}
+	if rule.Source != nil && *rule.Source != "" && !strings.EqualFold(*rule.Source, event.Source) {
+		return false
+	}
+	if rule.WithinSeconds != nil && now.Sub(event.OccurredAt) > time.Duration(*rule.WithinSeconds)*time.Second {
+		return false
+	}
+	return true
+}
+
+// evaluatePolicy decides by the first rule of a policy supervisor that an event of the run matches,
+// or by its default decision if none does
+func evaluatePolicy(supervisor Supervisor, events []RunEvent, now time.Time) (Decision, ResultExplanation, error) {
+	rules, err := parsePolicyRules(supervisor.Attributes)
+	if err != nil {
+		return "", ResultExplanation{}, err
+	}
+
+	for _, rule := range rules {
+		for _, event := range events {
+			if !matchesEvent(rule, event, now) {
+				continue
+			}
+
+			rationale := fmt.Sprintf("%s by rule %s, %s reported %s at %s", rule.Decision, rule.Name, event.Source, event.Type, event.OccurredAt.Format(time.RFC3339))
+			return rule.Decision, ResultExplanation{MatchedRuleIds: &[]string{rule.Name}, Rationale: &rationale}, nil
+		}
+	}
+
+	decision := policyDefaultDecision(supervisor.Attributes)
+	rationale := fmt.Sprintf("%s by default, no rule matched the run's %d events", decision, len(events))
+	return decision, ResultExplanation{Rationale: &rationale}, nil
+}
+
+// getRunIdForSupervisionRequest returns the run a supervision request's tool call was made in, or
+// nil if it can't be found
+func getRunIdForSupervisionRequest(ctx context.Context, supervisionRequestId uuid.UUID, store Store) (*uuid.UUID, error) {
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, supervisionRequestId, store)
+	if err != nil || toolCallId == nil {
+		return nil, err
+	}
+
+	toolCall, err := store.GetToolCall(ctx, *toolCallId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool call: %w", err)
+	}
+	if toolCall == nil {
+		return nil, nil
+	}
+
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool: %w", err)
+	}
+	if tool == nil {
+		return nil, nil
+	}
+
+	return &tool.RunId, nil
+}
+
+// decideByPolicy resolves a supervision request with what a policy supervisor decides given the
+// events posted to the request's run
+func decideByPolicy(ctx context.Context, supervisionRequest SupervisionRequest, supervisor Supervisor, store Store) error {
+	requestId := *supervisionRequest.Id
+
+	runId, err := getRunIdForSupervisionRequest(ctx, requestId, store)
+	if err != nil {
+		return err
+	}
+	if runId == nil {
+		return fmt.Errorf("run of supervision request %s not found", requestId)
+	}
+
+	events, err := store.GetRunEvents(ctx, *runId)
+	if err != nil {
+		return fmt.Errorf("error getting run events: %w", err)
+	}
+
+	decision, explanation, err := evaluatePolicy(supervisor, events, time.Now())
+	if err != nil {
+		return err
+	}
+
+	result := SupervisionResult{
+		Decision:             decision,
+		Reasoning:            *explanation.Rationale,
+		Explanation:          &explanation,
+		SupervisionRequestId: requestId,
+		CreatedAt:            time.Now(),
+	}
+	_, winner, err := resolveSupervisionRequest(ctx, requestId, result, SystemActor, store)
+	if err != nil {
+		return err
+	}
+	if winner != nil {
+		log.Printf("Supervision request %s was resolved before its policy decided", requestId)
+	}
+
+	return nil
+}
+
+func apiCreateRunEventHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request RunEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if request.Source == "" || request.Type == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "source and type are required", "")
+		return
+	}
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	now := time.Now()
+	event := RunEvent{
+		Id:         uuid.New(),
+		RunId:      runId,
+		Source:     request.Source,
+		Type:       request.Type,
+		Message:    request.Message,
+		Attributes: request.Attributes,
+		OccurredAt: now,
+		CreatedAt:  now,
+	}
+	if request.OccurredAt != nil {
+		event.OccurredAt = *request.OccurredAt
+	}
+
+	if err := store.CreateRunEvent(ctx, event); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating run event", err.Error())
+		return
+	}
+
+	respondJSON(w, event, http.StatusCreated)
+}
+
+func apiGetRunEventsHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	events, err := store.GetRunEvents(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run events", err.Error())
+		return
+	}
+
+	respondJSON(w, events, http.StatusOK)
+}
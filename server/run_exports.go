@@ -0,0 +1,211 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultExportRunsPerSecond = 50
+	defaultExportConcurrency   = 2
+	// runExportPageSize is how many runs are read from the store at a time
+	runExportPageSize = 100
+	// runExportBusyRetryAfter is how many seconds clients are told to wait when every export slot is taken
+	runExportBusyRetryAfter = 60
+)
+
+// RunExports paces run exports and bounds how many stream at once, so exporting a large project
+// doesn't starve the requests of agents and reviewers
+type RunExports struct {
+	// runsPerSecond is read when an export starts, so changing it leaves streaming exports at their pace
+	runsPerSecond atomic.Int64
+	slots         chan struct{}
+}
+
+// NewRunExportsFromEnv streams EXPORT_CONCURRENCY exports at once, each at EXPORT_RUNS_PER_SECOND
+func NewRunExportsFromEnv() (*RunExports, error) {
+	runsPerSecond, err := exportRunsPerSecond(os.Getenv)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := defaultExportConcurrency
+	if value := os.Getenv("EXPORT_CONCURRENCY"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			return nil, fmt.Errorf("EXPORT_CONCURRENCY must be a positive number")
+		}
+		concurrency = parsed
+	}
+
+	exports := &RunExports{slots: make(chan struct{}, concurrency)}
+	exports.runsPerSecond.Store(int64(runsPerSecond))
+	return exports, nil
+}
+
+// exportRunsPerSecond reads how many runs a second an export sends with getenv
+func exportRunsPerSecond(getenv func(string) string) (int, error) {
+	if value := getenv("EXPORT_RUNS_PER_SECOND"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			return 0, fmt.Errorf("EXPORT_RUNS_PER_SECOND must be a positive number")
+		}
+		return parsed, nil
+	}
+	return defaultExportRunsPerSecond, nil
+}
+
+// runExportCursor is what a resume token stands for. Until stays what it was when the export
+// started, so resuming doesn't chase the runs created since.
+type runExportCursor struct {
+	ProjectId uuid.UUID `json:"project_id"`
+	Until     time.Time `json:"until"`
+	CreatedAt time.Time `json:"created_at"`
+	RunId     uuid.UUID `json:"run_id"`
+}
+
+func (c runExportCursor) token() (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func parseResumeToken(token string) (runExportCursor, error) {
+	var cursor runExportCursor
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return cursor, fmt.Errorf("resume_token isn't one an export returned")
+	}
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return cursor, fmt.Errorf("resume_token isn't one an export returned")
+	}
+	return cursor, nil
+}
+
+// exportRun reads a run with its tool calls and their decisions
+func exportRun(ctx context.Context, run Run, store Store) (*ExportedRun, error) {
+	toolCalls, err := store.GetRunToolCalls(ctx, run.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool calls: %w", err)
+	}
+
+	exported := ExportedRun{Run: run, ToolCalls: make([]ExportedToolCall, 0, len(toolCalls))}
+	for _, toolCall := range toolCalls {
+		decision, err := getToolCallDecision(ctx, toolCall.Id, store)
+		if err != nil {
+			return nil, fmt.Errorf("error getting decision of tool call %s: %w", toolCall.Id, err)
+		}
+		exported.ToolCalls = append(exported.ToolCalls, ExportedToolCall{ToolCall: toolCall, Decision: decision})
+	}
+
+	return &exported, nil
+}
+
+func apiExportProjectRunsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, params ExportProjectRunsParams, exports *RunExports, store Store) {
+	ctx := r.Context()
+
+	cursor := runExportCursor{ProjectId: projectId, Until: time.Now()}
+	if params.ResumeToken != nil {
+		var err error
+		cursor, err = parseResumeToken(*params.ResumeToken)
+		if err != nil {
+			sendErrorResponse(w, http.StatusBadRequest, "Invalid resume token", err.Error())
+			return
+		}
+		if cursor.ProjectId != projectId {
+			sendErrorResponse(w, http.StatusBadRequest, "Invalid resume token", "resume_token is of another project's export")
+			return
+		}
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	select {
+	case exports.slots <- struct{}{}:
+		defer func() { <-exports.slots }()
+	default:
+		w.Header().Set("Retry-After", strconv.Itoa(runExportBusyRetryAfter))
+		sendErrorResponse(w, http.StatusTooManyRequests, "too many exports are streaming", "")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	controller := http.NewResponseController(w)
+	encoder := json.NewEncoder(w)
+
+	pace := time.NewTicker(time.Second / time.Duration(exports.runsPerSecond.Load()))
+	defer pace.Stop()
+
+	// Once streaming, errors can't be responded with anymore. The stream ends without its
+	// export_completed line instead, and the client resumes from the last run it got.
+	exported := 0
+	for {
+		runs, err := store.GetProjectRunsAfter(ctx, projectId, cursor.CreatedAt, cursor.RunId, cursor.Until, runExportPageSize)
+		if err != nil {
+			log.Printf("Error getting runs of project %s to export: %v", projectId, err)
+			return
+		}
+
+		for _, run := range runs {
+			select {
+			case <-ctx.Done():
+				return
+			case <-pace.C:
+			}
+
+			exportedRun, err := exportRun(ctx, run, store)
+			if err != nil {
+				log.Printf("Error exporting run %s: %v", run.Id, err)
+				return
+			}
+
+			cursor.CreatedAt, cursor.RunId = run.CreatedAt, run.Id
+			token, err := cursor.token()
+			if err != nil {
+				log.Printf("Error encoding resume token of run %s: %v", run.Id, err)
+				return
+			}
+
+			if err := encoder.Encode(RunExportLine{Type: RunExported, Run: exportedRun, ResumeToken: &token}); err != nil {
+				return // The client went away
+			}
+			if err := controller.Flush(); err != nil {
+				return
+			}
+			exported++
+		}
+
+		if len(runs) < runExportPageSize {
+			break
+		}
+	}
+
+	if err := encoder.Encode(RunExportLine{Type: ExportCompleted, Exported: &exported}); err != nil {
+		return
+	}
+	if err := controller.Flush(); err != nil {
+		log.Printf("Error flushing export of project %s: %v", projectId, err)
+	}
+}
@@ -0,0 +1,255 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// pendingSupervisionsRetryAfter is how many seconds a run at its limit of pending supervisions is
+// asked to wait, long enough for a reviewer to get through some of them
+const pendingSupervisionsRetryAfter = 30
+
+// runLimitKinds are the limits on a run, in the order they're reported
+var runLimitKinds = []RunLimitKind{ToolCallsPerMinute, MaxPendingSupervisions, MaxChatRequests}
+
+// runLimitSettings name the settings of the server's default for each limit
+var runLimitSettings = map[RunLimitKind]string{
+	ToolCallsPerMinute:     "RUN_TOOL_CALLS_PER_MINUTE",
+	MaxPendingSupervisions: "RUN_MAX_PENDING_SUPERVISIONS",
+	MaxChatRequests:        "RUN_MAX_CHAT_REQUESTS",
+}
+
+// runLimitField returns the field of limits holding a limit
+func runLimitField(limits *RunLimits, kind RunLimitKind) **int {
+	switch kind {
+	case ToolCallsPerMinute:
+		return &limits.ToolCallsPerMinute
+	case MaxPendingSupervisions:
+		return &limits.MaxPendingSupervisions
+	default:
+		return &limits.MaxChatRequests
+	}
+}
+
+// runLimit returns the value of a limit, nil if it isn't set
+func runLimit(limits RunLimits, kind RunLimitKind) *int {
+	return *runLimitField(&limits, kind)
+}
+
+// defaultRunLimits reads the server's default limits with getenv. Runs have no limits the settings
+// leave unset, unless their project sets them.
+func defaultRunLimits(getenv func(string) string) (RunLimits, error) {
+	var limits RunLimits
+	for _, kind := range runLimitKinds {
+		name := runLimitSettings[kind]
+		value := getenv(name)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			return RunLimits{}, fmt.Errorf("%s must be a positive number", name)
+		}
+		*runLimitField(&limits, kind) = &parsed
+	}
+	return limits, nil
+}
+
+// validateRunLimits checks that the limits a project sets are positive
+func validateRunLimits(limits RunLimits) error {
+	for _, kind := range runLimitKinds {
+		if value := runLimit(limits, kind); value != nil && *value <= 0 {
+			return fmt.Errorf("%s must be a positive number", kind)
+		}
+	}
+	return nil
+}
+
+// getRunLimitUsage measures a run against the limits that apply to it, its project's or else the
+// server's defaults, optionally only against some of them
+func getRunLimitUsage(ctx context.Context, runId uuid.UUID, project *Project, kinds []RunLimitKind, store Store) ([]RunLimitUsage, error) {
+	defaults, err := defaultRunLimits(os.Getenv)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectLimits RunLimits
+	if project != nil {
+		limits, err := store.GetRunLimits(ctx, project.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting run limits: %w", err)
+		}
+		if limits != nil {
+			projectLimits = *limits
+		}
+	}
+
+	usage := make([]RunLimitUsage, 0)
+	for _, kind := range runLimitKinds {
+		if kinds != nil && !slices.Contains(kinds, kind) {
+			continue
+		}
+
+		value, source := runLimit(projectLimits, kind), ProjectRunLimit
+		if value == nil {
+			value, source = runLimit(defaults, kind), ServerDefaultLimit
+		}
+		if value == nil {
+			continue
+		}
+
+		// Only tool calls are counted over a window, the rest over the whole run
+		var since time.Time
+		if kind == ToolCallsPerMinute {
+			since = time.Now().Add(-time.Minute)
+		}
+		used, err := store.GetRunLimitUsage(ctx, runId, kind, since)
+		if err != nil {
+			return nil, fmt.Errorf("error getting %s usage: %w", kind, err)
+		}
+
+		usage = append(usage, RunLimitUsage{
+			Limit:    kind,
+			Value:    *value,
+			Used:     used,
+			Exceeded: used >= int64(*value),
+			Source:   source,
+		})
+	}
+
+	return usage, nil
+}
+
+// enforceRunLimits refuses a request of a run that's at any of the given limits, asking it to retry
+// once the limit can have cleared. Returns false if the request was refused and a response has been sent.
+func enforceRunLimits(ctx context.Context, w http.ResponseWriter, runId uuid.UUID, project *Project, store Store, kinds ...RunLimitKind) bool {
+	if err := checkRunLimits(ctx, w.Header(), runId, project, store, kinds...); err != nil {
+		sendAPIError(w, err)
+		return false
+	}
+	return true
+}
+
+// checkRunLimits is enforceRunLimits for logic the HTTP and gRPC APIs share, setting the headers of
+// its response on header
+func checkRunLimits(ctx context.Context, header http.Header, runId uuid.UUID, project *Project, store Store, kinds ...RunLimitKind) error {
+	usage, err := getRunLimitUsage(ctx, runId, project, kinds, store)
+	if err != nil {
+		return &apiError{status: http.StatusInternalServerError, message: "error getting run limit usage", details: err.Error()}
+	}
+
+	for _, u := range usage {
+		if !u.Exceeded {
+			continue
+		}
+
+		// A run's chat requests only ever go up, so there's no point retrying those
+		switch u.Limit {
+		case ToolCallsPerMinute:
+			header.Set("Retry-After", strconv.Itoa(int(time.Minute.Seconds())))
+		case MaxPendingSupervisions:
+			header.Set("Retry-After", strconv.Itoa(pendingSupervisionsRetryAfter))
+		}
+		message := fmt.Sprintf("run %s is at its %s limit of %d", runId, u.Limit, u.Value)
+		return &apiError{status: http.StatusTooManyRequests, message: message, body: RunLimitExceeded{Error: message, Usage: u}}
+	}
+
+	return nil
+}
+
+func apiGetProjectRunLimitsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	limits, err := store.GetRunLimits(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run limits", err.Error())
+		return
+	}
+
+	if limits == nil {
+		limits = &RunLimits{}
+	}
+	respondJSON(w, limits, http.StatusOK)
+}
+
+func apiSetProjectRunLimitsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var limits RunLimits
+	if err := json.NewDecoder(r.Body).Decode(&limits); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateRunLimits(limits); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid run limits", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := store.SetRunLimits(ctx, projectId, limits); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting run limits", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiGetRunLimitUsageHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	project, err := getProjectForTask(ctx, run.TaskId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project for run", err.Error())
+		return
+	}
+
+	usage, err := getRunLimitUsage(ctx, runId, project, nil, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run limit usage", err.Error())
+		return
+	}
+
+	respondJSON(w, usage, http.StatusOK)
+}
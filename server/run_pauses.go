@@ -0,0 +1,204 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// runPausedRetryAfter is how many seconds agents are told to wait before retrying a paused run
+const runPausedRetryAfter = 30
+
+// sendRunPausedResponse refuses a request because its run is paused. Resuming the run admits it
+// again, so the agent is told to retry.
+func sendRunPausedResponse(w http.ResponseWriter, pause *RunPause) {
+	sendAPIError(w, runPausedError(w.Header(), pause))
+}
+
+func runPausedError(header http.Header, pause *RunPause) error {
+	reason := ""
+	if pause.Reason != nil {
+		reason = *pause.Reason
+	}
+	header.Set("Retry-After", strconv.Itoa(runPausedRetryAfter))
+	return &apiError{status: http.StatusLocked, message: fmt.Sprintf("run %s is paused", pause.RunId), details: reason}
+}
+
+// admitUnpausedRun reports whether a run isn't paused, responding instead if it is
+func admitUnpausedRun(ctx context.Context, w http.ResponseWriter, runId uuid.UUID, store Store) bool {
+	if err := checkUnpausedRun(ctx, w.Header(), runId, store); err != nil {
+		sendAPIError(w, err)
+		return false
+	}
+	return true
+}
+
+// checkUnpausedRun refuses a request of a paused run, setting the headers of its response
+func checkUnpausedRun(ctx context.Context, header http.Header, runId uuid.UUID, store Store) error {
+	pause, err := store.GetRunPause(ctx, runId)
+	if err != nil {
+		return &apiError{status: http.StatusInternalServerError, message: "error getting run pause", details: err.Error()}
+	}
+
+	if pause != nil {
+		return runPausedError(header, pause)
+	}
+
+	return nil
+}
+
+// getRunPauseForSupervisionRequest returns the pause of the run a supervision request belongs to, or
+// nil if the run isn't paused
+func getRunPauseForSupervisionRequest(ctx context.Context, supervisionRequestId uuid.UUID, store Store) (*RunPause, error) {
+	runId, err := getRunIdForSupervisionRequest(ctx, supervisionRequestId, store)
+	if err != nil || runId == nil {
+		return nil, err
+	}
+
+	return store.GetRunPause(ctx, *runId)
+}
+
+func apiGetRunPauseHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	pause, err := store.GetRunPause(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run pause", err.Error())
+		return
+	}
+
+	if pause == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run pause not found", "")
+		return
+	}
+
+	respondJSON(w, pause, http.StatusOK)
+}
+
+func apiPauseRunHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	// The body is optional, pauses don't need a reason
+	var request PauseRunJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil && !errors.Is(err, io.EOF) {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	status := Pending
+	if run.Status != nil {
+		status = *run.Status
+	}
+
+	switch status {
+	case Completed, Failed:
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("run is %s, so it can't be paused", status), "")
+		return
+	}
+
+	pause := RunPause{
+		RunId:          runId,
+		PausedAt:       time.Now(),
+		PausedBy:       actorFromContext(ctx),
+		Reason:         request.Reason,
+		PreviousStatus: status,
+	}
+
+	paused, err := store.PauseRun(ctx, pause)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error pausing run", err.Error())
+		return
+	}
+
+	if !paused {
+		sendErrorResponse(w, http.StatusConflict, "run is already paused", "")
+		return
+	}
+
+	recordAuditEvent(ctx, pause.PausedBy, AuditActionRunPaused, runResource, runId,
+		map[string]interface{}{"reason": request.Reason, "previous_status": status}, store)
+
+	respondJSON(w, pause, http.StatusCreated)
+}
+
+func apiResumeRunHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	pause, err := store.GetRunPause(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run pause", err.Error())
+		return
+	}
+
+	if pause == nil {
+		run, err := store.GetRun(ctx, runId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+			return
+		}
+
+		if run == nil {
+			sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+			return
+		}
+
+		sendErrorResponse(w, http.StatusConflict, "run isn't paused", "")
+		return
+	}
+
+	// The kill switch may have been activated or deactivated while the run was paused, in which case
+	// the run goes back to the status the kill switch would have given it
+	status := pause.PreviousStatus
+	if status == Pending || status == Paused {
+		project, err := getProjectForRun(ctx, runId, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting project for run", err.Error())
+			return
+		}
+
+		killSwitch, err := getActiveKillSwitch(ctx, project, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting kill switch", err.Error())
+			return
+		}
+
+		status = Pending
+		if killSwitch != nil {
+			status = Paused
+		}
+	}
+
+	resumed, err := store.ResumeRun(ctx, runId, status, time.Now())
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error resuming run", err.Error())
+		return
+	}
+
+	if !resumed {
+		sendErrorResponse(w, http.StatusConflict, "run isn't paused", "")
+		return
+	}
+
+	recordAuditEvent(ctx, actorFromContext(ctx), AuditActionRunResumed, runResource, runId,
+		map[string]interface{}{"paused_at": pause.PausedAt, "paused_by": pause.PausedBy, "status": status}, store)
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
@@ -0,0 +1,237 @@
+package asteroid
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+	"log"
+	"mime"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxScreenshotPixels bounds the screenshots that are decoded, a small PNG can decode to gigabytes
+	maxScreenshotPixels = 40_000_000
+	// screenshotPixelThreshold is how far apart, out of 0xffff, a channel of a pixel has to be in two
+	// screenshots for the pixel to have changed. It keeps JPEG noise from counting as changes.
+	screenshotPixelThreshold = 0x1000
+)
+
+// screenshotExtensions are the image types screenshots can be, with the extension they're named with
+var screenshotExtensions = map[string]string{
+	"image/png":  "png",
+	"image/jpeg": "jpg",
+}
+
+func isScreenshot(artifact RunArtifact) bool {
+	mediaType, _, err := mime.ParseMediaType(artifact.ContentType)
+	if err != nil {
+		return false
+	}
+	_, ok := screenshotExtensions[mediaType]
+	return ok
+}
+
+func apiUploadToolCallScreenshotHandler(w http.ResponseWriter, r *http.Request, toolCallId uuid.UUID, store Store, blobs BlobStore) {
+	ctx := r.Context()
+
+	if blobs == nil {
+		sendErrorResponse(w, http.StatusServiceUnavailable, ErrNoBlobStore.Error(), "set BLOB_STORE_DIR to enable artifacts")
+		return
+	}
+
+	runId, err := getToolCallRun(ctx, toolCallId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool call", err.Error())
+		return
+	}
+
+	if runId == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Tool call not found", "")
+		return
+	}
+
+	content, ok := readArtifactContent(w, r)
+	if !ok {
+		return
+	}
+
+	// The type is sniffed rather than taken from the request, the screenshot is decoded for diffing
+	contentType := http.DetectContentType(content)
+	extension, ok := screenshotExtensions[contentType]
+	if !ok {
+		sendErrorResponse(w, http.StatusBadRequest, "screenshot must be a PNG or JPEG image", fmt.Sprintf("got %s", contentType))
+		return
+	}
+
+	artifact := RunArtifact{
+		RunId:       *runId,
+		ToolCallId:  &toolCallId,
+		Name:        "screenshot." + extension,
+		ContentType: contentType,
+	}
+	if err := createRunArtifact(ctx, &artifact, content, store, blobs); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating screenshot", err.Error())
+		return
+	}
+
+	respondJSON(w, artifact, http.StatusCreated)
+}
+
+// getToolCallScreenshot returns the latest screenshot uploaded for a tool call, from the artifacts of
+// its run oldest first, or nil if there's none. When diff is set the screenshot is compared with the
+// latest one uploaded before it for another tool call.
+func getToolCallScreenshot(ctx context.Context, toolCall AsteroidToolCall, artifacts []RunArtifact, diff bool, forSupervisor bool, blobs BlobStore) (*ToolCallScreenshot, error) {
+	var current, previous *RunArtifact
+	for i := range artifacts {
+		artifact := &artifacts[i]
+		if !isScreenshot(*artifact) {
+			continue
+		}
+		if artifact.ToolCallId != nil && *artifact.ToolCallId == toolCall.Id {
+			current = artifact
+		} else if current == nil {
+			previous = artifact
+		}
+	}
+
+	if current == nil {
+		return nil, nil
+	}
+
+	if blobs == nil {
+		return nil, ErrNoBlobStore
+	}
+
+	screenshot := ToolCallScreenshot{Artifact: *current, ClickPoint: clickPoint(storedToolCallArguments(toolCall))}
+	if previous != nil {
+		screenshot.PreviousArtifactId = &previous.Id
+	}
+
+	content, err := blobs.GetBlob(ctx, artifactBlobKey(*current))
+	if err != nil {
+		return nil, fmt.Errorf("error getting content of screenshot %s: %w", current.Id, err)
+	}
+
+	if forSupervisor {
+		dataUrl := fmt.Sprintf("data:%s;base64,%s", current.ContentType, base64.StdEncoding.EncodeToString(content))
+		screenshot.DataUrl = &dataUrl
+	}
+
+	// Screenshots that can't be decoded are still shown, only without their size and diff
+	decoded, err := decodeScreenshot(content)
+	if err != nil {
+		log.Printf("Error decoding screenshot %s: %v", current.Id, err)
+		return &screenshot, nil
+	}
+	bounds := decoded.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	screenshot.Width, screenshot.Height = &width, &height
+
+	if !diff || previous == nil {
+		return &screenshot, nil
+	}
+
+	previousContent, err := blobs.GetBlob(ctx, artifactBlobKey(*previous))
+	if err != nil {
+		return nil, fmt.Errorf("error getting content of screenshot %s: %w", previous.Id, err)
+	}
+
+	previousDecoded, err := decodeScreenshot(previousContent)
+	if err != nil {
+		log.Printf("Error decoding screenshot %s: %v", previous.Id, err)
+		return &screenshot, nil
+	}
+
+	if region, fraction, ok := diffScreenshots(previousDecoded, decoded); ok {
+		screenshot.ChangedRegion, screenshot.ChangedFraction = region, &fraction
+	}
+
+	return &screenshot, nil
+}
+
+func decodeScreenshot(content []byte) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width*config.Height > maxScreenshotPixels {
+		return nil, fmt.Errorf("screenshot of %dx%d is too large to decode", config.Width, config.Height)
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(content))
+	return decoded, err
+}
+
+// diffScreenshots returns the smallest region holding every pixel that differs between two
+// screenshots, and the share of pixels that do. Screenshots of different sizes can't be compared. The
+// region is nil when nothing changed.
+func diffScreenshots(previous, current image.Image) (*ScreenshotRegion, float64, bool) {
+	bounds, previousBounds := current.Bounds(), previous.Bounds()
+	if bounds.Size() != previousBounds.Size() || bounds.Empty() {
+		return nil, 0, false
+	}
+
+	changed := 0
+	minX, minY, maxX, maxY := bounds.Dx(), bounds.Dy(), -1, -1
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			if !pixelsDiffer(previous.At(previousBounds.Min.X+x, previousBounds.Min.Y+y), current.At(bounds.Min.X+x, bounds.Min.Y+y)) {
+				continue
+			}
+			changed++
+			minX, minY, maxX, maxY = min(minX, x), min(minY, y), max(maxX, x), max(maxY, y)
+		}
+	}
+
+	fraction := float64(changed) / float64(bounds.Dx()*bounds.Dy())
+	if changed == 0 {
+		return nil, fraction, true
+	}
+	return &ScreenshotRegion{X: minX, Y: minY, Width: maxX - minX + 1, Height: maxY - minY + 1}, fraction, true
+}
+
+func pixelsDiffer(a, b color.Color) bool {
+	ar, ag, ab, aa := a.RGBA()
+	br, bg, bb, ba := b.RGBA()
+	for _, channels := range [][2]uint32{{ar, br}, {ag, bg}, {ab, bb}, {aa, ba}} {
+		if max(channels[0], channels[1])-min(channels[0], channels[1]) > screenshotPixelThreshold {
+			return true
+		}
+	}
+	return false
+}
+
+// clickPoint reads where a tool call clicks from its arguments. Computer use tools send a coordinate
+// of [x, y], other browser tools their x and y.
+func clickPoint(arguments *string) *ScreenshotPoint {
+	if arguments == nil {
+		return nil
+	}
+
+	var args struct {
+		Coordinate []float64 `json:"coordinate"`
+		X          *float64  `json:"x"`
+		Y          *float64  `json:"y"`
+	}
+	if err := json.Unmarshal([]byte(*arguments), &args); err != nil {
+		return nil
+	}
+
+	switch {
+	case len(args.Coordinate) == 2:
+		return &ScreenshotPoint{X: int(args.Coordinate[0]), Y: int(args.Coordinate[1])}
+	case args.X != nil && args.Y != nil:
+		return &ScreenshotPoint{X: int(*args.X), Y: int(*args.Y)}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,59 @@
+package asteroid
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	defaultSearchLimit = 50
+	maxSearchLimit     = 200
+)
+
+func apiSearchHandler(w http.ResponseWriter, r *http.Request, params SearchParams, store Store) {
+	ctx := r.Context()
+
+	query := strings.TrimSpace(params.Q)
+	if query == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid query", "q is required")
+		return
+	}
+
+	if params.Kind != nil {
+		switch *params.Kind {
+		case MessageHit, ToolCallHit, DecisionHit:
+		default:
+			sendErrorResponse(w, http.StatusBadRequest, "invalid kind", fmt.Sprintf("unknown kind %s", *params.Kind))
+			return
+		}
+	}
+
+	limit := defaultSearchLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxSearchLimit {
+			sendErrorResponse(w, http.StatusBadRequest, "invalid limit", fmt.Sprintf("limit must be between 1 and %d", maxSearchLimit))
+			return
+		}
+		limit = *params.Limit
+	}
+
+	// A project's key only searches its project, and other projects are answered as if they didn't
+	// exist
+	projectId := params.Project
+	if key := apiKeyFromContext(ctx); key != nil && key.ProjectId != nil {
+		if projectId != nil && *projectId != *key.ProjectId {
+			sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+			return
+		}
+		projectId = key.ProjectId
+	}
+
+	hits, err := store.Search(ctx, query, projectId, params.Kind, limit)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error searching", err.Error())
+		return
+	}
+
+	respondJSON(w, hits, http.StatusOK)
+}
@@ -0,0 +1,437 @@
+package asteroid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	serviceNowTimeout = 10 * time.Second
+	// defaultServiceNowPollInterval is how often open change requests are checked, unless
+	// SERVICENOW_POLL_INTERVAL says otherwise
+	defaultServiceNowPollInterval = time.Minute
+	// changeRequestBatchSize open change requests are checked at a time, the least recently checked
+	// first, so a backlog is worked through over several polls
+	changeRequestBatchSize = 50
+	// serviceNowCanceledState is the state of a canceled change request
+	serviceNowCanceledState = "4"
+	// maxShortDescriptionLength is the length of ServiceNow's short_description field
+	maxShortDescriptionLength = 160
+)
+
+var errChangeRequestNotFound = errors.New("change request not found in ServiceNow")
+
+// ServiceNowClient opens and reads change requests through the Table API of a ServiceNow instance
+type ServiceNowClient struct {
+	instanceUrl  string
+	username     string
+	password     string
+	token        string
+	pollInterval time.Duration
+	client       *http.Client
+}
+
+// serviceNowChangeRequest holds the fields of a change request that are read back from ServiceNow
+type serviceNowChangeRequest struct {
+	SysId    string `json:"sys_id"`
+	Number   string `json:"number"`
+	Approval string `json:"approval"`
+	State    string `json:"state"`
+}
+
+// NewServiceNowClientFromEnv configures ServiceNow from SERVICENOW_INSTANCE_URL, with either
+// SERVICENOW_TOKEN, sent as a bearer token, or SERVICENOW_USERNAME and SERVICENOW_PASSWORD. Returns
+// nil if no instance is configured.
+func NewServiceNowClientFromEnv() (*ServiceNowClient, error) {
+	instanceUrl := os.Getenv("SERVICENOW_INSTANCE_URL")
+	if instanceUrl == "" {
+		return nil, nil
+	}
+
+	u, err := url.Parse(instanceUrl)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("SERVICENOW_INSTANCE_URL must be an absolute https URL")
+	}
+
+	client := &ServiceNowClient{
+		instanceUrl:  strings.TrimRight(instanceUrl, "/"),
+		username:     os.Getenv("SERVICENOW_USERNAME"),
+		password:     os.Getenv("SERVICENOW_PASSWORD"),
+		token:        os.Getenv("SERVICENOW_TOKEN"),
+		pollInterval: defaultServiceNowPollInterval,
+		client: &http.Client{
+			Timeout: serviceNowTimeout,
+			// Credentials are only sent to the configured instance
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+	if client.token == "" && (client.username == "" || client.password == "") {
+		return nil, fmt.Errorf("SERVICENOW_TOKEN or SERVICENOW_USERNAME and SERVICENOW_PASSWORD must be set to use ServiceNow")
+	}
+
+	if value := os.Getenv("SERVICENOW_POLL_INTERVAL"); value != "" {
+		client.pollInterval, err = time.ParseDuration(value)
+		if err != nil || client.pollInterval <= 0 {
+			return nil, fmt.Errorf("SERVICENOW_POLL_INTERVAL must be a positive duration like 1m")
+		}
+	}
+
+	return client, nil
+}
+
+// ChangeRequestLink returns where a change request is shown in the instance
+func (c *ServiceNowClient) ChangeRequestLink(sysId string) string {
+	return c.instanceUrl + "/nav_to.do?uri=" + url.QueryEscape("change_request.do?sys_id="+sysId)
+}
+
+// do sends a request to the Table API and decodes the record it answers with
+func (c *ServiceNowClient) do(ctx context.Context, method string, path string, body interface{}) (*serviceNowChangeRequest, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling change request: %w", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.instanceUrl+path, reader)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ServiceNow request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	} else {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling ServiceNow: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errChangeRequestNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return nil, fmt.Errorf("ServiceNow responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	var decoded struct {
+		Result serviceNowChangeRequest `json:"result"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("error decoding ServiceNow response: %w", err)
+	}
+	if decoded.Result.SysId == "" {
+		return nil, fmt.Errorf("ServiceNow responded without a change request")
+	}
+
+	return &decoded.Result, nil
+}
+
+const serviceNowChangeRequestFields = "sysparm_fields=sys_id,number,approval,state"
+
+func (c *ServiceNowClient) CreateChangeRequest(ctx context.Context, fields map[string]string) (*serviceNowChangeRequest, error) {
+	return c.do(ctx, http.MethodPost, "/api/now/table/change_request?"+serviceNowChangeRequestFields, fields)
+}
+
+func (c *ServiceNowClient) GetChangeRequest(ctx context.Context, sysId string) (*serviceNowChangeRequest, error) {
+	return c.do(ctx, http.MethodGet, "/api/now/table/change_request/"+url.PathEscape(sysId)+"?"+serviceNowChangeRequestFields, nil)
+}
+
+// validateServiceNowAttributes checks the optional attributes of a ServiceNow supervisor are strings
+// and its change type is one ServiceNow has
+func validateServiceNowAttributes(attributes map[string]interface{}) error {
+	for _, name := range []string{"assignment_group", "change_type", "category"} {
+		if value, ok := attributes[name]; ok {
+			if _, ok := value.(string); !ok {
+				return fmt.Errorf("%s must be a string", name)
+			}
+		}
+	}
+
+	if changeType, ok := attributes["change_type"].(string); ok {
+		switch ServiceNowSupervisorAttributesChangeType(changeType) {
+		case Normal, Standard, Emergency:
+		default:
+			return fmt.Errorf("unknown change_type %s", changeType)
+		}
+	}
+
+	return nil
+}
+
+// changeRequestFields fills in the change request a ServiceNow supervisor opens for a supervision
+// request, describing the tool call it holds
+func changeRequestFields(ctx context.Context, supervisionRequest SupervisionRequest, supervisor Supervisor, store Store) (map[string]string, error) {
+	fields := map[string]string{
+		"type":                string(Normal),
+		"correlation_id":      supervisionRequest.Id.String(),
+		"correlation_display": "Asteroid",
+	}
+	for _, name := range []string{"assignment_group", "category"} {
+		if value, ok := supervisor.Attributes[name].(string); ok && value != "" {
+			fields[name] = value
+		}
+	}
+	if changeType, ok := supervisor.Attributes["change_type"].(string); ok && changeType != "" {
+		fields["type"] = changeType
+	}
+
+	toolName, toolDescription, arguments := "a tool", "", ""
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, *supervisionRequest.Id, store)
+	if err != nil {
+		return nil, err
+	}
+	if toolCallId != nil {
+		toolCall, err := store.GetToolCall(ctx, *toolCallId)
+		if err != nil {
+			return nil, fmt.Errorf("error getting tool call: %w", err)
+		}
+		if toolCall != nil {
+			if stored := storedToolCallArguments(*toolCall); stored != nil {
+				arguments = *stored
+				var indented bytes.Buffer
+				if json.Indent(&indented, []byte(arguments), "", "  ") == nil {
+					arguments = indented.String()
+				}
+			}
+			tool, err := store.GetTool(ctx, toolCall.ToolId)
+			if err != nil {
+				return nil, fmt.Errorf("error getting tool: %w", err)
+			}
+			if tool != nil {
+				toolName, toolDescription = tool.Name, tool.Description
+			}
+		}
+	}
+
+	shortDescription := []rune(fmt.Sprintf("Approve a call of %s by an AI agent", toolName))
+	if len(shortDescription) > maxShortDescriptionLength {
+		shortDescription = shortDescription[:maxShortDescriptionLength]
+	}
+	fields["short_description"] = string(shortDescription)
+
+	var description strings.Builder
+	fmt.Fprintf(&description, "An AI agent's call of %s is held until this change request is approved or rejected.\n\n", toolName)
+	if toolDescription != "" {
+		fmt.Fprintf(&description, "Tool: %s\n\n", toolDescription)
+	}
+	fmt.Fprintf(&description, "Supervision request: %s\n", supervisionRequest.Id)
+	if toolCallId != nil {
+		fmt.Fprintf(&description, "Tool call: %s\n", toolCallId)
+	}
+	if arguments != "" {
+		fmt.Fprintf(&description, "\nArguments:\n%s\n", arguments)
+	}
+	fields["description"] = description.String()
+
+	return fields, nil
+}
+
+// openChangeRequest opens the change request for a supervision request, unless it already has one
+func openChangeRequest(ctx context.Context, client *ServiceNowClient, supervisionRequest SupervisionRequest, supervisor Supervisor, store Store) (*ChangeRequest, error) {
+	existing, err := store.GetChangeRequest(ctx, *supervisionRequest.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting change request: %w", err)
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	fields, err := changeRequestFields(ctx, supervisionRequest, supervisor, store)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := client.CreateChangeRequest(ctx, fields)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ServiceNow change request: %w", err)
+	}
+
+	changeRequest := ChangeRequest{
+		Id:                   uuid.New(),
+		SupervisionRequestId: *supervisionRequest.Id,
+		SysId:                created.SysId,
+		Number:               created.Number,
+		Link:                 client.ChangeRequestLink(created.SysId),
+		Approval:             created.Approval,
+		State:                created.State,
+		CreatedAt:            time.Now(),
+	}
+
+	// Stored with the ServiceNow record's correlation_id, so a change request opened here but not
+	// stored can still be traced back to its supervision request
+	if err := store.CreateChangeRequest(ctx, changeRequest); err != nil {
+		return nil, fmt.Errorf("error storing change request %s: %w", created.Number, err)
+	}
+
+	return &changeRequest, nil
+}
+
+// changeRequestDecision returns what a change request decides, if its approval or state decides
+// anything yet
+func changeRequestDecision(changeRequest serviceNowChangeRequest) (Decision, string, bool) {
+	switch {
+	case changeRequest.Approval == "approved":
+		return Approve, fmt.Sprintf("Change request %s was approved in ServiceNow", changeRequest.Number), true
+	case changeRequest.Approval == "rejected":
+		return Reject, fmt.Sprintf("Change request %s was rejected in ServiceNow", changeRequest.Number), true
+	case changeRequest.State == serviceNowCanceledState:
+		return Reject, fmt.Sprintf("Change request %s was canceled in ServiceNow", changeRequest.Number), true
+	default:
+		return "", "", false
+	}
+}
+
+// ChangeRequestPoller checks the open change requests in ServiceNow and decides their supervision
+// requests once they're approved, rejected or canceled
+type ChangeRequestPoller struct {
+	client *ServiceNowClient
+	store  Store
+}
+
+func NewChangeRequestPoller(client *ServiceNowClient, store Store) *ChangeRequestPoller {
+	return &ChangeRequestPoller{client: client, store: store}
+}
+
+func (p *ChangeRequestPoller) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.client.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.checkOpenChangeRequests(ctx); err != nil {
+				log.Printf("Error checking change requests: %v", err)
+			}
+		}
+	}
+}
+
+func (p *ChangeRequestPoller) checkOpenChangeRequests(ctx context.Context) error {
+	changeRequests, err := p.store.GetOpenChangeRequests(ctx, changeRequestBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, changeRequest := range changeRequests {
+		if err := p.check(ctx, changeRequest); err != nil {
+			log.Printf("Error checking change request %s: %v", changeRequest.Number, err)
+		}
+	}
+
+	return nil
+}
+
+// check reads a change request back from ServiceNow and decides its supervision request if the
+// change request decides it. Change requests of supervision requests that were decided otherwise,
+// e.g. by their timeout, are closed without being read.
+func (p *ChangeRequestPoller) check(ctx context.Context, changeRequest ChangeRequest) error {
+	now := time.Now()
+	requestId := changeRequest.SupervisionRequestId
+
+	waiting, _, err := isWaiting(ctx, requestId, p.store)
+	if err != nil {
+		return err
+	}
+	if !waiting {
+		changeRequest.ClosedAt = &now
+		return p.store.UpdateChangeRequest(ctx, changeRequest)
+	}
+
+	remote, err := p.client.GetChangeRequest(ctx, changeRequest.SysId)
+	if errors.Is(err, errChangeRequestNotFound) {
+		// Deleted in ServiceNow, which leaves nothing to approve the tool call
+		remote = &serviceNowChangeRequest{SysId: changeRequest.SysId, Number: changeRequest.Number, Approval: changeRequest.Approval, State: serviceNowCanceledState}
+	} else if err != nil {
+		return err
+	}
+
+	changeRequest.Approval, changeRequest.State, changeRequest.CheckedAt = remote.Approval, remote.State, &now
+	decision, reasoning, decided := changeRequestDecision(*remote)
+	if !decided {
+		return p.store.UpdateChangeRequest(ctx, changeRequest)
+	}
+
+	// An approval is held like any other while the organization's kill switch is active
+	if decision == Approve {
+		killSwitch, err := getActiveKillSwitchForSupervisionRequest(ctx, requestId, p.store)
+		if err != nil {
+			return fmt.Errorf("error getting kill switch: %w", err)
+		}
+		if killSwitch != nil {
+			return p.store.UpdateChangeRequest(ctx, changeRequest)
+		}
+	}
+
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, requestId, p.store)
+	if err != nil {
+		return err
+	}
+
+	result := SupervisionResult{
+		CreatedAt:            now,
+		Decision:             decision,
+		Reasoning:            reasoning,
+		SupervisionRequestId: requestId,
+		ToolcallId:           toolCallId,
+	}
+	_, winner, err := resolveSupervisionRequest(ctx, requestId, result, "servicenow:"+changeRequest.Number, p.store)
+	if err != nil {
+		return err
+	}
+
+	// A rejection rejects everything depending on the tool call too
+	if winner == nil && toolCallId != nil && decision == Reject {
+		toolCallDecision, err := getToolCallDecision(ctx, *toolCallId, p.store)
+		if err != nil {
+			log.Printf("Error getting decision for tool call %s: %v", *toolCallId, err)
+		} else if toolCallDecision != nil && (*toolCallDecision == Reject || *toolCallDecision == Terminate) {
+			if err := propagateRejection(ctx, *toolCallId, p.store); err != nil {
+				log.Printf("Error propagating rejection of tool call %s: %v", *toolCallId, err)
+			}
+		}
+	}
+
+	changeRequest.ClosedAt = &now
+	return p.store.UpdateChangeRequest(ctx, changeRequest)
+}
+
+func apiGetSupervisionRequestChangeRequestHandler(w http.ResponseWriter, r *http.Request, supervisionRequestId uuid.UUID, store ChangeRequestStore) {
+	changeRequest, err := store.GetChangeRequest(r.Context(), supervisionRequestId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting change request", err.Error())
+		return
+	}
+
+	if changeRequest == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Change request not found", "")
+		return
+	}
+
+	respondJSON(w, changeRequest, http.StatusOK)
+}
@@ -0,0 +1,267 @@
+package asteroid
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// eventStreamResumeWindow is how long an event stream stays connected to the hub after its
+	// connection drops. Reconnecting with the Last-Event-ID header within it resumes the stream with
+	// the events sent meanwhile, and the session keeps its reviews.
+	eventStreamResumeWindow = 30 * time.Second
+	// eventStreamBufferSize events are kept for a stream to be resumed from
+	eventStreamBufferSize = 256
+	// eventStreamKeepAlive is how often a comment is sent on an idle stream, so proxies don't close it
+	eventStreamKeepAlive = 15 * time.Second
+	// eventStreamRetry is how long browsers wait before reconnecting, in milliseconds
+	eventStreamRetry = 3000
+)
+
+// streamEvent is a message the hub sent a stream, as the JSON a WebSocket connection would be sent
+type streamEvent struct {
+	sequence int64
+	data     []byte
+}
+
+// eventStream is an SSE connection's client of the hub. It outlives the connection by the resume
+// window, buffering what the hub sends it, so a reconnect carries on where the connection dropped.
+type eventStream struct {
+	id     string
+	client *Client
+
+	mu     sync.Mutex
+	events []streamEvent
+	next   int64
+	// detach is closed to stop the connection the stream is attached to, if any
+	detach chan struct{}
+	// expiry unregisters the client once the stream was detached for the resume window
+	expiry  *time.Timer
+	expired bool
+
+	// notify is signalled when an event is buffered
+	notify chan struct{}
+	// done is closed once the hub unregistered the client
+	done chan struct{}
+}
+
+// EventStreams are the hub's SSE clients, by stream ID
+type EventStreams struct {
+	hub     *Hub
+	mu      sync.Mutex
+	streams map[string]*eventStream
+}
+
+func NewEventStreams(hub *Hub) *EventStreams {
+	return &EventStreams{hub: hub, streams: make(map[string]*eventStream)}
+}
+
+// open starts a stream for a client and registers the client with the hub
+func (s *EventStreams) open(client *Client) *eventStream {
+	stream := &eventStream{
+		id:     uuid.New().String(),
+		client: client,
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+
+	s.mu.Lock()
+	s.streams[stream.id] = stream
+	s.mu.Unlock()
+
+	// Like a WebSocket's write pump, the stream has to be reading before registering, as joining a
+	// session replays its reviews
+	go s.pump(stream)
+	s.hub.Register <- client
+
+	return stream
+}
+
+// resume returns the stream a Last-Event-ID is of and the sequence it was read up to, if the stream
+// is still connected to the hub and the client would connect with the same project and session
+func (s *EventStreams) resume(lastEventId string, client *Client, sessionGiven bool) (*eventStream, int64) {
+	id, sequence, ok := strings.Cut(lastEventId, "/")
+	if !ok {
+		return nil, 0
+	}
+	after, err := strconv.ParseInt(sequence, 10, 64)
+	if err != nil {
+		return nil, 0
+	}
+
+	s.mu.Lock()
+	stream := s.streams[id]
+	s.mu.Unlock()
+	if stream == nil {
+		return nil, 0
+	}
+
+	if !sameProject(stream.client.Project, client.Project) || (sessionGiven && stream.client.Session != client.Session) {
+		return nil, 0
+	}
+
+	return stream, after
+}
+
+func sameProject(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// pump buffers what the hub sends a stream until the hub unregisters its client
+func (s *EventStreams) pump(stream *eventStream) {
+	for message := range stream.client.Send {
+		data, err := json.Marshal(redactForDemo(message))
+		if err != nil {
+			log.Printf("Error encoding event for stream %s: %v", stream.id, err)
+			continue
+		}
+
+		stream.mu.Lock()
+		stream.next++
+		stream.events = append(stream.events, streamEvent{sequence: stream.next, data: data})
+		if len(stream.events) > eventStreamBufferSize {
+			stream.events = stream.events[len(stream.events)-eventStreamBufferSize:]
+		}
+		stream.mu.Unlock()
+
+		select {
+		case stream.notify <- struct{}{}:
+		default:
+		}
+
+		stream.client.sent(message)
+	}
+
+	s.mu.Lock()
+	delete(s.streams, stream.id)
+	s.mu.Unlock()
+	close(stream.done)
+}
+
+// attach makes a connection the stream's, stopping the one it had, and returns the channel that's
+// closed when another connection takes over. It fails if the stream already expired.
+func (stream *eventStream) attach() (chan struct{}, bool) {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	if stream.expired {
+		return nil, false
+	}
+	if stream.expiry != nil {
+		stream.expiry.Stop()
+		stream.expiry = nil
+	}
+	if stream.detach != nil {
+		close(stream.detach)
+	}
+	stream.detach = make(chan struct{})
+	return stream.detach, true
+}
+
+// release detaches a connection that closed, unregistering the client once the resume window
+// passes without another connection attaching
+func (stream *eventStream) release(detach chan struct{}) {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	if stream.detach != detach {
+		return // Taken over by another connection
+	}
+	stream.detach = nil
+	stream.expiry = time.AfterFunc(eventStreamResumeWindow, func() {
+		stream.mu.Lock()
+		if stream.detach != nil {
+			stream.mu.Unlock()
+			return
+		}
+		stream.expired = true
+		stream.mu.Unlock()
+
+		stream.client.Hub.Unregister <- stream.client
+	})
+}
+
+// eventsAfter returns the buffered events after a sequence
+func (stream *eventStream) eventsAfter(sequence int64) []streamEvent {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	for i, event := range stream.events {
+		if event.sequence > sequence {
+			return append([]streamEvent(nil), stream.events[i:]...)
+		}
+	}
+	return nil
+}
+
+// serveEvents streams what the hub sends a reviewer session as server-sent events, for dashboards
+// behind proxies that break WebSockets. Each event's data is the JSON a WebSocket connection would
+// be sent. Decisions are made through the API, as the stream only goes one way.
+func serveEvents(streams *EventStreams, w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		sendErrorResponse(w, http.StatusInternalServerError, "streaming is not supported", "")
+		return
+	}
+
+	client, ok := newHubClient(streams.hub, w, r)
+	if !ok {
+		return
+	}
+
+	var detach chan struct{}
+	stream, after := streams.resume(r.Header.Get("Last-Event-ID"), client, r.URL.Query().Get(SessionQueryParam) != "")
+	if stream != nil {
+		detach, ok = stream.attach()
+	}
+	if !ok || stream == nil {
+		stream, after = streams.open(client), 0
+		detach, _ = stream.attach()
+	}
+	defer stream.release(detach)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", eventStreamRetry)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(eventStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		for _, event := range stream.eventsAfter(after) {
+			if _, err := fmt.Fprintf(w, "id: %s/%d\ndata: %s\n\n", stream.id, event.sequence, event.data); err != nil {
+				return
+			}
+			after = event.sequence
+		}
+		flusher.Flush()
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-detach:
+			return
+		case <-stream.done:
+			return
+		case <-stream.notify:
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
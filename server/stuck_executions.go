@@ -0,0 +1,154 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultStuckExecutionThreshold = 10 * time.Minute
+	stuckExecutionInterval         = time.Minute
+)
+
+// StuckExecutionRepairer decides the supervision requests an LLM or ensemble supervisor has been
+// deciding for too long with the supervisor's failure policy. They're asked in the background of the
+// replica that assigned them, so a request stays assigned if that replica stops midway or the
+// supervisor fails without storing a decision, and its chain execution never moves on.
+type StuckExecutionRepairer struct {
+	store     Store
+	threshold time.Duration
+	interval  time.Duration
+
+	mutex       sync.Mutex
+	repaired    int
+	byDecision  map[string]int
+	lastChecked *time.Time
+}
+
+// NewStuckExecutionRepairerFromEnv treats requests as stuck once they've been assigned for
+// STUCK_EXECUTION_SECONDS, 600 unless set
+func NewStuckExecutionRepairerFromEnv(store Store) (*StuckExecutionRepairer, error) {
+	threshold := defaultStuckExecutionThreshold
+	if value := os.Getenv("STUCK_EXECUTION_SECONDS"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds < 1 {
+			return nil, fmt.Errorf("STUCK_EXECUTION_SECONDS must be a positive number of seconds")
+		}
+		threshold = time.Duration(seconds) * time.Second
+	}
+
+	return &StuckExecutionRepairer{
+		store:      store,
+		threshold:  threshold,
+		interval:   stuckExecutionInterval,
+		byDecision: make(map[string]int),
+	}, nil
+}
+
+func (s *StuckExecutionRepairer) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.repairStuckExecutions(ctx); err != nil {
+				log.Printf("Error repairing stuck executions: %v", err)
+			}
+		}
+	}
+}
+
+func (s *StuckExecutionRepairer) repairStuckExecutions(ctx context.Context) error {
+	now := time.Now()
+	requests, err := s.store.GetSupervisionRequestsForStatus(ctx, Assigned)
+	if err != nil {
+		return fmt.Errorf("error getting assigned supervision requests: %w", err)
+	}
+
+	for _, request := range requests {
+		if request.Status == nil || now.Sub(request.Status.CreatedAt) < s.threshold {
+			continue
+		}
+		if err := s.repair(ctx, request, now.Sub(request.Status.CreatedAt)); err != nil {
+			log.Printf("Error repairing stuck supervision request %s: %v", *request.Id, err)
+		}
+	}
+
+	s.mutex.Lock()
+	s.lastChecked = &now
+	s.mutex.Unlock()
+	return nil
+}
+
+// repair decides a request that's been assigned for a while with the failure policy of its
+// supervisor, if that's one the server asks in the background. Human reviews and the requests of
+// consent and change request supervisors are left alone, as they wait on people.
+func (s *StuckExecutionRepairer) repair(ctx context.Context, request SupervisionRequest, stuckFor time.Duration) error {
+	supervisor, err := s.store.GetSupervisor(ctx, request.SupervisorId)
+	if err != nil {
+		return fmt.Errorf("error getting supervisor: %w", err)
+	}
+	if supervisor == nil || (supervisor.Type != LlmSupervisor && supervisor.Type != EnsembleSupervisor) {
+		return nil
+	}
+
+	// A supervisor whose failure handling can't be read escalates, as it would have when asked
+	handling, err := parseFailureHandling(supervisor.Attributes)
+	if err != nil {
+		handling.policy = EscalateOnFailure
+	}
+
+	result := SupervisionResult{SupervisionRequestId: *request.Id, CreatedAt: time.Now()}
+	applyFailurePolicy(&result, handling.policy, fmt.Sprintf("the supervisor was still deciding after %s", stuckFor.Round(time.Second)))
+
+	id, winner, err := resolveSupervisionRequest(ctx, *request.Id, result, SystemActor, s.store)
+	if err != nil {
+		return err
+	}
+	if winner != nil {
+		// The supervisor decided after all, while the request was being repaired
+		return nil
+	}
+
+	recordAuditEvent(ctx, SystemActor, AuditActionExecutionRepaired, supervisionRequestResource, *request.Id, map[string]interface{}{
+		"supervisor_type":   supervisor.Type,
+		"failure_policy":    handling.policy,
+		"decision":          result.Decision,
+		"result_id":         id,
+		"stuck_for_seconds": int(stuckFor.Seconds()),
+	}, s.store)
+
+	s.mutex.Lock()
+	s.repaired++
+	s.byDecision[string(result.Decision)]++
+	s.mutex.Unlock()
+
+	log.Printf("Repaired supervision request %s, stuck with supervisor %s for %s: %s by its %s failure policy",
+		*request.Id, supervisor.Name, stuckFor.Round(time.Second), result.Decision, handling.policy)
+	return nil
+}
+
+// report returns the repairs this replica made
+func (s *StuckExecutionRepairer) report() StuckExecutionRepairs {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	byDecision := make(map[string]int, len(s.byDecision))
+	for decision, count := range s.byDecision {
+		byDecision[decision] = count
+	}
+	return StuckExecutionRepairs{
+		ThresholdSeconds: int(s.threshold.Seconds()),
+		Repaired:         s.repaired,
+		ByDecision:       byDecision,
+		LastCheckedAt:    s.lastChecked,
+	}
+}
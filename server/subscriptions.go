@@ -0,0 +1,210 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// RunsQueryParam, ProjectsQueryParam and SupervisorsQueryParam are the WebSocket query parameters
+// listing the runs, projects and supervisor queues a connection subscribes to, comma separated.
+// Connections that don't subscribe to anything are sent every review, like before subscriptions.
+const (
+	RunsQueryParam        = "runs"
+	ProjectsQueryParam    = "projects"
+	SupervisorsQueryParam = "supervisors"
+)
+
+// SubscribeMessage and UnsubscribeMessage are the types of the messages a connection sends to
+// change what it subscribes to once connected
+const (
+	SubscribeMessage   = "subscribe"
+	UnsubscribeMessage = "unsubscribe"
+)
+
+// subscriptionMessage adds to or removes from a connection's subscription
+type subscriptionMessage struct {
+	Type          string      `json:"type"`
+	RunIds        []uuid.UUID `json:"run_ids"`
+	ProjectIds    []uuid.UUID `json:"project_ids"`
+	SupervisorIds []uuid.UUID `json:"supervisor_ids"`
+}
+
+// subscription is what a connection wants to be sent reviews of
+type subscription struct {
+	runs        map[uuid.UUID]bool
+	projects    map[uuid.UUID]bool
+	supervisors map[uuid.UUID]bool
+}
+
+// reviewScope is what a review belongs to, matched against subscriptions. The run and project are
+// nil for reviews whose tool call can't be found.
+type reviewScope struct {
+	runId        *uuid.UUID
+	projectId    *uuid.UUID
+	supervisorId uuid.UUID
+}
+
+func newSubscription() subscription {
+	return subscription{
+		runs:        make(map[uuid.UUID]bool),
+		projects:    make(map[uuid.UUID]bool),
+		supervisors: make(map[uuid.UUID]bool),
+	}
+}
+
+// parseSubscription reads the subscription a connection asked for in its query parameters
+func parseSubscription(query url.Values) (subscription, error) {
+	s := newSubscription()
+	for param, ids := range map[string]map[uuid.UUID]bool{RunsQueryParam: s.runs, ProjectsQueryParam: s.projects, SupervisorsQueryParam: s.supervisors} {
+		for _, value := range strings.Split(query.Get(param), ",") {
+			if value = strings.TrimSpace(value); value == "" {
+				continue
+			}
+			id, err := uuid.Parse(value)
+			if err != nil {
+				return s, fmt.Errorf("%s must be comma separated IDs: %w", param, err)
+			}
+			ids[id] = true
+		}
+	}
+	return s, nil
+}
+
+// apply adds or removes the IDs of a subscription message
+func (s subscription) apply(message subscriptionMessage) {
+	change := func(ids map[uuid.UUID]bool, changed []uuid.UUID) {
+		for _, id := range changed {
+			if message.Type == SubscribeMessage {
+				ids[id] = true
+			} else {
+				delete(ids, id)
+			}
+		}
+	}
+	change(s.runs, message.RunIds)
+	change(s.projects, message.ProjectIds)
+	change(s.supervisors, message.SupervisorIds)
+}
+
+func (s subscription) empty() bool {
+	return len(s.runs) == 0 && len(s.projects) == 0 && len(s.supervisors) == 0
+}
+
+// follows reports whether a subscription wants the reviews of a scope. Not subscribing to anything
+// follows every review.
+func (s subscription) follows(scope reviewScope) bool {
+	if s.empty() || s.supervisors[scope.supervisorId] {
+		return true
+	}
+	if scope.runId != nil && s.runs[*scope.runId] {
+		return true
+	}
+	return scope.projectId != nil && s.projects[*scope.projectId]
+}
+
+// getReviewScope returns the run, project and supervisor a supervision request belongs to
+func getReviewScope(ctx context.Context, supervisionRequest SupervisionRequest, store Store) (reviewScope, error) {
+	scope := reviewScope{supervisorId: supervisionRequest.SupervisorId}
+
+	runId, err := getRunIdForSupervisionRequest(ctx, *supervisionRequest.Id, store)
+	if err != nil || runId == nil {
+		return scope, err
+	}
+	scope.runId = runId
+
+	project, err := getProjectForRun(ctx, *runId, store)
+	if err != nil {
+		return scope, err
+	}
+	if project != nil {
+		scope.projectId = &project.Id
+	}
+	return scope, nil
+}
+
+// getReviewScopes returns the scopes of supervision requests by their ID. Requests whose scope
+// can't be read are left out, and go to every connection.
+func getReviewScopes(ctx context.Context, requestIds []uuid.UUID, store Store) map[uuid.UUID]reviewScope {
+	scopes := make(map[uuid.UUID]reviewScope)
+	for _, requestId := range requestIds {
+		supervisionRequest, err := store.GetSupervisionRequest(ctx, requestId)
+		if err == nil && supervisionRequest != nil {
+			var scope reviewScope
+			scope, err = getReviewScope(ctx, *supervisionRequest, store)
+			if err == nil {
+				scopes[requestId] = scope
+				continue
+			}
+		}
+		if err != nil {
+			log.Printf("Error getting scope of request %s, sending its events to every connection: %v", requestId, err)
+		}
+	}
+	return scopes
+}
+
+// follows reports whether a client is sent the reviews of a scope. Clients of a project's key only
+// follow that project, whatever they subscribe to. Must be called with the hub's ClientsMutex held.
+func (c *Client) follows(scope reviewScope) bool {
+	if c.Project != nil && (scope.projectId == nil || *scope.projectId != *c.Project) {
+		return false
+	}
+	return c.Subscription.follows(scope)
+}
+
+// followsRequest reports whether a client subscribes to a request, given the scopes of
+// getReviewScopes. Requests of unknown scope only go to clients without a project. Must be called
+// with the hub's ClientsMutex held.
+func (c *Client) followsRequest(scopes map[uuid.UUID]reviewScope, requestId uuid.UUID) bool {
+	scope, ok := scopes[requestId]
+	if !ok {
+		return c.Project == nil
+	}
+	return c.follows(scope)
+}
+
+// reachesRequest reports whether a client may decide a request, which clients of a project's key
+// only may for that project's requests
+func (c *Client) reachesRequest(ctx context.Context, requestId uuid.UUID) (bool, error) {
+	if c.Project == nil {
+		return true, nil
+	}
+
+	runId, err := getRunIdForSupervisionRequest(ctx, requestId, c.Hub.Store)
+	if err != nil || runId == nil {
+		return false, err
+	}
+
+	project, err := getProjectForRun(ctx, *runId, c.Hub.Store)
+	if err != nil || project == nil {
+		return false, err
+	}
+	return project.Id == *c.Project, nil
+}
+
+// sessionFollows reports whether any client of a session subscribes to a scope. A session is shown
+// the same reviews on every connection, so one subscribing is enough. Must be called with the hub's
+// ClientsMutex held.
+func (h *Hub) sessionFollows(session string, scope reviewScope) bool {
+	for client := range h.Sessions[session] {
+		if client.follows(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateSubscription applies a subscription message a client sent
+func (h *Hub) updateSubscription(client *Client, message subscriptionMessage) {
+	h.ClientsMutex.Lock()
+	defer h.ClientsMutex.Unlock()
+
+	client.Subscription.apply(message)
+	log.Printf("Client in session %s changed its subscription: %d runs, %d projects, %d supervisors",
+		client.Session, len(client.Subscription.runs), len(client.Subscription.projects), len(client.Subscription.supervisors))
+}
@@ -0,0 +1,205 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChatUsage is the model and token usage a model provider reported for one chat
+type ChatUsage struct {
+	Id               uuid.UUID
+	RunId            uuid.UUID
+	CreatedAt        time.Time
+	Model            string
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// getTaskSummary adds up the usage and tool call decisions of every run of a task
+func getTaskSummary(ctx context.Context, taskId uuid.UUID, store Store) (*TaskSummary, error) {
+	runs, err := store.GetTaskRuns(ctx, taskId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting task runs: %w", err)
+	}
+
+	summary := TaskSummary{
+		TaskId:       taskId,
+		Runs:         len(runs),
+		RunsByStatus: map[string]int{},
+	}
+
+	for _, run := range runs {
+		if run.Status != nil {
+			summary.RunsByStatus[string(*run.Status)]++
+		}
+
+		toolCalls, err := store.GetRunToolCalls(ctx, run.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting tool calls: %w", err)
+		}
+		summary.ToolCalls += len(toolCalls)
+
+		for _, toolCall := range toolCalls {
+			decision, err := getToolCallDecision(ctx, toolCall.Id, store)
+			if err != nil {
+				return nil, err
+			}
+			countDecision(&summary.Decisions, decision)
+		}
+	}
+
+	usage, err := store.GetTaskChatUsage(ctx, taskId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chat usage: %w", err)
+	}
+
+	summary.Chats = len(usage)
+	for _, chat := range usage {
+		summary.PromptTokens += chat.PromptTokens
+		summary.CompletionTokens += chat.CompletionTokens
+	}
+
+	return &summary, nil
+}
+
+// countDecision adds a tool call's decision to the counts, nil being undecided
+func countDecision(counts *TaskDecisionCounts, decision *Decision) {
+	switch {
+	case decision == nil:
+		counts.Undecided++
+	case *decision == Reject:
+		counts.Rejected++
+	case *decision == Terminate:
+		counts.Terminated++
+	case *decision == Modify:
+		counts.Modified++
+	default:
+		counts.Approved++
+	}
+}
+
+// getTaskTimeline merges the runs, chats, events and tool call histories of a task into one timeline
+func getTaskTimeline(ctx context.Context, taskId uuid.UUID, store Store) ([]TaskTimelineEntry, error) {
+	runs, err := store.GetTaskRuns(ctx, taskId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting task runs: %w", err)
+	}
+
+	timeline := make([]TaskTimelineEntry, 0)
+	for _, run := range runs {
+		timeline = append(timeline, TaskTimelineEntry{
+			CreatedAt: run.CreatedAt,
+			RunId:     run.Id,
+			Event:     RunStarted,
+		})
+
+		events, err := store.GetRunEvents(ctx, run.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting run events: %w", err)
+		}
+
+		for i := range events {
+			timeline = append(timeline, TaskTimelineEntry{
+				CreatedAt: events[i].OccurredAt,
+				RunId:     run.Id,
+				Event:     ExternalEvent,
+				RunEvent:  &events[i],
+			})
+		}
+
+		toolCalls, err := store.GetRunToolCalls(ctx, run.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting tool calls: %w", err)
+		}
+
+		for _, toolCall := range toolCalls {
+			history, err := getToolCallHistory(ctx, toolCall.Id, store)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range history {
+				timeline = append(timeline, TaskTimelineEntry{
+					CreatedAt:     history[i].CreatedAt,
+					RunId:         run.Id,
+					Event:         ToolCallEvent,
+					ToolCallId:    &toolCall.Id,
+					ToolCallEvent: &history[i],
+				})
+			}
+		}
+	}
+
+	usage, err := store.GetTaskChatUsage(ctx, taskId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting chat usage: %w", err)
+	}
+
+	for _, chat := range usage {
+		timeline = append(timeline, TaskTimelineEntry{
+			CreatedAt:        chat.CreatedAt,
+			RunId:            chat.RunId,
+			Event:            ChatCompletion,
+			ChatId:           &chat.Id,
+			PromptTokens:     &chat.PromptTokens,
+			CompletionTokens: &chat.CompletionTokens,
+		})
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].CreatedAt.Before(timeline[j].CreatedAt)
+	})
+
+	return timeline, nil
+}
+
+func apiGetTaskSummaryHandler(w http.ResponseWriter, r *http.Request, taskId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	task, err := store.GetTask(ctx, taskId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting task", err.Error())
+		return
+	}
+
+	if task == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Task not found", "")
+		return
+	}
+
+	summary, err := getTaskSummary(ctx, taskId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting task summary", err.Error())
+		return
+	}
+
+	respondJSON(w, summary, http.StatusOK)
+}
+
+func apiGetTaskTimelineHandler(w http.ResponseWriter, r *http.Request, taskId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	task, err := store.GetTask(ctx, taskId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting task", err.Error())
+		return
+	}
+
+	if task == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Task not found", "")
+		return
+	}
+
+	timeline, err := getTaskTimeline(ctx, taskId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting task timeline", err.Error())
+		return
+	}
+
+	respondJSON(w, timeline, http.StatusOK)
+}
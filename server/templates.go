@@ -0,0 +1,234 @@
+package asteroid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// projectTemplates are the built in supervision setups a project can be bootstrapped from
+var projectTemplates = []ProjectTemplate{
+	{
+		Name:        "human_review",
+		Description: "Humans review every tool call that can change something, with a second reviewer for critical tools",
+		Supervisors: []TemplateSupervisor{
+			{Key: "reviewer", Name: "Reviewer", Description: "Reviews tool calls in the Asteroid UI", Type: HumanSupervisor},
+			{Key: "senior_reviewer", Name: "Senior reviewer", Description: "Reviews escalated and critical tool calls", Type: HumanSupervisor},
+		},
+		RiskTiers: map[string][][]string{
+			string(Low):      {},
+			string(Medium):   {{"reviewer"}},
+			string(High):     {{"reviewer", "senior_reviewer"}},
+			string(Critical): {{"senior_reviewer"}, {"reviewer"}},
+		},
+		Tools: map[string]RiskTier{
+			"read_file":       Low,
+			"search":          Low,
+			"http_request":    Medium,
+			"write_file":      High,
+			"send_email":      High,
+			"execute_command": Critical,
+			WildcardToolName:  Medium,
+		},
+		NotificationSettings: NotificationSettings{
+			Events: []NotificationEvent{ReviewRequested, Escalated},
+		},
+	},
+	{
+		Name:        "client_first",
+		Description: "Supervisors running in the agent review first and escalate to a human when unsure",
+		Supervisors: []TemplateSupervisor{
+			{Key: "client", Name: "Client supervisor", Description: "Supervisor run by the agent's SDK", Type: ClientSupervisor},
+			{Key: "reviewer", Name: "Reviewer", Description: "Reviews escalated tool calls in the Asteroid UI", Type: HumanSupervisor},
+		},
+		RiskTiers: map[string][][]string{
+			string(Low):      {{"client"}},
+			string(Medium):   {{"client", "reviewer"}},
+			string(High):     {{"client", "reviewer"}},
+			string(Critical): {{"reviewer"}},
+		},
+		Tools: map[string]RiskTier{
+			"read_file":       Low,
+			"search":          Low,
+			"http_request":    Medium,
+			"write_file":      Medium,
+			"send_email":      High,
+			"execute_command": Critical,
+			WildcardToolName:  Medium,
+		},
+		NotificationSettings: NotificationSettings{
+			Events: []NotificationEvent{Escalated, Rejected},
+		},
+	},
+	{
+		Name:        "audit_only",
+		Description: "Every tool call is recorded and approved without review",
+		Supervisors: []TemplateSupervisor{
+			{Key: "audit", Name: "Audit log", Description: "Records tool calls without blocking them", Type: NoSupervisor},
+		},
+		RiskTiers: map[string][][]string{
+			string(Low):      {{"audit"}},
+			string(Medium):   {{"audit"}},
+			string(High):     {{"audit"}},
+			string(Critical): {{"audit"}},
+		},
+		Tools: map[string]RiskTier{
+			WildcardToolName: Low,
+		},
+		NotificationSettings: NotificationSettings{
+			Events: []NotificationEvent{},
+		},
+	},
+}
+
+// GetProjectTemplate returns the template with the given name, or nil if there isn't one
+func GetProjectTemplate(name string) *ProjectTemplate {
+	for i := range projectTemplates {
+		if projectTemplates[i].Name == name {
+			return &projectTemplates[i]
+		}
+	}
+	return nil
+}
+
+// toolPoliciesFromTemplate turns a template's tool tiers into tool policies, using the IDs of the
+// supervisors created for it
+func toolPoliciesFromTemplate(template ProjectTemplate, supervisorIds map[string]uuid.UUID) ([]ToolPolicy, error) {
+	toolNames := make([]string, 0, len(template.Tools))
+	for toolName := range template.Tools {
+		toolNames = append(toolNames, toolName)
+	}
+	sort.Strings(toolNames)
+
+	policies := make([]ToolPolicy, 0, len(toolNames))
+	for _, toolName := range toolNames {
+		tier := template.Tools[toolName]
+
+		chains := make([]ChainRequest, 0)
+		for _, keys := range template.RiskTiers[string(tier)] {
+			ids := make([]uuid.UUID, 0, len(keys))
+			for _, key := range keys {
+				id, ok := supervisorIds[key]
+				if !ok {
+					return nil, fmt.Errorf("template %s uses unknown supervisor %s", template.Name, key)
+				}
+				ids = append(ids, id)
+			}
+			chains = append(chains, ChainRequest{SupervisorIds: &ids})
+		}
+
+		policies = append(policies, ToolPolicy{ToolName: toolName, RiskTier: tier, Chains: chains})
+	}
+
+	return policies, nil
+}
+
+func apiGetProjectTemplatesHandler(w http.ResponseWriter, _ *http.Request) {
+	respondJSON(w, projectTemplates, http.StatusOK)
+}
+
+func apiBootstrapProjectHandler(w http.ResponseWriter, r *http.Request, store Store) {
+	ctx := r.Context()
+
+	var request BootstrapProjectJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if request.Name == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "name is required", "")
+		return
+	}
+
+	template := GetProjectTemplate(request.Template)
+	if template == nil {
+		sendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown project template: %s", request.Template), "")
+		return
+	}
+
+	existingProject, err := store.GetProjectFromName(ctx, request.Name)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "Error getting project", err.Error())
+		return
+	}
+
+	if existingProject != nil {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("project %s already exists", request.Name), existingProject.Id.String())
+		return
+	}
+
+	if request.OrganizationId != nil {
+		organization, err := store.GetOrganization(ctx, *request.OrganizationId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting organization", err.Error())
+			return
+		}
+
+		if organization == nil {
+			sendErrorResponse(w, http.StatusNotFound, "Organization not found", "")
+			return
+		}
+	}
+
+	project := Project{
+		Id:             uuid.New(),
+		Name:           request.Name,
+		RunResultTags:  []string{},
+		OrganizationId: request.OrganizationId,
+		CreatedAt:      time.Now(),
+	}
+	if request.RunResultTags != nil {
+		project.RunResultTags = *request.RunResultTags
+	}
+
+	if err := store.CreateProject(ctx, project); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "Failed to register project", err.Error())
+		return
+	}
+
+	supervisorIds := make(map[string]uuid.UUID, len(template.Supervisors))
+	for _, templateSupervisor := range template.Supervisors {
+		supervisorId, err := store.CreateSupervisor(ctx, Supervisor{
+			Name:        templateSupervisor.Name,
+			Description: templateSupervisor.Description,
+			Type:        templateSupervisor.Type,
+			Attributes:  map[string]interface{}{"template": template.Name},
+			CreatedAt:   time.Now(),
+		})
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error creating supervisor", err.Error())
+			return
+		}
+		supervisorIds[templateSupervisor.Key] = supervisorId
+	}
+
+	policies, err := toolPoliciesFromTemplate(*template, supervisorIds)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "invalid project template", err.Error())
+		return
+	}
+
+	if err := store.SetProjectToolPolicies(ctx, project.Id, policies); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting tool policies", err.Error())
+		return
+	}
+
+	if err := store.SetNotificationSettings(ctx, project.Id, template.NotificationSettings); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting notification settings", err.Error())
+		return
+	}
+
+	result := ProjectBootstrapResult{
+		Project:              project,
+		Supervisors:          supervisorIds,
+		ToolPolicies:         policies,
+		NotificationSettings: template.NotificationSettings,
+	}
+
+	respondJSON(w, result, http.StatusCreated)
+}
@@ -0,0 +1,193 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// validateSupervisorTestCases checks that test cases have unique names, a tool and a known expected decision
+func validateSupervisorTestCases(cases []SupervisorTestCase) error {
+	names := make(map[string]bool, len(cases))
+	for i, testCase := range cases {
+		if testCase.Name == "" {
+			return fmt.Errorf("test case %d needs a name", i)
+		}
+		if names[testCase.Name] {
+			return fmt.Errorf("duplicate test case %s", testCase.Name)
+		}
+		names[testCase.Name] = true
+
+		if testCase.ToolName == "" {
+			return fmt.Errorf("test case %s needs a tool name", testCase.Name)
+		}
+		if _, ok := adviceSeverity[testCase.ExpectedDecision]; !ok {
+			return fmt.Errorf("test case %s expects unknown decision %s", testCase.Name, testCase.ExpectedDecision)
+		}
+		if testCase.Arguments != nil && !json.Valid([]byte(*testCase.Arguments)) {
+			return fmt.Errorf("arguments of test case %s aren't valid JSON", testCase.Name)
+		}
+	}
+	return nil
+}
+
+// runSupervisorTestCase decides a test case with a supervisor alone. Ensemble supervisors review
+// it with their members' own prompts, whatever prompt variants they have.
+func runSupervisorTestCase(ctx context.Context, supervisor Supervisor, testCase SupervisorTestCase, judge Judge) (SupervisorTestResult, error) {
+	result := SupervisorTestResult{Name: testCase.Name, ExpectedDecision: testCase.ExpectedDecision}
+
+	var verdict advisoryVerdict
+	switch supervisor.Type {
+	case NoSupervisor:
+		approve := Approve
+		verdict = advisoryVerdict{decision: &approve, reasoning: fmt.Sprintf("%s doesn't supervise", supervisor.Name)}
+	case EnsembleSupervisor:
+		tool := Tool{Name: testCase.ToolName}
+		if testCase.ToolDescription != nil {
+			tool.Description = *testCase.ToolDescription
+		}
+		var err error
+		verdict, err = askEnsembleAdvisory(ctx, supervisor, tool, testCase.Arguments, nil, judge)
+		if err != nil {
+			return result, err
+		}
+	case LlmSupervisor:
+		tool := Tool{Name: testCase.ToolName}
+		if testCase.ToolDescription != nil {
+			tool.Description = *testCase.ToolDescription
+		}
+		var err error
+		verdict, err = askLlmAdvisory(ctx, supervisor, tool, testCase.Arguments, judge)
+		if err != nil {
+			return result, err
+		}
+	case PolicySupervisor:
+		verdict = advisoryVerdict{reasoning: fmt.Sprintf("%s decides by the events of a run, which test cases don't have", supervisor.Name)}
+	case ComputerUseSupervisor:
+		decision, explanation, err := evaluateComputerUse(supervisor, testCase.Arguments)
+		if err != nil {
+			return result, err
+		}
+		verdict = advisoryVerdict{decision: &decision, reasoning: *explanation.Rationale}
+	default:
+		verdict = advisoryVerdict{reasoning: fmt.Sprintf("%s supervisors aren't run by the server", supervisor.Type)}
+	}
+
+	result.Decision = verdict.decision
+	result.Reasoning = verdict.reasoning
+	result.Skipped = verdict.decision == nil
+	result.Passed = verdict.decision != nil && *verdict.decision == testCase.ExpectedDecision
+	return result, nil
+}
+
+// runSupervisorTestCases runs every test case of a supervisor, in order
+func runSupervisorTestCases(ctx context.Context, supervisor Supervisor, cases []SupervisorTestCase, judge Judge) (*SupervisorTestReport, error) {
+	report := SupervisorTestReport{SupervisorId: *supervisor.Id, Results: make([]SupervisorTestResult, 0, len(cases))}
+
+	for _, testCase := range cases {
+		result, err := runSupervisorTestCase(ctx, supervisor, testCase, judge)
+		if err != nil {
+			return nil, fmt.Errorf("error running test case %s: %w", testCase.Name, err)
+		}
+
+		switch {
+		case result.Skipped:
+			report.Skipped++
+		case result.Passed:
+			report.Passed++
+		default:
+			report.Failed++
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	return &report, nil
+}
+
+func apiGetSupervisorTestCasesHandler(w http.ResponseWriter, r *http.Request, supervisorId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	supervisor, err := store.GetSupervisor(ctx, supervisorId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor", err.Error())
+		return
+	}
+
+	if supervisor == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervisor not found", "")
+		return
+	}
+
+	cases, err := store.GetSupervisorTestCases(ctx, supervisorId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting test cases", err.Error())
+		return
+	}
+
+	respondJSON(w, cases, http.StatusOK)
+}
+
+func apiSetSupervisorTestCasesHandler(w http.ResponseWriter, r *http.Request, supervisorId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var cases []SupervisorTestCase
+	if err := json.NewDecoder(r.Body).Decode(&cases); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateSupervisorTestCases(cases); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid test case", err.Error())
+		return
+	}
+
+	supervisor, err := store.GetSupervisor(ctx, supervisorId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor", err.Error())
+		return
+	}
+
+	if supervisor == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervisor not found", "")
+		return
+	}
+
+	if err := store.SetSupervisorTestCases(ctx, supervisorId, cases); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting test cases", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiRunSupervisorTestCasesHandler(w http.ResponseWriter, r *http.Request, supervisorId uuid.UUID, store Store, judge Judge) {
+	ctx := r.Context()
+
+	supervisor, err := store.GetSupervisor(ctx, supervisorId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting supervisor", err.Error())
+		return
+	}
+
+	if supervisor == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Supervisor not found", "")
+		return
+	}
+
+	cases, err := store.GetSupervisorTestCases(ctx, supervisorId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting test cases", err.Error())
+		return
+	}
+
+	report, err := runSupervisorTestCases(ctx, *supervisor, cases, judge)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error running test cases", err.Error())
+		return
+	}
+
+	respondJSON(w, report, http.StatusOK)
+}
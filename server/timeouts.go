@@ -0,0 +1,173 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// validateChainTimeouts checks a chain's timeouts are positive with known fallbacks, and that
+// supervisor timeouts are for supervisors of the chain
+func validateChainTimeouts(chain ChainRequest) error {
+	if chain.Timeout != nil {
+		if err := validateTimeout(chain.Timeout.TimeoutSeconds, chain.Timeout.Fallback); err != nil {
+			return fmt.Errorf("chain timeout: %w", err)
+		}
+	}
+
+	if chain.SupervisorTimeouts == nil {
+		return nil
+	}
+
+	var supervisorIds []uuid.UUID
+	if chain.SupervisorIds != nil {
+		supervisorIds = *chain.SupervisorIds
+	}
+	positions := make(map[uuid.UUID]int)
+	for i, supervisorId := range supervisorIds {
+		positions[supervisorId] = i
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	for _, timeout := range *chain.SupervisorTimeouts {
+		position, ok := positions[timeout.SupervisorId]
+		if !ok {
+			return fmt.Errorf("timeout for supervisor %s, which isn't in the chain", timeout.SupervisorId)
+		}
+		if seen[timeout.SupervisorId] {
+			return fmt.Errorf("duplicate timeout for supervisor %s", timeout.SupervisorId)
+		}
+		seen[timeout.SupervisorId] = true
+
+		if err := validateTimeout(timeout.TimeoutSeconds, timeout.Fallback); err != nil {
+			return fmt.Errorf("timeout for supervisor %s: %w", timeout.SupervisorId, err)
+		}
+		if timeout.Fallback == EscalateToNext && position == len(supervisorIds)-1 {
+			return fmt.Errorf("supervisor %s is the last in the chain, so its timeout can't escalate to the next", timeout.SupervisorId)
+		}
+	}
+	return nil
+}
+
+func validateTimeout(seconds int, fallback TimeoutFallback) error {
+	if seconds < 1 {
+		return fmt.Errorf("timeout_seconds must be at least 1")
+	}
+	switch fallback {
+	case AutoApprove, AutoReject, EscalateToNext:
+	default:
+		return fmt.Errorf("unknown fallback: %s", fallback)
+	}
+	return nil
+}
+
+// chainTimeoutFor returns the timeout of a supervisor in a chain, its own or else the chain's
+func chainTimeoutFor(chain SupervisorChain, supervisorId uuid.UUID) *ChainTimeout {
+	if chain.SupervisorTimeouts != nil {
+		for _, timeout := range *chain.SupervisorTimeouts {
+			if timeout.SupervisorId == supervisorId {
+				return &ChainTimeout{TimeoutSeconds: timeout.TimeoutSeconds, Fallback: timeout.Fallback}
+			}
+		}
+	}
+	return chain.Timeout
+}
+
+// scheduleDecisionTimeout stores a timer that decides a supervision request with its chain's
+// fallback if the supervisor hasn't decided it in time
+func scheduleDecisionTimeout(ctx context.Context, supervisionRequestId uuid.UUID, chain SupervisorChain, supervisorId uuid.UUID, createdAt time.Time, store TimerStore) error {
+	timeout := chainTimeoutFor(chain, supervisorId)
+	if timeout == nil {
+		return nil
+	}
+
+	attributes := map[string]interface{}{"fallback": timeout.Fallback, "timeout_seconds": timeout.TimeoutSeconds}
+	fireAt := createdAt.Add(time.Duration(timeout.TimeoutSeconds) * time.Second)
+	_, err := scheduleTimer(ctx, DecisionTimeout, supervisionRequestId, fireAt, attributes, store)
+	return err
+}
+
+// fireDecisionTimeout decides a supervision request that's still undecided with the fallback of its
+// timer. The request's status records the timeout before the fallback's result completes it.
+func fireDecisionTimeout(ctx context.Context, timer DurableTimer, hub *Hub, store Store) error {
+	waiting, _, err := isWaiting(ctx, timer.SupervisionRequestId, store)
+	if err != nil || !waiting {
+		return err
+	}
+
+	var fallback TimeoutFallback
+	var seconds float64
+	if timer.Attributes != nil {
+		value, _ := (*timer.Attributes)["fallback"].(string)
+		fallback = TimeoutFallback(value)
+		seconds, _ = (*timer.Attributes)["timeout_seconds"].(float64)
+	}
+
+	supervisionRequest, chain, err := getSupervisionRequestChain(ctx, timer.SupervisionRequestId, store)
+	if err != nil {
+		return err
+	}
+
+	result := SupervisionResult{
+		SupervisionRequestId: timer.SupervisionRequestId,
+		TimeoutFallback:      &fallback,
+	}
+	within := fmt.Sprintf("No decision within %v", time.Duration(seconds)*time.Second)
+	switch fallback {
+	case AutoApprove:
+		result.Decision = Approve
+		result.Reasoning = within + ", approved by the timeout's fallback"
+	case AutoReject:
+		result.Decision = Reject
+		result.Reasoning = within + ", rejected by the timeout's fallback"
+	case EscalateToNext:
+		if chain != nil && supervisionRequest.PositionInChain >= len(chain.Supervisors)-1 {
+			result.Decision = Reject
+			result.Reasoning = within + " by the last supervisor of the chain, rejected as there's no one to escalate to"
+		} else {
+			result.Decision = Escalate
+			result.Reasoning = within + ", escalated to the next supervisor by the timeout's fallback"
+		}
+	default:
+		return fmt.Errorf("unknown timeout fallback: %s", fallback)
+	}
+
+	now := time.Now()
+	if err := store.CreateSupervisionStatus(ctx, timer.SupervisionRequestId, SupervisionStatus{Status: Timeout, CreatedAt: now}); err != nil {
+		return fmt.Errorf("error creating supervision status: %w", err)
+	}
+
+	result.CreatedAt = now
+	_, winner, err := resolveSupervisionRequest(ctx, timer.SupervisionRequestId, result, SystemActor, store)
+	if err != nil {
+		return err
+	}
+	if winner != nil {
+		return nil
+	}
+	hub.resolveAssignedReview(result)
+
+	notifyTimedOut(ctx, result, store)
+	return nil
+}
+
+// notifyTimedOut tells the project of a timed out supervision request's tool call about the fallback
+func notifyTimedOut(ctx context.Context, result SupervisionResult, store Store) {
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, result.SupervisionRequestId, store)
+	if err != nil || toolCallId == nil {
+		return
+	}
+
+	project, err := getProjectForToolCall(ctx, *toolCallId, store)
+	if err != nil || project == nil {
+		return
+	}
+
+	notification := Notification{Event: TimedOut, ProjectId: project.Id, Result: &result}
+	if _, err := dispatchNotification(ctx, notification, store); err != nil {
+		log.Printf("Error sending timeout of supervision request %s: %v", result.SupervisionRequestId, err)
+	}
+}
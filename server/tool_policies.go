@@ -0,0 +1,308 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// WildcardToolName is the tool name of a policy that applies to every tool without a specific policy
+const WildcardToolName = "*"
+
+// ResolveToolPolicy picks the policy for a tool: an exact match wins over the wildcard.
+// Returns nil if the project has no applicable policy.
+func ResolveToolPolicy(policies []ToolPolicy, toolName string) *ToolPolicy {
+	var wildcard *ToolPolicy
+	for i := range policies {
+		if policies[i].ToolName == toolName {
+			return &policies[i]
+		}
+		if policies[i].ToolName == WildcardToolName {
+			wildcard = &policies[i]
+		}
+	}
+	return wildcard
+}
+
+// getToolPolicy resolves the policy that applies to a tool from its organization's, project's and
+// agent build's policies. The agent's policies apply on top of the project's the same way the
+// project's apply on top of the organization's. Returns nil if no policy applies.
+func getToolPolicy(ctx context.Context, tool Tool, store Store) (*ToolPolicy, error) {
+	project, err := getProjectForRun(ctx, tool.RunId, store)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, nil
+	}
+
+	inherited, err := getInheritedToolPolicies(ctx, *project, store)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := store.GetProjectToolPolicies(ctx, project.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tool policies: %w", err)
+	}
+
+	policy := ResolveEffectiveToolPolicy(inherited, policies, tool.Name)
+
+	agent, err := getAgentForRun(ctx, tool.RunId, store)
+	if err != nil {
+		return nil, err
+	}
+	if agent == nil || len(agent.ToolPolicies) == 0 {
+		return policy, nil
+	}
+
+	projectPolicies := []ToolPolicy{}
+	if policy != nil {
+		projectPolicies = append(projectPolicies, *policy)
+	}
+
+	return ResolveEffectiveToolPolicy(projectPolicies, agent.ToolPolicies, tool.Name), nil
+}
+
+// getToolRiskTier returns the higher of the risk tier of a tool's policy and the risk_tier attribute
+// it was registered with. Tools can declare themselves riskier than their policy, but not safer.
+// Returns nil if neither gives a tier.
+func getToolRiskTier(ctx context.Context, tool Tool, store Store) (*RiskTier, error) {
+	policy, err := getToolPolicy(ctx, tool, store)
+	if err != nil {
+		return nil, err
+	}
+
+	var riskTier *RiskTier
+	if policy != nil {
+		riskTier = &policy.RiskTier
+	}
+	if declared, ok := tool.Attributes["risk_tier"].(string); ok {
+		if rank, known := riskTierRank[RiskTier(declared)]; known && (riskTier == nil || rank > riskTierRank[*riskTier]) {
+			tier := RiskTier(declared)
+			riskTier = &tier
+		}
+	}
+	return riskTier, nil
+}
+
+// applyToolPolicy creates the supervisor chains the project's policies prescribe for a newly registered tool
+func applyToolPolicy(ctx context.Context, tool Tool, store Store) ([]uuid.UUID, error) {
+	policy, err := getToolPolicy(ctx, tool, store)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return nil, nil
+	}
+
+	chainIds := make([]uuid.UUID, 0, len(policy.Chains))
+	for _, chain := range policy.Chains {
+		chainId, err := store.CreateSupervisorChain(ctx, *tool.Id, chain)
+		if err != nil {
+			return nil, fmt.Errorf("error creating supervisor chain: %w", err)
+		}
+		chainIds = append(chainIds, *chainId)
+	}
+
+	return chainIds, nil
+}
+
+// validateToolPolicies checks that tool names are unique, tiers are known and every chain refers to
+// supervisors that exist. Only organization policies may be locked.
+func validateToolPolicies(ctx context.Context, policies []ToolPolicy, allowLocked bool, store Store) error {
+	seen := make(map[string]bool)
+	for _, policy := range policies {
+		if policy.ToolName == "" {
+			return fmt.Errorf("tool_name is required")
+		}
+		if seen[policy.ToolName] {
+			return fmt.Errorf("duplicate policy for tool %s", policy.ToolName)
+		}
+		seen[policy.ToolName] = true
+
+		if !allowLocked && policy.Locked != nil && *policy.Locked {
+			return fmt.Errorf("only organization policies can be locked")
+		}
+
+		switch policy.RiskTier {
+		case Low, Medium, High, Critical:
+		default:
+			return fmt.Errorf("unknown risk tier: %s", policy.RiskTier)
+		}
+
+		for _, chain := range policy.Chains {
+			if chain.SupervisorIds == nil || len(*chain.SupervisorIds) == 0 {
+				return fmt.Errorf("chains for tool %s must have at least one supervisor", policy.ToolName)
+			}
+			if err := validateMinConfidence(chain); err != nil {
+				return fmt.Errorf("chain for tool %s: %w", policy.ToolName, err)
+			}
+			if err := validateChainTimeouts(chain); err != nil {
+				return fmt.Errorf("chain for tool %s: %w", policy.ToolName, err)
+			}
+			if err := validateChainTiers(chain); err != nil {
+				return fmt.Errorf("chain for tool %s: %w", policy.ToolName, err)
+			}
+
+			inChain := make(map[uuid.UUID]bool)
+			for _, supervisorId := range *chain.SupervisorIds {
+				if inChain[supervisorId] {
+					return fmt.Errorf("supervisor %s appears twice in a chain for tool %s", supervisorId, policy.ToolName)
+				}
+				inChain[supervisorId] = true
+
+				supervisor, err := store.GetSupervisor(ctx, supervisorId)
+				if err != nil {
+					return fmt.Errorf("error getting supervisor: %w", err)
+				}
+				if supervisor == nil {
+					return fmt.Errorf("supervisor %s not found", supervisorId)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateNotificationSettings checks the webhook URL is absolute and every event is known
+func validateNotificationSettings(settings NotificationSettings) error {
+	if settings.WebhookUrl != nil && *settings.WebhookUrl != "" && !isCallbackUrl(*settings.WebhookUrl) {
+		return fmt.Errorf("webhook_url must be an absolute http or https URL")
+	}
+
+	for _, event := range settings.Events {
+		switch event {
+		case ReviewRequested, Escalated, Rejected, TimedOut, AlertFired:
+		default:
+			return fmt.Errorf("unknown notification event: %s", event)
+		}
+	}
+
+	return nil
+}
+
+func apiGetProjectToolPoliciesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	policies, err := store.GetProjectToolPolicies(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting tool policies", err.Error())
+		return
+	}
+
+	respondJSON(w, policies, http.StatusOK)
+}
+
+func apiSetProjectToolPoliciesHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var policies []ToolPolicy
+	if err := json.NewDecoder(r.Body).Decode(&policies); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := validateToolPolicies(ctx, policies, false, store); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid tool policy", err.Error())
+		return
+	}
+
+	if err := store.SetProjectToolPolicies(ctx, projectId, policies); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting tool policies", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiGetProjectNotificationSettingsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	settings, err := store.GetNotificationSettings(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting notification settings", err.Error())
+		return
+	}
+
+	// Projects that never configured notifications don't get any
+	if settings == nil {
+		settings = &NotificationSettings{Events: []NotificationEvent{}}
+	}
+
+	respondJSON(w, settings, http.StatusOK)
+}
+
+func apiSetProjectNotificationSettingsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var settings NotificationSettings
+	if err := json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if settings.Events == nil {
+		settings.Events = []NotificationEvent{}
+	}
+
+	if err := validateNotificationSettings(settings); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid notification settings", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := store.SetNotificationSettings(ctx, projectId, settings); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting notification settings", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
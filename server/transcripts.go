@@ -0,0 +1,174 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxTranscriptSegmentBytes bounds the text of a single segment, which is what was said since the
+// previous one
+const maxTranscriptSegmentBytes = 64 << 10
+
+// checkUtterance runs chat supervisors over the text of an utterance, returning the first match. The
+// whole utterance is checked each time so patterns match across segments.
+func checkUtterance(supervisors []compiledChatSupervisor, segments []TranscriptSegment) (ChatSupervisor, string, bool) {
+	texts := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		texts = append(texts, segment.Text)
+	}
+	text := strings.Join(texts, "")
+
+	for _, supervisor := range supervisors {
+		if match := supervisor.pattern.FindString(text); match != "" {
+			return supervisor.ChatSupervisor, match, true
+		}
+	}
+	return ChatSupervisor{}, "", false
+}
+
+// emitBargeIn tells the telephony layer to stop the agent, through the project's barge_in webhooks
+func emitBargeIn(ctx context.Context, project *Project, bargeIn TranscriptBargeIn, runId uuid.UUID, store Store) {
+	recordAuditEvent(ctx, SystemActor, AuditActionUtteranceBargedIn, runResource, runId, map[string]interface{}{
+		"utterance_id": bargeIn.UtteranceId,
+		"segment_id":   bargeIn.SegmentId,
+		"supervisor":   bargeIn.Supervisor,
+		"match":        bargeIn.Match,
+	}, store)
+
+	if project == nil {
+		return
+	}
+	emitWebhookEvent(ctx, WebhookPayload{Event: BargeIn, ProjectId: project.Id, RunId: &runId, BargeIn: &bargeIn}, store)
+}
+
+func apiCreateTranscriptSegmentHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request TranscriptSegmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if request.UtteranceId == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "utterance_id is required", "")
+		return
+	}
+
+	if request.Speaker != TranscriptSpeakerAgent && request.Speaker != TranscriptSpeakerCaller {
+		sendErrorResponse(w, http.StatusBadRequest, "speaker must be agent or caller", "")
+		return
+	}
+
+	if len(request.Text) > maxTranscriptSegmentBytes {
+		sendErrorResponse(w, http.StatusRequestEntityTooLarge, "segment is too long", "")
+		return
+	}
+
+	if request.OffsetMs != nil && *request.OffsetMs < 0 {
+		sendErrorResponse(w, http.StatusBadRequest, "offset_ms can't be negative", "")
+		return
+	}
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	project, ok := admitChat(ctx, w, runId, store)
+	if !ok {
+		return
+	}
+
+	segments, err := store.GetUtteranceSegments(ctx, runId, request.UtteranceId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting utterance", err.Error())
+		return
+	}
+
+	for _, previous := range segments {
+		if previous.BargeIn != nil {
+			sendErrorResponse(w, http.StatusConflict, "utterance was barged in on", previous.BargeIn.Supervisor)
+			return
+		}
+		if previous.Speaker != request.Speaker {
+			sendErrorResponse(w, http.StatusBadRequest, "an utterance has a single speaker", "")
+			return
+		}
+	}
+
+	segment := TranscriptSegment{
+		Id:          uuid.New(),
+		RunId:       runId,
+		UtteranceId: request.UtteranceId,
+		Speaker:     request.Speaker,
+		Text:        request.Text,
+		OffsetMs:    request.OffsetMs,
+		CreatedAt:   time.Now(),
+	}
+
+	// Only what the agent says is supervised, the caller's side is kept for reviewers
+	if segment.Speaker == TranscriptSpeakerAgent {
+		supervisors, err := getChatSupervisors(ctx, project, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting chat supervisors", err.Error())
+			return
+		}
+
+		if supervisor, match, matched := checkUtterance(supervisors, append(segments, segment)); matched {
+			segment.BargeIn = &TranscriptBargeIn{
+				UtteranceId: segment.UtteranceId,
+				SegmentId:   segment.Id,
+				Supervisor:  supervisor.Name,
+				Match:       match,
+				Reason:      supervisor.Reason,
+				BargedInAt:  segment.CreatedAt,
+			}
+		}
+	}
+
+	if err := store.CreateTranscriptSegment(ctx, segment); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating transcript segment", err.Error())
+		return
+	}
+
+	if segment.BargeIn != nil {
+		emitBargeIn(ctx, project, *segment.BargeIn, runId, store)
+	}
+
+	respondJSON(w, TranscriptSegmentResult{Segment: segment, BargeIn: segment.BargeIn}, http.StatusCreated)
+}
+
+func apiGetRunTranscriptHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	segments, err := store.GetRunTranscript(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting transcript", err.Error())
+		return
+	}
+
+	respondJSON(w, segments, http.StatusOK)
+}
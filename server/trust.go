@@ -0,0 +1,251 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const agentResource = "agent"
+
+// defaultTrustPolicy is the trust policy of projects that never configured one: agents don't earn autonomy
+var defaultTrustPolicy = TrustPolicy{Enabled: false, ApprovalsToRelax: 20, MaxAutonomyLevel: 2}
+
+// validateTrustPolicy checks a trust policy needs at least one approval and grants a known autonomy level
+func validateTrustPolicy(policy TrustPolicy) error {
+	if policy.ApprovalsToRelax < 1 {
+		return fmt.Errorf("approvals_to_relax must be at least 1")
+	}
+	return validateAutonomyLevel(policy.MaxAutonomyLevel)
+}
+
+// getTrustPolicy returns the trust policy of a project, or the default if it has none
+func getTrustPolicy(ctx context.Context, projectId uuid.UUID, store Store) (TrustPolicy, error) {
+	policy, err := store.GetTrustPolicy(ctx, projectId)
+	if err != nil {
+		return defaultTrustPolicy, fmt.Errorf("error getting trust policy: %w", err)
+	}
+	if policy == nil {
+		return defaultTrustPolicy, nil
+	}
+	return *policy, nil
+}
+
+// earnedAutonomy returns the autonomy level an agent earned with a tool by approvals in a row, or nil if it earned none
+func earnedAutonomy(consecutiveApprovals int, policy TrustPolicy) *AutonomyLevel {
+	if !policy.Enabled || policy.ApprovalsToRelax < 1 {
+		return nil
+	}
+
+	level := min(AutonomyLevel(consecutiveApprovals/policy.ApprovalsToRelax), policy.MaxAutonomyLevel)
+	if level <= ManualAutonomy {
+		return nil
+	}
+	return &level
+}
+
+// withTrustPolicy fills in the approval rate and earned autonomy of an agent's trust with a tool
+func withTrustPolicy(trust AgentToolTrust, policy TrustPolicy) AgentToolTrust {
+	if decisions := trust.Approvals + trust.Rejections; decisions > 0 {
+		trust.ApprovalRate = float64(trust.Approvals) / float64(decisions)
+	}
+	trust.AutonomyLevel = earnedAutonomy(trust.ConsecutiveApprovals, policy)
+	return trust
+}
+
+// getEarnedAutonomy returns the autonomy level the agent of a tool's run earned with it, or nil if
+// the run has no agent or the agent earned none
+func getEarnedAutonomy(ctx context.Context, tool Tool, store Store) (*AutonomyLevel, error) {
+	agent, err := getAgentForRun(ctx, tool.RunId, store)
+	if err != nil || agent == nil {
+		return nil, err
+	}
+
+	policy, err := getTrustPolicy(ctx, agent.ProjectId, store)
+	if err != nil || !policy.Enabled {
+		return nil, err
+	}
+
+	trust, err := store.GetAgentToolTrust(ctx, agent.Id, tool.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting agent tool trust: %w", err)
+	}
+	if trust == nil {
+		return nil, nil
+	}
+
+	return earnedAutonomy(trust.ConsecutiveApprovals, policy), nil
+}
+
+// recordTrustDecision counts a decision towards the trust of the agent whose tool call it was for.
+// Only approvals and rejections count, and not those the server made itself. Failing to count a
+// decision doesn't fail it, so errors are only logged.
+func recordTrustDecision(ctx context.Context, requestId uuid.UUID, result SupervisionResult, actor string, store Store) {
+	var approved bool
+	switch result.Decision {
+	case Approve:
+		approved = true
+	case Reject, Terminate:
+		approved = false
+	default:
+		return
+	}
+	if actor == SystemActor {
+		return
+	}
+
+	if err := countTrustDecision(ctx, requestId, approved, store); err != nil {
+		log.Printf("Error counting decision on supervision request %s towards agent trust: %v", requestId, err)
+	}
+}
+
+func countTrustDecision(ctx context.Context, requestId uuid.UUID, approved bool, store Store) error {
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, requestId, store)
+	if err != nil || toolCallId == nil {
+		return err
+	}
+	toolCall, err := store.GetToolCall(ctx, *toolCallId)
+	if err != nil || toolCall == nil {
+		return err
+	}
+	tool, err := store.GetTool(ctx, toolCall.ToolId)
+	if err != nil || tool == nil {
+		return err
+	}
+	agent, err := getAgentForRun(ctx, tool.RunId, store)
+	if err != nil || agent == nil {
+		return err
+	}
+
+	trust, previous, err := store.RecordAgentToolDecision(ctx, agent.Id, tool.Name, approved, time.Now())
+	if err != nil {
+		return err
+	}
+
+	policy, err := getTrustPolicy(ctx, agent.ProjectId, store)
+	if err != nil {
+		return err
+	}
+
+	before := earnedAutonomy(previous, policy)
+	after := earnedAutonomy(trust.ConsecutiveApprovals, policy)
+	if levelOf(before) == levelOf(after) {
+		return nil
+	}
+
+	action := AuditActionTrustRelaxed
+	if levelOf(after) < levelOf(before) {
+		action = AuditActionTrustTightened
+	}
+	recordAuditEvent(ctx, SystemActor, action, agentResource, agent.Id, map[string]interface{}{
+		"tool_name":             tool.Name,
+		"from":                  before,
+		"to":                    after,
+		"consecutive_approvals": trust.ConsecutiveApprovals,
+		"supervision_request":   requestId,
+	}, store)
+
+	return nil
+}
+
+// levelOf orders earned autonomy levels, with no earned autonomy below every level
+func levelOf(level *AutonomyLevel) AutonomyLevel {
+	if level == nil {
+		return ManualAutonomy - 1
+	}
+	return *level
+}
+
+func apiGetAgentTrustHandler(w http.ResponseWriter, r *http.Request, agentId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	agent, err := store.GetAgent(ctx, agentId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting agent", err.Error())
+		return
+	}
+
+	if agent == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Agent not found", "")
+		return
+	}
+
+	policy, err := getTrustPolicy(ctx, agent.ProjectId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting trust policy", err.Error())
+		return
+	}
+
+	trust, err := store.GetAgentTrust(ctx, agentId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting agent trust", err.Error())
+		return
+	}
+
+	for i := range trust {
+		trust[i] = withTrustPolicy(trust[i], policy)
+	}
+
+	respondJSON(w, trust, http.StatusOK)
+}
+
+func apiGetProjectTrustPolicyHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	policy, err := getTrustPolicy(ctx, projectId, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting trust policy", err.Error())
+		return
+	}
+
+	respondJSON(w, policy, http.StatusOK)
+}
+
+func apiSetProjectTrustPolicyHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var policy TrustPolicy
+	if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateTrustPolicy(policy); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid trust policy", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := store.SetTrustPolicy(ctx, projectId, policy); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting trust policy", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
@@ -0,0 +1,188 @@
+package asteroid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// defaultUsageWindow is how far back a project's usage is counted unless asked otherwise
+const defaultUsageWindow = "30d"
+
+// parseChatUsage reads the model and token usage a provider reported in a chat completion response.
+// OpenAI reports prompt and completion tokens, Anthropic input and output tokens and Gemini prompt
+// and candidates token counts with the model version. Responses without usage used no tokens.
+func parseChatUsage(response []byte, format ChatFormat) ChatUsage {
+	var parsed struct {
+		Model        string `json:"model"`
+		ModelVersion string `json:"modelVersion"`
+		Usage        struct {
+			PromptTokens     int `json:"prompt_tokens"`
+			CompletionTokens int `json:"completion_tokens"`
+			InputTokens      int `json:"input_tokens"`
+			OutputTokens     int `json:"output_tokens"`
+		} `json:"usage"`
+		UsageMetadata struct {
+			PromptTokenCount     int `json:"promptTokenCount"`
+			CandidatesTokenCount int `json:"candidatesTokenCount"`
+		} `json:"usageMetadata"`
+	}
+	if err := json.Unmarshal(response, &parsed); err != nil {
+		return ChatUsage{}
+	}
+
+	switch format {
+	case Anthropic:
+		return ChatUsage{Model: parsed.Model, PromptTokens: parsed.Usage.InputTokens, CompletionTokens: parsed.Usage.OutputTokens}
+	case Gemini:
+		return ChatUsage{
+			Model:            parsed.ModelVersion,
+			PromptTokens:     parsed.UsageMetadata.PromptTokenCount,
+			CompletionTokens: parsed.UsageMetadata.CandidatesTokenCount,
+		}
+	default:
+		return ChatUsage{Model: parsed.Model, PromptTokens: parsed.Usage.PromptTokens, CompletionTokens: parsed.Usage.CompletionTokens}
+	}
+}
+
+// parseUsageWindow parses a window of hours like 24h or of days like 7d
+func parseUsageWindow(window string) (time.Duration, error) {
+	var duration time.Duration
+	if days, ok := strings.CutSuffix(window, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number of days %q", days)
+		}
+		duration = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		if duration, err = time.ParseDuration(window); err != nil {
+			return 0, err
+		}
+	}
+
+	if duration <= 0 {
+		return 0, fmt.Errorf("window must be positive")
+	}
+	return duration, nil
+}
+
+// groupChatUsage adds up the usage of chats by model, sorted by model
+func groupChatUsage(chats []ChatUsage) []ModelUsage {
+	byModel := make(map[string]*ModelUsage)
+	for _, chat := range chats {
+		model, ok := byModel[chat.Model]
+		if !ok {
+			model = &ModelUsage{Model: chat.Model}
+			byModel[chat.Model] = model
+		}
+		model.Chats++
+		model.PromptTokens += chat.PromptTokens
+		model.CompletionTokens += chat.CompletionTokens
+	}
+
+	models := make([]ModelUsage, 0, len(byModel))
+	for _, model := range byModel {
+		models = append(models, *model)
+	}
+	slices.SortFunc(models, func(a, b ModelUsage) int { return strings.Compare(a.Model, b.Model) })
+	return models
+}
+
+// priceUsage prices the usage of each model and adds it up. The cost leaves out the models without a
+// price, which are returned.
+func priceUsage(models []ModelUsage, pricing PricingTable) RunUsage {
+	usage := RunUsage{Models: models, UnpricedModels: make([]string, 0)}
+	for i, model := range models {
+		usage.Chats += model.Chats
+		usage.PromptTokens += model.PromptTokens
+		usage.CompletionTokens += model.CompletionTokens
+
+		price, ok := pricing.price(model.Model)
+		if !ok {
+			usage.UnpricedModels = append(usage.UnpricedModels, model.Model)
+			continue
+		}
+		cost := price.cost(model.PromptTokens, model.CompletionTokens)
+		models[i].CostUsd = &cost
+		usage.CostUsd += cost
+	}
+	return usage
+}
+
+func apiGetRunUsageHandler(w http.ResponseWriter, r *http.Request, runId uuid.UUID, pricing PricingTable, store Store) {
+	ctx := r.Context()
+
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting run", err.Error())
+		return
+	}
+
+	if run == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Run not found", "")
+		return
+	}
+
+	chats, err := store.GetRunChatUsage(ctx, runId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting chat usage", err.Error())
+		return
+	}
+
+	usage := priceUsage(groupChatUsage(chats), pricing)
+	usage.RunId = runId
+
+	respondJSON(w, usage, http.StatusOK)
+}
+
+func apiGetProjectUsageHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, params GetProjectUsageParams, pricing PricingTable, store Store) {
+	ctx := r.Context()
+
+	window := defaultUsageWindow
+	if params.Window != nil {
+		window = *params.Window
+	}
+	duration, err := parseUsageWindow(window)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid window", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	since := time.Now().Add(-duration)
+	models, err := store.GetProjectModelUsage(ctx, projectId, since)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting model usage", err.Error())
+		return
+	}
+
+	usage := priceUsage(models, pricing)
+	respondJSON(w, ProjectUsage{
+		ProjectId:        projectId,
+		Window:           window,
+		Since:            since,
+		Chats:            usage.Chats,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		CostUsd:          usage.CostUsd,
+		UnpricedModels:   usage.UnpricedModels,
+		Models:           usage.Models,
+	}, http.StatusOK)
+}
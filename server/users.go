@@ -0,0 +1,131 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// userFromContext returns the user whose API key made a request, nil for keys of no user
+func userFromContext(ctx context.Context) *uuid.UUID {
+	if key := apiKeyFromContext(ctx); key != nil {
+		return key.UserId
+	}
+	return nil
+}
+
+// userActor names a user, for the audit log
+func userActor(userId uuid.UUID) string {
+	return "user:" + userId.String()
+}
+
+func apiCreateUserHandler(w http.ResponseWriter, r *http.Request, store ReviewerStore) {
+	ctx := r.Context()
+
+	var user User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	user.Name = strings.TrimSpace(user.Name)
+	if user.Name == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "name is required", "")
+		return
+	}
+
+	address, err := mail.ParseAddress(user.Email)
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid email", err.Error())
+		return
+	}
+
+	id := uuid.New()
+	user.Id = &id
+	user.Email = strings.ToLower(address.Address)
+	now := time.Now()
+	user.CreatedAt = &now
+
+	created, err := store.CreateUser(ctx, user)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating user", err.Error())
+		return
+	}
+
+	if !created {
+		sendErrorResponse(w, http.StatusConflict, fmt.Sprintf("another user has the email %s", user.Email), "")
+		return
+	}
+
+	respondJSON(w, user, http.StatusCreated)
+}
+
+func apiGetUsersHandler(w http.ResponseWriter, r *http.Request, store ReviewerStore) {
+	users, err := store.GetUsers(r.Context())
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting users", err.Error())
+		return
+	}
+
+	respondJSON(w, users, http.StatusOK)
+}
+
+func apiGetUserQueueHandler(w http.ResponseWriter, r *http.Request, userId uuid.UUID, store Store, hub *Hub) {
+	ctx := r.Context()
+
+	user, err := store.GetUser(ctx, userId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting user", err.Error())
+		return
+	}
+
+	if user == nil {
+		sendErrorResponse(w, http.StatusNotFound, "User not found", "")
+		return
+	}
+
+	claims, err := store.GetUserReviewClaims(ctx, userId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting review claims", err.Error())
+		return
+	}
+
+	// Claims stay after their requests are decided, so only those still waiting are queued
+	now := time.Now()
+	queue := make([]WaitingSupervisionRequest, 0, len(claims))
+	for _, claim := range claims {
+		waiting, status, err := isWaiting(ctx, claim.SupervisionRequestId, store)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision status", err.Error())
+			return
+		}
+		if !waiting {
+			continue
+		}
+
+		request, err := store.GetSupervisionRequest(ctx, claim.SupervisionRequestId)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting supervision request", err.Error())
+			return
+		}
+		if request == nil {
+			continue
+		}
+
+		item, err := getWaitingSupervisionRequest(ctx, *request, *status, now, store, hub)
+		if err != nil {
+			sendErrorResponse(w, http.StatusInternalServerError, "error getting waiting supervision request", err.Error())
+			return
+		}
+		queue = append(queue, *item)
+	}
+
+	sortWaitingSupervisionRequests(queue)
+	respondJSON(w, queue, http.StatusOK)
+}
@@ -0,0 +1,146 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// verdictDecisions is the decision each verdict behavior stands for in chain logic. Verdicts asking
+// the agent to clarify don't decide anything, the reviewer decides once the agent has answered.
+var verdictDecisions = map[VerdictBehavior]Decision{
+	Block:    Reject,
+	Continue: Approve,
+	Clarify:  "",
+}
+
+// validateVerdicts checks that verdicts have unique names that aren't built in decisions and known behaviors
+func validateVerdicts(verdicts []CustomVerdict) error {
+	names := make(map[string]bool)
+	for _, verdict := range verdicts {
+		if verdict.Name == "" {
+			return fmt.Errorf("verdicts need a name")
+		}
+		if names[verdict.Name] {
+			return fmt.Errorf("duplicate verdict %s", verdict.Name)
+		}
+		names[verdict.Name] = true
+
+		switch Decision(verdict.Name) {
+		case Approve, Reject, Terminate, Modify, Escalate:
+			return fmt.Errorf("verdict %s is a built in decision", verdict.Name)
+		}
+
+		if _, ok := verdictDecisions[verdict.Behavior]; !ok {
+			return fmt.Errorf("unknown behavior of verdict %s: %s", verdict.Name, verdict.Behavior)
+		}
+	}
+
+	return nil
+}
+
+// getVerdictsForSupervisionRequest returns the custom verdicts of the project a supervision request belongs to
+func getVerdictsForSupervisionRequest(ctx context.Context, supervisionRequestId uuid.UUID, store Store) ([]CustomVerdict, error) {
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, supervisionRequestId, store)
+	if err != nil || toolCallId == nil {
+		return nil, err
+	}
+
+	project, err := getProjectForToolCall(ctx, *toolCallId, store)
+	if err != nil || project == nil {
+		return nil, err
+	}
+
+	verdicts, err := store.GetProjectVerdicts(ctx, project.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting verdicts: %w", err)
+	}
+
+	return verdicts, nil
+}
+
+// applyVerdict sets the decision and behavior of a result given a custom verdict. A decision that
+// was also given has to agree with the verdict's behavior.
+func applyVerdict(result *SupervisionResult, verdicts []CustomVerdict) error {
+	result.VerdictBehavior = nil
+	if result.Verdict == nil {
+		return nil
+	}
+
+	for _, verdict := range verdicts {
+		if verdict.Name != *result.Verdict {
+			continue
+		}
+
+		decision := verdictDecisions[verdict.Behavior]
+		if result.Decision != "" && result.Decision != decision {
+			return fmt.Errorf("verdict %s has behavior %s, so its decision can't be %s", verdict.Name, verdict.Behavior, result.Decision)
+		}
+
+		behavior := verdict.Behavior
+		result.Decision = decision
+		result.VerdictBehavior = &behavior
+		return nil
+	}
+
+	return fmt.Errorf("unknown verdict: %s", *result.Verdict)
+}
+
+func apiGetProjectVerdictsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	verdicts, err := store.GetProjectVerdicts(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting verdicts", err.Error())
+		return
+	}
+
+	respondJSON(w, verdicts, http.StatusOK)
+}
+
+func apiSetProjectVerdictsHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var verdicts []CustomVerdict
+	if err := json.NewDecoder(r.Body).Decode(&verdicts); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateVerdicts(verdicts); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid verdict", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	if err := store.SetProjectVerdicts(ctx, projectId, verdicts); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error setting verdicts", err.Error())
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
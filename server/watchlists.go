@@ -0,0 +1,363 @@
+package asteroid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// watchInterval is how often the hub looks for notifications to send to connected sessions
+	watchInterval = time.Second
+	// watchBatchSize notifications are read at a time, both by the hub and when they're listed
+	watchBatchSize = 100
+)
+
+// WatchNotificationMessage is sent to the connections of a session when one of its watch
+// subscriptions matches
+type WatchNotificationMessage struct {
+	Type         string            `json:"type"`
+	Notification WatchNotification `json:"notification"`
+}
+
+// validateWatchSubscriptionRequest checks a subscription watches exactly one of a run, an agent and a
+// tool, with the project of a tool, and that its events are known, dropping events listed twice
+func validateWatchSubscriptionRequest(request *WatchSubscriptionRequest) error {
+	if request.ToolName != nil {
+		name := strings.TrimSpace(*request.ToolName)
+		request.ToolName = &name
+	}
+
+	watched := 0
+	for _, set := range []bool{request.RunId != nil, request.AgentId != nil, request.ToolName != nil} {
+		if set {
+			watched++
+		}
+	}
+	if watched != 1 {
+		return fmt.Errorf("exactly one of run_id, agent_id and tool_name must be set")
+	}
+
+	if request.ToolName != nil && (*request.ToolName == "" || request.ProjectId == nil) {
+		return fmt.Errorf("tool_name must be non-empty and needs project_id")
+	}
+	if request.ToolName == nil && request.ProjectId != nil {
+		return fmt.Errorf("project_id is only used with tool_name")
+	}
+
+	if len(request.Events) == 0 {
+		return fmt.Errorf("events must list at least one event")
+	}
+
+	for _, event := range request.Events {
+		switch event {
+		case NewToolCall, ToolCallRejected, RunFinished:
+		default:
+			return fmt.Errorf("unknown watch event: %s", event)
+		}
+	}
+	request.Events = slices.Compact(slices.Sorted(slices.Values(request.Events)))
+
+	return nil
+}
+
+// watchedExists reports whether the run, agent or project a subscription watches exists
+func watchedExists(ctx context.Context, request WatchSubscriptionRequest, store Store) (bool, error) {
+	switch {
+	case request.RunId != nil:
+		run, err := store.GetRun(ctx, *request.RunId)
+		if err != nil {
+			return false, fmt.Errorf("error getting run: %w", err)
+		}
+		return run != nil, nil
+	case request.AgentId != nil:
+		agent, err := store.GetAgent(ctx, *request.AgentId)
+		if err != nil {
+			return false, fmt.Errorf("error getting agent: %w", err)
+		}
+		return agent != nil, nil
+	default:
+		project, err := store.GetProject(ctx, *request.ProjectId)
+		if err != nil {
+			return false, fmt.Errorf("error getting project: %w", err)
+		}
+		return project != nil, nil
+	}
+}
+
+// notifyWatchers stores a notification of an event of a run for each subscription that watches for
+// it. Watchers of a tool are only notified of events with a tool call. Failing to notify doesn't fail
+// what caused the event, so errors are only logged.
+func notifyWatchers(ctx context.Context, event WatchEvent, runId uuid.UUID, toolCall *AsteroidToolCall, decision *Decision, store Store) {
+	run, err := store.GetRun(ctx, runId)
+	if err != nil {
+		log.Printf("Error getting run %s for watchers of %s: %v", runId, event, err)
+		return
+	}
+	if run == nil {
+		return
+	}
+
+	project, err := getProjectForTask(ctx, run.TaskId, store)
+	if err != nil {
+		log.Printf("Error getting project of run %s for watchers of %s: %v", runId, event, err)
+		return
+	}
+
+	var projectId *uuid.UUID
+	if project != nil {
+		projectId = &project.Id
+	}
+	var toolCallId *uuid.UUID
+	var toolName *string
+	if toolCall != nil {
+		toolCallId = &toolCall.Id
+		toolName = toolCall.Name
+	}
+
+	subscriptions, err := store.GetMatchingWatchSubscriptions(ctx, event, runId, run.AgentId, projectId, toolName)
+	if err != nil {
+		log.Printf("Error getting watchers of %s in run %s: %v", event, runId, err)
+		return
+	}
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	now := time.Now()
+	notifications := make([]WatchNotification, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		notifications = append(notifications, WatchNotification{
+			Id:             uuid.New(),
+			SubscriptionId: subscription.Id,
+			Session:        subscription.Session,
+			Event:          event,
+			RunId:          runId,
+			ToolCallId:     toolCallId,
+			ToolName:       toolName,
+			Decision:       decision,
+			CreatedAt:      now,
+		})
+	}
+
+	if err := store.CreateWatchNotifications(ctx, notifications); err != nil {
+		log.Printf("Error storing %s notifications of run %s: %v", event, runId, err)
+	}
+}
+
+// notifyNewToolCalls notifies the watchers of a run of the tool calls an agent made in a chat
+func notifyNewToolCalls(ctx context.Context, runId uuid.UUID, choices []AsteroidChoice, store Store) {
+	for _, choice := range choices {
+		if choice.Message.ToolCalls == nil {
+			continue
+		}
+		for _, toolCall := range *choice.Message.ToolCalls {
+			notifyWatchers(ctx, NewToolCall, runId, &toolCall, nil, store)
+		}
+	}
+}
+
+// notifyRejectionWatchers notifies the watchers of a tool call's run when a supervisor rejects it
+func notifyRejectionWatchers(ctx context.Context, result SupervisionResult, store Store) {
+	if result.Decision != Reject {
+		return
+	}
+
+	requestId := result.SupervisionRequestId
+	toolCallId, err := getToolCallForSupervisionRequest(ctx, requestId, store)
+	var runId *uuid.UUID
+	if err == nil && toolCallId != nil {
+		runId, err = getRunIdForSupervisionRequest(ctx, requestId, store)
+	}
+	var toolCall *AsteroidToolCall
+	if err == nil && runId != nil {
+		toolCall, err = store.GetToolCall(ctx, *toolCallId)
+	}
+	if err != nil {
+		log.Printf("Error getting tool call of supervision request %s for watchers: %v", requestId, err)
+		return
+	}
+	if toolCall == nil {
+		return
+	}
+
+	decision := result.Decision
+	notifyWatchers(ctx, ToolCallRejected, *runId, toolCall, &decision, store)
+}
+
+// WatchNotifier sends the hub's connected sessions the notifications of their watch subscriptions as
+// they're stored, by whichever server stored them. Notifications stored while a session isn't
+// connected, or whose transactions commit after a later notification was sent, are only listed.
+type WatchNotifier struct {
+	hub      *Hub
+	store    WatchStore
+	interval time.Duration
+}
+
+func NewWatchNotifier(hub *Hub, store WatchStore) *WatchNotifier {
+	return &WatchNotifier{hub: hub, store: store, interval: watchInterval}
+}
+
+func (n *WatchNotifier) Start(ctx context.Context) {
+	// Notifications from before the server started were for connections it doesn't have
+	sequence, err := n.store.GetLatestWatchNotificationSequence(ctx)
+	if err != nil {
+		log.Printf("Error getting latest watch notification, sending only later ones: %v", err)
+	}
+
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sequence, err = n.sendAfter(ctx, sequence)
+			if err != nil {
+				log.Printf("Error sending watch notifications: %v", err)
+			}
+		}
+	}
+}
+
+// sendAfter sends the notifications after a sequence, returning the sequence of the last one sent
+func (n *WatchNotifier) sendAfter(ctx context.Context, sequence int64) (int64, error) {
+	for {
+		notifications, err := n.store.GetWatchNotificationsAfter(ctx, sequence, watchBatchSize)
+		if err != nil {
+			return sequence, fmt.Errorf("error getting watch notifications: %w", err)
+		}
+
+		for _, notification := range notifications {
+			n.hub.sendWatchNotification(notification)
+			sequence = notification.Sequence
+		}
+
+		if len(notifications) < watchBatchSize {
+			return sequence, nil
+		}
+	}
+}
+
+// sendWatchNotification sends a notification to every connection of its session, dropping it for
+// connections that aren't reading
+func (h *Hub) sendWatchNotification(notification WatchNotification) {
+	message := WatchNotificationMessage{Type: WatchNotificationEvent, Notification: notification}
+
+	h.ClientsMutex.RLock()
+	defer h.ClientsMutex.RUnlock()
+
+	for client := range h.Sessions[notification.Session] {
+		select {
+		case client.Send <- message:
+		default:
+			log.Printf("Dropped watch notification %d, client in session %s is not reading", notification.Sequence, client.Session)
+		}
+	}
+}
+
+func apiGetWatchSubscriptionsHandler(w http.ResponseWriter, r *http.Request, session string, store Store) {
+	subscriptions, err := store.GetSessionWatchSubscriptions(r.Context(), session)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting watch subscriptions", err.Error())
+		return
+	}
+
+	respondJSON(w, subscriptions, http.StatusOK)
+}
+
+func apiCreateWatchSubscriptionHandler(w http.ResponseWriter, r *http.Request, session string, store Store) {
+	ctx := r.Context()
+
+	var request WatchSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if session == "" {
+		sendErrorResponse(w, http.StatusBadRequest, "session is required", "")
+		return
+	}
+
+	if err := validateWatchSubscriptionRequest(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid watch subscription", err.Error())
+		return
+	}
+
+	exists, err := watchedExists(ctx, request, store)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting watched resource", err.Error())
+		return
+	}
+
+	if !exists {
+		sendErrorResponse(w, http.StatusNotFound, "Watched run, agent or project not found", "")
+		return
+	}
+
+	subscription := WatchSubscription{
+		Id:        uuid.New(),
+		Session:   session,
+		RunId:     request.RunId,
+		AgentId:   request.AgentId,
+		ToolName:  request.ToolName,
+		ProjectId: request.ProjectId,
+		Events:    request.Events,
+		CreatedAt: time.Now(),
+	}
+
+	if err := store.CreateWatchSubscription(ctx, subscription); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating watch subscription", err.Error())
+		return
+	}
+
+	respondJSON(w, subscription, http.StatusCreated)
+}
+
+func apiDeleteWatchSubscriptionHandler(w http.ResponseWriter, r *http.Request, watchSubscriptionId uuid.UUID, store Store) {
+	deleted, err := store.DeleteWatchSubscription(r.Context(), watchSubscriptionId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error deleting watch subscription", err.Error())
+		return
+	}
+
+	if !deleted {
+		sendErrorResponse(w, http.StatusNotFound, "Watch subscription not found", "")
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiGetWatchNotificationsHandler(w http.ResponseWriter, r *http.Request, session string, params GetWatchNotificationsParams, store Store) {
+	var after int64
+	if params.After != nil {
+		after = *params.After
+	}
+
+	limit := watchBatchSize
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > watchBatchSize {
+			sendErrorResponse(w, http.StatusBadRequest, "invalid limit", fmt.Sprintf("limit must be between 1 and %d", watchBatchSize))
+			return
+		}
+		limit = *params.Limit
+	}
+
+	notifications, err := store.GetSessionWatchNotifications(r.Context(), session, after, limit)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting watch notifications", err.Error())
+		return
+	}
+
+	respondJSON(w, notifications, http.StatusOK)
+}
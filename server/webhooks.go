@@ -0,0 +1,465 @@
+package asteroid
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookSignatureHeader carries the signature of a webhook delivery, as t=<unix seconds>,v1=<hex
+// HMAC-SHA256 of "<t>.<body>" with the webhook's secret>. WebhookEventHeader and
+// WebhookDeliveryHeader name the event and the delivery, which stays the same across retries.
+const (
+	WebhookSignatureHeader = "X-Asteroid-Signature"
+	WebhookEventHeader     = "X-Asteroid-Event"
+	WebhookDeliveryHeader  = "X-Asteroid-Delivery"
+)
+
+const (
+	webhookSecretPrefix = "whsec_"
+	// webhookInterval is how often due deliveries are looked for
+	webhookInterval = 5 * time.Second
+	// webhookBatchSize deliveries are claimed at a time, and attempted one after another
+	webhookBatchSize = 20
+	// webhookLease is how long claimed deliveries are kept from other servers, long enough for a
+	// whole batch to time out
+	webhookLease = webhookBatchSize * notificationTimeout * 3 / 2
+	// maxWebhookAttempts is how many times a delivery is attempted before it's abandoned. With the
+	// backoff that's about 15 hours of retrying.
+	maxWebhookAttempts = 12
+	webhookBaseBackoff = 30 * time.Second
+	webhookMaxBackoff  = 6 * time.Hour
+	// webhookDeliveriesLimit is how many of a webhook's latest deliveries are listed
+	webhookDeliveriesLimit = 100
+)
+
+// webhookBackoff is how long to wait before attempting a delivery again after its nth failed attempt
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookBaseBackoff
+	for i := 1; i < attempts && backoff < webhookMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, webhookMaxBackoff)
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating webhook secret: %w", err)
+	}
+	return webhookSecretPrefix + hex.EncodeToString(b), nil
+}
+
+// signWebhookPayload signs a delivery's body at a time, for the WebhookSignatureHeader
+func signWebhookPayload(secret string, body []byte, at time.Time) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", timestamp, hex.EncodeToString(hmacSha256([]byte(secret), timestamp+"."+string(body))))
+}
+
+// validateWebhookRequest checks a webhook's URL is absolute and its events are known, dropping
+// events listed twice
+func validateWebhookRequest(request *WebhookRequest) error {
+	if !isCallbackUrl(request.Url) {
+		return fmt.Errorf("url must be an absolute http or https URL")
+	}
+
+	if len(request.Events) == 0 {
+		return fmt.Errorf("events must list at least one event")
+	}
+
+	for _, event := range request.Events {
+		switch event {
+		case SupervisionRequested, DecisionMade, RunCompleted, ChainFailed, BargeIn, ToolCallResultHeld:
+		default:
+			return fmt.Errorf("unknown webhook event: %s", event)
+		}
+	}
+	request.Events = slices.Compact(slices.Sorted(slices.Values(request.Events)))
+
+	return nil
+}
+
+// isRealtimeWebhookEvent reports whether an event is only of use as it happens. Its deliveries are
+// attempted once, straight away, and abandoned if that fails.
+func isRealtimeWebhookEvent(event WebhookEvent) bool {
+	return event == BargeIn
+}
+
+// emitWebhookEvent queues a delivery of an event to each of its project's webhooks that's sent it.
+// Failing to queue doesn't fail what caused the event, so errors are only logged.
+func emitWebhookEvent(ctx context.Context, payload WebhookPayload, store WebhookStore) {
+	webhooks, err := store.GetEventWebhooks(ctx, payload.ProjectId, payload.Event)
+	if err != nil {
+		log.Printf("Error getting webhooks of project %s for %s: %v", payload.ProjectId, payload.Event, err)
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	now := time.Now()
+	payload.OccurredAt = now
+
+	// Realtime deliveries are kept from the dispatcher while they're attempted here
+	realtime := isRealtimeWebhookEvent(payload.Event)
+	nextAttemptAt := now
+	if realtime {
+		nextAttemptAt = now.Add(webhookLease)
+	}
+
+	deliveries := make([]WebhookDelivery, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		delivery := WebhookDelivery{
+			Id:            uuid.New(),
+			WebhookId:     webhook.Id,
+			Event:         payload.Event,
+			Payload:       payload,
+			Status:        Queued,
+			NextAttemptAt: nextAttemptAt,
+			CreatedAt:     now,
+		}
+		delivery.Payload.Id = delivery.Id
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := store.CreateWebhookDeliveries(ctx, deliveries); err != nil {
+		log.Printf("Error queueing %s deliveries of project %s: %v", payload.Event, payload.ProjectId, err)
+		return
+	}
+
+	if realtime {
+		go func() {
+			dispatcher := NewWebhookDispatcher(store)
+			for _, delivery := range deliveries {
+				if err := dispatcher.attempt(context.Background(), delivery); err != nil {
+					log.Printf("Error attempting webhook delivery %s: %v", delivery.Id, err)
+				}
+			}
+		}()
+	}
+}
+
+// emitDecisionWebhooks emits decision_made for a decision, and chain_failed when the decision stops
+// the chain without approving the tool call
+func emitDecisionWebhooks(ctx context.Context, result SupervisionResult, store Store) {
+	requestId := result.SupervisionRequestId
+	supervisionRequest, err := store.GetSupervisionRequest(ctx, requestId)
+	if err == nil && supervisionRequest == nil {
+		return
+	}
+
+	var runId, toolCallId *uuid.UUID
+	var project *Project
+	if err == nil {
+		toolCallId, err = getToolCallForSupervisionRequest(ctx, requestId, store)
+	}
+	if err == nil {
+		runId, err = getRunIdForSupervisionRequest(ctx, requestId, store)
+	}
+	if err == nil && runId != nil {
+		project, err = getProjectForRun(ctx, *runId, store)
+	}
+	if err != nil {
+		log.Printf("Error getting project of supervision request %s for webhooks: %v", requestId, err)
+		return
+	}
+	if project == nil {
+		return
+	}
+
+	payload := WebhookPayload{
+		Event:              DecisionMade,
+		ProjectId:          project.Id,
+		RunId:              runId,
+		ToolCallId:         toolCallId,
+		SupervisionRequest: supervisionRequest,
+		Result:             &result,
+	}
+	emitWebhookEvent(ctx, payload, store)
+
+	if result.Decision == Reject || result.Decision == Terminate {
+		payload.Event = ChainFailed
+		emitWebhookEvent(ctx, payload, store)
+	}
+}
+
+// WebhookDispatcher attempts queued webhook deliveries once they're due, retrying failed ones with
+// exponential backoff. Deliveries are stored before they're attempted, so events queued when the
+// server stopped are delivered once it's back.
+type WebhookDispatcher struct {
+	store    WebhookStore
+	client   *http.Client
+	interval time.Duration
+}
+
+func NewWebhookDispatcher(store WebhookStore) *WebhookDispatcher {
+	return &WebhookDispatcher{store: store, client: notificationClient, interval: webhookInterval}
+}
+
+func (d *WebhookDispatcher) Start(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := d.attemptDueDeliveries(ctx); err != nil {
+				log.Printf("Error attempting webhook deliveries: %v", err)
+			}
+		}
+	}
+}
+
+func (d *WebhookDispatcher) attemptDueDeliveries(ctx context.Context) error {
+	deliveries, err := d.store.ClaimDueWebhookDeliveries(ctx, time.Now(), webhookLease, webhookBatchSize)
+	if err != nil {
+		return fmt.Errorf("error claiming due deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		if err := d.attempt(ctx, delivery); err != nil {
+			log.Printf("Error attempting webhook delivery %s: %v", delivery.Id, err)
+		}
+	}
+
+	return nil
+}
+
+// attempt POSTs a delivery to its webhook and stores the outcome. Errors are those of storing it, a
+// webhook that fails is retried later.
+func (d *WebhookDispatcher) attempt(ctx context.Context, delivery WebhookDelivery) error {
+	webhook, err := d.store.GetWebhook(ctx, delivery.WebhookId)
+	if err != nil {
+		return fmt.Errorf("error getting webhook: %w", err)
+	}
+	if webhook == nil {
+		return nil // Deleted along with its deliveries
+	}
+
+	// A realtime delivery the dispatcher picked up wasn't attempted when it happened, the server
+	// stopped first, and is too late to be of use now
+	realtime := isRealtimeWebhookEvent(delivery.Event)
+	if realtime && delivery.Attempts == 0 && time.Since(delivery.CreatedAt) >= webhookLease {
+		message := "too late to deliver"
+		delivery.Status, delivery.LastError = Abandoned, &message
+		return d.store.UpdateWebhookDelivery(ctx, delivery)
+	}
+
+	secret, err := d.store.GetWebhookSecret(ctx, webhook.Id)
+	if err != nil {
+		return err
+	}
+
+	statusCode, sendErr := d.send(ctx, *webhook, secret, delivery)
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	delivery.LastError = nil
+	switch {
+	case sendErr == nil:
+		delivery.Status = Delivered
+		delivery.DeliveredAt = &now
+	case delivery.Attempts >= maxWebhookAttempts || realtime:
+		message := sendErr.Error()
+		delivery.LastError = &message
+		delivery.Status = Abandoned
+		log.Printf("Abandoning webhook delivery %s to %s after %d attempts: %v", delivery.Id, webhook.Url, delivery.Attempts, sendErr)
+	default:
+		message := sendErr.Error()
+		delivery.LastError = &message
+		delivery.NextAttemptAt = now.Add(webhookBackoff(delivery.Attempts))
+	}
+
+	return d.store.UpdateWebhookDelivery(ctx, delivery)
+}
+
+// send POSTs a delivery's payload, rendered with the project's template and signed, returning the
+// webhook's status code if it responded
+func (d *WebhookDispatcher) send(ctx context.Context, webhook Webhook, secret string, delivery WebhookDelivery) (*int, error) {
+	body, contentType, err := renderPayload(ctx, webhook.ProjectId, Webhooks, delivery.Payload, d.store)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	request.Header.Set("Content-Type", contentType)
+	request.Header.Set(WebhookEventHeader, string(delivery.Event))
+	request.Header.Set(WebhookDeliveryHeader, delivery.Id.String())
+	request.Header.Set(WebhookSignatureHeader, signWebhookPayload(secret, body, time.Now()))
+
+	resp, err := d.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("error calling webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return &resp.StatusCode, nil
+}
+
+func apiGetProjectWebhooksHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	webhooks, err := store.GetProjectWebhooks(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting webhooks", err.Error())
+		return
+	}
+
+	respondJSON(w, webhooks, http.StatusOK)
+}
+
+func apiCreateProjectWebhookHandler(w http.ResponseWriter, r *http.Request, projectId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request WebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateWebhookRequest(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid webhook", err.Error())
+		return
+	}
+
+	project, err := store.GetProject(ctx, projectId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting project", err.Error())
+		return
+	}
+
+	if project == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Project not found", "")
+		return
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error generating webhook secret", err.Error())
+		return
+	}
+
+	webhook := Webhook{
+		Id:        uuid.New(),
+		ProjectId: projectId,
+		Url:       request.Url,
+		Events:    request.Events,
+		Enabled:   request.Enabled == nil || *request.Enabled,
+		CreatedAt: time.Now(),
+	}
+	if err := store.CreateWebhook(ctx, webhook, secret); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error creating webhook", err.Error())
+		return
+	}
+
+	respondJSON(w, CreatedWebhook{Webhook: webhook, Secret: secret}, http.StatusCreated)
+}
+
+func apiUpdateWebhookHandler(w http.ResponseWriter, r *http.Request, webhookId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	var request WebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", err.Error())
+		return
+	}
+
+	if err := validateWebhookRequest(&request); err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid webhook", err.Error())
+		return
+	}
+
+	webhook, err := store.GetWebhook(ctx, webhookId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting webhook", err.Error())
+		return
+	}
+
+	if webhook == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Webhook not found", "")
+		return
+	}
+
+	webhook.Url = request.Url
+	webhook.Events = request.Events
+	if request.Enabled != nil {
+		webhook.Enabled = *request.Enabled
+	}
+	if err := store.UpdateWebhook(ctx, *webhook); err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error updating webhook", err.Error())
+		return
+	}
+
+	respondJSON(w, webhook, http.StatusOK)
+}
+
+func apiDeleteWebhookHandler(w http.ResponseWriter, r *http.Request, webhookId uuid.UUID, store Store) {
+	deleted, err := store.DeleteWebhook(r.Context(), webhookId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error deleting webhook", err.Error())
+		return
+	}
+
+	if !deleted {
+		sendErrorResponse(w, http.StatusNotFound, "Webhook not found", "")
+		return
+	}
+
+	respondJSON(w, nil, http.StatusNoContent)
+}
+
+func apiGetWebhookDeliveriesHandler(w http.ResponseWriter, r *http.Request, webhookId uuid.UUID, store Store) {
+	ctx := r.Context()
+
+	webhook, err := store.GetWebhook(ctx, webhookId)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting webhook", err.Error())
+		return
+	}
+
+	if webhook == nil {
+		sendErrorResponse(w, http.StatusNotFound, "Webhook not found", "")
+		return
+	}
+
+	deliveries, err := store.GetWebhookDeliveries(ctx, webhookId, webhookDeliveriesLimit)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting webhook deliveries", err.Error())
+		return
+	}
+
+	respondJSON(w, deliveries, http.StatusOK)
+}
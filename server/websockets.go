@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -16,6 +17,59 @@ import (
 
 const MAX_SUPERVISORS_PER_CLIENT = 8
 
+// SessionQueryParam is the WebSocket query parameter that ties connections to a reviewer session.
+// Connections sharing a session (e.g. several tabs of one reviewer) are shown the same reviews.
+const SessionQueryParam = "session"
+
+// SkillsQueryParam is the WebSocket query parameter listing a reviewer's skills, comma separated.
+// Supervisors assigning reviews by skill_match prefer sessions with a skill among the tool's categories.
+const SkillsQueryParam = "skills"
+
+// AlreadyResolvedEvent is the type of the message sent when a review has already been decided
+const AlreadyResolvedEvent = "already_resolved"
+
+// ReassignedEvent is the type of the message sent when a review was handed to another session
+const ReassignedEvent = "reassigned"
+
+// ClarificationRequestedEvent is the type of the message sent when a review waits for the agent to
+// answer its reviewer's question. Once answered, the review is sent to the reviewer's session again.
+const ClarificationRequestedEvent = "clarification_requested"
+
+// HeldEvent is the type of the message sent when a decision can't be made yet because the
+// organization's kill switch is active. The review stays assigned.
+const HeldEvent = "held"
+
+// RunPausedEvent is the type of the message sent when a decision can't be made because the review's
+// run is paused. The review stays assigned until the run is resumed.
+const RunPausedEvent = "run_paused"
+
+// ClaimedEvent is the type of the message sent when a decision is refused because another user
+// claimed the review. The review stays with the session until it's decided.
+const ClaimedEvent = "claimed"
+
+// ReminderEvent is the type of the message sent when a reminder about a review still assigned to
+// the session fires
+const ReminderEvent = "reminder"
+
+// QueueReminderEvent is the type of the message sent to every session when a reminder about a
+// review that's waiting too long fires
+const QueueReminderEvent = "queue_reminder"
+
+// BatchResolvedEvent is the type of the message sent to every session when several reviews were
+// decided together through the API. It lists the reviews in request_ids rather than request_id.
+const BatchResolvedEvent = "batch_resolved"
+
+// EscalatedEvent is the type of the message sent along with a review another supervisor of its chain
+// escalated to the session's supervisor. The escalation is in escalation.
+const EscalatedEvent = "escalated"
+
+// WatchNotificationEvent is the type of the message sent when one of the session's watch
+// subscriptions matches. The notification is in notification.
+const WatchNotificationEvent = "watch_notification"
+
+// clientSendBuffer is how many messages can be queued for a connection before sends block
+const clientSendBuffer = 2 * MAX_SUPERVISORS_PER_CLIENT
+
 // Upgrade HTTP connection to WebSocket with proper settings
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
@@ -23,59 +77,149 @@ var upgrader = websocket.Upgrader{
 	CheckOrigin:     func(r *http.Request) bool { return true }, // Adjust as needed for security
 }
 
+// ReviewEvent tells a connection that a review it was shown is no longer its to decide: it was
+// decided by another connection or before its own decision arrived, or it was reassigned
+type ReviewEvent struct {
+	Type      string    `json:"type"`
+	RequestId uuid.UUID `json:"request_id"`
+	// RequestIds are the reviews of a batch_resolved event
+	RequestIds []uuid.UUID `json:"request_ids,omitempty"`
+	Decision   Decision    `json:"decision,omitempty"`
+	// Escalation is what sent the review to the session, for escalated events
+	Escalation *Escalation `json:"escalation,omitempty"`
+	// Message explains the event to the reviewer in their locale
+	Message string `json:"message,omitempty"`
+}
+
 // Hub maintains active connections and broadcasts messages
 type Hub struct {
 	// Clients is a map of clients to their connection status
-	Clients      map[*Client]bool
+	Clients map[*Client]bool
+	// Sessions groups clients by session key. Guarded by ClientsMutex
+	Sessions     map[string]map[*Client]bool
 	ClientsMutex sync.RWMutex
 	// ReviewChan is a channel that receives new reviews, then assigns them to a connected client
 	ReviewChan chan SupervisionRequest
+	// RealtimeReviewChan receives the reviews of realtime runs as they're created. They're assigned
+	// apart from the hub's other work, so they don't wait behind it.
+	RealtimeReviewChan chan SupervisionRequest
 	// Register and Unregister are used when a new client connects and disconnects
 	Register   chan *Client
 	Unregister chan *Client
-	// AssignedReviews is a map of clients to the reviews they are currently processing
-	AssignedReviews      map[*Client]map[string]bool
+	// AssignedReviews is a map of session keys to the reviews they are currently processing
+	AssignedReviews      map[string]map[string]SupervisionRequest
 	AssignedReviewsMutex sync.RWMutex
+	// LastAssignedSessions is the session each supervisor's last review went to, for round robin
+	// assignment. Guarded by AssignedReviewsMutex
+	LastAssignedSessions map[uuid.UUID]string
 
 	// CompletedReviewCount is used to count the number of reviews that have been completed
 	CompletedReviewCount int
 	Store                Store
+
+	// backplane shares what the hub does with the hubs of other replicas, nil if there's none.
+	// instance is the instance ID of this replica.
+	backplane Backplane
+	instance  string
+
+	// decisionWatchers are the watchers of each tool call's decision. Guarded by decisionWatchersMutex
+	decisionWatchers      map[uuid.UUID]map[*decisionWatcher]bool
+	decisionWatchersMutex sync.Mutex
 }
 
+// NewHub returns a hub whose Store tells it of every supervision result stored with it, which is the
+// store everything else has to use for the hub to hear of their decisions
 func NewHub(store Store, humanReviewChan chan SupervisionRequest) *Hub {
-	return &Hub{
-		Clients:    make(map[*Client]bool),
-		ReviewChan: humanReviewChan,
-		Register:   make(chan *Client),
-		Unregister: make(chan *Client),
+	hub := &Hub{
+		Clients:            make(map[*Client]bool),
+		Sessions:           make(map[string]map[*Client]bool),
+		ReviewChan:         humanReviewChan,
+		RealtimeReviewChan: make(chan SupervisionRequest, realtimeReviewBuffer),
+		Register:           make(chan *Client),
+		Unregister:         make(chan *Client),
+
+		AssignedReviews:      make(map[string]map[string]SupervisionRequest),
+		LastAssignedSessions: make(map[uuid.UUID]string),
+
+		decisionWatchers: make(map[uuid.UUID]map[*decisionWatcher]bool),
+	}
+	hub.Store = decisionStore{Store: store, hub: hub}
+	return hub
+}
+
+// newHubClient authenticates a connection to the hub and reads its session, subscription and
+// locale, sending an error response if it can't connect. Connections without a session key get a
+// session of their own.
+func newHubClient(hub *Hub, w http.ResponseWriter, r *http.Request) (*Client, bool) {
+	key, ok := authenticateWs(w, r, hub.Store)
+	if !ok {
+		return nil, false
+	}
+
+	session := r.URL.Query().Get(SessionQueryParam)
+	if session == "" {
+		session = uuid.New().String()
+	}
+	// Connections of a project's key can't join the sessions of other keys, which are sent reviews
+	// of every project
+	var project, user *uuid.UUID
+	if key != nil {
+		user = key.UserId
+	}
+	if key != nil && key.ProjectId != nil {
+		project = key.ProjectId
+		session = project.String() + ":" + session
+	}
 
-		AssignedReviews: make(map[*Client]map[string]bool),
+	subscription, err := parseSubscription(r.URL.Query())
+	if err != nil {
+		sendErrorResponse(w, http.StatusBadRequest, "invalid subscription", err.Error())
+		return nil, false
+	}
 
-		Store: store,
+	requested := append([]string{r.URL.Query().Get(LocaleQueryParam)}, acceptedLocales(r.Header.Get("Accept-Language"))...)
+	locale, err := reviewerLocale(r.Context(), session, requested, hub.Store)
+	if err != nil {
+		log.Printf("Error getting locale of session %s, using %s: %v", session, DefaultLocale, err)
+		locale = DefaultLocale
 	}
+
+	return &Client{
+		Hub:          hub,
+		Session:      session,
+		Skills:       parseSkills(r.URL.Query().Get(SkillsQueryParam)),
+		Subscription: subscription,
+		Project:      project,
+		User:         user,
+		Locale:       locale,
+		Send:         make(chan interface{}, clientSendBuffer),
+	}, true
 }
 
 // serveWs upgrades the HTTP connection to a WebSocket connection and registers the client with the hub
 func serveWs(hub *Hub, w http.ResponseWriter, r *http.Request) {
+	client, ok := newHubClient(hub, w, r)
+	if !ok {
+		return
+	}
+
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Println("upgrade error:", err)
 		return
 	}
+	client.Conn = conn
 
-	client := &Client{
-		Hub:  hub,
-		Conn: conn,
-		Send: make(chan SupervisionRequest),
-	}
-	hub.Register <- client
-
+	// The write pump has to be running before registering, as joining a session replays its reviews
 	go client.WritePump()
+	hub.Register <- client
 	go client.ReadPump()
 }
 
 // Run starts the hub and handles client connections/disconnections and supervisor assignments
 func (h *Hub) Run() {
+	go h.deliverRealtimeReviews()
+
 	for {
 		select {
 		case client := <-h.Register:
@@ -83,122 +227,436 @@ func (h *Hub) Run() {
 		case client := <-h.Unregister:
 			h.unregisterClient(client)
 		case supervisionRequest := <-h.ReviewChan:
-			h.assignReview(supervisionRequest)
+			if !h.assignReview(supervisionRequest) {
+				h.publish(hubMessage{Kind: offerMessage, SupervisionRequest: &supervisionRequest})
+			}
 		}
 	}
 }
 
-// registerClient adds a new client to the hub and initializes their assigned reviews map
+// registerClient adds a new client to the hub and its session. A client joining an existing
+// session is sent the reviews the session is already working on.
 func (h *Hub) registerClient(client *Client) {
 	h.ClientsMutex.Lock()
 	h.AssignedReviewsMutex.Lock()
 	h.Clients[client] = true
-	h.AssignedReviews[client] = make(map[string]bool)
+	if _, exists := h.Sessions[client.Session]; !exists {
+		h.Sessions[client.Session] = make(map[*Client]bool)
+	}
+	h.Sessions[client.Session][client] = true
+
+	if _, exists := h.AssignedReviews[client.Session]; !exists {
+		h.AssignedReviews[client.Session] = make(map[string]SupervisionRequest)
+	}
+	for _, supervisionRequest := range h.AssignedReviews[client.Session] {
+		client.Send <- supervisionRequest
+	}
 	h.AssignedReviewsMutex.Unlock()
 	h.ClientsMutex.Unlock()
 
-	log.Println("Client registered.")
+	log.Printf("Client registered to session %s.", client.Session)
 }
 
-// unregisterClient removes a client from the hub and handles the cleanup of their assigned reviews
+// unregisterClient removes a client from the hub. Once the last client of a session is gone the
+// session's reviews are reassigned.
 func (h *Hub) unregisterClient(client *Client) {
 	h.ClientsMutex.Lock()
 	if _, exists := h.Clients[client]; exists {
 		delete(h.Clients, client)
+		delete(h.Sessions[client.Session], client)
+		sessionEmpty := len(h.Sessions[client.Session]) == 0
+		if sessionEmpty {
+			delete(h.Sessions, client.Session)
+		}
 		h.ClientsMutex.Unlock()
 
-		// Remove the client from the AssignedReviews map and requeue their reviews
-		h.AssignedReviewsMutex.Lock()
-		h.requeueAssignedReviews(client)
-		delete(h.AssignedReviews, client)
-		h.AssignedReviewsMutex.Unlock()
+		if sessionEmpty {
+			h.requeueAssignedReviews(client.Session)
+		}
 
 		close(client.Send)
-		log.Println("Client unregistered.")
+		log.Printf("Client unregistered from session %s.", client.Session)
 	} else {
 		h.ClientsMutex.Unlock()
 	}
 }
 
-// assignReview assigns a supervisor to a client if they have capacity, otherwise it queues the supervisor
-func (h *Hub) assignReview(supervisionRequest SupervisionRequest) {
+// assignReview assigns a review to a session picked by its supervisor's assignment strategy, and
+// reports whether one had capacity. Otherwise the review stays pending.
+func (h *Hub) assignReview(supervisionRequest SupervisionRequest) bool {
 	if supervisionRequest.Id == nil {
 		log.Fatalf("can't assign supervisor with nil ID")
 	}
 
+	// Read before taking the locks, it needs the store
+	ctx := reviewContext(context.Background(), supervisionRequest)
+	assignment, err := getReviewAssignment(ctx, supervisionRequest, h.Store)
+	if err != nil {
+		log.Printf("Error getting assignment of request %s, leaving it pending: %v", *supervisionRequest.Id, err)
+		return false
+	}
+
 	h.ClientsMutex.RLock()
 	defer h.ClientsMutex.RUnlock()
 
-	// Attempt to assign the supervisor to a client. Does nothing if no client is available
-	h.assignReviewToClient(supervisionRequest)
+	// Replicas offered a review claim it before assigning it, so only one does. Those without a
+	// session for it leave it to the others.
+	if h.backplane != nil {
+		h.AssignedReviewsMutex.RLock()
+		_, ok := h.chooseSession(supervisionRequest, assignment)
+		h.AssignedReviewsMutex.RUnlock()
+		if !ok || !h.claimReview(*supervisionRequest.Id) {
+			return false
+		}
+	}
+
+	return h.assignReviewToClient(ctx, supervisionRequest, assignment)
 }
 
-// assignReviewToClient attempts to assign a supervisor to a client if they have capacity
-func (h *Hub) assignReviewToClient(supervisionRequest SupervisionRequest) bool {
+// assignReviewToClient attempts to assign a review to a session with capacity, sending it to every
+// client in the session
+func (h *Hub) assignReviewToClient(ctx context.Context, supervisionRequest SupervisionRequest, assignment reviewAssignment) bool {
 	h.AssignedReviewsMutex.Lock()
 	defer h.AssignedReviewsMutex.Unlock()
 
-	// Iterate over all clients and assign the supervisor if they have capacity
-	for client := range h.Clients {
-		assignedReviewsCount := len(h.AssignedReviews[client])
+	// Realtime reviews can reach the hub from their creation and from the processor both
+	for _, reviews := range h.AssignedReviews {
+		if _, ok := reviews[supervisionRequest.Id.String()]; ok {
+			return true
+		}
+	}
+
+	session, ok := h.chooseSession(supervisionRequest, assignment)
+	if !ok {
+		return false // No session available
+	}
 
-		if assignedReviewsCount < MAX_SUPERVISORS_PER_CLIENT {
-			client.Send <- supervisionRequest
+	for client := range h.Sessions[session] {
+		client.Send <- supervisionRequest
+		if assignment.escalation != nil {
+			client.sendEvent(ReviewEvent{Type: EscalatedEvent, RequestId: *supervisionRequest.Id, Escalation: assignment.escalation})
+		}
+	}
 
-			h.AssignedReviews[client][supervisionRequest.Id.String()] = true
-			log.Printf("Assigned supervisor.RequestId %s to client.", supervisionRequest.Id)
+	h.AssignedReviews[session][supervisionRequest.Id.String()] = supervisionRequest
+	h.LastAssignedSessions[supervisionRequest.SupervisorId] = session
+	log.Printf("Assigned supervisor.RequestId %s to session %s by %s.", supervisionRequest.Id, session, assignment.strategy)
+	recordAuditEvent(ctx, SystemActor, AuditActionReviewAssigned, supervisionRequestResource,
+		*supervisionRequest.Id, map[string]interface{}{"session": session, "strategy": assignment.strategy}, h.Store)
 
-			status := SupervisionStatus{
-				Status:               Assigned,
-				CreatedAt:            time.Now(),
-				SupervisionRequestId: supervisionRequest.Id,
-			}
-			// Update the supervisor status to assigned
-			err := h.Store.CreateSupervisionStatus(context.Background(), *supervisionRequest.Id, status)
-			if err != nil {
-				fmt.Printf("Error creating supervisor status: %v\n", err)
-			}
+	status := SupervisionStatus{
+		Status:               Assigned,
+		CreatedAt:            time.Now(),
+		SupervisionRequestId: supervisionRequest.Id,
+	}
+	// Update the supervisor status to assigned
+	err := h.Store.CreateSupervisionStatus(ctx, *supervisionRequest.Id, status)
+	if err != nil {
+		fmt.Printf("Error creating supervisor status: %v\n", err)
+	}
+
+	return true // Supervisor assigned
+}
+
+// requeueAssignedReviews removes all reviews from a session that went offline and reassigns them.
+// Reviews no other session has capacity for are pending again.
+func (h *Hub) requeueAssignedReviews(session string) {
+	h.AssignedReviewsMutex.Lock()
+	assignedReviews := h.AssignedReviews[session]
+	// Remove the session from the AssignedReviews map
+	delete(h.AssignedReviews, session)
+	h.AssignedReviewsMutex.Unlock()
+
+	for _, supervisionRequest := range assignedReviews {
+		if h.assignReview(supervisionRequest) {
+			continue
+		}
+
+		status := SupervisionStatus{
+			Status:               Pending,
+			CreatedAt:            time.Now(),
+			SupervisionRequestId: supervisionRequest.Id,
+		}
 
-			return true // Supervisor assigned
+		err := h.Store.CreateSupervisionStatus(context.Background(), *supervisionRequest.Id, status)
+		if err != nil {
+			fmt.Printf("Error getting supervisor from store: %v\n", err)
+			continue
 		}
 	}
+}
+
+// removeAssignedReview stops tracking a review as assigned to a session
+func (h *Hub) removeAssignedReview(session string, requestId uuid.UUID) {
+	h.AssignedReviewsMutex.Lock()
+	if _, exists := h.AssignedReviews[session]; exists {
+		delete(h.AssignedReviews[session], requestId.String())
+	}
+	h.AssignedReviewsMutex.Unlock()
+}
 
-	return false // No client available
+// notifyResolved sends a resolved event to the other clients in a session, so tabs that didn't
+// make the decision can drop the review
+func (h *Hub) notifyResolved(from *Client, result SupervisionResult) {
+	event := ReviewEvent{Type: AlreadyResolvedEvent, RequestId: result.SupervisionRequestId, Decision: result.Decision}
+
+	h.notifySessionLocally(from.Session, event, from)
+	h.publish(hubMessage{Kind: resolvedMessage, Event: &event, Session: from.Session})
 }
 
-// requeueAssignedReviews removes all reviews from a client and requeues them
-func (h *Hub) requeueAssignedReviews(client *Client) {
-	if assignedReviews, ok := h.AssignedReviews[client]; ok {
-		for reviewID := range assignedReviews {
-			reviewID, err := uuid.Parse(reviewID)
-			if err != nil {
-				fmt.Printf("Error parsing supervisor ID: %v\n", err)
-				continue
-			}
+// notifySessionLocally sends an event to the clients of a session connected to this replica, other
+// than one that's excluded
+func (h *Hub) notifySessionLocally(session string, event ReviewEvent, except *Client) {
+	h.ClientsMutex.RLock()
+	defer h.ClientsMutex.RUnlock()
 
-			status := SupervisionStatus{
-				Status:               Pending,
-				CreatedAt:            time.Now(),
-				SupervisionRequestId: &reviewID,
+	for client := range h.Sessions[session] {
+		if client != except {
+			client.sendEvent(event)
+		}
+	}
+}
+
+// resolveAssignedReview tells every session a review is assigned to that it was resolved
+// elsewhere, e.g. by an automated supervisor, and stops tracking the assignment
+func (h *Hub) resolveAssignedReview(result SupervisionResult) {
+	h.releaseAssignedReview(ReviewEvent{Type: AlreadyResolvedEvent, RequestId: result.SupervisionRequestId, Decision: result.Decision})
+}
+
+// resolveBatch stops tracking the assignments of reviews decided together, and tells every session
+// which of the reviews it subscribes to were decided so each can clear them at once
+func (h *Hub) resolveBatch(requestIds []uuid.UUID, decision Decision) {
+	h.resolveBatchLocally(requestIds, decision)
+	h.publish(hubMessage{Kind: batchMessage, Event: &ReviewEvent{Type: BatchResolvedEvent, RequestIds: requestIds, Decision: decision}})
+}
+
+func (h *Hub) resolveBatchLocally(requestIds []uuid.UUID, decision Decision) {
+	// Read before taking the locks, it needs the store
+	scopes := getReviewScopes(context.Background(), requestIds, h.Store)
+
+	h.ClientsMutex.RLock()
+	defer h.ClientsMutex.RUnlock()
+	h.AssignedReviewsMutex.Lock()
+	for _, reviews := range h.AssignedReviews {
+		for _, requestId := range requestIds {
+			delete(reviews, requestId.String())
+		}
+	}
+	h.AssignedReviewsMutex.Unlock()
+
+	for client := range h.Clients {
+		followed := make([]uuid.UUID, 0, len(requestIds))
+		for _, requestId := range requestIds {
+			if client.followsRequest(scopes, requestId) {
+				followed = append(followed, requestId)
 			}
+		}
+		if len(followed) > 0 {
+			client.sendEvent(ReviewEvent{Type: BatchResolvedEvent, RequestIds: followed, Decision: decision})
+		}
+	}
+	log.Printf("Sent %s event for %d requests to the sessions subscribed to them", BatchResolvedEvent, len(requestIds))
+}
 
-			err = h.Store.CreateSupervisionStatus(context.Background(), reviewID, status)
-			if err != nil {
-				fmt.Printf("Error getting supervisor from store: %v\n", err)
-				continue
+// holdForClarification takes a review from the sessions it's assigned to while the agent answers
+// its reviewer's question
+func (h *Hub) holdForClarification(requestId uuid.UUID) {
+	h.releaseAssignedReview(ReviewEvent{Type: ClarificationRequestedEvent, RequestId: requestId})
+}
+
+// releaseAssignedReview stops tracking the sessions a review is assigned to and sends them an event
+func (h *Hub) releaseAssignedReview(event ReviewEvent) {
+	h.releaseAssignedReviewLocally(event)
+	h.publish(hubMessage{Kind: releaseMessage, Event: &event})
+}
+
+func (h *Hub) releaseAssignedReviewLocally(event ReviewEvent) {
+	h.ClientsMutex.RLock()
+	defer h.ClientsMutex.RUnlock()
+	h.AssignedReviewsMutex.Lock()
+	defer h.AssignedReviewsMutex.Unlock()
+
+	for session, reviews := range h.AssignedReviews {
+		if _, assigned := reviews[event.RequestId.String()]; !assigned {
+			continue
+		}
+
+		delete(reviews, event.RequestId.String())
+		for client := range h.Sessions[session] {
+			client.sendEvent(event)
+		}
+		log.Printf("Sent %s event for request %s to session %s", event.Type, event.RequestId, session)
+	}
+}
+
+// remindAssignedReview sends a reminder event to the clients of the session a review is assigned
+// to, and returns the session, or nil if the review isn't assigned on this replica. The other
+// replicas are asked to remind it then.
+func (h *Hub) remindAssignedReview(requestId uuid.UUID) *string {
+	session := h.remindAssignedReviewLocally(requestId)
+	if session == nil {
+		h.publish(hubMessage{Kind: remindMessage, Event: &ReviewEvent{Type: ReminderEvent, RequestId: requestId}})
+	}
+	return session
+}
+
+func (h *Hub) remindAssignedReviewLocally(requestId uuid.UUID) *string {
+	h.ClientsMutex.RLock()
+	defer h.ClientsMutex.RUnlock()
+	h.AssignedReviewsMutex.RLock()
+	defer h.AssignedReviewsMutex.RUnlock()
+
+	for session, reviews := range h.AssignedReviews {
+		if _, assigned := reviews[requestId.String()]; !assigned {
+			continue
+		}
+
+		for client := range h.Sessions[session] {
+			client.sendEvent(ReviewEvent{Type: ReminderEvent, RequestId: requestId})
+		}
+		return &session
+	}
+	return nil
+}
+
+// remindQueue sends a queue reminder event about a review to every connected session subscribed to
+// it, and returns the sessions of this replica it reminded
+func (h *Hub) remindQueue(requestId uuid.UUID) []string {
+	sessions := h.remindQueueLocally(requestId)
+	h.publish(hubMessage{Kind: remindQueueMessage, Event: &ReviewEvent{Type: QueueReminderEvent, RequestId: requestId}})
+	return sessions
+}
+
+func (h *Hub) remindQueueLocally(requestId uuid.UUID) []string {
+	scopes := getReviewScopes(context.Background(), []uuid.UUID{requestId}, h.Store)
+
+	h.ClientsMutex.RLock()
+	defer h.ClientsMutex.RUnlock()
+
+	sessions := make([]string, 0, len(h.Sessions))
+	for session, clients := range h.Sessions {
+		reminded := false
+		for client := range clients {
+			if client.followsRequest(scopes, requestId) {
+				client.sendEvent(ReviewEvent{Type: QueueReminderEvent, RequestId: requestId})
+				reminded = true
 			}
 		}
+		if reminded {
+			sessions = append(sessions, session)
+		}
+	}
+	sort.Strings(sessions)
+	return sessions
+}
+
+// sessionConnected reports whether a session has any clients connected to this replica
+func (h *Hub) sessionConnected(session string) bool {
+	h.ClientsMutex.RLock()
+	defer h.ClientsMutex.RUnlock()
+
+	return len(h.Sessions[session]) > 0
+}
+
+// assignedSession returns the session a review is assigned to, or nil if it isn't assigned on this
+// replica
+func (h *Hub) assignedSession(requestId uuid.UUID) *string {
+	h.AssignedReviewsMutex.RLock()
+	defer h.AssignedReviewsMutex.RUnlock()
+
+	for session, reviews := range h.AssignedReviews {
+		if _, assigned := reviews[requestId.String()]; assigned {
+			return &session
+		}
+	}
+	return nil
+}
+
+// reassignReview moves a review to a session, taking it from any session it's assigned to. The
+// session doesn't need to be connected: its first connection is sent the review.
+func (h *Hub) reassignReview(supervisionRequest SupervisionRequest, session string) error {
+	h.reassignReviewLocally(supervisionRequest, session, true)
+	h.publish(hubMessage{Kind: reassignMessage, SupervisionRequest: &supervisionRequest, Session: session})
+
+	// Marking it assigned stops the processor queueing it for someone else
+	status := SupervisionStatus{
+		Status:               Assigned,
+		CreatedAt:            time.Now(),
+		SupervisionRequestId: supervisionRequest.Id,
+	}
+	if err := h.Store.CreateSupervisionStatus(context.Background(), *supervisionRequest.Id, status); err != nil {
+		return fmt.Errorf("error creating supervision status: %w", err)
+	}
+
+	return nil
+}
+
+// reassignReviewLocally takes a review from the other sessions of this replica and assigns it to a
+// session. Unless keep is set, it's only assigned if the session is connected to this replica.
+func (h *Hub) reassignReviewLocally(supervisionRequest SupervisionRequest, session string, keep bool) {
+	event := ReviewEvent{Type: ReassignedEvent, RequestId: *supervisionRequest.Id}
+
+	h.ClientsMutex.RLock()
+	h.AssignedReviewsMutex.Lock()
+	for previous, reviews := range h.AssignedReviews {
+		if _, assigned := reviews[supervisionRequest.Id.String()]; !assigned || previous == session {
+			continue
+		}
+
+		delete(reviews, supervisionRequest.Id.String())
+		for client := range h.Sessions[previous] {
+			client.sendEvent(event)
+		}
+	}
 
-		// Remove the client from the AssignedReviews map
-		delete(h.AssignedReviews, client)
+	if keep || len(h.Sessions[session]) > 0 {
+		if _, exists := h.AssignedReviews[session]; !exists {
+			h.AssignedReviews[session] = make(map[string]SupervisionRequest)
+		}
+		if _, assigned := h.AssignedReviews[session][supervisionRequest.Id.String()]; !assigned {
+			h.AssignedReviews[session][supervisionRequest.Id.String()] = supervisionRequest
+			for client := range h.Sessions[session] {
+				client.Send <- supervisionRequest
+			}
+		}
 	}
+	h.AssignedReviewsMutex.Unlock()
+	h.ClientsMutex.RUnlock()
 }
 
-// Client represents a single WebSocket connection
+// Client represents a single WebSocket connection, or the event stream of an SSE connection
 type Client struct {
-	Hub  *Hub
+	Hub *Hub
+	// Conn is the WebSocket connection, nil for clients of an event stream
 	Conn *websocket.Conn
-	Send chan SupervisionRequest
+	// Session is the session key the client connected with
+	Session string
+	// Skills are the skills the client connected with, lowercased
+	Skills []string
+	// Subscription is the runs, projects and supervisors the client is sent reviews of. Guarded by
+	// the hub's ClientsMutex
+	Subscription subscription
+	// Project is the project of the key the client connected with, if the key has one. The client is
+	// only sent and can only decide that project's reviews.
+	Project *uuid.UUID
+	// User is the user of the key the client connected with, if the key has one, who its decisions
+	// are recorded as made by
+	User *uuid.UUID
+	// Locale is the locale the client is sent event messages in
+	Locale string
+	// Send carries SupervisionRequests to review and ReviewEvents
+	Send chan interface{}
+}
+
+// sendEvent queues an event for the client without blocking, dropping it if the client is backed up.
+// Must be called with the hub's ClientsMutex held so the client can't be unregistered meanwhile.
+func (c *Client) sendEvent(event ReviewEvent) {
+	event.Message = localizeReviewEvent(c.Locale, event)
+	select {
+	case c.Send <- event:
+	default:
+		log.Printf("Dropped %s event for request %s, client in session %s is not reading", event.Type, event.RequestId, c.Session)
+	}
 }
 
 // WritePump handles the sending of reviews to the client
@@ -208,18 +666,28 @@ func (c *Client) WritePump() {
 		c.Hub.Unregister <- c
 	}()
 
-	for supervisionRequest := range c.Send {
-		if err := c.Conn.WriteJSON(supervisionRequest); err != nil {
-			log.Println("Error sending supervisionRequest to client:", err)
+	for message := range c.Send {
+		if err := c.Conn.WriteJSON(redactForDemo(message)); err != nil {
+			log.Println("Error sending message to client:", err)
 			break
 		}
 
-		// Log the supervisionrequest_status entry for the supervision request
-		rs := SupervisionStatus{Status: Assigned, CreatedAt: time.Now()}
-		err := c.Hub.Store.CreateSupervisionStatus(context.Background(), *supervisionRequest.Id, rs)
-		if err != nil {
-			fmt.Printf("Error creating supervisionrequest_status entry for supervisionRequest.RequestId %s: %v\n", *supervisionRequest.Id, err)
-		}
+		c.sent(message)
+	}
+}
+
+// sent records that a review was sent to the client
+func (c *Client) sent(message interface{}) {
+	supervisionRequest, ok := message.(SupervisionRequest)
+	if !ok {
+		return
+	}
+
+	// Log the supervisionrequest_status entry for the supervision request
+	rs := SupervisionStatus{Status: Assigned, CreatedAt: time.Now()}
+	err := c.Hub.Store.CreateSupervisionStatus(context.Background(), *supervisionRequest.Id, rs)
+	if err != nil {
+		fmt.Printf("Error creating supervisionrequest_status entry for supervisionRequest.RequestId %s: %v\n", *supervisionRequest.Id, err)
 	}
 }
 
@@ -237,6 +705,13 @@ func (c *Client) ReadPump() {
 			break
 		}
 
+		// Subscription changes are told apart from decisions by their type
+		var change subscriptionMessage
+		if err := json.Unmarshal(message, &change); err == nil && (change.Type == SubscribeMessage || change.Type == UnsubscribeMessage) {
+			c.Hub.updateSubscription(c, change)
+			continue
+		}
+
 		var response SupervisionResult
 		if err := json.Unmarshal(message, &response); err != nil {
 			log.Printf("Error unmarshaling reviewer response: %v. Message: %s", err, string(message))
@@ -249,8 +724,122 @@ func (c *Client) ReadPump() {
 			continue
 		}
 
-		// Handle the response
-		_, err = c.Hub.Store.CreateSupervisionResult(context.Background(), response, response.SupervisionRequestId)
+		reaches, err := c.reachesRequest(context.Background(), response.SupervisionRequestId)
+		if err != nil {
+			log.Printf("Error getting project of request %s: %v", response.SupervisionRequestId, err)
+			continue
+		}
+		if !reaches {
+			log.Printf("Ignoring response for request %s, it isn't of project %s of session %s", response.SupervisionRequestId, *c.Project, c.Session)
+			continue
+		}
+
+		// Only the server overrides decisions
+		response.OverriddenDecision = nil
+		response.UserId = c.User
+
+		if response.Verdict != nil {
+			verdicts, err := getVerdictsForSupervisionRequest(context.Background(), response.SupervisionRequestId, c.Hub.Store)
+			if err == nil {
+				err = applyVerdict(&response, verdicts)
+			}
+			if err != nil {
+				log.Printf("Error applying verdict for request %s: %v", response.SupervisionRequestId, err)
+				continue
+			}
+
+			// Asking the agent to clarify leaves the request undecided
+			if response.VerdictBehavior != nil && *response.VerdictBehavior == Clarify {
+				err := validateClarificationQuestion(response.Question)
+				if err == nil {
+					_, err = requestClarification(context.Background(), response.SupervisionRequestId, response, sessionActor(c.Session), c.Hub.Store, c.Hub)
+				}
+				if err != nil {
+					log.Printf("Error requesting clarification for request %s: %v", response.SupervisionRequestId, err)
+				}
+				continue
+			}
+		} else {
+			response.VerdictBehavior = nil
+		}
+
+		if holdsDecision(response.Decision) {
+			killSwitch, err := getActiveKillSwitchForSupervisionRequest(context.Background(), response.SupervisionRequestId, c.Hub.Store)
+			if err != nil {
+				log.Printf("Error getting kill switch for request %s: %v", response.SupervisionRequestId, err)
+				continue
+			}
+
+			if killSwitch != nil {
+				log.Printf("Holding %s decision for request %s, organization %s is halted", response.Decision, response.SupervisionRequestId, killSwitch.OrganizationId)
+				c.Hub.ClientsMutex.RLock()
+				if c.Hub.Clients[c] {
+					c.sendEvent(ReviewEvent{Type: HeldEvent, RequestId: response.SupervisionRequestId, Decision: response.Decision})
+				}
+				c.Hub.ClientsMutex.RUnlock()
+				continue
+			}
+		}
+
+		pause, err := getRunPauseForSupervisionRequest(context.Background(), response.SupervisionRequestId, c.Hub.Store)
+		if err != nil {
+			log.Printf("Error getting run pause for request %s: %v", response.SupervisionRequestId, err)
+			continue
+		}
+
+		if pause != nil {
+			log.Printf("Holding %s decision for request %s, run %s is paused", response.Decision, response.SupervisionRequestId, pause.RunId)
+			c.Hub.ClientsMutex.RLock()
+			if c.Hub.Clients[c] {
+				c.sendEvent(ReviewEvent{Type: RunPausedEvent, RequestId: response.SupervisionRequestId, Decision: response.Decision})
+			}
+			c.Hub.ClientsMutex.RUnlock()
+			continue
+		}
+
+		claim, err := claimedByOther(context.Background(), response.SupervisionRequestId, c.User, c.Hub.Store)
+		if err != nil {
+			log.Printf("Error getting claim of request %s: %v", response.SupervisionRequestId, err)
+			continue
+		}
+
+		if claim != nil {
+			log.Printf("Refusing decision for request %s from session %s, user %s claimed it", response.SupervisionRequestId, c.Session, claim.UserId)
+			c.Hub.ClientsMutex.RLock()
+			if c.Hub.Clients[c] {
+				c.sendEvent(ReviewEvent{Type: ClaimedEvent, RequestId: response.SupervisionRequestId, Decision: response.Decision})
+			}
+			c.Hub.ClientsMutex.RUnlock()
+			continue
+		}
+
+		if err := checkPrecedents(context.Background(), response.SupervisionRequestId, &response, c.Hub.Store); err != nil {
+			log.Printf("Error checking precedents of decision for request %s: %v", response.SupervisionRequestId, err)
+			continue
+		}
+
+		// Modified arguments are checked as they are over HTTP, and the original ones are the tool call's
+		if err := checkModification(context.Background(), response.SupervisionRequestId, &response, c.Hub.Store); err != nil {
+			log.Printf("Error checking modification of decision for request %s: %v", response.SupervisionRequestId, err)
+			continue
+		}
+
+		// Handle the response. The first decision for a review wins. Decisions arriving after it, e.g.
+		// from another tab, are dropped and their sender is told the review was already resolved
+		_, existing, err := resolveSupervisionRequest(context.Background(), response.SupervisionRequestId, response, sessionActor(c.Session), c.Hub.Store)
+		if err == nil && existing != nil {
+			log.Printf("Ignoring decision for already resolved request %s from session %s", response.SupervisionRequestId, c.Session)
+
+			c.Hub.ClientsMutex.RLock()
+			if c.Hub.Clients[c] {
+				c.sendEvent(ReviewEvent{Type: AlreadyResolvedEvent, RequestId: existing.SupervisionRequestId, Decision: existing.Decision})
+			}
+			c.Hub.ClientsMutex.RUnlock()
+
+			c.Hub.removeAssignedReview(c.Session, response.SupervisionRequestId)
+			continue
+		}
+
 		if err != nil {
 			log.Printf("Error creating supervisionresult entry for supervisionResult.RequestId %s: %v",
 				response.SupervisionRequestId.String(), err)
@@ -264,14 +853,12 @@ func (c *Client) ReadPump() {
 			if err := c.Hub.Store.CreateSupervisionStatus(context.Background(), response.SupervisionRequestId, status); err != nil {
 				log.Printf("Error resetting supervision status: %v", err)
 			}
+		} else {
+			c.Hub.notifyResolved(c, response)
 		}
 
 		// Always remove the review from assigned reviews, whether it succeeded or failed
-		c.Hub.AssignedReviewsMutex.Lock()
-		if _, exists := c.Hub.AssignedReviews[c]; exists {
-			delete(c.Hub.AssignedReviews[c], response.SupervisionRequestId.String())
-		}
-		c.Hub.AssignedReviewsMutex.Unlock()
+		c.Hub.removeAssignedReview(c.Session, response.SupervisionRequestId)
 	}
 }
 
@@ -293,8 +880,14 @@ func (h *Hub) getStats() (HubStats, error) {
 		return HubStats{}, fmt.Errorf("error counting assigned reviews: %w", err)
 	}
 
+	h.ClientsMutex.RLock()
+	connectedClients := len(h.Clients)
+	connectedSessions := len(h.Sessions)
+	h.ClientsMutex.RUnlock()
+
 	stats := HubStats{
-		ConnectedClients:   len(h.Clients),
+		ConnectedClients:   connectedClients,
+		ConnectedSessions:  &connectedSessions,
 		ReviewDistribution: make(map[string]int),
 		AssignedReviews:    make(map[string]int),
 		FreeClients:        0,
@@ -308,15 +901,14 @@ func (h *Hub) getStats() (HubStats, error) {
 	totalAssignedReviews := 0
 
 	h.AssignedReviewsMutex.RLock()
-	for client, reviews := range h.AssignedReviews {
-		clientKey := fmt.Sprintf("%p", client)
+	for session, reviews := range h.AssignedReviews {
 		assignedCount := len(reviews)
 
 		// Annoyingly the ReviewDistribution map is a map[string]int so we need to
 		// convert the assignedCount to a string
 		assignedCountStr := strconv.Itoa(assignedCount)
 
-		stats.AssignedReviews[clientKey] = assignedCount
+		stats.AssignedReviews[session] = assignedCount
 		stats.ReviewDistribution[assignedCountStr]++
 		totalAssignedReviews += assignedCount
 	}
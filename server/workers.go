@@ -0,0 +1,190 @@
+package asteroid
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const defaultWorkerLease = 30 * time.Second
+
+// Workers runs background workers on one replica at a time, so scaling the server out doesn't run
+// their jobs twice. A replica runs a worker while it holds the worker's lease, renewing it a few
+// times per lease, and stops the worker once the lease is lost or runs out without being renewed.
+// A replica that stops abruptly holds its leases until they expire.
+type Workers struct {
+	store    WorkerLeaseStore
+	instance string
+	lease    time.Duration
+	workers  []*leasedWorker
+}
+
+type leasedWorker struct {
+	name  string
+	start func(ctx context.Context)
+
+	mu        sync.Mutex
+	cancel    context.CancelFunc
+	startedAt *time.Time
+	lastError *string
+	// until is when the lease runs out by this replica's clock, after which the worker is stopped
+	// even if the replica couldn't tell whether the lease was taken
+	until time.Time
+}
+
+// NewWorkersFromEnv identifies the replica by INSTANCE_ID, or its host name, which is the pod's
+// name under Kubernetes, with a random suffix so processes sharing a host don't share leases.
+// WORKER_LEASE_SECONDS sets how long a lease lasts, and so how long workers of a replica that
+// stopped abruptly stay stopped.
+func NewWorkersFromEnv(store WorkerLeaseStore) (*Workers, error) {
+	instance := os.Getenv("INSTANCE_ID")
+	if instance == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("error getting host name, set INSTANCE_ID: %w", err)
+		}
+		instance = fmt.Sprintf("%s-%s", hostname, uuid.NewString()[:8])
+	}
+
+	lease := defaultWorkerLease
+	if value := os.Getenv("WORKER_LEASE_SECONDS"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds < 3 {
+			return nil, fmt.Errorf("WORKER_LEASE_SECONDS must be a number of seconds, at least 3")
+		}
+		lease = time.Duration(seconds) * time.Second
+	}
+
+	return &Workers{store: store, instance: instance, lease: lease}, nil
+}
+
+// Add adds a worker under a name, which replicas need to agree on. It's started by Start.
+func (w *Workers) Add(name string, start func(ctx context.Context)) {
+	w.workers = append(w.workers, &leasedWorker{name: name, start: start})
+}
+
+// Start contends for the lease of every worker until the context is done
+func (w *Workers) Start(ctx context.Context) {
+	log.Printf("Running background workers as instance %s", w.instance)
+	for _, worker := range w.workers {
+		go w.contend(ctx, worker)
+	}
+}
+
+func (w *Workers) contend(ctx context.Context, worker *leasedWorker) {
+	ticker := time.NewTicker(w.lease / 3)
+	defer ticker.Stop()
+
+	for {
+		w.renew(ctx, worker)
+
+		select {
+		case <-ctx.Done():
+			worker.mu.Lock()
+			worker.stop()
+			worker.mu.Unlock()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// renew acquires or renews a worker's lease, starting the worker when the lease is acquired and
+// stopping it when it's lost
+func (w *Workers) renew(ctx context.Context, worker *leasedWorker) {
+	requested := time.Now()
+	lease, err := w.store.AcquireWorkerLease(ctx, worker.name, w.instance, w.lease)
+
+	worker.mu.Lock()
+	defer worker.mu.Unlock()
+
+	switch {
+	case err != nil:
+		message := err.Error()
+		worker.lastError = &message
+		if worker.cancel != nil && time.Now().After(worker.until) {
+			log.Printf("Couldn't renew the lease of worker %s before it ran out, stopping it: %v", worker.name, err)
+			worker.stop()
+		}
+	case lease == nil:
+		worker.lastError = nil
+		if worker.cancel != nil {
+			log.Printf("Lost the lease of worker %s, stopping it", worker.name)
+			worker.stop()
+		}
+	default:
+		worker.lastError = nil
+		// Counted from before the request, as the lease may have been renewed any time after
+		worker.until = requested.Add(w.lease)
+		if worker.cancel == nil {
+			log.Printf("Acquired the lease of worker %s, starting it", worker.name)
+			workerCtx, cancel := context.WithCancel(ctx)
+			now := time.Now()
+			worker.cancel = cancel
+			worker.startedAt = &now
+			go worker.start(workerCtx)
+		}
+	}
+}
+
+// stop stops the worker if it runs, with its lock held
+func (l *leasedWorker) stop() {
+	if l.cancel != nil {
+		l.cancel()
+		l.cancel = nil
+	}
+}
+
+// report returns the workers and their leases as this replica sees them
+func (w *Workers) report(ctx context.Context) (*WorkerReport, error) {
+	leases, err := w.store.GetWorkerLeases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byWorker := make(map[string]WorkerLease, len(leases))
+	for _, lease := range leases {
+		byWorker[lease.Worker] = lease
+	}
+
+	report := WorkerReport{
+		InstanceId:   w.instance,
+		LeaseSeconds: int(w.lease.Seconds()),
+		Workers:      make([]WorkerStatus, 0, len(w.workers)),
+	}
+	for _, worker := range w.workers {
+		worker.mu.Lock()
+		status := WorkerStatus{
+			Worker:    worker.name,
+			Running:   worker.cancel != nil,
+			StartedAt: worker.startedAt,
+			LastError: worker.lastError,
+		}
+		worker.mu.Unlock()
+
+		if lease, ok := byWorker[worker.name]; ok {
+			status.Lease = &lease
+		}
+		report.Workers = append(report.Workers, status)
+	}
+
+	return &report, nil
+}
+
+func apiGetWorkersHandler(w http.ResponseWriter, r *http.Request, workers *Workers) {
+	ctx := r.Context()
+
+	report, err := workers.report(ctx)
+	if err != nil {
+		sendErrorResponse(w, http.StatusInternalServerError, "error getting worker leases", err.Error())
+		return
+	}
+
+	respondJSON(w, report, http.StatusOK)
+}
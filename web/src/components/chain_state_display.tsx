@@ -62,6 +62,7 @@ export default function ChainStateDisplay({ chainState, currentRequestId, index
                     <h3 className="font-semibold mb-2">Chain Information</h3>
                     <div className="space-y-1">
                       <div>Chain ID: <UUIDDisplay uuid={chainState.chain.chain_id} /></div>
+                      <div>Chain version: {chainState.chain_execution.chain_version}</div>
                       <div>Execution ID: <UUIDDisplay uuid={chainState.chain_execution.id} /></div>
                       <div>Created: {new Date(chainState.chain_execution.created_at).toLocaleString()}</div>
                       <div>Toolcall ID: <UUIDDisplay uuid={chainState.chain_execution.toolcall_id} /></div>
@@ -122,6 +123,16 @@ export default function ChainStateDisplay({ chainState, currentRequestId, index
                                 <div className="text-sm">
                                   <span className="font-medium">Decision:</span> {request.result.decision}
                                 </div>
+                                {request.result.overridden_decision && (
+                                  <div className="text-sm text-amber-700">
+                                    Escalated for low confidence, the supervisor decided {request.result.overridden_decision}
+                                  </div>
+                                )}
+                                {request.result.timeout_fallback && (
+                                  <div className="text-sm text-amber-700">
+                                    Timed out, decided by the {request.result.timeout_fallback} fallback
+                                  </div>
+                                )}
                                 {request.result.toolcall_id && (
                                   <div className="text-sm">
                                     <span className="font-medium">Tool Call:</span>
@@ -132,6 +143,23 @@ export default function ChainStateDisplay({ chainState, currentRequestId, index
                                   <span className="font-medium">Reasoning:</span>
                                   <p className="mt-1 text-gray-600">{request.result.reasoning}</p>
                                 </div>
+                                {request.result.explanation && (
+                                  <div className="text-sm space-y-1">
+                                    <span className="font-medium">Explanation:</span>
+                                    {request.result.explanation.argument_rule && (
+                                      <p className="text-gray-600">Decided by argument rule {request.result.explanation.argument_rule}, not the supervisor</p>
+                                    )}
+                                    {request.result.explanation.matched_rule_ids && request.result.explanation.matched_rule_ids.length > 0 && (
+                                      <p className="text-gray-600">Matched rules: {request.result.explanation.matched_rule_ids.join(", ")}</p>
+                                    )}
+                                    {request.result.explanation.rationale && (
+                                      <p className="text-gray-600 whitespace-pre-wrap">{request.result.explanation.rationale}</p>
+                                    )}
+                                    {request.result.explanation.confidence !== undefined && (
+                                      <p className="text-gray-600">Confidence: {Math.round(request.result.explanation.confidence * 100)}%</p>
+                                    )}
+                                  </div>
+                                )}
                               </div>
                             )}
 
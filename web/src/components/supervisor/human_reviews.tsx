@@ -38,6 +38,50 @@ type TimeoutMessage = {
   request_id: string;
 };
 
+// Sent when a review was decided in another tab, or before our decision reached the server
+type AlreadyResolvedMessage = {
+  type: 'already_resolved';
+  request_id: string;
+  decision: Decision;
+};
+
+// Sent when a review was handed over to another reviewer session
+type ReassignedMessage = {
+  type: 'reassigned';
+  request_id: string;
+};
+
+// Sent when we asked the agent a question, the review comes back once it's answered
+type ClarificationRequestedMessage = {
+  type: 'clarification_requested';
+  request_id: string;
+};
+
+// Sent to every session when several reviews were decided together through the API
+type BatchResolvedMessage = {
+  type: 'batch_resolved';
+  request_ids: string[];
+  decision: Decision;
+};
+
+// Sent when a reminder about a review we still have, or any review of the queue, fired
+type ReminderMessage = {
+  type: 'reminder' | 'queue_reminder';
+  request_id: string;
+  message?: string;
+};
+
+// Tabs of the same browser share a session so they're shown the same reviews
+const getSessionKey = (): string => {
+  const storageKey = 'asteroid_review_session';
+  let session = localStorage.getItem(storageKey);
+  if (!session) {
+    session = crypto.randomUUID();
+    localStorage.setItem(storageKey, session);
+  }
+  return session;
+};
+
 const HumanReviews: React.FC<ReviewSectionProps> = ({ supervisor }) => {
   const { API_BASE_URL, WEBSOCKET_BASE_URL } = useConfig();
   const [socket, setSocket] = useState<WebSocket | null>(null);
@@ -56,7 +100,9 @@ const HumanReviews: React.FC<ReviewSectionProps> = ({ supervisor }) => {
 
   // Hook to fetch payload for the next request in queue
   const nextRequestId = requestQueue[0];
-  const { data: nextReviewPayload } = useGetSupervisionReviewPayload(nextRequestId || '');
+  const { data: nextReviewPayload } = useGetSupervisionReviewPayload(nextRequestId || '', {
+    axios: { params: { screenshot_diff: true } },
+  });
 
   // Process the next item in the queue when payload is received
   useEffect(() => {
@@ -79,7 +125,9 @@ const HumanReviews: React.FC<ReviewSectionProps> = ({ supervisor }) => {
 
   // WebSocket initialization
   useEffect(() => {
-    const ws = new WebSocket(WEBSOCKET_BASE_URL);
+    const wsUrl = new URL(WEBSOCKET_BASE_URL);
+    wsUrl.searchParams.set('session', getSessionKey());
+    const ws = new WebSocket(wsUrl.toString());
     setSocket(ws);
 
     ws.onopen = () => {
@@ -89,9 +137,47 @@ const HumanReviews: React.FC<ReviewSectionProps> = ({ supervisor }) => {
     ws.onmessage = (event) => {
       const data = JSON.parse(event.data);
 
-      // Handle timeout messages
-      if (data.type === 'timeout') {
-        const timeoutData = data as TimeoutMessage;
+      // Approvals are held while the organization's kill switch is active, the review stays here
+      if (data.type === 'held') {
+        console.warn(`Decision for ${data.request_id} is held, the organization's kill switch is active`);
+        return;
+      }
+
+      // Decisions are held while the review's run is paused, the review stays here too
+      if (data.type === 'run_paused') {
+        console.warn(`Decision for ${data.request_id} is held, its run is paused`);
+        return;
+      }
+
+      // Reminders are about a review that's still here, it stays where it is
+      if (data.type === 'reminder' || data.type === 'queue_reminder') {
+        const reminder = data as ReminderMessage;
+        console.info(`Reminder for ${reminder.request_id}: ${reminder.message ?? 'still waiting for a decision'}`);
+        return;
+      }
+
+      // Reviews decided in a batch are all gone at once
+      if (data.type === 'batch_resolved') {
+        const batch = data as BatchResolvedMessage;
+        const resolved = new Set(batch.request_ids);
+        setRequestQueue(prev => prev.filter(id => !resolved.has(id)));
+        setReviews(prev => {
+          const newReviews = { ...prev };
+          batch.request_ids.forEach(id => delete newReviews[id]);
+
+          if (selectedRequestId && resolved.has(selectedRequestId)) {
+            const remainingIds = Object.keys(newReviews);
+            setSelectedRequestId(remainingIds.length > 0 ? remainingIds[0] : undefined);
+          }
+
+          return newReviews;
+        });
+        return;
+      }
+
+      // Handle timeout, already resolved, reassigned and clarification messages, all of which mean the review is gone
+      if (data.type === 'timeout' || data.type === 'already_resolved' || data.type === 'reassigned' || data.type === 'clarification_requested') {
+        const timeoutData = data as TimeoutMessage | AlreadyResolvedMessage | ReassignedMessage | ClarificationRequestedMessage;
         // Remove from queue if present
         setRequestQueue(prev => prev.filter(id => id !== timeoutData.request_id));
         // Remove from reviews if present
@@ -1,6 +1,7 @@
 import { Check, X, SkullIcon } from "lucide-react"
-import { ReviewPayload, Decision, AsteroidToolCall } from "@/types"
+import { ReviewPayload, Decision, AsteroidToolCall, ToolCallScreenshot, ComputerAction, ArgumentChange, useGetToolCallArgumentDiff } from "@/types"
 import React, { useState, useEffect } from "react"
+import axios from "axios"
 import { Button } from "@/components/ui/button"
 import { MessagesDisplay } from "../messages"
 import ChainStateDisplay from "../chain_state_display"
@@ -72,6 +73,123 @@ export default function ReviewRequestDisplay({ reviewPayload, sendResponse }: Re
       </div>
       <ToolCallState toolCallId={toolcall.call_id} />
 
+      {/* Blast Radius */}
+      {reviewPayload.blast_radius && (
+        <div className="space-y-2 rounded-md border p-2">
+          <h3 className="text-sm font-semibold">Blast Radius</h3>
+          <p className="text-sm">{reviewPayload.blast_radius.summary}</p>
+          {reviewPayload.blast_radius.resources.length > 0 && (
+            <ul className="text-xs">
+              {reviewPayload.blast_radius.resources.map((resource) => (
+                <li key={`${resource.kind}:${resource.identifier}`}>
+                  <span className="font-mono">{resource.identifier}</span>{" "}
+                  <span className="text-muted-foreground">
+                    ({resource.prior_tool_calls} earlier, {resource.prior_rejections} rejected, {resource.prior_incidents} incidents)
+                  </span>
+                </li>
+              ))}
+            </ul>
+          )}
+        </div>
+      )}
+
+      {/* Plan Deviation */}
+      {reviewPayload.plan_deviation && (
+        <div className="space-y-2 rounded-md border border-yellow-500 p-2">
+          <h3 className="text-sm font-semibold">Deviates from the approved plan</h3>
+          <p className="text-sm">
+            {{
+              out_of_order: `Matches step ${reviewPayload.plan_deviation.step_position} out of order`,
+              argument_mismatch: `Arguments differ from step ${reviewPayload.plan_deviation.step_position}`,
+              unplanned_tool: 'Uses a tool the rest of the plan doesn\'t',
+            }[reviewPayload.plan_deviation.kind]}
+            {reviewPayload.plan_deviation.expected_position !== undefined &&
+              `, step ${reviewPayload.plan_deviation.expected_position} was expected next`}
+          </p>
+          {reviewPayload.plan_deviation.diff && (
+            <pre className="max-h-96 overflow-auto whitespace-pre-wrap text-xs">
+              {reviewPayload.plan_deviation.diff.map((segment, i) => (
+                <span
+                  key={i}
+                  className={
+                    segment.op === 'insert' ? 'bg-green-200' : segment.op === 'delete' ? 'bg-red-200 line-through' : ''
+                  }
+                >
+                  {segment.text}
+                </span>
+              ))}
+            </pre>
+          )}
+        </div>
+      )}
+
+      {/* Reference Documents */}
+      {reviewPayload.documents && reviewPayload.documents.length > 0 && (
+        <div className="space-y-2">
+          <h3 className="text-sm font-semibold">Reference Documents</h3>
+          {reviewPayload.documents.map((document) => (
+            <details key={document.id} className="rounded-md border p-2">
+              <summary className="cursor-pointer text-sm">
+                {document.name} <span className="text-muted-foreground">({document.content_type})</span>
+              </summary>
+              <pre className="mt-2 max-h-96 overflow-auto whitespace-pre-wrap text-xs">{document.content}</pre>
+            </details>
+          ))}
+        </div>
+      )}
+
+      {/* Argument Diff */}
+      <ArgumentDiffDisplay toolCallId={toolcall.id} />
+
+      {/* Computer Action */}
+      {reviewPayload.computer_action && (
+        <ComputerActionDisplay action={reviewPayload.computer_action} />
+      )}
+
+      {/* Screenshot */}
+      {reviewPayload.screenshot && (
+        <ScreenshotDisplay screenshot={reviewPayload.screenshot} />
+      )}
+
+      {/* Artifacts */}
+      {reviewPayload.artifacts && reviewPayload.artifacts.length > 0 && (
+        <div className="space-y-2">
+          <h3 className="text-sm font-semibold">Artifacts</h3>
+          {reviewPayload.artifacts.map((artifact) => {
+            const url = artifactUrl(artifact.id);
+            return (
+              <div key={artifact.id} className="rounded-md border p-2 text-sm">
+                <a href={url} target="_blank" rel="noreferrer" className="underline">
+                  {artifact.name}
+                </a>{' '}
+                <span className="text-muted-foreground">
+                  ({artifact.content_type}, {artifact.size_bytes} bytes)
+                  {artifact.tool_call_id === toolcall.id && ' from this tool call'}
+                </span>
+                {artifact.content_type.startsWith('image/') && artifact.content_type !== 'image/svg+xml' && (
+                  <img src={url} alt={artifact.name} className="mt-2 max-h-96 rounded-md border" />
+                )}
+              </div>
+            );
+          })}
+        </div>
+      )}
+
+      {/* Clarifications */}
+      {reviewPayload.clarifications && reviewPayload.clarifications.length > 0 && (
+        <div className="space-y-2">
+          <h3 className="text-sm font-semibold">Questions to the Agent</h3>
+          {reviewPayload.clarifications.map((clarification) => (
+            <div key={clarification.id} className="rounded-md border p-2 text-sm">
+              <p className="font-medium">{clarification.question.question}</p>
+              <p className="text-muted-foreground">
+                {clarification.answer ?? 'Awaiting answer'}
+              </p>
+            </div>
+          ))}
+        </div>
+      )}
+
       {/* Context Display */}
       <MessagesDisplay messages={reviewPayload.messages} onToolCallClick={() => { }} expanded={true} />
 
@@ -89,3 +207,139 @@ export default function ReviewRequestDisplay({ reviewPayload, sendResponse }: Re
     </div>
   )
 }
+
+function artifactUrl(artifactId: string) {
+  return `${axios.defaults.baseURL}/artifact/${artifactId}/content`;
+}
+
+// Shows what changed in the arguments since the tool was last approved in the run, so repeated calls
+// don't have to be read in full
+function ArgumentDiffDisplay({ toolCallId }: { toolCallId: string }) {
+  const { data } = useGetToolCallArgumentDiff(toolCallId);
+  const diff = data?.data;
+  if (!diff?.basis) {
+    return null;
+  }
+
+  const baseline = diff.basis === 'safe_baseline';
+  return (
+    <div className="space-y-2">
+      <h3 className="text-sm font-semibold">{baseline ? 'Changes From the Safe Baseline' : 'Changes Since the Last Approved Call'}</h3>
+      {diff.changes.length === 0 ? (
+        <p className="text-sm text-muted-foreground">
+          {baseline ? "The arguments are the same as the tool's safe baseline" : "The arguments are the same as the last approved call's"}
+        </p>
+      ) : (
+        <div className="rounded-md border p-2 text-sm space-y-1">
+          {diff.changes.map((change) => (
+            <ArgumentChangeDisplay key={`${change.op}${change.path}`} change={change} />
+          ))}
+        </div>
+      )}
+    </div>
+  );
+}
+
+function ArgumentChangeDisplay({ change }: { change: ArgumentChange }) {
+  const path = <span className="font-mono text-muted-foreground">{change.path || '(arguments)'}</span>;
+
+  if (change.segments) {
+    return (
+      <p>
+        {path}{' '}
+        <span className="font-mono whitespace-pre-wrap">
+          {change.segments.map((segment, i) => (
+            <span
+              key={i}
+              className={
+                segment.op === 'insert' ? 'bg-green-100 text-green-800' :
+                segment.op === 'delete' ? 'bg-red-100 text-red-800 line-through' : undefined
+              }
+            >
+              {segment.text}
+            </span>
+          ))}
+        </span>
+      </p>
+    );
+  }
+
+  return (
+    <p>
+      {path}{' '}
+      {change.previous !== undefined && (
+        <span className="font-mono bg-red-100 text-red-800 line-through">{change.previous}</span>
+      )}
+      {change.previous !== undefined && change.current !== undefined && ' → '}
+      {change.current !== undefined && (
+        <span className="font-mono bg-green-100 text-green-800">{change.current}</span>
+      )}
+    </p>
+  );
+}
+
+function ComputerActionDisplay({ action }: { action: ComputerAction }) {
+  const { element } = action;
+  const point = (p?: { x: number; y: number }) => (p ? `(${p.x}, ${p.y})` : undefined);
+  const details: [string, string | undefined][] = [
+    ['From', point(action.start_coordinate)],
+    [action.start_coordinate ? 'To' : 'At', point(action.coordinate)],
+    ['Text', action.text],
+    ['Scroll', action.scroll_direction && `${action.scroll_direction} ${action.scroll_amount ?? ''}`.trim()],
+    ['Element', element && [element.role, element.name, element.selector].filter(Boolean).join(' ')],
+    ['Input type', element?.input_type],
+    ['Page', element?.url],
+  ];
+
+  return (
+    <div className="space-y-2">
+      <h3 className="text-sm font-semibold">Computer Action</h3>
+      <div className="rounded-md border p-2 text-sm">
+        <p className="font-medium">{action.action.replace(/_/g, ' ')}</p>
+        {details.filter(([, value]) => value).map(([label, value]) => (
+          <p key={label}>
+            <span className="text-muted-foreground">{label}:</span> <span className="font-mono">{value}</span>
+          </p>
+        ))}
+      </div>
+    </div>
+  );
+}
+
+// Outlines what changed since the previous screenshot and marks where the tool call clicks, scaled
+// from the screenshot's pixels to the size it's shown at
+function ScreenshotDisplay({ screenshot }: { screenshot: ToolCallScreenshot }) {
+  const { width, height, changed_region: region, click_point: click } = screenshot;
+  const percent = (value: number, of?: number) => (of ? `${(value / of) * 100}%` : undefined);
+
+  return (
+    <div className="space-y-2">
+      <h3 className="text-sm font-semibold">Screenshot</h3>
+      <div className="relative inline-block max-w-full">
+        <img src={artifactUrl(screenshot.artifact.id)} alt="Screenshot before the tool call" className="max-h-[32rem] rounded-md border" />
+        {region && (
+          <div
+            className="absolute border-2 border-yellow-400"
+            style={{
+              left: percent(region.x, width),
+              top: percent(region.y, height),
+              width: percent(region.width, width),
+              height: percent(region.height, height),
+            }}
+          />
+        )}
+        {click && width && height && (
+          <div
+            className="absolute h-4 w-4 -translate-x-1/2 -translate-y-1/2 rounded-full border-2 border-red-500 bg-red-500/40"
+            style={{ left: percent(click.x, width), top: percent(click.y, height) }}
+          />
+        )}
+      </div>
+      {screenshot.changed_fraction !== undefined && (
+        <p className="text-xs text-muted-foreground">
+          {(screenshot.changed_fraction * 100).toFixed(1)}% of the page changed since the previous screenshot
+        </p>
+      )}
+    </div>
+  );
+}
@@ -107,6 +107,11 @@ export function SupervisorTypeBadge({ type }: { type: SupervisorType }) {
     [SupervisorType.client_supervisor]: 'gray',
     [SupervisorType.human_supervisor]: 'gray',
     [SupervisorType.no_supervisor]: 'gray',
+    [SupervisorType.consent_supervisor]: 'gray',
+    [SupervisorType.ensemble_supervisor]: 'gray',
+    [SupervisorType.policy_supervisor]: 'gray',
+    [SupervisorType.llm_supervisor]: 'gray',
+    [SupervisorType.computer_use_supervisor]: 'gray',
   }
 
   return (
@@ -90,9 +90,23 @@ export interface AsteroidToolCall {
   created_at?: string;
   id: string;
   name?: string;
+  /** Where the arguments were redacted before they were stored */
+  redactions?: RedactedSpan[];
   tool_id: string;
 }
 
+/**
+ * A placeholder in text, where something was redacted before it was stored
+ */
+export interface RedactedSpan {
+  /** Offset of the end of the placeholder in UTF-16 code units, exclusive */
+  end: number;
+  placeholder: string;
+  redactor: string;
+  /** Offset of the placeholder in UTF-16 code units, the way JavaScript indexes strings */
+  start: number;
+}
+
 export type AsteroidMessageRole = typeof AsteroidMessageRole[keyof typeof AsteroidMessageRole];
 
 
@@ -109,7 +123,18 @@ export const AsteroidMessageRole = {
 /**
  * The raw b64 encoded JSON of the request and response data sent/received from the LLM.
  */
+export type ChatFormat = typeof ChatFormat[keyof typeof ChatFormat];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const ChatFormat = {
+  openai: 'openai',
+  anthropic: 'anthropic',
+  gemini: 'gemini',
+} as const;
+
 export interface AsteroidChat {
+  format?: ChatFormat;
   request_data: string;
   response_data: string;
 }
@@ -125,13 +150,233 @@ export interface Task {
 /**
  * Contains all the information needed for a human reviewer to make a supervision decision
  */
+export interface RunDocument {
+  /** Only included when getting a single document or a review payload */
+  content?: string;
+  content_type: string;
+  created_at: string;
+  id: string;
+  include_in_supervisor_context: boolean;
+  name: string;
+  run_id: string;
+  size_bytes: number;
+}
+
+/**
+ * A file an agent uploaded to its run, like a screenshot or a generated report
+ */
+export interface RunArtifact {
+  content_type: string;
+  created_at: string;
+  id: string;
+  name: string;
+  run_id: string;
+  sha256: string;
+  size_bytes: number;
+  /** The tool call that produced the artifact, if any */
+  tool_call_id?: string;
+  uploaded_by: string;
+}
+
+export interface ScreenshotRegion {
+  height: number;
+  width: number;
+  x: number;
+  y: number;
+}
+
+export interface ScreenshotPoint {
+  x: number;
+  y: number;
+}
+
+/**
+ * The screenshot a browser agent took before a tool call, with what changed since the previous
+ * screenshot of its run and where the call clicks, if its arguments say
+ */
+export interface ToolCallScreenshot {
+  artifact: RunArtifact;
+  /** The share of the screenshot's pixels that changed */
+  changed_fraction?: number;
+  changed_region?: ScreenshotRegion;
+  click_point?: ScreenshotPoint;
+  /** The screenshot as a data URL, only included for LLM supervisors */
+  data_url?: string;
+  height?: number;
+  /** The screenshot of the run's previous tool call, if it has one */
+  previous_artifact_id?: string;
+  /** Unset if the screenshot couldn't be decoded */
+  width?: number;
+}
+
+export type ComputerActionType =
+  | 'left_click'
+  | 'right_click'
+  | 'middle_click'
+  | 'double_click'
+  | 'type'
+  | 'key'
+  | 'scroll'
+  | 'mouse_move'
+  | 'left_click_drag'
+  | 'screenshot'
+  | 'wait';
+
+/** The element of a page an action targets, for agents that know it */
+export interface ComputerElement {
+  input_type?: string;
+  name?: string;
+  role?: string;
+  selector?: string;
+  url?: string;
+}
+
+/** A computer use tool call's action, read from its arguments */
+export interface ComputerAction {
+  action: ComputerActionType;
+  coordinate?: ScreenshotPoint;
+  element?: ComputerElement;
+  scroll_amount?: number;
+  scroll_direction?: 'up' | 'down' | 'left' | 'right';
+  start_coordinate?: ScreenshotPoint;
+  /** The text typed, or the keys pressed like ctrl+s */
+  text?: string;
+}
+
+export interface BlastRadiusResource {
+  identifier: string;
+  kind: string;
+  last_touched_at?: string;
+  /** Incidents of the project during which the resource was touched */
+  prior_incidents: number;
+  /** How many of those were rejected or terminated */
+  prior_rejections: number;
+  /** Earlier tool calls of the project that touched the resource */
+  prior_tool_calls: number;
+}
+
+/**
+ * Estimated from the resources the tool call's arguments refer to and what happened to earlier
+ * tool calls of the project that touched them
+ */
+export interface BlastRadius {
+  resources: BlastRadiusResource[];
+  reversibility: 'reversible' | 'irreversible' | 'unknown';
+  reversibility_reason: string;
+  risk_tier?: string;
+  summary: string;
+}
+
+export interface DiffSegment {
+  op: 'equal' | 'insert' | 'delete';
+  text: string;
+}
+
+export interface ArgumentChange {
+  /** The field's value in this call as JSON, unset if it was removed */
+  current?: string;
+  op: 'added' | 'removed' | 'changed';
+  /** A JSON pointer to the field, empty when the arguments differ as a whole */
+  path: string;
+  /** The field's value in the approved call or baseline as JSON, unset if it was added */
+  previous?: string;
+  /** A word level diff of the two values, for strings that changed */
+  segments?: DiffSegment[];
+}
+
+/**
+ * What a call's arguments are compared with. previous_approval is the latest call of the tool in
+ * the run approved before it, safe_baseline the arguments registered as safe for the tool in its
+ * project. Unset when there's neither.
+ */
+export type ArgumentDiffBasis = typeof ArgumentDiffBasis[keyof typeof ArgumentDiffBasis];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const ArgumentDiffBasis = {
+  previous_approval: 'previous_approval',
+  safe_baseline: 'safe_baseline',
+} as const;
+
+export interface ArgumentDiff {
+  basis?: ArgumentDiffBasis;
+  changes: ArgumentChange[];
+  /** The approved call the arguments are compared with, if the basis is previous_approval */
+  previous_tool_call_id?: string;
+  tool_call_id: string;
+}
+
+/** A field of a tool call's arguments, as described by its tool's JSON Schema */
+export interface ArgumentField {
+  depth: number;
+  description?: string;
+  enum?: unknown[];
+  format?: string;
+  label: string;
+  /** The JSON Pointer of the field in the arguments */
+  path: string;
+  /** How the value doesn't fit the schema, if it doesn't */
+  problem?: string;
+  required: boolean;
+  type: string;
+  /** The field's value as JSON, unset if the arguments leave it out */
+  value?: string;
+}
+
+export interface ArgumentForm {
+  /** Why the arguments couldn't be read, if they couldn't */
+  error?: string;
+  fields: ArgumentField[];
+  tool_call_id: string;
+  /** The JSON Pointers of arguments the schema doesn't describe */
+  unknown_paths: string[];
+}
+
+/**
+ * Recorded for a tool call a run makes once it has an approved plan that the call doesn't
+ * follow. Approvals of the tool call by automated supervisors are escalated.
+ */
+export interface PlanDeviation {
+  created_at: string;
+  /** From the step's arguments to the tool call's */
+  diff?: DiffSegment[];
+  /** The step the run was expected to make next, unset if it made them all */
+  expected_position?: number;
+  id: string;
+  kind: 'out_of_order' | 'argument_mismatch' | 'unplanned_tool';
+  plan_id: string;
+  /** The share of the step's argument values the tool call agrees on */
+  similarity?: number;
+  /** The step the tool call was compared with, unset for unplanned tools */
+  step_position?: number;
+  tool_call_id: string;
+}
+
 export interface ReviewPayload {
+  /** How the tool call's arguments differ from those of the tool's previous approved call or its argument baseline */
+  argument_diff?: ArgumentDiff;
+  /** The tool call's arguments as fields described by the tool's JSON Schema */
+  argument_form?: ArgumentForm;
+  /** The files the run uploaded, oldest first */
+  artifacts?: RunArtifact[];
+  /** An estimate of what the tool call could affect */
+  blast_radius?: BlastRadius;
   /** The state of the entire supervision chain, including previous supervision results */
   chain_state: ChainExecutionState;
+  /** The questions reviewers asked the agent about this request and its answers, oldest first */
+  clarifications?: Clarification[];
+  /** The tool call's action, if it's a computer use tool call */
+  computer_action?: ComputerAction;
+  /** The reference documents attached to the run, with their content */
+  documents?: RunDocument[];
   /** The messages in the run */
   messages: AsteroidMessage[];
+  /** How the tool call strays from its run's approved plan, if it does */
+  plan_deviation?: PlanDeviation;
   /** The ID of the run this review is for */
   run_id: string;
+  /** The latest screenshot uploaded for the tool call, if the agent uploaded any */
+  screenshot?: ToolCallScreenshot;
   /** The current supervision request being reviewed */
   supervision_request: SupervisionRequest;
   /** The tool call being supervised */
@@ -164,7 +409,7 @@ export interface HubStats {
 }
 
 /**
- * The type of supervisor. ClientSupervisor means that the supervision is done client side and the server is merely informed. Other supervisor types are handled serverside, e.g. HumanSupervisor means that a human will review the request via the Asteroid UI.
+ * The type of supervisor. ClientSupervisor means that the supervision is done client side and the server is merely informed. Other supervisor types are handled serverside, e.g. HumanSupervisor means that a human will review the request via the Asteroid UI, ConsentSupervisor means the end user affected by the tool call must consent to it through a link, EnsembleSupervisor means several LLM judges are asked in parallel and their verdicts aggregated, PolicySupervisor means the decision of the first of its rules (attribute rules, a list of PolicyRule) that the events posted to the run match, or its default_decision attribute (approve unless set) if none do, and LlmSupervisor means the server asks one model with a prompt rendered from a template and records the decision and rationale it answers with (attributes as in LlmSupervisorAttributes).
  */
 export type SupervisorType = typeof SupervisorType[keyof typeof SupervisorType];
 
@@ -174,6 +419,12 @@ export const SupervisorType = {
   client_supervisor: 'client_supervisor',
   human_supervisor: 'human_supervisor',
   no_supervisor: 'no_supervisor',
+  consent_supervisor: 'consent_supervisor',
+  ensemble_supervisor: 'ensemble_supervisor',
+  policy_supervisor: 'policy_supervisor',
+  llm_supervisor: 'llm_supervisor',
+  computer_use_supervisor: 'computer_use_supervisor',
+  servicenow_supervisor: 'servicenow_supervisor',
 } as const;
 
 export type Decision = typeof Decision[keyof typeof Decision];
@@ -198,6 +449,7 @@ export const Status = {
   failed: 'failed',
   assigned: 'assigned',
   timeout: 'timeout',
+  awaiting_clarification: 'awaiting_clarification',
 } as const;
 
 export interface SupervisionResult {
@@ -207,8 +459,78 @@ export interface SupervisionResult {
   reasoning: string;
   supervision_request_id: string;
   toolcall_id?: string;
+  verdict?: string;
+  verdict_behavior?: VerdictBehavior;
+  question?: ClarificationQuestion;
+  explanation?: ResultExplanation;
+  overridden_decision?: Decision;
+  timeout_fallback?: TimeoutFallback;
+}
+
+export interface ResultExplanation {
+  matched_rule_ids?: string[];
+  rationale?: string;
+  confidence?: number;
+  /** The project argument rule that decided the tool call in place of the supervisor */
+  argument_rule?: string;
+}
+
+export interface ArgumentCondition {
+  /** A JSON pointer to the field, like /amount. Empty for the arguments as a whole. */
+  path: string;
+  operator:
+    | 'equals'
+    | 'not_equals'
+    | 'less_than'
+    | 'less_or_equal'
+    | 'greater_than'
+    | 'greater_or_equal'
+    | 'starts_with'
+    | 'ends_with'
+    | 'contains'
+    | 'matches'
+    | 'one_of'
+    | 'exists';
+  value?: string;
+  values?: string[];
+}
+
+/** Approves or rejects calls of a tool whose arguments meet every condition, without asking its supervisors */
+export interface ArgumentRule {
+  name: string;
+  /** The tool the rule applies to, or * for every tool */
+  tool_name: string;
+  chain_id?: string;
+  conditions: ArgumentCondition[];
+  decision: Decision;
+}
+
+export interface ClarificationQuestion {
+  question: string;
+  choices?: string[];
+  arguments?: string[];
 }
 
+export interface Clarification {
+  id: string;
+  supervision_request_id: string;
+  question: ClarificationQuestion;
+  asked_by: string;
+  asked_at: string;
+  answer?: string;
+  answered_at?: string;
+}
+
+export type VerdictBehavior = typeof VerdictBehavior[keyof typeof VerdictBehavior];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const VerdictBehavior = {
+  block: 'block',
+  continue: 'continue',
+  clarify: 'clarify',
+} as const;
+
 export interface SupervisionStatus {
   created_at: string;
   id: number;
@@ -241,6 +563,8 @@ export interface AsteroidMessage {
   /** The raw b64 encoded JSON of the message objects in its original form */
   data?: string;
   id?: string;
+  /** Where the content was redacted before it was stored */
+  redactions?: RedactedSpan[];
   role: AsteroidMessageRole;
   tool_calls?: AsteroidToolCall[];
   /** The type of content in the message, either text or b64 encoded audio */
@@ -249,12 +573,41 @@ export interface AsteroidMessage {
 
 export interface SupervisorChain {
   chain_id: string;
+  /** Starts at 1 and increases with every edit of the chain's supervisors */
+  version: number;
   supervisors: Supervisor[];
+  min_confidence?: number;
+  timeout?: ChainTimeout;
+  supervisor_timeouts?: SupervisorTimeout[];
 }
 
 export interface ChainRequest {
   /** Array of supervisor IDs to create chains with */
   supervisor_ids?: string[];
+  min_confidence?: number;
+  timeout?: ChainTimeout;
+  supervisor_timeouts?: SupervisorTimeout[];
+}
+
+export type TimeoutFallback = typeof TimeoutFallback[keyof typeof TimeoutFallback];
+
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const TimeoutFallback = {
+  auto_approve: 'auto_approve',
+  auto_reject: 'auto_reject',
+  escalate_to_next: 'escalate_to_next',
+} as const;
+
+export interface ChainTimeout {
+  timeout_seconds: number;
+  fallback: TimeoutFallback;
+}
+
+export interface SupervisorTimeout {
+  supervisor_id: string;
+  timeout_seconds: number;
+  fallback: TimeoutFallback;
 }
 
 export type SupervisorAttributes = { [key: string]: unknown };
@@ -278,6 +631,8 @@ export interface Tool {
   id?: string;
   ignored_attributes?: string[];
   name: string;
+  /** The JSON Schema of the tool's arguments */
+  parameters?: Record<string, unknown>;
   run_id: string;
 }
 
@@ -304,6 +659,8 @@ export interface SupervisionRequestState {
 
 export interface ChainExecution {
   chain_id: string;
+  /** The version of the chain the execution started with, which it finishes under */
+  chain_version: number;
   created_at: string;
   id: string;
   toolcall_id: string;
@@ -1373,6 +1730,65 @@ export const useGetToolCall = <TData = Awaited<ReturnType<typeof getToolCall>>,
 
 
 
+/**
+ * @summary Get how a tool call's arguments differ from the latest approved call of its tool in its run
+ */
+export const getToolCallArgumentDiff = (
+    toolCallId: string, options?: AxiosRequestConfig
+ ): Promise<AxiosResponse<ArgumentDiff>> => {
+    
+    return axios.get(
+      `/tool_call/${toolCallId}/diff`,options
+    );
+  }
+
+
+export const getGetToolCallArgumentDiffQueryKey = (toolCallId: string,) => {
+    return [`/tool_call/${toolCallId}/diff`] as const;
+    }
+
+    
+export const getGetToolCallArgumentDiffQueryOptions = <TData = Awaited<ReturnType<typeof getToolCallArgumentDiff>>, TError = AxiosError<ErrorResponse>>(toolCallId: string, options?: { query?:UseQueryOptions<Awaited<ReturnType<typeof getToolCallArgumentDiff>>, TError, TData>, axios?: AxiosRequestConfig}
+) => {
+
+const {query: queryOptions, axios: axiosOptions} = options ?? {};
+
+  const queryKey =  queryOptions?.queryKey ?? getGetToolCallArgumentDiffQueryKey(toolCallId);
+
+  
+
+    const queryFn: QueryFunction<Awaited<ReturnType<typeof getToolCallArgumentDiff>>> = ({ signal }) => getToolCallArgumentDiff(toolCallId, { signal, ...axiosOptions });
+
+      
+
+      
+
+   return  { queryKey, queryFn, enabled: !!(toolCallId), ...queryOptions} as UseQueryOptions<Awaited<ReturnType<typeof getToolCallArgumentDiff>>, TError, TData> & { queryKey: QueryKey }
+}
+
+export type GetToolCallArgumentDiffQueryResult = NonNullable<Awaited<ReturnType<typeof getToolCallArgumentDiff>>>
+export type GetToolCallArgumentDiffQueryError = AxiosError<ErrorResponse>
+
+/**
+ * @summary Get how a tool call's arguments differ from the latest approved call of its tool in its run
+ */
+export const useGetToolCallArgumentDiff = <TData = Awaited<ReturnType<typeof getToolCallArgumentDiff>>, TError = AxiosError<ErrorResponse>>(
+ toolCallId: string, options?: { query?:UseQueryOptions<Awaited<ReturnType<typeof getToolCallArgumentDiff>>, TError, TData>, axios?: AxiosRequestConfig}
+
+  ):  UseQueryResult<TData, TError> & { queryKey: QueryKey } => {
+
+  const queryOptions = getGetToolCallArgumentDiffQueryOptions(toolCallId,options)
+
+  const query = useQuery(queryOptions) as  UseQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryOptions.queryKey ;
+
+  return query;
+}
+
+
+
+
 /**
  * @summary Get a tool call status
  */